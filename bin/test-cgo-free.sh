@@ -0,0 +1,43 @@
+#!/bin/bash -e
+
+# Checks that the services can be built without cgo, as make build PURE_GO=true does, for cross-compiling them: the
+# packages outside of the standard library which need cgo are listed in the dependencies of each service, with the
+# no_zmq build tag leaving out the message bus, then the service is built with cgo disabled. The standard library
+# packages using cgo, such as net, fall back to pure Go when cgo is disabled. GOOS, GOARCH and GOARM select the
+# target, e.g. GOARCH=riscv64 ./bin/test-cgo-free.sh
+
+# get the directory of this script
+# snippet from https://stackoverflow.com/a/246128/10102404
+SCRIPT_DIR="$( cd "$( dirname "${BASH_SOURCE[0]}" )" >/dev/null && pwd )"
+GIT_ROOT=$(dirname "$SCRIPT_DIR")
+
+EXIT_CODE=0
+
+cd "$GIT_ROOT"
+
+# turn on nullglobbing so if there is nothing in cmd dir then we don't do
+# anything in this loop
+shopt -s nullglob
+
+for cmd in cmd/* ; do
+    if [ ! -d "$cmd" ]; then
+        continue
+    fi
+
+    # cgo is enabled for the listing to include the files needing it
+    cgo_packages=$(CGO_ENABLED=1 GO111MODULE=on go list -deps -tags no_zmq \
+        -f '{{if and (not .Standard) (or .CgoFiles .CFiles .CXXFiles .SwigFiles)}}{{.ImportPath}}{{end}}' "./$cmd")
+    if [ -n "$cgo_packages" ]; then
+        echo "$cmd can't be built without cgo, as these packages need it:"
+        echo "$cgo_packages" | sed 's/^/    /'
+        EXIT_CODE=1
+        continue
+    fi
+
+    if ! CGO_ENABLED=0 GO111MODULE=on go build -tags no_zmq -o /dev/null "./$cmd"; then
+        echo "$cmd failed to build without cgo"
+        EXIT_CODE=1
+    fi
+done
+
+exit $EXIT_CODE
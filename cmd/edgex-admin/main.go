@@ -0,0 +1,16 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"os"
+
+	"github.com/edgexfoundry/edgex-go/internal/admin"
+)
+
+func main() {
+	os.Exit(admin.Main(os.Args[1:], os.Stdout))
+}
@@ -16,6 +16,8 @@ package main
 
 import (
 	"context"
+	// the image has no timezone database, the intervals scheduled in a named timezone rely on the embedded one
+	_ "time/tzdata"
 
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler"
 
@@ -0,0 +1,160 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package client calls the APIs of the EdgeX services on behalf of the admin commands, either directly or through the
+// API gateway, with the access token of the operator.
+package client
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+
+	"github.com/google/uuid"
+)
+
+// Service describes an EdgeX service reachable by the admin commands.
+type Service struct {
+	// Key is the service key, e.g. edgex-core-data.
+	Key string
+	// Port the service listens on when reached directly.
+	Port int
+	// Route is the path prefix of the service on the API gateway, empty when it isn't exposed by the gateway.
+	Route string
+}
+
+var (
+	CoreData         = Service{Key: clients.CoreDataServiceKey, Port: 48080, Route: "coredata"}
+	Metadata         = Service{Key: clients.CoreMetaDataServiceKey, Port: 48081, Route: "metadata"}
+	Command          = Service{Key: clients.CoreCommandServiceKey, Port: 48082, Route: "command"}
+	Notifications    = Service{Key: clients.SupportNotificationsServiceKey, Port: 48060, Route: "notifications"}
+	Scheduler        = Service{Key: clients.SupportSchedulerServiceKey, Port: 48085, Route: "scheduler"}
+	SystemManagement = Service{Key: clients.SystemManagementAgentServiceKey, Port: 48090}
+)
+
+// Services lists the services operated by the admin commands.
+var Services = []Service{CoreData, Metadata, Command, Notifications, Scheduler, SystemManagement}
+
+// StatusError is returned when a service answers a request with an unsuccessful status code.
+type StatusError struct {
+	Method     string
+	URL        string
+	StatusCode int
+	Body       string
+}
+
+func (e StatusError) Error() string {
+	return fmt.Sprintf("%s %s failed with code %d: %s", e.Method, e.URL, e.StatusCode, strings.TrimSpace(e.Body))
+}
+
+// Client calls the APIs of the EdgeX services.
+type Client struct {
+	host       string
+	gateway    string
+	token      string
+	httpClient *http.Client
+}
+
+// NewClient creates a Client reaching the services on host by their port or, when gateway is set, through the API
+// gateway at that url. The token, if any, is sent as the bearer token of every request.
+func NewClient(host string, gateway string, token string, timeout time.Duration, insecure bool) *Client {
+	httpClient := &http.Client{Timeout: timeout}
+	if insecure {
+		httpClient.Transport = &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}
+	}
+	return &Client{
+		host:       host,
+		gateway:    strings.TrimRight(gateway, "/"),
+		token:      token,
+		httpClient: httpClient,
+	}
+}
+
+// URL returns the url of the path of the API of the service.
+func (c *Client) URL(s Service, path string) string {
+	if c.gateway != "" && s.Route != "" {
+		return c.gateway + "/" + s.Route + path
+	}
+	return "http://" + c.host + ":" + strconv.Itoa(s.Port) + path
+}
+
+// Call sends a request to the path of the API of the service and returns the body of the response, or a StatusError
+// when the response isn't successful.
+func (c *Client) Call(s Service, method string, path string, body []byte) ([]byte, error) {
+	return c.Do(method, c.URL(s, path), body)
+}
+
+// Do sends a request to the url, which may be outside of the services operated, e.g. a device service, and returns
+// the body of the response, or a StatusError when the response isn't successful.
+func (c *Client) Do(method string, url string, body []byte) ([]byte, error) {
+	headers := map[string]string{}
+	if c.token != "" {
+		headers["Authorization"] = "Bearer " + c.token
+	}
+	return c.DoWithHeaders(method, url, headers, body)
+}
+
+// DoWithHeaders sends a request with the headers, instead of the access token, to the url, e.g. of the secret store,
+// and returns the body of the response, or a StatusError when the response isn't successful.
+func (c *Client) DoWithHeaders(method string, url string, headers map[string]string, body []byte) ([]byte, error) {
+	req, err := http.NewRequest(method, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > 0 {
+		req.Header.Set(clients.ContentType, clients.ContentTypeJSON)
+	}
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+	req.Header.Set(clients.CorrelationHeader, uuid.New().String())
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return data, StatusError{Method: method, URL: url, StatusCode: resp.StatusCode, Body: string(data)}
+	}
+	return data, nil
+}
+
+// ReadToken reads the access token stored in the file, either as is or, for the JSON files written when creating
+// the token, as its access_token or token field.
+func ReadToken(path string) (string, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	var fields struct {
+		AccessToken string `json:"access_token"`
+		Token       string `json:"token"`
+	}
+	if json.Unmarshal(data, &fields) == nil {
+		if fields.AccessToken != "" {
+			return fields.AccessToken, nil
+		}
+		if fields.Token != "" {
+			return fields.Token, nil
+		}
+		return "", fmt.Errorf("no access token found in %s", path)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
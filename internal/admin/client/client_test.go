@@ -0,0 +1,91 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestURL(t *testing.T) {
+	direct := NewClient("edge", "", "", time.Second, false)
+	assert.Equal(t, "http://edge:48080/api/v1/ping", direct.URL(CoreData, "/api/v1/ping"))
+
+	gateway := NewClient("edge", "https://edge:8443/", "", time.Second, false)
+	assert.Equal(t, "https://edge:8443/coredata/api/v1/ping", gateway.URL(CoreData, "/api/v1/ping"))
+	assert.Equal(t, "http://edge:48090/api/v1/ping", gateway.URL(SystemManagement, "/api/v1/ping"),
+		"the services not exposed by the gateway should be reached directly")
+}
+
+func TestCall(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer admin-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Path == "/coredata/missing" {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte("pong"))
+	}))
+	defer server.Close()
+
+	c := NewClient("", server.URL, "admin-token", time.Second, false)
+	body, err := c.Call(CoreData, http.MethodGet, "/api/v1/ping", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "pong", string(body))
+
+	_, err = c.Call(CoreData, http.MethodGet, "/missing", nil)
+	var statusErr StatusError
+	require.True(t, errors.As(err, &statusErr))
+	assert.Equal(t, http.StatusNotFound, statusErr.StatusCode)
+
+	_, err = NewClient("", server.URL, "", time.Second, false).Call(CoreData, http.MethodGet, "/api/v1/ping", nil)
+	require.True(t, errors.As(err, &statusErr))
+	assert.Equal(t, http.StatusUnauthorized, statusErr.StatusCode)
+}
+
+func TestReadToken(t *testing.T) {
+	dir, err := ioutil.TempDir("", "edgex-admin")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		name          string
+		content       string
+		expectedToken string
+		expectedErr   bool
+	}{
+		{"raw token", "eyJhbGciOi.payload.signature\n", "eyJhbGciOi.payload.signature", false},
+		{"access token field", `{"access_token":"jwt-token"}`, "jwt-token", false},
+		{"token field", `{"token":"jwt-token"}`, "jwt-token", false},
+		{"no token field", `{"user":"admin"}`, "", true},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(dir, string(rune('a'+i)))
+			require.NoError(t, ioutil.WriteFile(path, []byte(tt.content), 0600))
+
+			token, err := ReadToken(path)
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedToken, token)
+		})
+	}
+}
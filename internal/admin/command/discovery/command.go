@@ -0,0 +1,92 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package discovery
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal/admin/client"
+	"github.com/edgexfoundry/edgex-go/internal/admin/interfaces"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+const (
+	CommandName string = "discovery"
+
+	// discoveryPath is the path of the API of the device services triggering a discovery
+	discoveryPath = clients.ApiBase + "/discovery"
+)
+
+type cmd struct {
+	loggingClient logger.LoggingClient
+	client        *client.Client
+	out           io.Writer
+	service       string
+}
+
+func NewCommand(
+	lc logger.LoggingClient,
+	adminClient *client.Client,
+	out io.Writer,
+	args []string) (interfaces.Command, error) {
+
+	cmd := cmd{
+		loggingClient: lc,
+		client:        adminClient,
+		out:           out,
+	}
+
+	flagSet := flag.NewFlagSet(CommandName, flag.ContinueOnError)
+	flagSet.StringVar(&cmd.service, "service", "", "Name of the device service to trigger the discovery of")
+
+	err := flagSet.Parse(args)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to parse command: %s: %w", strings.Join(args, " "), err)
+	}
+	if cmd.service == "" {
+		return nil, fmt.Errorf("%s discovery: argument --service is required", os.Args[0])
+	}
+	return &cmd, nil
+}
+
+// Execute looks the device service up in core metadata and triggers its discovery, which runs asynchronously: the
+// devices found are added to core metadata by the device service.
+func (c *cmd) Execute() (int, error) {
+	body, err := c.client.Call(
+		client.Metadata,
+		http.MethodGet,
+		clients.ApiBase+"/deviceservice/name/"+url.PathEscape(c.service),
+		nil)
+	if err != nil {
+		return interfaces.StatusCodeExitWithError, fmt.Errorf("failed to look the device service %s up: %w", c.service, err)
+	}
+	var service models.DeviceService
+	if err = json.Unmarshal(body, &service); err != nil {
+		return interfaces.StatusCodeExitWithError, fmt.Errorf("failed to decode the device service %s: %w", c.service, err)
+	}
+	if service.AdminState == models.Locked {
+		return interfaces.StatusCodeExitWithError, fmt.Errorf("device service %s is locked", c.service)
+	}
+
+	discoveryURL := service.Addressable.GetBaseURL() + discoveryPath
+	c.loggingClient.Info(fmt.Sprintf("triggering the discovery of device service %s at %s", c.service, discoveryURL))
+	if _, err = c.client.Do(http.MethodPost, discoveryURL, nil); err != nil {
+		return interfaces.StatusCodeExitWithError, fmt.Errorf("failed to trigger the discovery of device service %s: %w", c.service, err)
+	}
+
+	fmt.Fprintf(c.out, "discovery triggered on device service %s\n", c.service)
+	return interfaces.StatusCodeExitNormal, nil
+}
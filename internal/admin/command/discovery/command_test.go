@@ -0,0 +1,95 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package discovery
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/admin/client"
+	"github.com/edgexfoundry/edgex-go/internal/admin/interfaces"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscovery(t *testing.T) {
+	discovered := false
+	deviceService := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == discoveryPath {
+			discovered = true
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer deviceService.Close()
+	deviceServiceURL, _ := url.Parse(deviceService.URL)
+	port, _ := strconv.Atoi(deviceServiceURL.Port())
+
+	metadata := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		service := models.DeviceService{
+			Name:       "device-onvif-camera",
+			AdminState: models.Unlocked,
+			Addressable: models.Addressable{
+				Name:     "device-onvif-camera",
+				Protocol: "HTTP",
+				Address:  deviceServiceURL.Hostname(),
+				Port:     port,
+			},
+		}
+		switch r.URL.Path {
+		case "/metadata/api/v1/deviceservice/name/device-onvif-camera":
+		case "/metadata/api/v1/deviceservice/name/device-locked":
+			service.AdminState = models.Locked
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(service)
+	}))
+	defer metadata.Close()
+
+	tests := []struct {
+		name               string
+		service            string
+		expectedDiscovered bool
+	}{
+		{"ok", "device-onvif-camera", true},
+		{"locked service", "device-locked", false},
+		{"unknown service", "device-unknown", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			discovered = false
+			command, err := NewCommand(
+				logger.MockLogger{},
+				client.NewClient("", metadata.URL, "", time.Second, false),
+				&bytes.Buffer{},
+				[]string{"--service", tt.service})
+			require.NoError(t, err)
+
+			code, err := command.Execute()
+			assert.Equal(t, tt.expectedDiscovered, discovered)
+			if tt.expectedDiscovered {
+				require.NoError(t, err)
+				assert.Equal(t, interfaces.StatusCodeExitNormal, code)
+			} else {
+				require.Error(t, err)
+				assert.Equal(t, interfaces.StatusCodeExitWithError, code)
+			}
+		})
+	}
+}
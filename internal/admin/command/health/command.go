@@ -0,0 +1,107 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package health
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/admin/client"
+	"github.com/edgexfoundry/edgex-go/internal/admin/interfaces"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+)
+
+const (
+	CommandName string = "health"
+)
+
+type cmd struct {
+	loggingClient logger.LoggingClient
+	client        *client.Client
+	out           io.Writer
+	services      []client.Service
+}
+
+func NewCommand(
+	lc logger.LoggingClient,
+	adminClient *client.Client,
+	out io.Writer,
+	args []string) (interfaces.Command, error) {
+
+	cmd := cmd{
+		loggingClient: lc,
+		client:        adminClient,
+		out:           out,
+	}
+	var services string
+
+	flagSet := flag.NewFlagSet(CommandName, flag.ContinueOnError)
+	flagSet.StringVar(&services, "services", "", "Comma separated keys of the services to check, all of them by default")
+
+	err := flagSet.Parse(args)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to parse command: %s: %w", strings.Join(args, " "), err)
+	}
+
+	cmd.services, err = selectServices(services)
+	if err != nil {
+		return nil, err
+	}
+	return &cmd, nil
+}
+
+// selectServices returns the services named by their comma separated keys, or all of them when there are none.
+func selectServices(keys string) ([]client.Service, error) {
+	if keys == "" {
+		return client.Services, nil
+	}
+
+	var services []client.Service
+	for _, key := range strings.Split(keys, ",") {
+		key = strings.TrimSpace(key)
+		found := false
+		for _, s := range client.Services {
+			if s.Key == key {
+				services = append(services, s)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown service %s", key)
+		}
+	}
+	return services, nil
+}
+
+// Execute pings every service and prints its status, and fails when any of them is unhealthy.
+func (c *cmd) Execute() (int, error) {
+	unhealthy := 0
+	for _, s := range c.services {
+		url := c.client.URL(s, clients.ApiPingRoute)
+		start := time.Now()
+		_, err := c.client.Do(http.MethodGet, url, nil)
+		latency := time.Since(start).Round(time.Millisecond)
+
+		status := "healthy"
+		if err != nil {
+			unhealthy++
+			status = "unhealthy: " + err.Error()
+		}
+		fmt.Fprintf(c.out, "%-30s %-8s %s (%s)\n", s.Key, latency, status, url)
+	}
+
+	if unhealthy > 0 {
+		return interfaces.StatusCodeExitWithError, fmt.Errorf("%d of %d services are unhealthy", unhealthy, len(c.services))
+	}
+	return interfaces.StatusCodeExitNormal, nil
+}
@@ -0,0 +1,71 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package health
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/admin/client"
+	"github.com/edgexfoundry/edgex-go/internal/admin/interfaces"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealth(t *testing.T) {
+	// the gateway only reaches core data and core metadata
+	gateway := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/coredata/api/v1/ping", "/metadata/api/v1/ping":
+			_, _ = w.Write([]byte("pong"))
+		default:
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	}))
+	defer gateway.Close()
+
+	tests := []struct {
+		name         string
+		services     string
+		expectedCode int
+	}{
+		{"healthy services", clients.CoreDataServiceKey + "," + clients.CoreMetaDataServiceKey, interfaces.StatusCodeExitNormal},
+		{"unhealthy service", clients.CoreDataServiceKey + "," + clients.CoreCommandServiceKey, interfaces.StatusCodeExitWithError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			command, err := NewCommand(
+				logger.MockLogger{},
+				client.NewClient("", gateway.URL, "", time.Second, false),
+				out,
+				[]string{"--services", tt.services})
+			require.NoError(t, err)
+
+			code, _ := command.Execute()
+			assert.Equal(t, tt.expectedCode, code)
+			assert.Len(t, strings.Split(strings.TrimSpace(out.String()), "\n"), 2, "every service should be reported")
+		})
+	}
+}
+
+func TestHealthUnknownService(t *testing.T) {
+	command, err := NewCommand(
+		logger.MockLogger{},
+		client.NewClient("", "", "", time.Second, false),
+		&bytes.Buffer{},
+		[]string{"--services", "edgex-unknown"})
+	require.Error(t, err)
+	require.Nil(t, command)
+}
@@ -0,0 +1,40 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package help
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal/admin/command"
+	"github.com/edgexfoundry/edgex-go/internal/admin/interfaces"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+)
+
+const (
+	CommandName = "help"
+)
+
+type cmd struct {
+	loggingClient logger.LoggingClient
+}
+
+func NewCommand(lc logger.LoggingClient, args []string) (interfaces.Command, error) {
+	flagSet := flag.NewFlagSet(CommandName, flag.ContinueOnError)
+	err := flagSet.Parse(args)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to parse command: %s: %w", strings.Join(args, " "), err)
+	}
+
+	return &cmd{loggingClient: lc}, nil
+}
+
+func (c *cmd) Execute() (statusCode int, err error) {
+	command.HelpCallback()
+	return interfaces.StatusCodeExitNormal, nil
+}
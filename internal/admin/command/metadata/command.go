@@ -0,0 +1,165 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metadata
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal/admin/client"
+	"github.com/edgexfoundry/edgex-go/internal/admin/interfaces"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+)
+
+const (
+	CommandName string = "metadata"
+
+	// Export is the subcommand writing the metadata to a file
+	Export = "export"
+	// Import is the subcommand adding the metadata of a file
+	Import = "import"
+)
+
+// Document holds the metadata exported, in the representation of the metadata API, so that importing it into another
+// deployment recreates the same objects.
+type Document struct {
+	Addressables   []json.RawMessage `json:"addressables"`
+	DeviceServices []json.RawMessage `json:"deviceServices"`
+	DeviceProfiles []json.RawMessage `json:"deviceProfiles"`
+	Devices        []json.RawMessage `json:"devices"`
+}
+
+// collection describes where the objects of a collection of the document are read from and added to.
+type collection struct {
+	name    string
+	path    string
+	objects *[]json.RawMessage
+}
+
+// collections lists the collections of the document in the order of their import, the referenced objects before
+// the objects referencing them.
+func (d *Document) collections() []collection {
+	return []collection{
+		{name: "addressables", path: clients.ApiBase + "/addressable", objects: &d.Addressables},
+		{name: "device services", path: clients.ApiBase + "/deviceservice", objects: &d.DeviceServices},
+		{name: "device profiles", path: clients.ApiBase + "/deviceprofile", objects: &d.DeviceProfiles},
+		{name: "devices", path: clients.ApiDeviceRoute, objects: &d.Devices},
+	}
+}
+
+type cmd struct {
+	loggingClient logger.LoggingClient
+	client        *client.Client
+	out           io.Writer
+	subcommand    string
+	file          string
+}
+
+func NewCommand(
+	lc logger.LoggingClient,
+	adminClient *client.Client,
+	out io.Writer,
+	args []string) (interfaces.Command, error) {
+
+	if len(args) == 0 || (args[0] != Export && args[0] != Import) {
+		return nil, fmt.Errorf("%s metadata: expected %s or %s", os.Args[0], Export, Import)
+	}
+	cmd := cmd{
+		loggingClient: lc,
+		client:        adminClient,
+		out:           out,
+		subcommand:    args[0],
+	}
+
+	flagSet := flag.NewFlagSet(CommandName+" "+cmd.subcommand, flag.ContinueOnError)
+	flagSet.StringVar(&cmd.file, "file", "", "File the metadata is exported to or imported from")
+
+	err := flagSet.Parse(args[1:])
+	if err != nil {
+		return nil, fmt.Errorf("Unable to parse command: %s: %w", strings.Join(args, " "), err)
+	}
+	if cmd.file == "" {
+		return nil, fmt.Errorf("%s metadata %s: argument --file is required", os.Args[0], cmd.subcommand)
+	}
+	return &cmd, nil
+}
+
+func (c *cmd) Execute() (int, error) {
+	if c.subcommand == Export {
+		return c.export()
+	}
+	return c.importDocument()
+}
+
+// export reads every collection from core metadata and writes the document to the file.
+func (c *cmd) export() (int, error) {
+	var document Document
+	for _, coll := range document.collections() {
+		body, err := c.client.Call(client.Metadata, http.MethodGet, coll.path, nil)
+		if err != nil {
+			return interfaces.StatusCodeExitWithError, fmt.Errorf("failed to export the %s: %w", coll.name, err)
+		}
+		if err = json.Unmarshal(body, coll.objects); err != nil {
+			return interfaces.StatusCodeExitWithError, fmt.Errorf("failed to decode the %s: %w", coll.name, err)
+		}
+		fmt.Fprintf(c.out, "exported %d %s\n", len(*coll.objects), coll.name)
+	}
+
+	data, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return interfaces.StatusCodeExitWithError, err
+	}
+	if err = ioutil.WriteFile(c.file, data, 0600); err != nil {
+		return interfaces.StatusCodeExitWithError, fmt.Errorf("failed to write %s: %w", c.file, err)
+	}
+	return interfaces.StatusCodeExitNormal, nil
+}
+
+// importDocument adds the objects of the file to core metadata. The objects which already exist are skipped, and
+// those which can't be added don't stop the import of the others.
+func (c *cmd) importDocument() (int, error) {
+	data, err := ioutil.ReadFile(c.file)
+	if err != nil {
+		return interfaces.StatusCodeExitWithError, fmt.Errorf("failed to read %s: %w", c.file, err)
+	}
+	var document Document
+	if err = json.Unmarshal(data, &document); err != nil {
+		return interfaces.StatusCodeExitWithError, fmt.Errorf("failed to decode %s: %w", c.file, err)
+	}
+
+	failed := 0
+	for _, coll := range document.collections() {
+		added, skipped := 0, 0
+		for _, object := range *coll.objects {
+			_, err := c.client.Call(client.Metadata, http.MethodPost, coll.path, object)
+			var statusErr client.StatusError
+			switch {
+			case err == nil:
+				added++
+			case errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusConflict:
+				skipped++
+			default:
+				failed++
+				c.loggingClient.Error(fmt.Sprintf("failed to import one of the %s: %s", coll.name, err.Error()))
+			}
+		}
+		fmt.Fprintf(c.out, "imported %d %s, %d already existing\n", added, coll.name, skipped)
+	}
+
+	if failed > 0 {
+		return interfaces.StatusCodeExitWithError, fmt.Errorf("%d objects failed to import", failed)
+	}
+	return interfaces.StatusCodeExitNormal, nil
+}
@@ -0,0 +1,99 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metadata
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/admin/client"
+	"github.com/edgexfoundry/edgex-go/internal/admin/interfaces"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestMetadata starts a core metadata serving the collections, and recording the objects posted, compacted, by
+// path. The objects named "existing" are rejected as duplicates, those named "invalid" as bad requests.
+func newTestMetadata(collections map[string]string, posted map[string][]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/metadata")
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = w.Write([]byte(collections[path]))
+		case http.MethodPost:
+			body, _ := ioutil.ReadAll(r.Body)
+			switch {
+			case strings.Contains(string(body), `"existing"`):
+				w.WriteHeader(http.StatusConflict)
+			case strings.Contains(string(body), `"invalid"`):
+				w.WriteHeader(http.StatusBadRequest)
+			default:
+				compacted := &bytes.Buffer{}
+				_ = json.Compact(compacted, body)
+				posted[path] = append(posted[path], compacted.String())
+			}
+		}
+	}))
+}
+
+func TestExportImport(t *testing.T) {
+	dir, err := ioutil.TempDir("", "edgex-admin")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "metadata.json")
+
+	source := newTestMetadata(map[string]string{
+		"/api/v1/addressable":   `[{"name":"address"}]`,
+		"/api/v1/deviceservice": `[{"name":"service"},{"name":"existing"}]`,
+		"/api/v1/deviceprofile": `[{"name":"profile"}]`,
+		"/api/v1/device":        `[{"name":"device","profile":{"name":"profile"}},{"name":"invalid"}]`,
+	}, nil)
+	defer source.Close()
+
+	out := &bytes.Buffer{}
+	command, err := NewCommand(logger.MockLogger{}, client.NewClient("", source.URL, "", time.Second, false), out, []string{Export, "--file", file})
+	require.NoError(t, err)
+	code, err := command.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, interfaces.StatusCodeExitNormal, code)
+	assert.Contains(t, out.String(), "exported 2 devices")
+
+	posted := make(map[string][]string)
+	target := newTestMetadata(nil, posted)
+	defer target.Close()
+
+	out.Reset()
+	command, err = NewCommand(logger.MockLogger{}, client.NewClient("", target.URL, "", time.Second, false), out, []string{Import, "--file", file})
+	require.NoError(t, err)
+	code, err = command.Execute()
+	require.Error(t, err, "the invalid device should fail the import")
+	assert.Equal(t, interfaces.StatusCodeExitWithError, code)
+
+	assert.Equal(t, []string{`{"name":"address"}`}, posted["/api/v1/addressable"])
+	assert.Equal(t, []string{`{"name":"service"}`}, posted["/api/v1/deviceservice"])
+	assert.Equal(t, []string{`{"name":"profile"}`}, posted["/api/v1/deviceprofile"])
+	assert.Equal(t, []string{`{"name":"device","profile":{"name":"profile"}}`}, posted["/api/v1/device"])
+	assert.Contains(t, out.String(), "imported 1 device services, 1 already existing")
+}
+
+func TestNewCommandBadArgs(t *testing.T) {
+	for _, args := range [][]string{{}, {"backup", "--file", "f"}, {Export}} {
+		command, err := NewCommand(logger.MockLogger{}, client.NewClient("", "", "", time.Second, false), &bytes.Buffer{}, args)
+		require.Error(t, err)
+		require.Nil(t, command)
+	}
+}
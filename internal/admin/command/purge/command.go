@@ -0,0 +1,119 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package purge
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/admin/client"
+	"github.com/edgexfoundry/edgex-go/internal/admin/interfaces"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+)
+
+const (
+	CommandName string = "purge"
+
+	// Events is the target purging the events, along with their readings, from core data
+	Events = "events"
+	// Notifications is the target purging the notifications, along with their transmissions, from support
+	// notifications
+	Notifications = "notifications"
+)
+
+type cmd struct {
+	loggingClient logger.LoggingClient
+	client        *client.Client
+	out           io.Writer
+	target        string
+	age           time.Duration
+	pushed        bool
+	all           bool
+}
+
+func NewCommand(
+	lc logger.LoggingClient,
+	adminClient *client.Client,
+	out io.Writer,
+	args []string) (interfaces.Command, error) {
+
+	if len(args) == 0 || (args[0] != Events && args[0] != Notifications) {
+		return nil, fmt.Errorf("%s purge: expected %s or %s", os.Args[0], Events, Notifications)
+	}
+	cmd := cmd{
+		loggingClient: lc,
+		client:        adminClient,
+		out:           out,
+		target:        args[0],
+	}
+
+	flagSet := flag.NewFlagSet(CommandName+" "+cmd.target, flag.ContinueOnError)
+	flagSet.DurationVar(&cmd.age, "age", 0, "Purge what is older than the age, e.g. 720h")
+	if cmd.target == Events {
+		flagSet.BoolVar(&cmd.pushed, "pushed", false, "Purge the events already pushed to the northbound")
+	}
+	flagSet.BoolVar(&cmd.all, "all", false, "Purge everything, regardless of its age")
+
+	err := flagSet.Parse(args[1:])
+	if err != nil {
+		return nil, fmt.Errorf("Unable to parse command: %s: %w", strings.Join(args, " "), err)
+	}
+
+	selected := 0
+	for _, set := range []bool{cmd.age > 0, cmd.pushed, cmd.all} {
+		if set {
+			selected++
+		}
+	}
+	if selected != 1 {
+		return nil, fmt.Errorf("%s purge %s: exactly one of the arguments --age, --pushed or --all is required", os.Args[0], cmd.target)
+	}
+	return &cmd, nil
+}
+
+// Execute deletes the data selected from the service holding it.
+func (c *cmd) Execute() (int, error) {
+	service, path := c.request()
+	c.loggingClient.Info(fmt.Sprintf("purging %s on %s", c.target, c.client.URL(service, path)))
+
+	body, err := c.client.Call(service, http.MethodDelete, path, nil)
+	if err != nil {
+		return interfaces.StatusCodeExitWithError, fmt.Errorf("failed to purge %s: %w", c.target, err)
+	}
+
+	// core data answers with the number of events deleted
+	if count, err := strconv.Atoi(strings.TrimSpace(string(body))); err == nil {
+		fmt.Fprintf(c.out, "%d %s purged\n", count, c.target)
+	} else {
+		fmt.Fprintf(c.out, "%s purged\n", c.target)
+	}
+	return interfaces.StatusCodeExitNormal, nil
+}
+
+// request returns the service and the path of the API purging the data selected.
+func (c *cmd) request() (client.Service, string) {
+	age := strconv.FormatInt(c.age.Milliseconds(), 10)
+	switch {
+	case c.target == Events && c.pushed:
+		return client.CoreData, clients.ApiEventRoute + "/scrub"
+	case c.target == Events && c.all:
+		return client.CoreData, clients.ApiEventRoute + "/scruball"
+	case c.target == Events:
+		return client.CoreData, clients.ApiEventRoute + "/removeold/age/" + age
+	case c.all:
+		return client.Notifications, clients.ApiBase + "/cleanup"
+	default:
+		return client.Notifications, clients.ApiBase + "/cleanup/age/" + age
+	}
+}
@@ -0,0 +1,73 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package purge
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/admin/client"
+	"github.com/edgexfoundry/edgex-go/internal/admin/interfaces"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPurge(t *testing.T) {
+	var requested string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = r.Method + " " + r.URL.Path
+		if r.URL.Path == "/coredata/api/v1/event/removeold/age/86400000" {
+			_, _ = w.Write([]byte("42"))
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name              string
+		args              []string
+		expectedRequest   string
+		expectedOutput    string
+		expectedParseFail bool
+	}{
+		{"events by age", []string{Events, "--age", "24h"}, "DELETE /coredata/api/v1/event/removeold/age/86400000", "42 events purged\n", false},
+		{"pushed events", []string{Events, "--pushed"}, "DELETE /coredata/api/v1/event/scrub", "events purged\n", false},
+		{"all events", []string{Events, "--all"}, "DELETE /coredata/api/v1/event/scruball", "events purged\n", false},
+		{"notifications by age", []string{Notifications, "--age", "1h"}, "DELETE /notifications/api/v1/cleanup/age/3600000", "notifications purged\n", false},
+		{"all notifications", []string{Notifications, "--all"}, "DELETE /notifications/api/v1/cleanup", "notifications purged\n", false},
+		{"no target", []string{}, "", "", true},
+		{"unknown target", []string{"readings", "--all"}, "", "", true},
+		{"no selection", []string{Events}, "", "", true},
+		{"several selections", []string{Events, "--all", "--pushed"}, "", "", true},
+		{"pushed notifications", []string{Notifications, "--pushed"}, "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			command, err := NewCommand(
+				logger.MockLogger{},
+				client.NewClient("", server.URL, "", time.Second, false),
+				out,
+				tt.args)
+			if tt.expectedParseFail {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			code, err := command.Execute()
+			require.NoError(t, err)
+			assert.Equal(t, interfaces.StatusCodeExitNormal, code)
+			assert.Equal(t, tt.expectedRequest, requested)
+			assert.Equal(t, tt.expectedOutput, out.String())
+		})
+	}
+}
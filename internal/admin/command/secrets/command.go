@@ -0,0 +1,188 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal/admin/client"
+	"github.com/edgexfoundry/edgex-go/internal/admin/interfaces"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+)
+
+const (
+	CommandName string = "secrets"
+
+	// Rotate is the subcommand replacing the values of secrets with new random ones
+	Rotate = "rotate"
+
+	// VaultTokenEnv is the environment variable holding the secret store token when none is given as argument
+	VaultTokenEnv = "VAULT_TOKEN"
+
+	// secretsPath is the path of the secrets of the EdgeX services in the key/value engine of the secret store
+	secretsPath = "/v1/secret/edgex/"
+)
+
+type cmd struct {
+	loggingClient logger.LoggingClient
+	client        *client.Client
+	out           io.Writer
+	vaultURL      string
+	vaultToken    string
+	path          string
+	keys          []string
+	length        int
+}
+
+func NewCommand(
+	lc logger.LoggingClient,
+	adminClient *client.Client,
+	out io.Writer,
+	args []string) (interfaces.Command, error) {
+
+	if len(args) == 0 || args[0] != Rotate {
+		return nil, fmt.Errorf("%s secrets: expected %s", os.Args[0], Rotate)
+	}
+	cmd := cmd{
+		loggingClient: lc,
+		client:        adminClient,
+		out:           out,
+	}
+	var keys string
+	var tokenFile string
+
+	flagSet := flag.NewFlagSet(CommandName+" "+Rotate, flag.ContinueOnError)
+	flagSet.StringVar(&cmd.vaultURL, "vault", "http://localhost:8200", "Url of the secret store")
+	flagSet.StringVar(&cmd.vaultToken, "vault-token", "", "Token of the secret store, defaults to $"+VaultTokenEnv)
+	flagSet.StringVar(&tokenFile, "vault-token-file", "", "File holding the token of the secret store, e.g. resp-init.json")
+	flagSet.StringVar(&cmd.path, "path", "", "Path of the secret under edgex/, e.g. redisdb")
+	flagSet.StringVar(&keys, "keys", "password", "Comma separated keys of the secret to rotate")
+	flagSet.IntVar(&cmd.length, "length", 32, "Number of random bytes of the new values")
+
+	err := flagSet.Parse(args[1:])
+	if err != nil {
+		return nil, fmt.Errorf("Unable to parse command: %s: %w", strings.Join(args, " "), err)
+	}
+	if cmd.path == "" {
+		return nil, fmt.Errorf("%s secrets rotate: argument --path is required", os.Args[0])
+	}
+	for _, key := range strings.Split(keys, ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			cmd.keys = append(cmd.keys, key)
+		}
+	}
+	if len(cmd.keys) == 0 {
+		return nil, fmt.Errorf("%s secrets rotate: argument --keys is required", os.Args[0])
+	}
+	if cmd.length < 16 {
+		return nil, fmt.Errorf("%s secrets rotate: argument --length must be at least 16", os.Args[0])
+	}
+
+	if cmd.vaultToken == "" && tokenFile != "" {
+		cmd.vaultToken, err = readVaultToken(tokenFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if cmd.vaultToken == "" {
+		cmd.vaultToken = os.Getenv(VaultTokenEnv)
+	}
+	if cmd.vaultToken == "" {
+		return nil, fmt.Errorf("%s secrets rotate: a secret store token is required", os.Args[0])
+	}
+	return &cmd, nil
+}
+
+// readVaultToken reads the root token of the initialization response, or the client token of a token creation
+// response, stored in the file.
+func readVaultToken(path string) (string, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the secret store token: %w", err)
+	}
+
+	var response struct {
+		RootToken string `json:"root_token"`
+		Auth      struct {
+			ClientToken string `json:"client_token"`
+		} `json:"auth"`
+	}
+	if err = json.Unmarshal(data, &response); err != nil {
+		return "", fmt.Errorf("failed to decode the secret store token file %s: %w", path, err)
+	}
+	if response.RootToken != "" {
+		return response.RootToken, nil
+	}
+	if response.Auth.ClientToken != "" {
+		return response.Auth.ClientToken, nil
+	}
+	return "", fmt.Errorf("no secret store token found in %s", path)
+}
+
+// Execute replaces the values of the keys of the secret with new random ones, keeping its other keys. The values
+// aren't printed: the services read them from the secret store.
+func (c *cmd) Execute() (int, error) {
+	url := strings.TrimRight(c.vaultURL, "/") + secretsPath + strings.Trim(c.path, "/")
+	headers := map[string]string{"X-Vault-Token": c.vaultToken}
+
+	var secret struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	body, err := c.client.DoWithHeaders(http.MethodGet, url, headers, nil)
+	var statusErr client.StatusError
+	switch {
+	case err == nil:
+		if err = json.Unmarshal(body, &secret); err != nil {
+			return interfaces.StatusCodeExitWithError, fmt.Errorf("failed to decode the secret %s: %w", c.path, err)
+		}
+	case errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusNotFound:
+		c.loggingClient.Info(fmt.Sprintf("secret %s not found, creating it", c.path))
+	default:
+		return interfaces.StatusCodeExitWithError, fmt.Errorf("failed to read the secret %s: %w", c.path, err)
+	}
+	if secret.Data == nil {
+		secret.Data = make(map[string]interface{})
+	}
+
+	for _, key := range c.keys {
+		value, err := randomValue(c.length)
+		if err != nil {
+			return interfaces.StatusCodeExitWithError, err
+		}
+		secret.Data[key] = value
+	}
+
+	data, err := json.Marshal(secret.Data)
+	if err != nil {
+		return interfaces.StatusCodeExitWithError, err
+	}
+	if _, err = c.client.DoWithHeaders(http.MethodPost, url, headers, data); err != nil {
+		return interfaces.StatusCodeExitWithError, fmt.Errorf("failed to write the secret %s: %w", c.path, err)
+	}
+
+	fmt.Fprintf(c.out, "rotated %s of secret %s\n", strings.Join(c.keys, ", "), c.path)
+	return interfaces.StatusCodeExitNormal, nil
+}
+
+// randomValue returns length random bytes, encoded in base64 without padding to be usable as a password.
+func randomValue(length int) (string, error) {
+	b := make([]byte, length)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate a random value: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
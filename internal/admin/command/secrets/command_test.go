@@ -0,0 +1,89 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/admin/client"
+	"github.com/edgexfoundry/edgex-go/internal/admin/interfaces"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotate(t *testing.T) {
+	stored := map[string]interface{}{"username": "redis5", "password": "old-password"}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "root-token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if r.URL.Path != "/v1/secret/edgex/redisdb" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": stored})
+		case http.MethodPost:
+			body, _ := ioutil.ReadAll(r.Body)
+			stored = nil
+			_ = json.Unmarshal(body, &stored)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	out := &bytes.Buffer{}
+	command, err := NewCommand(
+		logger.MockLogger{},
+		client.NewClient("", "", "", time.Second, false),
+		out,
+		[]string{Rotate, "--vault", server.URL, "--vault-token", "root-token", "--path", "redisdb"})
+	require.NoError(t, err)
+
+	code, err := command.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, interfaces.StatusCodeExitNormal, code)
+	assert.Equal(t, "redis5", stored["username"], "the keys not rotated should be kept")
+	assert.NotEqual(t, "old-password", stored["password"])
+	assert.Len(t, stored["password"], 43, "32 random bytes should be encoded in 43 characters")
+	assert.NotContains(t, out.String(), stored["password"], "the new value should not be printed")
+
+	command, err = NewCommand(
+		logger.MockLogger{},
+		client.NewClient("", "", "", time.Second, false),
+		out,
+		[]string{Rotate, "--vault", server.URL, "--vault-token", "wrong-token", "--path", "redisdb"})
+	require.NoError(t, err)
+	code, err = command.Execute()
+	require.Error(t, err)
+	assert.Equal(t, interfaces.StatusCodeExitWithError, code)
+}
+
+func TestNewCommandBadArgs(t *testing.T) {
+	tests := [][]string{
+		{},
+		{"delete", "--path", "redisdb", "--vault-token", "root-token"},
+		{Rotate, "--vault-token", "root-token"},
+		{Rotate, "--path", "redisdb", "--vault-token", "root-token", "--keys", ""},
+		{Rotate, "--path", "redisdb", "--vault-token", "root-token", "--length", "8"},
+	}
+	for _, args := range tests {
+		command, err := NewCommand(logger.MockLogger{}, client.NewClient("", "", "", time.Second, false), &bytes.Buffer{}, args)
+		require.Error(t, err)
+		require.Nil(t, command)
+	}
+}
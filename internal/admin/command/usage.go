@@ -0,0 +1,34 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package command
+
+import (
+	"fmt"
+	"os"
+)
+
+// HelpCallback displays the help usage message
+func HelpCallback() {
+	fmt.Printf(
+		"Usage: %s [options] <command> [arg...]\n"+
+			"Options:\n"+
+			"    -h, --help      Show this message\n"+
+			"    --host          Host the services are reached on by their port, defaults to localhost\n"+
+			"    --gateway       Url of the API gateway to reach the services through instead, e.g. https://edge:8443\n"+
+			"    --token         Access token sent to the services, defaults to $EDGEX_ADMIN_TOKEN\n"+
+			"    --token-file    File holding the access token\n"+
+			"    --timeout       Timeout of every request, defaults to 30s\n"+
+			"    --insecure      Skip the verification of the certificate of the API gateway\n"+
+			"\n"+
+			"Commands:\n"+
+			"    help            Show available commands (this text)\n"+
+			"    health          Check the health of the services\n"+
+			"    purge           Purge events or notifications\n"+
+			"    metadata        Export or import the device services, profiles and devices\n"+
+			"    secrets         Rotate secrets in the secret store\n"+
+			"    discovery       Trigger the discovery of devices by a device service\n",
+		os.Args[0])
+}
@@ -0,0 +1,111 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package admin implements edgex-admin, the command-line tool wrapping the common operations across the EdgeX
+// services, which it reaches through their APIs.
+package admin
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/admin/client"
+	"github.com/edgexfoundry/edgex-go/internal/admin/command"
+	"github.com/edgexfoundry/edgex-go/internal/admin/command/discovery"
+	"github.com/edgexfoundry/edgex-go/internal/admin/command/health"
+	"github.com/edgexfoundry/edgex-go/internal/admin/command/help"
+	"github.com/edgexfoundry/edgex-go/internal/admin/command/metadata"
+	"github.com/edgexfoundry/edgex-go/internal/admin/command/purge"
+	"github.com/edgexfoundry/edgex-go/internal/admin/command/secrets"
+	"github.com/edgexfoundry/edgex-go/internal/admin/interfaces"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+const (
+	adminServiceKey = "edgex-admin"
+
+	// TokenEnv is the environment variable holding the access token when none is given as argument
+	TokenEnv = "EDGEX_ADMIN_TOKEN"
+)
+
+// Main function called from cmd/edgex-admin, returning the exit status code
+func Main(args []string, out io.Writer) int {
+	lc := logger.NewClientStdOut(adminServiceKey, false, models.InfoLog)
+
+	var host, gateway, token, tokenFile string
+	var timeout time.Duration
+	var insecure, showHelp bool
+
+	flagSet := flag.NewFlagSet(adminServiceKey, flag.ContinueOnError)
+	flagSet.Usage = command.HelpCallback
+	flagSet.StringVar(&host, "host", "localhost", "")
+	flagSet.StringVar(&gateway, "gateway", "", "")
+	flagSet.StringVar(&token, "token", "", "")
+	flagSet.StringVar(&tokenFile, "token-file", "", "")
+	flagSet.DurationVar(&timeout, "timeout", 30*time.Second, "")
+	flagSet.BoolVar(&insecure, "insecure", false, "")
+	flagSet.BoolVar(&showHelp, "h", false, "")
+	flagSet.BoolVar(&showHelp, "help", false, "")
+
+	if err := flagSet.Parse(args); err != nil {
+		return interfaces.StatusCodeNoOptionSelected
+	}
+	if showHelp || flagSet.NArg() < 1 {
+		command.HelpCallback()
+		return interfaces.StatusCodeNoOptionSelected
+	}
+
+	if token == "" && tokenFile != "" {
+		var err error
+		token, err = client.ReadToken(tokenFile)
+		if err != nil {
+			lc.Error(fmt.Sprintf("unable to read the access token: %s", err.Error()))
+			return interfaces.StatusCodeExitWithError
+		}
+	}
+	if token == "" {
+		token = os.Getenv(TokenEnv)
+	}
+	adminClient := client.NewClient(host, gateway, token, timeout, insecure)
+
+	commandName := flagSet.Arg(0)
+	subcommandArgs := flagSet.Args()[1:]
+
+	var cmd interfaces.Command
+	var err error
+	switch commandName {
+	case help.CommandName:
+		cmd, err = help.NewCommand(lc, subcommandArgs)
+	case health.CommandName:
+		cmd, err = health.NewCommand(lc, adminClient, out, subcommandArgs)
+	case purge.CommandName:
+		cmd, err = purge.NewCommand(lc, adminClient, out, subcommandArgs)
+	case metadata.CommandName:
+		cmd, err = metadata.NewCommand(lc, adminClient, out, subcommandArgs)
+	case secrets.CommandName:
+		cmd, err = secrets.NewCommand(lc, adminClient, out, subcommandArgs)
+	case discovery.CommandName:
+		cmd, err = discovery.NewCommand(lc, adminClient, out, subcommandArgs)
+	default:
+		lc.Error(fmt.Sprintf("unsupported command %s", commandName))
+		return interfaces.StatusCodeNoOptionSelected
+	}
+
+	if err != nil {
+		lc.Error(err.Error())
+		return interfaces.StatusCodeExitWithError
+	}
+
+	exitStatusCode, err := cmd.Execute()
+	if err != nil {
+		lc.Error(err.Error())
+	}
+	return exitStatusCode
+}
@@ -0,0 +1,98 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package breaker fast-fails the commands addressed to a device service which has been unreachable for several
+// consecutive commands, instead of letting every caller wait out the full HTTP timeout.
+package breaker
+
+import (
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/errors"
+)
+
+// serviceState is the circuit state of a single device service.
+type serviceState struct {
+	failures int
+	// openedAt is when the circuit was tripped; it is zero while the circuit is closed
+	openedAt time.Time
+	// probing is true while the command probing whether the device service recovered is executed
+	probing bool
+}
+
+// Breaker tracks the consecutive failures of each device service. A nil Breaker never trips.
+type Breaker struct {
+	failureThreshold int
+	openDuration     time.Duration
+	now              func() time.Time
+
+	mutex    sync.Mutex
+	services map[string]*serviceState
+}
+
+// NewBreaker creates a Breaker tripping the circuit of a device service after failureThreshold consecutive failures.
+// Commands fail fast for openDuration, after which a single command is let through to probe the device service: the
+// circuit closes when it succeeds and stays open for another openDuration when it fails.
+func NewBreaker(failureThreshold int, openDuration time.Duration) *Breaker {
+	return &Breaker{
+		failureThreshold: failureThreshold,
+		openDuration:     openDuration,
+		now:              time.Now,
+		services:         make(map[string]*serviceState),
+	}
+}
+
+// Allow checks whether a command may be issued to the device service and returns the function reporting whether the
+// device service could be reached. An errors.ErrServiceUnavailable is returned while the circuit is open.
+func (b *Breaker) Allow(service string) (done func(reachable bool), err error) {
+	if b == nil || b.failureThreshold <= 0 {
+		return func(bool) {}, nil
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	state, ok := b.services[service]
+	if !ok {
+		state = &serviceState{}
+		b.services[service] = state
+	}
+
+	probe := false
+	if !state.openedAt.IsZero() {
+		retryAfter := state.openedAt.Add(b.openDuration).Sub(b.now())
+		if retryAfter > 0 {
+			return nil, errors.NewErrServiceUnavailable(service, retryAfter)
+		}
+		if state.probing {
+			// the outcome of the probe is not known yet
+			return nil, errors.NewErrServiceUnavailable(service, b.openDuration)
+		}
+		state.probing = true
+		probe = true
+	}
+
+	return func(reachable bool) { b.report(state, probe, reachable) }, nil
+}
+
+func (b *Breaker) report(state *serviceState, probe bool, reachable bool) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if probe {
+		state.probing = false
+	}
+	if reachable {
+		state.failures = 0
+		state.openedAt = time.Time{}
+		return
+	}
+
+	state.failures++
+	if probe || (state.openedAt.IsZero() && state.failures >= b.failureThreshold) {
+		state.openedAt = b.now()
+	}
+}
@@ -0,0 +1,84 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package breaker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestBreaker creates a Breaker whose clock is moved by the returned function.
+func newTestBreaker(failureThreshold int, openDuration time.Duration) (*Breaker, func(time.Duration)) {
+	breaker := NewBreaker(failureThreshold, openDuration)
+	now := time.Now()
+	breaker.now = func() time.Time { return now }
+	return breaker, func(d time.Duration) { now = now.Add(d) }
+}
+
+func execute(t *testing.T, breaker *Breaker, service string, reachable bool) {
+	done, err := breaker.Allow(service)
+	require.NoError(t, err)
+	done(reachable)
+}
+
+func TestNilBreakerNeverTrips(t *testing.T) {
+	var breaker *Breaker
+	for i := 0; i < 10; i++ {
+		execute(t, breaker, "service1", false)
+	}
+}
+
+func TestBreakerTripsAfterConsecutiveFailures(t *testing.T) {
+	breaker, _ := newTestBreaker(3, time.Minute)
+
+	execute(t, breaker, "service1", false)
+	execute(t, breaker, "service1", false)
+	execute(t, breaker, "service1", true)
+	execute(t, breaker, "service1", false)
+	execute(t, breaker, "service1", false)
+	_, err := breaker.Allow("service1")
+	require.NoError(t, err, "a success should reset the consecutive failures")
+
+	breaker.services["service1"].failures = 2
+	execute(t, breaker, "service1", false)
+	_, err = breaker.Allow("service1")
+	require.Error(t, err)
+	require.IsType(t, errors.ErrServiceUnavailable{}, err)
+	assert.Equal(t, time.Minute, err.(errors.ErrServiceUnavailable).RetryAfter())
+
+	execute(t, breaker, "service2", true)
+}
+
+func TestBreakerProbe(t *testing.T) {
+	breaker, advance := newTestBreaker(1, 30*time.Second)
+	execute(t, breaker, "service1", false)
+
+	advance(10 * time.Second)
+	_, err := breaker.Allow("service1")
+	require.Error(t, err)
+	assert.Equal(t, 20*time.Second, err.(errors.ErrServiceUnavailable).RetryAfter())
+
+	advance(20 * time.Second)
+	probe, err := breaker.Allow("service1")
+	require.NoError(t, err, "a command should be let through to probe the service once the circuit has been open long enough")
+	_, err = breaker.Allow("service1")
+	require.Error(t, err, "only one probe should be in flight")
+
+	probe(false)
+	_, err = breaker.Allow("service1")
+	require.Error(t, err, "a failed probe should keep the circuit open")
+
+	advance(30 * time.Second)
+	probe, err = breaker.Allow("service1")
+	require.NoError(t, err)
+	probe(true)
+	execute(t, breaker, "service1", true)
+}
@@ -0,0 +1,123 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package cache keeps the responses of read commands for a short time, so that bursts of identical reads are answered
+// without reaching the device service.
+package cache
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Response is a cached response of a device service.
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	Body       string
+}
+
+type entry struct {
+	device   string
+	response Response
+	expiry   time.Time
+}
+
+// Cache holds the responses of read commands until their TTL elapses. A nil Cache doesn't hold anything.
+type Cache struct {
+	ttl        time.Duration
+	maxEntries int
+
+	mutex   sync.Mutex
+	entries map[string]entry
+}
+
+// NewCache creates a Cache holding at most maxEntries responses, or any number of them when maxEntries is not
+// positive, for the ttl.
+func NewCache(ttl time.Duration, maxEntries int) *Cache {
+	return &Cache{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		entries:    make(map[string]entry),
+	}
+}
+
+// Key returns the key of the response of the command of the device to the query. Query parameters are sorted, so
+// that their order doesn't matter.
+func Key(device string, command string, query url.Values) string {
+	return strings.Join([]string{device, command, query.Encode()}, "|")
+}
+
+// Get returns the response cached with the key, if it didn't expire.
+func (c *Cache) Get(key string) (Response, bool) {
+	if c == nil {
+		return Response{}, false
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	e, ok := c.entries[key]
+	if !ok {
+		return Response{}, false
+	}
+	if time.Now().After(e.expiry) {
+		delete(c.entries, key)
+		return Response{}, false
+	}
+	return e.response, true
+}
+
+// Put caches the response of the device with the key. When the cache is full, expired responses are discarded first,
+// then the responses closest to expiry.
+func (c *Cache) Put(key string, device string, response Response) {
+	if c == nil || c.ttl <= 0 {
+		return
+	}
+
+	now := time.Now()
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if _, ok := c.entries[key]; !ok && c.maxEntries > 0 && len(c.entries) >= c.maxEntries {
+		c.evict(now)
+	}
+	c.entries[key] = entry{device: device, response: response, expiry: now.Add(c.ttl)}
+}
+
+// InvalidateDevice discards the cached responses of the device, e.g. once a set command changed its state.
+func (c *Cache) InvalidateDevice(device string) {
+	if c == nil {
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for key, e := range c.entries {
+		if e.device == device {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// evict makes room for a new entry; the caller must hold the lock.
+func (c *Cache) evict(now time.Time) {
+	var oldestKey string
+	var oldest time.Time
+	for key, e := range c.entries {
+		if now.After(e.expiry) {
+			delete(c.entries, key)
+			continue
+		}
+		if oldestKey == "" || e.expiry.Before(oldest) {
+			oldestKey = key
+			oldest = e.expiry
+		}
+	}
+	if len(c.entries) >= c.maxEntries && oldestKey != "" {
+		delete(c.entries, oldestKey)
+	}
+}
@@ -0,0 +1,67 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyIgnoresQueryOrder(t *testing.T) {
+	first, err := url.ParseQuery("a=1&b=2")
+	require.NoError(t, err)
+	second, err := url.ParseQuery("b=2&a=1")
+	require.NoError(t, err)
+
+	assert.Equal(t, Key("device1", "command1", first), Key("device1", "command1", second))
+	assert.NotEqual(t, Key("device1", "command1", first), Key("device1", "command2", first))
+}
+
+func TestCacheExpiry(t *testing.T) {
+	c := NewCache(50*time.Millisecond, 0)
+	response := Response{StatusCode: http.StatusOK, Body: `{"value":1}`}
+	c.Put("key", "device1", response)
+
+	cached, ok := c.Get("key")
+	require.True(t, ok)
+	assert.Equal(t, response, cached)
+
+	time.Sleep(60 * time.Millisecond)
+	_, ok = c.Get("key")
+	assert.False(t, ok, "expired response should not be returned")
+}
+
+func TestCacheEviction(t *testing.T) {
+	c := NewCache(time.Minute, 2)
+	c.Put("first", "device1", Response{Body: "1"})
+	time.Sleep(time.Millisecond)
+	c.Put("second", "device1", Response{Body: "2"})
+	c.Put("third", "device2", Response{Body: "3"})
+
+	_, ok := c.Get("first")
+	assert.False(t, ok, "the response closest to expiry should be evicted")
+	_, ok = c.Get("third")
+	assert.True(t, ok)
+
+	c.InvalidateDevice("device2")
+	_, ok = c.Get("third")
+	assert.False(t, ok)
+	_, ok = c.Get("second")
+	assert.True(t, ok)
+}
+
+func TestNilCache(t *testing.T) {
+	var c *Cache
+	c.Put("key", "device1", Response{})
+	_, ok := c.Get("key")
+	assert.False(t, ok)
+	c.InvalidateDevice("device1")
+}
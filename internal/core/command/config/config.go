@@ -15,17 +15,64 @@
 package config
 
 import (
+	"fmt"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/authz"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dependency"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/gateway"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/httptuning"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/mtls"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/readygate"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/selftest"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/slo"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/sysevent"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/telemetry"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/tracing"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/trustedproxy"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/unixsocket"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/warmup"
+
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
 )
 
 // ConfigurationStruct contains the configuration properties for the core-command service.
 type ConfigurationStruct struct {
-	Writable    WritableInfo
-	Clients     map[string]bootstrapConfig.ClientInfo
-	Databases   map[string]bootstrapConfig.Database
-	Registry    bootstrapConfig.RegistryInfo
-	Service     bootstrapConfig.ServiceInfo
-	SecretStore bootstrapConfig.SecretStoreInfo
+	Writable         WritableInfo
+	Clients          map[string]bootstrapConfig.ClientInfo
+	Databases        map[string]bootstrapConfig.Database
+	Registry         bootstrapConfig.RegistryInfo
+	Service          bootstrapConfig.ServiceInfo
+	SecretStore      bootstrapConfig.SecretStoreInfo
+	SLO              slo.SLOInfo
+	Authorization    authz.AuthorizationInfo
+	RBAC             authz.RBACInfo
+	UnixSocket       unixsocket.UnixSocketInfo
+	HttpTuning       httptuning.HttpTuningInfo
+	MutualTLS        mtls.MutualTLSInfo
+	TrustedProxy     trustedproxy.TrustedProxyInfo
+	Tracing          tracing.TracingInfo
+	Telemetry        telemetry.TelemetryInfo
+	Audit            audit.AuditInfo
+	DependencyCheck  dependency.DependencyCheckInfo
+	Gateway          gateway.GatewayInfo
+	Warmup           warmup.WarmupInfo
+	SelfTest         selftest.SelfTestInfo
+	SystemEvents     sysevent.SystemEventsInfo
+	ReadyGate        readygate.ReadyGateInfo
+	AsyncCommand     AsyncCommandInfo
+	CommandHistory   CommandHistoryInfo
+	CommandThrottle  CommandThrottleInfo
+	CommandCache     CommandCacheInfo
+	DeviceCache      DeviceCacheInfo
+	CircuitBreaker   CircuitBreakerInfo
+	Simulation       SimulationInfo
+	Streaming        StreamingInfo
+	EventPersistence EventPersistenceInfo
+	MessageQueue     MessageQueueInfo
+
+	// resolveWritable resolves the secret references of the writable configuration received from the registry
+	resolveWritable func(writable interface{}) bool
 }
 
 // WritableInfo contains configuration properties that can be updated and applied without restarting the service.
@@ -33,6 +80,137 @@ type WritableInfo struct {
 	LogLevel string
 }
 
+// AsyncCommandInfo contains configuration properties for executing commands requested asynchronously.
+type AsyncCommandInfo struct {
+	// Timeout of a single attempt to execute the command, e.g. '30s'
+	Timeout string
+	// Retries is the number of additional attempts made when the device service is unreachable or failing
+	Retries int
+	// RetryInterval is the delay between two attempts, e.g. '1s'
+	RetryInterval string
+	// JobRetention is how long the status of a completed job remains available, e.g. '10m'
+	JobRetention string
+}
+
+// CommandHistoryInfo contains configuration properties for the history of the commands executed.
+type CommandHistoryInfo struct {
+	// MaxAge is how long an executed command is kept in the history, e.g. '168h', forever when empty
+	MaxAge string
+	// MaxEntries is the number of the latest executed commands kept in the history, 0 for no limit
+	MaxEntries int
+	// PruneInterval is how often the commands beyond MaxAge or MaxEntries are deleted from the history, e.g. '1m'
+	PruneInterval string
+}
+
+// CommandThrottleInfo contains configuration properties for protecting devices from being overwhelmed by commands.
+type CommandThrottleInfo struct {
+	// CommandsPerSecond is the maximum number of commands issued to a single device per second, 0 for no limit
+	CommandsPerSecond float64
+	// MaxWait is how long a command waits for its turn before it is rejected, e.g. '5s'
+	MaxWait string
+	// ExclusiveSet indicates whether the set commands of a device are executed one at a time
+	ExclusiveSet bool
+}
+
+// CommandCacheInfo contains configuration properties for caching the responses of read commands.
+type CommandCacheInfo struct {
+	// Enabled indicates whether identical read commands are answered from the cache during the TTL
+	Enabled bool
+	// TTL is how long a response is cached, e.g. '2s'
+	TTL string
+	// MaxEntries is the maximum number of cached responses, 0 for no limit
+	MaxEntries int
+}
+
+// DeviceCacheInfo contains configuration properties for caching the devices read from core-metadata.
+type DeviceCacheInfo struct {
+	// Enabled indicates whether the devices, along with their profiles and device services, are cached
+	Enabled bool
+	// TTL is how long a device is cached, and so how long an update of the device in core-metadata, such as locking
+	// it, may take to apply to its commands, e.g. '30s'
+	TTL string
+}
+
+// CircuitBreakerInfo contains configuration properties for failing fast the commands to unreachable device services.
+type CircuitBreakerInfo struct {
+	// FailureThreshold is the number of consecutive failures to reach a device service tripping its circuit, 0 to
+	// never trip it
+	FailureThreshold int
+	// OpenDuration is how long commands fail fast before one is let through to probe the device service, e.g. '30s'
+	OpenDuration string
+}
+
+// SimulationInfo contains configuration properties for answering the commands of devices without their device service.
+type SimulationInfo struct {
+	// Devices are the names of the devices whose commands are simulated, in addition to the devices labelled
+	// 'simulated' in metadata
+	Devices []string
+}
+
+// StreamingInfo contains configuration properties for relaying the binary responses of device services without
+// buffering them.
+type StreamingInfo struct {
+	// Enabled indicates whether binary responses, such as images or CBOR events, are relayed as they are received
+	Enabled bool
+	// MaxSize is the maximum size in bytes of a relayed response, 0 for no limit
+	MaxSize int64
+	// Timeout is how long relaying a response may take, e.g. '60s'
+	Timeout string
+}
+
+// EventPersistenceInfo contains configuration properties for adding the events returned by read commands to core-data.
+type EventPersistenceInfo struct {
+	// Enabled indicates whether the events of read commands are persisted, on request with the 'persist' query
+	// parameter or for the commands listed
+	Enabled bool
+	// Commands are the read commands whose events are persisted unless the request says otherwise, named either after
+	// the command of any device or as '<device>/<command>'
+	Commands []string
+}
+
+// MessageQueueInfo provides parameters related to accepting command requests over a message bus.
+type MessageQueueInfo struct {
+	// Enabled indicates whether command requests are accepted over the message bus.
+	Enabled bool
+	// Host is the hostname or IP address of the broker, if applicable.
+	Host string
+	// Port defines the port on which to access the message queue.
+	Port int
+	// Protocol indicates the protocol to use when accessing the message queue.
+	Protocol string
+	// Indicates the message queue platform being used.
+	Type string
+	// RequestTopic is the topic on which command requests are received.
+	RequestTopic string
+	// ResponseTopic is the topic on which command responses are published.
+	ResponseTopic string
+	// Workers is the number of command requests executed concurrently, the others waiting for a worker to be free.
+	Workers int
+	// Timeout of the execution of a command requested over the message bus, e.g. '30s'.
+	Timeout string
+	// RequestsPerSecond is the maximum rate of the command requests accepted, the others being answered with 429, 0
+	// for no limit.
+	RequestsPerSecond float64
+	// Provides additional configuration properties which do not fit within the existing field.
+	// Typically the key is the name of the configuration property and the value is a string representation of the
+	// desired value for the configuration property.
+	Optional map[string]string
+}
+
+// URL constructs a URL from the protocol, host and port and returns that as a string.
+func (m MessageQueueInfo) URL() string {
+	return fmt.Sprintf("%s://%s:%v", m.Protocol, m.Host, m.Port)
+}
+
+// MessageBusAddress returns the address of the broker of the message bus, none when the command requests aren't
+// accepted over the message bus.
+func (c *ConfigurationStruct) MessageBusAddress() (string, bool) {
+	if !c.MessageQueue.Enabled {
+		return "", false
+	}
+	return fmt.Sprintf("%s:%d", c.MessageQueue.Host, c.MessageQueue.Port), true
+}
+
 // UpdateFromRaw converts configuration received from the registry to a service-specific configuration struct which is
 // then used to overwrite the service's existing configuration struct.
 func (c *ConfigurationStruct) UpdateFromRaw(rawConfig interface{}) bool {
@@ -54,15 +232,22 @@ func (c *ConfigurationStruct) EmptyWritablePtr() interface{} {
 }
 
 // UpdateWritableFromRaw converts configuration received from the registry to a service-specific WritableInfo struct
-// which is then used to overwrite the service's existing configuration's WritableInfo struct.
+// which is then used to overwrite the service's existing configuration's WritableInfo struct, once its secret
+// references are resolved.
 func (c *ConfigurationStruct) UpdateWritableFromRaw(rawWritable interface{}) bool {
 	writable, ok := rawWritable.(*WritableInfo)
-	if ok {
+	if ok && (c.resolveWritable == nil || c.resolveWritable(writable)) {
 		c.Writable = *writable
 	}
 	return ok
 }
 
+// SetWritableResolver sets the function resolving the secret references of the writable configuration received from
+// the registry, which is only applied when they are.
+func (c *ConfigurationStruct) SetWritableResolver(resolve func(writable interface{}) bool) {
+	c.resolveWritable = resolve
+}
+
 // GetBootstrap returns the configuration elements required by the bootstrap.  Currently, a copy of the configuration
 // data is returned.  This is intended to be temporary -- since ConfigurationStruct drives the configuration.toml's
 // structure -- until we can make backwards-breaking configuration.toml changes (which would consolidate these fields
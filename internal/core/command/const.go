@@ -22,4 +22,29 @@ const (
 	COMMANDID        = "commandid"
 	COMMANDNAME      = "commandname"
 	DEVICE           = "device"
+	ASYNC            = "async"
+	EXECUTEAT        = "executeAt"
+	CRON             = "cron"
+	DRYRUN           = "dryRun"
+	PERSIST          = "persist"
+	SIMULATION       = "simulation"
+	HISTORY          = "history"
+	START            = "start"
+	END              = "end"
+	LIMIT            = "limit"
+
+	// CACHECONTROLHEADER set to NOCACHE makes a read command bypass the command cache.
+	CACHECONTROLHEADER = "Cache-Control"
+	NOCACHE            = "no-cache"
+
+	// SIMULATEDHEADER marks the responses of the commands answered by the simulator instead of the device service.
+	SIMULATEDHEADER = "X-Simulated"
+
+	// RETRYAFTERHEADER tells the client how many seconds to wait before issuing a command failing fast again.
+	RETRYAFTERHEADER = "Retry-After"
+
+	// Outcomes of a set command writing several resources, depending on how many of them the device service applied.
+	SETSUCCEEDED = "SUCCEEDED"
+	SETPARTIAL   = "PARTIAL"
+	SETFAILED    = "FAILED"
 )
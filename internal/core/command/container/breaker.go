@@ -0,0 +1,20 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/command/breaker"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// BreakerName contains the name of the breaker.Breaker implementation in the DIC.
+var BreakerName = di.TypeInstanceToName(breaker.Breaker{})
+
+// BreakerFrom helper function queries the DIC and returns the breaker.Breaker implementation.
+func BreakerFrom(get di.Get) *breaker.Breaker {
+	return get(BreakerName).(*breaker.Breaker)
+}
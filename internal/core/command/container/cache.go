@@ -0,0 +1,21 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/command/cache"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// CommandCacheName contains the name of the cache.Cache implementation in the DIC.
+var CommandCacheName = di.TypeInstanceToName(cache.Cache{})
+
+// CommandCacheFrom helper function queries the DIC and returns the cache.Cache implementation, nil when the command
+// cache is disabled.
+func CommandCacheFrom(get di.Get) *cache.Cache {
+	return get(CommandCacheName).(*cache.Cache)
+}
@@ -0,0 +1,35 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db/mongo"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db/redis"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// the database clients put in the DIC by the database bootstrap handler
+var (
+	_ interfaces.DBClient = &redis.Client{}
+	_ interfaces.DBClient = mongo.MongoClient{}
+)
+
+func TestDBClientFrom(t *testing.T) {
+	client := &redis.Client{}
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return client
+		},
+	})
+	assert.Equal(t, client, DBClientFrom(dic.Get))
+}
@@ -0,0 +1,20 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/command/job"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// JobStoreName contains the name of the job.Store implementation in the DIC.
+var JobStoreName = di.TypeInstanceToName(job.Store{})
+
+// JobStoreFrom helper function queries the DIC and returns the job.Store implementation.
+func JobStoreFrom(get di.Get) *job.Store {
+	return get(JobStoreName).(*job.Store)
+}
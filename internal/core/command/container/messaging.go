@@ -0,0 +1,20 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/messaging"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// MessagingClientName contains the name of the messaging client instance in the DIC.
+var MessagingClientName = di.TypeInstanceToName((*messaging.MessageClient)(nil))
+
+// MessagingClientFrom helper function queries the DIC and returns the messaging client.
+func MessagingClientFrom(get di.Get) messaging.MessageClient {
+	return get(MessagingClientName).(messaging.MessageClient)
+}
@@ -0,0 +1,20 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/command/persist"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// PersisterName contains the name of the persist.Persister implementation in the DIC.
+var PersisterName = di.TypeInstanceToName(persist.Persister{})
+
+// PersisterFrom helper function queries the DIC and returns the persist.Persister implementation.
+func PersisterFrom(get di.Get) *persist.Persister {
+	return get(PersisterName).(*persist.Persister)
+}
@@ -0,0 +1,20 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/command/schedule"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// SchedulerName contains the name of the schedule.Scheduler implementation in the DIC.
+var SchedulerName = di.TypeInstanceToName(schedule.Scheduler{})
+
+// SchedulerFrom helper function queries the DIC and returns the schedule.Scheduler implementation.
+func SchedulerFrom(get di.Get) *schedule.Scheduler {
+	return get(SchedulerName).(*schedule.Scheduler)
+}
@@ -0,0 +1,20 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/command/simulator"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// SimulatorName contains the name of the simulator.Simulator implementation in the DIC.
+var SimulatorName = di.TypeInstanceToName(simulator.Simulator{})
+
+// SimulatorFrom helper function queries the DIC and returns the simulator.Simulator implementation.
+func SimulatorFrom(get di.Get) *simulator.Simulator {
+	return get(SimulatorName).(*simulator.Simulator)
+}
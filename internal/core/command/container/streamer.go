@@ -0,0 +1,20 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/command/stream"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// StreamerName contains the name of the stream.Streamer implementation in the DIC.
+var StreamerName = di.TypeInstanceToName(stream.Streamer{})
+
+// StreamerFrom helper function queries the DIC and returns the stream.Streamer implementation.
+func StreamerFrom(get di.Get) *stream.Streamer {
+	return get(StreamerName).(*stream.Streamer)
+}
@@ -0,0 +1,20 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/command/throttle"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// ThrottleName contains the name of the throttle.Throttle implementation in the DIC.
+var ThrottleName = di.TypeInstanceToName(throttle.Throttle{})
+
+// ThrottleFrom helper function queries the DIC and returns the throttle.Throttle implementation.
+func ThrottleFrom(get di.Get) *throttle.Throttle {
+	return get(ThrottleName).(*throttle.Throttle)
+}
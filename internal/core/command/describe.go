@@ -0,0 +1,207 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package command
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/utils"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/metadata"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+	v2 "github.com/edgexfoundry/go-mod-core-contracts/v2"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+
+	"github.com/gorilla/mux"
+)
+
+// commandDescription describes a command of a device for a UI to present it, from the device profile.
+type commandDescription struct {
+	Name string `json:"name"`
+	// Path is the path the command is issued on, with GET when it is readable and PUT when it is writable
+	Path     string `json:"path"`
+	Readable bool   `json:"readable"`
+	Writable bool   `json:"writable"`
+	// Parameters are the parameters of the body of the PUT command
+	Parameters []valueDescription `json:"parameters"`
+	// Readings are the readings returned by the GET command
+	Readings []valueDescription `json:"readings"`
+}
+
+// valueDescription describes a parameter or a reading of a command, from the device resource of the same name.
+type valueDescription struct {
+	Name         string `json:"name"`
+	Description  string `json:"description,omitempty"`
+	Type         string `json:"type,omitempty"`
+	ReadWrite    string `json:"readWrite,omitempty"`
+	Minimum      string `json:"minimum,omitempty"`
+	Maximum      string `json:"maximum,omitempty"`
+	DefaultValue string `json:"defaultValue,omitempty"`
+	Units        string `json:"units,omitempty"`
+	MediaType    string `json:"mediaType,omitempty"`
+	// Enum lists the only values accepted, or returned, when the operation maps them
+	Enum []string `json:"enum,omitempty"`
+}
+
+// commandDescriptionsResponse is the response of the command description API.
+type commandDescriptionsResponse struct {
+	commonDTO.BaseResponse `json:",inline"`
+	DeviceName             string               `json:"deviceName"`
+	ProfileName            string               `json:"profileName"`
+	Commands               []commandDescription `json:"commands"`
+}
+
+func restDescribeDeviceCommandsByName(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	deviceClient metadata.DeviceClient) {
+
+	ctx := r.Context()
+	name := mux.Vars(r)[v2.Name]
+
+	device, err := deviceClient.DeviceForName(ctx, name)
+	if err != nil {
+		status := commandErrorStatus(err)
+		lc.Error(fmt.Sprintf("failed to describe the commands of device %s: %s", name, err.Error()),
+			clients.CorrelationHeader, correlation.FromContext(ctx))
+		utils.WriteHttpHeader(w, ctx, status)
+		pkg.Encode(commonDTO.NewBaseResponse("", err.Error(), status), w, lc)
+		return
+	}
+
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(commandDescriptionsResponse{
+		BaseResponse: commonDTO.NewBaseResponse("", "", http.StatusOK),
+		DeviceName:   device.Name,
+		ProfileName:  device.Profile.Name,
+		Commands:     describeCommands(device),
+	}, w, lc)
+}
+
+// describeCommands describes the core commands of the profile of the device. The parameters of a command are the
+// parameter names of its PUT, or the device resources its device command sets, and its readings the device resources
+// its device command gets, or the device resource of the same name when it has no device command.
+func describeCommands(device contract.Device) []commandDescription {
+	profile := device.Profile
+	resources := make(map[string]contract.DeviceResource, len(profile.DeviceResources))
+	for _, dr := range profile.DeviceResources {
+		resources[dr.Name] = dr
+	}
+	deviceCommands := make(map[string]contract.ProfileResource, len(profile.DeviceCommands))
+	for _, pr := range profile.DeviceCommands {
+		deviceCommands[pr.Name] = pr
+	}
+
+	descriptions := make([]commandDescription, 0, len(profile.CoreCommands))
+	for _, c := range profile.CoreCommands {
+		description := commandDescription{
+			Name:       c.Name,
+			Path:       fmt.Sprintf("%s/name/%s/command/%s", clients.ApiDeviceRoute, url.PathEscape(device.Name), url.PathEscape(c.Name)),
+			Readable:   c.Get.Path != "",
+			Writable:   c.Put.Path != "",
+			Parameters: []valueDescription{},
+			Readings:   []valueDescription{},
+		}
+		deviceCommand, hasDeviceCommand := deviceCommands[c.Name]
+
+		if description.Writable {
+			enumerations := setEnumerations(profile, c.Name)
+			names := c.Put.ParameterNames
+			if len(names) == 0 {
+				names = operationResources(deviceCommand.Set)
+			}
+			for _, name := range names {
+				var enum []string
+				if values, ok := enumerations[name]; ok {
+					enum = sortedKeys(values)
+				}
+				description.Parameters = append(description.Parameters, describeValue(name, resources, enum))
+			}
+		}
+
+		if description.Readable {
+			if hasDeviceCommand {
+				enumerations := getEnumerations(deviceCommand.Get)
+				for _, name := range operationResources(deviceCommand.Get) {
+					description.Readings = append(description.Readings,
+						describeValue(name, resources, enumerations[name]))
+				}
+			} else if _, ok := resources[c.Name]; ok {
+				description.Readings = append(description.Readings, describeValue(c.Name, resources, nil))
+			}
+		}
+
+		descriptions = append(descriptions, description)
+	}
+	return descriptions
+}
+
+// describeValue describes the parameter or reading name from its device resource, when there is one.
+func describeValue(name string, resources map[string]contract.DeviceResource, enum []string) valueDescription {
+	description := valueDescription{Name: name, Enum: enum}
+	resource, ok := resources[name]
+	if !ok {
+		return description
+	}
+
+	value := resource.Properties.Value
+	description.Description = resource.Description
+	description.Type = value.Type
+	description.ReadWrite = value.ReadWrite
+	description.Minimum = value.Minimum
+	description.Maximum = value.Maximum
+	description.DefaultValue = value.DefaultValue
+	description.Units = resource.Properties.Units.DefaultValue
+	description.MediaType = value.MediaType
+	return description
+}
+
+// operationResources returns the device resources of the resource operations, in order and without duplicates.
+func operationResources(operations []contract.ResourceOperation) []string {
+	var names []string
+	seen := make(map[string]bool, len(operations))
+	for _, ro := range operations {
+		name := operationResource(ro)
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		names = append(names, name)
+	}
+	return names
+}
+
+// getEnumerations returns, per device resource, the values the get operations with mappings translate the readings
+// of the device to.
+func getEnumerations(operations []contract.ResourceOperation) map[string][]string {
+	enumerations := make(map[string][]string)
+	for _, ro := range operations {
+		if len(ro.Mappings) == 0 {
+			continue
+		}
+		seen := make(map[string]struct{}, len(ro.Mappings))
+		for _, value := range ro.Mappings {
+			seen[value] = struct{}{}
+		}
+		enumerations[operationResource(ro)] = sortedKeys(seen)
+	}
+	return enumerations
+}
+
+// operationResource returns the device resource of a resource operation, named by Object in the older profiles.
+func operationResource(ro contract.ResourceOperation) string {
+	if ro.DeviceResource != "" {
+		return ro.DeviceResource
+	}
+	return ro.Object
+}
@@ -0,0 +1,125 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package command
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mdMocks "github.com/edgexfoundry/edgex-go/internal/mocks"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/types"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+	v2 "github.com/edgexfoundry/go-mod-core-contracts/v2"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func describeTestDevice() contract.Device {
+	device := validationTestDevice()
+	device.Profile.Name = "thermostat-profile"
+	device.Profile.DeviceResources[3].Description = "ambient temperature"
+	device.Profile.DeviceResources[3].Properties.Units = contract.Units{DefaultValue: "C"}
+	device.Profile.DeviceCommands[0].Get = []contract.ResourceOperation{
+		{DeviceResource: "SetPoint"},
+		{Object: "Mode", Mappings: map[string]string{"1": "heat", "2": "cool", "3": "cool"}},
+	}
+	device.Profile.CoreCommands = []contract.Command{
+		{
+			Name: "Configuration",
+			Get:  contract.Get{Action: contract.Action{Path: "/api/v1/device/{deviceId}/Configuration"}},
+			Put:  contract.Put{Action: contract.Action{Path: "/api/v1/device/{deviceId}/Configuration"}},
+		},
+		{
+			Name: "Temperature",
+			Get:  contract.Get{Action: contract.Action{Path: "/api/v1/device/{deviceId}/Temperature"}},
+		},
+		{
+			Name: "Enable",
+			Put: contract.Put{
+				Action:         contract.Action{Path: "/api/v1/device/{deviceId}/Enable"},
+				ParameterNames: []string{"Enabled"},
+			},
+		},
+	}
+	return device
+}
+
+func TestDescribeCommands(t *testing.T) {
+	descriptions := describeCommands(describeTestDevice())
+	require.Len(t, descriptions, 3)
+
+	configuration := descriptions[0]
+	assert.Equal(t, "/api/v1/device/name/thermostat/command/Configuration", configuration.Path)
+	assert.True(t, configuration.Readable)
+	assert.True(t, configuration.Writable)
+	assert.Equal(t, []valueDescription{
+		{Name: "SetPoint", Type: "Float32", ReadWrite: "RW", Minimum: "5", Maximum: "30"},
+		{Name: "Mode", Type: "String", ReadWrite: "RW", Enum: []string{"cool", "heat"}},
+	}, configuration.Parameters)
+	assert.Equal(t, []valueDescription{
+		{Name: "SetPoint", Type: "Float32", ReadWrite: "RW", Minimum: "5", Maximum: "30"},
+		{Name: "Mode", Type: "String", ReadWrite: "RW", Enum: []string{"cool", "heat"}},
+	}, configuration.Readings)
+
+	temperature := descriptions[1]
+	assert.True(t, temperature.Readable)
+	assert.False(t, temperature.Writable)
+	assert.Empty(t, temperature.Parameters)
+	assert.Equal(t, []valueDescription{
+		{Name: "Temperature", Description: "ambient temperature", Type: "Int16", ReadWrite: "R", Units: "C"},
+	}, temperature.Readings)
+
+	enable := descriptions[2]
+	assert.False(t, enable.Readable)
+	assert.True(t, enable.Writable)
+	assert.Equal(t, []valueDescription{{Name: "Enabled", Type: "Bool", ReadWrite: "RW"}}, enable.Parameters)
+	assert.Empty(t, enable.Readings)
+}
+
+func TestRestDescribeDeviceCommandsByName(t *testing.T) {
+	device := describeTestDevice()
+	deviceClient := &mdMocks.DeviceClient{}
+	deviceClient.On("DeviceForName", mock.Anything, device.Name).Return(device, nil)
+	deviceClient.On("DeviceForName", mock.Anything, "unknown").
+		Return(contract.Device{}, types.NewErrServiceClient(http.StatusNotFound, []byte("device not found")))
+
+	tests := []struct {
+		name           string
+		deviceName     string
+		expectedStatus int
+	}{
+		{"described", device.Name, http.StatusOK},
+		{"device not found", "unknown", http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v2/device/name/"+tt.deviceName+"/commands/describe", nil)
+			req = mux.SetURLVars(req, map[string]string{v2.Name: tt.deviceName})
+			rr := httptest.NewRecorder()
+
+			restDescribeDeviceCommandsByName(rr, req, logger.NewMockClient(), deviceClient)
+			require.Equal(t, tt.expectedStatus, rr.Code)
+
+			var response commandDescriptionsResponse
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+			assert.Equal(t, tt.expectedStatus, response.StatusCode)
+			if tt.expectedStatus != http.StatusOK {
+				assert.NotEmpty(t, response.Message)
+				return
+			}
+			assert.Equal(t, device.Name, response.DeviceName)
+			assert.Equal(t, "thermostat-profile", response.ProfileName)
+			assert.Len(t, response.Commands, 3)
+		})
+	}
+}
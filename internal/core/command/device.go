@@ -18,12 +18,25 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/edgexfoundry/edgex-go/internal"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/breaker"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/cache"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/errors"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/persist"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/simulator"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/stream"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/throttle"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/tracing"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/trustedproxy"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/metadata"
@@ -32,13 +45,25 @@ import (
 	"github.com/gorilla/mux"
 )
 
+// commandDeps are the dependencies of the execution of the commands against the device services. The throttle, cache,
+// breaker, simulator, persister and streamer are nil when they're disabled.
+type commandDeps struct {
+	lc              logger.LoggingClient
+	dbClient        interfaces.DBClient
+	deviceClient    metadata.DeviceClient
+	commandThrottle *throttle.Throttle
+	commandCache    *cache.Cache
+	commandBreaker  *breaker.Breaker
+	deviceSimulator *simulator.Simulator
+	eventPersister  *persist.Persister
+	commandStreamer *stream.Streamer
+	httpCaller      internal.HttpCaller
+}
+
 func executeCommandByDeviceID(
 	originalRequest *http.Request,
 	body string,
-	lc logger.LoggingClient,
-	dbClient interfaces.DBClient,
-	deviceClient metadata.DeviceClient,
-	httpCaller internal.HttpCaller) (deviceServiceResponse *http.Response, theResponseBody string, failure error) {
+	deps commandDeps) (deviceServiceResponse *http.Response, theResponseBody string, failure error) {
 
 	if originalRequest == nil {
 		return nil, "", errors.NewErrExtractingInfoFromRequest()
@@ -50,7 +75,7 @@ func executeCommandByDeviceID(
 		return nil, "", err
 	}
 
-	d, err := deviceClient.Device(ctx, deviceID)
+	d, err := deps.deviceClient.Device(ctx, deviceID)
 	if err != nil {
 		return nil, "", err
 	}
@@ -60,7 +85,7 @@ func executeCommandByDeviceID(
 	}
 
 	// once command service have its own persistence layer this call will be changed.
-	commands, err := dbClient.GetCommandsByDeviceId(d.Id)
+	commands, err := deps.dbClient.GetCommandsByDeviceId(d.Id)
 	if err != nil {
 		return nil, "", err
 	}
@@ -77,7 +102,7 @@ func executeCommandByDeviceID(
 		return nil, "", errors.NewErrCommandNotAssociatedWithDevice(commandID, deviceID)
 	}
 
-	return executeCommandByDevice(ctx, d, c, body, lc, originalRequest, httpCaller)
+	return executeCommandByDevice(ctx, d, c, body, originalRequest, deps)
 }
 
 // extractDeviceIdAndCommandIdFromRequest extracts deviceID and commandID from r, which
@@ -101,12 +126,9 @@ func executeCommandByName(
 	dn string,
 	cn string,
 	body string,
-	lc logger.LoggingClient,
-	dbClient interfaces.DBClient,
-	deviceClient metadata.DeviceClient,
-	httpCaller internal.HttpCaller) (deviceServiceResponse *http.Response, theResponseBody string, failure error) {
+	deps commandDeps) (deviceServiceResponse *http.Response, theResponseBody string, failure error) {
 
-	d, err := deviceClient.DeviceForName(ctx, dn)
+	d, err := deps.deviceClient.DeviceForName(ctx, dn)
 	if err != nil {
 		return nil, "", err
 	}
@@ -115,12 +137,12 @@ func executeCommandByName(
 		return nil, "", errors.NewErrDeviceLocked(d.Name)
 	}
 
-	command, err := dbClient.GetCommandByNameAndDeviceId(cn, d.Id)
+	command, err := deps.dbClient.GetCommandByNameAndDeviceId(cn, d.Id)
 	if err != nil {
 		return nil, "", err
 	}
 
-	return executeCommandByDevice(ctx, d, command, body, lc, originalRequest, httpCaller)
+	return executeCommandByDevice(ctx, d, command, body, originalRequest, deps)
 }
 
 func executeCommandByDevice(
@@ -128,9 +150,8 @@ func executeCommandByDevice(
 	device contract.Device,
 	command contract.Command,
 	body string,
-	lc logger.LoggingClient,
 	originalRequest *http.Request,
-	httpCaller internal.HttpCaller) (deviceServiceResponse *http.Response, theResponseBody string, failure error) {
+	deps commandDeps) (deviceServiceResponse *http.Response, theResponseBody string, failure error) {
 
 	var method string
 	var ex Executor
@@ -140,33 +161,150 @@ func executeCommandByDevice(
 		return nil, "", errors.NewErrParsingOriginalRequest("method")
 	}
 
+	dryRun, originalRequest, err := extractDryRun(originalRequest)
+	if err != nil {
+		return nil, "", err
+	}
+	persistRequested, persistPresent, originalRequest, err := extractQueryFlag(originalRequest, PERSIST)
+	if err != nil {
+		return nil, "", err
+	}
+
+	simulated := deps.deviceSimulator.Enabled(device)
+
+	// Read commands may be answered from the cache, unless the client asks for a fresh reading, or for the reading to
+	// be persisted.
+	var cacheKey string
+	if originalRequest.Method == http.MethodGet {
+		cacheKey = cache.Key(device.Id, command.Id, originalRequest.URL.Query())
+		if originalRequest.Header.Get(CACHECONTROLHEADER) != NOCACHE && !dryRun && !simulated && !persistRequested {
+			if cached, ok := deps.commandCache.Get(cacheKey); ok {
+				deps.lc.Debug(fmt.Sprintf("Answering command %s of device %s from the cache", command.Name, device.Name))
+				return &http.Response{
+					StatusCode: cached.StatusCode,
+					Header:     cached.Header.Clone(),
+					Body:       ioutil.NopCloser(strings.NewReader(cached.Body)),
+				}, cached.Body, nil
+			}
+		}
+	}
+
 	switch originalRequest.Method {
 	case http.MethodPut:
-		ex, err = NewPutCommand(device, command, body, ctx, httpCaller, lc, originalRequest)
+		if err = validateSetParameters(device, command, body); err != nil {
+			return nil, "", err
+		}
+		ex, err = NewPutCommand(device, command, body, ctx, deps.httpCaller, deps.lc, originalRequest)
 	case http.MethodGet:
-		ex, err = NewGetCommand(device, command, ctx, httpCaller, lc, originalRequest)
+		ex, err = NewGetCommand(device, command, ctx, deps.httpCaller, deps.lc, originalRequest)
 	default:
-		lc.Error(fmt.Sprintf("unknown method: %s", method))
+		deps.lc.Error(fmt.Sprintf("unknown method: %s", method))
 	}
 
 	if err != nil {
 		return nil, "", err
 	}
 
+	if dryRun {
+		// the device, the command and the parameters are resolved and validated, but nothing is sent
+		return newDryRunResponse(device, command, ex.ProxiedRequest(), body)
+	}
+
+	if simulated {
+		// the device service, which may not be deployed yet, is neither throttled nor tracked by the circuit breaker
+		started := time.Now()
+		deviceServiceResponse, responseBody, err := executeSimulatedCommand(device, command, originalRequest.Method, body, deps.deviceSimulator)
+		recordCommandHistory(ctx, originalRequest, device, command, body, started, deviceServiceResponse, nil, err, deps.lc, deps.dbClient)
+		return deviceServiceResponse, responseBody, err
+	}
+
+	release, err := deps.commandThrottle.Acquire(ctx, device.Name, originalRequest.Method == http.MethodPut)
+	if err != nil {
+		return nil, "", err
+	}
+	defer release()
+
+	reachable, err := deps.commandBreaker.Allow(device.Service.Name)
+	if err != nil {
+		return nil, "", err
+	}
+
+	started := time.Now()
+	spanCtx, span := tracing.StartSpanOfKind(ctx, tracing.KindClient, "device service "+device.Service.Name)
+	// the device service continues the trace of the command, when it's traced
+	tracing.Inject(spanCtx, ex.ProxiedRequest().Header)
 	deviceServiceResponse, err = ex.Execute()
+	if deviceServiceResponse != nil {
+		span.SetTag("http.status_code", strconv.Itoa(deviceServiceResponse.StatusCode))
+	}
+	span.End(err)
+	reachable(serviceReachable(deviceServiceResponse, err))
 	if err != nil {
+		recordCommandHistory(ctx, originalRequest, device, command, body, started, nil, nil, err, deps.lc, deps.dbClient)
 		return nil, "", err
 	}
 
+	if originalRequest.Method == http.MethodGet && deps.commandStreamer.Streamable(deviceServiceResponse) {
+		// the body is left unread for the caller to relay it as it is received, so it is neither buffered nor cached
+		if err = deps.commandStreamer.Check(deviceServiceResponse); err != nil {
+			deviceServiceResponse.Body.Close()
+			recordCommandHistory(ctx, originalRequest, device, command, body, started, deviceServiceResponse, nil, err, deps.lc, deps.dbClient)
+			return nil, "", err
+		}
+		recordCommandHistory(ctx, originalRequest, device, command, body, started, deviceServiceResponse, nil, nil, deps.lc, deps.dbClient)
+		return deviceServiceResponse, "", nil
+	}
+
 	responseBody := new(bytes.Buffer)
 	_, readErr := responseBody.ReadFrom(deviceServiceResponse.Body)
+	var resources []models.ResourceStatus
+	if readErr == nil && originalRequest.Method == http.MethodPut {
+		resources = parseResourceStatuses(responseBody.Bytes())
+	}
+	recordCommandHistory(ctx, originalRequest, device, command, body, started, deviceServiceResponse, resources, readErr, deps.lc, deps.dbClient)
 	if readErr != nil {
 		return nil, "", readErr
 	}
 
+	if len(resources) > 0 {
+		// some of the resources may have been written even though the command failed as a whole
+		deps.commandCache.InvalidateDevice(device.Id)
+		return newSetCommandResponse(deviceServiceResponse, resources)
+	}
+
+	if deviceServiceResponse.StatusCode >= http.StatusOK && deviceServiceResponse.StatusCode < http.StatusMultipleChoices {
+		switch originalRequest.Method {
+		case http.MethodGet:
+			deps.commandCache.Put(cacheKey, device.Id, cache.Response{
+				StatusCode: deviceServiceResponse.StatusCode,
+				Header:     deviceServiceResponse.Header.Clone(),
+				Body:       responseBody.String(),
+			})
+			// the readings answered from the cache or by the simulator are not new, nor real, so they aren't persisted
+			if deps.eventPersister.Persisted(device.Name, command.Name, persistRequested, persistPresent) {
+				persistCommandEvent(ctx, device, command, deviceServiceResponse, responseBody.String(), deps.eventPersister, deps.lc)
+			}
+		case http.MethodPut:
+			// the readings of the device cached so far may no longer be accurate
+			deps.commandCache.InvalidateDevice(device.Id)
+		}
+	}
+
 	return deviceServiceResponse, responseBody.String(), nil
 }
 
+// serviceReachable reports whether the device service could be reached to execute a command.
+func serviceReachable(resp *http.Response, err error) bool {
+	if err != nil {
+		return false
+	}
+	switch resp.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return false
+	}
+	return true
+}
+
 func getAllCommands(
 	ctx context.Context,
 	dbClient interfaces.DBClient,
@@ -180,7 +318,7 @@ func getAllCommands(
 
 	var responses []contract.CommandResponse
 	for _, d := range devices {
-		cr, err := newCommandResponse(d, dbClient, configuration)
+		cr, err := newCommandResponse(ctx, d, dbClient, configuration)
 		if err != nil {
 			return nil, err
 		}
@@ -203,7 +341,7 @@ func getCommandsByDeviceID(
 		return contract.CommandResponse{}, err
 	}
 
-	return newCommandResponse(d, dbClient, configuration)
+	return newCommandResponse(ctx, d, dbClient, configuration)
 }
 
 func getCommandsByDeviceName(
@@ -218,10 +356,11 @@ func getCommandsByDeviceName(
 		return contract.CommandResponse{}, err
 	}
 
-	return newCommandResponse(d, dbClient, configuration)
+	return newCommandResponse(ctx, d, dbClient, configuration)
 }
 
 func newCommandResponse(
+	ctx context.Context,
 	d contract.Device,
 	dbClient interfaces.DBClient,
 	configuration *config.ConfigurationStruct) (contract.CommandResponse, error) {
@@ -231,5 +370,6 @@ func newCommandResponse(
 		return contract.CommandResponse{}, err
 	}
 
-	return contract.CommandResponseFromDevice(d, commands, configuration.Service.Url()), nil
+	// the URLs of the commands are reachable with the scheme the client used through the trusted proxies
+	return contract.CommandResponseFromDevice(d, commands, trustedproxy.URL(ctx, configuration.Service.Url())), nil
 }
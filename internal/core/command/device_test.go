@@ -15,14 +15,26 @@
 package command
 
 import (
+	"context"
+	"encoding/json"
 	goErrors "errors"
+	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/command/breaker"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/cache"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/errors"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/interfaces"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/persist"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/simulator"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/stream"
 	mdMocks "github.com/edgexfoundry/edgex-go/internal/mocks"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
 
@@ -32,6 +44,7 @@ import (
 	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
 
 	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -148,10 +161,12 @@ func TestExecuteGETCommandByDeviceIDAndCommandID(t *testing.T) {
 			_, _, actualErr := executeCommandByDeviceID(
 				tt.request,
 				"",
-				logger.NewMockClient(),
-				newMockDBClient(),
-				newMockDeviceClient(),
-				httpCaller)
+				commandDeps{
+					lc:           logger.NewMockClient(),
+					dbClient:     newMockDBClient(),
+					deviceClient: newMockDeviceClient(),
+					httpCaller:   httpCaller,
+				})
 			if actualErr == nil {
 				t.Fatal("expected error")
 			}
@@ -160,6 +175,261 @@ func TestExecuteGETCommandByDeviceIDAndCommandID(t *testing.T) {
 	}
 }
 
+func TestExecuteCommandByDeviceUsesCache(t *testing.T) {
+	commandCache := cache.NewCache(time.Minute, 0)
+	httpCaller := &mdMocks.HttpCaller{}
+	httpCaller.On("Do", mock.Anything).Return(func(req *http.Request) *http.Response {
+		return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader("reading"))}
+	}, nil)
+	dbClient := newMockDBClient()
+
+	execute := func(method string, cacheControl string) string {
+		req := httptest.NewRequest(method, cmdURI+"?a=1", nil)
+		if cacheControl != "" {
+			req.Header.Set(CACHECONTROLHEADER, cacheControl)
+		}
+		_, body, err := executeCommandByDevice(
+			context.Background(),
+			unlockedDevice,
+			exampleCommand,
+			"",
+			req,
+			commandDeps{
+				lc:           logger.NewMockClient(),
+				dbClient:     dbClient,
+				commandCache: commandCache,
+				httpCaller:   httpCaller,
+			})
+		require.NoError(t, err)
+		return body
+	}
+
+	assert.Equal(t, "reading", execute(http.MethodGet, ""))
+	assert.Equal(t, "reading", execute(http.MethodGet, ""))
+	httpCaller.AssertNumberOfCalls(t, "Do", 1)
+
+	execute(http.MethodGet, NOCACHE)
+	httpCaller.AssertNumberOfCalls(t, "Do", 2)
+
+	// a set command invalidates the cached readings of the device
+	execute(http.MethodPut, "")
+	execute(http.MethodGet, "")
+	httpCaller.AssertNumberOfCalls(t, "Do", 4)
+}
+
+func TestExecuteCommandByDeviceFailsFastWhenServiceUnreachable(t *testing.T) {
+	commandBreaker := breaker.NewBreaker(2, time.Minute)
+	httpCaller := &mdMocks.HttpCaller{}
+	httpCaller.On("Do", mock.Anything).Return(nil, &url.Error{Op: "Get", URL: cmdURI, Err: goErrors.New("connection refused")})
+	dbClient := newMockDBClient()
+
+	execute := func() error {
+		_, _, err := executeCommandByDevice(
+			context.Background(),
+			unlockedDevice,
+			exampleCommand,
+			"",
+			httptest.NewRequest(http.MethodGet, cmdURI, nil),
+			commandDeps{
+				lc:             logger.NewMockClient(),
+				dbClient:       dbClient,
+				commandBreaker: commandBreaker,
+				httpCaller:     httpCaller,
+			})
+		return err
+	}
+
+	for i := 0; i < 2; i++ {
+		assert.IsType(t, &url.Error{}, execute())
+	}
+	err := execute()
+	assert.IsType(t, errors.ErrServiceUnavailable{}, err)
+	assert.Equal(t, http.StatusServiceUnavailable, commandErrorStatus(err))
+	httpCaller.AssertNumberOfCalls(t, "Do", 2)
+}
+
+func TestExecuteCommandByDeviceDryRun(t *testing.T) {
+	httpCaller := &mdMocks.HttpCaller{}
+	dbClient := newMockDBClient()
+
+	execute := func(query string) (*http.Response, string, error) {
+		return executeCommandByDevice(
+			context.Background(),
+			unlockedDevice,
+			exampleCommand,
+			"",
+			httptest.NewRequest(http.MethodGet, cmdURI+"?"+query, nil),
+			commandDeps{
+				lc:         logger.NewMockClient(),
+				dbClient:   dbClient,
+				httpCaller: httpCaller,
+			})
+	}
+
+	resp, body, err := execute("a=1&" + DRYRUN + "=true")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	var description dryRunResponse
+	require.NoError(t, json.Unmarshal([]byte(body), &description))
+	assert.Equal(t, unlockedDevice.Name, description.Device)
+	assert.Equal(t, exampleCommand.Name, description.Command)
+	assert.Equal(t, http.MethodGet, description.Method)
+	assert.True(t, strings.HasSuffix(description.Url, "?a=1"), "the dry run parameter should not be forwarded: %s", description.Url)
+	httpCaller.AssertNotCalled(t, "Do", mock.Anything)
+
+	_, _, err = execute(DRYRUN + "=maybe")
+	assert.IsType(t, errors.ErrBadRequest{}, err)
+}
+
+func TestExecuteCommandByDeviceSimulated(t *testing.T) {
+	httpCaller := &mdMocks.HttpCaller{}
+	dbClient := newMockDBClient()
+	deviceSimulator := simulator.NewSimulator(nil)
+	device := unlockedDevice
+	device.Name = "thermostat"
+	device.Labels = []string{simulator.SimulatedLabel}
+	device.Profile = models.DeviceProfile{DeviceResources: []models.DeviceResource{{
+		Name: exampleCommand.Name,
+		Properties: models.ProfileProperty{
+			Value: models.PropertyValue{Type: "Int16", ReadWrite: "RW", Minimum: "10", Maximum: "20"},
+		},
+	}}}
+
+	execute := func(method string, body string) (*http.Response, string) {
+		resp, responseBody, err := executeCommandByDevice(
+			context.Background(),
+			device,
+			exampleCommand,
+			body,
+			httptest.NewRequest(method, cmdURI, nil),
+			commandDeps{
+				lc:              logger.NewMockClient(),
+				dbClient:        dbClient,
+				deviceSimulator: deviceSimulator,
+				httpCaller:      httpCaller,
+			})
+		require.NoError(t, err)
+		assert.Equal(t, "true", resp.Header.Get(SIMULATEDHEADER))
+		return resp, responseBody
+	}
+
+	resp, body := execute(http.MethodGet, "")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	var event models.Event
+	require.NoError(t, json.Unmarshal([]byte(body), &event))
+	require.Len(t, event.Readings, 1)
+	assert.Equal(t, exampleCommand.Name, event.Readings[0].Name)
+	value, err := strconv.Atoi(event.Readings[0].Value)
+	require.NoError(t, err)
+	assert.True(t, value >= 10 && value <= 20, "value %d out of the range of the profile", value)
+
+	// the value set is returned by the following read commands
+	resp, _ = execute(http.MethodPut, `{"`+exampleCommand.Name+`":"15"}`)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	_, body = execute(http.MethodGet, "")
+	require.NoError(t, json.Unmarshal([]byte(body), &event))
+	assert.Equal(t, "15", event.Readings[0].Value)
+	httpCaller.AssertNotCalled(t, "Do", mock.Anything)
+}
+
+func TestExecuteCommandByDeviceLeavesBinaryResponsesToStream(t *testing.T) {
+	commandCache := cache.NewCache(time.Minute, 0)
+	dbClient := newMockDBClient()
+
+	execute := func(contentLength int64) (*http.Response, string, error) {
+		httpCaller := &mdMocks.HttpCaller{}
+		httpCaller.On("Do", mock.Anything).Return(func(req *http.Request) *http.Response {
+			return &http.Response{
+				StatusCode:    http.StatusOK,
+				Header:        http.Header{"Content-Type": []string{"image/jpeg"}},
+				ContentLength: contentLength,
+				Body:          ioutil.NopCloser(strings.NewReader("snapshot")),
+			}
+		}, nil)
+		return executeCommandByDevice(
+			context.Background(),
+			unlockedDevice,
+			exampleCommand,
+			"",
+			httptest.NewRequest(http.MethodGet, cmdURI, nil),
+			commandDeps{
+				lc:              logger.NewMockClient(),
+				dbClient:        dbClient,
+				commandCache:    commandCache,
+				commandStreamer: stream.NewStreamer(1024, time.Minute),
+				httpCaller:      httpCaller,
+			})
+	}
+
+	resp, body, err := execute(-1)
+	require.NoError(t, err)
+	assert.Empty(t, body)
+	unread, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "snapshot", string(unread))
+	_, cached := commandCache.Get(cache.Key(unlockedDevice.Id, exampleCommand.Id, url.Values{}))
+	assert.False(t, cached, "binary responses must not be cached")
+
+	_, _, err = execute(4096)
+	assert.IsType(t, errors.ErrResponseTooLarge{}, err)
+}
+
+type fakeEventClient struct {
+	events []contract.Event
+}
+
+func (c *fakeEventClient) Add(_ context.Context, event *contract.Event) (string, error) {
+	c.events = append(c.events, *event)
+	return "event-id", nil
+}
+
+func TestExecuteCommandByDevicePersistsEvents(t *testing.T) {
+	httpCaller := &mdMocks.HttpCaller{}
+	httpCaller.On("Do", mock.Anything).Return(func(req *http.Request) *http.Response {
+		assert.NotContains(t, req.URL.RawQuery, PERSIST, "the persist parameter should not be forwarded")
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body: ioutil.NopCloser(strings.NewReader(
+				`{"device":"thermostat","readings":[{"name":"Temperature","value":"21"}]}`)),
+		}
+	}, nil)
+	dbClient := newMockDBClient()
+	device := unlockedDevice
+	device.Name = "thermostat"
+
+	execute := func(eventPersister *persist.Persister, method string, query string) {
+		_, _, err := executeCommandByDevice(
+			context.Background(),
+			device,
+			exampleCommand,
+			"",
+			httptest.NewRequest(method, cmdURI+query, nil),
+			commandDeps{
+				lc:             logger.NewMockClient(),
+				dbClient:       dbClient,
+				eventPersister: eventPersister,
+				httpCaller:     httpCaller,
+			})
+		require.NoError(t, err)
+	}
+
+	client := &fakeEventClient{}
+	execute(persist.NewPersister(client, nil), http.MethodGet, "")
+	assert.Empty(t, client.events, "events should only be persisted on request")
+	execute(persist.NewPersister(client, nil), http.MethodGet, "?"+PERSIST+"=true")
+	execute(persist.NewPersister(client, nil), http.MethodPut, "?"+PERSIST+"=true")
+	execute(persist.NewPersister(client, []string{exampleCommand.Name}), http.MethodGet, "")
+	execute(persist.NewPersister(client, []string{exampleCommand.Name}), http.MethodGet, "?"+PERSIST+"=false")
+	execute(nil, http.MethodGet, "?"+PERSIST+"=true")
+
+	require.Len(t, client.events, 2)
+	for _, event := range client.events {
+		assert.Equal(t, device.Name, event.Device)
+		assert.Equal(t, "21", event.Readings[0].Value)
+	}
+}
+
 func newMockDeviceClient() *mdMocks.DeviceClient {
 	client := mdMocks.DeviceClient{}
 	client.On("Device", mock.Anything, DeviceIDWithAssociatedInvalidObjectID).Return(contract.Device{}, types.NewErrServiceClient(400, []byte("Invalid object ID")))
@@ -182,5 +452,6 @@ func newMockDBClient() interfaces.DBClient {
 	dbMock.On("GetCommandsByDeviceId", TestDeviceID).Return([]models.Command{{Id: TestCommandID}}, nil)
 	dbMock.On("GetCommandsByDeviceId", ExistingDeviceID).Return([]models.Command{{Id: ExistingDeviceID}}, nil)
 	dbMock.On("GetCommandsByDeviceId", DeviceIDd200c200).Return([]models.Command{{Id: ExistingDeviceID}}, nil)
+	dbMock.On("AddCommandHistory", mock.Anything).Return("", nil)
 	return dbMock
 }
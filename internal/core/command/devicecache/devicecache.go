@@ -0,0 +1,131 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package devicecache keeps the devices read from core-metadata, along with their profiles and device services, for
+// a while, so that the commands of a device don't each cost a round trip to core-metadata.
+package devicecache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/metadata"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+type entry struct {
+	device contract.Device
+	expiry time.Time
+}
+
+// Client is a metadata.DeviceClient answering the devices read by id or name from its cache until their TTL elapses.
+// A device updated in core-metadata, e.g. locked, is therefore seen as it was cached for up to the TTL. The other
+// requests are passed to the wrapped client.
+type Client struct {
+	metadata.DeviceClient
+	ttl time.Duration
+
+	mutex sync.Mutex
+	byID  map[string]entry
+	ids   map[string]string
+}
+
+// NewClient creates a Client caching the devices read with client for the ttl.
+func NewClient(client metadata.DeviceClient, ttl time.Duration) *Client {
+	return &Client{
+		DeviceClient: client,
+		ttl:          ttl,
+		byID:         make(map[string]entry),
+		ids:          make(map[string]string),
+	}
+}
+
+// Device returns the device of the id, from the cache unless it expired.
+func (c *Client) Device(ctx context.Context, id string) (contract.Device, error) {
+	if d, ok := c.get(id); ok {
+		return d, nil
+	}
+
+	d, err := c.DeviceClient.Device(ctx, id)
+	if err != nil {
+		return d, err
+	}
+	c.put(time.Now(), d)
+	return d, nil
+}
+
+// DeviceForName returns the device of the name, from the cache unless it expired.
+func (c *Client) DeviceForName(ctx context.Context, name string) (contract.Device, error) {
+	c.mutex.Lock()
+	id, ok := c.ids[name]
+	c.mutex.Unlock()
+	if ok {
+		if d, ok := c.get(id); ok && d.Name == name {
+			return d, nil
+		}
+	}
+
+	d, err := c.DeviceClient.DeviceForName(ctx, name)
+	if err != nil {
+		return d, err
+	}
+	c.put(time.Now(), d)
+	return d, nil
+}
+
+// Devices returns all the devices from core-metadata, refreshing the cache with them.
+func (c *Client) Devices(ctx context.Context) ([]contract.Device, error) {
+	devices, err := c.DeviceClient.Devices(ctx)
+	if err != nil {
+		return devices, err
+	}
+
+	now := time.Now()
+	for _, d := range devices {
+		c.put(now, d)
+	}
+	return devices, nil
+}
+
+// Preload caches all the devices of core-metadata, and returns how many were cached.
+func (c *Client) Preload(ctx context.Context) (int, error) {
+	devices, err := c.Devices(ctx)
+	return len(devices), err
+}
+
+// get returns the cached device of the id, if it didn't expire.
+func (c *Client) get(id string) (contract.Device, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	e, ok := c.byID[id]
+	if !ok {
+		return contract.Device{}, false
+	}
+	if time.Now().After(e.expiry) {
+		delete(c.byID, id)
+		if c.ids[e.device.Name] == id {
+			delete(c.ids, e.device.Name)
+		}
+		return contract.Device{}, false
+	}
+	return e.device, true
+}
+
+// put caches the device read at now.
+func (c *Client) put(now time.Time, d contract.Device) {
+	if c.ttl <= 0 || d.Id == "" {
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if previous, ok := c.byID[d.Id]; ok && previous.device.Name != d.Name && c.ids[previous.device.Name] == d.Id {
+		// the device was renamed
+		delete(c.ids, previous.device.Name)
+	}
+	c.byID[d.Id] = entry{device: d, expiry: now.Add(c.ttl)}
+	c.ids[d.Name] = d.Id
+}
@@ -0,0 +1,98 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package devicecache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mdMocks "github.com/edgexfoundry/edgex-go/internal/mocks"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testDevice = contract.Device{
+	Id:      "device-id",
+	Name:    "thermostat",
+	Profile: contract.DeviceProfile{Name: "thermostat-profile"},
+	Service: contract.DeviceService{Name: "device-virtual"},
+}
+
+func TestDevice(t *testing.T) {
+	deviceClient := &mdMocks.DeviceClient{}
+	deviceClient.On("Device", mock.Anything, testDevice.Id).Return(testDevice, nil).Once()
+	deviceClient.On("Device", mock.Anything, "unknown").Return(contract.Device{}, errors.New("device not found"))
+	client := NewClient(deviceClient, time.Minute)
+
+	for i := 0; i < 2; i++ {
+		d, err := client.Device(context.Background(), testDevice.Id)
+		require.NoError(t, err)
+		assert.Equal(t, testDevice, d)
+	}
+	d, err := client.DeviceForName(context.Background(), testDevice.Name)
+	require.NoError(t, err)
+	assert.Equal(t, testDevice, d, "a device read by id should be cached by name as well")
+
+	for i := 0; i < 2; i++ {
+		_, err = client.Device(context.Background(), "unknown")
+		assert.Error(t, err)
+	}
+	deviceClient.AssertNumberOfCalls(t, "Device", 3)
+}
+
+func TestDeviceForNameExpiry(t *testing.T) {
+	renamed := testDevice
+	renamed.Name = "thermostat-2"
+	deviceClient := &mdMocks.DeviceClient{}
+	deviceClient.On("DeviceForName", mock.Anything, testDevice.Name).Return(testDevice, nil)
+	deviceClient.On("DeviceForName", mock.Anything, renamed.Name).Return(renamed, nil)
+	client := NewClient(deviceClient, 20*time.Millisecond)
+
+	_, err := client.DeviceForName(context.Background(), testDevice.Name)
+	require.NoError(t, err)
+	_, err = client.DeviceForName(context.Background(), testDevice.Name)
+	require.NoError(t, err)
+	deviceClient.AssertNumberOfCalls(t, "DeviceForName", 1)
+
+	time.Sleep(30 * time.Millisecond)
+	_, err = client.DeviceForName(context.Background(), testDevice.Name)
+	require.NoError(t, err)
+	deviceClient.AssertNumberOfCalls(t, "DeviceForName", 2)
+
+	d, err := client.DeviceForName(context.Background(), renamed.Name)
+	require.NoError(t, err)
+	assert.Equal(t, renamed, d)
+	_, err = client.DeviceForName(context.Background(), testDevice.Name)
+	require.NoError(t, err)
+	// the former name of the renamed device isn't cached anymore
+	deviceClient.AssertNumberOfCalls(t, "DeviceForName", 4)
+}
+
+func TestPreload(t *testing.T) {
+	other := contract.Device{Id: "other-id", Name: "camera"}
+	deviceClient := &mdMocks.DeviceClient{}
+	deviceClient.On("Devices", mock.Anything).Return([]contract.Device{testDevice, other}, nil)
+	client := NewClient(deviceClient, time.Minute)
+
+	loaded, err := client.Preload(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, loaded)
+
+	d, err := client.Device(context.Background(), other.Id)
+	require.NoError(t, err)
+	assert.Equal(t, other, d)
+	d, err = client.DeviceForName(context.Background(), testDevice.Name)
+	require.NoError(t, err)
+	assert.Equal(t, testDevice, d)
+	deviceClient.AssertNotCalled(t, "Device", mock.Anything, mock.Anything)
+	deviceClient.AssertNotCalled(t, "DeviceForName", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,88 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package command
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/errors"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// dryRunResponse describes the request a command would send to the device service.
+type dryRunResponse struct {
+	Device        string            `json:"device"`
+	Command       string            `json:"command"`
+	DeviceService string            `json:"deviceService"`
+	Method        string            `json:"method"`
+	Url           string            `json:"url"`
+	Headers       map[string]string `json:"headers,omitempty"`
+	Body          string            `json:"body,omitempty"`
+}
+
+// extractDryRun reports whether the request asks for a dry run, and returns the request without the dry run query
+// parameter, which is not forwarded to the device service.
+func extractDryRun(r *http.Request) (bool, *http.Request, error) {
+	dryRun, _, r, err := extractQueryFlag(r, DRYRUN)
+	return dryRun, r, err
+}
+
+// extractQueryFlag returns the boolean value of the query parameter name and whether the request has it, along with
+// the request without the query parameter, which is meant for the command service rather than the device service.
+func extractQueryFlag(r *http.Request, name string) (bool, bool, *http.Request, error) {
+	query := r.URL.Query()
+	values, ok := query[name]
+	if !ok {
+		return false, false, r, nil
+	}
+	value, err := strconv.ParseBool(values[0])
+	if err != nil {
+		return false, true, r, errors.NewErrParsingOriginalRequest(name)
+	}
+
+	query.Del(name)
+	stripped := r.Clone(r.Context())
+	stripped.URL.RawQuery = query.Encode()
+	return value, true, stripped, nil
+}
+
+// newDryRunResponse answers a dry run with the description of the request the command would send to the device
+// service, as if the device service had returned it.
+func newDryRunResponse(
+	device contract.Device,
+	command contract.Command,
+	deviceServiceRequest *http.Request,
+	body string) (*http.Response, string, error) {
+
+	headers := make(map[string]string, len(deviceServiceRequest.Header))
+	for name := range deviceServiceRequest.Header {
+		headers[name] = deviceServiceRequest.Header.Get(name)
+	}
+	description, err := json.Marshal(dryRunResponse{
+		Device:        device.Name,
+		Command:       command.Name,
+		DeviceService: device.Service.Name,
+		Method:        deviceServiceRequest.Method,
+		Url:           deviceServiceRequest.URL.String(),
+		Headers:       headers,
+		Body:          body,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{clients.ContentType: []string{clients.ContentTypeJSON}},
+		Body:       ioutil.NopCloser(strings.NewReader(string(description))),
+	}, string(description), nil
+}
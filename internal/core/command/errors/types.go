@@ -1,6 +1,11 @@
 package errors
 
-import "fmt"
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
 
 type ErrDeviceLocked struct {
 	device string
@@ -59,3 +64,115 @@ func (e ErrBadRequest) Error() string {
 func NewErrParsingOriginalRequest(invalid string) error {
 	return ErrBadRequest{value: invalid}
 }
+
+// ErrLimitExceeded is a struct that serves as the value receiver
+// for Error as defined for NewErrLimitExceeded
+type ErrLimitExceeded struct {
+	limit int
+}
+
+// Error returns a meaningful string message describing error details.
+func (e ErrLimitExceeded) Error() string {
+	return fmt.Sprintf("result count exceeds configured max %d", e.limit)
+}
+
+// NewErrLimitExceeded returns the relevant, properly-
+// constructed error type.
+func NewErrLimitExceeded(limit int) error {
+	return ErrLimitExceeded{limit: limit}
+}
+
+// ErrInvalidCommandParameters is a struct that serves as the value receiver
+// for Error as defined for NewErrInvalidCommandParameters
+type ErrInvalidCommandParameters struct {
+	command string
+	fields  map[string]string
+}
+
+// Error returns a meaningful string message describing error details.
+func (e ErrInvalidCommandParameters) Error() string {
+	names := make([]string, 0, len(e.fields))
+	for name := range e.fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	details := make([]string, len(names))
+	for i, name := range names {
+		details[i] = fmt.Sprintf("%s: %s", name, e.fields[name])
+	}
+	return fmt.Sprintf("invalid parameters for command '%s': %s", e.command, strings.Join(details, "; "))
+}
+
+// Fields returns the reason why each invalid parameter was rejected, keyed by parameter name.
+func (e ErrInvalidCommandParameters) Fields() map[string]string {
+	return e.fields
+}
+
+// NewErrInvalidCommandParameters returns the relevant, properly-
+// constructed error type.
+func NewErrInvalidCommandParameters(command string, fields map[string]string) error {
+	return ErrInvalidCommandParameters{command: command, fields: fields}
+}
+
+// ErrDeviceBusy is a struct that serves as the value receiver
+// for Error as defined for NewErrDeviceBusy
+type ErrDeviceBusy struct {
+	device string
+	reason string
+}
+
+// Error returns a meaningful string message describing error details.
+func (e ErrDeviceBusy) Error() string {
+	return fmt.Sprintf("device %s is busy: %s", e.device, e.reason)
+}
+
+// NewErrDeviceBusy returns the relevant, properly-
+// constructed error type.
+func NewErrDeviceBusy(device string, reason string) error {
+	return ErrDeviceBusy{device: device, reason: reason}
+}
+
+// ErrServiceUnavailable is a struct that serves as the value receiver
+// for Error as defined for NewErrServiceUnavailable
+type ErrServiceUnavailable struct {
+	service    string
+	retryAfter time.Duration
+}
+
+// Error returns a meaningful string message describing error details.
+func (e ErrServiceUnavailable) Error() string {
+	return fmt.Sprintf("device service %s is unavailable, retry after %s", e.service, e.retryAfter)
+}
+
+// RetryAfter returns how long the caller should wait before issuing the command again.
+func (e ErrServiceUnavailable) RetryAfter() time.Duration {
+	return e.retryAfter
+}
+
+// NewErrServiceUnavailable returns the relevant, properly-
+// constructed error type.
+func NewErrServiceUnavailable(service string, retryAfter time.Duration) error {
+	return ErrServiceUnavailable{service: service, retryAfter: retryAfter}
+}
+
+// ErrResponseTooLarge is a struct that serves as the value receiver
+// for Error as defined for NewErrResponseTooLarge
+type ErrResponseTooLarge struct {
+	size    int64
+	maxSize int64
+}
+
+// Error returns a meaningful string message describing error details.
+func (e ErrResponseTooLarge) Error() string {
+	if e.size < 0 {
+		return fmt.Sprintf("response of the device service exceeds the limit of %d bytes", e.maxSize)
+	}
+	return fmt.Sprintf("response of the device service of %d bytes exceeds the limit of %d bytes", e.size, e.maxSize)
+}
+
+// NewErrResponseTooLarge returns the relevant, properly-
+// constructed error type. A negative size means the size of the response is unknown.
+func NewErrResponseTooLarge(size int64, maxSize int64) error {
+	return ErrResponseTooLarge{size: size, maxSize: maxSize}
+}
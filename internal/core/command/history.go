@@ -0,0 +1,122 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/authz"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/trustedproxy"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/types"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// defaultHistoryPruneInterval is how often the history is pruned when no PruneInterval is configured.
+const defaultHistoryPruneInterval = time.Minute
+
+// recordCommandHistory persists the execution of command on device, along with the user authorized to execute it.
+// Failing to do so is logged but does not fail the command, which has already been executed.
+func recordCommandHistory(
+	ctx context.Context,
+	originalRequest *http.Request,
+	device contract.Device,
+	command contract.Command,
+	body string,
+	started time.Time,
+	deviceServiceResponse *http.Response,
+	resources []models.ResourceStatus,
+	failure error,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	h := models.CommandHistory{
+		User:          authz.UserFrom(originalRequest.Context()),
+		Origin:        trustedproxy.ClientIP(originalRequest),
+		DeviceId:      device.Id,
+		DeviceName:    device.Name,
+		Command:       command.Name,
+		Method:        originalRequest.Method,
+		Parameters:    body,
+		Resources:     resources,
+		Duration:      time.Since(started).Milliseconds(),
+		CorrelationId: correlation.FromContext(ctx),
+		Created:       db.MakeTimestamp(),
+	}
+	if deviceServiceResponse != nil {
+		h.StatusCode = deviceServiceResponse.StatusCode
+	}
+	notApplied := 0
+	for _, r := range resources {
+		if !r.Applied() {
+			notApplied++
+		}
+	}
+	if notApplied > 0 {
+		h.Error = fmt.Sprintf("%d of %d resources not applied", notApplied, len(resources))
+	}
+	if failure != nil {
+		h.Error = failure.Error()
+		if serviceErr, ok := failure.(types.ErrServiceClient); ok {
+			h.StatusCode = serviceErr.StatusCode
+		}
+	}
+
+	if _, err := dbClient.AddCommandHistory(h); err != nil {
+		lc.Error(
+			fmt.Sprintf("failed to record history of command %s of device %s: %s", command.Name, device.Name, err.Error()),
+			clients.CorrelationHeader,
+			h.CorrelationId)
+	}
+}
+
+// pruneCommandHistory deletes the commands executed more than maxAge ago, when positive, and the oldest ones beyond
+// maxEntries, when positive, from the history every interval until ctx is done.
+func pruneCommandHistory(
+	ctx context.Context,
+	maxAge time.Duration,
+	maxEntries int,
+	interval time.Duration,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleteExpiredCommandHistory(maxAge, maxEntries, lc, dbClient)
+		}
+	}
+}
+
+// deleteExpiredCommandHistory deletes the commands beyond maxAge or maxEntries from the history. A failure is only
+// logged, the commands being deleted on the next attempt.
+func deleteExpiredCommandHistory(maxAge time.Duration, maxEntries int, lc logger.LoggingClient, dbClient interfaces.DBClient) {
+	var expired int64
+	if maxAge > 0 {
+		expired = db.MakeTimestamp() - maxAge.Milliseconds()
+	}
+	deleted, err := dbClient.DeleteCommandHistoryBefore(expired, maxEntries)
+	if err != nil {
+		lc.Error(fmt.Sprintf("failed to prune the command history: %s", err.Error()))
+		return
+	}
+	if deleted > 0 {
+		lc.Debug(fmt.Sprintf("deleted %d commands from the command history", deleted))
+	}
+}
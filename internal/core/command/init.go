@@ -25,11 +25,21 @@ import (
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
 	"github.com/edgexfoundry/go-mod-bootstrap/di"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/command/breaker"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/cache"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/devicecache"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/job"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/persist"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/schedule"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/simulator"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/stream"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/throttle"
 	errorContainer "github.com/edgexfoundry/edgex-go/internal/pkg/container"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/errorconcept"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/coredata"
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/metadata"
 	"github.com/gorilla/mux"
 )
@@ -47,7 +57,7 @@ func NewBootstrap(router *mux.Router) *Bootstrap {
 }
 
 // BootstrapHandler fulfills the BootstrapHandler contract and performs initialization needed by the command service.
-func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
+func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, startupTimer startup.Timer, dic *di.Container) bool {
 	loadRestRoutes(b.router, dic)
 
 	// TODO: there is an outstanding known issue (https://github.com/edgexfoundry/edgex-go/issues/2462)
@@ -59,16 +69,105 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _
 	//		https://github.com/edgexfoundry/edgex-go/issues/2421, the correct fix is to bump up the client timeout.
 	configuration := container.ConfigurationFrom(dic.Get)
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	scheduler := schedule.NewScheduler()
+	jobs := job.NewStore(ctx, parseDuration(configuration.AsyncCommand.JobRetention, defaultJobRetention, lc))
 
 	// initialize clients required by the service
 	dic.Update(di.ServiceConstructorMap{
 		container.MetadataDeviceClientName: func(get di.Get) interface{} {
-			return metadata.NewDeviceClient(local.New(configuration.Clients["Metadata"].Url() + clients.ApiDeviceRoute))
+			deviceClient := metadata.NewDeviceClient(local.New(configuration.Clients["Metadata"].Url() + clients.ApiDeviceRoute))
+			if !configuration.DeviceCache.Enabled {
+				return deviceClient
+			}
+			return devicecache.NewClient(
+				deviceClient,
+				parseDuration(configuration.DeviceCache.TTL, defaultDeviceCacheTTL, lc))
 		},
 		errorContainer.ErrorHandlerName: func(get di.Get) interface{} {
 			return errorconcept.NewErrorHandler(lc)
 		},
+		container.JobStoreName: func(get di.Get) interface{} {
+			return jobs
+		},
+		container.SchedulerName: func(get di.Get) interface{} {
+			return scheduler
+		},
+		container.CommandCacheName: func(get di.Get) interface{} {
+			if !configuration.CommandCache.Enabled {
+				return (*cache.Cache)(nil)
+			}
+			return cache.NewCache(
+				parseDuration(configuration.CommandCache.TTL, defaultCommandCacheTTL, lc),
+				configuration.CommandCache.MaxEntries)
+		},
+		container.ThrottleName: func(get di.Get) interface{} {
+			return throttle.NewThrottle(
+				configuration.CommandThrottle.CommandsPerSecond,
+				parseDuration(configuration.CommandThrottle.MaxWait, defaultThrottleMaxWait, lc),
+				configuration.CommandThrottle.ExclusiveSet)
+		},
+		container.SimulatorName: func(get di.Get) interface{} {
+			return simulator.NewSimulator(configuration.Simulation.Devices)
+		},
+		container.StreamerName: func(get di.Get) interface{} {
+			if !configuration.Streaming.Enabled {
+				return (*stream.Streamer)(nil)
+			}
+			return stream.NewStreamer(
+				configuration.Streaming.MaxSize,
+				parseDuration(configuration.Streaming.Timeout, defaultStreamingTimeout, lc))
+		},
+		container.PersisterName: func(get di.Get) interface{} {
+			if !configuration.EventPersistence.Enabled {
+				return (*persist.Persister)(nil)
+			}
+			return persist.NewPersister(
+				coredata.NewEventClient(local.New(configuration.Clients["CoreData"].Url()+clients.ApiEventRoute)),
+				configuration.EventPersistence.Commands)
+		},
+		container.BreakerName: func(get di.Get) interface{} {
+			return breaker.NewBreaker(
+				configuration.CircuitBreaker.FailureThreshold,
+				parseDuration(configuration.CircuitBreaker.OpenDuration, defaultBreakerOpenTime, lc))
+		},
 	})
 
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		scheduler.Run(ctx)
+		lc.Info("Command scheduler stopped")
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		jobs.Run()
+	}()
+
+	maxAge := parseDuration(configuration.CommandHistory.MaxAge, 0, lc)
+	if maxAge > 0 || configuration.CommandHistory.MaxEntries > 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			pruneCommandHistory(
+				ctx,
+				maxAge,
+				configuration.CommandHistory.MaxEntries,
+				parseDuration(configuration.CommandHistory.PruneInterval, defaultHistoryPruneInterval, lc),
+				lc,
+				container.DBClientFrom(dic.Get))
+		}()
+	}
+
 	return true
 }
+
+// MessageBusHandler fulfills the BootstrapHandler contract. When enabled, it accepts the command requests over the
+// message bus; it must run after the audit and authorization handlers, whose checks apply to these requests as well.
+func (b *Bootstrap) MessageBusHandler(ctx context.Context, wg *sync.WaitGroup, startupTimer startup.Timer, dic *di.Container) bool {
+	if !container.ConfigurationFrom(dic.Get).MessageQueue.Enabled {
+		return true
+	}
+	return connectMessageBus(ctx, wg, startupTimer, dic)
+}
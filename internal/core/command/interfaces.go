@@ -19,4 +19,6 @@ import "net/http"
 // Executor interface used to execute commands
 type Executor interface {
 	Execute() (deviceServiceResponse *http.Response, failure error)
+	// ProxiedRequest returns the request sent to the device service when the command is executed
+	ProxiedRequest() *http.Request
 }
@@ -1,6 +1,8 @@
 package interfaces
 
 import (
+	command "github.com/edgexfoundry/edgex-go/internal/core/command/models"
+
 	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
 )
 
@@ -11,4 +13,9 @@ type DBClient interface {
 	GetCommandsByName(id string) ([]contract.Command, error)
 	GetCommandsByDeviceId(id string) ([]contract.Command, error)
 	GetCommandByNameAndDeviceId(cname string, did string) (contract.Command, error)
+	AddCommandHistory(h command.CommandHistory) (string, error)
+	GetCommandHistory(start int64, end int64, limit int) ([]command.CommandHistory, error)
+	GetCommandHistoryByDeviceId(id string, start int64, end int64, limit int) ([]command.CommandHistory, error)
+	GetCommandHistoryByDeviceName(name string, start int64, end int64, limit int) ([]command.CommandHistory, error)
+	DeleteCommandHistoryBefore(expired int64, max int) (int, error)
 }
@@ -4,17 +4,60 @@ package mocks
 
 import mock "github.com/stretchr/testify/mock"
 import models "github.com/edgexfoundry/go-mod-core-contracts/models"
+import commandmodels "github.com/edgexfoundry/edgex-go/internal/core/command/models"
 
 // DBClient is an autogenerated mock type for the DBClient type
 type DBClient struct {
 	mock.Mock
 }
 
+// AddCommandHistory provides a mock function with given fields: h
+func (_m *DBClient) AddCommandHistory(h commandmodels.CommandHistory) (string, error) {
+	ret := _m.Called(h)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(commandmodels.CommandHistory) string); ok {
+		r0 = rf(h)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(commandmodels.CommandHistory) error); ok {
+		r1 = rf(h)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CloseSession provides a mock function with given fields:
 func (_m *DBClient) CloseSession() {
 	_m.Called()
 }
 
+// DeleteCommandHistoryBefore provides a mock function with given fields: expired, max
+func (_m *DBClient) DeleteCommandHistoryBefore(expired int64, max int) (int, error) {
+	ret := _m.Called(expired, max)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(int64, int) int); ok {
+		r0 = rf(expired, max)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int) error); ok {
+		r1 = rf(expired, max)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAllCommands provides a mock function with given fields:
 func (_m *DBClient) GetAllCommands() ([]models.Command, error) {
 	ret := _m.Called()
@@ -80,6 +123,75 @@ func (_m *DBClient) GetCommandByNameAndDeviceId(cname string, did string) (model
 	return r0, r1
 }
 
+// GetCommandHistory provides a mock function with given fields: start, end, limit
+func (_m *DBClient) GetCommandHistory(start int64, end int64, limit int) ([]commandmodels.CommandHistory, error) {
+	ret := _m.Called(start, end, limit)
+
+	var r0 []commandmodels.CommandHistory
+	if rf, ok := ret.Get(0).(func(int64, int64, int) []commandmodels.CommandHistory); ok {
+		r0 = rf(start, end, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]commandmodels.CommandHistory)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int64, int) error); ok {
+		r1 = rf(start, end, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCommandHistoryByDeviceId provides a mock function with given fields: id, start, end, limit
+func (_m *DBClient) GetCommandHistoryByDeviceId(id string, start int64, end int64, limit int) ([]commandmodels.CommandHistory, error) {
+	ret := _m.Called(id, start, end, limit)
+
+	var r0 []commandmodels.CommandHistory
+	if rf, ok := ret.Get(0).(func(string, int64, int64, int) []commandmodels.CommandHistory); ok {
+		r0 = rf(id, start, end, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]commandmodels.CommandHistory)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int64, int64, int) error); ok {
+		r1 = rf(id, start, end, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCommandHistoryByDeviceName provides a mock function with given fields: name, start, end, limit
+func (_m *DBClient) GetCommandHistoryByDeviceName(name string, start int64, end int64, limit int) ([]commandmodels.CommandHistory, error) {
+	ret := _m.Called(name, start, end, limit)
+
+	var r0 []commandmodels.CommandHistory
+	if rf, ok := ret.Get(0).(func(string, int64, int64, int) []commandmodels.CommandHistory); ok {
+		r0 = rf(name, start, end, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]commandmodels.CommandHistory)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int64, int64, int) error); ok {
+		r1 = rf(name, start, end, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetCommandsByDeviceId provides a mock function with given fields: id
 func (_m *DBClient) GetCommandsByDeviceId(id string) ([]models.Command, error) {
 	ret := _m.Called(id)
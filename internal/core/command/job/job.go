@@ -0,0 +1,142 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package job keeps track of the commands which are executed asynchronously by core-command.
+package job
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+
+	"github.com/google/uuid"
+)
+
+const (
+	Pending   = "PENDING"
+	Running   = "RUNNING"
+	Succeeded = "SUCCEEDED"
+	Failed    = "FAILED"
+)
+
+// Job describes a command issued asynchronously and, once it completed, the response of the device service.
+type Job struct {
+	Id          string `json:"id"`
+	Status      string `json:"status"`
+	Device      string `json:"device"`
+	Command     string `json:"command"`
+	Method      string `json:"method"`
+	Attempts    int    `json:"attempts"`
+	StatusCode  int    `json:"statusCode,omitempty"`
+	ContentType string `json:"contentType,omitempty"`
+	Response    string `json:"response,omitempty"`
+	Error       string `json:"error,omitempty"`
+	Created     int64  `json:"created"`
+	Modified    int64  `json:"modified"`
+}
+
+// Done reports whether the job reached a final status.
+func (j Job) Done() bool {
+	return j.Status == Succeeded || j.Status == Failed
+}
+
+// Store is an in-memory registry of jobs. Completed jobs are discarded once they are older than the retention, as
+// jobs are added and periodically while Run is running.
+type Store struct {
+	ctx       context.Context
+	retention time.Duration
+	mutex     sync.RWMutex
+	jobs      map[string]Job
+}
+
+// NewStore creates a Store whose jobs are cancelled when ctx is done.
+func NewStore(ctx context.Context, retention time.Duration) *Store {
+	return &Store{
+		ctx:       ctx,
+		retention: retention,
+		jobs:      make(map[string]Job),
+	}
+}
+
+// Context returns the context in which the jobs of the store are executed.
+func (s *Store) Context() context.Context {
+	return s.ctx
+}
+
+// Add registers a new pending job and returns it.
+func (s *Store) Add(device string, command string, method string) Job {
+	now := db.MakeTimestamp()
+	j := Job{
+		Id:       uuid.New().String(),
+		Status:   Pending,
+		Device:   device,
+		Command:  command,
+		Method:   method,
+		Created:  now,
+		Modified: now,
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.prune(now)
+	s.jobs[j.Id] = j
+	return j
+}
+
+// Run prunes the completed jobs which outlived the retention every half retention, so that they're discarded even
+// when no job is added anymore, until the context of the store is done.
+func (s *Store) Run() {
+	if s.retention <= 0 {
+		return
+	}
+	ticker := time.NewTicker(s.retention / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			s.mutex.Lock()
+			s.prune(db.MakeTimestamp())
+			s.mutex.Unlock()
+		}
+	}
+}
+
+// Get returns the job with the specified id.
+func (s *Store) Get(id string) (Job, bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	j, ok := s.jobs[id]
+	return j, ok
+}
+
+// Update applies the update to the job with the specified id, if it is still known.
+func (s *Store) Update(id string, update func(j *Job)) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	j, ok := s.jobs[id]
+	if !ok {
+		return
+	}
+	update(&j)
+	j.Modified = db.MakeTimestamp()
+	s.jobs[id] = j
+}
+
+// prune removes the completed jobs which outlived the retention; the caller must hold the lock.
+func (s *Store) prune(now int64) {
+	if s.retention <= 0 {
+		return
+	}
+	expiry := now - s.retention.Milliseconds()
+	for id, j := range s.jobs {
+		if j.Done() && j.Modified < expiry {
+			delete(s.jobs, id)
+		}
+	}
+}
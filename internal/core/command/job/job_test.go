@@ -0,0 +1,74 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package job
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStoreLifecycle(t *testing.T) {
+	s := NewStore(context.Background(), time.Minute)
+
+	j := s.Add("device1", "command1", "GET")
+	require.NotEmpty(t, j.Id)
+	assert.Equal(t, Pending, j.Status)
+
+	s.Update(j.Id, func(j *Job) {
+		j.Status = Succeeded
+		j.StatusCode = 200
+		j.Response = "ok"
+	})
+
+	stored, ok := s.Get(j.Id)
+	require.True(t, ok)
+	assert.True(t, stored.Done())
+	assert.Equal(t, "ok", stored.Response)
+
+	_, ok = s.Get("unknown")
+	assert.False(t, ok)
+}
+
+func TestStorePrunesExpiredJobs(t *testing.T) {
+	s := NewStore(context.Background(), time.Minute)
+
+	done := s.Add("device1", "command1", "GET")
+	running := s.Add("device1", "command1", "PUT")
+	expired := time.Now().Add(-2*time.Minute).UnixNano() / int64(time.Millisecond)
+	s.jobs[done.Id] = Job{Id: done.Id, Status: Failed, Modified: expired}
+	s.jobs[running.Id] = Job{Id: running.Id, Status: Running, Modified: expired}
+
+	s.Add("device2", "command2", "GET")
+
+	_, ok := s.Get(done.Id)
+	assert.False(t, ok, "expired completed job should be pruned")
+	_, ok = s.Get(running.Id)
+	assert.True(t, ok, "running job should never be pruned")
+}
+
+func TestStoreRunPrunesExpiredJobs(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := NewStore(ctx, 20*time.Millisecond)
+	done := make(chan struct{})
+	go func() {
+		s.Run()
+		close(done)
+	}()
+
+	j := s.Add("device1", "command1", "GET")
+	s.Update(j.Id, func(j *Job) { j.Status = Succeeded })
+	assert.Eventually(t, func() bool {
+		_, ok := s.Get(j.Id)
+		return !ok
+	}, time.Second, 5*time.Millisecond, "expired completed job should be pruned without another job being added")
+
+	cancel()
+	<-done
+}
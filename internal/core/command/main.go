@@ -22,14 +22,29 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/authz"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers/database"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers/dependencies"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers/secret"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/gateway"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/httptuning"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/mtls"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/readygate"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/secretref"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/selftest"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/slo"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/sysevent"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/telemetry"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/tracing"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/trustedproxy"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/unixsocket"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/warmup"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/flags"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/handlers/httpserver"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/handlers/message"
-	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/handlers/secret"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/handlers/testing"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/interfaces"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
@@ -61,6 +76,10 @@ func Main(ctx context.Context, cancel context.CancelFunc, router *mux.Router, re
 	})
 
 	httpServer := httpserver.NewBootstrap(router, true)
+	readyGate := readygate.NewBootstrap(clients.CoreCommandServiceKey, &configuration.ReadyGate)
+	tunedServer := httptuning.NewBootstrap(router, &configuration.Service, &configuration.HttpTuning, httpServer)
+	unixSocket := unixsocket.NewBootstrap(router, &configuration.UnixSocket, tunedServer)
+	commandBootstrap := NewBootstrap(router)
 
 	bootstrap.Run(
 		ctx,
@@ -73,12 +92,27 @@ func Main(ctx context.Context, cancel context.CancelFunc, router *mux.Router, re
 		dic,
 		[]interfaces.BootstrapHandler{
 			secret.NewSecret().BootstrapHandler,
-			database.NewDatabase(httpServer, configuration).BootstrapHandler,
-			NewBootstrap(router).BootstrapHandler,
-			telemetry.BootstrapHandler,
-			httpServer.BootstrapHandler,
+			secretref.NewBootstrap(configuration).BootstrapHandler,
+			readyGate.WaitHandler,
+			mtls.NewBootstrap(&configuration.Service, &configuration.SecretStore, &configuration.MutualTLS).BootstrapHandler,
+			database.NewDatabase(unixSocket, configuration).BootstrapHandler,
+			commandBootstrap.BootstrapHandler,
+			dependencies.NewBootstrap(configuration, &configuration.DependencyCheck).BootstrapHandler,
+			trustedproxy.NewBootstrap(router, &configuration.TrustedProxy).BootstrapHandler,
+			tracing.NewBootstrap(router, clients.CoreCommandServiceKey, &configuration.Tracing).BootstrapHandler,
+			slo.NewBootstrap(router, clients.CoreCommandServiceKey, configuration, &configuration.SLO).BootstrapHandler,
+			audit.NewBootstrap(router, clients.CoreCommandServiceKey, &configuration.Audit).BootstrapHandler,
+			authz.NewBootstrap(router, clients.CoreCommandServiceKey, &configuration.RBAC, &configuration.Authorization).BootstrapHandler,
+			commandBootstrap.MessageBusHandler,
+			gateway.NewBootstrap(clients.CoreCommandServiceKey, configuration, &configuration.Gateway).BootstrapHandler,
+			telemetry.NewBootstrap(clients.CoreCommandServiceKey, &configuration.Telemetry).BootstrapHandler,
+			warmup.NewBootstrap(&configuration.Warmup, warmupLoaders).BootstrapHandler,
+			selftest.NewBootstrap(router, &configuration.SelfTest).BootstrapHandler,
+			sysevent.NewBootstrap(router, clients.CoreCommandServiceKey, &configuration.Writable, &configuration.SystemEvents).BootstrapHandler,
+			unixSocket.BootstrapHandler,
+			readyGate.AnnounceHandler,
 			message.NewBootstrap(clients.CoreCommandServiceKey, edgex.Version).BootstrapHandler,
-			testing.NewBootstrap(httpServer, readyStream).BootstrapHandler,
+			testing.NewBootstrap(unixSocket, readyStream).BootstrapHandler,
 		})
 
 	// code here!
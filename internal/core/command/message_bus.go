@@ -0,0 +1,373 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/errors"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/throttle"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/authz"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/messaging"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/mtls"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/types"
+
+	msgTypes "github.com/edgexfoundry/go-mod-messaging/pkg/types"
+
+	"github.com/gorilla/mux"
+)
+
+const (
+	defaultMessageBusWorkers = 8
+	defaultMessageBusTimeout = 30 * time.Second
+
+	// messageBusLimiterKey is the key under which the rate of all the command requests received over the message bus
+	// is limited
+	messageBusLimiterKey = "message bus"
+)
+
+var (
+	// commandByIdRoute and commandByNameRoute are the route templates of the REST API the command requests received
+	// over the message bus are authorized and audited as.
+	commandByIdRoute   = clients.ApiBase + "/" + DEVICE + "/{" + ID + "}/" + COMMAND + "/{" + COMMANDID + "}"
+	commandByNameRoute = clients.ApiBase + "/" + DEVICE + "/" + NAME + "/{" + NAME + "}/" + COMMAND + "/{" + COMMANDNAME + "}"
+)
+
+// commandRequest is the payload of a command request received over the message bus. The device and the command are
+// identified either by their ids or by their names, like in the REST API. The token is submitted to the policy agent
+// as the bearer token of a REST request would be.
+type commandRequest struct {
+	RequestId   string `json:"requestId"`
+	DeviceId    string `json:"deviceId,omitempty"`
+	CommandId   string `json:"commandId,omitempty"`
+	DeviceName  string `json:"deviceName,omitempty"`
+	CommandName string `json:"commandName,omitempty"`
+	Method      string `json:"method"`
+	QueryParams string `json:"queryParams,omitempty"`
+	Body        string `json:"body,omitempty"`
+	Token       string `json:"token,omitempty"`
+}
+
+// commandResponse is the payload of the response published for a command request.
+type commandResponse struct {
+	RequestId   string `json:"requestId"`
+	StatusCode  int    `json:"statusCode"`
+	ContentType string `json:"contentType,omitempty"`
+	Body        string `json:"body,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+// busAccess applies the rate limit, authorization and audit of the REST API to the command requests received over the
+// message bus. The limiter, guard and auditor are nil when they're disabled.
+type busAccess struct {
+	limiter *throttle.Throttle
+	guard   *authz.Guard
+	auditor *audit.Auditor
+}
+
+// connectMessageBus connects to the message bus configured for command requests and starts listening for them. The
+// requests are executed by a fixed number of workers, the subscription waiting while they're all busy.
+func connectMessageBus(ctx context.Context, wg *sync.WaitGroup, startupTimer startup.Timer, dic *di.Container) bool {
+	configuration := commandContainer.ConfigurationFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	messageQueue := configuration.MessageQueue
+
+	// For Redis Streams MessageBus, we reuse the Redis instance running for the DB, which may have a password,
+	// so we need to get and use the DB credentials for the MessageBus connection.
+	if messageQueue.Type == "redisstreams" {
+		credentials, err := bootstrapContainer.CredentialsProviderFrom(dic.Get).GetDatabaseCredentials(configuration.Databases["Primary"])
+		if err != nil {
+			lc.Error(fmt.Sprintf("Error getting DB creds for RedisStreams: %s", err.Error()))
+			return false
+		}
+
+		if messageQueue.Optional == nil {
+			messageQueue.Optional = make(map[string]string)
+		}
+		messageQueue.Optional["Password"] = credentials.Password
+	}
+
+	msgClient, err := messaging.NewMessageClient(
+		msgTypes.MessageBusConfig{
+			PublishHost: msgTypes.HostInfo{
+				Host:     messageQueue.Host,
+				Port:     messageQueue.Port,
+				Protocol: messageQueue.Protocol,
+			},
+			SubscribeHost: msgTypes.HostInfo{
+				Host:     messageQueue.Host,
+				Port:     messageQueue.Port,
+				Protocol: messageQueue.Protocol,
+			},
+			Type:     messageQueue.Type,
+			Optional: messageQueue.Optional,
+		})
+	if err != nil {
+		lc.Error(fmt.Sprintf("failed to create messaging client: %s", err.Error()))
+		return false
+	}
+
+	for startupTimer.HasNotElapsed() {
+		err = msgClient.Connect()
+		if err == nil {
+			break
+		}
+
+		lc.Warn(fmt.Sprintf("couldn't connect to message bus: %s", err.Error()))
+		startupTimer.SleepForInterval()
+	}
+
+	if err != nil {
+		lc.Error("failed to connect to message bus in allotted time")
+		return false
+	}
+
+	messages := make(chan msgTypes.MessageEnvelope)
+	messageErrors := make(chan error)
+	err = msgClient.Subscribe(
+		[]msgTypes.TopicChannel{{Topic: messageQueue.RequestTopic, Messages: messages}},
+		messageErrors)
+	if err != nil {
+		lc.Error(fmt.Sprintf("failed to subscribe to topic '%s': %s", messageQueue.RequestTopic, err.Error()))
+		return false
+	}
+
+	dic.Update(di.ServiceConstructorMap{
+		commandContainer.MessagingClientName: func(get di.Get) interface{} {
+			return msgClient
+		},
+	})
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-ctx.Done():
+				if err := msgClient.Disconnect(); err != nil {
+					lc.Error("failed to disconnect from the Message Bus")
+					return
+				}
+				lc.Info("Message Bus disconnected")
+				return
+
+			case err := <-messageErrors:
+				lc.Error(fmt.Sprintf("failed to receive command request: %s", err.Error()))
+			}
+		}
+	}()
+
+	workers := messageQueue.Workers
+	if workers <= 0 {
+		workers = defaultMessageBusWorkers
+	}
+	timeout := parseDuration(messageQueue.Timeout, defaultMessageBusTimeout, lc)
+	access := busAccess{
+		guard:   authz.GuardFrom(dic.Get),
+		auditor: audit.AuditorFrom(dic.Get),
+	}
+	if messageQueue.RequestsPerSecond > 0 {
+		access.limiter = throttle.NewThrottle(messageQueue.RequestsPerSecond, 0, false)
+	}
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+
+				case envelope := <-messages:
+					response := handleCommandRequest(
+						envelope,
+						commandDeps{
+							lc:              lc,
+							dbClient:        commandContainer.DBClientFrom(dic.Get),
+							deviceClient:    commandContainer.MetadataDeviceClientFrom(dic.Get),
+							commandThrottle: commandContainer.ThrottleFrom(dic.Get),
+							commandCache:    commandContainer.CommandCacheFrom(dic.Get),
+							commandBreaker:  commandContainer.BreakerFrom(dic.Get),
+							deviceSimulator: commandContainer.SimulatorFrom(dic.Get),
+							eventPersister:  commandContainer.PersisterFrom(dic.Get),
+							httpCaller:      mtls.CertificatesFrom(dic.Get).Client(timeout),
+						},
+						access)
+
+					err := msgClient.Publish(response, commandContainer.ConfigurationFrom(dic.Get).MessageQueue.ResponseTopic)
+					if err != nil {
+						lc.Error(
+							fmt.Sprintf("failed to publish command response: %s", err.Error()),
+							clients.CorrelationHeader,
+							response.CorrelationID)
+					}
+				}
+			}
+		}()
+	}
+
+	lc.Info(fmt.Sprintf(
+		"Connected to %s Message Bus @ %s listening for command requests on '%s' topic with %d workers",
+		messageQueue.Type,
+		messageQueue.URL(),
+		messageQueue.RequestTopic,
+		workers))
+
+	return true
+}
+
+// handleCommandRequest executes the command requested by the envelope and returns the envelope of the response.
+func handleCommandRequest(envelope msgTypes.MessageEnvelope, deps commandDeps, access busAccess) msgTypes.MessageEnvelope {
+	lc := deps.lc
+
+	ctx := context.WithValue(context.Background(), clients.CorrelationHeader, envelope.CorrelationID)
+
+	var response commandResponse
+	var request commandRequest
+	if err := json.Unmarshal(envelope.Payload, &request); err != nil {
+		lc.Error(fmt.Sprintf("failed to decode command request: %s", err.Error()), clients.CorrelationHeader, envelope.CorrelationID)
+		response = commandResponse{StatusCode: http.StatusBadRequest, Error: err.Error()}
+	} else {
+		if request.RequestId == "" {
+			request.RequestId = envelope.CorrelationID
+		}
+		response = executeCommandRequest(ctx, request, deps, access)
+	}
+
+	payload, err := json.Marshal(response)
+	if err != nil {
+		lc.Error(fmt.Sprintf("failed to encode command response: %s", err.Error()), clients.CorrelationHeader, envelope.CorrelationID)
+	}
+	return msgTypes.NewMessageEnvelope(payload, context.WithValue(ctx, clients.ContentType, clients.ContentTypeJSON))
+}
+
+// executeCommandRequest executes the request the same way as the REST API does, except that binary responses are
+// buffered, rather than streamed, to be published. The request is rate limited, authorized and audited as the REST
+// request of the same command would be; as it carries no identity trusted by RBAC, it is given the anonymous role.
+func executeCommandRequest(ctx context.Context, request commandRequest, deps commandDeps, access busAccess) commandResponse {
+	response := commandResponse{RequestId: request.RequestId}
+
+	method := strings.ToUpper(request.Method)
+	if method == "SET" {
+		method = http.MethodPut
+	}
+	if method != http.MethodGet && method != http.MethodPut {
+		response.StatusCode = http.StatusBadRequest
+		response.Error = fmt.Sprintf("unsupported command method '%s'", request.Method)
+		return response
+	}
+
+	var route, path string
+	var vars map[string]string
+	switch {
+	case request.DeviceId != "" && request.CommandId != "":
+		route = commandByIdRoute
+		path = strings.Join([]string{clients.ApiBase, DEVICE, url.PathEscape(request.DeviceId), COMMAND, url.PathEscape(request.CommandId)}, "/")
+		vars = map[string]string{ID: request.DeviceId, COMMANDID: request.CommandId}
+	case request.DeviceName != "" && request.CommandName != "":
+		route = commandByNameRoute
+		path = strings.Join([]string{clients.ApiBase, DEVICE, NAME, url.PathEscape(request.DeviceName), COMMAND, url.PathEscape(request.CommandName)}, "/")
+		vars = map[string]string{NAME: request.DeviceName, COMMANDNAME: request.CommandName}
+	default:
+		err := errors.NewErrExtractingInfoFromRequest()
+		response.StatusCode = commandErrorStatus(err)
+		response.Error = err.Error()
+		return response
+	}
+
+	originalRequest, err := http.NewRequest(method, path+"?"+request.QueryParams, nil)
+	if err != nil {
+		response.StatusCode = http.StatusBadRequest
+		response.Error = err.Error()
+		return response
+	}
+	originalRequest = mux.SetURLVars(originalRequest.WithContext(ctx), vars)
+	originalRequest.Header.Set(clients.CorrelationHeader, request.RequestId)
+	if request.Token != "" {
+		originalRequest.Header.Set("Authorization", "Bearer "+request.Token)
+	}
+	defer func() {
+		access.auditor.Record(originalRequest, route, response.StatusCode)
+	}()
+
+	if access.limiter != nil {
+		release, err := access.limiter.Acquire(ctx, messageBusLimiterKey, false)
+		if err != nil {
+			response.StatusCode = http.StatusTooManyRequests
+			response.Error = "too many command requests received over the message bus"
+			return response
+		}
+		release()
+	}
+
+	if access.guard.Enabled() {
+		user, status := access.guard.Authorize(originalRequest, route)
+		if status != http.StatusOK {
+			response.StatusCode = status
+			response.Error = strings.ToLower(http.StatusText(status))
+			return response
+		}
+		originalRequest = originalRequest.WithContext(authz.WithUser(originalRequest.Context(), user))
+	}
+
+	var deviceServiceResponse *http.Response
+	var body string
+	if route == commandByIdRoute {
+		deviceServiceResponse, body, err = executeCommandByDeviceID(originalRequest, request.Body, deps)
+	} else {
+		deviceServiceResponse, body, err = executeCommandByName(originalRequest, ctx, request.DeviceName, request.CommandName, request.Body, deps)
+	}
+
+	if err != nil {
+		deps.lc.Error(err.Error(), clients.CorrelationHeader, request.RequestId)
+		response.StatusCode = commandErrorStatus(err)
+		response.Error = err.Error()
+		return response
+	}
+
+	response.StatusCode = deviceServiceResponse.StatusCode
+	response.ContentType = deviceServiceResponse.Header.Get(clients.ContentType)
+	response.Body = body
+	return response
+}
+
+// commandErrorStatus returns the HTTP status code the REST API answers with for err.
+func commandErrorStatus(err error) int {
+	switch e := err.(type) {
+	case types.ErrServiceClient:
+		return e.StatusCode
+	case errors.ErrDeviceLocked:
+		return http.StatusLocked
+	case errors.ErrCommandNotAssociatedWithDevice:
+		return http.StatusNotFound
+	case errors.ErrExtractingInfoFromRequest, errors.ErrBadRequest, errors.ErrInvalidCommandParameters:
+		return http.StatusBadRequest
+	case errors.ErrDeviceBusy:
+		return http.StatusTooManyRequests
+	case errors.ErrServiceUnavailable:
+		return http.StatusServiceUnavailable
+	}
+	if err == db.ErrNotFound {
+		return http.StatusNotFound
+	}
+	return http.StatusInternalServerError
+}
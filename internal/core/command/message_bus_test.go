@@ -0,0 +1,149 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/throttle"
+	"github.com/edgexfoundry/edgex-go/internal/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/authz"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	msgTypes "github.com/edgexfoundry/go-mod-messaging/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testCorrelationId = "c7f8e3b4-4b46-4a8f-9b8a-2b8e4a6f9d31"
+
+func TestHandleCommandRequest(t *testing.T) {
+	dbMock := createMockWithOutlines([]mockOutline{
+		{"GetCommandsByDeviceId", []interface{}{deviceId}, []interface{}{[]models.Command{exampleCommand}, nil}},
+	})
+
+	tests := []struct {
+		name           string
+		payload        []byte
+		dcMock         *mocks.DeviceClient
+		expectedStatus int
+	}{
+		{
+			"get by ids",
+			requestPayload(commandRequest{DeviceId: deviceId, CommandId: TestCommandId, Method: "get"}),
+			createMockUnlockedDeviceCommandClient(deviceId),
+			http.StatusOK,
+		},
+		{
+			"locked device",
+			requestPayload(commandRequest{DeviceId: deviceId, CommandId: TestCommandId, Method: "set", Body: "{}"}),
+			createMockLockedDeviceCommandClient(deviceId),
+			http.StatusLocked,
+		},
+		{
+			"unsupported method",
+			requestPayload(commandRequest{DeviceId: deviceId, CommandId: TestCommandId, Method: "delete"}),
+			createMockUnlockedDeviceCommandClient(deviceId),
+			http.StatusBadRequest,
+		},
+		{
+			"missing command",
+			requestPayload(commandRequest{DeviceName: knownDeviceName, Method: "get"}),
+			createMockUnlockedDeviceCommandClient(deviceId),
+			http.StatusBadRequest,
+		},
+		{
+			"invalid payload",
+			[]byte("not json"),
+			createMockUnlockedDeviceCommandClient(deviceId),
+			http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			envelope := msgTypes.MessageEnvelope{
+				CorrelationID: testCorrelationId,
+				ContentType:   clients.ContentTypeJSON,
+				Payload:       tt.payload,
+			}
+
+			deps := commandDeps{lc: logger.NewMockClient(), dbClient: dbMock, deviceClient: tt.dcMock, httpCaller: createMockHttpCaller()}
+			result := handleCommandRequest(envelope, deps, busAccess{})
+			assert.Equal(t, testCorrelationId, result.CorrelationID)
+
+			var response commandResponse
+			require.NoError(t, json.Unmarshal(result.Payload, &response))
+			assert.Equal(t, tt.expectedStatus, response.StatusCode)
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, testCorrelationId, response.RequestId, "request id should default to the correlation id")
+				assert.Equal(t, exampleCommand.String(), response.Body)
+			} else {
+				assert.NotEmpty(t, response.Error)
+			}
+		})
+	}
+}
+
+// auditRecords collects the audited requests.
+type auditRecords []audit.Record
+
+func (r *auditRecords) Record(record audit.Record) {
+	*r = append(*r, record)
+}
+
+func TestExecuteCommandRequestAccess(t *testing.T) {
+	dbMock := createMockWithOutlines([]mockOutline{
+		{"GetCommandsByDeviceId", []interface{}{deviceId}, []interface{}{[]models.Command{exampleCommand}, nil}},
+	})
+	deps := commandDeps{
+		lc:           logger.NewMockClient(),
+		dbClient:     dbMock,
+		deviceClient: createMockUnlockedDeviceCommandClient(deviceId),
+		httpCaller:   createMockHttpCaller(),
+	}
+	request := commandRequest{RequestId: testCorrelationId, DeviceId: deviceId, CommandId: TestCommandId, Method: "get"}
+	rbacInfo := authz.RBACInfo{Enabled: true, IdentityHeader: "X-Consumer-Username", Policies: []authz.PolicyInfo{{Route: "*", Role: "reader"}}}
+
+	var records auditRecords
+	auditor, err := audit.NewAuditor("edgex-core-command", audit.AuditInfo{
+		Rules: []audit.RuleInfo{{Route: commandByIdRoute, Category: "command"}},
+	}, &records)
+	require.NoError(t, err)
+	denied, err := authz.NewGuard("edgex-core-command", rbacInfo, authz.AuthorizationInfo{}, logger.NewMockClient())
+	require.NoError(t, err)
+	rbacInfo.AnonymousRole = "reader"
+	allowed, err := authz.NewGuard("edgex-core-command", rbacInfo, authz.AuthorizationInfo{}, logger.NewMockClient())
+	require.NoError(t, err)
+
+	response := executeCommandRequest(context.Background(), request, deps, busAccess{guard: denied, auditor: auditor})
+	assert.Equal(t, http.StatusUnauthorized, response.StatusCode, "the requests over the message bus should be anonymous")
+
+	limiter := throttle.NewThrottle(1, 0, false)
+	response = executeCommandRequest(context.Background(), request, deps, busAccess{limiter: limiter, guard: allowed, auditor: auditor})
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	response = executeCommandRequest(context.Background(), request, deps, busAccess{limiter: limiter, guard: allowed, auditor: auditor})
+	assert.Equal(t, http.StatusTooManyRequests, response.StatusCode)
+
+	require.Len(t, records, 3)
+	assert.Equal(t, audit.AuthenticationCategory, records[0].Category)
+	assert.Equal(t, commandByIdRoute, records[1].Route)
+	assert.Equal(t, "/api/v1/device/"+deviceId+"/command/"+TestCommandId, records[1].Path)
+	assert.Equal(t, audit.SuccessOutcome, records[1].Outcome)
+	assert.Equal(t, audit.FailureOutcome, records[2].Outcome)
+}
+
+func requestPayload(request commandRequest) []byte {
+	payload, _ := json.Marshal(request)
+	return payload
+}
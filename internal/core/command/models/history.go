@@ -0,0 +1,36 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// CommandHistory records a command issued to a device through core-command, for the traceability of actuation.
+type CommandHistory struct {
+	ID            string           `json:"id"`
+	User          string           `json:"user,omitempty"`
+	Origin        string           `json:"origin,omitempty"`
+	DeviceId      string           `json:"deviceId"`
+	DeviceName    string           `json:"deviceName"`
+	Command       string           `json:"command"`
+	Method        string           `json:"method"`
+	Parameters    string           `json:"parameters,omitempty"`
+	StatusCode    int              `json:"statusCode,omitempty"`
+	Resources     []ResourceStatus `json:"resources,omitempty"`
+	Error         string           `json:"error,omitempty"`
+	Duration      int64            `json:"duration"`
+	CorrelationId string           `json:"correlationId,omitempty"`
+	Created       int64            `json:"created"`
+}
+
+// ResourceStatus is the outcome of writing one resource of a set command, as reported by the device service.
+type ResourceStatus struct {
+	Resource   string `json:"resource"`
+	StatusCode int    `json:"statusCode"`
+	Message    string `json:"message,omitempty"`
+}
+
+// Applied reports whether the device service applied the value written to the resource.
+func (r ResourceStatus) Applied() bool {
+	return r.StatusCode >= 200 && r.StatusCode < 300
+}
@@ -0,0 +1,91 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package persist adds the readings returned by the read commands of devices to core-data as events, so that the
+// values polled on demand join the history recorded from the events pushed by the device services.
+package persist
+
+import (
+	"context"
+	"encoding/json"
+	goErrors "errors"
+	"mime"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// ErrNotAnEvent is returned when the response of a read command doesn't carry an event with readings.
+var ErrNotAnEvent = goErrors.New("the response of the device service is not an event with readings")
+
+// EventClient is the part of the core-data coredata.EventClient the Persister adds the events with.
+type EventClient interface {
+	Add(ctx context.Context, event *contract.Event) (string, error)
+}
+
+// Persister adds the events returned by read commands to core-data. A nil Persister persists no event.
+type Persister struct {
+	client EventClient
+	// commands holds the commands whose events are persisted unless the request says otherwise, named either after
+	// the command of any device or as '<device>/<command>'
+	commands map[string]struct{}
+}
+
+// NewPersister creates a Persister adding the events to core-data with client, always for the commands named, on
+// request for the others.
+func NewPersister(client EventClient, commands []string) *Persister {
+	p := &Persister{client: client, commands: make(map[string]struct{}, len(commands))}
+	for _, command := range commands {
+		p.commands[command] = struct{}{}
+	}
+	return p
+}
+
+// Persisted reports whether the event returned by the command of the device is persisted, as requested when the
+// request says so, or as configured for the command otherwise.
+func (p *Persister) Persisted(device string, command string, requested bool, present bool) bool {
+	if p == nil {
+		return false
+	}
+	if present {
+		return requested
+	}
+	if _, ok := p.commands[command]; ok {
+		return true
+	}
+	_, ok := p.commands[device+"/"+command]
+	return ok
+}
+
+// Persist adds the event in the JSON body of a response of the device service to core-data, on behalf of the device,
+// and returns its id in core-data.
+func (p *Persister) Persist(ctx context.Context, device string, contentType string, body string) (string, error) {
+	if contentType != "" {
+		mediaType, _, err := mime.ParseMediaType(contentType)
+		if err != nil || mediaType != clients.ContentTypeJSON {
+			return "", ErrNotAnEvent
+		}
+	}
+
+	var event contract.Event
+	if err := json.Unmarshal([]byte(body), &event); err != nil || len(event.Readings) == 0 {
+		return "", ErrNotAnEvent
+	}
+
+	// the event is new to core-data, which assigns its id and timestamps
+	event.ID = ""
+	event.Pushed = 0
+	event.Created = 0
+	event.Modified = 0
+	event.Device = device
+	for i := range event.Readings {
+		event.Readings[i].Id = ""
+		event.Readings[i].Pushed = 0
+		event.Readings[i].Created = 0
+		event.Readings[i].Modified = 0
+		event.Readings[i].Device = device
+	}
+	return p.client.Add(ctx, &event)
+}
@@ -0,0 +1,96 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package persist
+
+import (
+	"context"
+	"testing"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeEventClient struct {
+	events []contract.Event
+}
+
+func (c *fakeEventClient) Add(_ context.Context, event *contract.Event) (string, error) {
+	c.events = append(c.events, *event)
+	return "event-id", nil
+}
+
+func TestNilPersisterPersistsNothing(t *testing.T) {
+	var persister *Persister
+	assert.False(t, persister.Persisted("thermostat", "Temperature", true, true))
+}
+
+func TestPersisted(t *testing.T) {
+	persister := NewPersister(&fakeEventClient{}, []string{"Temperature", "camera/Status"})
+
+	tests := []struct {
+		name      string
+		device    string
+		command   string
+		requested bool
+		present   bool
+		expected  bool
+	}{
+		{"configured command", "thermostat", "Temperature", false, false, true},
+		{"configured device command", "camera", "Status", false, false, true},
+		{"command of another device", "thermostat", "Status", false, false, false},
+		{"requested", "thermostat", "Mode", true, true, true},
+		{"declined", "thermostat", "Temperature", false, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, persister.Persisted(tt.device, tt.command, tt.requested, tt.present))
+		})
+	}
+}
+
+func TestPersist(t *testing.T) {
+	client := &fakeEventClient{}
+	persister := NewPersister(client, nil)
+
+	id, err := persister.Persist(context.Background(), "thermostat", "application/json; charset=utf-8",
+		`{"id":"ds-id","device":"thermostat","origin":1600000000000,`+
+			`"readings":[{"id":"reading-id","name":"Temperature","value":"21","origin":1600000000000}]}`)
+	require.NoError(t, err)
+	assert.Equal(t, "event-id", id)
+	require.Len(t, client.events, 1)
+	event := client.events[0]
+	assert.Empty(t, event.ID)
+	assert.Equal(t, "thermostat", event.Device)
+	assert.Equal(t, int64(1600000000000), event.Origin)
+	require.Len(t, event.Readings, 1)
+	assert.Empty(t, event.Readings[0].Id)
+	assert.Equal(t, "thermostat", event.Readings[0].Device)
+	assert.Equal(t, "21", event.Readings[0].Value)
+}
+
+func TestPersistRejectsResponsesWithoutEvent(t *testing.T) {
+	client := &fakeEventClient{}
+	persister := NewPersister(client, nil)
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+	}{
+		{"not JSON", "text/plain", `{"readings":[{"name":"Temperature","value":"21"}]}`},
+		{"malformed", "application/json", `{"readings":`},
+		{"no readings", "", `{"device":"thermostat"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := persister.Persist(context.Background(), "thermostat", tt.contentType, tt.body)
+			assert.Equal(t, ErrNotAnEvent, err)
+		})
+	}
+	assert.Empty(t, client.events)
+}
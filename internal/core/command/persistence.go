@@ -0,0 +1,37 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/persist"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// persistCommandEvent adds the event returned by a successful read command to core-data. The command has succeeded
+// whatever the outcome, so that a failure to persist the event is only logged.
+func persistCommandEvent(
+	ctx context.Context,
+	device contract.Device,
+	command contract.Command,
+	deviceServiceResponse *http.Response,
+	body string,
+	eventPersister *persist.Persister,
+	lc logger.LoggingClient) {
+
+	id, err := eventPersister.Persist(ctx, device.Name, deviceServiceResponse.Header.Get(clients.ContentType), body)
+	if err != nil {
+		lc.Error(fmt.Sprintf("failed to persist the event of command %s of device %s: %s", command.Name, device.Name, err.Error()))
+		return
+	}
+	lc.Debug(fmt.Sprintf("Persisted the event of command %s of device %s as event %s", command.Name, device.Name, id))
+}
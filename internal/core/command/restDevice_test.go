@@ -240,6 +240,12 @@ func TestRestPutDeviceCommandByCommandID(t *testing.T) {
 				tt.dbMock,
 				tt.dcMock,
 				errorconcept.NewErrorHandler(loggerMock),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 				httpCaller)
 			response := rr.Result()
 			require.Equal(t, tt.expectedStatus, response.StatusCode)
@@ -335,6 +341,12 @@ func TestRestGetDeviceCommandByCommandID(t *testing.T) {
 				tt.dbMock,
 				tt.dcMock,
 				errorconcept.NewErrorHandler(loggerMock),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 				httpCaller)
 			response := rr.Result()
 			require.Equal(t, tt.expectedStatus, response.StatusCode)
@@ -348,6 +360,7 @@ func createMockWithOutlines(outlines []mockOutline) interfaces.DBClient {
 	for _, o := range outlines {
 		dbMock.On(o.methodName, o.arg...).Return(o.ret...)
 	}
+	dbMock.On("AddCommandHistory", mock.Anything).Return("", nil)
 	return &dbMock
 }
 
@@ -16,12 +16,22 @@ package command
 
 import (
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
+	"math"
 	"net/http"
+	"strconv"
 
 	"github.com/edgexfoundry/edgex-go/internal"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/breaker"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/cache"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/errors"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/persist"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/simulator"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/stream"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/throttle"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/errorconcept"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/clients"
@@ -38,9 +48,15 @@ func restGetDeviceCommandByCommandID(
 	dbClient interfaces.DBClient,
 	deviceClient metadata.DeviceClient,
 	httpErrorHandler errorconcept.ErrorHandler,
+	commandThrottle *throttle.Throttle,
+	commandCache *cache.Cache,
+	commandBreaker *breaker.Breaker,
+	deviceSimulator *simulator.Simulator,
+	eventPersister *persist.Persister,
+	commandStreamer *stream.Streamer,
 	httpCaller internal.HttpCaller) {
 
-	issueDeviceCommand(w, originalRequest, lc, dbClient, deviceClient, httpErrorHandler, httpCaller)
+	issueDeviceCommand(w, originalRequest, lc, dbClient, deviceClient, httpErrorHandler, commandThrottle, commandCache, commandBreaker, deviceSimulator, eventPersister, commandStreamer, httpCaller)
 }
 
 func restPutDeviceCommandByCommandID(
@@ -50,9 +66,15 @@ func restPutDeviceCommandByCommandID(
 	dbClient interfaces.DBClient,
 	deviceClient metadata.DeviceClient,
 	httpErrorHandler errorconcept.ErrorHandler,
+	commandThrottle *throttle.Throttle,
+	commandCache *cache.Cache,
+	commandBreaker *breaker.Breaker,
+	deviceSimulator *simulator.Simulator,
+	eventPersister *persist.Persister,
+	commandStreamer *stream.Streamer,
 	httpCaller internal.HttpCaller) {
 
-	issueDeviceCommand(w, originalRequest, lc, dbClient, deviceClient, httpErrorHandler, httpCaller)
+	issueDeviceCommand(w, originalRequest, lc, dbClient, deviceClient, httpErrorHandler, commandThrottle, commandCache, commandBreaker, deviceSimulator, eventPersister, commandStreamer, httpCaller)
 }
 
 func issueDeviceCommand(
@@ -62,6 +84,12 @@ func issueDeviceCommand(
 	dbClient interfaces.DBClient,
 	deviceClient metadata.DeviceClient,
 	httpErrorHandler errorconcept.ErrorHandler,
+	commandThrottle *throttle.Throttle,
+	commandCache *cache.Cache,
+	commandBreaker *breaker.Breaker,
+	deviceSimulator *simulator.Simulator,
+	eventPersister *persist.Persister,
+	commandStreamer *stream.Streamer,
 	httpCaller internal.HttpCaller) {
 
 	defer originalRequest.Body.Close()
@@ -75,12 +103,21 @@ func issueDeviceCommand(
 	deviceServiceResponse, deviceServiceResponseBody, err := executeCommandByDeviceID(
 		originalRequest,
 		string(b),
-		lc,
-		dbClient,
-		deviceClient,
-		httpCaller)
+		commandDeps{
+			lc:              lc,
+			dbClient:        dbClient,
+			deviceClient:    deviceClient,
+			commandThrottle: commandThrottle,
+			commandCache:    commandCache,
+			commandBreaker:  commandBreaker,
+			deviceSimulator: deviceSimulator,
+			eventPersister:  eventPersister,
+			commandStreamer: commandStreamer,
+			httpCaller:      httpCaller,
+		})
 
 	if err != nil {
+		setRetryAfter(w, err)
 		httpErrorHandler.HandleManyVariants(
 			w,
 			err,
@@ -89,6 +126,11 @@ func issueDeviceCommand(
 				errorconcept.Device.Locked,
 				errorconcept.Database.NotFound,
 				errorconcept.Command.NotAssociatedWithDevice,
+				errorconcept.Command.InvalidParameters,
+				errorconcept.Command.DeviceBusy,
+				errorconcept.Command.ServiceUnavailable,
+				errorconcept.Command.BadRequest,
+				errorconcept.Command.ResponseTooLarge,
 			},
 			errorconcept.Default.InternalServerError)
 		return
@@ -108,7 +150,11 @@ func issueDeviceCommand(
 	// Set the returned header Content-type based on header Content-type received in
 	// the Device Service request (No need to inspect it).
 	w.Header().Set(clients.ContentType, headers[clients.ContentType])
-	w.WriteHeader(http.StatusOK)
+	w.WriteHeader(commandResponseStatus(deviceServiceResponse))
+	if commandStreamer.Streamable(deviceServiceResponse) {
+		relayResponse(w, deviceServiceResponse, commandStreamer, lc)
+		return
+	}
 	w.Write([]byte(deviceServiceResponseBody))
 }
 
@@ -119,9 +165,15 @@ func restGetDeviceCommandByNames(
 	dbClient interfaces.DBClient,
 	deviceClient metadata.DeviceClient,
 	httpErrorHandler errorconcept.ErrorHandler,
+	commandThrottle *throttle.Throttle,
+	commandCache *cache.Cache,
+	commandBreaker *breaker.Breaker,
+	deviceSimulator *simulator.Simulator,
+	eventPersister *persist.Persister,
+	commandStreamer *stream.Streamer,
 	httpCaller internal.HttpCaller) {
 
-	issueDeviceCommandByNames(w, originalRequest, lc, dbClient, deviceClient, httpErrorHandler, httpCaller)
+	issueDeviceCommandByNames(w, originalRequest, lc, dbClient, deviceClient, httpErrorHandler, commandThrottle, commandCache, commandBreaker, deviceSimulator, eventPersister, commandStreamer, httpCaller)
 }
 
 func restPutDeviceCommandByNames(
@@ -131,9 +183,15 @@ func restPutDeviceCommandByNames(
 	dbClient interfaces.DBClient,
 	deviceClient metadata.DeviceClient,
 	httpErrorHandler errorconcept.ErrorHandler,
+	commandThrottle *throttle.Throttle,
+	commandCache *cache.Cache,
+	commandBreaker *breaker.Breaker,
+	deviceSimulator *simulator.Simulator,
+	eventPersister *persist.Persister,
+	commandStreamer *stream.Streamer,
 	httpCaller internal.HttpCaller) {
 
-	issueDeviceCommandByNames(w, originalRequest, lc, dbClient, deviceClient, httpErrorHandler, httpCaller)
+	issueDeviceCommandByNames(w, originalRequest, lc, dbClient, deviceClient, httpErrorHandler, commandThrottle, commandCache, commandBreaker, deviceSimulator, eventPersister, commandStreamer, httpCaller)
 }
 
 func issueDeviceCommandByNames(
@@ -143,6 +201,12 @@ func issueDeviceCommandByNames(
 	dbClient interfaces.DBClient,
 	deviceClient metadata.DeviceClient,
 	httpErrorHandler errorconcept.ErrorHandler,
+	commandThrottle *throttle.Throttle,
+	commandCache *cache.Cache,
+	commandBreaker *breaker.Breaker,
+	deviceSimulator *simulator.Simulator,
+	eventPersister *persist.Persister,
+	commandStreamer *stream.Streamer,
 	httpCaller internal.HttpCaller) {
 
 	defer originalRequest.Body.Close()
@@ -165,12 +229,21 @@ func issueDeviceCommandByNames(
 		dn,
 		cn,
 		string(b),
-		lc,
-		dbClient,
-		deviceClient,
-		httpCaller)
+		commandDeps{
+			lc:              lc,
+			dbClient:        dbClient,
+			deviceClient:    deviceClient,
+			commandThrottle: commandThrottle,
+			commandCache:    commandCache,
+			commandBreaker:  commandBreaker,
+			deviceSimulator: deviceSimulator,
+			eventPersister:  eventPersister,
+			commandStreamer: commandStreamer,
+			httpCaller:      httpCaller,
+		})
 
 	if err != nil {
+		setRetryAfter(w, err)
 		httpErrorHandler.HandleManyVariants(
 			w,
 			err,
@@ -178,6 +251,11 @@ func issueDeviceCommandByNames(
 				errorconcept.NewServiceClientHttpError(err),
 				errorconcept.Device.Locked,
 				errorconcept.Database.NotFound,
+				errorconcept.Command.InvalidParameters,
+				errorconcept.Command.DeviceBusy,
+				errorconcept.Command.ServiceUnavailable,
+				errorconcept.Command.BadRequest,
+				errorconcept.Command.ResponseTooLarge,
 			},
 			errorconcept.Default.InternalServerError)
 		return
@@ -197,7 +275,11 @@ func issueDeviceCommandByNames(
 	// Set the returned header Content-type based on header Content-type received in
 	// the Device Service request (No need to inspect it).
 	w.Header().Set(clients.ContentType, headers[clients.ContentType])
-	w.WriteHeader(http.StatusOK)
+	w.WriteHeader(commandResponseStatus(deviceServiceResponse))
+	if commandStreamer.Streamable(deviceServiceResponse) {
+		relayResponse(w, deviceServiceResponse, commandStreamer, lc)
+		return
+	}
 	w.Write([]byte(deviceServiceResponseBody))
 }
 
@@ -286,3 +368,28 @@ func restGetAllCommands(
 	w.WriteHeader(http.StatusOK)
 	_ = json.NewEncoder(w).Encode(devices)
 }
+
+// relayResponse streams the binary body of the response of the device service to the client as it is received. Once
+// the headers are sent a failed transfer can only be aborted, so that the client doesn't take a truncated body for a
+// complete one.
+func relayResponse(
+	w http.ResponseWriter,
+	deviceServiceResponse *http.Response,
+	commandStreamer *stream.Streamer,
+	lc logger.LoggingClient) {
+
+	n, err := commandStreamer.Copy(w, deviceServiceResponse.Body)
+	if err != nil {
+		lc.Error(fmt.Sprintf("Relaying the response of the device service failed after %d bytes: %s", n, err.Error()))
+		panic(http.ErrAbortHandler)
+	}
+}
+
+// setRetryAfter tells the client when to issue the command again if it failed fast because the device service is
+// unavailable.
+func setRetryAfter(w http.ResponseWriter, err error) {
+	if e, ok := err.(errors.ErrServiceUnavailable); ok {
+		seconds := int(math.Ceil(e.RetryAfter().Seconds()))
+		w.Header().Set(RETRYAFTERHEADER, strconv.Itoa(seconds))
+	}
+}
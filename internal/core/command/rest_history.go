@@ -0,0 +1,117 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package command
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/errors"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/errorconcept"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/gorilla/mux"
+)
+
+// historyQuery returns the command history recorded between start and end, limited by limit.
+type historyQuery func(start int64, end int64, limit int) ([]models.CommandHistory, error)
+
+// Get the commands issued between {start} and {end}, limited by {limit}
+// 413 - number of results exceeds the configured maximum
+// api/v1/history/{start}/{end}/{limit}
+func restGetCommandHistory(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	httpErrorHandler errorconcept.ErrorHandler,
+	configuration *config.ConfigurationStruct) {
+
+	queryCommandHistory(w, r, lc, httpErrorHandler, configuration, dbClient.GetCommandHistory)
+}
+
+// Get the commands issued to the device with {id} between {start} and {end}, limited by {limit}
+// api/v1/history/device/{id}/{start}/{end}/{limit}
+func restGetCommandHistoryByDeviceId(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	httpErrorHandler errorconcept.ErrorHandler,
+	configuration *config.ConfigurationStruct) {
+
+	id := mux.Vars(r)[ID]
+	queryCommandHistory(w, r, lc, httpErrorHandler, configuration,
+		func(start int64, end int64, limit int) ([]models.CommandHistory, error) {
+			return dbClient.GetCommandHistoryByDeviceId(id, start, end, limit)
+		})
+}
+
+// Get the commands issued to the device with {name} between {start} and {end}, limited by {limit}
+// api/v1/history/device/name/{name}/{start}/{end}/{limit}
+func restGetCommandHistoryByDeviceName(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	httpErrorHandler errorconcept.ErrorHandler,
+	configuration *config.ConfigurationStruct) {
+
+	name := mux.Vars(r)[NAME]
+	queryCommandHistory(w, r, lc, httpErrorHandler, configuration,
+		func(start int64, end int64, limit int) ([]models.CommandHistory, error) {
+			return dbClient.GetCommandHistoryByDeviceName(name, start, end, limit)
+		})
+}
+
+func queryCommandHistory(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	httpErrorHandler errorconcept.ErrorHandler,
+	configuration *config.ConfigurationStruct,
+	query historyQuery) {
+
+	vars := mux.Vars(r)
+	start, err := strconv.ParseInt(vars[START], 10, 64)
+	if err != nil {
+		httpErrorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return
+	}
+
+	end, err := strconv.ParseInt(vars[END], 10, 64)
+	if err != nil {
+		httpErrorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return
+	}
+
+	limit, err := strconv.Atoi(vars[LIMIT])
+	if err != nil {
+		httpErrorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return
+	}
+
+	if limit > configuration.Service.MaxResultCount {
+		httpErrorHandler.Handle(
+			w,
+			errors.NewErrLimitExceeded(configuration.Service.MaxResultCount),
+			errorconcept.Common.LimitExceeded)
+		return
+	}
+
+	history, err := query(start, end, limit)
+	if err != nil {
+		httpErrorHandler.Handle(w, err, errorconcept.Default.InternalServerError)
+		return
+	}
+
+	pkg.Encode(history, w, lc)
+}
@@ -0,0 +1,122 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package command
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	commandMocks "github.com/edgexfoundry/edgex-go/internal/core/command/interfaces/mocks"
+	commandModels "github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/authz"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/errorconcept"
+
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testHistoryConfig = &config.ConfigurationStruct{Service: bootstrapConfig.ServiceInfo{MaxResultCount: 5}}
+
+func TestRestGetCommandHistoryByDeviceId(t *testing.T) {
+	history := []commandModels.CommandHistory{{ID: "1", DeviceId: deviceId, Command: exampleCommand.Name, StatusCode: http.StatusOK}}
+	dbMock := &commandMocks.DBClient{}
+	dbMock.On("GetCommandHistoryByDeviceId", deviceId, int64(10), int64(20), 5).Return(history, nil)
+
+	tests := []struct {
+		name           string
+		vars           map[string]string
+		expectedStatus int
+	}{
+		{"ok", map[string]string{ID: deviceId, START: "10", END: "20", LIMIT: "5"}, http.StatusOK},
+		{"invalid start", map[string]string{ID: deviceId, START: "x", END: "20", LIMIT: "5"}, http.StatusBadRequest},
+		{"limit exceeded", map[string]string{ID: deviceId, START: "10", END: "20", LIMIT: "6"}, http.StatusRequestEntityTooLarge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := mux.SetURLVars(httptest.NewRequest(http.MethodGet, clients.ApiBase+"/"+HISTORY, nil), tt.vars)
+			rr := httptest.NewRecorder()
+			loggerMock := logger.NewMockClient()
+			restGetCommandHistoryByDeviceId(rr, req, loggerMock, dbMock, errorconcept.NewErrorHandler(loggerMock), testHistoryConfig)
+			require.Equal(t, tt.expectedStatus, rr.Code)
+
+			if tt.expectedStatus == http.StatusOK {
+				var actual []commandModels.CommandHistory
+				require.NoError(t, json.NewDecoder(rr.Body).Decode(&actual))
+				assert.Equal(t, history, actual)
+			}
+		})
+	}
+}
+
+func TestIssueDeviceCommandRecordsHistory(t *testing.T) {
+	dbMock := &commandMocks.DBClient{}
+	dbMock.On("GetCommandsByDeviceId", deviceId).Return([]models.Command{exampleCommand}, nil)
+	dbMock.On("AddCommandHistory", mock.MatchedBy(func(h commandModels.CommandHistory) bool {
+		return h.User == "operator" &&
+			h.DeviceId == deviceId &&
+			h.Command == exampleCommand.Name &&
+			h.Method == http.MethodGet &&
+			h.StatusCode == http.StatusOK
+	})).Return("1", nil)
+
+	req := createRequestWithPathParameters(
+		http.MethodGet,
+		clients.ContentTypeJSON,
+		map[string]string{ID: deviceId, COMMANDID: TestCommandId},
+		createTestDeviceWithPathUrl(TestCommandId, deviceId),
+		exampleCommand)
+	req = req.WithContext(authz.WithUser(req.Context(), "operator"))
+
+	rr := httptest.NewRecorder()
+	loggerMock := logger.NewMockClient()
+	restGetDeviceCommandByCommandID(
+		rr,
+		req,
+		loggerMock,
+		dbMock,
+		createMockUnlockedDeviceCommandClient(deviceId),
+		errorconcept.NewErrorHandler(loggerMock),
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		createMockHttpCaller())
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	dbMock.AssertCalled(t, "AddCommandHistory", mock.Anything)
+}
+
+func TestDeleteExpiredCommandHistory(t *testing.T) {
+	dbMock := &commandMocks.DBClient{}
+	dbMock.On("DeleteCommandHistoryBefore", mock.Anything, 100).Return(3, nil)
+
+	before := db.MakeTimestamp()
+	deleteExpiredCommandHistory(time.Hour, 100, logger.NewMockClient(), dbMock)
+	after := db.MakeTimestamp()
+
+	expired := dbMock.Calls[0].Arguments.Get(0).(int64)
+	assert.True(t, expired >= before-time.Hour.Milliseconds() && expired <= after-time.Hour.Milliseconds(),
+		"the commands executed more than an hour ago should be deleted")
+
+	dbMock = &commandMocks.DBClient{}
+	dbMock.On("DeleteCommandHistoryBefore", int64(0), 100).Return(0, nil)
+	deleteExpiredCommandHistory(0, 100, logger.NewMockClient(), dbMock)
+	dbMock.AssertExpectations(t)
+}
@@ -0,0 +1,366 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package command
+
+import (
+	"context"
+	"encoding/json"
+	goErrors "errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/breaker"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/cache"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/errors"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/job"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/persist"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/simulator"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/throttle"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/constant"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/utils"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/metadata"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/types"
+	v2 "github.com/edgexfoundry/go-mod-core-contracts/v2"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+
+	"github.com/gorilla/mux"
+)
+
+const (
+	defaultAsyncTimeout       = 30 * time.Second
+	defaultAsyncRetryInterval = time.Second
+	defaultJobRetention       = 10 * time.Minute
+	defaultThrottleMaxWait    = 5 * time.Second
+	defaultBreakerOpenTime    = 30 * time.Second
+	defaultCommandCacheTTL    = 2 * time.Second
+	defaultStreamingTimeout   = 60 * time.Second
+	defaultDeviceCacheTTL     = 30 * time.Second
+)
+
+// commandJobResponse is the response of the command job API.
+type commandJobResponse struct {
+	commonDTO.BaseResponse `json:",inline"`
+	Job                    job.Job `json:"job"`
+}
+
+// commandExecution executes a command once, using the route variables carried by req.
+type commandExecution func(
+	ctx context.Context,
+	req *http.Request,
+	body string,
+	httpCaller internal.HttpCaller) (*http.Response, string, error)
+
+func restAsyncDeviceCommandByCommandID(
+	w http.ResponseWriter,
+	originalRequest *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	deviceClient metadata.DeviceClient,
+	commandThrottle *throttle.Throttle,
+	commandCache *cache.Cache,
+	commandBreaker *breaker.Breaker,
+	deviceSimulator *simulator.Simulator,
+	eventPersister *persist.Persister,
+	jobs *job.Store,
+	asyncConfig config.AsyncCommandInfo,
+	transport http.RoundTripper) {
+
+	vars := mux.Vars(originalRequest)
+	issueAsyncDeviceCommand(w, originalRequest, vars[ID], vars[COMMANDID], lc, jobs, asyncConfig, transport,
+		func(ctx context.Context, req *http.Request, body string, httpCaller internal.HttpCaller) (*http.Response, string, error) {
+			return executeCommandByDeviceID(req, body, commandDeps{
+				lc:              lc,
+				dbClient:        dbClient,
+				deviceClient:    deviceClient,
+				commandThrottle: commandThrottle,
+				commandCache:    commandCache,
+				commandBreaker:  commandBreaker,
+				deviceSimulator: deviceSimulator,
+				eventPersister:  eventPersister,
+				httpCaller:      httpCaller,
+			})
+		})
+}
+
+func restAsyncDeviceCommandByNames(
+	w http.ResponseWriter,
+	originalRequest *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	deviceClient metadata.DeviceClient,
+	commandThrottle *throttle.Throttle,
+	commandCache *cache.Cache,
+	commandBreaker *breaker.Breaker,
+	deviceSimulator *simulator.Simulator,
+	eventPersister *persist.Persister,
+	jobs *job.Store,
+	asyncConfig config.AsyncCommandInfo,
+	transport http.RoundTripper) {
+
+	vars := mux.Vars(originalRequest)
+	dn := vars[NAME]
+	cn := vars[COMMANDNAME]
+	issueAsyncDeviceCommand(w, originalRequest, dn, cn, lc, jobs, asyncConfig, transport,
+		func(ctx context.Context, req *http.Request, body string, httpCaller internal.HttpCaller) (*http.Response, string, error) {
+			return executeCommandByName(req, ctx, dn, cn, body, commandDeps{
+				lc:              lc,
+				dbClient:        dbClient,
+				deviceClient:    deviceClient,
+				commandThrottle: commandThrottle,
+				commandCache:    commandCache,
+				commandBreaker:  commandBreaker,
+				deviceSimulator: deviceSimulator,
+				eventPersister:  eventPersister,
+				httpCaller:      httpCaller,
+			})
+		})
+}
+
+// issueAsyncDeviceCommand registers a job for the command, answers with the job straight away and leaves the
+// execution of the command against the device service to a background goroutine. The response of the device service is
+// kept with the job, so binary responses are buffered rather than streamed.
+func issueAsyncDeviceCommand(
+	w http.ResponseWriter,
+	originalRequest *http.Request,
+	device string,
+	command string,
+	lc logger.LoggingClient,
+	jobs *job.Store,
+	asyncConfig config.AsyncCommandInfo,
+	transport http.RoundTripper,
+	execute commandExecution) {
+
+	defer originalRequest.Body.Close()
+
+	b, err := ioutil.ReadAll(originalRequest.Body)
+	if err != nil {
+		lc.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	correlationID := correlation.FromContext(originalRequest.Context())
+	template := detachRequest(originalRequest, ASYNC)
+	j := startCommandJob(
+		device,
+		command,
+		template,
+		mux.Vars(originalRequest),
+		string(b),
+		correlationID,
+		lc,
+		jobs,
+		asyncConfig,
+		transport,
+		execute)
+	lc.Info(
+		fmt.Sprintf("Accepted %s command %s of device %s as job %s", j.Method, command, device, j.Id),
+		clients.CorrelationHeader,
+		correlationID)
+
+	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+	w.Header().Set("Location", strings.Replace(constant.ApiCommandJobByIdRoute, "{"+v2.Id+"}", j.Id, 1))
+	w.WriteHeader(http.StatusAccepted)
+	_ = json.NewEncoder(w).Encode(&j)
+}
+
+// detachRequest returns a copy of the original request, without the specified query parameters, which is detached
+// from the request context. The original request is done with once the response is written, whereas the copy can be
+// executed later on.
+func detachRequest(originalRequest *http.Request, params ...string) *http.Request {
+	template := originalRequest.Clone(context.Background())
+	query := template.URL.Query()
+	for _, param := range params {
+		query.Del(param)
+	}
+	template.URL.RawQuery = query.Encode()
+	return template
+}
+
+// startCommandJob registers a job for the command and executes it in a background goroutine.
+func startCommandJob(
+	device string,
+	command string,
+	template *http.Request,
+	vars map[string]string,
+	body string,
+	correlationID string,
+	lc logger.LoggingClient,
+	jobs *job.Store,
+	asyncConfig config.AsyncCommandInfo,
+	transport http.RoundTripper,
+	execute commandExecution) job.Job {
+
+	j := jobs.Add(device, command, template.Method)
+	go runCommandJob(j.Id, template, vars, body, correlationID, lc, jobs, asyncConfig, transport, execute)
+	return j
+}
+
+// runCommandJob executes the command of the job, retrying while the device service is unreachable or failing, and
+// records the outcome in the job store.
+func runCommandJob(
+	id string,
+	template *http.Request,
+	vars map[string]string,
+	body string,
+	correlationID string,
+	lc logger.LoggingClient,
+	jobs *job.Store,
+	asyncConfig config.AsyncCommandInfo,
+	transport http.RoundTripper,
+	execute commandExecution) {
+
+	timeout := parseDuration(asyncConfig.Timeout, defaultAsyncTimeout, lc)
+	retryInterval := parseDuration(asyncConfig.RetryInterval, defaultAsyncRetryInterval, lc)
+	retries := asyncConfig.Retries
+	if retries < 0 {
+		retries = 0
+	}
+	jobCtx := context.WithValue(jobs.Context(), clients.CorrelationHeader, correlationID)
+
+	var resp *http.Response
+	var responseBody string
+	var err error
+	for attempt := 1; ; attempt++ {
+		jobs.Update(id, func(j *job.Job) {
+			j.Status = job.Running
+			j.Attempts = attempt
+		})
+
+		ctx, cancel := context.WithTimeout(jobCtx, timeout)
+		req := mux.SetURLVars(template.WithContext(ctx), vars)
+		resp, responseBody, err = execute(ctx, req, body, &http.Client{Transport: transport, Timeout: timeout})
+		cancel()
+		if resp != nil && resp.Body != nil {
+			_ = resp.Body.Close()
+		}
+
+		if attempt > retries || !isRetryable(template.Method, resp, err) {
+			break
+		}
+		lc.Warn(
+			fmt.Sprintf("Attempt %d of command job %s failed, retrying in %s", attempt, id, retryInterval),
+			clients.CorrelationHeader,
+			correlationID)
+
+		select {
+		case <-jobCtx.Done():
+		case <-time.After(retryInterval):
+		}
+		if jobCtx.Err() != nil {
+			err = jobCtx.Err()
+			resp = nil
+			break
+		}
+	}
+
+	jobs.Update(id, func(j *job.Job) {
+		if err != nil {
+			j.Status = job.Failed
+			j.Error = err.Error()
+			if serviceErr, ok := err.(types.ErrServiceClient); ok {
+				j.StatusCode = serviceErr.StatusCode
+			}
+			return
+		}
+
+		j.StatusCode = resp.StatusCode
+		j.ContentType = resp.Header.Get(clients.ContentType)
+		j.Response = responseBody
+		if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+			j.Status = job.Succeeded
+		} else {
+			j.Status = job.Failed
+		}
+	})
+	lc.Debug(fmt.Sprintf("Command job %s completed", id), clients.CorrelationHeader, correlationID)
+}
+
+// isRetryable reports whether the failure is transient and the command may safely be sent again: any failure of the
+// device service or busy device for a GET, which reads only, but for the other methods only the failures where the
+// request was never sent, as the device may have already applied it.
+func isRetryable(method string, resp *http.Response, err error) bool {
+	if notSent(err) {
+		return true
+	}
+	if method != http.MethodGet {
+		return false
+	}
+	if err != nil {
+		switch e := err.(type) {
+		case *url.Error:
+			return true
+		case types.ErrServiceClient:
+			return e.StatusCode >= http.StatusInternalServerError
+		}
+		return false
+	}
+	return resp != nil && resp.StatusCode >= http.StatusInternalServerError
+}
+
+// notSent reports whether the error occurred before the request was sent, i.e. the device was busy, the circuit of
+// the device service was open or its connection couldn't be established.
+func notSent(err error) bool {
+	switch e := err.(type) {
+	case errors.ErrDeviceBusy, errors.ErrServiceUnavailable:
+		return true
+	case *url.Error:
+		var opErr *net.OpError
+		return goErrors.As(e.Err, &opErr) && opErr.Op == "dial"
+	}
+	return false
+}
+
+// parseDuration returns the configured duration, or the fallback when none or an invalid one is configured.
+func parseDuration(value string, fallback time.Duration, lc logger.LoggingClient) time.Duration {
+	if value == "" {
+		return fallback
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		lc.Warn(fmt.Sprintf("Invalid duration '%s' configured, using %s instead", value, fallback))
+		return fallback
+	}
+	return d
+}
+
+func restGetCommandJob(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	jobs *job.Store) {
+
+	ctx := r.Context()
+	id := mux.Vars(r)[v2.Id]
+
+	j, ok := jobs.Get(id)
+	if !ok {
+		message := fmt.Sprintf("command job %s does not exist", id)
+		lc.Debug(message, clients.CorrelationHeader, correlation.FromContext(ctx))
+		utils.WriteHttpHeader(w, ctx, http.StatusNotFound)
+		pkg.Encode(commonDTO.NewBaseResponse("", message, http.StatusNotFound), w, lc)
+		return
+	}
+
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(commandJobResponse{
+		BaseResponse: commonDTO.NewBaseResponse("", "", http.StatusOK),
+		Job:          j,
+	}, w, lc)
+}
@@ -0,0 +1,145 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	commandErrors "github.com/edgexfoundry/edgex-go/internal/core/command/errors"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/job"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/types"
+	v2 "github.com/edgexfoundry/go-mod-core-contracts/v2"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testAsyncConfig = config.AsyncCommandInfo{Timeout: "1s", Retries: 2, RetryInterval: "1ms", JobRetention: "1m"}
+
+func issueTestAsyncCommand(t *testing.T, jobs *job.Store, execute commandExecution) job.Job {
+	req := httptest.NewRequest(http.MethodGet, cmdURI+"/"+deviceId+"/"+COMMAND+"/"+TestCommandId+"?async=true&x=1", nil)
+	req = mux.SetURLVars(req, map[string]string{ID: deviceId, COMMANDID: TestCommandId})
+
+	rr := httptest.NewRecorder()
+	issueAsyncDeviceCommand(rr, req, deviceId, TestCommandId, logger.NewMockClient(), jobs, testAsyncConfig, nil, execute)
+	require.Equal(t, http.StatusAccepted, rr.Code)
+	assert.NotEmpty(t, rr.Header().Get("Location"))
+
+	var accepted job.Job
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&accepted))
+	require.NotEmpty(t, accepted.Id)
+
+	var j job.Job
+	require.Eventually(t, func() bool {
+		j, _ = jobs.Get(accepted.Id)
+		return j.Done()
+	}, 5*time.Second, 5*time.Millisecond)
+	return j
+}
+
+func TestAsyncDeviceCommandRetriesUntilSuccess(t *testing.T) {
+	jobs := job.NewStore(context.Background(), time.Minute)
+	attempts := 0
+	j := issueTestAsyncCommand(t, jobs, func(ctx context.Context, req *http.Request, body string, httpCaller internal.HttpCaller) (*http.Response, string, error) {
+		attempts++
+		assert.Equal(t, "x=1", req.URL.RawQuery, "async parameter should not be forwarded")
+		assert.Equal(t, deviceId, mux.Vars(req)[ID])
+		if attempts == 1 {
+			return nil, "", &url.Error{Op: "Get", URL: "http://localhost:49990", Err: errors.New("connection refused")}
+		}
+		resp := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{clients.ContentType: []string{clients.ContentTypeJSON}},
+			Body:       ioutil.NopCloser(strings.NewReader("")),
+		}
+		return resp, `{"value":1}`, nil
+	})
+
+	assert.Equal(t, job.Succeeded, j.Status)
+	assert.Equal(t, 2, j.Attempts)
+	assert.Equal(t, http.StatusOK, j.StatusCode)
+	assert.Equal(t, `{"value":1}`, j.Response)
+}
+
+func TestAsyncDeviceCommandDoesNotRetryClientErrors(t *testing.T) {
+	jobs := job.NewStore(context.Background(), time.Minute)
+	j := issueTestAsyncCommand(t, jobs, func(ctx context.Context, req *http.Request, body string, httpCaller internal.HttpCaller) (*http.Response, string, error) {
+		return nil, "", types.NewErrServiceClient(http.StatusNotFound, []byte("device not found"))
+	})
+
+	assert.Equal(t, job.Failed, j.Status)
+	assert.Equal(t, 1, j.Attempts)
+	assert.Equal(t, http.StatusNotFound, j.StatusCode)
+}
+
+func TestIsRetryable(t *testing.T) {
+	refused := &url.Error{Op: "Put", URL: "http://localhost:49990", Err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}}
+	reset := &url.Error{Op: "Put", URL: "http://localhost:49990", Err: &net.OpError{Op: "read", Err: errors.New("connection reset by peer")}}
+	failed := &http.Response{StatusCode: http.StatusInternalServerError}
+
+	tests := []struct {
+		name     string
+		method   string
+		resp     *http.Response
+		err      error
+		expected bool
+	}{
+		{"GET not sent", http.MethodGet, nil, refused, true},
+		{"GET connection lost", http.MethodGet, nil, reset, true},
+		{"GET server error", http.MethodGet, nil, types.NewErrServiceClient(http.StatusBadGateway, nil), true},
+		{"GET failed", http.MethodGet, failed, nil, true},
+		{"GET not found", http.MethodGet, nil, types.NewErrServiceClient(http.StatusNotFound, nil), false},
+		{"PUT not sent", http.MethodPut, nil, refused, true},
+		{"PUT device busy", http.MethodPut, nil, commandErrors.NewErrDeviceBusy(deviceId, "busy"), true},
+		{"PUT circuit open", http.MethodPut, nil, commandErrors.NewErrServiceUnavailable("device-virtual", time.Second), true},
+		{"PUT connection lost", http.MethodPut, nil, reset, false},
+		{"PUT server error", http.MethodPut, nil, types.NewErrServiceClient(http.StatusBadGateway, nil), false},
+		{"PUT failed", http.MethodPut, failed, nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, isRetryable(tt.method, tt.resp, tt.err))
+		})
+	}
+}
+
+func TestRestGetCommandJob(t *testing.T) {
+	jobs := job.NewStore(context.Background(), time.Minute)
+	j := jobs.Add(deviceId, TestCommandId, http.MethodGet)
+
+	tests := []struct {
+		name           string
+		id             string
+		expectedStatus int
+	}{
+		{"found", j.Id, http.StatusOK},
+		{"not found", "unknown", http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/api/v2/command/job/"+tt.id, nil), map[string]string{v2.Id: tt.id})
+			rr := httptest.NewRecorder()
+			restGetCommandJob(rr, req, logger.NewMockClient(), jobs)
+			require.Equal(t, tt.expectedStatus, rr.Code)
+		})
+	}
+}
@@ -0,0 +1,255 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/breaker"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/cache"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/job"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/persist"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/schedule"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/simulator"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/throttle"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/constant"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/utils"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/metadata"
+	v2 "github.com/edgexfoundry/go-mod-core-contracts/v2"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+
+	"github.com/gorilla/mux"
+)
+
+// scheduledCommandResponse is the response of the scheduled command APIs returning a single command.
+type scheduledCommandResponse struct {
+	commonDTO.BaseResponse `json:",inline"`
+	ScheduledCommand       schedule.ScheduledCommand `json:"scheduledCommand"`
+}
+
+// scheduledCommandsResponse is the response of the scheduled command API returning the pending commands.
+type scheduledCommandsResponse struct {
+	commonDTO.BaseResponse `json:",inline"`
+	ScheduledCommands      []schedule.ScheduledCommand `json:"scheduledCommands"`
+}
+
+// isScheduledRequest matches the command requests deferred with the executeAt or cron query parameter.
+func isScheduledRequest(r *http.Request, _ *mux.RouteMatch) bool {
+	query := r.URL.Query()
+	return query.Get(EXECUTEAT) != "" || query.Get(CRON) != ""
+}
+
+func restScheduleDeviceCommandByCommandID(
+	w http.ResponseWriter,
+	originalRequest *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	deviceClient metadata.DeviceClient,
+	commandThrottle *throttle.Throttle,
+	commandCache *cache.Cache,
+	commandBreaker *breaker.Breaker,
+	deviceSimulator *simulator.Simulator,
+	eventPersister *persist.Persister,
+	jobs *job.Store,
+	scheduler *schedule.Scheduler,
+	asyncConfig config.AsyncCommandInfo,
+	transport http.RoundTripper) {
+
+	vars := mux.Vars(originalRequest)
+	scheduleDeviceCommand(w, originalRequest, vars[ID], vars[COMMANDID], lc, jobs, scheduler, asyncConfig, transport,
+		func(ctx context.Context, req *http.Request, body string, httpCaller internal.HttpCaller) (*http.Response, string, error) {
+			return executeCommandByDeviceID(req, body, commandDeps{
+				lc:              lc,
+				dbClient:        dbClient,
+				deviceClient:    deviceClient,
+				commandThrottle: commandThrottle,
+				commandCache:    commandCache,
+				commandBreaker:  commandBreaker,
+				deviceSimulator: deviceSimulator,
+				eventPersister:  eventPersister,
+				httpCaller:      httpCaller,
+			})
+		})
+}
+
+func restScheduleDeviceCommandByNames(
+	w http.ResponseWriter,
+	originalRequest *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	deviceClient metadata.DeviceClient,
+	commandThrottle *throttle.Throttle,
+	commandCache *cache.Cache,
+	commandBreaker *breaker.Breaker,
+	deviceSimulator *simulator.Simulator,
+	eventPersister *persist.Persister,
+	jobs *job.Store,
+	scheduler *schedule.Scheduler,
+	asyncConfig config.AsyncCommandInfo,
+	transport http.RoundTripper) {
+
+	vars := mux.Vars(originalRequest)
+	dn := vars[NAME]
+	cn := vars[COMMANDNAME]
+	scheduleDeviceCommand(w, originalRequest, dn, cn, lc, jobs, scheduler, asyncConfig, transport,
+		func(ctx context.Context, req *http.Request, body string, httpCaller internal.HttpCaller) (*http.Response, string, error) {
+			return executeCommandByName(req, ctx, dn, cn, body, commandDeps{
+				lc:              lc,
+				dbClient:        dbClient,
+				deviceClient:    deviceClient,
+				commandThrottle: commandThrottle,
+				commandCache:    commandCache,
+				commandBreaker:  commandBreaker,
+				deviceSimulator: deviceSimulator,
+				eventPersister:  eventPersister,
+				httpCaller:      httpCaller,
+			})
+		})
+}
+
+// scheduleDeviceCommand defers the command to the time given by the executeAt query parameter, in milliseconds since
+// the epoch, or to the times described by the cron query parameter. Every execution of the command is tracked by a
+// job, like the commands executed asynchronously.
+func scheduleDeviceCommand(
+	w http.ResponseWriter,
+	originalRequest *http.Request,
+	device string,
+	command string,
+	lc logger.LoggingClient,
+	jobs *job.Store,
+	scheduler *schedule.Scheduler,
+	asyncConfig config.AsyncCommandInfo,
+	transport http.RoundTripper,
+	execute commandExecution) {
+
+	defer originalRequest.Body.Close()
+
+	ctx := originalRequest.Context()
+	correlationID := correlation.FromContext(ctx)
+
+	b, err := ioutil.ReadAll(originalRequest.Body)
+	if err != nil {
+		lc.Error(err.Error(), clients.CorrelationHeader, correlationID)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	query := originalRequest.URL.Query()
+	var executeAt int64
+	if value := query.Get(EXECUTEAT); value != "" {
+		executeAt, err = strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			err = fmt.Errorf("invalid %s '%s', milliseconds since the epoch expected", EXECUTEAT, value)
+		}
+	}
+
+	var sc schedule.ScheduledCommand
+	if err == nil {
+		template := detachRequest(originalRequest, ASYNC, EXECUTEAT, CRON)
+		vars := mux.Vars(originalRequest)
+		body := string(b)
+		sc, err = scheduler.Add(device, command, originalRequest.Method, executeAt, query.Get(CRON), func() string {
+			j := startCommandJob(device, command, template, vars, body, correlationID, lc, jobs, asyncConfig, transport, execute)
+			lc.Info(
+				fmt.Sprintf("Dispatched scheduled %s command %s of device %s as job %s", j.Method, command, device, j.Id),
+				clients.CorrelationHeader,
+				correlationID)
+			return j.Id
+		})
+	}
+	if err != nil {
+		lc.Debug(err.Error(), clients.CorrelationHeader, correlationID)
+		utils.WriteHttpHeader(w, ctx, http.StatusBadRequest)
+		pkg.Encode(commonDTO.NewBaseResponse("", err.Error(), http.StatusBadRequest), w, lc)
+		return
+	}
+
+	lc.Info(
+		fmt.Sprintf("Scheduled %s command %s of device %s as %s", sc.Method, command, device, sc.Id),
+		clients.CorrelationHeader,
+		correlationID)
+
+	w.Header().Set("Location", strings.Replace(constant.ApiScheduledCommandByIdRoute, "{"+v2.Id+"}", sc.Id, 1))
+	utils.WriteHttpHeader(w, ctx, http.StatusAccepted)
+	pkg.Encode(scheduledCommandResponse{
+		BaseResponse:     commonDTO.NewBaseResponse("", "", http.StatusAccepted),
+		ScheduledCommand: sc,
+	}, w, lc)
+}
+
+func restGetScheduledCommands(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	scheduler *schedule.Scheduler) {
+
+	utils.WriteHttpHeader(w, r.Context(), http.StatusOK)
+	pkg.Encode(scheduledCommandsResponse{
+		BaseResponse:      commonDTO.NewBaseResponse("", "", http.StatusOK),
+		ScheduledCommands: scheduler.All(),
+	}, w, lc)
+}
+
+func restGetScheduledCommand(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	scheduler *schedule.Scheduler) {
+
+	ctx := r.Context()
+	id := mux.Vars(r)[v2.Id]
+
+	sc, ok := scheduler.Get(id)
+	if !ok {
+		writeScheduledCommandNotFound(w, ctx, id, lc)
+		return
+	}
+
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(scheduledCommandResponse{
+		BaseResponse:     commonDTO.NewBaseResponse("", "", http.StatusOK),
+		ScheduledCommand: sc,
+	}, w, lc)
+}
+
+func restCancelScheduledCommand(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	scheduler *schedule.Scheduler) {
+
+	ctx := r.Context()
+	id := mux.Vars(r)[v2.Id]
+
+	if !scheduler.Cancel(id) {
+		writeScheduledCommandNotFound(w, ctx, id, lc)
+		return
+	}
+	lc.Info(fmt.Sprintf("Cancelled scheduled command %s", id), clients.CorrelationHeader, correlation.FromContext(ctx))
+
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(commonDTO.NewBaseResponse("", "", http.StatusOK), w, lc)
+}
+
+func writeScheduledCommandNotFound(w http.ResponseWriter, ctx context.Context, id string, lc logger.LoggingClient) {
+	message := fmt.Sprintf("scheduled command %s does not exist", id)
+	lc.Debug(message, clients.CorrelationHeader, correlation.FromContext(ctx))
+	utils.WriteHttpHeader(w, ctx, http.StatusNotFound)
+	pkg.Encode(commonDTO.NewBaseResponse("", message, http.StatusNotFound), w, lc)
+}
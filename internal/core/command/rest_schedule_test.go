@@ -0,0 +1,138 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/job"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/schedule"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	v2 "github.com/edgexfoundry/go-mod-core-contracts/v2"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func scheduleTestCommand(
+	t *testing.T,
+	query string,
+	jobs *job.Store,
+	scheduler *schedule.Scheduler,
+	execute commandExecution) *httptest.ResponseRecorder {
+
+	target := cmdURI + "/" + deviceId + "/" + COMMAND + "/" + TestCommandId + "?" + query
+	req := httptest.NewRequest(http.MethodPut, target, strings.NewReader(`{"speed":"10"}`))
+	req = mux.SetURLVars(req, map[string]string{ID: deviceId, COMMANDID: TestCommandId})
+
+	rr := httptest.NewRecorder()
+	scheduleDeviceCommand(rr, req, deviceId, TestCommandId, logger.NewMockClient(), jobs, scheduler, testAsyncConfig, nil, execute)
+	return rr
+}
+
+func TestScheduleDeviceCommand(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	jobs := job.NewStore(ctx, time.Minute)
+	scheduler := schedule.NewScheduler()
+	go scheduler.Run(ctx)
+
+	executed := make(chan string, 1)
+	execute := func(ctx context.Context, req *http.Request, body string, httpCaller internal.HttpCaller) (*http.Response, string, error) {
+		assert.Equal(t, "x=1", req.URL.RawQuery, "schedule parameters should not be forwarded")
+		executed <- body
+		return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(""))}, "", nil
+	}
+
+	executeAt := time.Now().Add(50*time.Millisecond).UnixNano() / int64(time.Millisecond)
+	rr := scheduleTestCommand(t, fmt.Sprintf("%s=%d&x=1", EXECUTEAT, executeAt), jobs, scheduler, execute)
+	require.Equal(t, http.StatusAccepted, rr.Code)
+	assert.NotEmpty(t, rr.Header().Get("Location"))
+
+	var res scheduledCommandResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&res))
+	assert.Equal(t, executeAt, res.ScheduledCommand.NextRun)
+	assert.Equal(t, http.MethodPut, res.ScheduledCommand.Method)
+
+	select {
+	case body := <-executed:
+		assert.Equal(t, `{"speed":"10"}`, body)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "scheduled command was not executed")
+	}
+	assert.Empty(t, scheduler.All())
+}
+
+func TestScheduleDeviceCommandInvalidParameters(t *testing.T) {
+	jobs := job.NewStore(context.Background(), time.Minute)
+	scheduler := schedule.NewScheduler()
+	execute := func(ctx context.Context, req *http.Request, body string, httpCaller internal.HttpCaller) (*http.Response, string, error) {
+		return nil, "", nil
+	}
+
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{"executeAt not a number", EXECUTEAT + "=tomorrow"},
+		{"executeAt in the past", EXECUTEAT + "=1000"},
+		{"invalid cron", CRON + "=often"},
+		{"both executeAt and cron", EXECUTEAT + "=99999999999999&" + CRON + "=@hourly"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := scheduleTestCommand(t, tt.query, jobs, scheduler, execute)
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+		})
+	}
+	assert.Empty(t, scheduler.All())
+}
+
+func TestRestScheduledCommands(t *testing.T) {
+	scheduler := schedule.NewScheduler()
+	sc, err := scheduler.Add(deviceId, TestCommandId, http.MethodGet, 0, "@daily", func() string { return "" })
+	require.NoError(t, err)
+	lc := logger.NewMockClient()
+
+	rr := httptest.NewRecorder()
+	restGetScheduledCommands(rr, httptest.NewRequest(http.MethodGet, "/api/v2/command/scheduled", nil), lc, scheduler)
+	require.Equal(t, http.StatusOK, rr.Code)
+	var res scheduledCommandsResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&res))
+	require.Len(t, res.ScheduledCommands, 1)
+	assert.Equal(t, sc.Id, res.ScheduledCommands[0].Id)
+
+	tests := []struct {
+		name           string
+		handler        func(http.ResponseWriter, *http.Request, logger.LoggingClient, *schedule.Scheduler)
+		id             string
+		expectedStatus int
+	}{
+		{"get found", restGetScheduledCommand, sc.Id, http.StatusOK},
+		{"get not found", restGetScheduledCommand, "unknown", http.StatusNotFound},
+		{"cancel found", restCancelScheduledCommand, sc.Id, http.StatusOK},
+		{"cancel already cancelled", restCancelScheduledCommand, sc.Id, http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/api/v2/command/scheduled/"+tt.id, nil), map[string]string{v2.Id: tt.id})
+			rr := httptest.NewRecorder()
+			tt.handler(rr, req, lc, scheduler)
+			require.Equal(t, tt.expectedStatus, rr.Code)
+		})
+	}
+}
@@ -0,0 +1,103 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/cache"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/simulator"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/errorconcept"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/metadata"
+
+	"github.com/gorilla/mux"
+)
+
+// simulationRequest toggles the simulation of a device.
+type simulationRequest struct {
+	Enabled *bool `json:"enabled"`
+}
+
+// simulationStatus tells whether the commands of a device are simulated.
+type simulationStatus struct {
+	Device  string `json:"device"`
+	Enabled bool   `json:"enabled"`
+}
+
+// Get whether the commands of the device with {name} are simulated
+// api/v1/device/name/{name}/simulation
+func restGetDeviceSimulation(
+	w http.ResponseWriter,
+	r *http.Request,
+	deviceClient metadata.DeviceClient,
+	deviceSimulator *simulator.Simulator,
+	httpErrorHandler errorconcept.ErrorHandler) {
+
+	d, err := deviceClient.DeviceForName(r.Context(), mux.Vars(r)[NAME])
+	if err != nil {
+		httpErrorHandler.HandleManyVariants(
+			w,
+			err,
+			[]errorconcept.ErrorConceptType{
+				errorconcept.NewServiceClientHttpError(err),
+			},
+			errorconcept.Default.InternalServerError)
+		return
+	}
+
+	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(simulationStatus{Device: d.Name, Enabled: deviceSimulator.Enabled(d)})
+}
+
+// Enable or disable the simulation of the commands of the device with {name}, whatever its labels
+// api/v1/device/name/{name}/simulation
+func restUpdateDeviceSimulation(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	deviceClient metadata.DeviceClient,
+	commandCache *cache.Cache,
+	deviceSimulator *simulator.Simulator,
+	httpErrorHandler errorconcept.ErrorHandler) {
+
+	defer r.Body.Close()
+
+	var request simulationRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil || request.Enabled == nil {
+		httpErrorHandler.Handle(
+			w,
+			fmt.Errorf("the body must be a JSON object with the enabled field"),
+			errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return
+	}
+
+	d, err := deviceClient.DeviceForName(r.Context(), mux.Vars(r)[NAME])
+	if err != nil {
+		httpErrorHandler.HandleManyVariants(
+			w,
+			err,
+			[]errorconcept.ErrorConceptType{
+				errorconcept.NewServiceClientHttpError(err),
+			},
+			errorconcept.Default.InternalServerError)
+		return
+	}
+
+	deviceSimulator.SetEnabled(d.Name, *request.Enabled)
+	// the cached readings come from the device service, or from the simulator, which no longer answers the commands
+	commandCache.InvalidateDevice(d.Id)
+	lc.Info(fmt.Sprintf("Simulation of the commands of device %s enabled: %t", d.Name, *request.Enabled))
+
+	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(simulationStatus{Device: d.Name, Enabled: *request.Enabled})
+}
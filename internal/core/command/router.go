@@ -19,10 +19,13 @@ import (
 
 	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
-	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
 	errorContainer "github.com/edgexfoundry/edgex-go/internal/pkg/container"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dependency"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/mtls"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/secretref"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/telemetry"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/constant"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/di"
@@ -36,16 +39,19 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 	// Ping Resource
 	r.HandleFunc(
 		clients.ApiPingRoute,
-		func(w http.ResponseWriter, _ *http.Request) {
-			w.Header().Set(clients.ContentType, clients.ContentTypeText)
-			_, _ = w.Write([]byte("pong"))
+		func(w http.ResponseWriter, r *http.Request) {
+			dependency.Ping(
+				w,
+				r,
+				errorContainer.DependencyCheckerFrom(dic.Get),
+				bootstrapContainer.LoggingClientFrom(dic.Get))
 		}).Methods(http.MethodGet)
 
 	// Configuration
 	r.HandleFunc(
 		clients.ApiConfigRoute,
 		func(w http.ResponseWriter, _ *http.Request) {
-			pkg.Encode(commandContainer.ConfigurationFrom(dic.Get), w, bootstrapContainer.LoggingClientFrom(dic.Get))
+			secretref.EncodeConfiguration(commandContainer.ConfigurationFrom(dic.Get), w, dic)
 		}).Methods(http.MethodGet)
 
 	// Metrics
@@ -58,9 +64,61 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 	// Version
 	r.HandleFunc(clients.ApiVersionRoute, pkg.VersionHandler).Methods(http.MethodGet)
 
+	// Command Jobs
+	r.HandleFunc(
+		constant.ApiCommandJobByIdRoute,
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetCommandJob(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				commandContainer.JobStoreFrom(dic.Get))
+		}).Methods(http.MethodGet)
+
+	// Command Descriptions
+	r.HandleFunc(
+		constant.ApiDeviceCommandsByNameRoute,
+		func(w http.ResponseWriter, r *http.Request) {
+			restDescribeDeviceCommandsByName(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				commandContainer.MetadataDeviceClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
+
+	// Scheduled Commands
+	r.HandleFunc(
+		constant.ApiScheduledCommandRoute,
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetScheduledCommands(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				commandContainer.SchedulerFrom(dic.Get))
+		}).Methods(http.MethodGet)
+	r.HandleFunc(
+		constant.ApiScheduledCommandByIdRoute,
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetScheduledCommand(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				commandContainer.SchedulerFrom(dic.Get))
+		}).Methods(http.MethodGet)
+	r.HandleFunc(
+		constant.ApiScheduledCommandByIdRoute,
+		func(w http.ResponseWriter, r *http.Request) {
+			restCancelScheduledCommand(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				commandContainer.SchedulerFrom(dic.Get))
+		}).Methods(http.MethodDelete)
+
 	b := r.PathPrefix(clients.ApiBase).Subrouter()
 
 	loadDeviceRoutes(b, dic)
+	loadHistoryRoutes(b, dic)
 
 	r.Use(correlation.ManageHeader)
 	r.Use(correlation.OnResponseComplete)
@@ -74,7 +132,7 @@ func loadDeviceRoutes(b *mux.Router, dic *di.Container) {
 			restGetAllCommands(
 				w,
 				r,
-				container.DBClientFrom(dic.Get),
+				commandContainer.DBClientFrom(dic.Get),
 				commandContainer.MetadataDeviceClientFrom(dic.Get),
 				commandContainer.ConfigurationFrom(dic.Get),
 				errorContainer.ErrorHandlerFrom(dic.Get))
@@ -89,11 +147,50 @@ func loadDeviceRoutes(b *mux.Router, dic *di.Container) {
 			restGetCommandsByDeviceID(
 				w,
 				r,
-				container.DBClientFrom(dic.Get),
+				commandContainer.DBClientFrom(dic.Get),
 				commandContainer.MetadataDeviceClientFrom(dic.Get),
 				commandContainer.ConfigurationFrom(dic.Get),
 				errorContainer.ErrorHandlerFrom(dic.Get))
 		}).Methods(http.MethodGet)
+	// Deferred execution is requested with the executeAt or cron query parameter, asynchronous execution with the
+	// async query parameter; these routes must precede the others.
+	d.HandleFunc(
+		"/{"+ID+"}/"+COMMAND+"/{"+COMMANDID+"}",
+		func(w http.ResponseWriter, r *http.Request) {
+			restScheduleDeviceCommandByCommandID(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				commandContainer.DBClientFrom(dic.Get),
+				commandContainer.MetadataDeviceClientFrom(dic.Get),
+				commandContainer.ThrottleFrom(dic.Get),
+				commandContainer.CommandCacheFrom(dic.Get),
+				commandContainer.BreakerFrom(dic.Get),
+				commandContainer.SimulatorFrom(dic.Get),
+				commandContainer.PersisterFrom(dic.Get),
+				commandContainer.JobStoreFrom(dic.Get),
+				commandContainer.SchedulerFrom(dic.Get),
+				commandContainer.ConfigurationFrom(dic.Get).AsyncCommand,
+				mtls.CertificatesFrom(dic.Get).Transport())
+		}).Methods(http.MethodGet, http.MethodPut).MatcherFunc(isScheduledRequest)
+	d.HandleFunc(
+		"/{"+ID+"}/"+COMMAND+"/{"+COMMANDID+"}",
+		func(w http.ResponseWriter, r *http.Request) {
+			restAsyncDeviceCommandByCommandID(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				commandContainer.DBClientFrom(dic.Get),
+				commandContainer.MetadataDeviceClientFrom(dic.Get),
+				commandContainer.ThrottleFrom(dic.Get),
+				commandContainer.CommandCacheFrom(dic.Get),
+				commandContainer.BreakerFrom(dic.Get),
+				commandContainer.SimulatorFrom(dic.Get),
+				commandContainer.PersisterFrom(dic.Get),
+				commandContainer.JobStoreFrom(dic.Get),
+				commandContainer.ConfigurationFrom(dic.Get).AsyncCommand,
+				mtls.CertificatesFrom(dic.Get).Transport())
+		}).Methods(http.MethodGet, http.MethodPut).Queries(ASYNC, "true")
 	d.HandleFunc(
 		"/{"+ID+"}/"+COMMAND+"/{"+COMMANDID+"}",
 		func(w http.ResponseWriter, r *http.Request) {
@@ -101,10 +198,16 @@ func loadDeviceRoutes(b *mux.Router, dic *di.Container) {
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get),
+				commandContainer.DBClientFrom(dic.Get),
 				commandContainer.MetadataDeviceClientFrom(dic.Get),
 				errorContainer.ErrorHandlerFrom(dic.Get),
-				&http.Client{})
+				commandContainer.ThrottleFrom(dic.Get),
+				commandContainer.CommandCacheFrom(dic.Get),
+				commandContainer.BreakerFrom(dic.Get),
+				commandContainer.SimulatorFrom(dic.Get),
+				commandContainer.PersisterFrom(dic.Get),
+				commandContainer.StreamerFrom(dic.Get),
+				mtls.CertificatesFrom(dic.Get).Client(0))
 		}).Methods(http.MethodGet)
 	d.HandleFunc(
 		"/{"+ID+"}/"+COMMAND+"/{"+COMMANDID+"}",
@@ -113,10 +216,16 @@ func loadDeviceRoutes(b *mux.Router, dic *di.Container) {
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get),
+				commandContainer.DBClientFrom(dic.Get),
 				commandContainer.MetadataDeviceClientFrom(dic.Get),
 				errorContainer.ErrorHandlerFrom(dic.Get),
-				&http.Client{})
+				commandContainer.ThrottleFrom(dic.Get),
+				commandContainer.CommandCacheFrom(dic.Get),
+				commandContainer.BreakerFrom(dic.Get),
+				commandContainer.SimulatorFrom(dic.Get),
+				commandContainer.PersisterFrom(dic.Get),
+				commandContainer.StreamerFrom(dic.Get),
+				mtls.CertificatesFrom(dic.Get).Client(0))
 		}).Methods(http.MethodPut)
 	// In the block of code above, as well as in the one that follows below,
 	// there are two references each to http.Client. Putting them into the
@@ -127,7 +236,9 @@ func loadDeviceRoutes(b *mux.Router, dic *di.Container) {
 	// REST handler would be served by a different goroutine. This would create
 	// a situation where each one of them would use the same http.Client instance,
 	// resulting in state divergence, misalignment. So the decision is to not
-	// put this into the DI container(dic).
+	// put this into the DI container(dic). Every invocation still gets its own
+	// http.Client, only sharing the transport presenting the certificate of the
+	// service when mutual TLS is enabled.
 
 	// /api/<version>/device/name
 	dn := d.PathPrefix("/" + NAME).Subrouter()
@@ -138,12 +249,71 @@ func loadDeviceRoutes(b *mux.Router, dic *di.Container) {
 			restGetCommandsByDeviceName(
 				w,
 				r,
-				container.DBClientFrom(dic.Get),
+				commandContainer.DBClientFrom(dic.Get),
 				commandContainer.MetadataDeviceClientFrom(dic.Get),
 				commandContainer.ConfigurationFrom(dic.Get),
 				errorContainer.ErrorHandlerFrom(dic.Get),
 			)
 		}).Methods(http.MethodGet)
+	dn.HandleFunc(
+		"/{"+NAME+"}/"+SIMULATION,
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetDeviceSimulation(
+				w,
+				r,
+				commandContainer.MetadataDeviceClientFrom(dic.Get),
+				commandContainer.SimulatorFrom(dic.Get),
+				errorContainer.ErrorHandlerFrom(dic.Get))
+		}).Methods(http.MethodGet)
+	dn.HandleFunc(
+		"/{"+NAME+"}/"+SIMULATION,
+		func(w http.ResponseWriter, r *http.Request) {
+			restUpdateDeviceSimulation(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				commandContainer.MetadataDeviceClientFrom(dic.Get),
+				commandContainer.CommandCacheFrom(dic.Get),
+				commandContainer.SimulatorFrom(dic.Get),
+				errorContainer.ErrorHandlerFrom(dic.Get))
+		}).Methods(http.MethodPut)
+	dn.HandleFunc(
+		"/{"+NAME+"}/"+COMMAND+"/{"+COMMANDNAME+"}",
+		func(w http.ResponseWriter, r *http.Request) {
+			restScheduleDeviceCommandByNames(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				commandContainer.DBClientFrom(dic.Get),
+				commandContainer.MetadataDeviceClientFrom(dic.Get),
+				commandContainer.ThrottleFrom(dic.Get),
+				commandContainer.CommandCacheFrom(dic.Get),
+				commandContainer.BreakerFrom(dic.Get),
+				commandContainer.SimulatorFrom(dic.Get),
+				commandContainer.PersisterFrom(dic.Get),
+				commandContainer.JobStoreFrom(dic.Get),
+				commandContainer.SchedulerFrom(dic.Get),
+				commandContainer.ConfigurationFrom(dic.Get).AsyncCommand,
+				mtls.CertificatesFrom(dic.Get).Transport())
+		}).Methods(http.MethodGet, http.MethodPut).MatcherFunc(isScheduledRequest)
+	dn.HandleFunc(
+		"/{"+NAME+"}/"+COMMAND+"/{"+COMMANDNAME+"}",
+		func(w http.ResponseWriter, r *http.Request) {
+			restAsyncDeviceCommandByNames(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				commandContainer.DBClientFrom(dic.Get),
+				commandContainer.MetadataDeviceClientFrom(dic.Get),
+				commandContainer.ThrottleFrom(dic.Get),
+				commandContainer.CommandCacheFrom(dic.Get),
+				commandContainer.BreakerFrom(dic.Get),
+				commandContainer.SimulatorFrom(dic.Get),
+				commandContainer.PersisterFrom(dic.Get),
+				commandContainer.JobStoreFrom(dic.Get),
+				commandContainer.ConfigurationFrom(dic.Get).AsyncCommand,
+				mtls.CertificatesFrom(dic.Get).Transport())
+		}).Methods(http.MethodGet, http.MethodPut).Queries(ASYNC, "true")
 	dn.HandleFunc(
 		"/{"+NAME+"}/"+COMMAND+"/{"+COMMANDNAME+"}",
 		func(w http.ResponseWriter, r *http.Request) {
@@ -151,10 +321,16 @@ func loadDeviceRoutes(b *mux.Router, dic *di.Container) {
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get),
+				commandContainer.DBClientFrom(dic.Get),
 				commandContainer.MetadataDeviceClientFrom(dic.Get),
 				errorContainer.ErrorHandlerFrom(dic.Get),
-				&http.Client{})
+				commandContainer.ThrottleFrom(dic.Get),
+				commandContainer.CommandCacheFrom(dic.Get),
+				commandContainer.BreakerFrom(dic.Get),
+				commandContainer.SimulatorFrom(dic.Get),
+				commandContainer.PersisterFrom(dic.Get),
+				commandContainer.StreamerFrom(dic.Get),
+				mtls.CertificatesFrom(dic.Get).Client(0))
 		}).Methods(http.MethodGet)
 	dn.HandleFunc(
 		"/{"+NAME+"}/"+COMMAND+"/{"+COMMANDNAME+"}",
@@ -163,9 +339,54 @@ func loadDeviceRoutes(b *mux.Router, dic *di.Container) {
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get),
+				commandContainer.DBClientFrom(dic.Get),
 				commandContainer.MetadataDeviceClientFrom(dic.Get),
 				errorContainer.ErrorHandlerFrom(dic.Get),
-				&http.Client{})
+				commandContainer.ThrottleFrom(dic.Get),
+				commandContainer.CommandCacheFrom(dic.Get),
+				commandContainer.BreakerFrom(dic.Get),
+				commandContainer.SimulatorFrom(dic.Get),
+				commandContainer.PersisterFrom(dic.Get),
+				commandContainer.StreamerFrom(dic.Get),
+				mtls.CertificatesFrom(dic.Get).Client(0))
 		}).Methods(http.MethodPut)
 }
+
+func loadHistoryRoutes(b *mux.Router, dic *di.Container) {
+	h := b.PathPrefix("/" + HISTORY).Subrouter()
+
+	// /api/<version>/history
+	h.HandleFunc(
+		"/{"+START+":[0-9]+}/{"+END+":[0-9]+}/{"+LIMIT+":[0-9]+}",
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetCommandHistory(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				commandContainer.DBClientFrom(dic.Get),
+				errorContainer.ErrorHandlerFrom(dic.Get),
+				commandContainer.ConfigurationFrom(dic.Get))
+		}).Methods(http.MethodGet)
+	h.HandleFunc(
+		"/"+DEVICE+"/"+NAME+"/{"+NAME+"}/{"+START+":[0-9]+}/{"+END+":[0-9]+}/{"+LIMIT+":[0-9]+}",
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetCommandHistoryByDeviceName(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				commandContainer.DBClientFrom(dic.Get),
+				errorContainer.ErrorHandlerFrom(dic.Get),
+				commandContainer.ConfigurationFrom(dic.Get))
+		}).Methods(http.MethodGet)
+	h.HandleFunc(
+		"/"+DEVICE+"/{"+ID+"}/{"+START+":[0-9]+}/{"+END+":[0-9]+}/{"+LIMIT+":[0-9]+}",
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetCommandHistoryByDeviceId(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				commandContainer.DBClientFrom(dic.Get),
+				errorContainer.ErrorHandlerFrom(dic.Get),
+				commandContainer.ConfigurationFrom(dic.Get))
+		}).Methods(http.MethodGet)
+}
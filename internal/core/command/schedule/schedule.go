@@ -0,0 +1,245 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package schedule keeps track of the commands whose execution is deferred by core-command, either to a point in time
+// or to the times described by a cron expression, and dispatches them when they are due.
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+
+	"github.com/google/uuid"
+	"github.com/robfig/cron"
+)
+
+// ScheduledCommand describes a command whose execution is deferred.
+type ScheduledCommand struct {
+	Id      string `json:"id"`
+	Device  string `json:"device"`
+	Command string `json:"command"`
+	Method  string `json:"method"`
+	// ExecuteAt is the time, in milliseconds since the epoch, at which a one-off command is executed
+	ExecuteAt int64 `json:"executeAt,omitempty"`
+	// Cron is the cron expression describing when a recurring command is executed
+	Cron string `json:"cron,omitempty"`
+	// NextRun is the time, in milliseconds since the epoch, of the next execution of the command
+	NextRun int64 `json:"nextRun"`
+	Runs    int   `json:"runs"`
+	// LastJobId is the id of the job tracking the latest execution of the command
+	LastJobId string `json:"lastJobId,omitempty"`
+	Created   int64  `json:"created"`
+}
+
+// Dispatch executes a due command and returns the id of the job tracking the execution.
+type Dispatch func() string
+
+type entry struct {
+	command  ScheduledCommand
+	schedule cron.Schedule
+	dispatch Dispatch
+}
+
+// Scheduler dispatches the scheduled commands when they are due.
+type Scheduler struct {
+	mutex   sync.Mutex
+	entries map[string]*entry
+	wake    chan struct{}
+}
+
+// NewScheduler creates a Scheduler; the commands are only dispatched once Run is called.
+func NewScheduler() *Scheduler {
+	return &Scheduler{
+		entries: make(map[string]*entry),
+		wake:    make(chan struct{}, 1),
+	}
+}
+
+// Add schedules the command for a single execution at executeAt, in milliseconds since the epoch, or, when
+// cronSpec is not empty, for recurring executions at the times described by the cron expression.
+func (s *Scheduler) Add(
+	device string,
+	command string,
+	method string,
+	executeAt int64,
+	cronSpec string,
+	dispatch Dispatch) (ScheduledCommand, error) {
+
+	now := time.Now()
+	e := &entry{
+		command: ScheduledCommand{
+			Id:      uuid.New().String(),
+			Device:  device,
+			Command: command,
+			Method:  method,
+			Created: db.MakeTimestamp(),
+		},
+		dispatch: dispatch,
+	}
+
+	switch {
+	case cronSpec != "" && executeAt != 0:
+		return ScheduledCommand{}, errors.New("a command cannot be scheduled with both a time and a cron expression")
+	case cronSpec != "":
+		schedule, err := cron.Parse(cronSpec)
+		if err != nil {
+			return ScheduledCommand{}, fmt.Errorf("invalid cron expression '%s': %s", cronSpec, err.Error())
+		}
+		next := schedule.Next(now)
+		if next.IsZero() {
+			return ScheduledCommand{}, fmt.Errorf("cron expression '%s' never matches", cronSpec)
+		}
+		e.schedule = schedule
+		e.command.Cron = cronSpec
+		e.command.NextRun = toMillis(next)
+	case executeAt <= toMillis(now):
+		return ScheduledCommand{}, fmt.Errorf("execution time %d is not in the future", executeAt)
+	default:
+		e.command.ExecuteAt = executeAt
+		e.command.NextRun = executeAt
+	}
+
+	s.mutex.Lock()
+	s.entries[e.command.Id] = e
+	s.mutex.Unlock()
+	s.notify()
+	return e.command, nil
+}
+
+// All returns the pending scheduled commands, ordered by their next execution.
+func (s *Scheduler) All() []ScheduledCommand {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	commands := make([]ScheduledCommand, 0, len(s.entries))
+	for _, e := range s.entries {
+		commands = append(commands, e.command)
+	}
+	sort.Slice(commands, func(i, j int) bool {
+		if commands[i].NextRun == commands[j].NextRun {
+			return commands[i].Created < commands[j].Created
+		}
+		return commands[i].NextRun < commands[j].NextRun
+	})
+	return commands
+}
+
+// Get returns the pending scheduled command with the specified id.
+func (s *Scheduler) Get(id string) (ScheduledCommand, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	e, ok := s.entries[id]
+	if !ok {
+		return ScheduledCommand{}, false
+	}
+	return e.command, true
+}
+
+// Cancel removes the pending scheduled command with the specified id and reports whether it existed.
+func (s *Scheduler) Cancel(id string) bool {
+	s.mutex.Lock()
+	_, ok := s.entries[id]
+	delete(s.entries, id)
+	s.mutex.Unlock()
+	if ok {
+		s.notify()
+	}
+	return ok
+}
+
+// Run dispatches the scheduled commands when they are due, until ctx is done.
+func (s *Scheduler) Run(ctx context.Context) {
+	timer := time.NewTimer(time.Hour)
+	defer timer.Stop()
+
+	for {
+		next, ok := s.dispatchDue(time.Now())
+
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		if ok {
+			timer.Reset(time.Until(next))
+		} else {
+			timer.Reset(time.Hour)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.wake:
+		case <-timer.C:
+		}
+	}
+}
+
+// dispatchDue dispatches the commands due at now and returns the time of the next execution, if any is pending.
+func (s *Scheduler) dispatchDue(now time.Time) (time.Time, bool) {
+	s.mutex.Lock()
+	var due []*entry
+	for id, e := range s.entries {
+		if e.command.NextRun > toMillis(now) {
+			continue
+		}
+		due = append(due, e)
+		if e.schedule == nil {
+			delete(s.entries, id)
+			continue
+		}
+		next := e.schedule.Next(now)
+		if next.IsZero() {
+			delete(s.entries, id)
+			continue
+		}
+		e.command.NextRun = toMillis(next)
+	}
+	s.mutex.Unlock()
+
+	// The dispatch happens outside of the lock so that it may use the scheduler.
+	for _, e := range due {
+		jobId := e.dispatch()
+
+		s.mutex.Lock()
+		if pending, ok := s.entries[e.command.Id]; ok {
+			pending.command.Runs++
+			pending.command.LastJobId = jobId
+		}
+		s.mutex.Unlock()
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	var next int64
+	for _, e := range s.entries {
+		if next == 0 || e.command.NextRun < next {
+			next = e.command.NextRun
+		}
+	}
+	if next == 0 {
+		return time.Time{}, false
+	}
+	return time.Unix(0, next*int64(time.Millisecond)), true
+}
+
+// notify wakes Run up so that it takes added or cancelled commands into account.
+func (s *Scheduler) notify() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+func toMillis(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
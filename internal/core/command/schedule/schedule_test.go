@@ -0,0 +1,88 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package schedule
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddValidation(t *testing.T) {
+	s := NewScheduler()
+	dispatch := func() string { return "" }
+	future := toMillis(time.Now().Add(time.Hour))
+
+	_, err := s.Add("device1", "command1", "PUT", future, "0 0 2 * * *", dispatch)
+	assert.Error(t, err, "time and cron expression are exclusive")
+	_, err = s.Add("device1", "command1", "PUT", 0, "not a cron", dispatch)
+	assert.Error(t, err, "invalid cron expression")
+	_, err = s.Add("device1", "command1", "PUT", toMillis(time.Now().Add(-time.Minute)), "", dispatch)
+	assert.Error(t, err, "time in the past")
+
+	later, err := s.Add("device1", "command1", "PUT", future+1000, "", dispatch)
+	require.NoError(t, err)
+	sooner, err := s.Add("device1", "command1", "GET", future, "", dispatch)
+	require.NoError(t, err)
+	recurring, err := s.Add("device1", "command1", "GET", 0, "0 0 2 * * *", dispatch)
+	require.NoError(t, err)
+	assert.Equal(t, "0 0 2 * * *", recurring.Cron)
+	assert.NotZero(t, recurring.NextRun)
+
+	all := s.All()
+	require.Len(t, all, 3)
+	assert.Equal(t, sooner.Id, all[0].Id)
+	assert.Equal(t, later.Id, all[1].Id)
+
+	assert.True(t, s.Cancel(later.Id))
+	assert.False(t, s.Cancel(later.Id))
+	_, ok := s.Get(later.Id)
+	assert.False(t, ok)
+	assert.Len(t, s.All(), 2)
+}
+
+func TestRunDispatchesDueCommands(t *testing.T) {
+	s := NewScheduler()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.Run(ctx)
+
+	var oneOff, recurring, cancelled int32
+	_, err := s.Add("device1", "command1", "PUT", toMillis(time.Now().Add(50*time.Millisecond)), "", func() string {
+		atomic.AddInt32(&oneOff, 1)
+		return "job1"
+	})
+	require.NoError(t, err)
+	every, err := s.Add("device1", "command1", "GET", 0, "@every 1s", func() string {
+		atomic.AddInt32(&recurring, 1)
+		return "job2"
+	})
+	require.NoError(t, err)
+	dropped, err := s.Add("device1", "command1", "PUT", toMillis(time.Now().Add(100*time.Millisecond)), "", func() string {
+		atomic.AddInt32(&cancelled, 1)
+		return "job3"
+	})
+	require.NoError(t, err)
+	require.True(t, s.Cancel(dropped.Id))
+
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt32(&oneOff) == 1 && atomic.LoadInt32(&recurring) >= 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The one-off command is no longer pending once dispatched, the recurring one is rescheduled.
+	all := s.All()
+	require.Len(t, all, 1)
+	assert.Equal(t, every.Id, all[0].Id)
+	assert.Eventually(t, func() bool {
+		c, ok := s.Get(every.Id)
+		return ok && c.Runs >= 1 && c.LastJobId == "job2"
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&cancelled))
+}
@@ -0,0 +1,102 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package command
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+)
+
+// setCommandResult answers a set command for which the device service reported the outcome of each resource written.
+type setCommandResult struct {
+	Status    string                  `json:"status"`
+	Resources []models.ResourceStatus `json:"resources"`
+}
+
+// parseResourceStatuses returns the outcome of each resource written by a set command, or nil if the device service
+// didn't report it. A device service reports it, whatever the status code of its response, with a JSON object listing
+// the resources, e.g. {"resources":[{"resource":"speed","statusCode":200},{"resource":"mode","statusCode":500,
+// "message":"read only"}]}.
+func parseResourceStatuses(body []byte) []models.ResourceStatus {
+	var report struct {
+		Resources []models.ResourceStatus `json:"resources"`
+	}
+	if err := json.Unmarshal(body, &report); err != nil {
+		return nil
+	}
+
+	var resources []models.ResourceStatus
+	for _, r := range report.Resources {
+		if r.Resource != "" && r.StatusCode != 0 {
+			resources = append(resources, r)
+		}
+	}
+	return resources
+}
+
+// setCommandStatus returns whether the device service applied all, some or none of the resources.
+func setCommandStatus(resources []models.ResourceStatus) string {
+	applied := 0
+	for _, r := range resources {
+		if r.Applied() {
+			applied++
+		}
+	}
+
+	switch applied {
+	case len(resources):
+		return SETSUCCEEDED
+	case 0:
+		return SETFAILED
+	default:
+		return SETPARTIAL
+	}
+}
+
+// newSetCommandResponse answers a set command with the outcome of each resource written. A partially applied command
+// is answered with the 207 Multi-Status code, otherwise the status code of the device service is kept.
+func newSetCommandResponse(
+	deviceServiceResponse *http.Response,
+	resources []models.ResourceStatus) (*http.Response, string, error) {
+
+	result := setCommandResult{Status: setCommandStatus(resources), Resources: resources}
+	body, err := json.Marshal(result)
+	if err != nil {
+		return nil, "", err
+	}
+
+	statusCode := deviceServiceResponse.StatusCode
+	if result.Status == SETPARTIAL {
+		statusCode = http.StatusMultiStatus
+	}
+
+	header := deviceServiceResponse.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	header.Set(clients.ContentType, clients.ContentTypeJSON)
+
+	return &http.Response{
+		StatusCode: statusCode,
+		Header:     header,
+		Body:       ioutil.NopCloser(strings.NewReader(string(body))),
+	}, string(body), nil
+}
+
+// commandResponseStatus returns the status code the REST API answers a command with. Responses of the device service
+// are answered with 200 OK, except for partially applied set commands which are reported as such.
+func commandResponseStatus(deviceServiceResponse *http.Response) int {
+	if deviceServiceResponse.StatusCode == http.StatusMultiStatus {
+		return http.StatusMultiStatus
+	}
+	return http.StatusOK
+}
@@ -0,0 +1,98 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	mdMocks "github.com/edgexfoundry/edgex-go/internal/mocks"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseResourceStatuses(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected []models.ResourceStatus
+	}{
+		{"not json", "ok", nil},
+		{"no resources", `{"value":1}`, nil},
+		{
+			"resources",
+			`{"resources":[{"resource":"speed","statusCode":200},{"resource":"mode","statusCode":500,"message":"read only"}]}`,
+			[]models.ResourceStatus{{Resource: "speed", StatusCode: 200}, {Resource: "mode", StatusCode: 500, Message: "read only"}},
+		},
+		{
+			"incomplete entries",
+			`{"resources":[{"resource":"speed"},{"statusCode":200},{"resource":"mode","statusCode":204}]}`,
+			[]models.ResourceStatus{{Resource: "mode", StatusCode: 204}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, parseResourceStatuses([]byte(tt.body)))
+		})
+	}
+}
+
+func TestSetCommandStatus(t *testing.T) {
+	applied := models.ResourceStatus{Resource: "speed", StatusCode: http.StatusOK}
+	failed := models.ResourceStatus{Resource: "mode", StatusCode: http.StatusInternalServerError}
+
+	assert.Equal(t, SETSUCCEEDED, setCommandStatus([]models.ResourceStatus{applied, applied}))
+	assert.Equal(t, SETPARTIAL, setCommandStatus([]models.ResourceStatus{applied, failed}))
+	assert.Equal(t, SETFAILED, setCommandStatus([]models.ResourceStatus{failed, failed}))
+}
+
+func TestExecuteCommandByDevicePartialSet(t *testing.T) {
+	deviceServiceBody := `{"resources":[{"resource":"speed","statusCode":200},{"resource":"mode","statusCode":500,"message":"read only"}]}`
+	httpCaller := &mdMocks.HttpCaller{}
+	httpCaller.On("Do", mock.Anything).Return(&http.Response{
+		StatusCode: http.StatusInternalServerError,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(strings.NewReader(deviceServiceBody)),
+	}, nil)
+	dbClient := &mocks.DBClient{}
+	dbClient.On("AddCommandHistory", mock.Anything).Return("", nil)
+
+	resp, body, err := executeCommandByDevice(
+		context.Background(),
+		unlockedDevice,
+		exampleCommand,
+		`{"speed":"10","mode":"eco"}`,
+		httptest.NewRequest(http.MethodPut, cmdURI, nil),
+		commandDeps{
+			lc:         logger.NewMockClient(),
+			dbClient:   dbClient,
+			httpCaller: httpCaller,
+		})
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusMultiStatus, resp.StatusCode)
+	assert.Equal(t, http.StatusMultiStatus, commandResponseStatus(resp))
+	var result setCommandResult
+	require.NoError(t, json.Unmarshal([]byte(body), &result))
+	assert.Equal(t, SETPARTIAL, result.Status)
+	assert.Len(t, result.Resources, 2)
+
+	history := dbClient.Calls[0].Arguments.Get(0).(models.CommandHistory)
+	assert.Equal(t, http.StatusInternalServerError, history.StatusCode)
+	assert.Equal(t, result.Resources, history.Resources)
+	assert.Equal(t, "1 of 2 resources not applied", history.Error)
+}
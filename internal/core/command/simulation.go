@@ -0,0 +1,67 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package command
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/simulator"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// executeSimulatedCommand answers the command of a simulated device as its device service would, with the event of a
+// read command or an empty body for a set command. The responses are marked with the SIMULATEDHEADER header.
+func executeSimulatedCommand(
+	device contract.Device,
+	command contract.Command,
+	method string,
+	body string,
+	deviceSimulator *simulator.Simulator) (*http.Response, string, error) {
+
+	header := http.Header{}
+	header.Set(SIMULATEDHEADER, "true")
+
+	var responseBody string
+	switch method {
+	case http.MethodGet:
+		event, err := deviceSimulator.Get(device, command)
+		if err != nil {
+			return nil, "", err
+		}
+		b, err := json.Marshal(event)
+		if err != nil {
+			return nil, "", err
+		}
+		header.Set(clients.ContentType, clients.ContentTypeJSON)
+		responseBody = string(b)
+	case http.MethodPut:
+		// the parameters were validated against the profile before the command was executed
+		var parameters map[string]interface{}
+		if strings.TrimSpace(body) != "" {
+			if err := json.Unmarshal([]byte(body), &parameters); err != nil {
+				return nil, "", err
+			}
+		}
+		values := make(map[string]string, len(parameters))
+		for name, parameter := range parameters {
+			values[name] = parameterString(parameter)
+		}
+		if err := deviceSimulator.Set(device, command, values); err != nil {
+			return nil, "", err
+		}
+	}
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     header,
+		Body:       ioutil.NopCloser(strings.NewReader(responseBody)),
+	}, responseBody, nil
+}
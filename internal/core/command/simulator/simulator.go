@@ -0,0 +1,204 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package simulator answers the commands of simulated devices in place of their device service: read commands return
+// values generated from the device profile and set commands are accepted and remembered. Applications can thus be
+// developed against a device before the device, or its device service, is deployed.
+package simulator
+
+import (
+	"fmt"
+	"math/rand"
+	"path"
+	"sort"
+	"sync"
+	"time"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// SimulatedLabel marks, among the labels of a device in metadata, the devices whose commands are simulated.
+const SimulatedLabel = "simulated"
+
+// Simulator tracks which devices are simulated and the values set on them. A nil Simulator simulates no device.
+type Simulator struct {
+	mutex sync.Mutex
+	// toggled holds, per device name, whether simulation was explicitly enabled or disabled, overriding the label
+	toggled map[string]bool
+	// values holds, per device name, the last value set on each device resource
+	values map[string]map[string]string
+	random *rand.Rand
+	now    func() time.Time
+}
+
+// NewSimulator creates a Simulator simulating the named devices in addition to the devices labelled SimulatedLabel.
+func NewSimulator(devices []string) *Simulator {
+	s := &Simulator{
+		toggled: make(map[string]bool, len(devices)),
+		values:  make(map[string]map[string]string),
+		random:  rand.New(rand.NewSource(time.Now().UnixNano())),
+		now:     time.Now,
+	}
+	for _, device := range devices {
+		s.toggled[device] = true
+	}
+	return s
+}
+
+// Enabled reports whether the commands of the device are simulated.
+func (s *Simulator) Enabled(device contract.Device) bool {
+	if s == nil {
+		return false
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if enabled, ok := s.toggled[device.Name]; ok {
+		return enabled
+	}
+	for _, label := range device.Labels {
+		if label == SimulatedLabel {
+			return true
+		}
+	}
+	return false
+}
+
+// SetEnabled enables or disables the simulation of a device, whatever its labels. The values set on a device are
+// forgotten when its simulation is disabled.
+func (s *Simulator) SetEnabled(device string, enabled bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.toggled[device] = enabled
+	if !enabled {
+		delete(s.values, device)
+	}
+}
+
+// Get returns the event a device service would return for a read command: a reading for each device resource read by
+// the command, holding the value last set on the resource or else a value generated from its properties.
+func (s *Simulator) Get(device contract.Device, command contract.Command) (contract.Event, error) {
+	operations := resourceOperations(device.Profile, commandName(command.Name, command.Get.Action.Path), true)
+	if len(operations) == 0 {
+		return contract.Event{}, fmt.Errorf("profile %s defines no device resource read by command %s",
+			device.Profile.Name, command.Name)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	origin := s.now().UnixNano()
+	event := contract.Event{Device: device.Name, Origin: origin}
+	for _, op := range operations {
+		value, ok := s.values[device.Name][op.resource.Name]
+		if !ok {
+			value, ok = s.generate(op)
+		}
+		if !ok {
+			// binary values are not simulated
+			continue
+		}
+		event.Readings = append(event.Readings, contract.Reading{
+			Device:    device.Name,
+			Name:      op.resource.Name,
+			Value:     value,
+			ValueType: op.resource.Properties.Value.Type,
+			Origin:    origin,
+		})
+	}
+	return event, nil
+}
+
+// Set remembers the parameters of a set command which are values of the device resources written by the command, so
+// that later read commands return them. The parameters are expected to have been validated against the profile.
+func (s *Simulator) Set(device contract.Device, command contract.Command, parameters map[string]string) error {
+	operations := resourceOperations(device.Profile, commandName(command.Name, command.Put.Action.Path), false)
+	if len(operations) == 0 {
+		return fmt.Errorf("profile %s defines no device resource written by command %s",
+			device.Profile.Name, command.Name)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	values, ok := s.values[device.Name]
+	if !ok {
+		values = make(map[string]string)
+		s.values[device.Name] = values
+	}
+	for _, op := range operations {
+		if value, ok := parameters[op.resource.Name]; ok {
+			values[op.resource.Name] = value
+		}
+	}
+	return nil
+}
+
+// Devices returns the names of the devices whose simulation was explicitly enabled, sorted.
+func (s *Simulator) Devices() []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	devices := make([]string, 0, len(s.toggled))
+	for device, enabled := range s.toggled {
+		if enabled {
+			devices = append(devices, device)
+		}
+	}
+	sort.Strings(devices)
+	return devices
+}
+
+// commandName returns the name of the device command or device resource a command is executed against, which is the
+// last segment of the path of its action on the device service.
+func commandName(name string, actionPath string) string {
+	if actionPath == "" {
+		return name
+	}
+	return path.Base(actionPath)
+}
+
+// resourceOperation is a device resource read or written by a command, along with the mappings of its value.
+type resourceOperation struct {
+	resource contract.DeviceResource
+	mappings map[string]string
+}
+
+// resourceOperations returns the device resources read, or written, by the device command of the profile with the
+// given name, or the device resource of that name when no device command has it.
+func resourceOperations(profile contract.DeviceProfile, name string, read bool) []resourceOperation {
+	resources := make(map[string]contract.DeviceResource, len(profile.DeviceResources))
+	for _, dr := range profile.DeviceResources {
+		resources[dr.Name] = dr
+	}
+
+	for _, pr := range profile.DeviceCommands {
+		if pr.Name != name {
+			continue
+		}
+		ros := pr.Set
+		if read {
+			ros = pr.Get
+		}
+		var operations []resourceOperation
+		for _, ro := range ros {
+			resourceName := ro.DeviceResource
+			if resourceName == "" {
+				resourceName = ro.Object
+			}
+			if dr, ok := resources[resourceName]; ok {
+				operations = append(operations, resourceOperation{resource: dr, mappings: ro.Mappings})
+			}
+		}
+		return operations
+	}
+
+	if dr, ok := resources[name]; ok {
+		return []resourceOperation{{resource: dr}}
+	}
+	return nil
+}
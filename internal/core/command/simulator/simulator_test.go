@@ -0,0 +1,142 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"encoding/base64"
+	"strconv"
+	"testing"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func resource(name string, value contract.PropertyValue) contract.DeviceResource {
+	return contract.DeviceResource{Name: name, Properties: contract.ProfileProperty{Value: value}}
+}
+
+var testDevice = contract.Device{
+	Name: "thermostat",
+	Profile: contract.DeviceProfile{
+		Name: "thermostat-profile",
+		DeviceResources: []contract.DeviceResource{
+			resource("temperature", contract.PropertyValue{Type: "Float32", Minimum: "-10", Maximum: "40"}),
+			resource("setpoint", contract.PropertyValue{Type: "Int16", Minimum: "5", Maximum: "30"}),
+			resource("heating", contract.PropertyValue{Type: "Bool"}),
+			resource("mode", contract.PropertyValue{Type: "Int8"}),
+			resource("label", contract.PropertyValue{Type: "String", DefaultValue: "living room"}),
+			resource("snapshot", contract.PropertyValue{Type: "Binary"}),
+		},
+		DeviceCommands: []contract.ProfileResource{{
+			Name: "status",
+			Get: []contract.ResourceOperation{
+				{DeviceResource: "temperature"},
+				{DeviceResource: "setpoint"},
+				{DeviceResource: "mode", Mappings: map[string]string{"0": "off", "1": "eco", "2": "comfort"}},
+				{DeviceResource: "snapshot"},
+			},
+			Set: []contract.ResourceOperation{{DeviceResource: "setpoint"}, {DeviceResource: "heating"}},
+		}},
+	},
+}
+
+var statusCommand = contract.Command{
+	Name: "status",
+	Get:  contract.Get{Action: contract.Action{Path: "/api/v1/device/{deviceId}/status"}},
+	Put:  contract.Put{Action: contract.Action{Path: "/api/v1/device/{deviceId}/status"}},
+}
+
+func readings(t *testing.T, s *Simulator, command contract.Command) map[string]contract.Reading {
+	event, err := s.Get(testDevice, command)
+	require.NoError(t, err)
+	assert.Equal(t, testDevice.Name, event.Device)
+	byName := make(map[string]contract.Reading, len(event.Readings))
+	for _, r := range event.Readings {
+		byName[r.Name] = r
+	}
+	return byName
+}
+
+func TestGetGeneratesValuesConformingToTheProfile(t *testing.T) {
+	s := NewSimulator(nil)
+
+	for i := 0; i < 50; i++ {
+		r := readings(t, s, statusCommand)
+		require.Len(t, r, 3, "binary resources are not simulated")
+
+		temperature, err := strconv.ParseFloat(r["temperature"].Value, 32)
+		require.NoError(t, err)
+		assert.True(t, temperature >= -10 && temperature <= 40, "temperature %v out of range", temperature)
+		assert.Equal(t, "Float32", r["temperature"].ValueType)
+
+		setpoint, err := strconv.Atoi(r["setpoint"].Value)
+		require.NoError(t, err)
+		assert.True(t, setpoint >= 5 && setpoint <= 30, "setpoint %v out of range", setpoint)
+
+		assert.Contains(t, []string{"off", "eco", "comfort"}, r["mode"].Value)
+	}
+}
+
+func TestGetDeviceResource(t *testing.T) {
+	s := NewSimulator(nil)
+
+	r := readings(t, s, contract.Command{Name: "label"})
+	assert.Equal(t, "living room", r["label"].Value)
+
+	r = readings(t, s, contract.Command{Name: "heating"})
+	_, err := strconv.ParseBool(r["heating"].Value)
+	assert.NoError(t, err)
+
+	_, err = s.Get(testDevice, contract.Command{Name: "unknown"})
+	assert.Error(t, err)
+}
+
+func TestSetValuesAreReturned(t *testing.T) {
+	s := NewSimulator(nil)
+
+	require.NoError(t, s.Set(testDevice, statusCommand, map[string]string{"setpoint": "21", "temperature": "99"}))
+	r := readings(t, s, statusCommand)
+	assert.Equal(t, "21", r["setpoint"].Value)
+	assert.NotEqual(t, "99", r["temperature"].Value, "resources not written by the command are not set")
+
+	// disabling the simulation forgets the values set
+	s.SetEnabled(testDevice.Name, false)
+	s.SetEnabled(testDevice.Name, true)
+	for i := 0; i < 10; i++ {
+		if readings(t, s, statusCommand)["setpoint"].Value != "21" {
+			return
+		}
+	}
+	t.Error("the value set should have been forgotten")
+}
+
+func TestEnabled(t *testing.T) {
+	var nilSimulator *Simulator
+	assert.False(t, nilSimulator.Enabled(testDevice))
+
+	s := NewSimulator([]string{"configured"})
+	assert.True(t, s.Enabled(contract.Device{Name: "configured"}))
+	assert.False(t, s.Enabled(contract.Device{Name: "other"}))
+
+	labelled := contract.Device{Name: "labelled", Labels: []string{"hvac", SimulatedLabel}}
+	assert.True(t, s.Enabled(labelled))
+	s.SetEnabled(labelled.Name, false)
+	assert.False(t, s.Enabled(labelled))
+
+	s.SetEnabled("other", true)
+	assert.Equal(t, []string{"configured", "other"}, s.Devices())
+}
+
+func TestFormatFloat(t *testing.T) {
+	assert.Equal(t, "1.5", formatFloat(1.5, 32, ""))
+	assert.Equal(t, "1.5e+00", formatFloat(1.5, 64, "eNotation"))
+	encoded := formatFloat(1.5, 32, "Base64")
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x3f, 0xc0, 0x00, 0x00}, decoded)
+}
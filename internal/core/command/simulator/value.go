@@ -0,0 +1,119 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	base64Encoding    = "base64"
+	eNotationEncoding = "enotation"
+	// defaultSpan is the width of the range of the generated numbers when the profile doesn't bound the values.
+	defaultSpan = 100.0
+	// defaultString is the value of string resources without a default value.
+	defaultString = "simulated"
+	// maxExactInteger bounds the generated integers, which are computed as floats, so that they are exact.
+	maxExactInteger = 1 << 53
+)
+
+// generate returns a value complying with the properties of the device resource of an operation: one of the mapped
+// values when the operation has mappings, and otherwise a random value of the value type, within the minimum and
+// maximum. It returns false for value types which are not simulated.
+func (s *Simulator) generate(op resourceOperation) (string, bool) {
+	if len(op.mappings) > 0 {
+		mapped := make([]string, 0, len(op.mappings))
+		for _, value := range op.mappings {
+			mapped = append(mapped, value)
+		}
+		sort.Strings(mapped)
+		return mapped[s.random.Intn(len(mapped))], true
+	}
+
+	pv := op.resource.Properties.Value
+	valueType := strings.ToLower(pv.Type)
+	switch valueType {
+	case "bool":
+		return strconv.FormatBool(s.random.Intn(2) == 1), true
+	case "string":
+		if pv.DefaultValue != "" {
+			return pv.DefaultValue, true
+		}
+		return defaultString, true
+	case "int8", "int16", "int32", "int64":
+		size := bitSize(valueType)
+		limit := math.Min(math.Pow(2, float64(size-1)), maxExactInteger)
+		minimum, maximum := bounds(pv.Minimum, pv.Maximum, -limit, limit-1)
+		return strconv.FormatInt(s.integer(minimum, maximum), 10), true
+	case "uint8", "uint16", "uint32", "uint64":
+		size := bitSize(valueType)
+		minimum, maximum := bounds(pv.Minimum, pv.Maximum, 0, math.Min(math.Pow(2, float64(size)), maxExactInteger)-1)
+		return strconv.FormatInt(s.integer(minimum, maximum), 10), true
+	case "float32", "float64":
+		minimum, maximum := bounds(pv.Minimum, pv.Maximum, -math.MaxFloat32, math.MaxFloat32)
+		return formatFloat(minimum+s.random.Float64()*(maximum-minimum), bitSize(valueType), pv.FloatEncoding), true
+	}
+	return "", false
+}
+
+// integer returns a random integer between minimum and maximum, both included.
+func (s *Simulator) integer(minimum float64, maximum float64) int64 {
+	low, high := int64(math.Ceil(minimum)), int64(math.Floor(maximum))
+	if high <= low {
+		return low
+	}
+	return low + s.random.Int63n(high-low+1)
+}
+
+// bounds returns the range of the generated numbers: the minimum and maximum of the profile, defaulting to a range of
+// defaultSpan next to the other bound, or starting at zero, and limited to the range of the value type.
+func bounds(minimumProperty string, maximumProperty string, typeMinimum float64, typeMaximum float64) (float64, float64) {
+	minimum, minErr := strconv.ParseFloat(minimumProperty, 64)
+	maximum, maxErr := strconv.ParseFloat(maximumProperty, 64)
+	switch {
+	case minErr != nil && maxErr != nil:
+		minimum, maximum = 0, defaultSpan
+	case minErr != nil:
+		minimum = maximum - defaultSpan
+	case maxErr != nil:
+		maximum = minimum + defaultSpan
+	}
+	minimum = math.Max(minimum, typeMinimum)
+	maximum = math.Min(maximum, typeMaximum)
+	if maximum < minimum {
+		maximum = minimum
+	}
+	return minimum, maximum
+}
+
+// formatFloat formats a float the way device services do for the float encoding of the device resource.
+func formatFloat(value float64, size int, encoding string) string {
+	switch strings.ToLower(encoding) {
+	case base64Encoding:
+		buf := new(bytes.Buffer)
+		if size == 32 {
+			_ = binary.Write(buf, binary.BigEndian, float32(value))
+		} else {
+			_ = binary.Write(buf, binary.BigEndian, value)
+		}
+		return base64.StdEncoding.EncodeToString(buf.Bytes())
+	case eNotationEncoding:
+		return strconv.FormatFloat(value, 'e', -1, size)
+	}
+	return strconv.FormatFloat(value, 'f', -1, size)
+}
+
+// bitSize returns the size in bits of a numeric value type such as int16 or float32.
+func bitSize(valueType string) int {
+	size, _ := strconv.Atoi(strings.TrimLeft(valueType, "uintfloa"))
+	return size
+}
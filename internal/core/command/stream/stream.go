@@ -0,0 +1,96 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package stream relays the binary responses of device services, such as camera snapshots, to the client as they are
+// received instead of buffering them, within a size limit and a time limit.
+package stream
+
+import (
+	goErrors "errors"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/errors"
+)
+
+// ErrTimeout is returned when relaying a response takes longer than the time limit.
+var ErrTimeout = goErrors.New("relaying the response of the device service timed out")
+
+// Streamer relays binary responses. A nil Streamer doesn't stream any response, so that they are buffered.
+type Streamer struct {
+	maxSize int64
+	timeout time.Duration
+}
+
+// NewStreamer creates a Streamer relaying responses of at most maxSize bytes, or of any size when maxSize is not
+// positive, within timeout, or without time limit when timeout is not positive.
+func NewStreamer(maxSize int64, timeout time.Duration) *Streamer {
+	return &Streamer{maxSize: maxSize, timeout: timeout}
+}
+
+// Streamable reports whether the response is a successful one carrying a binary payload, which is relayed rather than
+// buffered.
+func (s *Streamer) Streamable(resp *http.Response) bool {
+	if s == nil || resp == nil || resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return false
+	}
+	mediaType, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if err != nil {
+		return false
+	}
+	switch {
+	case mediaType == "application/cbor", mediaType == "application/octet-stream":
+		return true
+	case strings.HasPrefix(mediaType, "image/"), strings.HasPrefix(mediaType, "video/"),
+		strings.HasPrefix(mediaType, "audio/"):
+		return true
+	}
+	return false
+}
+
+// Check rejects the response with an errors.ErrResponseTooLarge when its announced length already exceeds the size
+// limit, before anything is relayed.
+func (s *Streamer) Check(resp *http.Response) error {
+	if s.maxSize > 0 && resp.ContentLength > s.maxSize {
+		return errors.NewErrResponseTooLarge(resp.ContentLength, s.maxSize)
+	}
+	return nil
+}
+
+// Copy relays the body of the response to w as it is read, and closes it. It returns the number of bytes relayed,
+// which may be short of the whole body when the size limit is exceeded or the time limit elapses.
+func (s *Streamer) Copy(w io.Writer, body io.ReadCloser) (int64, error) {
+	defer body.Close()
+
+	var timedOut int32
+	if s.timeout > 0 {
+		// closing the body interrupts a read blocked on a slow device service
+		timer := time.AfterFunc(s.timeout, func() {
+			atomic.StoreInt32(&timedOut, 1)
+			body.Close()
+		})
+		defer timer.Stop()
+	}
+
+	var r io.Reader = body
+	if s.maxSize > 0 {
+		r = io.LimitReader(body, s.maxSize+1)
+	}
+	n, err := io.Copy(w, r)
+	if atomic.LoadInt32(&timedOut) == 1 {
+		return n, ErrTimeout
+	}
+	if err != nil {
+		return n, err
+	}
+	if s.maxSize > 0 && n > s.maxSize {
+		return n, errors.NewErrResponseTooLarge(-1, s.maxSize)
+	}
+	return n, nil
+}
@@ -0,0 +1,123 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package stream
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newResponse(statusCode int, contentType string) *http.Response {
+	header := http.Header{}
+	header.Set("Content-Type", contentType)
+	return &http.Response{StatusCode: statusCode, Header: header, ContentLength: -1}
+}
+
+func TestNilStreamerStreamsNothing(t *testing.T) {
+	var streamer *Streamer
+	assert.False(t, streamer.Streamable(newResponse(http.StatusOK, "image/jpeg")))
+}
+
+func TestStreamable(t *testing.T) {
+	streamer := NewStreamer(0, 0)
+
+	tests := []struct {
+		name       string
+		statusCode int
+		mediaType  string
+		expected   bool
+	}{
+		{"image", http.StatusOK, "image/jpeg", true},
+		{"CBOR event", http.StatusOK, "application/cbor", true},
+		{"octet stream", http.StatusOK, "application/octet-stream", true},
+		{"media type parameters", http.StatusOK, "video/mp4; codecs=avc1", true},
+		{"JSON event", http.StatusOK, "application/json", false},
+		{"text", http.StatusOK, "text/plain; charset=utf-8", false},
+		{"no media type", http.StatusOK, "", false},
+		{"failed command", http.StatusInternalServerError, "application/octet-stream", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, streamer.Streamable(newResponse(tt.statusCode, tt.mediaType)))
+		})
+	}
+}
+
+func TestCheckRejectsAnnouncedOversizedResponses(t *testing.T) {
+	streamer := NewStreamer(1024, 0)
+	resp := newResponse(http.StatusOK, "image/png")
+
+	resp.ContentLength = 1024
+	assert.NoError(t, streamer.Check(resp))
+
+	resp.ContentLength = 1025
+	err := streamer.Check(resp)
+	require.Error(t, err)
+	assert.IsType(t, errors.ErrResponseTooLarge{}, err)
+}
+
+func TestCopyRelaysBody(t *testing.T) {
+	payload := bytes.Repeat([]byte{0xff, 0xd8}, 100000)
+	var out bytes.Buffer
+
+	n, err := NewStreamer(int64(len(payload)), time.Minute).Copy(&out, ioutil.NopCloser(bytes.NewReader(payload)))
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(payload)), n)
+	assert.Equal(t, payload, out.Bytes())
+}
+
+func TestCopyStopsAtSizeLimit(t *testing.T) {
+	var out bytes.Buffer
+
+	_, err := NewStreamer(10, 0).Copy(&out, ioutil.NopCloser(strings.NewReader(strings.Repeat("x", 1000))))
+	require.Error(t, err)
+	assert.IsType(t, errors.ErrResponseTooLarge{}, err)
+	assert.True(t, out.Len() <= 11, "%d bytes relayed past the limit", out.Len())
+}
+
+// stalledBody returns a first chunk, then blocks until it is closed.
+type stalledBody struct {
+	sent   bool
+	closed chan struct{}
+}
+
+func (b *stalledBody) Read(p []byte) (int, error) {
+	if !b.sent {
+		b.sent = true
+		return copy(p, "chunk"), nil
+	}
+	<-b.closed
+	return 0, io.ErrClosedPipe
+}
+
+func (b *stalledBody) Close() error {
+	select {
+	case <-b.closed:
+	default:
+		close(b.closed)
+	}
+	return nil
+}
+
+func TestCopyTimesOut(t *testing.T) {
+	var out bytes.Buffer
+	body := &stalledBody{closed: make(chan struct{})}
+
+	n, err := NewStreamer(0, 50*time.Millisecond).Copy(&out, body)
+	assert.Equal(t, ErrTimeout, err)
+	assert.Equal(t, int64(len("chunk")), n)
+}
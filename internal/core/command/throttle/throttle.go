@@ -0,0 +1,147 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package throttle protects devices from being overwhelmed by commands, by limiting the rate of the commands issued to
+// each device and optionally serializing the set commands of a device.
+package throttle
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/errors"
+)
+
+// deviceState is the throttling state of a single device.
+type deviceState struct {
+	// lock is held by the set command being executed when set commands are exclusive
+	lock chan struct{}
+	// tokens available for commands, replenished at the configured rate; negative when commands are waiting
+	tokens float64
+	last   time.Time
+}
+
+// Throttle limits the commands issued to each device. A nil Throttle doesn't limit anything.
+type Throttle struct {
+	rate         float64
+	burst        float64
+	maxWait      time.Duration
+	exclusiveSet bool
+
+	mutex   sync.Mutex
+	devices map[string]*deviceState
+}
+
+// NewThrottle creates a Throttle allowing commandsPerSecond commands per device, or any number of commands when
+// commandsPerSecond is not positive. A command waits at most maxWait for its turn before it is rejected. When
+// exclusiveSet is true, the set commands of a device are executed one at a time.
+func NewThrottle(commandsPerSecond float64, maxWait time.Duration, exclusiveSet bool) *Throttle {
+	return &Throttle{
+		rate:         commandsPerSecond,
+		burst:        math.Max(1, commandsPerSecond),
+		maxWait:      maxWait,
+		exclusiveSet: exclusiveSet,
+		devices:      make(map[string]*deviceState),
+	}
+}
+
+// Acquire waits until a command may be issued to the device and returns the function to call once the command
+// completed. An errors.ErrDeviceBusy is returned when the command would have to wait longer than allowed.
+func (t *Throttle) Acquire(ctx context.Context, device string, set bool) (release func(), err error) {
+	release = func() {}
+	if t == nil || (t.rate <= 0 && !(set && t.exclusiveSet)) {
+		return release, nil
+	}
+
+	deadline := time.Now().Add(t.maxWait)
+	state := t.stateOf(device)
+
+	if set && t.exclusiveSet {
+		if !acquireLock(ctx, deadline, state.lock) {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			return nil, errors.NewErrDeviceBusy(device, "another set command is in progress")
+		}
+		release = func() { <-state.lock }
+	}
+
+	if t.rate > 0 {
+		delay, ok := t.reserve(state, time.Now(), deadline)
+		if !ok {
+			release()
+			return nil, errors.NewErrDeviceBusy(device, fmt.Sprintf("more than %g commands per second", t.rate))
+		}
+		if delay > 0 {
+			timer := time.NewTimer(delay)
+			defer timer.Stop()
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				t.cancelReservation(state)
+				release()
+				return nil, ctx.Err()
+			}
+		}
+	}
+	return release, nil
+}
+
+// stateOf returns the throttling state of the device, creating it for the first command.
+func (t *Throttle) stateOf(device string) *deviceState {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	state, ok := t.devices[device]
+	if !ok {
+		state = &deviceState{lock: make(chan struct{}, 1), tokens: t.burst, last: time.Now()}
+		t.devices[device] = state
+	}
+	return state
+}
+
+// reserve takes a token of the device and returns how long the command has to wait for it, unless the command would
+// still be waiting at the deadline.
+func (t *Throttle) reserve(state *deviceState, now time.Time, deadline time.Time) (time.Duration, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	state.tokens = math.Min(t.burst, state.tokens+now.Sub(state.last).Seconds()*t.rate)
+	state.last = now
+	if state.tokens >= 1 {
+		state.tokens--
+		return 0, true
+	}
+
+	delay := time.Duration((1 - state.tokens) / t.rate * float64(time.Second))
+	if now.Add(delay).After(deadline) {
+		return 0, false
+	}
+	state.tokens--
+	return delay, true
+}
+
+// cancelReservation gives back the token of a command which gave up waiting.
+func (t *Throttle) cancelReservation(state *deviceState) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	state.tokens++
+}
+
+// acquireLock takes the lock, unless it is still held by someone else at the deadline or ctx is done first.
+func acquireLock(ctx context.Context, deadline time.Time, lock chan struct{}) bool {
+	timer := time.NewTimer(time.Until(deadline))
+	defer timer.Stop()
+	select {
+	case lock <- struct{}{}:
+		return true
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return false
+	}
+}
@@ -0,0 +1,79 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package throttle
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNilThrottleDoesNotLimit(t *testing.T) {
+	var throttle *Throttle
+	for i := 0; i < 10; i++ {
+		release, err := throttle.Acquire(context.Background(), "device1", true)
+		require.NoError(t, err)
+		release()
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	throttle := NewThrottle(2, 0, false)
+
+	for i := 0; i < 2; i++ {
+		release, err := throttle.Acquire(context.Background(), "device1", false)
+		require.NoError(t, err, "commands within the burst should pass")
+		release()
+	}
+	_, err := throttle.Acquire(context.Background(), "device1", false)
+	require.Error(t, err, "command over the rate should be rejected without waiting")
+	assert.IsType(t, errors.ErrDeviceBusy{}, err)
+
+	_, err = throttle.Acquire(context.Background(), "device2", false)
+	assert.NoError(t, err, "devices should be limited independently")
+}
+
+func TestRateLimitWaits(t *testing.T) {
+	throttle := NewThrottle(20, time.Second, false)
+
+	started := time.Now()
+	for i := 0; i < 21; i++ {
+		release, err := throttle.Acquire(context.Background(), "device1", false)
+		require.NoError(t, err)
+		release()
+	}
+	assert.True(t, time.Since(started) >= 40*time.Millisecond, "command over the burst should wait for its turn")
+}
+
+func TestExclusiveSet(t *testing.T) {
+	throttle := NewThrottle(0, 50*time.Millisecond, true)
+
+	release, err := throttle.Acquire(context.Background(), "device1", true)
+	require.NoError(t, err)
+
+	_, err = throttle.Acquire(context.Background(), "device1", false)
+	assert.NoError(t, err, "get commands should not wait for the lock")
+	_, err = throttle.Acquire(context.Background(), "device1", true)
+	assert.IsType(t, errors.ErrDeviceBusy{}, err, "set command should time out while the lock is held")
+
+	// A waiting set command proceeds as soon as the lock is released.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		second, err := throttle.Acquire(context.Background(), "device1", true)
+		if assert.NoError(t, err) {
+			second()
+		}
+	}()
+	time.Sleep(10 * time.Millisecond)
+	release()
+	<-done
+}
@@ -45,6 +45,11 @@ func (sc serviceCommand) Execute() (deviceServiceResponse *http.Response, failur
 	return deviceServiceResponse, nil
 }
 
+// ProxiedRequest returns the request sent to the device service when the command is executed.
+func (sc serviceCommand) ProxiedRequest() *http.Request {
+	return sc.Request
+}
+
 func newServiceCommand(
 	device contract.Device,
 	caller internal.HttpCaller,
@@ -0,0 +1,169 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/errors"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+const (
+	base64FloatEncoding = "base64"
+	readOnly            = "R"
+)
+
+// validateSetParameters checks the body of a set command against the device resources of the device profile, so that
+// invalid values are rejected with the reason for every parameter instead of failing in the device service. Parameters
+// which don't match a device resource are left to the device service.
+func validateSetParameters(device contract.Device, command contract.Command, body string) error {
+	if strings.TrimSpace(body) == "" {
+		return nil
+	}
+
+	var parameters map[string]interface{}
+	if err := json.Unmarshal([]byte(body), &parameters); err != nil {
+		return errors.NewErrInvalidCommandParameters(
+			command.Name,
+			map[string]string{"body": "must be a JSON object of parameter names and values"})
+	}
+
+	resources := make(map[string]contract.DeviceResource, len(device.Profile.DeviceResources))
+	for _, dr := range device.Profile.DeviceResources {
+		resources[dr.Name] = dr
+	}
+	enumerations := setEnumerations(device.Profile, command.Name)
+
+	fields := make(map[string]string)
+	for name, parameter := range parameters {
+		resource, ok := resources[name]
+		if !ok {
+			continue
+		}
+
+		value := parameterString(parameter)
+		if reason := validateValue(resource.Properties.Value, value); reason != "" {
+			fields[name] = reason
+			continue
+		}
+		if allowed, ok := enumerations[name]; ok {
+			if _, ok := allowed[value]; !ok {
+				fields[name] = fmt.Sprintf("value '%s' is not one of %s", value, strings.Join(sortedKeys(allowed), ", "))
+			}
+		}
+	}
+
+	if len(fields) > 0 {
+		return errors.NewErrInvalidCommandParameters(command.Name, fields)
+	}
+	return nil
+}
+
+// setEnumerations returns, per device resource, the values accepted by the set operations of the device command
+// which have mappings, since only the mapped values can be translated by the device service.
+func setEnumerations(profile contract.DeviceProfile, commandName string) map[string]map[string]struct{} {
+	enumerations := make(map[string]map[string]struct{})
+	for _, pr := range profile.DeviceCommands {
+		if pr.Name != commandName {
+			continue
+		}
+		for _, ro := range pr.Set {
+			if len(ro.Mappings) == 0 {
+				continue
+			}
+			resource := ro.DeviceResource
+			if resource == "" {
+				resource = ro.Object
+			}
+			allowed := make(map[string]struct{}, len(ro.Mappings))
+			for value := range ro.Mappings {
+				allowed[value] = struct{}{}
+			}
+			enumerations[resource] = allowed
+		}
+	}
+	return enumerations
+}
+
+// validateValue returns why value doesn't comply with the property value of a device resource, or an empty string.
+func validateValue(pv contract.PropertyValue, value string) string {
+	if pv.ReadWrite == readOnly {
+		return "device resource is read-only"
+	}
+
+	valueType := strings.ToLower(pv.Type)
+	var number float64
+	var err error
+	switch valueType {
+	case "bool":
+		_, err = strconv.ParseBool(value)
+	case "int8", "int16", "int32", "int64":
+		var i int64
+		i, err = strconv.ParseInt(value, 10, bitSize(valueType))
+		number = float64(i)
+	case "uint8", "uint16", "uint32", "uint64":
+		var u uint64
+		u, err = strconv.ParseUint(value, 10, bitSize(valueType))
+		number = float64(u)
+	case "float32", "float64":
+		if strings.ToLower(pv.FloatEncoding) == base64FloatEncoding {
+			return ""
+		}
+		number, err = strconv.ParseFloat(value, bitSize(valueType))
+	default:
+		return ""
+	}
+	if err != nil {
+		return fmt.Sprintf("value '%s' is not a valid %s", value, pv.Type)
+	}
+	if valueType == "bool" {
+		return ""
+	}
+
+	if minimum, err := strconv.ParseFloat(pv.Minimum, 64); err == nil && number < minimum {
+		return fmt.Sprintf("value %s is less than the minimum %s", value, pv.Minimum)
+	}
+	if maximum, err := strconv.ParseFloat(pv.Maximum, 64); err == nil && number > maximum {
+		return fmt.Sprintf("value %s is greater than the maximum %s", value, pv.Maximum)
+	}
+	return ""
+}
+
+// bitSize returns the size in bits of a numeric value type such as int16 or float32.
+func bitSize(valueType string) int {
+	size, _ := strconv.Atoi(strings.TrimLeft(valueType, "uintfloa"))
+	return size
+}
+
+// parameterString returns the string representation of a parameter, which device services accept either as a JSON
+// string or as a JSON number or boolean.
+func parameterString(parameter interface{}) string {
+	switch p := parameter.(type) {
+	case string:
+		return p
+	case float64:
+		return strconv.FormatFloat(p, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(p)
+	}
+	b, _ := json.Marshal(parameter)
+	return string(b)
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,106 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package command
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/errors"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validationTestDevice() contract.Device {
+	return contract.Device{
+		Name: "thermostat",
+		Profile: contract.DeviceProfile{
+			DeviceResources: []contract.DeviceResource{
+				{
+					Name: "SetPoint",
+					Properties: contract.ProfileProperty{
+						Value: contract.PropertyValue{Type: "Float32", ReadWrite: "RW", Minimum: "5", Maximum: "30"},
+					},
+				},
+				{
+					Name: "Enabled",
+					Properties: contract.ProfileProperty{
+						Value: contract.PropertyValue{Type: "Bool", ReadWrite: "RW"},
+					},
+				},
+				{
+					Name: "Mode",
+					Properties: contract.ProfileProperty{
+						Value: contract.PropertyValue{Type: "String", ReadWrite: "RW"},
+					},
+				},
+				{
+					Name: "Temperature",
+					Properties: contract.ProfileProperty{
+						Value: contract.PropertyValue{Type: "Int16", ReadWrite: "R"},
+					},
+				},
+			},
+			DeviceCommands: []contract.ProfileResource{
+				{
+					Name: "Configuration",
+					Set: []contract.ResourceOperation{
+						{DeviceResource: "SetPoint"},
+						{DeviceResource: "Mode", Mappings: map[string]string{"heat": "1", "cool": "2"}},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestValidateSetParameters(t *testing.T) {
+	device := validationTestDevice()
+	command := contract.Command{Name: "Configuration"}
+
+	tests := []struct {
+		name          string
+		body          string
+		invalidFields []string
+	}{
+		{"empty body", "", nil},
+		{"valid parameters", `{"SetPoint":"21.5","Enabled":"true","Mode":"heat"}`, nil},
+		{"valid JSON values", `{"SetPoint":21.5,"Enabled":false}`, nil},
+		{"unknown parameter", `{"Unknown":"value"}`, nil},
+		{"not an object", `["SetPoint"]`, []string{"body"}},
+		{"invalid type", `{"SetPoint":"warm","Enabled":"maybe"}`, []string{"Enabled", "SetPoint"}},
+		{"below minimum", `{"SetPoint":"4.5"}`, []string{"SetPoint"}},
+		{"above maximum", `{"SetPoint":31}`, []string{"SetPoint"}},
+		{"not enumerated", `{"Mode":"auto"}`, []string{"Mode"}},
+		{"read-only", `{"Temperature":"20"}`, []string{"Temperature"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSetParameters(device, command, tt.body)
+			if tt.invalidFields == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			invalid, ok := err.(errors.ErrInvalidCommandParameters)
+			require.True(t, ok)
+			assert.Len(t, invalid.Fields(), len(tt.invalidFields))
+			for _, field := range tt.invalidFields {
+				assert.Contains(t, invalid.Fields(), field)
+			}
+		})
+	}
+}
+
+func TestValidateIntegerRange(t *testing.T) {
+	pv := contract.PropertyValue{Type: "Uint8", ReadWrite: "W"}
+	assert.Empty(t, validateValue(pv, "255"))
+	assert.NotEmpty(t, validateValue(pv, "256"))
+	assert.NotEmpty(t, validateValue(pv, "-1"))
+}
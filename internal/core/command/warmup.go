@@ -0,0 +1,23 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package command
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/devicecache"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/warmup"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// warmupLoaders returns the loaders of the caches of core-command preloaded at startup, none of the disabled ones.
+func warmupLoaders(dic *di.Container) []warmup.Loader {
+	var loaders []warmup.Loader
+	if deviceCache, ok := container.MetadataDeviceClientFrom(dic.Get).(*devicecache.Client); ok {
+		loaders = append(loaders, warmup.Loader{Name: "devices", Load: deviceCache.Preload})
+	}
+	return loaders
+}
@@ -0,0 +1,154 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ack defines the levels at which the incoming events are acknowledged to their sender, trading the latency of
+// the response against the guarantee it gives: before the event is persisted, once it is persisted, or once it is
+// persisted and published to the message bus.
+package ack
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Header is the request header a client selects the acknowledgment level of its events with.
+const Header = "X-Ack-Level"
+
+// Level is the point in the processing of an event at which it is acknowledged.
+type Level string
+
+const (
+	// None acknowledges the event with 202 as soon as it is read, before it is validated and persisted
+	None Level = "none"
+	// Persisted acknowledges the event once it is persisted
+	Persisted Level = "persisted"
+	// Published acknowledges the event once it is persisted and published to the message bus
+	Published Level = "published"
+)
+
+// AcknowledgmentInfo configures the acknowledgment of the incoming events.
+type AcknowledgmentInfo struct {
+	// DefaultLevel is the level of the events posted without the X-Ack-Level header, 'persisted' when empty
+	DefaultLevel string
+	// MaxPending is the number of events acknowledged before their persistence processed at a time; the events over it,
+	// all of them when 0, are processed before they're acknowledged, as with the persisted level
+	MaxPending int
+}
+
+// Parse returns the level named value, or fallback when value is empty.
+func Parse(value string, fallback Level) (Level, error) {
+	switch level := Level(strings.ToLower(strings.TrimSpace(value))); level {
+	case "":
+		return fallback, nil
+	case None, Persisted, Published:
+		return level, nil
+	default:
+		return "", fmt.Errorf("unknown acknowledgment level '%s', expected '%s', '%s' or '%s'", value, None, Persisted, Published)
+	}
+}
+
+// Dispatcher processes the events acknowledged before their persistence in the background, up to a maximum at a time.
+type Dispatcher struct {
+	level   Level
+	pending chan struct{}
+
+	mutex   sync.Mutex
+	stopped bool
+	wg      sync.WaitGroup
+}
+
+// NewDispatcher validates info and returns a Dispatcher for its events.
+func NewDispatcher(info AcknowledgmentInfo) (*Dispatcher, error) {
+	level, err := Parse(info.DefaultLevel, Persisted)
+	if err != nil {
+		return nil, err
+	}
+	if info.MaxPending < 0 {
+		return nil, fmt.Errorf("invalid acknowledgment MaxPending %d", info.MaxPending)
+	}
+	return &Dispatcher{level: level, pending: make(chan struct{}, info.MaxPending)}, nil
+}
+
+// Level returns the level requested by the value of the header of an event, the default one when the header is
+// empty. A nil Dispatcher acknowledges the events once they're persisted.
+func (d *Dispatcher) Level(header string) (Level, error) {
+	if d == nil {
+		return Parse(header, Persisted)
+	}
+	return Parse(header, d.level)
+}
+
+// Go runs process in the background and returns true, unless the maximum number of events are already pending or the
+// service is stopping. A nil Dispatcher never runs process.
+func (d *Dispatcher) Go(process func()) bool {
+	if d == nil {
+		return false
+	}
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if d.stopped {
+		return false
+	}
+	select {
+	case d.pending <- struct{}{}:
+	default:
+		return false
+	}
+
+	d.wg.Add(1)
+	go func() {
+		defer func() {
+			<-d.pending
+			d.wg.Done()
+		}()
+		process()
+	}()
+	return true
+}
+
+// Start waits, once ctx is done, for the pending events to be processed before wg is done, so that they're not lost
+// when the service stops.
+func (d *Dispatcher) Start(ctx context.Context, wg *sync.WaitGroup) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		<-ctx.Done()
+
+		d.mutex.Lock()
+		d.stopped = true
+		d.mutex.Unlock()
+		d.wg.Wait()
+	}()
+}
+
+// detached is a context carrying the values of its parent, such as the correlation ID and the content type of the
+// event, without being canceled along with it.
+type detached struct {
+	parent context.Context
+}
+
+// Detach returns a context carrying the values of ctx, which stays alive after the request of ctx is answered.
+func Detach(ctx context.Context) context.Context {
+	return detached{parent: ctx}
+}
+
+func (d detached) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (d detached) Done() <-chan struct{} {
+	return nil
+}
+
+func (d detached) Err() error {
+	return nil
+}
+
+func (d detached) Value(key interface{}) interface{} {
+	return d.parent.Value(key)
+}
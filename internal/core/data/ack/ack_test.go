@@ -0,0 +1,118 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ack
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected Level
+		err      bool
+	}{
+		{"empty", "", Published, false},
+		{"none", "none", None, false},
+		{"case and spaces", " Persisted ", Persisted, false},
+		{"published", "published", Published, false},
+		{"unknown", "durable", "", true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			level, err := Parse(testCase.value, Published)
+			if testCase.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, level)
+		})
+	}
+}
+
+func TestNewDispatcherValidation(t *testing.T) {
+	_, err := NewDispatcher(AcknowledgmentInfo{DefaultLevel: "durable"})
+	assert.Error(t, err)
+	_, err = NewDispatcher(AcknowledgmentInfo{MaxPending: -1})
+	assert.Error(t, err)
+
+	dispatcher, err := NewDispatcher(AcknowledgmentInfo{DefaultLevel: "none"})
+	require.NoError(t, err)
+	level, err := dispatcher.Level("")
+	require.NoError(t, err)
+	assert.Equal(t, None, level, "the default level should apply without the header")
+}
+
+func TestNilDispatcher(t *testing.T) {
+	var dispatcher *Dispatcher
+	level, err := dispatcher.Level("")
+	require.NoError(t, err)
+	assert.Equal(t, Persisted, level)
+	assert.False(t, dispatcher.Go(func() { t.Error("nothing should run in the background") }))
+}
+
+func TestDispatcherMaxPending(t *testing.T) {
+	dispatcher, err := NewDispatcher(AcknowledgmentInfo{MaxPending: 2})
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	dispatcher.Start(ctx, wg)
+
+	release := make(chan struct{})
+	var processed sync.WaitGroup
+	processed.Add(2)
+	for i := 0; i < 2; i++ {
+		require.True(t, dispatcher.Go(func() {
+			<-release
+			processed.Done()
+		}))
+	}
+	assert.False(t, dispatcher.Go(func() {}), "the events over MaxPending should not run in the background")
+
+	close(release)
+	processed.Wait()
+	cancel()
+	wg.Wait()
+	assert.False(t, dispatcher.Go(func() {}), "no event should run in the background once stopping")
+}
+
+func TestDispatcherDrainsOnStop(t *testing.T) {
+	dispatcher, err := NewDispatcher(AcknowledgmentInfo{MaxPending: 1})
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	dispatcher.Start(ctx, wg)
+
+	release := make(chan struct{})
+	done := false
+	require.True(t, dispatcher.Go(func() {
+		<-release
+		done = true
+	}))
+
+	cancel()
+	close(release)
+	wg.Wait()
+	assert.True(t, done, "the pending event should be processed before the service stops")
+}
+
+func TestDetach(t *testing.T) {
+	type key struct{}
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), key{}, "correlation"))
+	detached := Detach(ctx)
+	cancel()
+
+	assert.NoError(t, detached.Err())
+	assert.Nil(t, detached.Done())
+	assert.Equal(t, "correlation", detached.Value(key{}))
+}
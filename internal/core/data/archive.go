@@ -0,0 +1,110 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package data
+
+import (
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/interfaces"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+)
+
+// archiveTimeLayout formats the time an archive is created at in its file name.
+const archiveTimeLayout = "20060102T150405.000Z"
+
+// DeviceArchive describes the archive of the events of a device. Created is in epoch milliseconds.
+type DeviceArchive struct {
+	Device  string `json:"device"`
+	File    string `json:"file"`
+	Events  int    `json:"events"`
+	Sha256  string `json:"sha256"`
+	Created int64  `json:"created"`
+}
+
+// archiveFileName returns the name of the archive of the events of the device created at now, the characters of the
+// device name which aren't safe in a file name being replaced by underscores.
+func archiveFileName(device string, now time.Time) string {
+	name := []byte(device)
+	for i, c := range name {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '.' || c == '_' || c == '-') {
+			name[i] = '_'
+		}
+	}
+	return fmt.Sprintf("%s-%s.jsonl.gz", name, now.UTC().Format(archiveTimeLayout))
+}
+
+// archiveDeviceEvents writes the events of the device, oldest first, as gzipped JSON lines to a new file of the
+// directory and returns the archive, whose digest is the SHA-256 of the file. The file is written under a temporary
+// name and renamed once complete, so that an incomplete archive is never left under its final name.
+func archiveDeviceEvents(
+	deviceId string,
+	directory string,
+	now time.Time,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) (DeviceArchive, error) {
+
+	events, err := dbClient.EventsForDevice(deviceId)
+	if err != nil {
+		return DeviceArchive{}, err
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Created < events[j].Created })
+
+	if err = os.MkdirAll(directory, 0750); err != nil {
+		return DeviceArchive{}, err
+	}
+	path := filepath.Join(directory, archiveFileName(deviceId, now))
+	file, err := os.OpenFile(path+".tmp", os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0640)
+	if err != nil {
+		return DeviceArchive{}, err
+	}
+	defer func() {
+		if err != nil {
+			_ = file.Close()
+			_ = os.Remove(path + ".tmp")
+		}
+	}()
+
+	digest := sha256.New()
+	zw := gzip.NewWriter(io.MultiWriter(file, digest))
+	encoder := json.NewEncoder(zw)
+	for _, e := range events {
+		if err = encoder.Encode(e); err != nil {
+			return DeviceArchive{}, err
+		}
+	}
+	if err = zw.Close(); err != nil {
+		return DeviceArchive{}, err
+	}
+	if err = file.Sync(); err != nil {
+		return DeviceArchive{}, err
+	}
+	if err = file.Close(); err != nil {
+		return DeviceArchive{}, err
+	}
+	if err = os.Rename(path+".tmp", path); err != nil {
+		return DeviceArchive{}, err
+	}
+
+	archive := DeviceArchive{
+		Device:  deviceId,
+		File:    path,
+		Events:  len(events),
+		Sha256:  hex.EncodeToString(digest.Sum(nil)),
+		Created: now.UnixNano() / int64(time.Millisecond),
+	}
+	lc.Info(fmt.Sprintf("Archived %d events of device %s to %s", archive.Events, deviceId, path))
+	return archive, nil
+}
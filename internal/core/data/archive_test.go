@@ -0,0 +1,96 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package data
+
+import (
+	"bufio"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/interfaces/mocks"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveFileName(t *testing.T) {
+	now := time.Date(2020, 6, 1, 12, 30, 15, 250*int(time.Millisecond), time.UTC)
+
+	assert.Equal(t, "thermostat-1-20200601T123015.250Z.jsonl.gz", archiveFileName("thermostat-1", now))
+	assert.Equal(t, ".._etc_passwd-20200601T123015.250Z.jsonl.gz", archiveFileName("../etc/passwd", now))
+}
+
+func TestArchiveDeviceEvents(t *testing.T) {
+	directory, err := ioutil.TempDir("", "archive")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(directory) }()
+
+	events := []contract.Event{
+		{ID: "2", Device: testDeviceName, Created: 200},
+		{ID: "1", Device: testDeviceName, Created: 100},
+	}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("EventsForDevice", testDeviceName).Return(events, nil)
+
+	now := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+	archive, err := archiveDeviceEvents(testDeviceName, directory, now, logger.NewMockClient(), dbClientMock)
+	require.NoError(t, err)
+
+	assert.Equal(t, testDeviceName, archive.Device)
+	assert.Equal(t, filepath.Join(directory, archiveFileName(testDeviceName, now)), archive.File)
+	assert.Equal(t, 2, archive.Events)
+	assert.Equal(t, now.UnixNano()/int64(time.Millisecond), archive.Created)
+
+	content, err := ioutil.ReadFile(archive.File)
+	require.NoError(t, err)
+	digest := sha256.Sum256(content)
+	assert.Equal(t, hex.EncodeToString(digest[:]), archive.Sha256)
+
+	file, err := os.Open(archive.File)
+	require.NoError(t, err)
+	defer func() { _ = file.Close() }()
+	zr, err := gzip.NewReader(file)
+	require.NoError(t, err)
+	var ids []string
+	scanner := bufio.NewScanner(zr)
+	for scanner.Scan() {
+		var e contract.Event
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &e))
+		ids = append(ids, e.ID)
+	}
+	require.NoError(t, scanner.Err())
+	assert.Equal(t, []string{"1", "2"}, ids, "the events are archived oldest first")
+
+	_, err = os.Stat(archive.File + ".tmp")
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestArchiveDeviceEventsError(t *testing.T) {
+	directory, err := ioutil.TempDir("", "archive")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(directory) }()
+
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("EventsForDevice", testDeviceName).Return(nil, fmt.Errorf("some error"))
+
+	_, err = archiveDeviceEvents(testDeviceName, directory, time.Now(), logger.NewMockClient(), dbClientMock)
+	assert.Error(t, err)
+
+	files, err := ioutil.ReadDir(directory)
+	require.NoError(t, err)
+	assert.Empty(t, files)
+}
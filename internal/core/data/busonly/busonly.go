@@ -0,0 +1,258 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package busonly selects the readings of the device profiles, or of some of their resources, which are published to
+// the message bus but never persisted, so that high-rate streams don't load the database while the other readings of
+// the same events are persisted as usual.
+package busonly
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+const (
+	defaultProfileCacheTTL = time.Minute
+	// maxCachedProfiles is the number of devices whose profile is cached, beyond which the cache is cleared
+	maxCachedProfiles = 10000
+)
+
+// BusOnlyInfo configures the readings published to the message bus without being persisted.
+type BusOnlyInfo struct {
+	// Rules are the readings initially bus-only, named after their device profile, e.g. 'camera', or after their
+	// device profile and resource, e.g. 'camera/Frame'; the rules are then managed by the API
+	Rules []string
+	// ProfileCacheTTL is how long the profile of a device read from core-metadata is used to match the V1 events of
+	// the device, which don't carry their profile, e.g. '1m'
+	ProfileCacheTTL string
+}
+
+// Rule makes the readings of a device profile, or of one of its resources when Resource is set, bus-only.
+type Rule struct {
+	Profile  string `json:"profile"`
+	Resource string `json:"resource,omitempty"`
+	Created  int64  `json:"created"`
+}
+
+func key(profile string, resource string) string {
+	if resource == "" {
+		return profile
+	}
+	return profile + "/" + resource
+}
+
+// DeviceClient is the part of the metadata.DeviceClient the profiles of the devices are read with.
+type DeviceClient interface {
+	CheckForDevice(ctx context.Context, device string) (contract.Device, error)
+}
+
+type profileEntry struct {
+	profile string
+	expiry  time.Time
+}
+
+// Policy holds the rules of the bus-only readings. A nil Policy persists every reading.
+type Policy struct {
+	client DeviceClient
+	ttl    time.Duration
+
+	mutex    sync.RWMutex
+	rules    map[string]Rule
+	profiles map[string]profileEntry
+}
+
+// NewPolicy creates a Policy of the rules of info, reading the profiles of the devices of the V1 events with client.
+func NewPolicy(info BusOnlyInfo, client DeviceClient) (*Policy, error) {
+	ttl := defaultProfileCacheTTL
+	if info.ProfileCacheTTL != "" {
+		var err error
+		if ttl, err = time.ParseDuration(info.ProfileCacheTTL); err != nil || ttl < 0 {
+			return nil, fmt.Errorf("invalid BusOnly ProfileCacheTTL '%s'", info.ProfileCacheTTL)
+		}
+	}
+
+	p := &Policy{
+		client:   client,
+		ttl:      ttl,
+		rules:    make(map[string]Rule, len(info.Rules)),
+		profiles: make(map[string]profileEntry),
+	}
+	for _, name := range info.Rules {
+		parts := strings.SplitN(name, "/", 2)
+		resource := ""
+		if len(parts) == 2 {
+			resource = parts[1]
+		}
+		if _, _, err := p.Add(parts[0], resource); err != nil {
+			return nil, fmt.Errorf("invalid BusOnly rule '%s': %v", name, err)
+		}
+	}
+	return p, nil
+}
+
+// Add makes the readings of the profile, or of its resource when not empty, bus-only, and returns the rule along with
+// whether it's new.
+func (p *Policy) Add(profile string, resource string) (Rule, bool, error) {
+	if profile == "" {
+		return Rule{}, false, fmt.Errorf("the profile of a rule can't be empty")
+	}
+	if strings.Contains(profile, "/") {
+		return Rule{}, false, fmt.Errorf("the profile of a rule can't contain '/'")
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	k := key(profile, resource)
+	if rule, ok := p.rules[k]; ok {
+		return rule, false, nil
+	}
+	rule := Rule{Profile: profile, Resource: resource, Created: db.MakeTimestamp()}
+	p.rules[k] = rule
+	return rule, true, nil
+}
+
+// Delete removes the rule of the profile, or of its resource when not empty, and reports whether it existed. The
+// rule of a profile and the ones of its resources are distinct.
+func (p *Policy) Delete(profile string, resource string) bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	k := key(profile, resource)
+	_, ok := p.rules[k]
+	delete(p.rules, k)
+	return ok
+}
+
+// Rules returns the rules ordered by profile and resource.
+func (p *Policy) Rules() []Rule {
+	if p == nil {
+		return []Rule{}
+	}
+
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+	rules := make([]Rule, 0, len(p.rules))
+	for _, rule := range p.rules {
+		rules = append(rules, rule)
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		if rules[i].Profile != rules[j].Profile {
+			return rules[i].Profile < rules[j].Profile
+		}
+		return rules[i].Resource < rules[j].Resource
+	})
+	return rules
+}
+
+// BusOnly reports whether the reading of the resource of the profile is bus-only, by a rule of either.
+func (p *Policy) BusOnly(profile string, resource string) bool {
+	if p == nil {
+		return false
+	}
+
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+	if _, ok := p.rules[profile]; ok {
+		return true
+	}
+	_, ok := p.rules[key(profile, resource)]
+	return ok
+}
+
+// active reports whether any reading is bus-only, for the events not to be matched otherwise.
+func (p *Policy) active() bool {
+	if p == nil {
+		return false
+	}
+
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+	return len(p.rules) > 0
+}
+
+// FilterV1 returns the V1 API event without its bus-only readings, and whether it's persisted, which it isn't when
+// all of its readings are bus-only. The profile of the device of the event is read from core-metadata, unless cached.
+func (p *Policy) FilterV1(ctx context.Context, e contract.Event) (contract.Event, bool, error) {
+	if !p.active() || len(e.Readings) == 0 {
+		return e, true, nil
+	}
+
+	profile, err := p.profile(ctx, e.Device)
+	if err != nil {
+		return e, true, err
+	}
+	readings := make([]contract.Reading, 0, len(e.Readings))
+	for _, r := range e.Readings {
+		if !p.BusOnly(profile, r.Name) {
+			readings = append(readings, r)
+		}
+	}
+	if len(readings) == len(e.Readings) {
+		return e, true, nil
+	}
+	e.Readings = readings
+	return e, len(readings) > 0, nil
+}
+
+// FilterV2 returns the V2 API event without its bus-only readings, matched by the profile they carry, and whether
+// it's persisted, which it isn't when all of its readings are bus-only.
+func (p *Policy) FilterV2(e models.Event) (models.Event, bool) {
+	if !p.active() || len(e.Readings) == 0 {
+		return e, true
+	}
+
+	readings := make([]models.Reading, 0, len(e.Readings))
+	for _, r := range e.Readings {
+		var base models.BaseReading
+		switch reading := r.(type) {
+		case models.SimpleReading:
+			base = reading.BaseReading
+		case models.BinaryReading:
+			base = reading.BaseReading
+		}
+		if !p.BusOnly(base.ProfileName, base.ResourceName) {
+			readings = append(readings, r)
+		}
+	}
+	if len(readings) == len(e.Readings) {
+		return e, true
+	}
+	e.Readings = readings
+	return e, len(readings) > 0
+}
+
+// profile returns the name of the profile of the device, named or identified by device, from the cache unless it
+// expired.
+func (p *Policy) profile(ctx context.Context, device string) (string, error) {
+	now := time.Now()
+	p.mutex.RLock()
+	entry, ok := p.profiles[device]
+	p.mutex.RUnlock()
+	if ok && now.Before(entry.expiry) {
+		return entry.profile, nil
+	}
+
+	d, err := p.client.CheckForDevice(ctx, device)
+	if err != nil {
+		return "", err
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if len(p.profiles) >= maxCachedProfiles {
+		// the devices removed from core-metadata would otherwise stay cached
+		p.profiles = make(map[string]profileEntry)
+	}
+	p.profiles[device] = profileEntry{profile: d.Profile.Name, expiry: now.Add(p.ttl)}
+	return d.Profile.Name, nil
+}
@@ -0,0 +1,119 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package busonly
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeDeviceClient struct {
+	profiles map[string]string
+	calls    int
+}
+
+func (c *fakeDeviceClient) CheckForDevice(_ context.Context, device string) (contract.Device, error) {
+	c.calls++
+	profile, ok := c.profiles[device]
+	if !ok {
+		return contract.Device{}, errors.New("device not found")
+	}
+	return contract.Device{Name: device, Profile: contract.DeviceProfile{Name: profile}}, nil
+}
+
+func TestNilPolicyPersistsEverything(t *testing.T) {
+	var policy *Policy
+	assert.False(t, policy.BusOnly("camera", "Frame"))
+	assert.Empty(t, policy.Rules())
+
+	e := contract.Event{Device: "camera-01", Readings: []contract.Reading{{Name: "Frame"}}}
+	filtered, persisted, err := policy.FilterV1(context.Background(), e)
+	require.NoError(t, err)
+	assert.True(t, persisted)
+	assert.Equal(t, e, filtered)
+}
+
+func TestRules(t *testing.T) {
+	policy, err := NewPolicy(BusOnlyInfo{Rules: []string{"camera/Frame", "vibration"}}, &fakeDeviceClient{})
+	require.NoError(t, err)
+
+	assert.True(t, policy.BusOnly("camera", "Frame"))
+	assert.False(t, policy.BusOnly("camera", "Status"))
+	assert.True(t, policy.BusOnly("vibration", "Acceleration"), "a rule of a profile should apply to all its resources")
+
+	rule, created, err := policy.Add("camera", "")
+	require.NoError(t, err)
+	assert.True(t, created)
+	assert.Equal(t, "camera", rule.Profile)
+	_, created, err = policy.Add("camera", "")
+	require.NoError(t, err)
+	assert.False(t, created)
+	_, _, err = policy.Add("", "Frame")
+	assert.Error(t, err)
+
+	var keys []string
+	for _, rule := range policy.Rules() {
+		keys = append(keys, key(rule.Profile, rule.Resource))
+	}
+	assert.Equal(t, []string{"camera", "camera/Frame", "vibration"}, keys)
+
+	assert.True(t, policy.Delete("camera", ""))
+	assert.False(t, policy.Delete("camera", ""))
+	assert.True(t, policy.BusOnly("camera", "Frame"), "deleting the rule of a profile should keep the ones of its resources")
+	assert.False(t, policy.BusOnly("camera", "Status"))
+
+	_, err = NewPolicy(BusOnlyInfo{ProfileCacheTTL: "soon"}, &fakeDeviceClient{})
+	assert.Error(t, err)
+	_, err = NewPolicy(BusOnlyInfo{Rules: []string{"/Frame"}}, &fakeDeviceClient{})
+	assert.Error(t, err)
+}
+
+func TestFilterV1(t *testing.T) {
+	client := &fakeDeviceClient{profiles: map[string]string{"camera-01": "camera"}}
+	policy, err := NewPolicy(BusOnlyInfo{Rules: []string{"camera/Frame"}}, client)
+	require.NoError(t, err)
+
+	e := contract.Event{Device: "camera-01", Readings: []contract.Reading{{Name: "Frame"}, {Name: "Status"}}}
+	filtered, persisted, err := policy.FilterV1(context.Background(), e)
+	require.NoError(t, err)
+	assert.True(t, persisted)
+	assert.Equal(t, []contract.Reading{{Name: "Status"}}, filtered.Readings)
+	assert.Len(t, e.Readings, 2, "the event should be left whole to be published")
+
+	_, persisted, err = policy.FilterV1(context.Background(), contract.Event{Device: "camera-01", Readings: []contract.Reading{{Name: "Frame"}}})
+	require.NoError(t, err)
+	assert.False(t, persisted, "an event of bus-only readings only shouldn't be persisted")
+	assert.Equal(t, 1, client.calls, "the profile of the device should be cached")
+
+	_, persisted, err = policy.FilterV1(context.Background(), contract.Event{Device: "unknown", Readings: []contract.Reading{{Name: "Frame"}}})
+	assert.Error(t, err)
+	assert.True(t, persisted)
+}
+
+func TestFilterV2(t *testing.T) {
+	policy, err := NewPolicy(BusOnlyInfo{Rules: []string{"camera/Frame"}}, &fakeDeviceClient{})
+	require.NoError(t, err)
+
+	frame := models.BinaryReading{BaseReading: models.BaseReading{ProfileName: "camera", ResourceName: "Frame"}}
+	status := models.SimpleReading{BaseReading: models.BaseReading{ProfileName: "camera", ResourceName: "Status"}}
+
+	filtered, persisted := policy.FilterV2(models.Event{DeviceName: "camera-01", Readings: []models.Reading{frame, status}})
+	assert.True(t, persisted)
+	assert.Equal(t, []models.Reading{status}, filtered.Readings)
+
+	_, persisted = policy.FilterV2(models.Event{DeviceName: "camera-01", Readings: []models.Reading{frame}})
+	assert.False(t, persisted)
+
+	_, persisted = policy.FilterV2(models.Event{DeviceName: "camera-01"})
+	assert.True(t, persisted, "an event without readings should be persisted")
+}
@@ -0,0 +1,86 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package data
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// CompactEvent is an event as returned by the event queries called with compact=true, for the clients on constrained
+// links: the IDs and the timestamps other than the origin are omitted, along with the device of each reading, which is
+// the device of the event.
+type CompactEvent struct {
+	Device   string            `json:"device"`
+	Origin   int64             `json:"origin,omitempty"`
+	Tags     map[string]string `json:"tags,omitempty"`
+	Readings []CompactReading  `json:"readings,omitempty"`
+}
+
+// CompactReading is a reading of a CompactEvent. Its origin is omitted when it's the origin of the event.
+type CompactReading struct {
+	Name          string `json:"name"`
+	Value         string `json:"value,omitempty"`
+	BinaryValue   []byte `json:"binaryValue,omitempty"`
+	ValueType     string `json:"valueType,omitempty"`
+	FloatEncoding string `json:"floatEncoding,omitempty"`
+	Origin        int64  `json:"origin,omitempty"`
+}
+
+// compactEvent returns the compact form of e.
+func compactEvent(e contract.Event) CompactEvent {
+	c := CompactEvent{Device: e.Device, Origin: e.Origin, Tags: e.Tags}
+	if len(e.Readings) > 0 {
+		c.Readings = make([]CompactReading, len(e.Readings))
+	}
+	for i, r := range e.Readings {
+		c.Readings[i] = CompactReading{
+			Name:          r.Name,
+			Value:         r.Value,
+			BinaryValue:   r.BinaryValue,
+			ValueType:     r.ValueType,
+			FloatEncoding: r.FloatEncoding,
+		}
+		if r.Origin != e.Origin {
+			c.Readings[i].Origin = r.Origin
+		}
+	}
+	return c
+}
+
+// isCompact tells whether the request asks for the compact form of the events with compact=true.
+func isCompact(r *http.Request) bool {
+	compact, err := strconv.ParseBool(r.URL.Query().Get(COMPACT))
+	return err == nil && compact
+}
+
+// encodeEvents writes the events in the form asked for by the request.
+func encodeEvents(events []contract.Event, w http.ResponseWriter, r *http.Request, lc logger.LoggingClient) {
+	if !isCompact(r) {
+		pkg.Encode(events, w, lc)
+		return
+	}
+
+	compact := make([]CompactEvent, len(events))
+	for i, e := range events {
+		compact[i] = compactEvent(e)
+	}
+	pkg.Encode(compact, w, lc)
+}
+
+// encodeEvent writes the event in the form asked for by the request.
+func encodeEvent(e contract.Event, w http.ResponseWriter, r *http.Request, lc logger.LoggingClient) {
+	if !isCompact(r) {
+		pkg.Encode(e, w, lc)
+		return
+	}
+	pkg.Encode(compactEvent(e), w, lc)
+}
@@ -0,0 +1,102 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package data
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func compactTestEvent() contract.Event {
+	return contract.Event{
+		ID:       "7a1707f0-166f-4c4b-bc9d-1d54c74e0137",
+		Device:   "thermostat",
+		Origin:   1600000000000,
+		Created:  1600000000100,
+		Modified: 1600000000100,
+		Tags:     map[string]string{"site": "north"},
+		Readings: []contract.Reading{
+			{
+				Id:        "9f2d5b1e-2c2a-4c4e-8b7e-2f1e7c2d1a01",
+				Device:    "thermostat",
+				Name:      "temperature",
+				Value:     "21.5",
+				ValueType: contract.ValueTypeFloat64,
+				Origin:    1600000000000,
+				Created:   1600000000100,
+			},
+			{
+				Id:        "9f2d5b1e-2c2a-4c4e-8b7e-2f1e7c2d1a02",
+				Device:    "thermostat",
+				Name:      "humidity",
+				Value:     "40",
+				ValueType: contract.ValueTypeInt64,
+				Origin:    1600000000050,
+				Created:   1600000000100,
+			},
+		},
+	}
+}
+
+func TestEncodeEventsCompact(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/event/device/thermostat/10?compact=true", nil)
+	w := httptest.NewRecorder()
+
+	encodeEvents([]contract.Event{compactTestEvent()}, w, r, logger.NewMockClient())
+
+	var events []map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &events))
+	require.Len(t, events, 1)
+	assert.Equal(t, map[string]interface{}{
+		"device": "thermostat",
+		"origin": float64(1600000000000),
+		"tags":   map[string]interface{}{"site": "north"},
+		"readings": []interface{}{
+			map[string]interface{}{"name": "temperature", "value": "21.5", "valueType": contract.ValueTypeFloat64},
+			map[string]interface{}{
+				"name":      "humidity",
+				"value":     "40",
+				"valueType": contract.ValueTypeInt64,
+				"origin":    float64(1600000000050),
+			},
+		},
+	}, events[0], "the origin of a reading should only be given when it's not the origin of its event")
+}
+
+func TestEncodeEventsNotCompact(t *testing.T) {
+	for _, target := range []string{"/api/v1/event/device/thermostat/10", "/api/v1/event/device/thermostat/10?compact=no"} {
+		r := httptest.NewRequest(http.MethodGet, target, nil)
+		w := httptest.NewRecorder()
+
+		encodeEvents([]contract.Event{compactTestEvent()}, w, r, logger.NewMockClient())
+
+		var events []map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &events), target)
+		require.Len(t, events, 1, target)
+		assert.Equal(t, "7a1707f0-166f-4c4b-bc9d-1d54c74e0137", events[0]["id"], target)
+		assert.Contains(t, w.Body.String(), "9f2d5b1e-2c2a-4c4e-8b7e-2f1e7c2d1a02", target)
+	}
+}
+
+func TestEncodeEventCompact(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/event/7a1707f0-166f-4c4b-bc9d-1d54c74e0137?compact=1", nil)
+	w := httptest.NewRecorder()
+
+	encodeEvent(compactTestEvent(), w, r, logger.NewMockClient())
+
+	var event CompactEvent
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &event))
+	assert.Equal(t, compactEvent(compactTestEvent()), event)
+	assert.NotContains(t, w.Body.String(), "7a1707f0")
+}
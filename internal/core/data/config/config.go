@@ -16,17 +16,67 @@ package config
 import (
 	"fmt"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/data/ack"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/busonly"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/quota"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/shadow"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/units"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/virtual"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/authz"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dependency"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/gateway"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/httptuning"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/mtls"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/readygate"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/selftest"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/slo"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/sysevent"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/telemetry"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/tracing"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/unixsocket"
+
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
 )
 
 type ConfigurationStruct struct {
-	Writable     WritableInfo
-	MessageQueue MessageQueueInfo
-	Clients      map[string]bootstrapConfig.ClientInfo
-	Databases    map[string]bootstrapConfig.Database
-	Registry     bootstrapConfig.RegistryInfo
-	Service      bootstrapConfig.ServiceInfo
-	SecretStore  bootstrapConfig.SecretStoreInfo
+	Writable         WritableInfo
+	MessageQueue     MessageQueueInfo
+	Clients          map[string]bootstrapConfig.ClientInfo
+	Databases        map[string]bootstrapConfig.Database
+	DatabaseDecoding db.DecodingInfo
+	Registry         bootstrapConfig.RegistryInfo
+	Service          bootstrapConfig.ServiceInfo
+	SecretStore      bootstrapConfig.SecretStoreInfo
+	SLO              slo.SLOInfo
+	Authorization    authz.AuthorizationInfo
+	RBAC             authz.RBACInfo
+	UnixSocket       unixsocket.UnixSocketInfo
+	HttpTuning       httptuning.HttpTuningInfo
+	MutualTLS        mtls.MutualTLSInfo
+	Tracing          tracing.TracingInfo
+	Telemetry        telemetry.TelemetryInfo
+	Audit            audit.AuditInfo
+	DependencyCheck  dependency.DependencyCheckInfo
+	Gateway          gateway.GatewayInfo
+	SelfTest         selftest.SelfTestInfo
+	SystemEvents     sysevent.SystemEventsInfo
+	ReadyGate        readygate.ReadyGateInfo
+	EventValidation  EventValidationInfo
+	Acknowledgment   ack.AcknowledgmentInfo
+	Quotas           quota.QuotasInfo
+	BusOnly          busonly.BusOnlyInfo
+	Shadow           shadow.ShadowInfo
+	Units            units.UnitsInfo
+	Rollups          RollupsInfo
+	KafkaExport      KafkaExportInfo
+	Archive          ArchiveInfo
+	// VirtualResources are computed from the rollups and queried as if they were real resources
+	VirtualResources []virtual.ResourceInfo
+
+	// resolveWritable resolves the secret references of the writable configuration received from the registry
+	resolveWritable func(writable interface{}) bool
 }
 
 type WritableInfo struct {
@@ -37,6 +87,61 @@ type WritableInfo struct {
 	ValidateCheck              bool
 	LogLevel                   string
 	ChecksumAlgo               string
+	// BypassEventValidation lets the incoming events through without running the EventValidation validators, meant
+	// for emergencies when a validator wrongly rejects valid events
+	BypassEventValidation bool
+}
+
+// EventValidationInfo selects the validators, compiled into the service, run on every incoming event before it is
+// persisted.
+type EventValidationInfo struct {
+	// Validators are the names of the registered validators run on the events, in order
+	Validators []string
+	// Parameters holds the parameters passed to each validator, by validator name
+	Parameters map[string]map[string]string
+}
+
+// RollupsInfo configures the hourly and daily rollups of the numeric readings, maintained as the events are received.
+type RollupsInfo struct {
+	Enabled bool
+	// FlushInterval is how often the values aggregated in memory are merged into the stored rollups
+	FlushInterval string
+	// HourlyRetention is how long the hourly rollups are kept, forever when empty
+	HourlyRetention string
+	// DailyRetention is how long the daily rollups are kept, forever when empty
+	DailyRetention string
+}
+
+// KafkaExportInfo configures the continuous export of the events persisted to Kafka.
+type KafkaExportInfo struct {
+	Enabled bool
+	// Brokers are the host:port of the brokers the cluster is discovered from
+	Brokers  []string
+	ClientId string
+	// Topic receives all the events, unless TopicPerProfile is set
+	Topic string
+	// TopicPerProfile sends the events to a topic per device profile, named after the profile prefixed by TopicPrefix
+	TopicPerProfile bool
+	TopicPrefix     string
+	// RequiredAcks is all, waiting for all the in-sync replicas to write the events, or leader
+	RequiredAcks string
+	// Timeout bounds the connections and the requests to the brokers
+	Timeout string
+	// QueueSize is the number of events waiting for their export beyond which the new events are dropped
+	QueueSize int
+	// BatchSize is the maximum number of events produced at once
+	BatchSize int
+	// RetryBackoff is the delay before producing again the events which couldn't be, doubled after every failure up to
+	// MaxRetryBackoff
+	RetryBackoff    string
+	MaxRetryBackoff string
+}
+
+// ArchiveInfo configures the archives of the events of the devices, written before the events of a decommissioned
+// device are deleted.
+type ArchiveInfo struct {
+	// Directory receives the archives, one gzipped JSON lines file per archive
+	Directory string
 }
 
 // MessageQueueInfo provides parameters related to connecting to a message queue
@@ -62,6 +167,15 @@ func (m MessageQueueInfo) URL() string {
 	return fmt.Sprintf("%s://%s:%v", m.Protocol, m.Host, m.Port)
 }
 
+// MessageBusAddress returns the address of the broker of the message bus, none when the service binds the publisher
+// itself, listening on all the interfaces.
+func (c *ConfigurationStruct) MessageBusAddress() (string, bool) {
+	if c.MessageQueue.Host == "" || c.MessageQueue.Host == "*" {
+		return "", false
+	}
+	return fmt.Sprintf("%s:%d", c.MessageQueue.Host, c.MessageQueue.Port), true
+}
+
 // UpdateFromRaw converts configuration received from the registry to a service-specific configuration struct which is
 // then used to overwrite the service's existing configuration struct.
 func (c *ConfigurationStruct) UpdateFromRaw(rawConfig interface{}) bool {
@@ -83,15 +197,22 @@ func (c *ConfigurationStruct) EmptyWritablePtr() interface{} {
 }
 
 // UpdateWritableFromRaw converts configuration received from the registry to a service-specific WritableInfo struct
-// which is then used to overwrite the service's existing configuration's WritableInfo struct.
+// which is then used to overwrite the service's existing configuration's WritableInfo struct, once its secret
+// references are resolved.
 func (c *ConfigurationStruct) UpdateWritableFromRaw(rawWritable interface{}) bool {
 	writable, ok := rawWritable.(*WritableInfo)
-	if ok {
+	if ok && (c.resolveWritable == nil || c.resolveWritable(writable)) {
 		c.Writable = *writable
 	}
 	return ok
 }
 
+// SetWritableResolver sets the function resolving the secret references of the writable configuration received from
+// the registry, which is only applied when they are.
+func (c *ConfigurationStruct) SetWritableResolver(resolve func(writable interface{}) bool) {
+	c.resolveWritable = resolve
+}
+
 // GetBootstrap returns the configuration elements required by the bootstrap.  Currently, a copy of the configuration
 // data is returned.  This is intended to be temporary -- since ConfigurationStruct drives the configuration.toml's
 // structure -- until we can make backwards-breaking configuration.toml changes (which would consolidate these fields
@@ -120,3 +241,8 @@ func (c *ConfigurationStruct) GetRegistryInfo() bootstrapConfig.RegistryInfo {
 func (c *ConfigurationStruct) GetDatabaseInfo() map[string]bootstrapConfig.Database {
 	return c.Databases
 }
+
+// GetDatabaseDecodePolicy returns the decode policy of the objects read from the database.
+func (c *ConfigurationStruct) GetDatabaseDecodePolicy() string {
+	return c.DatabaseDecoding.Policy
+}
@@ -17,6 +17,7 @@ const (
 	SCRUB          = "scrub"
 	SCRUBALL       = "scruball"
 	COUNT          = "count"
+	COMPACT        = "compact"
 	CHECKSUM       = "checksum"
 	LABEL          = "label"
 	DEVICEID_PARAM = "deviceId"
@@ -35,4 +36,18 @@ const (
 	NAMES          = "names"
 	DEVICE         = "device"
 	USAGE          = "usage"
+	VALIDATION     = "validation"
+	UNITS          = "units"
+	ROLLUP         = "rollup"
+	PERIOD         = "period"
+	WINDOW         = "window"
+	RENAME         = "rename"
+	ARCHIVE        = "archive"
+	KAFKA          = "kafka"
+	METRICS        = "metrics"
+	INGESTION      = "ingestion"
+	BUSONLY        = "busonly"
+	PROFILE        = "profile"
+	RESOURCE       = "resource"
+	EXPLAIN        = "explain"
 )
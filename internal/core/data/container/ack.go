@@ -0,0 +1,21 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/data/ack"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// AckDispatcherName contains the name of the ack.Dispatcher implementation in the DIC.
+var AckDispatcherName = di.TypeInstanceToName(ack.Dispatcher{})
+
+// AckDispatcherFrom helper function queries the DIC and returns the ack.Dispatcher implementation.
+func AckDispatcherFrom(get di.Get) *ack.Dispatcher {
+	dispatcher, _ := get(AckDispatcherName).(*ack.Dispatcher)
+	return dispatcher
+}
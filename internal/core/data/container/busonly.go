@@ -0,0 +1,21 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/data/busonly"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// BusOnlyPolicyName contains the name of the busonly.Policy implementation in the DIC.
+var BusOnlyPolicyName = di.TypeInstanceToName(busonly.Policy{})
+
+// BusOnlyPolicyFrom helper function queries the DIC and returns the busonly.Policy implementation.
+func BusOnlyPolicyFrom(get di.Get) *busonly.Policy {
+	policy, _ := get(BusOnlyPolicyName).(*busonly.Policy)
+	return policy
+}
@@ -0,0 +1,21 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/data/ingestion"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// IngestionMetricsName contains the name of the ingestion.Metrics implementation in the DIC.
+var IngestionMetricsName = di.TypeInstanceToName(ingestion.Metrics{})
+
+// IngestionMetricsFrom helper function queries the DIC and returns the ingestion.Metrics implementation.
+func IngestionMetricsFrom(get di.Get) *ingestion.Metrics {
+	metrics, _ := get(IngestionMetricsName).(*ingestion.Metrics)
+	return metrics
+}
@@ -0,0 +1,22 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/data/kafka"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// KafkaExporterName contains the name of the kafka.Exporter implementation in the DIC.
+var KafkaExporterName = di.TypeInstanceToName(kafka.Exporter{})
+
+// KafkaExporterFrom helper function queries the DIC and returns the kafka.Exporter implementation, nil when the
+// export to Kafka is disabled.
+func KafkaExporterFrom(get di.Get) *kafka.Exporter {
+	exporter, _ := get(KafkaExporterName).(*kafka.Exporter)
+	return exporter
+}
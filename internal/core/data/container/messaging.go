@@ -15,9 +15,9 @@
 package container
 
 import (
-	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/messaging"
 
-	"github.com/edgexfoundry/go-mod-messaging/messaging"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
 )
 
 // MessagingClientName contains the name of the messaging client instance in the DIC.
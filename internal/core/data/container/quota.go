@@ -0,0 +1,22 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/data/quota"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// QuotaEnforcerName contains the name of the quota.Enforcer implementation in the DIC.
+var QuotaEnforcerName = di.TypeInstanceToName(quota.Enforcer{})
+
+// QuotaEnforcerFrom helper function queries the DIC and returns the quota.Enforcer implementation, nil when the quotas
+// are disabled.
+func QuotaEnforcerFrom(get di.Get) *quota.Enforcer {
+	enforcer, _ := get(QuotaEnforcerName).(*quota.Enforcer)
+	return enforcer
+}
@@ -0,0 +1,22 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/data/rollup"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// RollupMaintainerName contains the name of the rollup.Maintainer implementation in the DIC.
+var RollupMaintainerName = di.TypeInstanceToName(rollup.Maintainer{})
+
+// RollupMaintainerFrom helper function queries the DIC and returns the rollup.Maintainer implementation, nil when the
+// rollups are disabled.
+func RollupMaintainerFrom(get di.Get) *rollup.Maintainer {
+	maintainer, _ := get(RollupMaintainerName).(*rollup.Maintainer)
+	return maintainer
+}
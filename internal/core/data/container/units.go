@@ -0,0 +1,20 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/data/units"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// UnitConverterName contains the name of the units.Converter implementation in the DIC.
+var UnitConverterName = di.TypeInstanceToName(units.Converter{})
+
+// UnitConverterFrom helper function queries the DIC and returns the units.Converter implementation.
+func UnitConverterFrom(get di.Get) *units.Converter {
+	return get(UnitConverterName).(*units.Converter)
+}
@@ -0,0 +1,22 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/data/validator"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// EventValidatorsName contains the name of the validator.Chain implementation in the DIC.
+var EventValidatorsName = di.TypeInstanceToName(validator.Chain{})
+
+// EventValidatorsFrom helper function queries the DIC and returns the validator.Chain implementation, nil when no
+// chain is registered so that every event is accepted.
+func EventValidatorsFrom(get di.Get) *validator.Chain {
+	validators, _ := get(EventValidatorsName).(*validator.Chain)
+	return validators
+}
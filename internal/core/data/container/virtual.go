@@ -0,0 +1,20 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/data/virtual"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// VirtualResourcesName contains the name of the virtual.Resources implementation in the DIC.
+var VirtualResourcesName = di.TypeInstanceToName(virtual.Resources{})
+
+// VirtualResourcesFrom helper function queries the DIC and returns the virtual.Resources implementation.
+func VirtualResourcesFrom(get di.Get) *virtual.Resources {
+	return get(VirtualResourcesName).(*virtual.Resources)
+}
@@ -170,3 +170,69 @@ func (e ErrInvalidId) Error() string {
 func NewErrInvalidId(id string) error {
 	return ErrInvalidId{id: id}
 }
+
+type ErrEventRejected struct {
+	validator string
+	err       error
+}
+
+func (e ErrEventRejected) Error() string {
+	return fmt.Sprintf("event rejected by validator '%s': %v", e.validator, e.err)
+}
+
+func NewErrEventRejected(validator string, err error) error {
+	return ErrEventRejected{validator: validator, err: err}
+}
+
+type ErrUnsupportedUnits struct {
+	units string
+}
+
+func (e ErrUnsupportedUnits) Error() string {
+	return fmt.Sprintf("units '%s' are neither a system of units nor a unit of the conversion table", e.units)
+}
+
+func NewErrUnsupportedUnits(units string) error {
+	return ErrUnsupportedUnits{units: units}
+}
+
+type ErrEventTooLarge struct {
+	device string
+	quota  string
+	limit  int
+	size   int
+}
+
+func (e ErrEventTooLarge) Error() string {
+	return fmt.Sprintf("event of device '%s' exceeds its quota of %d %s with %d", e.device, e.limit, e.quota, e.size)
+}
+
+func NewErrEventTooLarge(device string, quota string, limit int, size int) error {
+	return ErrEventTooLarge{device: device, quota: quota, limit: limit, size: size}
+}
+
+type ErrEventRateExceeded struct {
+	device string
+	limit  int
+}
+
+func (e ErrEventRateExceeded) Error() string {
+	return fmt.Sprintf("device '%s' exceeds its quota of %d events per hour", e.device, e.limit)
+}
+
+func NewErrEventRateExceeded(device string, limit int) error {
+	return ErrEventRateExceeded{device: device, limit: limit}
+}
+
+type ErrEventNotPublished struct {
+	id  string
+	err error
+}
+
+func (e ErrEventNotPublished) Error() string {
+	return fmt.Sprintf("event '%s' was persisted but not published: %s", e.id, e.err.Error())
+}
+
+func NewErrEventNotPublished(id string, err error) error {
+	return ErrEventNotPublished{id: id, err: err}
+}
@@ -19,18 +19,25 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/data/ack"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/busonly"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/errors"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/kafka"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/quota"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/rollup"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/validator"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation/models"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/messaging"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/tracing"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/clients"
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/metadata"
 	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
-	"github.com/edgexfoundry/go-mod-messaging/messaging"
 	msgTypes "github.com/edgexfoundry/go-mod-messaging/pkg/types"
 )
 
@@ -105,6 +112,12 @@ func addNewEvent(
 	chEvents chan<- interface{},
 	msgClient messaging.MessageClient,
 	mdc metadata.DeviceClient,
+	validators *validator.Chain,
+	quotas *quota.Enforcer,
+	rollups *rollup.Maintainer,
+	exporter *kafka.Exporter,
+	busOnly *busonly.Policy,
+	level ack.Level,
 	configuration *config.ConfigurationStruct) (string, error) {
 
 	err := checkDevice(e.Device, ctx, mdc, configuration)
@@ -112,6 +125,17 @@ func addNewEvent(
 		return "", err
 	}
 
+	err = quotas.Check(ctx, validator.FromV1(e.Event))
+	if err != nil {
+		return "", err
+	}
+
+	err = validators.Validate(validator.FromV1(e.Event), configuration.Writable.BypassEventValidation)
+	if err != nil {
+		lc.Debug(err.Error(), clients.CorrelationHeader, correlation.FromContext(ctx))
+		return "", err
+	}
+
 	if configuration.Writable.ValidateCheck {
 		lc.Debug("Validation enabled, parsing events")
 		for reading := range e.Readings {
@@ -132,22 +156,46 @@ func addNewEvent(
 		}
 	}
 
-	// Add the event and readings to the database
+	// Add the event and readings to the database, apart from the bus-only readings
 	if configuration.Writable.PersistData {
 		if e.Created == 0 {
 			e.Created = db.MakeTimestamp()
 		}
-		id, err := dbClient.AddEvent(e)
+		stored, persisted, err := busOnly.FilterV1(ctx, e.Event)
 		if err != nil {
-			return "", err
+			lc.Warn(
+				fmt.Sprintf("failed to read the profile of device %s, persisting all the readings: %s", e.Device, err.Error()),
+				clients.CorrelationHeader,
+				correlation.FromContext(ctx))
+		}
+		if persisted {
+			record := e
+			record.Event = stored
+			_, span := tracing.StartSpanOfKind(ctx, tracing.KindClient, "database AddEvent")
+			id, err := dbClient.AddEvent(record)
+			span.End(err)
+			if err != nil {
+				return "", err
+			}
+			e.ID = id
+			exporter.Export(e.Device, e.Created, stored)
 		}
-		e.ID = id
 	}
 
-	putEventOnQueue(e, ctx, lc, msgClient, configuration) // Push event to message bus for App Services to consume
-	chEvents <- DeviceLastReported{e.Device}              // update last reported connected (device)
-	chEvents <- DeviceServiceLastReported{e.Device}       // update last reported connected (device service)
+	received := db.MakeTimestamp()
+	for _, r := range e.Readings {
+		if r.FloatEncoding != contract.Base64Encoding {
+			rollups.AddReading(e.Device, r.Name, r.Value, received)
+		}
+	}
 
+	err = putEventOnQueue(e, ctx, lc, msgClient, configuration) // Push event to message bus for App Services to consume
+	chEvents <- DeviceLastReported{e.Device}                    // update last reported connected (device)
+	chEvents <- DeviceServiceLastReported{e.Device}             // update last reported connected (device service)
+
+	if err != nil && level == ack.Published {
+		return e.ID, errors.NewErrEventNotPublished(e.ID, err)
+	}
 	return e.ID, nil
 }
 
@@ -282,7 +330,7 @@ func putEventOnQueue(
 	ctx context.Context,
 	lc logger.LoggingClient,
 	msgClient messaging.MessageClient,
-	configuration *config.ConfigurationStruct) {
+	configuration *config.ConfigurationStruct) error {
 
 	lc.Debug("Putting event on message queue")
 
@@ -292,22 +340,26 @@ func putEventOnQueue(
 		data, err := json.Marshal(evt)
 		if err != nil {
 			lc.Error(fmt.Sprintf("error marshaling event: %s", evt.String()))
-			return
+			return err
 		}
 		evt.Bytes = data
 	}
 
 	msgEnvelope := msgTypes.NewMessageEnvelope(evt.Bytes, ctx)
+	_, span := tracing.StartSpanOfKind(ctx, tracing.KindProducer, "publish "+configuration.MessageQueue.Topic)
+	span.SetTag(tracing.MessagingDestinationTag, configuration.MessageQueue.Topic)
 	err := msgClient.Publish(msgEnvelope, configuration.MessageQueue.Topic)
+	span.End(err)
 	if err != nil {
 		lc.Error(fmt.Sprintf("Unable to send message for event: %s %v", evt.String(), err))
-	} else {
-		lc.Debug(fmt.Sprintf(
-			"Event Published on message queue. Topic: %s, Correlation-id: %s ",
-			configuration.MessageQueue.Topic,
-			msgEnvelope.CorrelationID,
-		))
+		return err
 	}
+	lc.Debug(fmt.Sprintf(
+		"Event Published on message queue. Topic: %s, Correlation-id: %s ",
+		configuration.MessageQueue.Topic,
+		msgEnvelope.CorrelationID,
+	))
+	return nil
 }
 
 func getEventsByDeviceIdLimit(
@@ -345,6 +397,29 @@ func deleteEvents(deviceId string, dbClient interfaces.DBClient) (int, error) {
 	return dbClient.DeleteEventsByDevice(deviceId)
 }
 
+// renameDeviceData moves the events, readings and rollups of a device to its new name. The values aggregated in
+// memory are flushed first so that no rollup of the old name is written after the rename.
+func renameDeviceData(
+	from string,
+	to string,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	rollups *rollup.Maintainer) (int, error) {
+
+	if rollups != nil {
+		rollups.Flush()
+	}
+
+	count, err := dbClient.RenameDeviceData(from, to)
+	if err != nil {
+		lc.Error(fmt.Sprintf("failed to rename the data of device %s to %s after %d events: %s", from, to, count, err.Error()))
+		return count, err
+	}
+
+	lc.Info(fmt.Sprintf("Renamed the data of device %s to %s, %d events moved", from, to, count))
+	return count, nil
+}
+
 func scrubPushedEvents(lc logger.LoggingClient, dbClient interfaces.DBClient) (int, error) {
 	lc.Info("Scrubbing events.  Deleting all events that have been pushed")
 
@@ -21,17 +21,17 @@ import (
 	"sync"
 	"testing"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/data/ack"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/errors"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/interfaces/mocks"
 	dataMocks "github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/rollup"
 	correlation "github.com/edgexfoundry/edgex-go/internal/pkg/correlation/models"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
 	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
-	"github.com/edgexfoundry/go-mod-messaging/messaging"
-	msgTypes "github.com/edgexfoundry/go-mod-messaging/pkg/types"
 
 	"github.com/globalsign/mgo/bson"
 	"github.com/stretchr/testify/mock"
@@ -208,15 +208,7 @@ func newAddEventMockDB(persist bool) *dbMock.DBClient {
 func TestAddEventWithPersistence(t *testing.T) {
 	reset()
 
-	// no need to mock this since it's all in process
-	msgClient, _ := messaging.NewMessageClient(msgTypes.MessageBusConfig{
-		PublishHost: msgTypes.HostInfo{
-			Host:     "*",
-			Protocol: "tcp",
-			Port:     5563,
-		},
-		Type: "zero",
-	})
+	msgClient := newTestMessageClient(t)
 
 	dbClientMock := newAddEventMockDB(true)
 	chEvents := make(chan interface{}, 10)
@@ -235,6 +227,12 @@ func TestAddEventWithPersistence(t *testing.T) {
 		chEvents,
 		msgClient,
 		dataMocks.NewMockDeviceClient(),
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		ack.Persisted,
 		&config.ConfigurationStruct{
 			Writable: config.WritableInfo{
 				PersistData: true,
@@ -257,14 +255,7 @@ func TestAddEventWithPersistence(t *testing.T) {
 
 func TestAddEventNoPersistence(t *testing.T) {
 	reset()
-	msgClient, _ := messaging.NewMessageClient(msgTypes.MessageBusConfig{
-		PublishHost: msgTypes.HostInfo{
-			Host:     "*",
-			Protocol: "tcp",
-			Port:     5563,
-		},
-		Type: "zero",
-	})
+	msgClient := newTestMessageClient(t)
 
 	dbClientMock := newAddEventMockDB(false)
 	evt := contract.Event{Device: testDeviceName, Origin: testOrigin, Readings: buildReadings()}
@@ -283,6 +274,12 @@ func TestAddEventNoPersistence(t *testing.T) {
 		chEvents,
 		msgClient,
 		dataMocks.NewMockDeviceClient(),
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		ack.Persisted,
 		&config.ConfigurationStruct{
 			Writable: config.WritableInfo{
 				PersistData: false,
@@ -609,6 +606,38 @@ func TestDeleteEvents(t *testing.T) {
 	dbClientMock.AssertExpectations(t)
 }
 
+func TestRenameDeviceData(t *testing.T) {
+	reset()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("MergeRollup", mock.Anything).Return(nil)
+	dbClientMock.On("RenameDeviceData", testDeviceName, "renamed").Return(2, nil)
+
+	lc := logger.NewMockClient()
+	rollups := rollup.NewMaintainer(lc, dbClientMock, nil)
+	rollups.AddReading(testDeviceName, "Temperature", "21.5", db.MakeTimestamp())
+
+	count, err := renameDeviceData(testDeviceName, "renamed", lc, dbClientMock, rollups)
+	if err != nil {
+		t.Errorf("Should not throw error")
+	}
+	if count != 2 {
+		t.Errorf("Expected 2 events moved, was %d", count)
+	}
+
+	dbClientMock.AssertExpectations(t)
+}
+
+func TestRenameDeviceDataError(t *testing.T) {
+	reset()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("RenameDeviceData", testDeviceName, "renamed").Return(1, fmt.Errorf("some error"))
+
+	_, err := renameDeviceData(testDeviceName, "renamed", logger.NewMockClient(), dbClientMock, nil)
+	if err == nil {
+		t.Errorf("Should throw error")
+	}
+}
+
 func TestScrubPushedEvents(t *testing.T) {
 	reset()
 
@@ -0,0 +1,99 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package data
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	dbInterfaces "github.com/edgexfoundry/edgex-go/internal/pkg/db/interfaces"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+)
+
+// queryExplainer is implemented by the database clients able to explain how they answer queries, e.g. Redis.
+type queryExplainer interface {
+	Explain(query func(client dbInterfaces.DBClient) (int, error)) (db.QueryExplanation, error)
+}
+
+// explainQuery answers a query with handle, or explains how the database answers it when the request asks for it with
+// explain=true. The results are then discarded, the response giving the indexes read, the members scanned and the
+// time spent in each phase instead, unless the query failed, its error response being returned as is.
+func explainQuery(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	handle func(w http.ResponseWriter, dbClient interfaces.DBClient)) {
+
+	explain, err := strconv.ParseBool(r.URL.Query().Get(EXPLAIN))
+	if err != nil || !explain {
+		handle(w, dbClient)
+		return
+	}
+
+	explainer, ok := dbClient.(queryExplainer)
+	if !ok {
+		http.Error(w, "The database doesn't explain its queries", http.StatusNotImplemented)
+		return
+	}
+
+	response := &bufferedResponse{header: make(http.Header), statusCode: http.StatusOK}
+	explanation, err := explainer.Explain(func(client dbInterfaces.DBClient) (int, error) {
+		handle(response, client)
+		return response.results(), nil
+	})
+	if err != nil {
+		lc.Error("Error explaining the query: " + err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if response.statusCode < http.StatusOK || response.statusCode >= http.StatusMultipleChoices {
+		for name, values := range response.header {
+			w.Header()[name] = values
+		}
+		w.WriteHeader(response.statusCode)
+		_, _ = w.Write(response.body.Bytes())
+		return
+	}
+
+	lc.Debug("Explained the query " + r.URL.Path)
+	pkg.Encode(explanation, w, lc)
+}
+
+// bufferedResponse keeps the response of a query explained instead of writing it.
+type bufferedResponse struct {
+	header     http.Header
+	statusCode int
+	body       bytes.Buffer
+}
+
+func (b *bufferedResponse) Header() http.Header {
+	return b.header
+}
+
+func (b *bufferedResponse) Write(data []byte) (int, error) {
+	return b.body.Write(data)
+}
+
+func (b *bufferedResponse) WriteHeader(statusCode int) {
+	b.statusCode = statusCode
+}
+
+// results returns the number of results of the response, the length of the JSON array it holds.
+func (b *bufferedResponse) results() int {
+	var results []json.RawMessage
+	if err := json.Unmarshal(b.body.Bytes(), &results); err != nil {
+		return 0
+	}
+	return len(results)
+}
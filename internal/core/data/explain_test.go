@@ -0,0 +1,95 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package data
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/interfaces"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	dbInterfaces "github.com/edgexfoundry/edgex-go/internal/pkg/db/interfaces"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// explainingDB explains the queries it's given, answered without a client.
+type explainingDB struct {
+	*dbMock.DBClient
+}
+
+func (e explainingDB) Explain(query func(client dbInterfaces.DBClient) (int, error)) (db.QueryExplanation, error) {
+	returned, err := query(nil)
+	return db.QueryExplanation{
+		Database: db.RedisDB,
+		Phases:   []db.QueryPhase{{Name: db.PhaseScan, Command: "ZRANGE", Calls: 1, Scanned: 3}},
+		Returned: returned,
+	}, err
+}
+
+func answerQuery(statusCode int, body string) func(w http.ResponseWriter, dbClient interfaces.DBClient) {
+	return func(w http.ResponseWriter, dbClient interfaces.DBClient) {
+		w.WriteHeader(statusCode)
+		_, _ = w.Write([]byte(body))
+	}
+}
+
+func TestExplainQuery(t *testing.T) {
+	dbClient := explainingDB{&dbMock.DBClient{}}
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/reading/label/cold/10?explain=true", nil)
+	w := httptest.NewRecorder()
+
+	explainQuery(w, r, logger.NewMockClient(), dbClient, answerQuery(http.StatusOK, `[{"id":"1"},{"id":"2"}]`))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var explanation db.QueryExplanation
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &explanation))
+	assert.Equal(t, db.RedisDB, explanation.Database)
+	assert.Equal(t, 2, explanation.Returned)
+	assert.Equal(t, []db.QueryPhase{{Name: db.PhaseScan, Command: "ZRANGE", Calls: 1, Scanned: 3}}, explanation.Phases)
+}
+
+func TestExplainQueryNotAsked(t *testing.T) {
+	for _, target := range []string{"/api/v1/reading/label/cold/10", "/api/v1/reading/label/cold/10?explain=false"} {
+		r := httptest.NewRequest(http.MethodGet, target, nil)
+		w := httptest.NewRecorder()
+
+		explainQuery(w, r, logger.NewMockClient(), explainingDB{&dbMock.DBClient{}}, answerQuery(http.StatusOK, `[]`))
+
+		assert.Equal(t, http.StatusOK, w.Code, target)
+		assert.Equal(t, `[]`, w.Body.String(), target)
+	}
+}
+
+func TestExplainQueryFailed(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/reading/label/cold/100000?explain=true", nil)
+	w := httptest.NewRecorder()
+
+	explainQuery(
+		w,
+		r,
+		logger.NewMockClient(),
+		explainingDB{&dbMock.DBClient{}},
+		answerQuery(http.StatusRequestEntityTooLarge, "Exceeded max limit"))
+
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code, "the error response of the query should be returned")
+	assert.Equal(t, "Exceeded max limit", w.Body.String())
+}
+
+func TestExplainQueryNotSupported(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/reading/label/cold/10?explain=true", nil)
+	w := httptest.NewRecorder()
+
+	explainQuery(w, r, logger.NewMockClient(), &dbMock.DBClient{}, answerQuery(http.StatusOK, `[]`))
+
+	assert.Equal(t, http.StatusNotImplemented, w.Code)
+}
@@ -0,0 +1,138 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ingestion measures the ingestion of the events separately for each transport they arrive through, so that
+// when the ingestion lags, the slowness can be traced to the REST path or to the broker path. Core-data only receives
+// the events through its REST API so far; the events received from the message bus would be recorded under a transport
+// of their own for each topic.
+package ingestion
+
+import (
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Transports of the events received by core-data, i.e. the versions of its REST API
+const (
+	HTTPv1 = "http/v1"
+	HTTPv2 = "http/v2"
+)
+
+// bucketsMs are the upper bounds in milliseconds of the buckets of the latency histograms
+var bucketsMs = []float64{1, 2.5, 5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000}
+
+// Bucket counts the events ingested within UpperBoundMs milliseconds, those of the previous buckets included.
+type Bucket struct {
+	UpperBoundMs float64 `json:"upperBoundMs"`
+	Count        int64   `json:"count"`
+}
+
+// TransportMetrics are the metrics of the ingestion of the events arriving through a transport. The events slower
+// than the last bucket are counted by Events only.
+type TransportMetrics struct {
+	Transport string   `json:"transport"`
+	Events    int64    `json:"events"`
+	Failures  int64    `json:"failures"`
+	MeanMs    float64  `json:"meanMs"`
+	MaxMs     float64  `json:"maxMs"`
+	Buckets   []Bucket `json:"buckets"`
+}
+
+// stats accumulates the latencies of a transport; buckets counts the events of each bucket alone.
+type stats struct {
+	events   int64
+	failures int64
+	sumMs    float64
+	maxMs    float64
+	buckets  []int64
+}
+
+// Metrics accumulates the metrics of the ingestion of the events since the service started, by transport.
+type Metrics struct {
+	mutex      sync.Mutex
+	transports map[string]*stats
+}
+
+// NewMetrics creates empty Metrics.
+func NewMetrics() *Metrics {
+	return &Metrics{transports: make(map[string]*stats)}
+}
+
+// Record records the ingestion of an event through the transport, which took latency and failed or not.
+func (m *Metrics) Record(transport string, latency time.Duration, failed bool) {
+	ms := float64(latency) / float64(time.Millisecond)
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	s, ok := m.transports[transport]
+	if !ok {
+		s = &stats{buckets: make([]int64, len(bucketsMs))}
+		m.transports[transport] = s
+	}
+	s.events++
+	if failed {
+		s.failures++
+	}
+	s.sumMs += ms
+	if ms > s.maxMs {
+		s.maxMs = ms
+	}
+	if i := sort.SearchFloat64s(bucketsMs, ms); i < len(bucketsMs) {
+		s.buckets[i]++
+	}
+}
+
+// Snapshot returns the metrics of each transport, ordered by transport.
+func (m *Metrics) Snapshot() []TransportMetrics {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	snapshot := make([]TransportMetrics, 0, len(m.transports))
+	for transport, s := range m.transports {
+		metrics := TransportMetrics{
+			Transport: transport,
+			Events:    s.events,
+			Failures:  s.failures,
+			MeanMs:    s.sumMs / float64(s.events),
+			MaxMs:     s.maxMs,
+			Buckets:   make([]Bucket, len(bucketsMs)),
+		}
+		var count int64
+		for i, bound := range bucketsMs {
+			count += s.buckets[i]
+			metrics.Buckets[i] = Bucket{UpperBoundMs: bound, Count: count}
+		}
+		snapshot = append(snapshot, metrics)
+	}
+	sort.Slice(snapshot, func(i, j int) bool { return snapshot[i].Transport < snapshot[j].Transport })
+	return snapshot
+}
+
+// statusRecorder records the status code of the response.
+type statusRecorder struct {
+	http.ResponseWriter
+	statusCode int
+}
+
+func (r *statusRecorder) WriteHeader(statusCode int) {
+	r.statusCode = statusCode
+	r.ResponseWriter.WriteHeader(statusCode)
+}
+
+// Observe handles the request with handle and, when it posts events, records their ingestion through the transport
+// until the response, failed when the response is an error. Nothing is recorded when metrics is nil.
+func Observe(metrics *Metrics, transport string, w http.ResponseWriter, r *http.Request, handle http.HandlerFunc) {
+	if metrics == nil || r.Method != http.MethodPost {
+		handle(w, r)
+		return
+	}
+
+	recorder := &statusRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+	start := time.Now()
+	handle(recorder, r)
+	metrics.Record(transport, time.Since(start), recorder.statusCode >= http.StatusBadRequest)
+}
@@ -0,0 +1,71 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ingestion
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecord(t *testing.T) {
+	metrics := NewMetrics()
+	assert.Empty(t, metrics.Snapshot())
+
+	metrics.Record(HTTPv1, 3*time.Millisecond, false)
+	metrics.Record(HTTPv1, 40*time.Millisecond, true)
+	metrics.Record(HTTPv1, 10*time.Second, false)
+	metrics.Record(HTTPv2, time.Millisecond, false)
+
+	snapshot := metrics.Snapshot()
+	require.Len(t, snapshot, 2)
+
+	h := snapshot[0]
+	assert.Equal(t, HTTPv1, h.Transport)
+	assert.Equal(t, int64(3), h.Events)
+	assert.Equal(t, int64(1), h.Failures)
+	assert.InDelta(t, 3347.67, h.MeanMs, 0.01)
+	assert.Equal(t, float64(10000), h.MaxMs)
+	require.Len(t, h.Buckets, len(bucketsMs))
+	assert.Equal(t, Bucket{UpperBoundMs: 2.5, Count: 0}, h.Buckets[1])
+	assert.Equal(t, Bucket{UpperBoundMs: 5, Count: 1}, h.Buckets[2])
+	assert.Equal(t, Bucket{UpperBoundMs: 50, Count: 2}, h.Buckets[5])
+	assert.Equal(t, Bucket{UpperBoundMs: 5000, Count: 2}, h.Buckets[len(bucketsMs)-1],
+		"the events slower than the last bucket should only be counted by Events")
+
+	v2 := snapshot[1]
+	assert.Equal(t, HTTPv2, v2.Transport)
+	assert.Equal(t, int64(1), v2.Events)
+	assert.Equal(t, Bucket{UpperBoundMs: 1, Count: 1}, v2.Buckets[0], "the bounds of the buckets should be inclusive")
+}
+
+func TestObserve(t *testing.T) {
+	metrics := NewMetrics()
+	handle := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("fail") != "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte("id"))
+	}
+
+	for _, target := range []string{"/api/v1/event", "/api/v1/event?fail=true"} {
+		rr := httptest.NewRecorder()
+		Observe(metrics, HTTPv1, rr, httptest.NewRequest(http.MethodPost, target, nil), handle)
+	}
+	rr := httptest.NewRecorder()
+	Observe(metrics, HTTPv1, rr, httptest.NewRequest(http.MethodGet, "/api/v1/event", nil), handle)
+	assert.Equal(t, "id", rr.Body.String())
+
+	snapshot := metrics.Snapshot()
+	require.Len(t, snapshot, 1)
+	assert.Equal(t, int64(2), snapshot[0].Events, "only the posted events should be recorded")
+	assert.Equal(t, int64(1), snapshot[0].Failures)
+}
@@ -18,19 +18,34 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/data/ack"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/busonly"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
 	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/ingestion"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/kafka"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/quota"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/rollup"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/units"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/v2"
 	v2DataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/v2/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/validator"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/virtual"
+	pkgContainer "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
 	errorContainer "github.com/edgexfoundry/edgex-go/internal/pkg/container"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/errorconcept"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/messaging"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
 	"github.com/edgexfoundry/go-mod-bootstrap/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/metadata"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/notifications"
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/urlclient/local"
-	"github.com/edgexfoundry/go-mod-messaging/messaging"
 	msgTypes "github.com/edgexfoundry/go-mod-messaging/pkg/types"
 
 	"github.com/gorilla/mux"
@@ -129,6 +144,81 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, st
 		configuration.MessageQueue.Port,
 		configuration.MessageQueue.Topic))
 
+	validators, err := validator.NewChain(
+		configuration.EventValidation.Validators,
+		configuration.EventValidation.Parameters)
+	if err != nil {
+		lc.Error(fmt.Sprintf("failed to create event validators: %s", err.Error()))
+		return false
+	}
+	if len(configuration.EventValidation.Validators) > 0 {
+		lc.Info(fmt.Sprintf("Validating incoming events with %v", configuration.EventValidation.Validators))
+	}
+
+	var enforcer *quota.Enforcer
+	if configuration.Quotas.Enabled {
+		var nc notifications.NotificationsClient
+		if client, ok := configuration.Clients["Notifications"]; ok {
+			nc = notifications.NewNotificationsClient(local.New(client.Url() + clients.ApiNotificationRoute))
+		}
+		enforcer, err = quota.NewEnforcer(configuration.Quotas, nc, lc)
+		if err != nil {
+			lc.Error(fmt.Sprintf("failed to create the device quotas: %s", err.Error()))
+			return false
+		}
+		lc.Info("Enforcing the quotas of the devices on their events")
+	}
+
+	dispatcher, err := ack.NewDispatcher(configuration.Acknowledgment)
+	if err != nil {
+		lc.Error(fmt.Sprintf("failed to configure the acknowledgment of the events: %s", err.Error()))
+		return false
+	}
+	dispatcher.Start(ctx, wg)
+
+	converter, err := units.NewConverter(configuration.Units)
+	if err != nil {
+		lc.Error(fmt.Sprintf("failed to create unit converter: %s", err.Error()))
+		return false
+	}
+
+	var maintainer *rollup.Maintainer
+	if configuration.Rollups.Enabled {
+		maintainer, err = newRollupMaintainer(ctx, wg, lc, dic, configuration.Rollups)
+		if err != nil {
+			lc.Error(fmt.Sprintf("failed to start the rollup maintenance: %s", err.Error()))
+			return false
+		}
+		lc.Info("Maintaining hourly and daily rollups of the readings")
+	}
+
+	var exporter *kafka.Exporter
+	if configuration.KafkaExport.Enabled {
+		exporter, err = newKafkaExporter(ctx, wg, lc, mdc, configuration.KafkaExport)
+		if err != nil {
+			lc.Error(fmt.Sprintf("failed to start the export to kafka: %s", err.Error()))
+			return false
+		}
+		lc.Info(fmt.Sprintf("Exporting the events to kafka brokers %v", configuration.KafkaExport.Brokers))
+	}
+
+	virtuals, err := virtual.NewResources(configuration.VirtualResources)
+	if err != nil {
+		lc.Error(fmt.Sprintf("failed to define the virtual resources: %s", err.Error()))
+		return false
+	}
+	if len(configuration.VirtualResources) > 0 && !configuration.Rollups.Enabled {
+		lc.Warn("virtual resources are defined but the rollups they are computed from aren't maintained")
+	}
+
+	busOnly, err := busonly.NewPolicy(configuration.BusOnly, mdc)
+	if err != nil {
+		lc.Error(fmt.Sprintf("failed to define the bus-only readings: %s", err.Error()))
+		return false
+	}
+
+	ingestionMetrics := ingestion.NewMetrics()
+
 	chEvents := make(chan interface{}, 100)
 	// initialize event handlers
 	initEventHandlers(lc, chEvents, mdc, msc, configuration)
@@ -143,6 +233,33 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, st
 		dataContainer.EventsChannelName: func(get di.Get) interface{} {
 			return chEvents
 		},
+		dataContainer.EventValidatorsName: func(get di.Get) interface{} {
+			return validators
+		},
+		dataContainer.QuotaEnforcerName: func(get di.Get) interface{} {
+			return enforcer
+		},
+		dataContainer.AckDispatcherName: func(get di.Get) interface{} {
+			return dispatcher
+		},
+		dataContainer.UnitConverterName: func(get di.Get) interface{} {
+			return converter
+		},
+		dataContainer.RollupMaintainerName: func(get di.Get) interface{} {
+			return maintainer
+		},
+		dataContainer.KafkaExporterName: func(get di.Get) interface{} {
+			return exporter
+		},
+		dataContainer.VirtualResourcesName: func(get di.Get) interface{} {
+			return virtuals
+		},
+		dataContainer.IngestionMetricsName: func(get di.Get) interface{} {
+			return ingestionMetrics
+		},
+		dataContainer.BusOnlyPolicyName: func(get di.Get) interface{} {
+			return busOnly
+		},
 		errorContainer.ErrorHandlerName: func(get di.Get) interface{} {
 			return errorconcept.NewErrorHandler(lc)
 		},
@@ -153,3 +270,104 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, st
 
 	return true
 }
+
+// newRollupMaintainer starts the maintenance of the rollups, flushed every FlushInterval until ctx is done.
+func newRollupMaintainer(
+	ctx context.Context,
+	wg *sync.WaitGroup,
+	lc logger.LoggingClient,
+	dic *di.Container,
+	info config.RollupsInfo) (*rollup.Maintainer, error) {
+
+	interval, err := time.ParseDuration(info.FlushInterval)
+	if err != nil || interval <= 0 {
+		return nil, fmt.Errorf("invalid flush interval '%s'", info.FlushInterval)
+	}
+
+	retention := make(map[string]time.Duration)
+	for period, value := range map[string]string{
+		dataModels.RollupHourly: info.HourlyRetention,
+		dataModels.RollupDaily:  info.DailyRetention,
+	} {
+		if value == "" {
+			continue
+		}
+		d, err := time.ParseDuration(value)
+		if err != nil || d <= 0 {
+			return nil, fmt.Errorf("invalid %s retention '%s'", period, value)
+		}
+		retention[period] = d
+	}
+
+	maintainer := rollup.NewMaintainer(lc, pkgContainer.DBClientFrom(dic.Get), retention)
+	wg.Add(1)
+	go maintainer.Run(ctx, wg, interval)
+	return maintainer, nil
+}
+
+// newKafkaExporter starts the export of the events to kafka, until ctx is done.
+func newKafkaExporter(
+	ctx context.Context,
+	wg *sync.WaitGroup,
+	lc logger.LoggingClient,
+	mdc metadata.DeviceClient,
+	info config.KafkaExportInfo) (*kafka.Exporter, error) {
+
+	if len(info.Brokers) == 0 {
+		return nil, fmt.Errorf("no broker")
+	}
+	if !info.TopicPerProfile && info.Topic == "" {
+		return nil, fmt.Errorf("no topic")
+	}
+	if info.QueueSize <= 0 || info.BatchSize <= 0 {
+		return nil, fmt.Errorf("invalid queue size %d or batch size %d", info.QueueSize, info.BatchSize)
+	}
+
+	var acks int
+	switch info.RequiredAcks {
+	case "all":
+		acks = kafka.AcksAll
+	case "leader":
+		acks = kafka.AcksLeader
+	default:
+		return nil, fmt.Errorf("invalid required acks '%s', expected all or leader", info.RequiredAcks)
+	}
+
+	durations := make(map[string]time.Duration)
+	for name, value := range map[string]string{
+		"timeout":           info.Timeout,
+		"retry backoff":     info.RetryBackoff,
+		"max retry backoff": info.MaxRetryBackoff,
+	} {
+		d, err := time.ParseDuration(value)
+		if err != nil || d <= 0 {
+			return nil, fmt.Errorf("invalid %s '%s'", name, value)
+		}
+		durations[name] = d
+	}
+
+	profiles := func(device string) (string, error) {
+		d, err := mdc.CheckForDevice(context.Background(), device)
+		if err != nil {
+			return "", err
+		}
+		return d.Profile.Name, nil
+	}
+
+	exporter := kafka.NewExporter(
+		lc,
+		kafka.NewProducer(info.Brokers, info.ClientId, acks, durations["timeout"]),
+		profiles,
+		kafka.Config{
+			Topic:           info.Topic,
+			TopicPerProfile: info.TopicPerProfile,
+			TopicPrefix:     info.TopicPrefix,
+			QueueSize:       info.QueueSize,
+			BatchSize:       info.BatchSize,
+			RetryBackoff:    durations["retry backoff"],
+			MaxRetryBackoff: durations["max retry backoff"],
+		})
+	wg.Add(1)
+	go exporter.Run(ctx, wg)
+	return exporter, nil
+}
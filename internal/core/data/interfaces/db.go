@@ -14,6 +14,7 @@
 package interfaces
 
 import (
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation/models"
 
 	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
@@ -55,6 +56,10 @@ type DBClient interface {
 	// Get the number of events in Core Data for the device specified by id
 	EventCountByDeviceId(id string) (int, error)
 
+	// Get the number of events of the device created during each window of window milliseconds from start up to end,
+	// excluded
+	EventCountsByDevice(device string, start int64, end int64, window int64) ([]int64, error)
+
 	// Update an event by ID
 	// Set the pushed variable to the current time
 	// 404 - Event not found
@@ -69,6 +74,10 @@ type DBClient interface {
 	// Delete events associated with the specified device
 	DeleteEventsByDevice(deviceId string) (int, error)
 
+	// Move the events, readings and rollups of a device to its new name, merging the rollups of the same period
+	// Returns the number of events moved
+	RenameDeviceData(from string, to string) (int, error)
+
 	// Get a list of events based on the device id and limit
 	EventsForDeviceLimit(id string, limit int) ([]contract.Event, error)
 
@@ -199,4 +208,20 @@ type DBClient interface {
 
 	// Delete all value descriptors
 	ScrubAllValueDescriptors() error
+
+	// ********************** ROLLUP FUNCTIONS *******************************
+
+	// Merge the rollup into the stored rollup of the same device resource, period and start, adding it if missing
+	MergeRollup(r dataModels.Rollup) error
+
+	// Return the rollups of the period for the resources of the device starting between start and end, limited by
+	// limit; a negative end is unbounded
+	RollupsByDevice(period string, device string, start int64, end int64, limit int) ([]dataModels.Rollup, error)
+
+	// Return the rollups of the period for the device resource starting between start and end, limited by limit; a
+	// negative end is unbounded
+	RollupsByDeviceAndName(period string, device string, name string, start int64, end int64, limit int) ([]dataModels.Rollup, error)
+
+	// Delete the rollups of the period starting before start
+	DeleteRollupsBefore(period string, start int64) error
 }
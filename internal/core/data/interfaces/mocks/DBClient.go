@@ -4,6 +4,7 @@ package mocks
 
 import go_mod_core_contractsmodels "github.com/edgexfoundry/go-mod-core-contracts/models"
 
+import datamodels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
 import mock "github.com/stretchr/testify/mock"
 import models "github.com/edgexfoundry/edgex-go/internal/pkg/correlation/models"
 
@@ -143,6 +144,20 @@ func (_m *DBClient) DeleteReadingsByDevice(device string) error {
 	return r0
 }
 
+// DeleteRollupsBefore provides a mock function with given fields: period, start
+func (_m *DBClient) DeleteRollupsBefore(period string, start int64) error {
+	ret := _m.Called(period, start)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64) error); ok {
+		r0 = rf(period, start)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteValueDescriptorById provides a mock function with given fields: id
 func (_m *DBClient) DeleteValueDescriptorById(id string) error {
 	ret := _m.Called(id)
@@ -220,6 +235,29 @@ func (_m *DBClient) EventCountByDeviceId(id string) (int, error) {
 	return r0, r1
 }
 
+// EventCountsByDevice provides a mock function with given fields: device, start, end, window
+func (_m *DBClient) EventCountsByDevice(device string, start int64, end int64, window int64) ([]int64, error) {
+	ret := _m.Called(device, start, end, window)
+
+	var r0 []int64
+	if rf, ok := ret.Get(0).(func(string, int64, int64, int64) []int64); ok {
+		r0 = rf(device, start, end, window)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int64, int64, int64) error); ok {
+		r1 = rf(device, start, end, window)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Events provides a mock function with given fields:
 func (_m *DBClient) Events() ([]go_mod_core_contractsmodels.Event, error) {
 	ret := _m.Called()
@@ -404,6 +442,20 @@ func (_m *DBClient) EventsWithLimit(limit int) ([]go_mod_core_contractsmodels.Ev
 	return r0, r1
 }
 
+// MergeRollup provides a mock function with given fields: r
+func (_m *DBClient) MergeRollup(r datamodels.Rollup) error {
+	ret := _m.Called(r)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(datamodels.Rollup) error); ok {
+		r0 = rf(r)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ReadingById provides a mock function with given fields: id
 func (_m *DBClient) ReadingById(id string) (go_mod_core_contractsmodels.Reading, error) {
 	ret := _m.Called(id)
@@ -584,6 +636,73 @@ func (_m *DBClient) ReadingsByValueDescriptorNames(names []string, limit int) ([
 	return r0, r1
 }
 
+// RenameDeviceData provides a mock function with given fields: from, to
+func (_m *DBClient) RenameDeviceData(from string, to string) (int, error) {
+	ret := _m.Called(from, to)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(string, string) int); ok {
+		r0 = rf(from, to)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RollupsByDevice provides a mock function with given fields: period, device, start, end, limit
+func (_m *DBClient) RollupsByDevice(period string, device string, start int64, end int64, limit int) ([]datamodels.Rollup, error) {
+	ret := _m.Called(period, device, start, end, limit)
+
+	var r0 []datamodels.Rollup
+	if rf, ok := ret.Get(0).(func(string, string, int64, int64, int) []datamodels.Rollup); ok {
+		r0 = rf(period, device, start, end, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]datamodels.Rollup)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, int64, int64, int) error); ok {
+		r1 = rf(period, device, start, end, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RollupsByDeviceAndName provides a mock function with given fields: period, device, name, start, end, limit
+func (_m *DBClient) RollupsByDeviceAndName(period string, device string, name string, start int64, end int64, limit int) ([]datamodels.Rollup, error) {
+	ret := _m.Called(period, device, name, start, end, limit)
+
+	var r0 []datamodels.Rollup
+	if rf, ok := ret.Get(0).(func(string, string, string, int64, int64, int) []datamodels.Rollup); ok {
+		r0 = rf(period, device, name, start, end, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]datamodels.Rollup)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, string, int64, int64, int) error); ok {
+		r1 = rf(period, device, name, start, end, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ScrubAllEvents provides a mock function with given fields:
 func (_m *DBClient) ScrubAllEvents() error {
 	ret := _m.Called()
@@ -0,0 +1,321 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package kafka streams the events persisted by core data to Kafka, either to a single topic or to a topic per device
+// profile, keyed by device name so that the events of a device stay ordered within a partition.
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+)
+
+const (
+	// profileCacheTTL is how long the profile of a device is remembered when exporting to a topic per profile.
+	profileCacheTTL = 5 * time.Minute
+	// maxTopicLength is the longest topic name accepted by the brokers.
+	maxTopicLength = 249
+)
+
+// Config configures an Exporter.
+type Config struct {
+	// Topic receives all the events, unless TopicPerProfile is set.
+	Topic string
+	// TopicPerProfile sends the events of the devices of each profile to the topic named after the profile, prefixed
+	// by TopicPrefix.
+	TopicPerProfile bool
+	TopicPrefix     string
+	// QueueSize is the number of events waiting for their export beyond which the new events are dropped.
+	QueueSize int
+	// BatchSize is the maximum number of events produced at once.
+	BatchSize int
+	// RetryBackoff is the delay before producing again the events which couldn't be, doubled after every failure up to
+	// MaxRetryBackoff.
+	RetryBackoff    time.Duration
+	MaxRetryBackoff time.Duration
+}
+
+// RecordProducer produces records to the topics of a Kafka cluster, as the Producer does.
+type RecordProducer interface {
+	Produce(topic string, records []Record) error
+	Close() error
+}
+
+// ProfileLookup returns the name of the device profile of the device.
+type ProfileLookup func(device string) (string, error)
+
+// Metrics reports the progress of the export. Times are epoch milliseconds.
+type Metrics struct {
+	// Queued is the number of events waiting for their export.
+	Queued int `json:"queued"`
+	// Lag is the number of milliseconds the oldest event waiting for its export has been waiting for, 0 when none is.
+	Lag            int64  `json:"lag"`
+	Delivered      int64  `json:"delivered"`
+	Dropped        int64  `json:"dropped"`
+	FailedAttempts int64  `json:"failedAttempts"`
+	LastDelivery   int64  `json:"lastDelivery,omitempty"`
+	LastError      string `json:"lastError,omitempty"`
+}
+
+type pending struct {
+	device  string
+	value   []byte
+	created time.Time
+	queued  time.Time
+}
+
+type cachedProfile struct {
+	name    string
+	expires time.Time
+}
+
+// Exporter queues the events persisted and produces them to Kafka in the background, in the order they were queued.
+// The events which can't be produced are retried until they are, so that every event queued is delivered at least
+// once while the service runs; only the events arriving while the queue is full are dropped. A nil Exporter doesn't
+// export anything.
+type Exporter struct {
+	lc       logger.LoggingClient
+	producer RecordProducer
+	profiles ProfileLookup
+	config   Config
+
+	mutex   sync.Mutex
+	queue   []pending
+	metrics Metrics
+	signal  chan struct{}
+
+	// profileCache is only accessed by the goroutine running the export.
+	profileCache map[string]cachedProfile
+}
+
+// NewExporter creates an Exporter producing the events with the producer, looking the profile of their device up with
+// profiles when exporting to a topic per profile.
+func NewExporter(lc logger.LoggingClient, producer RecordProducer, profiles ProfileLookup, config Config) *Exporter {
+	if config.BatchSize <= 0 {
+		config.BatchSize = 1
+	}
+	return &Exporter{
+		lc:           lc,
+		producer:     producer,
+		profiles:     profiles,
+		config:       config,
+		signal:       make(chan struct{}, 1),
+		profileCache: make(map[string]cachedProfile),
+	}
+}
+
+// Export queues the event of the device, created at created epoch milliseconds, for its export as JSON, dropping it
+// when the queue is full.
+func (e *Exporter) Export(device string, created int64, event interface{}) {
+	if e == nil {
+		return
+	}
+
+	value, err := json.Marshal(event)
+	if err != nil {
+		e.lc.Error(fmt.Sprintf("unable to marshal an event of device %s for its export to kafka: %s", device, err.Error()))
+		return
+	}
+	now := time.Now()
+	timestamp := now
+	if created > 0 {
+		timestamp = time.Unix(0, created*int64(time.Millisecond))
+	}
+
+	e.mutex.Lock()
+	if len(e.queue) >= e.config.QueueSize {
+		e.metrics.Dropped++
+		e.mutex.Unlock()
+		e.lc.Warn(fmt.Sprintf("kafka export queue is full, dropping an event of device %s", device))
+		return
+	}
+	e.queue = append(e.queue, pending{device: device, value: value, created: timestamp, queued: now})
+	e.mutex.Unlock()
+
+	select {
+	case e.signal <- struct{}{}:
+	default:
+	}
+}
+
+// Metrics returns the progress of the export.
+func (e *Exporter) Metrics() Metrics {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	m := e.metrics
+	m.Queued = len(e.queue)
+	if len(e.queue) > 0 {
+		m.Lag = time.Since(e.queue[0].queued).Milliseconds()
+	}
+	return m
+}
+
+// Run produces the events queued until ctx is done, trying to produce those still queued one last time before
+// closing the producer and returning.
+func (e *Exporter) Run(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	defer func() { _ = e.producer.Close() }()
+
+	backoff := e.config.RetryBackoff
+	for {
+		select {
+		case <-ctx.Done():
+			e.drain()
+			return
+		case <-e.signal:
+		}
+
+		for {
+			delivered, err := e.flush(time.Now())
+			if err == nil {
+				backoff = e.config.RetryBackoff
+				if delivered == 0 {
+					break
+				}
+				continue
+			}
+
+			e.lc.Error(fmt.Sprintf("unable to export events to kafka, retrying in %v: %s", backoff, err.Error()))
+			select {
+			case <-ctx.Done():
+				e.drain()
+				return
+			case <-time.After(backoff):
+			}
+			if backoff *= 2; backoff > e.config.MaxRetryBackoff {
+				backoff = e.config.MaxRetryBackoff
+			}
+		}
+	}
+}
+
+// drain tries to produce the events still queued once, on shutdown.
+func (e *Exporter) drain() {
+	for {
+		delivered, err := e.flush(time.Now())
+		if err != nil || delivered == 0 {
+			break
+		}
+	}
+	if queued := e.Metrics().Queued; queued > 0 {
+		e.lc.Warn(fmt.Sprintf("%d events were not exported to kafka before stopping", queued))
+	}
+}
+
+// flush produces a batch of the events at the head of the queue, grouped by topic, and removes those delivered from
+// the queue. It returns the number of events delivered, 0 when the queue is empty.
+func (e *Exporter) flush(now time.Time) (int, error) {
+	e.mutex.Lock()
+	size := len(e.queue)
+	if size > e.config.BatchSize {
+		size = e.config.BatchSize
+	}
+	// Export only appends to the queue, so the head of the queue is left as is until the batch is removed below.
+	batch := e.queue[:size:size]
+	e.mutex.Unlock()
+
+	if len(batch) == 0 {
+		return 0, nil
+	}
+
+	var topics []string
+	byTopic := make(map[string][]int)
+	var err error
+	for i, p := range batch {
+		var topic string
+		if topic, err = e.topic(p.device, now); err != nil {
+			break
+		}
+		if byTopic[topic] == nil {
+			topics = append(topics, topic)
+		}
+		byTopic[topic] = append(byTopic[topic], i)
+	}
+
+	delivered := make([]bool, len(batch))
+	count := 0
+	if err == nil {
+		for _, topic := range topics {
+			records := make([]Record, len(byTopic[topic]))
+			for j, i := range byTopic[topic] {
+				records[j] = Record{
+					Key:       []byte(batch[i].device),
+					Value:     batch[i].value,
+					Headers:   []Header{{Key: clients.ContentType, Value: []byte(clients.ContentTypeJSON)}},
+					Timestamp: batch[i].created,
+				}
+			}
+			if err = e.producer.Produce(topic, records); err != nil {
+				break
+			}
+			for _, i := range byTopic[topic] {
+				delivered[i] = true
+			}
+			count += len(records)
+		}
+	}
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	remaining := make([]pending, 0, len(e.queue)-count)
+	for i, p := range batch {
+		if !delivered[i] {
+			remaining = append(remaining, p)
+		}
+	}
+	e.queue = append(remaining, e.queue[len(batch):]...)
+
+	e.metrics.Delivered += int64(count)
+	if count > 0 {
+		e.metrics.LastDelivery = now.UnixNano() / int64(time.Millisecond)
+	}
+	if err != nil {
+		e.metrics.FailedAttempts++
+		e.metrics.LastError = err.Error()
+		return count, err
+	}
+	return count, nil
+}
+
+// topic returns the topic the events of the device are exported to.
+func (e *Exporter) topic(device string, now time.Time) (string, error) {
+	if !e.config.TopicPerProfile {
+		return e.config.Topic, nil
+	}
+
+	cached, ok := e.profileCache[device]
+	if !ok || now.After(cached.expires) {
+		profile, err := e.profiles(device)
+		if err != nil {
+			return "", fmt.Errorf("unable to look the profile of device %s up: %w", device, err)
+		}
+		cached = cachedProfile{name: profile, expires: now.Add(profileCacheTTL)}
+		e.profileCache[device] = cached
+	}
+	return TopicName(e.config.TopicPrefix, cached.name), nil
+}
+
+// TopicName returns the name of the topic of the profile, replacing the characters not allowed in topic names by
+// underscores.
+func TopicName(prefix string, profile string) string {
+	name := []byte(prefix + profile)
+	for i, c := range name {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '.' || c == '_' || c == '-') {
+			name[i] = '_'
+		}
+	}
+	if len(name) > maxTopicLength {
+		name = name[:maxTopicLength]
+	}
+	return string(name)
+}
@@ -0,0 +1,239 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kafka
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEvent struct {
+	Device string `json:"device"`
+	Value  int    `json:"value"`
+}
+
+// fakeProducer records the records produced to each topic, failing the topics listed in failures.
+type fakeProducer struct {
+	mutex    sync.Mutex
+	produced map[string][]Record
+	failures map[string]int
+	closed   bool
+}
+
+func newFakeProducer() *fakeProducer {
+	return &fakeProducer{produced: make(map[string][]Record), failures: make(map[string]int)}
+}
+
+func (p *fakeProducer) Produce(topic string, records []Record) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.failures[topic] > 0 {
+		p.failures[topic]--
+		return errors.New("broker unavailable")
+	}
+	p.produced[topic] = append(p.produced[topic], records...)
+	return nil
+}
+
+func (p *fakeProducer) Close() error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.closed = true
+	return nil
+}
+
+func (p *fakeProducer) values(topic string) []string {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	var values []string
+	for _, r := range p.produced[topic] {
+		values = append(values, string(r.Value))
+	}
+	return values
+}
+
+func testConfig() Config {
+	return Config{
+		Topic:           "edgex-events",
+		TopicPrefix:     "edgex-",
+		QueueSize:       10,
+		BatchSize:       10,
+		RetryBackoff:    time.Millisecond,
+		MaxRetryBackoff: 10 * time.Millisecond,
+	}
+}
+
+func profilesOf(devices map[string]string, lookups *int) ProfileLookup {
+	return func(device string) (string, error) {
+		*lookups++
+		profile, ok := devices[device]
+		if !ok {
+			return "", errors.New("device not found")
+		}
+		return profile, nil
+	}
+}
+
+func TestNilExporterIgnoresEvents(t *testing.T) {
+	var e *Exporter
+	assert.NotPanics(t, func() { e.Export("thermostat", 0, testEvent{}) })
+}
+
+func TestExportToTopic(t *testing.T) {
+	producer := newFakeProducer()
+	e := NewExporter(logger.NewMockClient(), producer, nil, testConfig())
+
+	e.Export("thermostat", 1592228730123, testEvent{Device: "thermostat", Value: 1})
+	e.Export("meter", 0, testEvent{Device: "meter", Value: 2})
+	assert.Equal(t, 2, e.Metrics().Queued)
+
+	delivered, err := e.flush(time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, 2, delivered)
+
+	assert.Equal(t,
+		[]string{`{"device":"thermostat","value":1}`, `{"device":"meter","value":2}`},
+		producer.values("edgex-events"))
+	record := producer.produced["edgex-events"][0]
+	assert.Equal(t, []byte("thermostat"), record.Key)
+	assert.Equal(t, int64(1592228730123), record.Timestamp.UnixNano()/int64(time.Millisecond))
+
+	metrics := e.Metrics()
+	assert.Equal(t, 0, metrics.Queued)
+	assert.Equal(t, int64(0), metrics.Lag)
+	assert.Equal(t, int64(2), metrics.Delivered)
+	assert.NotZero(t, metrics.LastDelivery)
+}
+
+func TestExportToTopicPerProfile(t *testing.T) {
+	producer := newFakeProducer()
+	config := testConfig()
+	config.TopicPerProfile = true
+	lookups := 0
+	profiles := profilesOf(map[string]string{"thermostat": "HVAC Thermostat", "meter": "meter"}, &lookups)
+	e := NewExporter(logger.NewMockClient(), producer, profiles, config)
+
+	e.Export("thermostat", 0, testEvent{Value: 1})
+	e.Export("meter", 0, testEvent{Value: 2})
+	e.Export("thermostat", 0, testEvent{Value: 3})
+	_, err := e.flush(time.Now())
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{`{"device":"","value":1}`, `{"device":"","value":3}`}, producer.values("edgex-HVAC_Thermostat"))
+	assert.Equal(t, []string{`{"device":"","value":2}`}, producer.values("edgex-meter"))
+	assert.Equal(t, 2, lookups, "the profiles are cached")
+
+	e.Export("thermostat", 0, testEvent{Value: 4})
+	_, err = e.flush(time.Now().Add(profileCacheTTL + time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, 3, lookups, "the profiles expire")
+}
+
+func TestExportRetriesFailedTopics(t *testing.T) {
+	producer := newFakeProducer()
+	producer.failures["edgex-meter"] = 1
+	config := testConfig()
+	config.TopicPerProfile = true
+	lookups := 0
+	profiles := profilesOf(map[string]string{"thermostat": "thermostat", "meter": "meter"}, &lookups)
+	e := NewExporter(logger.NewMockClient(), producer, profiles, config)
+
+	e.Export("thermostat", 0, testEvent{Value: 1})
+	e.Export("meter", 0, testEvent{Value: 2})
+	e.Export("thermostat", 0, testEvent{Value: 3})
+
+	delivered, err := e.flush(time.Now())
+	assert.Error(t, err)
+	assert.Equal(t, 2, delivered)
+	metrics := e.Metrics()
+	assert.Equal(t, 1, metrics.Queued, "the events delivered are removed from the queue")
+	assert.Equal(t, int64(1), metrics.FailedAttempts)
+	assert.Equal(t, "broker unavailable", metrics.LastError)
+
+	e.Export("meter", 0, testEvent{Value: 4})
+	delivered, err = e.flush(time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, 2, delivered)
+	assert.Equal(t, []string{`{"device":"","value":2}`, `{"device":"","value":4}`}, producer.values("edgex-meter"))
+	assert.Equal(t, 0, e.Metrics().Queued)
+}
+
+func TestExportUnknownProfile(t *testing.T) {
+	producer := newFakeProducer()
+	config := testConfig()
+	config.TopicPerProfile = true
+	lookups := 0
+	e := NewExporter(logger.NewMockClient(), producer, profilesOf(nil, &lookups), config)
+
+	e.Export("thermostat", 0, testEvent{Value: 1})
+	_, err := e.flush(time.Now())
+	assert.Error(t, err)
+	assert.Equal(t, 1, e.Metrics().Queued)
+}
+
+func TestExportDropsEventsWhenFull(t *testing.T) {
+	config := testConfig()
+	config.QueueSize = 2
+	e := NewExporter(logger.NewMockClient(), newFakeProducer(), nil, config)
+
+	for i := 0; i < 3; i++ {
+		e.Export("thermostat", 0, testEvent{Value: i})
+	}
+	metrics := e.Metrics()
+	assert.Equal(t, 2, metrics.Queued)
+	assert.Equal(t, int64(1), metrics.Dropped)
+}
+
+func TestExportBatches(t *testing.T) {
+	producer := newFakeProducer()
+	config := testConfig()
+	config.BatchSize = 2
+	e := NewExporter(logger.NewMockClient(), producer, nil, config)
+
+	for i := 0; i < 3; i++ {
+		e.Export("thermostat", 0, testEvent{Value: i})
+	}
+	delivered, err := e.flush(time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, 2, delivered)
+	assert.Equal(t, 1, e.Metrics().Queued)
+}
+
+func TestRun(t *testing.T) {
+	producer := newFakeProducer()
+	producer.failures["edgex-events"] = 2
+	e := NewExporter(logger.NewMockClient(), producer, nil, testConfig())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	go e.Run(ctx, wg)
+
+	e.Export("thermostat", 0, testEvent{Value: 1})
+	e.Export("thermostat", 0, testEvent{Value: 2})
+	assert.Eventually(t, func() bool { return e.Metrics().Delivered == 2 }, time.Second, time.Millisecond)
+
+	cancel()
+	wg.Wait()
+	assert.Equal(t, []string{`{"device":"","value":1}`, `{"device":"","value":2}`}, producer.values("edgex-events"))
+	assert.Equal(t, int64(2), e.Metrics().FailedAttempts)
+	assert.True(t, producer.closed)
+}
+
+func TestTopicName(t *testing.T) {
+	assert.Equal(t, "edgex-HVAC_Thermostat_v2.1", TopicName("edgex-", "HVAC Thermostat/v2.1"))
+	assert.Len(t, TopicName("", string(make([]byte, 300))), maxTopicLength)
+}
@@ -0,0 +1,149 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	kafkago "github.com/segmentio/kafka-go"
+)
+
+// Acknowledgements required from the brokers before a produce request succeeds.
+const (
+	// AcksAll waits for the records to be written by all the in-sync replicas of the partition.
+	AcksAll = -1
+	// AcksLeader waits for the records to be written by the leader of the partition only.
+	AcksLeader = 1
+)
+
+// batchTimeout is how long the records produced are waited for before sending those of a partition, the records of a
+// call to Produce being handed to the writer all at once.
+const batchTimeout = 10 * time.Millisecond
+
+// Header is a header of a record.
+type Header struct {
+	Key   string
+	Value []byte
+}
+
+// Record is a record produced to a topic.
+type Record struct {
+	Key       []byte
+	Value     []byte
+	Headers   []Header
+	Timestamp time.Time
+}
+
+// Producer produces records to the topics of a Kafka cluster with a kafka-go writer per topic. The records are
+// assigned to the partitions by the murmur2 hash of their key, as the Java client does. It is safe for concurrent use.
+type Producer struct {
+	brokers []string
+	dialer  *kafkago.Dialer
+	acks    int
+	timeout time.Duration
+
+	mutex   sync.Mutex
+	writers map[string]*kafkago.Writer
+}
+
+// NewProducer creates a Producer discovering the cluster from the bootstrap brokers, given as host:port, and waiting
+// for the acknowledgements acks, AcksAll or AcksLeader, of every request for up to timeout.
+func NewProducer(bootstrap []string, clientId string, acks int, timeout time.Duration) *Producer {
+	return &Producer{
+		brokers: bootstrap,
+		dialer:  &kafkago.Dialer{ClientID: clientId, Timeout: timeout, DualStack: true},
+		acks:    acks,
+		timeout: timeout,
+		writers: make(map[string]*kafkago.Writer),
+	}
+}
+
+// Produce writes the records to the topic. When an error is returned the records may have been written to some of the
+// partitions, so producing them again may duplicate them: the delivery is at least once.
+func (p *Producer) Produce(topic string, records []Record) error {
+	if len(records) == 0 {
+		return nil
+	}
+	if topic == "" {
+		return errors.New("unable to produce to an empty topic")
+	}
+
+	writer := p.writer(topic)
+	if err := writer.WriteMessages(context.Background(), messages(records)...); err != nil {
+		// the partitions and their leaders may have changed, they are looked up again by the writer of the next attempt
+		p.discard(topic, writer)
+		return fmt.Errorf("unable to produce to topic %s: %w", topic, err)
+	}
+	return nil
+}
+
+// Close closes the writers of the topics.
+func (p *Producer) Close() error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	var err error
+	for topic, writer := range p.writers {
+		if closeErr := writer.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+		delete(p.writers, topic)
+	}
+	return err
+}
+
+// writer returns the writer of the topic, creating it when the topic is produced to for the first time.
+func (p *Producer) writer(topic string) *kafkago.Writer {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	writer, ok := p.writers[topic]
+	if !ok {
+		writer = kafkago.NewWriter(kafkago.WriterConfig{
+			Brokers:      p.brokers,
+			Topic:        topic,
+			Dialer:       p.dialer,
+			Balancer:     kafkago.Murmur2Balancer{},
+			RequiredAcks: p.acks,
+			BatchTimeout: batchTimeout,
+			WriteTimeout: p.timeout,
+			// the broker may take up to the write timeout to gather the acknowledgements before answering
+			ReadTimeout: 2 * p.timeout,
+			// the records which couldn't be produced are retried by the exporter, with its backoff
+			MaxAttempts: 1,
+		})
+		p.writers[topic] = writer
+	}
+	return writer
+}
+
+// discard closes the writer of the topic, unless it has already been replaced.
+func (p *Producer) discard(topic string, writer *kafkago.Writer) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.writers[topic] == writer {
+		delete(p.writers, topic)
+		_ = writer.Close()
+	}
+}
+
+// messages returns the kafka-go messages of the records.
+func messages(records []Record) []kafkago.Message {
+	msgs := make([]kafkago.Message, len(records))
+	for i, r := range records {
+		headers := make([]kafkago.Header, len(r.Headers))
+		for j, h := range r.Headers {
+			headers[j] = kafkago.Header{Key: h.Key, Value: h.Value}
+		}
+		msgs[i] = kafkago.Message{Key: r.Key, Value: r.Value, Headers: headers, Time: r.Timestamp}
+	}
+	return msgs
+}
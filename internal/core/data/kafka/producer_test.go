@@ -0,0 +1,86 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kafka
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// unreachableBroker returns the address of a port nothing listens on.
+func unreachableBroker(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	require.NoError(t, listener.Close())
+	return address
+}
+
+func TestMessages(t *testing.T) {
+	created := time.Unix(1600000000, 0)
+	records := []Record{
+		{
+			Key:       []byte("thermostat"),
+			Value:     []byte("{}"),
+			Headers:   []Header{{Key: "Content-Type", Value: []byte("application/json")}},
+			Timestamp: created,
+		},
+		{Value: []byte("[]"), Timestamp: created},
+	}
+
+	msgs := messages(records)
+	require.Len(t, msgs, 2)
+	assert.Equal(t, []byte("thermostat"), msgs[0].Key)
+	assert.Equal(t, []byte("{}"), msgs[0].Value)
+	require.Len(t, msgs[0].Headers, 1)
+	assert.Equal(t, "Content-Type", msgs[0].Headers[0].Key)
+	assert.Equal(t, []byte("application/json"), msgs[0].Headers[0].Value)
+	assert.Equal(t, created, msgs[0].Time)
+	assert.Nil(t, msgs[1].Key)
+	assert.Empty(t, msgs[1].Headers)
+}
+
+func TestProduceNothing(t *testing.T) {
+	producer := NewProducer([]string{unreachableBroker(t)}, "core-data", AcksAll, time.Second)
+	defer func() { _ = producer.Close() }()
+
+	assert.NoError(t, producer.Produce("events", nil))
+	assert.Empty(t, producer.writers, "no writer is created without records")
+}
+
+func TestProduceEmptyTopic(t *testing.T) {
+	producer := NewProducer([]string{unreachableBroker(t)}, "core-data", AcksAll, time.Second)
+	defer func() { _ = producer.Close() }()
+
+	assert.Error(t, producer.Produce("", []Record{{Value: []byte("{}"), Timestamp: time.Now()}}))
+}
+
+func TestProduceUnreachable(t *testing.T) {
+	producer := NewProducer([]string{unreachableBroker(t)}, "core-data", AcksLeader, time.Second)
+	defer func() { _ = producer.Close() }()
+
+	err := producer.Produce("events", []Record{{Value: []byte("{}"), Timestamp: time.Now()}})
+	assert.Error(t, err)
+	assert.Empty(t, producer.writers, "the writer is discarded after an error, to look the partitions up again")
+}
+
+func TestProducerWriters(t *testing.T) {
+	producer := NewProducer([]string{unreachableBroker(t)}, "core-data", AcksAll, time.Second)
+
+	events := producer.writer("events")
+	assert.Same(t, events, producer.writer("events"), "the writer of a topic is reused")
+	assert.NotSame(t, events, producer.writer("readings"))
+
+	producer.discard("events", producer.writer("readings"))
+	assert.Len(t, producer.writers, 2, "only the writer of the topic is discarded")
+
+	require.NoError(t, producer.Close())
+	assert.Empty(t, producer.writers)
+}
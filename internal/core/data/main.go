@@ -22,16 +22,30 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
 	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/shadow"
 	v2DataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/v2/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/authz"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers/database"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers/dependencies"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers/secret"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/gateway"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/httptuning"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/mtls"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/readygate"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/secretref"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/selftest"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/slo"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/sysevent"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/telemetry"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/tracing"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/unixsocket"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/bootstrap/handlers"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/flags"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/handlers/httpserver"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/handlers/message"
-	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/handlers/secret"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/handlers/testing"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/interfaces"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
@@ -63,6 +77,9 @@ func Main(ctx context.Context, cancel context.CancelFunc, router *mux.Router, re
 	})
 
 	httpServer := httpserver.NewBootstrap(router, true)
+	readyGate := readygate.NewBootstrap(clients.CoreDataServiceKey, &configuration.ReadyGate)
+	tunedServer := httptuning.NewBootstrap(router, &configuration.Service, &configuration.HttpTuning, httpServer)
+	unixSocket := unixsocket.NewBootstrap(router, &configuration.UnixSocket, tunedServer)
 
 	bootstrap.Run(
 		ctx,
@@ -75,13 +92,26 @@ func Main(ctx context.Context, cancel context.CancelFunc, router *mux.Router, re
 		dic,
 		[]interfaces.BootstrapHandler{
 			secret.NewSecret().BootstrapHandler,
-			database.NewDatabaseForCoreData(httpServer, configuration).BootstrapHandler,
-			handlers.NewDatabase(httpServer, configuration, v2DataContainer.DBClientInterfaceName).BootstrapHandler, // add v2 db client bootstrap handler
+			secretref.NewBootstrap(configuration).BootstrapHandler,
+			readyGate.WaitHandler,
+			mtls.NewBootstrap(&configuration.Service, &configuration.SecretStore, &configuration.MutualTLS).BootstrapHandler,
+			database.NewDatabaseForCoreData(unixSocket, configuration).BootstrapHandler,
+			handlers.NewDatabase(unixSocket, configuration, v2DataContainer.DBClientInterfaceName).BootstrapHandler, // add v2 db client bootstrap handler
+			shadow.NewBootstrap(router, configuration, &configuration.Shadow).BootstrapHandler,
 			NewBootstrap(router).BootstrapHandler,
-			telemetry.BootstrapHandler,
-			httpServer.BootstrapHandler,
+			dependencies.NewBootstrap(configuration, &configuration.DependencyCheck).BootstrapHandler,
+			tracing.NewBootstrap(router, clients.CoreDataServiceKey, &configuration.Tracing).BootstrapHandler,
+			slo.NewBootstrap(router, clients.CoreDataServiceKey, configuration, &configuration.SLO).BootstrapHandler,
+			audit.NewBootstrap(router, clients.CoreDataServiceKey, &configuration.Audit).BootstrapHandler,
+			authz.NewBootstrap(router, clients.CoreDataServiceKey, &configuration.RBAC, &configuration.Authorization).BootstrapHandler,
+			gateway.NewBootstrap(clients.CoreDataServiceKey, configuration, &configuration.Gateway).BootstrapHandler,
+			telemetry.NewBootstrap(clients.CoreDataServiceKey, &configuration.Telemetry).BootstrapHandler,
+			selftest.NewBootstrap(router, &configuration.SelfTest).BootstrapHandler,
+			sysevent.NewBootstrap(router, clients.CoreDataServiceKey, &configuration.Writable, &configuration.SystemEvents).BootstrapHandler,
+			unixSocket.BootstrapHandler,
+			readyGate.AnnounceHandler,
 			message.NewBootstrap(clients.CoreDataServiceKey, edgex.Version).BootstrapHandler,
-			testing.NewBootstrap(httpServer, readyStream).BootstrapHandler,
+			testing.NewBootstrap(unixSocket, readyStream).BootstrapHandler,
 		},
 	)
 }
@@ -0,0 +1,21 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// +build no_zmq
+
+package data
+
+import (
+	"testing"
+
+	v2Mocks "github.com/edgexfoundry/edgex-go/internal/core/data/v2/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/messaging"
+)
+
+// newTestMessageClient returns the client keeping the events published in memory, as the builds with the no_zmq tag
+// have no message bus.
+func newTestMessageClient(_ *testing.T) messaging.MessageClient {
+	return v2Mocks.NewMockMessageClient()
+}
@@ -0,0 +1,32 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// +build !no_zmq
+
+package data
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/messaging"
+
+	msgTypes "github.com/edgexfoundry/go-mod-messaging/pkg/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newTestMessageClient returns the client publishing the events on a ZeroMQ socket, which needs no broker.
+func newTestMessageClient(t *testing.T) messaging.MessageClient {
+	msgClient, err := messaging.NewMessageClient(msgTypes.MessageBusConfig{
+		PublishHost: msgTypes.HostInfo{
+			Host:     "*",
+			Protocol: "tcp",
+			Port:     5563,
+		},
+		Type: messaging.ZeroMQ,
+	})
+	require.NoError(t, err)
+	return msgClient
+}
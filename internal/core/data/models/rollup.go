@@ -0,0 +1,78 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// RollupHourly is the period of the rollups aggregating the values received during an hour.
+	RollupHourly = "hourly"
+	// RollupDaily is the period of the rollups aggregating the values received during a day, in UTC.
+	RollupDaily = "daily"
+)
+
+// RollupPeriods are the periods of the rollups maintained for every device resource.
+var RollupPeriods = []string{RollupHourly, RollupDaily}
+
+// Rollup aggregates the numeric values of a device resource received during the period beginning at Start, in
+// milliseconds.
+type Rollup struct {
+	ID       string  `json:"id,omitempty"`
+	Device   string  `json:"device"`
+	Name     string  `json:"name"`
+	Period   string  `json:"period"`
+	Start    int64   `json:"start"`
+	Count    int64   `json:"count"`
+	Min      float64 `json:"min"`
+	Max      float64 `json:"max"`
+	Sum      float64 `json:"sum"`
+	Avg      float64 `json:"avg"`
+	Modified int64   `json:"modified,omitempty"`
+}
+
+// PeriodLength returns the length of the period in milliseconds.
+func PeriodLength(period string) (int64, error) {
+	switch period {
+	case RollupHourly:
+		return 60 * 60 * 1000, nil
+	case RollupDaily:
+		return 24 * 60 * 60 * 1000, nil
+	}
+	return 0, fmt.Errorf("unknown rollup period '%s', expected %v", period, RollupPeriods)
+}
+
+// NewRollup returns the empty rollup of the device resource for the period including timestamp, in milliseconds.
+func NewRollup(device, name, period string, timestamp int64) (Rollup, error) {
+	length, err := PeriodLength(period)
+	if err != nil {
+		return Rollup{}, err
+	}
+	return Rollup{Device: device, Name: name, Period: period, Start: timestamp - timestamp%length}, nil
+}
+
+// Add aggregates a value into the rollup.
+func (r *Rollup) Add(v float64) {
+	r.Merge(Rollup{Count: 1, Min: v, Max: v, Sum: v})
+}
+
+// Merge aggregates the values of another rollup of the same device resource and period into the rollup.
+func (r *Rollup) Merge(other Rollup) {
+	if other.Count == 0 {
+		return
+	}
+	if r.Count == 0 {
+		r.Min, r.Max = other.Min, other.Max
+	} else {
+		r.Min = math.Min(r.Min, other.Min)
+		r.Max = math.Max(r.Max, other.Max)
+	}
+	r.Count += other.Count
+	r.Sum += other.Sum
+	r.Avg = r.Sum / float64(r.Count)
+}
@@ -0,0 +1,42 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRollupAlignsStartOnPeriod(t *testing.T) {
+	// 2020-06-15T13:45:30.123Z
+	timestamp := int64(1592228730123)
+
+	hourly, err := NewRollup("thermostat", "Temperature", RollupHourly, timestamp)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1592226000000), hourly.Start)
+
+	daily, err := NewRollup("thermostat", "Temperature", RollupDaily, timestamp)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1592179200000), daily.Start)
+
+	_, err = NewRollup("thermostat", "Temperature", "weekly", timestamp)
+	assert.Error(t, err)
+}
+
+func TestRollupMerge(t *testing.T) {
+	var r Rollup
+	r.Add(4)
+	r.Add(-2)
+	assert.Equal(t, Rollup{Count: 2, Min: -2, Max: 4, Sum: 2, Avg: 1}, r)
+
+	r.Merge(Rollup{Count: 2, Min: 1, Max: 10, Sum: 11})
+	assert.Equal(t, Rollup{Count: 4, Min: -2, Max: 10, Sum: 13, Avg: 3.25}, r)
+
+	r.Merge(Rollup{})
+	assert.Equal(t, int64(4), r.Count, "merging an empty rollup changes nothing")
+}
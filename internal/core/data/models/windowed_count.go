@@ -0,0 +1,70 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"fmt"
+)
+
+// WindowedCount counts the events of a device, or the readings of one of its resources, received during consecutive
+// windows of Window milliseconds from Start up to End, excluded; the last window is cut short by End. Counts holds a
+// count per window, in order, so that a range renders as a heatmap row without reading the events themselves.
+type WindowedCount struct {
+	Device string  `json:"device"`
+	Name   string  `json:"name,omitempty"`
+	Start  int64   `json:"start"`
+	End    int64   `json:"end"`
+	Window int64   `json:"window"`
+	Counts []int64 `json:"counts"`
+}
+
+// NewWindowedCount returns the windowed count of the device, or of its resource when name isn't empty, with a zero
+// count for every window between start and end.
+func NewWindowedCount(device, name string, start, end, window int64) (WindowedCount, error) {
+	if window <= 0 {
+		return WindowedCount{}, fmt.Errorf("the window must be positive")
+	}
+	if end <= start {
+		return WindowedCount{}, fmt.Errorf("the end %d must be after the start %d", end, start)
+	}
+	return WindowedCount{
+		Device: device,
+		Name:   name,
+		Start:  start,
+		End:    end,
+		Window: window,
+		Counts: make([]int64, Windows(start, end, window)),
+	}, nil
+}
+
+// Windows returns the number of windows between start and end.
+func Windows(start, end, window int64) int64 {
+	if window <= 0 || end <= start {
+		return 0
+	}
+	return (end - start + window - 1) / window
+}
+
+// WindowedCountPeriod returns the longest rollup period the windows starting at start are made of, so that they are
+// counted from the fewest rollups.
+func WindowedCountPeriod(start, window int64) (string, error) {
+	for _, period := range []string{RollupDaily, RollupHourly} {
+		length, _ := PeriodLength(period)
+		if window%length == 0 && start%length == 0 {
+			return period, nil
+		}
+	}
+	return "", fmt.Errorf("the start and the window must be whole hours to count the readings from the rollups")
+}
+
+// AddRollup adds the count of the rollup to the window including its start. The period of the rollup must divide the
+// windows, and the rollups starting outside of the range are ignored.
+func (c *WindowedCount) AddRollup(r Rollup) {
+	if r.Start < c.Start || r.Start >= c.End {
+		return
+	}
+	c.Counts[(r.Start-c.Start)/c.Window] += r.Count
+}
@@ -0,0 +1,56 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const hour = int64(60 * 60 * 1000)
+
+func TestNewWindowedCount(t *testing.T) {
+	c, err := NewWindowedCount("thermostat", "", 0, 10*hour, 3*hour)
+	require.NoError(t, err)
+	assert.Len(t, c.Counts, 4, "the last window is cut short by the end")
+
+	_, err = NewWindowedCount("thermostat", "", 0, 10*hour, 0)
+	assert.Error(t, err)
+	_, err = NewWindowedCount("thermostat", "", 10*hour, 10*hour, hour)
+	assert.Error(t, err)
+}
+
+func TestWindowedCountPeriod(t *testing.T) {
+	period, err := WindowedCountPeriod(2*24*hour, 24*hour)
+	require.NoError(t, err)
+	assert.Equal(t, RollupDaily, period)
+
+	period, err = WindowedCountPeriod(2*24*hour+hour, 24*hour)
+	require.NoError(t, err)
+	assert.Equal(t, RollupHourly, period, "windows of a day not starting at midnight")
+
+	period, err = WindowedCountPeriod(hour, 2*hour)
+	require.NoError(t, err)
+	assert.Equal(t, RollupHourly, period)
+
+	_, err = WindowedCountPeriod(0, 15*60*1000)
+	assert.Error(t, err)
+}
+
+func TestWindowedCountAddRollup(t *testing.T) {
+	c, err := NewWindowedCount("thermostat", "Temperature", 24*hour, 30*hour, 2*hour)
+	require.NoError(t, err)
+
+	c.AddRollup(Rollup{Start: 24 * hour, Count: 3})
+	c.AddRollup(Rollup{Start: 25 * hour, Count: 4})
+	c.AddRollup(Rollup{Start: 29 * hour, Count: 5})
+	c.AddRollup(Rollup{Start: 23 * hour, Count: 100})
+	c.AddRollup(Rollup{Start: 30 * hour, Count: 100})
+
+	assert.Equal(t, []int64{7, 0, 5}, c.Counts)
+}
@@ -0,0 +1,223 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package quota enforces the quotas of the devices on the events they send, so that a misconfigured device service
+// can't flood the shared infrastructure: the number of events per hour, the number of readings per event and the size
+// of the readings.
+package quota
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/errors"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/validator"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/notifications"
+)
+
+const (
+	defaultNotificationInterval = time.Hour
+	// pruneSize is the number of devices tracked beyond which the devices idle for over an hour are forgotten
+	pruneSize = 1024
+
+	eventsPerHourQuota    = "events per hour"
+	readingsPerEventQuota = "readings per event"
+	readingSizeQuota      = "bytes per reading"
+)
+
+// QuotasInfo configures the quotas of the devices on the events they send.
+type QuotasInfo struct {
+	Enabled bool
+	// MaxEventsPerHour is the number of events a device sends over a sliding hour, unlimited when 0
+	MaxEventsPerHour int
+	// MaxReadingsPerEvent is the number of readings, one per device resource, of an event, unlimited when 0
+	MaxReadingsPerEvent int
+	// MaxReadingSize is the size in bytes of the value of a reading, unlimited when 0
+	MaxReadingSize int
+	// NotificationInterval is the minimum delay between the notifications of the violations of a quota by a device
+	NotificationInterval string
+	// Devices overrides the quotas of devices by name, a quota of 0 keeping the default and a negative one removing
+	// the limit
+	Devices map[string]DeviceQuotasInfo
+}
+
+// DeviceQuotasInfo overrides the quotas of a device.
+type DeviceQuotasInfo struct {
+	MaxEventsPerHour    int
+	MaxReadingsPerEvent int
+	MaxReadingSize      int
+}
+
+// window counts the events of a device over the current hour and the previous one, from which the number of events
+// over the sliding hour is estimated.
+type window struct {
+	start    time.Time
+	previous int
+	current  int
+}
+
+// Enforcer checks the incoming events against the quotas of their device and notifies the violations.
+type Enforcer struct {
+	info     QuotasInfo
+	interval time.Duration
+	nc       notifications.NotificationsClient
+	lc       logger.LoggingClient
+
+	mutex    sync.Mutex
+	windows  map[string]*window
+	notified map[string]time.Time
+	now      func() time.Time
+}
+
+// NewEnforcer creates an Enforcer for the quotas of info. The violations are logged, and sent to support-notifications
+// when nc isn't nil.
+func NewEnforcer(info QuotasInfo, nc notifications.NotificationsClient, lc logger.LoggingClient) (*Enforcer, error) {
+	interval := defaultNotificationInterval
+	if info.NotificationInterval != "" {
+		var err error
+		if interval, err = time.ParseDuration(info.NotificationInterval); err != nil || interval < 0 {
+			return nil, fmt.Errorf("invalid Quotas NotificationInterval '%s'", info.NotificationInterval)
+		}
+	}
+	return &Enforcer{
+		info:     info,
+		interval: interval,
+		nc:       nc,
+		lc:       lc,
+		windows:  make(map[string]*window),
+		notified: make(map[string]time.Time),
+		now:      time.Now,
+	}, nil
+}
+
+// limit returns the quota of the device, the override of the device replacing the default one when it is set. A limit
+// of 0 or below is unlimited.
+func limit(defaultLimit int, override int) int {
+	if override != 0 {
+		return override
+	}
+	return defaultLimit
+}
+
+// Check returns an ErrEventTooLarge when the event exceeds the quotas of its device on its readings, and an
+// ErrEventRateExceeded when the device already sent its quota of events over the last hour; otherwise the event is
+// counted. A nil Enforcer accepts every event.
+func (q *Enforcer) Check(ctx context.Context, e validator.Event) error {
+	if q == nil {
+		return nil
+	}
+
+	device := q.info.Devices[e.Device]
+	if max := limit(q.info.MaxReadingsPerEvent, device.MaxReadingsPerEvent); max > 0 && len(e.Readings) > max {
+		return q.violated(ctx, e.Device, readingsPerEventQuota,
+			errors.NewErrEventTooLarge(e.Device, readingsPerEventQuota, max, len(e.Readings)))
+	}
+	if max := limit(q.info.MaxReadingSize, device.MaxReadingSize); max > 0 {
+		for _, r := range e.Readings {
+			if size := len(r.Value) + len(r.BinaryValue); size > max {
+				return q.violated(ctx, e.Device, readingSizeQuota,
+					errors.NewErrEventTooLarge(e.Device, readingSizeQuota, max, size))
+			}
+		}
+	}
+	if max := limit(q.info.MaxEventsPerHour, device.MaxEventsPerHour); max > 0 && !q.count(e.Device, max) {
+		return q.violated(ctx, e.Device, eventsPerHourQuota, errors.NewErrEventRateExceeded(e.Device, max))
+	}
+	return nil
+}
+
+// count counts an event of the device unless the device already sent max events over the sliding hour, estimated from
+// the events of the previous hour weighted by the part of it still in the sliding hour.
+func (q *Enforcer) count(device string, max int) bool {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	now := q.now()
+	start := now.Truncate(time.Hour)
+	w, exists := q.windows[device]
+	if !exists {
+		q.prune(start)
+		w = &window{start: start}
+		q.windows[device] = w
+	}
+	if !w.start.Equal(start) {
+		if w.start.Equal(start.Add(-time.Hour)) {
+			w.previous = w.current
+		} else {
+			w.previous = 0
+		}
+		w.current = 0
+		w.start = start
+	}
+
+	remaining := 1 - float64(now.Sub(start))/float64(time.Hour)
+	if float64(w.previous)*remaining+float64(w.current) >= float64(max) {
+		return false
+	}
+	w.current++
+	return true
+}
+
+// prune forgets the devices idle since before the previous hour, once many devices are tracked.
+func (q *Enforcer) prune(start time.Time) {
+	if len(q.windows) < pruneSize {
+		return
+	}
+	for device, w := range q.windows {
+		if w.start.Before(start.Add(-time.Hour)) {
+			delete(q.windows, device)
+		}
+	}
+}
+
+// violated notifies the violation of the quota by the device, unless it was already notified within the notification
+// interval, and returns err.
+func (q *Enforcer) violated(ctx context.Context, device string, quota string, err error) error {
+	q.mutex.Lock()
+	now := q.now()
+	key := device + "/" + quota
+	last, notified := q.notified[key]
+	if notified && now.Sub(last) < q.interval {
+		q.mutex.Unlock()
+		return err
+	}
+	if len(q.notified) >= pruneSize {
+		for k, t := range q.notified {
+			if now.Sub(t) >= q.interval {
+				delete(q.notified, k)
+			}
+		}
+	}
+	q.notified[key] = now
+	q.mutex.Unlock()
+
+	q.lc.Warn(err.Error(), clients.CorrelationHeader, correlation.FromContext(ctx))
+	if q.nc == nil {
+		return err
+	}
+
+	notification := notifications.Notification{
+		Slug:        fmt.Sprintf("quota-%s-%d", device, now.UnixNano()/int64(time.Millisecond)),
+		Sender:      clients.CoreDataServiceKey,
+		Category:    notifications.SW_HEALTH,
+		Severity:    notifications.NORMAL,
+		Description: fmt.Sprintf("Device %s exceeds its quota of %s", device, quota),
+		Content:     err.Error(),
+		Labels:      []string{"quota", device},
+	}
+	// the notification isn't waited for, the event being rejected right away
+	go func() {
+		if err := q.nc.SendNotification(context.Background(), notification); err != nil {
+			q.lc.Error(fmt.Sprintf("unable to notify the violation of a quota by device %s: %v", device, err))
+		}
+	}()
+	return err
+}
@@ -0,0 +1,144 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package quota
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/errors"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/validator"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/notifications"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testDevice = "thermostat"
+
+type notificationsClientStub struct {
+	sync.WaitGroup
+	mutex sync.Mutex
+	sent  []notifications.Notification
+}
+
+func (c *notificationsClientStub) SendNotification(_ context.Context, n notifications.Notification) error {
+	c.mutex.Lock()
+	c.sent = append(c.sent, n)
+	c.mutex.Unlock()
+	c.Done()
+	return nil
+}
+
+func newTestEnforcer(t *testing.T, info QuotasInfo, nc notifications.NotificationsClient, now *time.Time) *Enforcer {
+	info.Enabled = true
+	q, err := NewEnforcer(info, nc, logger.NewMockClient())
+	require.NoError(t, err)
+	q.now = func() time.Time { return *now }
+	return q
+}
+
+func newTestEvent(device string, readings ...string) validator.Event {
+	e := validator.Event{Device: device}
+	for _, value := range readings {
+		e.Readings = append(e.Readings, validator.Reading{Name: "Temperature", Value: value})
+	}
+	return e
+}
+
+func TestNewEnforcerValidation(t *testing.T) {
+	_, err := NewEnforcer(QuotasInfo{NotificationInterval: "hourly"}, nil, logger.NewMockClient())
+	assert.Error(t, err)
+	_, err = NewEnforcer(QuotasInfo{NotificationInterval: "-1h"}, nil, logger.NewMockClient())
+	assert.Error(t, err)
+}
+
+func TestCheckNilEnforcer(t *testing.T) {
+	var q *Enforcer
+	assert.NoError(t, q.Check(context.Background(), newTestEvent(testDevice, "21")))
+}
+
+func TestCheckReadings(t *testing.T) {
+	now := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+	q := newTestEnforcer(t, QuotasInfo{
+		MaxReadingsPerEvent: 2,
+		MaxReadingSize:      4,
+		Devices: map[string]DeviceQuotasInfo{
+			"camera":  {MaxReadingSize: -1},
+			"gateway": {MaxReadingsPerEvent: 3},
+		},
+	}, nil, &now)
+
+	tests := []struct {
+		name          string
+		event         validator.Event
+		expectedError bool
+	}{
+		{"within quotas", newTestEvent(testDevice, "21", "22"), false},
+		{"too many readings", newTestEvent(testDevice, "21", "22", "23"), true},
+		{"reading too large", newTestEvent(testDevice, "21.125"), true},
+		{"device readings override", newTestEvent("gateway", "21", "22", "23"), false},
+		{"device unlimited size", newTestEvent("camera", strings.Repeat("x", 1024)), false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := q.Check(context.Background(), testCase.event)
+			if testCase.expectedError {
+				assert.IsType(t, errors.ErrEventTooLarge{}, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCheckRate(t *testing.T) {
+	now := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+	q := newTestEnforcer(t, QuotasInfo{MaxEventsPerHour: 4}, nil, &now)
+
+	for i := 0; i < 4; i++ {
+		require.NoError(t, q.Check(context.Background(), newTestEvent(testDevice, "21")))
+	}
+	assert.IsType(t, errors.ErrEventRateExceeded{}, q.Check(context.Background(), newTestEvent(testDevice, "21")))
+	assert.NoError(t, q.Check(context.Background(), newTestEvent("other", "21")), "the quotas should be per device")
+
+	// half of the previous hour is still in the sliding hour
+	now = now.Add(90 * time.Minute)
+	for i := 0; i < 2; i++ {
+		require.NoError(t, q.Check(context.Background(), newTestEvent(testDevice, "21")))
+	}
+	assert.Error(t, q.Check(context.Background(), newTestEvent(testDevice, "21")))
+
+	now = now.Add(2 * time.Hour)
+	assert.NoError(t, q.Check(context.Background(), newTestEvent(testDevice, "21")))
+}
+
+func TestViolationNotifications(t *testing.T) {
+	now := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+	nc := &notificationsClientStub{}
+	q := newTestEnforcer(t, QuotasInfo{MaxReadingsPerEvent: 1, NotificationInterval: "10m"}, nc, &now)
+	tooLarge := newTestEvent(testDevice, "21", "22")
+
+	nc.Add(1)
+	assert.Error(t, q.Check(context.Background(), tooLarge))
+	nc.Wait()
+	now = now.Add(5 * time.Minute)
+	assert.Error(t, q.Check(context.Background(), tooLarge), "the event should be rejected when not notified")
+
+	nc.Add(1)
+	now = now.Add(5 * time.Minute)
+	assert.Error(t, q.Check(context.Background(), tooLarge))
+	nc.Wait()
+
+	require.Len(t, nc.sent, 2, "the violations should be notified once per interval")
+	assert.Equal(t, []string{"quota", testDevice}, nc.sent[0].Labels)
+	assert.Equal(t, notifications.SW_HEALTH, nc.sent[0].Category)
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/errors"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/units"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
@@ -295,3 +296,40 @@ func getReadingsByDeviceAndValueDescriptor(
 
 	return readings, nil
 }
+
+// convertReadings converts the values of the readings to the requested units, the name of a system of units or a unit,
+// from the units of measure of their value descriptors.
+func convertReadings(
+	readings []contract.Reading,
+	requested string,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	converter *units.Converter) ([]units.Reading, error) {
+
+	if !converter.Supports(requested) {
+		return nil, errors.NewErrUnsupportedUnits(requested)
+	}
+
+	var names []string
+	seen := make(map[string]bool)
+	for _, r := range readings {
+		if !seen[r.Name] {
+			seen[r.Name] = true
+			names = append(names, r.Name)
+		}
+	}
+
+	uoms := make(map[string]string, len(names))
+	if len(names) > 0 {
+		vds, err := dbClient.ValueDescriptorsByName(names)
+		if err != nil {
+			lc.Error(err.Error())
+			return nil, err
+		}
+		for _, vd := range vds {
+			uoms[vd.Name] = vd.UomLabel
+		}
+	}
+
+	return converter.ConvertReadings(readings, uoms, requested), nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/core/data/interfaces"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/interfaces/mocks"
 	dataMocks "github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/units"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/config"
@@ -293,3 +294,30 @@ func TestGetReadingsByDeviceAndValueDescriptorError(t *testing.T) {
 		t.Errorf("Expected error in getting readings by device and value descriptor")
 	}
 }
+
+func TestConvertReadings(t *testing.T) {
+	reset()
+	converter, err := units.NewConverter(units.UnitsInfo{
+		Conversions: []units.ConversionInfo{{From: "degC", To: "degF", Scale: 1.8, Offset: 32}},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error creating the converter: %s", err.Error())
+	}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("ValueDescriptorsByName", []string{"Temperature"}).
+		Return([]models.ValueDescriptor{{Name: "Temperature", UomLabel: "degC"}}, nil)
+
+	readings := []models.Reading{{Name: "Temperature", Value: "100"}, {Name: "Temperature", Value: "0"}}
+	converted, err := convertReadings(readings, "degF", logger.NewMockClient(), dbClientMock, converter)
+	if err != nil {
+		t.Fatalf("Unexpected error converting readings: %s", err.Error())
+	}
+	if converted[0].Value != "2.12e+02" || converted[1].Value != "3.2e+01" || converted[0].Units != "degF" {
+		t.Errorf("Unexpected converted readings %v", converted)
+	}
+
+	_, err = convertReadings(readings, "furlong", logger.NewMockClient(), dbClientMock, converter)
+	if _, ok := err.(errors.ErrUnsupportedUnits); !ok {
+		t.Errorf("Expected ErrUnsupportedUnits converting to unknown units, got %v", err)
+	}
+}
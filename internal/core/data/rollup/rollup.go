@@ -0,0 +1,146 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package rollup maintains the hourly and daily aggregates of the numeric readings of every device resource as the
+// events are received, so that queries over long time ranges read a few rollups instead of every reading.
+package rollup
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+)
+
+type key struct {
+	device string
+	name   string
+	period string
+	start  int64
+}
+
+// Maintainer aggregates the values received in memory and merges the aggregates into the stored rollups every flush,
+// keeping the cost of ingestion independent of the database. A nil Maintainer doesn't maintain any rollup.
+type Maintainer struct {
+	lc        logger.LoggingClient
+	dbClient  interfaces.DBClient
+	retention map[string]time.Duration
+
+	mutex   sync.Mutex
+	pending map[key]*models.Rollup
+}
+
+// NewMaintainer creates a Maintainer keeping the rollups of each period for the retention configured for it, or
+// forever when there is none.
+func NewMaintainer(
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	retention map[string]time.Duration) *Maintainer {
+
+	return &Maintainer{lc: lc, dbClient: dbClient, retention: retention, pending: make(map[key]*models.Rollup)}
+}
+
+// AddReading aggregates the value of a reading of the device received at timestamp, in milliseconds, into the rollups
+// of every period. Values which aren't numeric are ignored.
+func (m *Maintainer) AddReading(device string, name string, value string, timestamp int64) {
+	if m == nil {
+		return
+	}
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for _, period := range models.RollupPeriods {
+		r, _ := models.NewRollup(device, name, period, timestamp)
+		k := key{device: device, name: name, period: period, start: r.Start}
+		if m.pending[k] == nil {
+			m.pending[k] = &r
+		}
+		m.pending[k].Add(v)
+	}
+}
+
+// Flush merges the values aggregated since the previous flush into the stored rollups. The aggregates which couldn't
+// be merged are kept for the next flush.
+func (m *Maintainer) Flush() {
+	m.mutex.Lock()
+	pending := m.pending
+	m.pending = make(map[key]*models.Rollup)
+	m.mutex.Unlock()
+
+	if len(pending) == 0 {
+		return
+	}
+
+	var lastErr error
+	for k, r := range pending {
+		if err := m.dbClient.MergeRollup(*r); err != nil {
+			lastErr = err
+			continue
+		}
+		delete(pending, k)
+	}
+	if lastErr == nil {
+		return
+	}
+
+	m.lc.Error(fmt.Sprintf("unable to merge %d rollups, retrying on next flush: %s", len(pending), lastErr.Error()))
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	for k, r := range pending {
+		if m.pending[k] != nil {
+			r.Merge(*m.pending[k])
+		}
+		m.pending[k] = r
+	}
+}
+
+// Prune deletes the rollups older than the retention of their period.
+func (m *Maintainer) Prune(now time.Time) {
+	for _, period := range models.RollupPeriods {
+		retention, ok := m.retention[period]
+		if !ok || retention <= 0 {
+			continue
+		}
+		before := now.Add(-retention).UnixNano() / int64(time.Millisecond)
+		if err := m.dbClient.DeleteRollupsBefore(period, before); err != nil {
+			m.lc.Error(fmt.Sprintf("unable to delete the %s rollups older than %v: %s", period, retention, err.Error()))
+		}
+	}
+}
+
+// Run flushes the aggregates every interval and prunes the rollups every hour until ctx is done, flushing one last
+// time before returning.
+func (m *Maintainer) Run(ctx context.Context, wg *sync.WaitGroup, interval time.Duration) {
+	defer wg.Done()
+
+	flush := time.NewTicker(interval)
+	defer flush.Stop()
+	prune := time.NewTicker(time.Hour)
+	defer prune.Stop()
+
+	m.Prune(time.Now())
+	for {
+		select {
+		case <-ctx.Done():
+			m.Flush()
+			return
+		case <-flush.C:
+			m.Flush()
+		case <-prune.C:
+			m.Prune(time.Now())
+		}
+	}
+}
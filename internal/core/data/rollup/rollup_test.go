@@ -0,0 +1,92 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rollup
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// 2020-06-15T13:45:30.123Z
+const testTimestamp = int64(1592228730123)
+
+func mergedRollups(dbClientMock *dbMock.DBClient) map[string]models.Rollup {
+	merged := make(map[string]models.Rollup)
+	for _, call := range dbClientMock.Calls {
+		if call.Method == "MergeRollup" {
+			r := call.Arguments.Get(0).(models.Rollup)
+			merged[r.Name+"/"+r.Period] = r
+		}
+	}
+	return merged
+}
+
+func TestNilMaintainerIgnoresReadings(t *testing.T) {
+	var m *Maintainer
+	assert.NotPanics(t, func() { m.AddReading("thermostat", "Temperature", "21.5", testTimestamp) })
+}
+
+func TestFlushMergesAggregates(t *testing.T) {
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("MergeRollup", mock.Anything).Return(nil)
+	m := NewMaintainer(logger.NewMockClient(), dbClientMock, nil)
+
+	m.AddReading("thermostat", "Temperature", "20", testTimestamp)
+	m.AddReading("thermostat", "Temperature", "24", testTimestamp+1000)
+	m.AddReading("thermostat", "Mode", "cooling", testTimestamp)
+	m.Flush()
+
+	merged := mergedRollups(dbClientMock)
+	require.Len(t, merged, 2, "non numeric readings are ignored")
+	hourly := merged["Temperature/"+models.RollupHourly]
+	assert.Equal(t, int64(1592226000000), hourly.Start)
+	assert.Equal(t, int64(2), hourly.Count)
+	assert.Equal(t, float64(22), hourly.Avg)
+	assert.Equal(t, int64(2), merged["Temperature/"+models.RollupDaily].Count)
+
+	dbClientMock.Calls = nil
+	m.Flush()
+	assert.Empty(t, dbClientMock.Calls, "the aggregates are only merged once")
+}
+
+func TestFlushRetriesFailedAggregates(t *testing.T) {
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("MergeRollup", mock.Anything).Return(errors.New("unavailable")).Times(2)
+	dbClientMock.On("MergeRollup", mock.Anything).Return(nil)
+	m := NewMaintainer(logger.NewMockClient(), dbClientMock, nil)
+
+	m.AddReading("thermostat", "Temperature", "20", testTimestamp)
+	m.Flush()
+	m.AddReading("thermostat", "Temperature", "30", testTimestamp)
+	dbClientMock.Calls = nil
+	m.Flush()
+
+	merged := mergedRollups(dbClientMock)
+	require.Len(t, merged, 2)
+	assert.Equal(t, int64(2), merged["Temperature/"+models.RollupHourly].Count)
+	assert.Equal(t, float64(25), merged["Temperature/"+models.RollupDaily].Avg)
+}
+
+func TestPruneDeletesRollupsPastRetention(t *testing.T) {
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeleteRollupsBefore", models.RollupHourly, int64(1592226000000)).Return(nil)
+	m := NewMaintainer(logger.NewMockClient(), dbClientMock, map[string]time.Duration{models.RollupHourly: time.Hour})
+
+	m.Prune(time.Unix(0, 1592229600000*int64(time.Millisecond)))
+
+	dbClientMock.AssertExpectations(t)
+	dbClientMock.AssertNotCalled(t, "DeleteRollupsBefore", models.RollupDaily, mock.Anything)
+}
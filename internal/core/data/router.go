@@ -15,23 +15,38 @@ package data
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/data/ack"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/busonly"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
 	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/errors"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/ingestion"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/kafka"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
 	readingOperator "github.com/edgexfoundry/edgex-go/internal/core/data/operators/reading"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/operators/value_descriptor"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/quota"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/rollup"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/units"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/validator"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/virtual"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
 	errorContainer "github.com/edgexfoundry/edgex-go/internal/pkg/container"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dependency"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/errorconcept"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/messaging"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/secretref"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/telemetry"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
@@ -41,8 +56,8 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/metadata"
 	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
-	"github.com/edgexfoundry/go-mod-messaging/messaging"
 
+	"github.com/google/uuid"
 	"github.com/gorilla/mux"
 )
 
@@ -54,16 +69,19 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 	// Ping Resource
 	r.HandleFunc(
 		clients.ApiPingRoute,
-		func(w http.ResponseWriter, _ *http.Request) {
-			w.Header().Set(clients.ContentType, clients.ContentTypeText)
-			_, _ = w.Write([]byte("pong"))
+		func(w http.ResponseWriter, r *http.Request) {
+			dependency.Ping(
+				w,
+				r,
+				errorContainer.DependencyCheckerFrom(dic.Get),
+				bootstrapContainer.LoggingClientFrom(dic.Get))
 		}).Methods(http.MethodGet)
 
 	// Configuration
 	r.HandleFunc(
 		clients.ApiConfigRoute,
 		func(w http.ResponseWriter, _ *http.Request) {
-			pkg.Encode(dataContainer.ConfigurationFrom(dic.Get), w, bootstrapContainer.LoggingClientFrom(dic.Get))
+			secretref.EncodeConfiguration(dataContainer.ConfigurationFrom(dic.Get), w, dic)
 		}).Methods(http.MethodGet)
 
 	// Metrics
@@ -80,28 +98,54 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 	r.HandleFunc(
 		clients.ApiEventRoute,
 		func(w http.ResponseWriter, r *http.Request) {
-			eventHandler(
+			ingestion.Observe(
+				dataContainer.IngestionMetricsFrom(dic.Get),
+				ingestion.HTTPv1,
 				w,
 				r,
-				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get),
-				dataContainer.PublisherEventsChannelFrom(dic.Get),
-				dataContainer.MessagingClientFrom(dic.Get),
-				dataContainer.MetadataDeviceClientFrom(dic.Get),
-				errorContainer.ErrorHandlerFrom(dic.Get),
-				dataContainer.ConfigurationFrom(dic.Get))
+				func(w http.ResponseWriter, r *http.Request) {
+					eventHandler(
+						w,
+						r,
+						bootstrapContainer.LoggingClientFrom(dic.Get),
+						container.DBClientFrom(dic.Get),
+						dataContainer.PublisherEventsChannelFrom(dic.Get),
+						dataContainer.MessagingClientFrom(dic.Get),
+						dataContainer.MetadataDeviceClientFrom(dic.Get),
+						dataContainer.EventValidatorsFrom(dic.Get),
+						dataContainer.QuotaEnforcerFrom(dic.Get),
+						dataContainer.RollupMaintainerFrom(dic.Get),
+						dataContainer.KafkaExporterFrom(dic.Get),
+						dataContainer.BusOnlyPolicyFrom(dic.Get),
+						dataContainer.AckDispatcherFrom(dic.Get),
+						errorContainer.ErrorHandlerFrom(dic.Get),
+						dataContainer.ConfigurationFrom(dic.Get))
+				})
 		}).Methods(http.MethodGet, http.MethodPut, http.MethodPost)
 	r.HandleFunc(clients.ApiEventRoute, func(writer http.ResponseWriter, request *http.Request) {
-		eventHandler(
+		ingestion.Observe(
+			dataContainer.IngestionMetricsFrom(dic.Get),
+			ingestion.HTTPv1,
 			writer,
 			request,
-			bootstrapContainer.LoggingClientFrom(dic.Get),
-			container.DBClientFrom(dic.Get),
-			dataContainer.PublisherEventsChannelFrom(dic.Get),
-			dataContainer.MessagingClientFrom(dic.Get),
-			dataContainer.MetadataDeviceClientFrom(dic.Get),
-			errorContainer.ErrorHandlerFrom(dic.Get),
-			dataContainer.ConfigurationFrom(dic.Get))
+			func(writer http.ResponseWriter, request *http.Request) {
+				eventHandler(
+					writer,
+					request,
+					bootstrapContainer.LoggingClientFrom(dic.Get),
+					container.DBClientFrom(dic.Get),
+					dataContainer.PublisherEventsChannelFrom(dic.Get),
+					dataContainer.MessagingClientFrom(dic.Get),
+					dataContainer.MetadataDeviceClientFrom(dic.Get),
+					dataContainer.EventValidatorsFrom(dic.Get),
+					dataContainer.QuotaEnforcerFrom(dic.Get),
+					dataContainer.RollupMaintainerFrom(dic.Get),
+					dataContainer.KafkaExporterFrom(dic.Get),
+					dataContainer.BusOnlyPolicyFrom(dic.Get),
+					dataContainer.AckDispatcherFrom(dic.Get),
+					errorContainer.ErrorHandlerFrom(dic.Get),
+					dataContainer.ConfigurationFrom(dic.Get))
+			})
 	}).Methods(http.MethodGet, http.MethodPut, http.MethodPost)
 
 	e := r.PathPrefix(clients.ApiEventRoute).Subrouter()
@@ -151,6 +195,28 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				dataContainer.ConfigurationFrom(dic.Get))
 		}).Methods(http.MethodGet)
 
+	e.HandleFunc(
+		"/"+COUNT+"/"+DEVICE+"/{"+DEVICE+"}/{"+START+":[0-9]+}/{"+END+":[0-9]+}/{"+WINDOW+":[0-9]+}",
+		func(w http.ResponseWriter, r *http.Request) {
+			eventWindowedCountHandler(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get),
+				errorContainer.ErrorHandlerFrom(dic.Get),
+				dataContainer.ConfigurationFrom(dic.Get))
+		}).Methods(http.MethodGet)
+
+	e.HandleFunc(
+		"/"+VALIDATION,
+		func(w http.ResponseWriter, r *http.Request) {
+			eventValidationHandler(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				dataContainer.EventValidatorsFrom(dic.Get))
+		}).Methods(http.MethodGet)
+
 	e.HandleFunc(
 		"/{"+ID+"}",
 		func(w http.ResponseWriter, r *http.Request) {
@@ -191,14 +257,21 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 	e.HandleFunc(
 		"/"+DEVICE+"/{"+DEVICEID_PARAM+"}/{"+LIMIT+":[0-9]+}",
 		func(w http.ResponseWriter, r *http.Request) {
-			getEventByDeviceHandler(
+			explainQuery(
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
 				container.DBClientFrom(dic.Get),
-				dataContainer.MetadataDeviceClientFrom(dic.Get),
-				errorContainer.ErrorHandlerFrom(dic.Get),
-				dataContainer.ConfigurationFrom(dic.Get))
+				func(w http.ResponseWriter, dbClient interfaces.DBClient) {
+					getEventByDeviceHandler(
+						w,
+						r,
+						bootstrapContainer.LoggingClientFrom(dic.Get),
+						dbClient,
+						dataContainer.MetadataDeviceClientFrom(dic.Get),
+						errorContainer.ErrorHandlerFrom(dic.Get),
+						dataContainer.ConfigurationFrom(dic.Get))
+				})
 		}).Methods(http.MethodGet)
 	e.HandleFunc(
 		"/"+DEVICE+"/{"+DEVICEID_PARAM+"}",
@@ -211,6 +284,30 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				errorContainer.ErrorHandlerFrom(dic.Get),
 				dataContainer.ConfigurationFrom(dic.Get))
 		}).Methods(http.MethodDelete)
+	e.HandleFunc(
+		"/"+DEVICE+"/{"+DEVICEID_PARAM+"}/"+RENAME+"/{"+NAME+"}",
+		func(w http.ResponseWriter, r *http.Request) {
+			renameDeviceDataHandler(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get),
+				dataContainer.MetadataDeviceClientFrom(dic.Get),
+				errorContainer.ErrorHandlerFrom(dic.Get),
+				dataContainer.ConfigurationFrom(dic.Get),
+				dataContainer.RollupMaintainerFrom(dic.Get))
+		}).Methods(http.MethodPut)
+	e.HandleFunc(
+		"/"+DEVICE+"/{"+DEVICEID_PARAM+"}/"+ARCHIVE,
+		func(w http.ResponseWriter, r *http.Request) {
+			archiveDeviceDataHandler(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get),
+				errorContainer.ErrorHandlerFrom(dic.Get),
+				dataContainer.ConfigurationFrom(dic.Get))
+		}).Methods(http.MethodPost)
 
 	e.HandleFunc(
 		"/"+REMOVEOLD+"/"+AGE+"/{"+AGE+":[0-9]+}",
@@ -226,13 +323,20 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 	e.HandleFunc(
 		"/{"+START+":[0-9]+}/{"+END+":[0-9]+}/{"+LIMIT+":[0-9]+}",
 		func(w http.ResponseWriter, r *http.Request) {
-			eventByCreationTimeHandler(
+			explainQuery(
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
 				container.DBClientFrom(dic.Get),
-				errorContainer.ErrorHandlerFrom(dic.Get),
-				dataContainer.ConfigurationFrom(dic.Get))
+				func(w http.ResponseWriter, dbClient interfaces.DBClient) {
+					eventByCreationTimeHandler(
+						w,
+						r,
+						bootstrapContainer.LoggingClientFrom(dic.Get),
+						dbClient,
+						errorContainer.ErrorHandlerFrom(dic.Get),
+						dataContainer.ConfigurationFrom(dic.Get))
+				})
 		}).Methods(http.MethodGet)
 
 	// Readings
@@ -246,7 +350,8 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				container.DBClientFrom(dic.Get),
 				dataContainer.MetadataDeviceClientFrom(dic.Get),
 				errorContainer.ErrorHandlerFrom(dic.Get),
-				dataContainer.ConfigurationFrom(dic.Get))
+				dataContainer.ConfigurationFrom(dic.Get),
+				dataContainer.UnitConverterFrom(dic.Get))
 		}).Methods(http.MethodGet, http.MethodPut, http.MethodPost)
 
 	rd := r.PathPrefix(clients.ApiReadingRoute).Subrouter()
@@ -262,6 +367,18 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				errorContainer.ErrorHandlerFrom(dic.Get))
 		}).Methods(http.MethodGet)
 
+	rd.HandleFunc(
+		"/"+COUNT+"/"+DEVICE+"/{"+DEVICE+"}/"+NAME+"/{"+NAME+"}/{"+START+":[0-9]+}/{"+END+":[0-9]+}/{"+WINDOW+":[0-9]+}",
+		func(w http.ResponseWriter, r *http.Request) {
+			readingWindowedCountHandler(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get),
+				errorContainer.ErrorHandlerFrom(dic.Get),
+				dataContainer.ConfigurationFrom(dic.Get))
+		}).Methods(http.MethodGet)
+
 	rd.HandleFunc(
 		"/"+ID+"/{"+ID+"}",
 		func(w http.ResponseWriter, r *http.Request) {
@@ -281,95 +398,242 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
 				container.DBClientFrom(dic.Get),
-				errorContainer.ErrorHandlerFrom(dic.Get))
+				errorContainer.ErrorHandlerFrom(dic.Get),
+				dataContainer.UnitConverterFrom(dic.Get))
 		}).Methods(http.MethodGet)
 
 	rd.HandleFunc(
 		"/"+DEVICE+"/{"+DEVICEID_PARAM+"}/{"+LIMIT+":[0-9]+}",
 		func(w http.ResponseWriter, r *http.Request) {
-			readingByDeviceHandler(
+			explainQuery(
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
 				container.DBClientFrom(dic.Get),
-				dataContainer.MetadataDeviceClientFrom(dic.Get),
-				errorContainer.ErrorHandlerFrom(dic.Get),
-				dataContainer.ConfigurationFrom(dic.Get))
+				func(w http.ResponseWriter, dbClient interfaces.DBClient) {
+					readingByDeviceHandler(
+						w,
+						r,
+						bootstrapContainer.LoggingClientFrom(dic.Get),
+						dbClient,
+						dataContainer.MetadataDeviceClientFrom(dic.Get),
+						errorContainer.ErrorHandlerFrom(dic.Get),
+						dataContainer.ConfigurationFrom(dic.Get),
+						dataContainer.UnitConverterFrom(dic.Get))
+				})
 		}).Methods(http.MethodGet)
 
 	rd.HandleFunc(
 		"/"+NAME+"/{"+NAME+"}/{"+LIMIT+":[0-9]+}",
 		func(w http.ResponseWriter, r *http.Request) {
-			readingbyValueDescriptorHandler(
+			explainQuery(
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
 				container.DBClientFrom(dic.Get),
-				errorContainer.ErrorHandlerFrom(dic.Get),
-				dataContainer.ConfigurationFrom(dic.Get))
+				func(w http.ResponseWriter, dbClient interfaces.DBClient) {
+					readingbyValueDescriptorHandler(
+						w,
+						r,
+						bootstrapContainer.LoggingClientFrom(dic.Get),
+						dbClient,
+						errorContainer.ErrorHandlerFrom(dic.Get),
+						dataContainer.ConfigurationFrom(dic.Get),
+						dataContainer.UnitConverterFrom(dic.Get))
+				})
 		}).Methods(http.MethodGet)
 
 	rd.HandleFunc(
 		"/"+UOMLABEL+"/{"+UOMLABEL_PARAM+"}/{"+LIMIT+":[0-9]+}",
 		func(w http.ResponseWriter, r *http.Request) {
-			readingByUomLabelHandler(
+			explainQuery(
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
 				container.DBClientFrom(dic.Get),
-				errorContainer.ErrorHandlerFrom(dic.Get),
-				dataContainer.ConfigurationFrom(dic.Get))
+				func(w http.ResponseWriter, dbClient interfaces.DBClient) {
+					readingByUomLabelHandler(
+						w,
+						r,
+						bootstrapContainer.LoggingClientFrom(dic.Get),
+						dbClient,
+						errorContainer.ErrorHandlerFrom(dic.Get),
+						dataContainer.ConfigurationFrom(dic.Get),
+						dataContainer.UnitConverterFrom(dic.Get))
+				})
 		}).Methods(http.MethodGet)
 
 	rd.HandleFunc(
 		"/"+LABEL+"/{"+LABEL+"}/{"+LIMIT+":[0-9]+}",
 		func(w http.ResponseWriter, r *http.Request) {
-			readingByLabelHandler(
+			explainQuery(
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
 				container.DBClientFrom(dic.Get),
-				errorContainer.ErrorHandlerFrom(dic.Get),
-				dataContainer.ConfigurationFrom(dic.Get))
+				func(w http.ResponseWriter, dbClient interfaces.DBClient) {
+					readingByLabelHandler(
+						w,
+						r,
+						bootstrapContainer.LoggingClientFrom(dic.Get),
+						dbClient,
+						errorContainer.ErrorHandlerFrom(dic.Get),
+						dataContainer.ConfigurationFrom(dic.Get),
+						dataContainer.UnitConverterFrom(dic.Get))
+				})
 		}).Methods(http.MethodGet)
 
 	rd.HandleFunc(
 		"/"+TYPE+"/{"+TYPE+"}/{"+LIMIT+":[0-9]+}",
 		func(w http.ResponseWriter, r *http.Request) {
-			readingByTypeHandler(
+			explainQuery(
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
 				container.DBClientFrom(dic.Get),
-				errorContainer.ErrorHandlerFrom(dic.Get),
-				dataContainer.ConfigurationFrom(dic.Get))
+				func(w http.ResponseWriter, dbClient interfaces.DBClient) {
+					readingByTypeHandler(
+						w,
+						r,
+						bootstrapContainer.LoggingClientFrom(dic.Get),
+						dbClient,
+						errorContainer.ErrorHandlerFrom(dic.Get),
+						dataContainer.ConfigurationFrom(dic.Get),
+						dataContainer.UnitConverterFrom(dic.Get))
+				})
 		}).Methods(http.MethodGet)
 
 	rd.HandleFunc(
 		"/{"+START+":[0-9]+}/{"+END+":[0-9]+}/{"+LIMIT+":[0-9]+}",
 		func(w http.ResponseWriter, r *http.Request) {
-			readingByCreationTimeHandler(
+			explainQuery(
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
 				container.DBClientFrom(dic.Get),
-				errorContainer.ErrorHandlerFrom(dic.Get),
-				dataContainer.ConfigurationFrom(dic.Get))
+				func(w http.ResponseWriter, dbClient interfaces.DBClient) {
+					readingByCreationTimeHandler(
+						w,
+						r,
+						bootstrapContainer.LoggingClientFrom(dic.Get),
+						dbClient,
+						errorContainer.ErrorHandlerFrom(dic.Get),
+						dataContainer.ConfigurationFrom(dic.Get),
+						dataContainer.UnitConverterFrom(dic.Get))
+				})
 		}).Methods(http.MethodGet)
 
 	rd.HandleFunc(
 		"/"+NAME+"/{"+NAME+"}/"+DEVICE+"/{"+DEVICE+"}/{"+LIMIT+":[0-9]+}",
 		func(w http.ResponseWriter, r *http.Request) {
-			readingByValueDescriptorAndDeviceHandler(
+			explainQuery(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get),
+				func(w http.ResponseWriter, dbClient interfaces.DBClient) {
+					readingByValueDescriptorAndDeviceHandler(
+						w,
+						r,
+						bootstrapContainer.LoggingClientFrom(dic.Get),
+						dbClient,
+						dataContainer.MetadataDeviceClientFrom(dic.Get),
+						errorContainer.ErrorHandlerFrom(dic.Get),
+						dataContainer.ConfigurationFrom(dic.Get),
+						dataContainer.UnitConverterFrom(dic.Get),
+						dataContainer.VirtualResourcesFrom(dic.Get))
+				})
+		}).Methods(http.MethodGet)
+
+	// Rollups
+	ru := r.PathPrefix(clients.ApiBase + "/" + ROLLUP).Subrouter()
+
+	ru.HandleFunc(
+		"/{"+PERIOD+"}/"+DEVICE+"/{"+DEVICE+"}/{"+START+":[0-9]+}/{"+END+":[0-9]+}/{"+LIMIT+":[0-9]+}",
+		func(w http.ResponseWriter, r *http.Request) {
+			rollupByDeviceHandler(
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
 				container.DBClientFrom(dic.Get),
-				dataContainer.MetadataDeviceClientFrom(dic.Get),
 				errorContainer.ErrorHandlerFrom(dic.Get),
 				dataContainer.ConfigurationFrom(dic.Get))
 		}).Methods(http.MethodGet)
 
+	ru.HandleFunc(
+		"/{"+PERIOD+"}/"+DEVICE+"/{"+DEVICE+"}/"+NAME+"/{"+NAME+"}/{"+START+":[0-9]+}/{"+END+":[0-9]+}/{"+LIMIT+":[0-9]+}",
+		func(w http.ResponseWriter, r *http.Request) {
+			rollupByDeviceAndNameHandler(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get),
+				errorContainer.ErrorHandlerFrom(dic.Get),
+				dataContainer.ConfigurationFrom(dic.Get))
+		}).Methods(http.MethodGet)
+
+	// Kafka export
+	r.HandleFunc(
+		clients.ApiBase+"/"+KAFKA+"/"+METRICS,
+		func(w http.ResponseWriter, r *http.Request) {
+			kafkaMetricsHandler(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				dataContainer.KafkaExporterFrom(dic.Get),
+				errorContainer.ErrorHandlerFrom(dic.Get))
+		}).Methods(http.MethodGet)
+
+	// Bus-only readings
+	b := r.PathPrefix(clients.ApiBase + "/" + BUSONLY).Subrouter()
+
+	b.HandleFunc(
+		"",
+		func(w http.ResponseWriter, r *http.Request) {
+			busOnlyRulesHandler(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				dataContainer.BusOnlyPolicyFrom(dic.Get))
+		}).Methods(http.MethodGet)
+
+	for _, path := range []string{
+		"/" + PROFILE + "/{" + PROFILE + "}",
+		"/" + PROFILE + "/{" + PROFILE + "}/" + RESOURCE + "/{" + RESOURCE + "}",
+	} {
+		b.HandleFunc(
+			path,
+			func(w http.ResponseWriter, r *http.Request) {
+				addBusOnlyRuleHandler(
+					w,
+					r,
+					bootstrapContainer.LoggingClientFrom(dic.Get),
+					dataContainer.BusOnlyPolicyFrom(dic.Get),
+					errorContainer.ErrorHandlerFrom(dic.Get))
+			}).Methods(http.MethodPut)
+
+		b.HandleFunc(
+			path,
+			func(w http.ResponseWriter, r *http.Request) {
+				deleteBusOnlyRuleHandler(
+					w,
+					r,
+					dataContainer.BusOnlyPolicyFrom(dic.Get),
+					errorContainer.ErrorHandlerFrom(dic.Get))
+			}).Methods(http.MethodDelete)
+	}
+
+	// Ingestion
+	r.HandleFunc(
+		clients.ApiBase+"/"+INGESTION+"/"+METRICS,
+		func(w http.ResponseWriter, r *http.Request) {
+			ingestionMetricsHandler(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				dataContainer.IngestionMetricsFrom(dic.Get))
+		}).Methods(http.MethodGet)
+
 	// Value descriptors
 	r.HandleFunc(
 		clients.ApiValueDescriptorRoute,
@@ -481,6 +745,22 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 	r.Use(correlation.OnRequestBegin)
 }
 
+/*
+Return the outcome of the validation of the incoming events: the validators run, the number of events validated, the
+number of events let through while validation was bypassed and the number of events rejected by each validator
+api/v1/event/validation
+*/
+func eventValidationHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	validators *validator.Chain) {
+
+	defer func() { _ = r.Body.Close() }()
+
+	pkg.Encode(validators.Statistics(), w, lc)
+}
+
 /*
 Return number of events in Core Data
 /api/v1/event/count
@@ -579,10 +859,15 @@ func eventByAgeHandler(
 
 /*
 Handler for the event API
+The X-Ack-Level header of a POST selects when the event is acknowledged: 'none' answers 202 with the ID of the event
+before it is processed, 'persisted' once it is persisted, 'published' once it is also published to the message bus.
+Status code 202 - event accepted, processed in the background
 Status code 400 - Unsupported content type, or invalid data
 Status code 404 - event not found
-Status code 413 - number of events exceeds limit
+Status code 413 - number of events exceeds limit, or event exceeds the quotas of its device
+Status code 429 - device exceeds its quota of events per hour
 Status code 500 - unanticipated issues
+Status code 503 - event persisted but not published, at the 'published' level
 api/v1/event
 */
 func eventHandler(
@@ -593,6 +878,12 @@ func eventHandler(
 	chEvents chan<- interface{},
 	msgClient messaging.MessageClient,
 	mdc metadata.DeviceClient,
+	validators *validator.Chain,
+	quotas *quota.Enforcer,
+	rollups *rollup.Maintainer,
+	exporter *kafka.Exporter,
+	busOnly *busonly.Policy,
+	dispatcher *ack.Dispatcher,
 	httpErrorHandler errorconcept.ErrorHandler,
 	configuration *config.ConfigurationStruct) {
 
@@ -611,19 +902,48 @@ func eventHandler(
 			return
 		}
 
-		pkg.Encode(events, w, lc)
+		encodeEvents(events, w, r, lc)
 		break
 		// Post a new event
 	case http.MethodPost:
+		level, err := dispatcher.Level(r.Header.Get(ack.Header))
+		if err != nil {
+			httpErrorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+			return
+		}
+
 		reader := NewRequestReader(r, configuration)
 
 		evt := models.Event{}
-		evt, err := reader.Read(r.Body, &ctx)
+		evt, err = reader.Read(r.Body, &ctx)
 		if err != nil {
 			httpErrorHandler.Handle(w, err, errorconcept.Default.InternalServerError)
 			return
 		}
-		newId, err := addNewEvent(evt, ctx, lc, dbClient, chEvents, msgClient, mdc, configuration)
+
+		if level == ack.None {
+			// the ID is assigned before the event is persisted, for the client to refer to it
+			if evt.ID == "" {
+				evt.ID = uuid.New().String()
+			}
+			background := ack.Detach(ctx)
+			accepted := dispatcher.Go(func() {
+				_, err := addNewEvent(evt, background, lc, dbClient, chEvents, msgClient, mdc, validators, quotas, rollups, exporter, busOnly, level, configuration)
+				if err != nil {
+					lc.Error(
+						fmt.Sprintf("failed to process event %s acknowledged before its persistence: %s", evt.ID, err.Error()),
+						clients.CorrelationHeader,
+						correlation.FromContext(background))
+				}
+			})
+			if accepted {
+				w.WriteHeader(http.StatusAccepted)
+				_, _ = w.Write([]byte(evt.ID))
+				break
+			}
+		}
+
+		newId, err := addNewEvent(evt, ctx, lc, dbClient, chEvents, msgClient, mdc, validators, quotas, rollups, exporter, busOnly, level, configuration)
 		if err != nil {
 			httpErrorHandler.HandleManyVariants(
 				w,
@@ -631,6 +951,10 @@ func eventHandler(
 				[]errorconcept.ErrorConceptType{
 					errorconcept.ValueDescriptors.NotFound,
 					errorconcept.ValueDescriptors.Invalid,
+					errorconcept.Events.Rejected,
+					errorconcept.Events.TooLarge,
+					errorconcept.Events.RateExceeded,
+					errorconcept.Events.NotPublished,
 					errorconcept.NewServiceClientHttpError(err),
 				},
 				errorconcept.Default.InternalServerError)
@@ -727,7 +1051,7 @@ func getEventByIdHandler(
 		return
 	}
 
-	pkg.Encode(e, w, lc)
+	encodeEvent(e, w, r, lc)
 }
 
 // Get event by device id
@@ -788,7 +1112,7 @@ func getEventByDeviceHandler(
 			return
 		}
 
-		pkg.Encode(eventList, w, lc)
+		encodeEvents(eventList, w, r, lc)
 	}
 }
 
@@ -943,6 +1267,98 @@ func deleteByDeviceIdHandler(
 	}
 }
 
+// Move the events, readings and rollups of a device to its new name once the device has been renamed in metadata
+// Returns the number of events moved
+// 400 - the new name is empty or the same as the old one
+// 404 - new device name not found in metadata
+// 500 - the data was partially moved, renaming again resumes the move
+// api/v1/event/device/{deviceId}/rename/{name}
+func renameDeviceDataHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	mdc metadata.DeviceClient,
+	httpErrorHandler errorconcept.ErrorHandler,
+	configuration *config.ConfigurationStruct,
+	rollups *rollup.Maintainer) {
+
+	defer func() { _ = r.Body.Close() }()
+
+	vars := mux.Vars(r)
+	from, err := url.QueryUnescape(vars[DEVICEID_PARAM])
+	if err != nil {
+		httpErrorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return
+	}
+	to, err := url.QueryUnescape(vars[NAME])
+	if err != nil {
+		httpErrorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return
+	}
+	if to == "" || to == from {
+		err = fmt.Errorf("invalid new name '%s' for device %s", to, from)
+		httpErrorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return
+	}
+
+	// The device is renamed in metadata before its data
+	if err := checkDevice(to, r.Context(), mdc, configuration); err != nil {
+		httpErrorHandler.HandleOneVariant(
+			w,
+			err,
+			errorconcept.NewServiceClientHttpError(err),
+			errorconcept.Default.InternalServerError)
+		return
+	}
+
+	count, err := renameDeviceData(from, to, lc, dbClient, rollups)
+	if err != nil {
+		httpErrorHandler.Handle(w, err, errorconcept.Default.InternalServerError)
+		return
+	}
+
+	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(strconv.Itoa(count)))
+}
+
+// Archive the events of a device, e.g. before deleting them when the device is decommissioned
+// {deviceId} - the name of the device
+// 503 - archiving isn't configured
+// api/v1/event/device/{deviceId}/archive
+func archiveDeviceDataHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	httpErrorHandler errorconcept.ErrorHandler,
+	configuration *config.ConfigurationStruct) {
+
+	defer func() { _ = r.Body.Close() }()
+
+	vars := mux.Vars(r)
+	deviceId, err := url.QueryUnescape(vars[DEVICEID_PARAM])
+	if err != nil {
+		httpErrorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return
+	}
+	if configuration.Archive.Directory == "" {
+		err = fmt.Errorf("no archive directory is configured")
+		httpErrorHandler.Handle(w, err, errorconcept.Default.ServiceUnavailable)
+		return
+	}
+
+	archive, err := archiveDeviceEvents(deviceId, configuration.Archive.Directory, time.Now(), lc, dbClient)
+	if err != nil {
+		lc.Error(fmt.Sprintf("failed to archive the events of device %s: %s", deviceId, err.Error()))
+		httpErrorHandler.Handle(w, err, errorconcept.Default.InternalServerError)
+		return
+	}
+
+	pkg.Encode(archive, w, lc)
+}
+
 // Get events by creation time
 // {start} - start time, {end} - end time, {limit} - max number of results
 // Sort the events by creation date
@@ -996,7 +1412,7 @@ func eventByCreationTimeHandler(
 			return
 		}
 
-		pkg.Encode(eventList, w, lc)
+		encodeEvents(eventList, w, r, lc)
 	}
 }
 
@@ -1035,7 +1451,8 @@ func readingHandler(
 	dbClient interfaces.DBClient,
 	mdc metadata.DeviceClient,
 	httpErrorHandler errorconcept.ErrorHandler,
-	configuration *config.ConfigurationStruct) {
+	configuration *config.ConfigurationStruct,
+	converter *units.Converter) {
 
 	defer func() { _ = r.Body.Close() }()
 
@@ -1043,7 +1460,7 @@ func readingHandler(
 
 	switch r.Method {
 	case http.MethodGet:
-		r, err := getAllReadings(lc, dbClient, configuration)
+		readings, err := getAllReadings(lc, dbClient, configuration)
 
 		if err != nil {
 			httpErrorHandler.HandleOneVariant(
@@ -1053,7 +1470,7 @@ func readingHandler(
 				errorconcept.Default.InternalServerError)
 		}
 
-		pkg.Encode(r, w, lc)
+		encodeReadings(w, r, readings, lc, dbClient, converter, httpErrorHandler)
 	case http.MethodPost:
 		reading, err := decodeReading(r.Body, lc, dbClient, configuration)
 
@@ -1137,7 +1554,8 @@ func getReadingByIdHandler(
 	r *http.Request,
 	lc logger.LoggingClient,
 	dbClient interfaces.DBClient,
-	httpErrorHandler errorconcept.ErrorHandler) {
+	httpErrorHandler errorconcept.ErrorHandler,
+	converter *units.Converter) {
 
 	defer func() { _ = r.Body.Close() }()
 
@@ -1155,6 +1573,15 @@ func getReadingByIdHandler(
 				errorconcept.Default.InternalServerError)
 		}
 
+		if requested := r.URL.Query().Get(UNITS); requested != "" {
+			converted, err := convertReadings([]contract.Reading{reading}, requested, lc, dbClient, converter)
+			if err != nil {
+				handleConversionError(w, err, httpErrorHandler)
+				return
+			}
+			pkg.Encode(converted[0], w, lc)
+			return
+		}
 		pkg.Encode(reading, w, lc)
 	}
 }
@@ -1229,7 +1656,8 @@ func readingByDeviceHandler(
 	dbClient interfaces.DBClient,
 	mdc metadata.DeviceClient,
 	httpErrorHandler errorconcept.ErrorHandler,
-	configuration *config.ConfigurationStruct) {
+	configuration *config.ConfigurationStruct,
+	converter *units.Converter) {
 
 	defer func() { _ = r.Body.Close() }()
 
@@ -1267,7 +1695,7 @@ func readingByDeviceHandler(
 			return
 		}
 
-		pkg.Encode(readings, w, lc)
+		encodeReadings(w, r, readings, lc, dbClient, converter, httpErrorHandler)
 	}
 }
 
@@ -1280,7 +1708,8 @@ func readingbyValueDescriptorHandler(
 	lc logger.LoggingClient,
 	dbClient interfaces.DBClient,
 	httpErrorHandler errorconcept.ErrorHandler,
-	configuration *config.ConfigurationStruct) {
+	configuration *config.ConfigurationStruct,
+	converter *units.Converter) {
 
 	defer func() { _ = r.Body.Close() }()
 
@@ -1304,7 +1733,7 @@ func readingbyValueDescriptorHandler(
 		return
 	}
 
-	pkg.Encode(read, w, lc)
+	encodeReadings(w, r, read, lc, dbClient, converter, httpErrorHandler)
 }
 
 // Return a list of readings based on the UOM label for the value decriptor
@@ -1315,7 +1744,8 @@ func readingByUomLabelHandler(
 	lc logger.LoggingClient,
 	dbClient interfaces.DBClient,
 	httpErrorHandler errorconcept.ErrorHandler,
-	configuration *config.ConfigurationStruct) {
+	configuration *config.ConfigurationStruct,
+	converter *units.Converter) {
 
 	defer func() { _ = r.Body.Close() }()
 
@@ -1360,7 +1790,7 @@ func readingByUomLabelHandler(
 		return
 	}
 
-	pkg.Encode(readings, w, lc)
+	encodeReadings(w, r, readings, lc, dbClient, converter, httpErrorHandler)
 }
 
 // Get readings by the value descriptor (specified by the label)
@@ -1372,7 +1802,8 @@ func readingByLabelHandler(
 	lc logger.LoggingClient,
 	dbClient interfaces.DBClient,
 	httpErrorHandler errorconcept.ErrorHandler,
-	configuration *config.ConfigurationStruct) {
+	configuration *config.ConfigurationStruct,
+	converter *units.Converter) {
 
 	defer func() { _ = r.Body.Close() }()
 
@@ -1414,7 +1845,7 @@ func readingByLabelHandler(
 		return
 	}
 
-	pkg.Encode(readings, w, lc)
+	encodeReadings(w, r, readings, lc, dbClient, converter, httpErrorHandler)
 }
 
 // Return a list of readings who's value descriptor has the type
@@ -1426,7 +1857,8 @@ func readingByTypeHandler(
 	lc logger.LoggingClient,
 	dbClient interfaces.DBClient,
 	httpErrorHandler errorconcept.ErrorHandler,
-	configuration *config.ConfigurationStruct) {
+	configuration *config.ConfigurationStruct,
+	converter *units.Converter) {
 
 	defer func() { _ = r.Body.Close() }()
 
@@ -1468,7 +1900,7 @@ func readingByTypeHandler(
 		return
 	}
 
-	pkg.Encode(readings, w, lc)
+	encodeReadings(w, r, readings, lc, dbClient, converter, httpErrorHandler)
 }
 
 // Return a list of readings between the start and end (creation time)
@@ -1479,7 +1911,8 @@ func readingByCreationTimeHandler(
 	lc logger.LoggingClient,
 	dbClient interfaces.DBClient,
 	httpErrorHandler errorconcept.ErrorHandler,
-	configuration *config.ConfigurationStruct) {
+	configuration *config.ConfigurationStruct,
+	converter *units.Converter) {
 
 	defer func() { _ = r.Body.Close() }()
 
@@ -1514,7 +1947,7 @@ func readingByCreationTimeHandler(
 			return
 		}
 
-		pkg.Encode(readings, w, lc)
+		encodeReadings(w, r, readings, lc, dbClient, converter, httpErrorHandler)
 	}
 }
 
@@ -1528,7 +1961,9 @@ func readingByValueDescriptorAndDeviceHandler(
 	dbClient interfaces.DBClient,
 	mdc metadata.DeviceClient,
 	httpErrorHandler errorconcept.ErrorHandler,
-	configuration *config.ConfigurationStruct) {
+	configuration *config.ConfigurationStruct,
+	converter *units.Converter,
+	virtuals *virtual.Resources) {
 
 	defer func() { _ = r.Body.Close() }()
 
@@ -1570,6 +2005,18 @@ func readingByValueDescriptorAndDeviceHandler(
 		return
 	}
 
+	// Virtual resources are computed from the rollups of their source and have no value descriptor
+	if info, ok := virtuals.Lookup(name); ok {
+		readings, err := virtual.Readings(dbClient, info, device, limit)
+		if err != nil {
+			lc.Error(err.Error())
+			httpErrorHandler.Handle(w, err, errorconcept.Default.InternalServerError)
+			return
+		}
+		encodeReadings(w, r, readings, lc, dbClient, converter, httpErrorHandler)
+		return
+	}
+
 	// Check for value descriptor
 	if configuration.Writable.ValidateCheck {
 		_, err = getValueDescriptorByName(name, lc, dbClient)
@@ -1589,7 +2036,382 @@ func readingByValueDescriptorAndDeviceHandler(
 		return
 	}
 
-	pkg.Encode(readings, w, lc)
+	encodeReadings(w, r, readings, lc, dbClient, converter, httpErrorHandler)
+}
+
+// Return the rollups of the period, hourly or daily, for the resources of the device starting between start and end
+// 400 - unknown period
+// 413 - limit exceeded
+// api/v1/rollup/{period}/device/{device}/{start}/{end}/{limit}
+func rollupByDeviceHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	httpErrorHandler errorconcept.ErrorHandler,
+	configuration *config.ConfigurationStruct) {
+
+	defer func() { _ = r.Body.Close() }()
+
+	q, ok := parseRollupQuery(w, r, lc, httpErrorHandler, configuration)
+	if !ok {
+		return
+	}
+
+	rollups, err := dbClient.RollupsByDevice(q.period, q.device, q.start, q.end, q.limit)
+	if err != nil {
+		lc.Error(err.Error())
+		httpErrorHandler.Handle(w, err, errorconcept.Default.InternalServerError)
+		return
+	}
+
+	pkg.Encode(rollups, w, lc)
+}
+
+// Return the rollups of the period, hourly or daily, for the device resource starting between start and end
+// 400 - unknown period
+// 413 - limit exceeded
+// api/v1/rollup/{period}/device/{device}/name/{name}/{start}/{end}/{limit}
+func rollupByDeviceAndNameHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	httpErrorHandler errorconcept.ErrorHandler,
+	configuration *config.ConfigurationStruct) {
+
+	defer func() { _ = r.Body.Close() }()
+
+	q, ok := parseRollupQuery(w, r, lc, httpErrorHandler, configuration)
+	if !ok {
+		return
+	}
+	name, err := url.QueryUnescape(mux.Vars(r)[NAME])
+	if err != nil {
+		httpErrorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return
+	}
+
+	rollups, err := dbClient.RollupsByDeviceAndName(q.period, q.device, name, q.start, q.end, q.limit)
+	if err != nil {
+		lc.Error(err.Error())
+		httpErrorHandler.Handle(w, err, errorconcept.Default.InternalServerError)
+		return
+	}
+
+	pkg.Encode(rollups, w, lc)
+}
+
+// Return the number of events of the device created during each window of {window} milliseconds from {start} up to
+// {end}, excluded, counted from the index of the events by creation time without reading them
+// 400 - invalid range or window
+// 413 - more windows than the max result count
+// api/v1/event/count/device/{device}/{start}/{end}/{window}
+func eventWindowedCountHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	httpErrorHandler errorconcept.ErrorHandler,
+	configuration *config.ConfigurationStruct) {
+
+	defer func() { _ = r.Body.Close() }()
+
+	count, ok := parseWindowedCountQuery(w, r, lc, httpErrorHandler, configuration)
+	if !ok {
+		return
+	}
+
+	counts, err := dbClient.EventCountsByDevice(count.Device, count.Start, count.End, count.Window)
+	if err != nil {
+		lc.Error(err.Error())
+		httpErrorHandler.Handle(w, err, errorconcept.Default.InternalServerError)
+		return
+	}
+	count.Counts = counts
+
+	pkg.Encode(count, w, lc)
+}
+
+// Return the number of readings of the device resource received during each window of {window} milliseconds from
+// {start} up to {end}, excluded, counted from the hourly or daily rollups. As the rollups only aggregate numeric
+// values, the start and the window must be whole hours and the readings which aren't numeric aren't counted.
+// 400 - invalid range or window
+// 413 - more windows or rollups than the max result count
+// api/v1/reading/count/device/{device}/name/{name}/{start}/{end}/{window}
+func readingWindowedCountHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	httpErrorHandler errorconcept.ErrorHandler,
+	configuration *config.ConfigurationStruct) {
+
+	defer func() { _ = r.Body.Close() }()
+
+	count, ok := parseWindowedCountQuery(w, r, lc, httpErrorHandler, configuration)
+	if !ok {
+		return
+	}
+	name, err := url.QueryUnescape(mux.Vars(r)[NAME])
+	if err != nil {
+		httpErrorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return
+	}
+	count.Name = name
+
+	period, err := dataModels.WindowedCountPeriod(count.Start, count.Window)
+	if err != nil {
+		httpErrorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return
+	}
+	length, _ := dataModels.PeriodLength(period)
+	limit := int(dataModels.Windows(count.Start, count.End, length))
+	err = checkMaxLimit(limit, lc, configuration)
+	if err != nil {
+		httpErrorHandler.Handle(w, err, errorconcept.Common.LimitExceeded)
+		return
+	}
+
+	rollups, err := dbClient.RollupsByDeviceAndName(period, count.Device, name, count.Start, count.End-1, limit)
+	if err != nil {
+		lc.Error(err.Error())
+		httpErrorHandler.Handle(w, err, errorconcept.Default.InternalServerError)
+		return
+	}
+	for _, rollup := range rollups {
+		count.AddRollup(rollup)
+	}
+
+	pkg.Encode(count, w, lc)
+}
+
+// parseWindowedCountQuery reads the parameters common to the windowed count queries into an empty windowed count,
+// writing the error response itself when they are invalid.
+func parseWindowedCountQuery(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	httpErrorHandler errorconcept.ErrorHandler,
+	configuration *config.ConfigurationStruct) (dataModels.WindowedCount, bool) {
+
+	vars := mux.Vars(r)
+	device, err := url.QueryUnescape(vars[DEVICE])
+	if err != nil {
+		httpErrorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return dataModels.WindowedCount{}, false
+	}
+	start, err := strconv.ParseInt(vars[START], 10, 64)
+	if err != nil {
+		httpErrorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return dataModels.WindowedCount{}, false
+	}
+	end, err := strconv.ParseInt(vars[END], 10, 64)
+	if err != nil {
+		httpErrorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return dataModels.WindowedCount{}, false
+	}
+	window, err := strconv.ParseInt(vars[WINDOW], 10, 64)
+	if err != nil {
+		httpErrorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return dataModels.WindowedCount{}, false
+	}
+
+	err = checkMaxLimit(int(dataModels.Windows(start, end, window)), lc, configuration)
+	if err != nil {
+		httpErrorHandler.Handle(w, err, errorconcept.Common.LimitExceeded)
+		return dataModels.WindowedCount{}, false
+	}
+	count, err := dataModels.NewWindowedCount(device, "", start, end, window)
+	if err != nil {
+		httpErrorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return dataModels.WindowedCount{}, false
+	}
+	return count, true
+}
+
+// Return the progress of the export of the events to kafka: the number of events waiting for their export, the lag
+// of the oldest one in milliseconds and the number of events delivered, dropped and of failed attempts
+// 404 - the export to kafka is disabled
+// api/v1/kafka/metrics
+func kafkaMetricsHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	exporter *kafka.Exporter,
+	httpErrorHandler errorconcept.ErrorHandler) {
+
+	defer func() { _ = r.Body.Close() }()
+
+	if exporter == nil {
+		httpErrorHandler.Handle(w, fmt.Errorf("export to kafka is disabled"), errorconcept.Common.ItemNotFound)
+		return
+	}
+
+	pkg.Encode(exporter.Metrics(), w, lc)
+}
+
+// Return the rules of the readings published to the message bus without being persisted
+// api/v1/busonly
+func busOnlyRulesHandler(w http.ResponseWriter, r *http.Request, lc logger.LoggingClient, policy *busonly.Policy) {
+	defer func() { _ = r.Body.Close() }()
+
+	pkg.Encode(policy.Rules(), w, lc)
+}
+
+// Make the readings of the profile, or of its resource, bus-only, and return the rule, created with 201 when new
+// 400 - invalid profile
+// api/v1/busonly/profile/{profile}
+// api/v1/busonly/profile/{profile}/resource/{resource}
+func addBusOnlyRuleHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	policy *busonly.Policy,
+	httpErrorHandler errorconcept.ErrorHandler) {
+
+	defer func() { _ = r.Body.Close() }()
+
+	vars := mux.Vars(r)
+	rule, created, err := policy.Add(vars[PROFILE], vars[RESOURCE])
+	if err != nil {
+		httpErrorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return
+	}
+	if created {
+		lc.Info(fmt.Sprintf("The readings of %s are bus-only", busOnlyTarget(rule.Profile, rule.Resource)))
+		w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+		w.WriteHeader(http.StatusCreated)
+	}
+
+	pkg.Encode(rule, w, lc)
+}
+
+// Persist the readings of the profile, or of its resource, again
+// 404 - no such rule
+// api/v1/busonly/profile/{profile}
+// api/v1/busonly/profile/{profile}/resource/{resource}
+func deleteBusOnlyRuleHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+	policy *busonly.Policy,
+	httpErrorHandler errorconcept.ErrorHandler) {
+
+	defer func() { _ = r.Body.Close() }()
+
+	vars := mux.Vars(r)
+	if !policy.Delete(vars[PROFILE], vars[RESOURCE]) {
+		httpErrorHandler.Handle(
+			w,
+			fmt.Errorf("the readings of %s aren't bus-only", busOnlyTarget(vars[PROFILE], vars[RESOURCE])),
+			errorconcept.Common.ItemNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("true"))
+}
+
+// busOnlyTarget describes the readings of a bus-only rule.
+func busOnlyTarget(profile string, resource string) string {
+	if resource == "" {
+		return "profile " + profile
+	}
+	return fmt.Sprintf("resource %s of profile %s", resource, profile)
+}
+
+// ingestionMetricsHandler returns the metrics of the ingestion of the events by transport.
+func ingestionMetricsHandler(w http.ResponseWriter, r *http.Request, lc logger.LoggingClient, metrics *ingestion.Metrics) {
+	defer func() { _ = r.Body.Close() }()
+
+	pkg.Encode(metrics.Snapshot(), w, lc)
+}
+
+type rollupQuery struct {
+	period string
+	device string
+	start  int64
+	end    int64
+	limit  int
+}
+
+// parseRollupQuery reads the parameters common to the rollup queries, writing the error response itself when they are
+// invalid.
+func parseRollupQuery(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	httpErrorHandler errorconcept.ErrorHandler,
+	configuration *config.ConfigurationStruct) (rollupQuery, bool) {
+
+	vars := mux.Vars(r)
+	q := rollupQuery{period: vars[PERIOD]}
+
+	_, err := dataModels.PeriodLength(q.period)
+	if err != nil {
+		httpErrorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return q, false
+	}
+	q.device, err = url.QueryUnescape(vars[DEVICE])
+	if err != nil {
+		httpErrorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return q, false
+	}
+	q.start, err = strconv.ParseInt(vars[START], 10, 64)
+	if err != nil {
+		httpErrorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return q, false
+	}
+	q.end, err = strconv.ParseInt(vars[END], 10, 64)
+	if err != nil {
+		httpErrorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return q, false
+	}
+	q.limit, err = strconv.Atoi(vars[LIMIT])
+	if err != nil {
+		httpErrorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return q, false
+	}
+
+	err = checkMaxLimit(q.limit, lc, configuration)
+	if err != nil {
+		httpErrorHandler.Handle(w, err, errorconcept.Common.LimitExceeded)
+		return q, false
+	}
+	return q, true
+}
+
+// encodeReadings encodes the readings, converted to the units requested by the units query parameter if any, i.e. to
+// a system of units such as metric or imperial or to an explicit unit
+func encodeReadings(
+	w http.ResponseWriter,
+	r *http.Request,
+	readings []contract.Reading,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	converter *units.Converter,
+	httpErrorHandler errorconcept.ErrorHandler) {
+
+	requested := r.URL.Query().Get(UNITS)
+	if requested == "" {
+		pkg.Encode(readings, w, lc)
+		return
+	}
+
+	converted, err := convertReadings(readings, requested, lc, dbClient, converter)
+	if err != nil {
+		handleConversionError(w, err, httpErrorHandler)
+		return
+	}
+	pkg.Encode(converted, w, lc)
+}
+
+func handleConversionError(w http.ResponseWriter, err error, httpErrorHandler errorconcept.ErrorHandler) {
+	httpErrorHandler.HandleOneVariant(
+		w,
+		err,
+		errorconcept.ValueDescriptors.UnsupportedUnits,
+		errorconcept.Default.InternalServerError)
 }
 
 // Value Descriptors
@@ -30,11 +30,16 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/interfaces"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/interfaces/mocks"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/errorconcept"
 
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
 	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // TestSuccessfulConfig configuration used to avoid MaxResultCount errors.
@@ -286,3 +291,151 @@ func convertResponseToMap(resp []map[string]bool) map[string]bool {
 
 	return convertedMap
 }
+
+func TestEventWindowedCountHandler(t *testing.T) {
+	tests := []struct {
+		name           string
+		vars           map[string]string
+		dbMock         func() *mocks.DBClient
+		expectedCounts []int64
+		expectedStatus int
+	}{
+		{
+			"OK",
+			map[string]string{DEVICE: "thermostat", START: "1000", END: "3500", WINDOW: "1000"},
+			func() *mocks.DBClient {
+				dbMock := &mocks.DBClient{}
+				dbMock.On("EventCountsByDevice", "thermostat", int64(1000), int64(3500), int64(1000)).Return([]int64{4, 0, 2}, nil)
+				return dbMock
+			},
+			[]int64{4, 0, 2},
+			http.StatusOK,
+		},
+		{
+			"Invalid window",
+			map[string]string{DEVICE: "thermostat", START: "1000", END: "3500", WINDOW: "0"},
+			func() *mocks.DBClient { return &mocks.DBClient{} },
+			nil,
+			http.StatusBadRequest,
+		},
+		{
+			"End before start",
+			map[string]string{DEVICE: "thermostat", START: "3500", END: "1000", WINDOW: "1000"},
+			func() *mocks.DBClient { return &mocks.DBClient{} },
+			nil,
+			http.StatusBadRequest,
+		},
+		{
+			"Error Limit Exceeded",
+			map[string]string{DEVICE: "thermostat", START: "0", END: "1000000", WINDOW: "1000"},
+			func() *mocks.DBClient { return &mocks.DBClient{} },
+			nil,
+			http.StatusRequestEntityTooLarge,
+		},
+		{
+			"Database error",
+			map[string]string{DEVICE: "thermostat", START: "1000", END: "3500", WINDOW: "1000"},
+			func() *mocks.DBClient {
+				dbMock := &mocks.DBClient{}
+				dbMock.On("EventCountsByDevice", "thermostat", int64(1000), int64(3500), int64(1000)).Return(nil, TestError)
+				return dbMock
+			},
+			nil,
+			http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configuration := &config.ConfigurationStruct{Service: TestSuccessfulConfig}
+			dbMock := tt.dbMock()
+			rr := httptest.NewRecorder()
+			lc := logger.NewMockClient()
+			req := mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/event/count/device", nil), tt.vars)
+
+			eventWindowedCountHandler(rr, req, lc, dbMock, errorconcept.NewErrorHandler(lc), configuration)
+
+			require.Equal(t, tt.expectedStatus, rr.Code, rr.Body.String())
+			dbMock.AssertExpectations(t)
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+			var count dataModels.WindowedCount
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &count))
+			assert.Equal(t, "thermostat", count.Device)
+			assert.Equal(t, int64(1000), count.Window)
+			assert.Equal(t, tt.expectedCounts, count.Counts)
+		})
+	}
+}
+
+func TestReadingWindowedCountHandler(t *testing.T) {
+	const hour = int64(60 * 60 * 1000)
+	day := strconv.FormatInt(24*hour, 10)
+	tests := []struct {
+		name           string
+		vars           map[string]string
+		dbMock         func() *mocks.DBClient
+		expectedCounts []int64
+		expectedStatus int
+	}{
+		{
+			"OK from hourly rollups",
+			map[string]string{DEVICE: "thermostat", NAME: "Temperature", START: "0", END: strconv.FormatInt(5*hour, 10), WINDOW: strconv.FormatInt(2*hour, 10)},
+			func() *mocks.DBClient {
+				dbMock := &mocks.DBClient{}
+				dbMock.On("RollupsByDeviceAndName", dataModels.RollupHourly, "thermostat", "Temperature", int64(0), 5*hour-1, 5).
+					Return([]dataModels.Rollup{{Start: 0, Count: 2}, {Start: hour, Count: 3}, {Start: 4 * hour, Count: 1}}, nil)
+				return dbMock
+			},
+			[]int64{5, 0, 1},
+			http.StatusOK,
+		},
+		{
+			"OK from daily rollups",
+			map[string]string{DEVICE: "thermostat", NAME: "Temperature", START: "0", END: strconv.FormatInt(2*24*hour, 10), WINDOW: day},
+			func() *mocks.DBClient {
+				dbMock := &mocks.DBClient{}
+				dbMock.On("RollupsByDeviceAndName", dataModels.RollupDaily, "thermostat", "Temperature", int64(0), 2*24*hour-1, 2).
+					Return([]dataModels.Rollup{{Start: 24 * hour, Count: 7}}, nil)
+				return dbMock
+			},
+			[]int64{0, 7},
+			http.StatusOK,
+		},
+		{
+			"Window not a whole hour",
+			map[string]string{DEVICE: "thermostat", NAME: "Temperature", START: "0", END: strconv.FormatInt(hour, 10), WINDOW: "900000"},
+			func() *mocks.DBClient { return &mocks.DBClient{} },
+			nil,
+			http.StatusBadRequest,
+		},
+		{
+			"Error Limit Exceeded by the rollups",
+			map[string]string{DEVICE: "thermostat", NAME: "Temperature", START: "0", END: strconv.FormatInt(10*hour, 10), WINDOW: strconv.FormatInt(5*hour, 10)},
+			func() *mocks.DBClient { return &mocks.DBClient{} },
+			nil,
+			http.StatusRequestEntityTooLarge,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configuration := &config.ConfigurationStruct{Service: TestSuccessfulConfig}
+			dbMock := tt.dbMock()
+			rr := httptest.NewRecorder()
+			lc := logger.NewMockClient()
+			req := mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/reading/count/device", nil), tt.vars)
+
+			readingWindowedCountHandler(rr, req, lc, dbMock, errorconcept.NewErrorHandler(lc), configuration)
+
+			require.Equal(t, tt.expectedStatus, rr.Code, rr.Body.String())
+			dbMock.AssertExpectations(t)
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+			var count dataModels.WindowedCount
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &count))
+			assert.Equal(t, "Temperature", count.Name)
+			assert.Equal(t, tt.expectedCounts, count.Counts)
+		})
+	}
+}
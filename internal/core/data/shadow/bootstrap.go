@@ -0,0 +1,108 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shadow
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	pkgContainer "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers/database"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+
+	"github.com/gorilla/mux"
+)
+
+// ApiShadowRoute returns the divergences between the primary and the candidate databases
+const ApiShadowRoute = clients.ApiBase + "/shadow"
+
+// Bootstrap contains references to dependencies required by the BootstrapHandler.
+type Bootstrap struct {
+	router   *mux.Router
+	database interfaces.Database
+	info     *ShadowInfo
+}
+
+// NewBootstrap is a factory method that returns an initialized Bootstrap receiver struct.
+func NewBootstrap(router *mux.Router, database interfaces.Database, info *ShadowInfo) *Bootstrap {
+	return &Bootstrap{
+		router:   router,
+		database: database,
+		info:     info,
+	}
+}
+
+// BootstrapHandler fulfills the BootstrapHandler contract. When the shadow mode is enabled, it connects to the
+// candidate database and replaces the database client in the DIC, for the writes to be mirrored to the candidate
+// database. It must run after the primary database is connected, and before the database client is used.
+func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, startupTimer startup.Timer, dic *di.Container) bool {
+	if !b.info.Enabled {
+		return true
+	}
+
+	lc := container.LoggingClientFrom(dic.Get)
+	databases := b.database.GetDatabaseInfo()
+	candidateInfo, ok := databases[b.info.Database]
+	if !ok || b.info.Database == "Primary" {
+		lc.Error(fmt.Sprintf("no candidate database '%s' in [Databases] for the Shadow mode", b.info.Database))
+		return false
+	}
+	if sameDatabase(candidateInfo, databases["Primary"]) {
+		lc.Error(fmt.Sprintf("the Shadow database '%s' must be another database than the primary one", b.info.Database))
+		return false
+	}
+	// the readings of the events are de-referenced with the Mongo client of the process
+	if candidateInfo.Type == db.MongoDB && databases["Primary"].Type == db.MongoDB {
+		lc.Error(fmt.Sprintf("the Shadow database '%s' can't be a Mongo database along with the primary one", b.info.Database))
+		return false
+	}
+
+	candidate, ok := database.NewDatabaseForCoreData(nil, b.database).ConnectDedicated(b.info.Database, startupTimer, dic)
+	if !ok {
+		return false
+	}
+	client, err := NewClient(pkgContainer.DBClientFrom(dic.Get), candidate, *b.info, lc)
+	if err != nil {
+		candidate.CloseSession()
+		lc.Error(err.Error())
+		return false
+	}
+	client.Start(ctx, wg)
+
+	dic.Update(di.ServiceConstructorMap{
+		pkgContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return client
+		},
+	})
+	b.router.HandleFunc(ApiShadowRoute, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+		pkg.Encode(client.Report().Summary(), w, lc)
+	}).Methods(http.MethodGet)
+
+	lc.Info(fmt.Sprintf("Mirroring the writes to the %s database '%s', comparing %.0f%% of the reads",
+		candidateInfo.Type, b.info.Database, b.info.ReadSampleRate*100))
+	return true
+}
+
+// sameDatabase reports whether the configurations a and b are of the same database, which is the case when they're
+// of the same server and, but for Redis which has a single database, of the same name.
+func sameDatabase(a bootstrapConfig.Database, b bootstrapConfig.Database) bool {
+	if a.Type != b.Type || a.Host != b.Host || a.Port != b.Port {
+		return false
+	}
+	return a.Type == db.RedisDB || a.Name == b.Name
+}
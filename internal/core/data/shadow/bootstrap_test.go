@@ -0,0 +1,42 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shadow
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSameDatabase(t *testing.T) {
+	primary := bootstrapConfig.Database{Type: db.RedisDB, Host: "localhost", Port: 6379, Name: "coredata"}
+
+	tests := []struct {
+		name      string
+		candidate bootstrapConfig.Database
+		expected  bool
+	}{
+		{"same", primary, true},
+		{"same Redis, other name", bootstrapConfig.Database{Type: db.RedisDB, Host: "localhost", Port: 6379, Name: "shadow"}, true},
+		{"other Redis host", bootstrapConfig.Database{Type: db.RedisDB, Host: "redis-shadow", Port: 6379, Name: "coredata"}, false},
+		{"other Redis port", bootstrapConfig.Database{Type: db.RedisDB, Host: "localhost", Port: 6380, Name: "coredata"}, false},
+		{"other type", bootstrapConfig.Database{Type: db.MongoDB, Host: "localhost", Port: 6379, Name: "coredata"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, sameDatabase(tt.candidate, primary))
+		})
+	}
+
+	mongo := bootstrapConfig.Database{Type: db.MongoDB, Host: "localhost", Port: 27017, Name: "coredata"}
+	assert.True(t, sameDatabase(mongo, mongo))
+	assert.False(t, sameDatabase(bootstrapConfig.Database{Type: db.MongoDB, Host: "localhost", Port: 27017, Name: "shadow"}, mongo),
+		"Mongo databases of other names should differ")
+}
@@ -0,0 +1,590 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package shadow validates a candidate database before migrating core-data to it: the writes to the primary database
+// are mirrored to the candidate one, and a sample of the reads is compared between both, their divergences being
+// reported.
+package shadow
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+
+	dataInterfaces "github.com/edgexfoundry/edgex-go/internal/core/data/interfaces"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+	correlation "github.com/edgexfoundry/edgex-go/internal/pkg/correlation/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	dbInterfaces "github.com/edgexfoundry/edgex-go/internal/pkg/db/interfaces"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// defaultQueueSize is the number of operations waiting to be run on the candidate database, when none is configured.
+const defaultQueueSize = 1024
+
+// Client answers from the primary database, which it embeds, and runs the core-data writes and a sample of the
+// core-data reads on the candidate database in the background, in the order they're answered, so that the candidate
+// database doesn't delay nor fail the requests. The other operations are only run on the primary database.
+//
+// The concurrent requests may be answered in another order than they're queued, in which case a read may be compared
+// before or after a write it raced with, and reported as diverging.
+type Client struct {
+	dbInterfaces.DBClient
+	candidate  dataInterfaces.DBClient
+	report     *Report
+	sampleRate float64
+	lc         logger.LoggingClient
+
+	operations chan func()
+	mutex      sync.Mutex
+	stopped    bool
+}
+
+// NewClient creates a Client answering from primary, validating the sample rate of the reads.
+func NewClient(
+	primary dbInterfaces.DBClient,
+	candidate dataInterfaces.DBClient,
+	info ShadowInfo,
+	lc logger.LoggingClient) (*Client, error) {
+
+	if info.ReadSampleRate < 0 || info.ReadSampleRate > 1 {
+		return nil, fmt.Errorf("invalid Shadow ReadSampleRate %v, expected from 0 to 1", info.ReadSampleRate)
+	}
+	queueSize := info.QueueSize
+	if queueSize <= 0 {
+		queueSize = defaultQueueSize
+	}
+	return &Client{
+		DBClient:   primary,
+		candidate:  candidate,
+		report:     NewReport(info.MaxDivergences),
+		sampleRate: info.ReadSampleRate,
+		lc:         lc,
+		operations: make(chan func(), queueSize),
+	}, nil
+}
+
+// Report returns the divergences between the databases.
+func (c *Client) Report() *Report {
+	return c.report
+}
+
+// Explain explains how the primary database answers query, the candidate database not being queried.
+func (c *Client) Explain(query func(client dbInterfaces.DBClient) (int, error)) (db.QueryExplanation, error) {
+	explainer, ok := c.DBClient.(interface {
+		Explain(query func(client dbInterfaces.DBClient) (int, error)) (db.QueryExplanation, error)
+	})
+	if !ok {
+		return db.QueryExplanation{}, fmt.Errorf("the primary database doesn't explain its queries")
+	}
+	return explainer.Explain(query)
+}
+
+// Start runs the queued operations on the candidate database until ctx is done, then runs the ones still waiting and
+// closes the candidate database, the later operations being skipped.
+func (c *Client) Start(ctx context.Context, wg *sync.WaitGroup) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case operation := <-c.operations:
+				operation()
+			case <-ctx.Done():
+				c.mutex.Lock()
+				c.stopped = true
+				close(c.operations)
+				c.mutex.Unlock()
+
+				for operation := range c.operations {
+					operation()
+				}
+				c.candidate.CloseSession()
+				return
+			}
+		}
+	}()
+}
+
+// enqueue queues the operation run on the candidate database. A write that can't be queued is reported as diverging,
+// the candidate database missing it from then on.
+func (c *Client) enqueue(operation string, kind string, run func()) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if !c.stopped {
+		select {
+		case c.operations <- run:
+			return
+		default:
+		}
+	}
+
+	if kind != WriteDivergence {
+		c.report.record(operation, SkippedDivergence, nil)
+		return
+	}
+	c.lc.Warn(fmt.Sprintf("%s skipped on the shadow database, too many operations waiting", operation))
+	c.report.record(operation, SkippedDivergence, &Divergence{
+		Timestamp: db.MakeTimestamp(),
+		Operation: operation,
+		Kind:      SkippedDivergence,
+		Primary:   "written",
+		Candidate: "skipped",
+	})
+}
+
+// check reports the divergence of the outcome of the candidate database, if any.
+func (c *Client) check(operation string, kind string, primary outcome, candidate outcome) {
+	if primary.agrees(candidate) {
+		c.report.record(operation, kind, nil)
+		return
+	}
+	divergence := Divergence{
+		Timestamp: db.MakeTimestamp(),
+		Operation: operation,
+		Kind:      kind,
+		Primary:   primary.describe(),
+		Candidate: candidate.describe(),
+	}
+	c.lc.Debug(fmt.Sprintf("%s %s diverged on the shadow database: %s, instead of %s",
+		kind, operation, divergence.Candidate, divergence.Primary))
+	c.report.record(operation, kind, &divergence)
+}
+
+// mirror runs the write on the candidate database, unless it failed on the primary database.
+func (c *Client) mirror(operation string, primary outcome, run func(candidate dataInterfaces.DBClient) outcome) {
+	if primary.err != nil {
+		return
+	}
+	c.enqueue(operation, WriteDivergence, func() {
+		c.check(operation, WriteDivergence, primary, run(c.candidate))
+	})
+}
+
+// compare runs the read on the candidate database when it's sampled, to compare its result with the primary one.
+func (c *Client) compare(operation string, value interface{}, err error, run func(candidate dataInterfaces.DBClient) outcome) {
+	if c.sampleRate == 0 || rand.Float64() >= c.sampleRate {
+		return
+	}
+	primary := newOutcome(value, err)
+	c.enqueue(operation, ReadDivergence, func() {
+		c.check(operation, ReadDivergence, primary, run(c.candidate))
+	})
+}
+
+// copyEvent copies the event for its readings not to be shared with the caller.
+func copyEvent(e correlation.Event) correlation.Event {
+	e.Readings = append([]contract.Reading(nil), e.Readings...)
+	if e.Tags != nil {
+		tags := make(map[string]string, len(e.Tags))
+		for name, value := range e.Tags {
+			tags[name] = value
+		}
+		e.Tags = tags
+	}
+	return e
+}
+
+// copyStrings copies the strings for them not to be shared with the caller.
+func copyStrings(values []string) []string {
+	return append([]string(nil), values...)
+}
+
+// withoutIDs copies the rollups without their IDs, which each database sets on its own.
+func withoutIDs(rollups []dataModels.Rollup) []dataModels.Rollup {
+	copied := make([]dataModels.Rollup, len(rollups))
+	for i, rollup := range rollups {
+		rollup.ID = ""
+		copied[i] = rollup
+	}
+	return copied
+}
+
+// ********************** EVENT FUNCTIONS *******************************
+
+func (c *Client) Events() ([]contract.Event, error) {
+	events, err := c.DBClient.Events()
+	c.compare("Events", events, err, func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(candidate.Events())
+	})
+	return events, err
+}
+
+func (c *Client) EventsWithLimit(limit int) ([]contract.Event, error) {
+	events, err := c.DBClient.EventsWithLimit(limit)
+	c.compare("EventsWithLimit", events, err, func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(candidate.EventsWithLimit(limit))
+	})
+	return events, err
+}
+
+// AddEvent adds the event to the candidate database with the ID set by the primary database, for the later operations
+// on the event to apply to both.
+func (c *Client) AddEvent(e correlation.Event) (string, error) {
+	id, err := c.DBClient.AddEvent(e)
+	e = copyEvent(e)
+	e.ID = id
+	c.mirror("AddEvent", newOutcome(id, err), func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(candidate.AddEvent(e))
+	})
+	return id, err
+}
+
+func (c *Client) UpdateEvent(e correlation.Event) error {
+	err := c.DBClient.UpdateEvent(e)
+	e = copyEvent(e)
+	c.mirror("UpdateEvent", newOutcome(nil, err), func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(nil, candidate.UpdateEvent(e))
+	})
+	return err
+}
+
+func (c *Client) EventById(id string) (contract.Event, error) {
+	event, err := c.DBClient.EventById(id)
+	c.compare("EventById", event, err, func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(candidate.EventById(id))
+	})
+	return event, err
+}
+
+func (c *Client) EventsByChecksum(checksum string) ([]contract.Event, error) {
+	events, err := c.DBClient.EventsByChecksum(checksum)
+	c.compare("EventsByChecksum", events, err, func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(candidate.EventsByChecksum(checksum))
+	})
+	return events, err
+}
+
+func (c *Client) EventCount() (int, error) {
+	count, err := c.DBClient.EventCount()
+	c.compare("EventCount", count, err, func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(candidate.EventCount())
+	})
+	return count, err
+}
+
+func (c *Client) EventCountByDeviceId(id string) (int, error) {
+	count, err := c.DBClient.EventCountByDeviceId(id)
+	c.compare("EventCountByDeviceId", count, err, func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(candidate.EventCountByDeviceId(id))
+	})
+	return count, err
+}
+
+func (c *Client) EventCountsByDevice(device string, start int64, end int64, window int64) ([]int64, error) {
+	counts, err := c.DBClient.EventCountsByDevice(device, start, end, window)
+	c.compare("EventCountsByDevice", counts, err, func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(candidate.EventCountsByDevice(device, start, end, window))
+	})
+	return counts, err
+}
+
+func (c *Client) DeleteEventById(id string) error {
+	err := c.DBClient.DeleteEventById(id)
+	c.mirror("DeleteEventById", newOutcome(nil, err), func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(nil, candidate.DeleteEventById(id))
+	})
+	return err
+}
+
+func (c *Client) DeleteEventsByDevice(deviceId string) (int, error) {
+	count, err := c.DBClient.DeleteEventsByDevice(deviceId)
+	c.mirror("DeleteEventsByDevice", newOutcome(count, err), func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(candidate.DeleteEventsByDevice(deviceId))
+	})
+	return count, err
+}
+
+func (c *Client) RenameDeviceData(from string, to string) (int, error) {
+	count, err := c.DBClient.RenameDeviceData(from, to)
+	c.mirror("RenameDeviceData", newOutcome(count, err), func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(candidate.RenameDeviceData(from, to))
+	})
+	return count, err
+}
+
+func (c *Client) EventsForDeviceLimit(id string, limit int) ([]contract.Event, error) {
+	events, err := c.DBClient.EventsForDeviceLimit(id, limit)
+	c.compare("EventsForDeviceLimit", events, err, func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(candidate.EventsForDeviceLimit(id, limit))
+	})
+	return events, err
+}
+
+func (c *Client) EventsForDevice(id string) ([]contract.Event, error) {
+	events, err := c.DBClient.EventsForDevice(id)
+	c.compare("EventsForDevice", events, err, func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(candidate.EventsForDevice(id))
+	})
+	return events, err
+}
+
+func (c *Client) EventsByCreationTime(startTime, endTime int64, limit int) ([]contract.Event, error) {
+	events, err := c.DBClient.EventsByCreationTime(startTime, endTime, limit)
+	c.compare("EventsByCreationTime", events, err, func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(candidate.EventsByCreationTime(startTime, endTime, limit))
+	})
+	return events, err
+}
+
+func (c *Client) ReadingsByDeviceAndValueDescriptor(deviceId, valueDescriptor string, limit int) ([]contract.Reading, error) {
+	readings, err := c.DBClient.ReadingsByDeviceAndValueDescriptor(deviceId, valueDescriptor, limit)
+	c.compare("ReadingsByDeviceAndValueDescriptor", readings, err, func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(candidate.ReadingsByDeviceAndValueDescriptor(deviceId, valueDescriptor, limit))
+	})
+	return readings, err
+}
+
+func (c *Client) EventsOlderThanAge(age int64) ([]contract.Event, error) {
+	events, err := c.DBClient.EventsOlderThanAge(age)
+	c.compare("EventsOlderThanAge", events, err, func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(candidate.EventsOlderThanAge(age))
+	})
+	return events, err
+}
+
+func (c *Client) EventsPushed() ([]contract.Event, error) {
+	events, err := c.DBClient.EventsPushed()
+	c.compare("EventsPushed", events, err, func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(candidate.EventsPushed())
+	})
+	return events, err
+}
+
+func (c *Client) ScrubAllEvents() error {
+	err := c.DBClient.ScrubAllEvents()
+	c.mirror("ScrubAllEvents", newOutcome(nil, err), func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(nil, candidate.ScrubAllEvents())
+	})
+	return err
+}
+
+// ********************* READING FUNCTIONS *************************
+
+func (c *Client) Readings() ([]contract.Reading, error) {
+	readings, err := c.DBClient.Readings()
+	c.compare("Readings", readings, err, func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(candidate.Readings())
+	})
+	return readings, err
+}
+
+// AddReading adds the reading to the candidate database with the ID set by the primary database.
+func (c *Client) AddReading(r contract.Reading) (string, error) {
+	id, err := c.DBClient.AddReading(r)
+	r.Id = id
+	c.mirror("AddReading", newOutcome(id, err), func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(candidate.AddReading(r))
+	})
+	return id, err
+}
+
+func (c *Client) UpdateReading(r contract.Reading) error {
+	err := c.DBClient.UpdateReading(r)
+	c.mirror("UpdateReading", newOutcome(nil, err), func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(nil, candidate.UpdateReading(r))
+	})
+	return err
+}
+
+func (c *Client) ReadingById(id string) (contract.Reading, error) {
+	reading, err := c.DBClient.ReadingById(id)
+	c.compare("ReadingById", reading, err, func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(candidate.ReadingById(id))
+	})
+	return reading, err
+}
+
+func (c *Client) ReadingCount() (int, error) {
+	count, err := c.DBClient.ReadingCount()
+	c.compare("ReadingCount", count, err, func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(candidate.ReadingCount())
+	})
+	return count, err
+}
+
+func (c *Client) DeleteReadingById(id string) error {
+	err := c.DBClient.DeleteReadingById(id)
+	c.mirror("DeleteReadingById", newOutcome(nil, err), func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(nil, candidate.DeleteReadingById(id))
+	})
+	return err
+}
+
+func (c *Client) DeleteReadingsByDevice(device string) error {
+	err := c.DBClient.DeleteReadingsByDevice(device)
+	c.mirror("DeleteReadingsByDevice", newOutcome(nil, err), func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(nil, candidate.DeleteReadingsByDevice(device))
+	})
+	return err
+}
+
+func (c *Client) ReadingsByDevice(id string, limit int) ([]contract.Reading, error) {
+	readings, err := c.DBClient.ReadingsByDevice(id, limit)
+	c.compare("ReadingsByDevice", readings, err, func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(candidate.ReadingsByDevice(id, limit))
+	})
+	return readings, err
+}
+
+func (c *Client) ReadingsByValueDescriptor(name string, limit int) ([]contract.Reading, error) {
+	readings, err := c.DBClient.ReadingsByValueDescriptor(name, limit)
+	c.compare("ReadingsByValueDescriptor", readings, err, func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(candidate.ReadingsByValueDescriptor(name, limit))
+	})
+	return readings, err
+}
+
+func (c *Client) ReadingsByValueDescriptorNames(names []string, limit int) ([]contract.Reading, error) {
+	readings, err := c.DBClient.ReadingsByValueDescriptorNames(names, limit)
+	names = copyStrings(names)
+	c.compare("ReadingsByValueDescriptorNames", readings, err, func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(candidate.ReadingsByValueDescriptorNames(names, limit))
+	})
+	return readings, err
+}
+
+func (c *Client) ReadingsByCreationTime(start, end int64, limit int) ([]contract.Reading, error) {
+	readings, err := c.DBClient.ReadingsByCreationTime(start, end, limit)
+	c.compare("ReadingsByCreationTime", readings, err, func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(candidate.ReadingsByCreationTime(start, end, limit))
+	})
+	return readings, err
+}
+
+// ************************** VALUE DESCRIPTOR FUNCTIONS ***************************
+
+// AddValueDescriptor adds the value descriptor to the candidate database with the ID set by the primary database.
+func (c *Client) AddValueDescriptor(v contract.ValueDescriptor) (string, error) {
+	id, err := c.DBClient.AddValueDescriptor(v)
+	v.Id = id
+	v.Labels = copyStrings(v.Labels)
+	c.mirror("AddValueDescriptor", newOutcome(id, err), func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(candidate.AddValueDescriptor(v))
+	})
+	return id, err
+}
+
+func (c *Client) ValueDescriptors() ([]contract.ValueDescriptor, error) {
+	descriptors, err := c.DBClient.ValueDescriptors()
+	c.compare("ValueDescriptors", descriptors, err, func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(candidate.ValueDescriptors())
+	})
+	return descriptors, err
+}
+
+func (c *Client) UpdateValueDescriptor(v contract.ValueDescriptor) error {
+	err := c.DBClient.UpdateValueDescriptor(v)
+	v.Labels = copyStrings(v.Labels)
+	c.mirror("UpdateValueDescriptor", newOutcome(nil, err), func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(nil, candidate.UpdateValueDescriptor(v))
+	})
+	return err
+}
+
+func (c *Client) DeleteValueDescriptorById(id string) error {
+	err := c.DBClient.DeleteValueDescriptorById(id)
+	c.mirror("DeleteValueDescriptorById", newOutcome(nil, err), func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(nil, candidate.DeleteValueDescriptorById(id))
+	})
+	return err
+}
+
+func (c *Client) ValueDescriptorByName(name string) (contract.ValueDescriptor, error) {
+	descriptor, err := c.DBClient.ValueDescriptorByName(name)
+	c.compare("ValueDescriptorByName", descriptor, err, func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(candidate.ValueDescriptorByName(name))
+	})
+	return descriptor, err
+}
+
+func (c *Client) ValueDescriptorsByName(names []string) ([]contract.ValueDescriptor, error) {
+	descriptors, err := c.DBClient.ValueDescriptorsByName(names)
+	names = copyStrings(names)
+	c.compare("ValueDescriptorsByName", descriptors, err, func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(candidate.ValueDescriptorsByName(names))
+	})
+	return descriptors, err
+}
+
+func (c *Client) ValueDescriptorById(id string) (contract.ValueDescriptor, error) {
+	descriptor, err := c.DBClient.ValueDescriptorById(id)
+	c.compare("ValueDescriptorById", descriptor, err, func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(candidate.ValueDescriptorById(id))
+	})
+	return descriptor, err
+}
+
+func (c *Client) ValueDescriptorsByUomLabel(uomLabel string) ([]contract.ValueDescriptor, error) {
+	descriptors, err := c.DBClient.ValueDescriptorsByUomLabel(uomLabel)
+	c.compare("ValueDescriptorsByUomLabel", descriptors, err, func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(candidate.ValueDescriptorsByUomLabel(uomLabel))
+	})
+	return descriptors, err
+}
+
+func (c *Client) ValueDescriptorsByLabel(label string) ([]contract.ValueDescriptor, error) {
+	descriptors, err := c.DBClient.ValueDescriptorsByLabel(label)
+	c.compare("ValueDescriptorsByLabel", descriptors, err, func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(candidate.ValueDescriptorsByLabel(label))
+	})
+	return descriptors, err
+}
+
+func (c *Client) ValueDescriptorsByType(t string) ([]contract.ValueDescriptor, error) {
+	descriptors, err := c.DBClient.ValueDescriptorsByType(t)
+	c.compare("ValueDescriptorsByType", descriptors, err, func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(candidate.ValueDescriptorsByType(t))
+	})
+	return descriptors, err
+}
+
+func (c *Client) ScrubAllValueDescriptors() error {
+	err := c.DBClient.ScrubAllValueDescriptors()
+	c.mirror("ScrubAllValueDescriptors", newOutcome(nil, err), func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(nil, candidate.ScrubAllValueDescriptors())
+	})
+	return err
+}
+
+// ********************** ROLLUP FUNCTIONS *******************************
+
+func (c *Client) MergeRollup(r dataModels.Rollup) error {
+	err := c.DBClient.MergeRollup(r)
+	c.mirror("MergeRollup", newOutcome(nil, err), func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(nil, candidate.MergeRollup(r))
+	})
+	return err
+}
+
+// RollupsByDevice compares the rollups regardless of their IDs, as for RollupsByDeviceAndName.
+func (c *Client) RollupsByDevice(period string, device string, start int64, end int64, limit int) ([]dataModels.Rollup, error) {
+	rollups, err := c.DBClient.RollupsByDevice(period, device, start, end, limit)
+	c.compare("RollupsByDevice", withoutIDs(rollups), err, func(candidate dataInterfaces.DBClient) outcome {
+		rollups, err := candidate.RollupsByDevice(period, device, start, end, limit)
+		return newOutcome(withoutIDs(rollups), err)
+	})
+	return rollups, err
+}
+
+func (c *Client) RollupsByDeviceAndName(period string, device string, name string, start int64, end int64, limit int) ([]dataModels.Rollup, error) {
+	rollups, err := c.DBClient.RollupsByDeviceAndName(period, device, name, start, end, limit)
+	c.compare("RollupsByDeviceAndName", withoutIDs(rollups), err, func(candidate dataInterfaces.DBClient) outcome {
+		rollups, err := candidate.RollupsByDeviceAndName(period, device, name, start, end, limit)
+		return newOutcome(withoutIDs(rollups), err)
+	})
+	return rollups, err
+}
+
+func (c *Client) DeleteRollupsBefore(period string, start int64) error {
+	err := c.DBClient.DeleteRollupsBefore(period, start)
+	c.mirror("DeleteRollupsBefore", newOutcome(nil, err), func(candidate dataInterfaces.DBClient) outcome {
+		return newOutcome(nil, candidate.DeleteRollupsBefore(period, start))
+	})
+	return err
+}
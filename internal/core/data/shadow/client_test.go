@@ -0,0 +1,172 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shadow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	correlation "github.com/edgexfoundry/edgex-go/internal/pkg/correlation/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	dbInterfaces "github.com/edgexfoundry/edgex-go/internal/pkg/db/interfaces"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryDB stores the events in memory, the other operations being unimplemented.
+type memoryDB struct {
+	dbInterfaces.DBClient
+	events     map[string]contract.Event
+	failDevice string
+	closed     bool
+}
+
+func newMemoryDB() *memoryDB {
+	return &memoryDB{events: map[string]contract.Event{}}
+}
+
+func (m *memoryDB) AddEvent(e correlation.Event) (string, error) {
+	if e.Device == m.failDevice {
+		return "", errors.New("database unavailable")
+	}
+	if e.ID == "" {
+		e.ID = fmt.Sprintf("event-%d", len(m.events)+1)
+	}
+	e.Created = db.MakeTimestamp()
+	m.events[e.ID] = e.Event
+	return e.ID, nil
+}
+
+func (m *memoryDB) EventById(id string) (contract.Event, error) {
+	e, ok := m.events[id]
+	if !ok {
+		return contract.Event{}, db.ErrNotFound
+	}
+	return e, nil
+}
+
+func (m *memoryDB) DeleteEventById(id string) error {
+	if _, ok := m.events[id]; !ok {
+		return db.ErrNotFound
+	}
+	delete(m.events, id)
+	return nil
+}
+
+func (m *memoryDB) CloseSession() {
+	m.closed = true
+}
+
+// run runs the operations on the client, and returns once they've run on the candidate database.
+func run(client *Client, operations func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	client.Start(ctx, wg)
+	operations()
+	cancel()
+	wg.Wait()
+}
+
+func TestNewClientValidation(t *testing.T) {
+	_, err := NewClient(newMemoryDB(), newMemoryDB(), ShadowInfo{ReadSampleRate: 1.5}, logger.NewMockClient())
+	assert.Error(t, err)
+}
+
+func TestMirroredWrites(t *testing.T) {
+	primary, candidate := newMemoryDB(), newMemoryDB()
+	client, err := NewClient(primary, candidate, ShadowInfo{ReadSampleRate: 1, MaxDivergences: 10}, logger.NewMockClient())
+	require.NoError(t, err)
+
+	event := correlation.Event{Event: contract.Event{Device: "Thermostat", Readings: []contract.Reading{{Name: "Temperature", Value: "21"}}}}
+	run(client, func() {
+		id, err := client.AddEvent(event)
+		require.NoError(t, err)
+		_, err = client.EventById(id)
+		require.NoError(t, err)
+		require.NoError(t, client.DeleteEventById(id))
+		assert.Equal(t, db.ErrNotFound, client.DeleteEventById(id), "the primary failure should not be mirrored")
+		_, err = client.EventById(id)
+		assert.Equal(t, db.ErrNotFound, err)
+	})
+
+	summary := client.Report().Summary()
+	assert.Equal(t, 2, summary.Writes)
+	assert.Equal(t, 2, summary.Reads)
+	assert.Equal(t, 0, summary.Divergences, "the timestamps set by each database should not be compared")
+	assert.Empty(t, candidate.events)
+	assert.True(t, candidate.closed)
+	assert.False(t, primary.closed, "the primary database should be closed by its own bootstrap handler")
+}
+
+func TestDivergences(t *testing.T) {
+	primary, candidate := newMemoryDB(), newMemoryDB()
+	candidate.failDevice = "Humidistat"
+	client, err := NewClient(primary, candidate, ShadowInfo{ReadSampleRate: 1, MaxDivergences: 2}, logger.NewMockClient())
+	require.NoError(t, err)
+
+	run(client, func() {
+		first, err := client.AddEvent(correlation.Event{Event: contract.Event{Device: "Thermostat"}})
+		require.NoError(t, err)
+		second, err := client.AddEvent(correlation.Event{Event: contract.Event{Device: "Humidistat"}})
+		require.NoError(t, err, "the candidate failure should not fail the write")
+		_, _ = client.EventById(first)
+		_, _ = client.EventById(second)
+	})
+
+	summary := client.Report().Summary()
+	assert.Equal(t, 2, summary.Divergences)
+	assert.Equal(t, OperationReport{Writes: 2, Divergences: 1}, summary.Operations["AddEvent"])
+	assert.Equal(t, OperationReport{Reads: 2, Divergences: 1}, summary.Operations["EventById"])
+	require.Len(t, summary.Recent, 2)
+	assert.Equal(t, WriteDivergence, summary.Recent[0].Kind)
+	assert.Equal(t, "error: database unavailable", summary.Recent[0].Candidate)
+	assert.Equal(t, ReadDivergence, summary.Recent[1].Kind)
+	assert.Equal(t, "error: "+db.ErrNotFound.Error(), summary.Recent[1].Candidate)
+}
+
+func TestSkippedWrites(t *testing.T) {
+	client, err := NewClient(newMemoryDB(), newMemoryDB(), ShadowInfo{QueueSize: 1, MaxDivergences: 10}, logger.NewMockClient())
+	require.NoError(t, err)
+
+	// the operations are queued without being run, the client not being started
+	_, _ = client.AddEvent(correlation.Event{Event: contract.Event{Device: "Thermostat"}})
+	_, _ = client.AddEvent(correlation.Event{Event: contract.Event{Device: "Thermostat"}})
+	_, _ = client.EventById("event-1")
+
+	summary := client.Report().Summary()
+	assert.Equal(t, 1, summary.Skipped)
+	assert.Equal(t, 0, summary.Reads, "the reads should not be compared when not sampled")
+	require.Len(t, summary.Recent, 1)
+	assert.Equal(t, SkippedDivergence, summary.Recent[0].Kind)
+}
+
+func TestOutcomeAgrees(t *testing.T) {
+	tests := []struct {
+		name      string
+		primary   outcome
+		candidate outcome
+		agrees    bool
+	}{
+		{"same results", newOutcome(contract.Event{ID: "1", Created: 1}, nil), newOutcome(contract.Event{ID: "1", Created: 2}, nil), true},
+		{"different results", newOutcome(contract.Event{ID: "1"}, nil), newOutcome(contract.Event{ID: "2"}, nil), false},
+		{"no results", newOutcome([]contract.Event(nil), nil), newOutcome([]contract.Event{}, nil), true},
+		{"both failing", newOutcome(nil, errors.New("timeout")), newOutcome(nil, errors.New("connection refused")), true},
+		{"not found", newOutcome(nil, db.ErrNotFound), newOutcome(nil, errors.New("timeout")), false},
+		{"candidate failing", newOutcome(1, nil), newOutcome(nil, errors.New("timeout")), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.agrees, tt.primary.agrees(tt.candidate))
+		})
+	}
+}
@@ -0,0 +1,22 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shadow
+
+// ShadowInfo provides properties related to validating a candidate database against the primary one, before migrating
+// to it
+type ShadowInfo struct {
+	// Enabled indicates whether the writes to the primary database are mirrored to the candidate one
+	Enabled bool
+	// Database is the name of the candidate database in [Databases], of another type than the primary database
+	Database string
+	// ReadSampleRate is the fraction of the reads, from 0 to 1, that are also read from the candidate database to
+	// compare their results with those of the primary database
+	ReadSampleRate float64
+	// QueueSize is the number of operations waiting to be run on the candidate database, beyond which they're skipped
+	QueueSize int
+	// MaxDivergences is the number of the most recent divergences kept in the report
+	MaxDivergences int
+}
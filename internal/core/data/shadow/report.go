@@ -0,0 +1,195 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shadow
+
+import (
+	"encoding/json"
+	"reflect"
+	"sync"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+)
+
+const (
+	// WriteDivergence is a write whose outcome on the candidate database differs from the primary database
+	WriteDivergence = "write"
+	// ReadDivergence is a sampled read whose result from the candidate database differs from the primary database
+	ReadDivergence = "read"
+	// SkippedDivergence is an operation not run on the candidate database as too many were waiting
+	SkippedDivergence = "skipped"
+)
+
+// maxDescriptionLength is the length beyond which the results described in a divergence are truncated
+const maxDescriptionLength = 512
+
+// timestampFields are set by each database on its own, and not compared
+var timestampFields = []string{"created", "modified"}
+
+// Divergence is an operation whose outcome differs between the primary and the candidate databases.
+type Divergence struct {
+	Timestamp int64  `json:"timestamp"`
+	Operation string `json:"operation"`
+	Kind      string `json:"kind"`
+	Primary   string `json:"primary"`
+	Candidate string `json:"candidate"`
+}
+
+// OperationReport counts the operations of a kind run on the candidate database, and those diverging.
+type OperationReport struct {
+	Writes      int `json:"writes"`
+	Reads       int `json:"reads"`
+	Divergences int `json:"divergences"`
+	Skipped     int `json:"skipped"`
+}
+
+// Summary is the divergence report since the shadow mode started.
+type Summary struct {
+	Started     int64                      `json:"started"`
+	Writes      int                        `json:"writes"`
+	Reads       int                        `json:"reads"`
+	Divergences int                        `json:"divergences"`
+	Skipped     int                        `json:"skipped"`
+	Operations  map[string]OperationReport `json:"operations"`
+	// Recent are the most recent divergences, the oldest first
+	Recent []Divergence `json:"recent"`
+}
+
+// Report tracks the operations run on the candidate database and their divergences.
+type Report struct {
+	mutex          sync.Mutex
+	summary        Summary
+	maxDivergences int
+}
+
+// NewReport creates a Report keeping up to maxDivergences of the most recent divergences.
+func NewReport(maxDivergences int) *Report {
+	return &Report{
+		summary: Summary{
+			Started:    db.MakeTimestamp(),
+			Operations: map[string]OperationReport{},
+			Recent:     []Divergence{},
+		},
+		maxDivergences: maxDivergences,
+	}
+}
+
+// Summary returns a copy of the report.
+func (r *Report) Summary() Summary {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	summary := r.summary
+	summary.Operations = make(map[string]OperationReport, len(r.summary.Operations))
+	for operation, report := range r.summary.Operations {
+		summary.Operations[operation] = report
+	}
+	summary.Recent = append([]Divergence{}, r.summary.Recent...)
+	return summary
+}
+
+// record counts the operation of kind, and its divergence if any.
+func (r *Report) record(operation string, kind string, divergence *Divergence) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	report := r.summary.Operations[operation]
+	switch kind {
+	case WriteDivergence:
+		report.Writes++
+		r.summary.Writes++
+	case ReadDivergence:
+		report.Reads++
+		r.summary.Reads++
+	case SkippedDivergence:
+		report.Skipped++
+		r.summary.Skipped++
+	}
+	if divergence != nil {
+		report.Divergences++
+		r.summary.Divergences++
+		if r.maxDivergences > 0 {
+			if len(r.summary.Recent) == r.maxDivergences {
+				r.summary.Recent = r.summary.Recent[1:]
+			}
+			r.summary.Recent = append(r.summary.Recent, *divergence)
+		}
+	}
+	r.summary.Operations[operation] = report
+}
+
+// outcome is the result of an operation run on a database, normalized for the result not to be shared with the
+// caller of the operation.
+type outcome struct {
+	value interface{}
+	err   error
+}
+
+// newOutcome returns the outcome of an operation, its result normalized.
+func newOutcome(value interface{}, err error) outcome {
+	if err != nil {
+		return outcome{err: err}
+	}
+	return outcome{value: normalize(value)}
+}
+
+// agrees tells whether the outcomes of the primary and the candidate databases are the same, the results being
+// compared regardless of their timestamps and the errors regardless of their messages, unless one of them is a
+// db.ErrNotFound.
+func (o outcome) agrees(other outcome) bool {
+	if o.err != nil || other.err != nil {
+		return o.err != nil && other.err != nil && (o.err == db.ErrNotFound) == (other.err == db.ErrNotFound)
+	}
+	return reflect.DeepEqual(o.value, other.value)
+}
+
+// describe returns the error, or the JSON encoded result, truncated.
+func (o outcome) describe() string {
+	description := "error: "
+	if o.err != nil {
+		description += o.err.Error()
+	} else if encoded, err := json.Marshal(o.value); err != nil {
+		description += err.Error()
+	} else {
+		description = string(encoded)
+	}
+	if len(description) > maxDescriptionLength {
+		description = description[:maxDescriptionLength] + "..."
+	}
+	return description
+}
+
+// normalize returns the JSON representation of value, without its timestamps, an empty list being the same as none.
+func normalize(value interface{}) interface{} {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return value
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return value
+	}
+	return withoutTimestamps(decoded)
+}
+
+func withoutTimestamps(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for _, field := range timestampFields {
+			delete(v, field)
+		}
+		for key, field := range v {
+			v[key] = withoutTimestamps(field)
+		}
+	case []interface{}:
+		if len(v) == 0 {
+			return nil
+		}
+		for i, item := range v {
+			v[i] = withoutTimestamps(item)
+		}
+	}
+	return value
+}
@@ -0,0 +1,223 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package units converts the values of the readings returned by queries to the units requested by the client, using
+// the conversion table configured for the units of measure of the value descriptors, i.e. the units of the device
+// profiles the readings were produced from.
+package units
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// ConversionInfo converts values from one unit to another: a value in To is the value in From multiplied by Scale
+// plus Offset. The conversion from To back to From is derived from it.
+type ConversionInfo struct {
+	From   string
+	To     string
+	Scale  float64
+	Offset float64
+}
+
+// UnitsInfo is the conversion table of the units of measure.
+type UnitsInfo struct {
+	// Systems lists the units of each system of units, e.g. metric or imperial, by system name
+	Systems map[string][]string
+	// Conversions are the conversions between units, chained when there is no direct one
+	Conversions []ConversionInfo
+}
+
+// linear is the conversion of a value v to v*scale + offset.
+type linear struct {
+	scale  float64
+	offset float64
+}
+
+func (l linear) apply(v float64) float64 {
+	return v*l.scale + l.offset
+}
+
+// then returns the conversion applying l, then next.
+func (l linear) then(next linear) linear {
+	return linear{scale: l.scale * next.scale, offset: l.offset*next.scale + next.offset}
+}
+
+func (l linear) inverse() linear {
+	return linear{scale: 1 / l.scale, offset: -l.offset / l.scale}
+}
+
+// Converter converts the values of readings to a system of units or to an explicit unit.
+type Converter struct {
+	systems     map[string][]string
+	conversions map[string]map[string]linear
+}
+
+// NewConverter creates a Converter from the conversion table, which is rejected when a conversion is degenerate.
+func NewConverter(info UnitsInfo) (*Converter, error) {
+	direct := make(map[string]map[string]linear)
+	add := func(from, to string, l linear) {
+		if direct[from] == nil {
+			direct[from] = make(map[string]linear)
+		}
+		direct[from][to] = l
+	}
+	for _, c := range info.Conversions {
+		if c.From == "" || c.To == "" || c.From == c.To {
+			return nil, fmt.Errorf("conversion from '%s' to '%s' requires two distinct units", c.From, c.To)
+		}
+		if c.Scale == 0 || math.IsNaN(c.Scale) || math.IsInf(c.Scale, 0) {
+			return nil, fmt.Errorf("conversion from '%s' to '%s' has invalid scale %v", c.From, c.To, c.Scale)
+		}
+		l := linear{scale: c.Scale, offset: c.Offset}
+		add(c.From, c.To, l)
+		add(c.To, c.From, l.inverse())
+	}
+
+	// chain the conversions, preferring the shortest chain between two units
+	conversions := make(map[string]map[string]linear, len(direct))
+	for from := range direct {
+		reached := map[string]linear{from: {scale: 1}}
+		queue := []string{from}
+		for len(queue) > 0 {
+			unit := queue[0]
+			queue = queue[1:]
+			for to, l := range direct[unit] {
+				if _, ok := reached[to]; !ok {
+					reached[to] = reached[unit].then(l)
+					queue = append(queue, to)
+				}
+			}
+		}
+		delete(reached, from)
+		conversions[from] = reached
+	}
+
+	systems := make(map[string][]string, len(info.Systems))
+	for name, units := range info.Systems {
+		systems[strings.ToLower(name)] = units
+	}
+	return &Converter{systems: systems, conversions: conversions}, nil
+}
+
+// Supports reports whether units, the name of a system of units or a unit, is known to the conversion table.
+func (c *Converter) Supports(units string) bool {
+	if _, ok := c.systems[strings.ToLower(units)]; ok {
+		return true
+	}
+	_, ok := c.conversions[units]
+	return ok
+}
+
+// target returns the unit a value in the unit from is converted to when units are requested, and whether it can be.
+func (c *Converter) target(from, units string) (string, bool) {
+	system, ok := c.systems[strings.ToLower(units)]
+	if !ok {
+		_, ok = c.conversions[from][units]
+		return units, ok || from == units
+	}
+	for _, unit := range system {
+		if unit == from {
+			return from, true
+		}
+	}
+	for _, unit := range system {
+		if _, ok := c.conversions[from][unit]; ok {
+			return unit, true
+		}
+	}
+	return from, false
+}
+
+// Convert converts a value from one unit to another, reporting whether there is a conversion between them.
+func (c *Converter) Convert(v float64, from, to string) (float64, bool) {
+	if from == to {
+		return v, true
+	}
+	l, ok := c.conversions[from][to]
+	if !ok {
+		return v, false
+	}
+	return l.apply(v), true
+}
+
+// Reading is a reading returned by a query requesting units, along with the unit of its value when it is known.
+type Reading struct {
+	contract.Reading
+	Units string
+}
+
+// MarshalJSON adds the unit to the JSON representation of the reading.
+func (r Reading) MarshalJSON() ([]byte, error) {
+	b, err := json.Marshal(r.Reading)
+	if err != nil || r.Units == "" {
+		return b, err
+	}
+
+	var fields map[string]interface{}
+	if err = json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+	fields["units"] = r.Units
+	return json.Marshal(fields)
+}
+
+// ConvertReadings converts the values of the readings to units, the name of a system of units or a unit, given the
+// unit of measure of each reading name. The readings whose unit is unknown, whose value isn't numeric or which have
+// no conversion to the requested units are returned unchanged.
+func (c *Converter) ConvertReadings(readings []contract.Reading, uoms map[string]string, units string) []Reading {
+	result := make([]Reading, len(readings))
+	for i, r := range readings {
+		result[i] = Reading{Reading: r, Units: uoms[r.Name]}
+		from := result[i].Units
+		if from == "" {
+			continue
+		}
+		to, ok := c.target(from, units)
+		if !ok || to == from {
+			continue
+		}
+		v, ok := numericValue(r)
+		if !ok {
+			continue
+		}
+		converted, _ := c.Convert(v, from, to)
+		result[i].Value = strconv.FormatFloat(converted, 'e', -1, 64)
+		result[i].ValueType = contract.ValueTypeFloat64
+		result[i].FloatEncoding = contract.ENotation
+		result[i].Units = to
+	}
+	return result
+}
+
+// numericValue returns the value of a numeric reading, decoding the floating point values encoded in base64.
+func numericValue(r contract.Reading) (float64, bool) {
+	if r.ValueType == contract.ValueTypeBinary || r.ValueType == contract.ValueTypeBool ||
+		r.ValueType == contract.ValueTypeString {
+		return 0, false
+	}
+	if r.FloatEncoding == contract.Base64Encoding {
+		b, err := base64.StdEncoding.DecodeString(r.Value)
+		if err != nil {
+			return 0, false
+		}
+		switch len(b) {
+		case 4:
+			return float64(math.Float32frombits(binary.BigEndian.Uint32(b))), true
+		case 8:
+			return math.Float64frombits(binary.BigEndian.Uint64(b)), true
+		}
+		return 0, false
+	}
+	v, err := strconv.ParseFloat(r.Value, 64)
+	return v, err == nil
+}
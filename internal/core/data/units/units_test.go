@@ -0,0 +1,144 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package units
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"math"
+	"strconv"
+	"testing"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testConverter(t *testing.T) *Converter {
+	c, err := NewConverter(UnitsInfo{
+		Systems: map[string][]string{
+			"metric":   {"degC", "m"},
+			"imperial": {"degF", "ft"},
+		},
+		Conversions: []ConversionInfo{
+			{From: "degC", To: "degF", Scale: 1.8, Offset: 32},
+			{From: "K", To: "degC", Scale: 1, Offset: -273.15},
+			{From: "m", To: "ft", Scale: 3.28084},
+		},
+	})
+	require.NoError(t, err)
+	return c
+}
+
+func TestNewConverterRejectsDegenerateConversions(t *testing.T) {
+	tests := []struct {
+		name       string
+		conversion ConversionInfo
+	}{
+		{"zero scale", ConversionInfo{From: "m", To: "ft"}},
+		{"same unit", ConversionInfo{From: "m", To: "m", Scale: 1}},
+		{"missing unit", ConversionInfo{From: "m", Scale: 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewConverter(UnitsInfo{Conversions: []ConversionInfo{tt.conversion}})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestSupports(t *testing.T) {
+	c := testConverter(t)
+	assert.True(t, c.Supports("metric"))
+	assert.True(t, c.Supports("Imperial"))
+	assert.True(t, c.Supports("K"))
+	assert.False(t, c.Supports("furlong"))
+}
+
+func TestConvert(t *testing.T) {
+	c := testConverter(t)
+	tests := []struct {
+		name     string
+		value    float64
+		from     string
+		to       string
+		expected float64
+	}{
+		{"direct", 100, "degC", "degF", 212},
+		{"reverse", 212, "degF", "degC", 100},
+		{"chained", 273.15, "K", "degF", 32},
+		{"same unit", 5, "m", "m", 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, ok := c.Convert(tt.value, tt.from, tt.to)
+			require.True(t, ok)
+			assert.InDelta(t, tt.expected, v, 1e-9)
+		})
+	}
+
+	_, ok := c.Convert(1, "m", "degC")
+	assert.False(t, ok)
+}
+
+func TestConvertReadings(t *testing.T) {
+	c := testConverter(t)
+	encoded := make([]byte, 4)
+	binary.BigEndian.PutUint32(encoded, math.Float32bits(100))
+	readings := []contract.Reading{
+		{Name: "Temperature", Value: "100", ValueType: "Int32"},
+		{Name: "Altitude", Value: "10", ValueType: contract.ValueTypeFloat64, FloatEncoding: contract.ENotation},
+		{Name: "Boiler", Value: base64.StdEncoding.EncodeToString(encoded), ValueType: "Float32",
+			FloatEncoding: contract.Base64Encoding},
+		{Name: "Label", Value: "hot", ValueType: contract.ValueTypeString},
+		{Name: "Unknown", Value: "1", ValueType: "Int32"},
+	}
+	uoms := map[string]string{"Temperature": "degC", "Altitude": "ft", "Boiler": "degC", "Label": "degC"}
+
+	converted := c.ConvertReadings(readings, uoms, "imperial")
+	require.Len(t, converted, len(readings))
+
+	assertValue(t, 212, converted[0])
+	assert.Equal(t, "degF", converted[0].Units)
+	assert.Equal(t, contract.ValueTypeFloat64, converted[0].ValueType)
+	assert.Equal(t, contract.ENotation, converted[0].FloatEncoding)
+
+	assert.Equal(t, readings[1], converted[1].Reading, "readings already in the system are unchanged")
+	assert.Equal(t, "ft", converted[1].Units)
+
+	assertValue(t, 212, converted[2])
+	assert.Equal(t, "degF", converted[2].Units)
+
+	assert.Equal(t, readings[3], converted[3].Reading, "non numeric readings are unchanged")
+	assert.Equal(t, readings[4], converted[4].Reading, "readings of unknown unit are unchanged")
+	assert.Empty(t, converted[4].Units)
+
+	converted = c.ConvertReadings(readings[:1], uoms, "K")
+	assertValue(t, 373.15, converted[0])
+	assert.Equal(t, "K", converted[0].Units)
+}
+
+func TestReadingMarshalJSON(t *testing.T) {
+	b, err := json.Marshal(Reading{Reading: contract.Reading{Name: "Temperature", Value: "1"}, Units: "degC"})
+	require.NoError(t, err)
+
+	var fields map[string]interface{}
+	require.NoError(t, json.Unmarshal(b, &fields))
+	assert.Equal(t, "degC", fields["units"])
+	assert.Equal(t, "Temperature", fields["name"])
+
+	b, err = json.Marshal(Reading{Reading: contract.Reading{Name: "Temperature"}})
+	require.NoError(t, err)
+	assert.NotContains(t, string(b), "units")
+}
+
+func assertValue(t *testing.T, expected float64, r Reading) {
+	v, err := strconv.ParseFloat(r.Value, 64)
+	require.NoError(t, err)
+	assert.InDelta(t, expected, v, 1e-4)
+}
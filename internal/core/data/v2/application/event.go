@@ -12,8 +12,12 @@ import (
 	"strings"
 
 	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dataErrors "github.com/edgexfoundry/edgex-go/internal/core/data/errors"
 	v2DataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/v2/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/validator"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/tracing"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/clients"
@@ -38,14 +42,40 @@ func AddEvent(e models.Event, ctx context.Context, dic *di.Container) (id string
 		return "", errors.NewCommonEdgeXWrapper(err)
 	}
 
-	// Add the event and readings to the database
-	if configuration.Writable.PersistData {
+	// the v2 errors have no kind for a rate limit, a device over its quota of events is answered as unavailable
+	quotaErr := dataContainer.QuotaEnforcerFrom(dic.Get).Check(ctx, validator.FromV2(e))
+	if _, ok := quotaErr.(dataErrors.ErrEventRateExceeded); ok {
+		return "", errors.NewCommonEdgeX(errors.KindServiceUnavailable, "event quota exceeded", quotaErr)
+	} else if quotaErr != nil {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, "event quota exceeded", quotaErr)
+	}
+
+	validationErr := dataContainer.EventValidatorsFrom(dic.Get).Validate(
+		validator.FromV2(e),
+		configuration.Writable.BypassEventValidation)
+	if validationErr != nil {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, "event validation failed", validationErr)
+	}
+
+	// Add the event and readings to the database, apart from the bus-only readings
+	stored, persisted := dataContainer.BusOnlyPolicyFrom(dic.Get).FilterV2(e)
+	if configuration.Writable.PersistData && persisted {
 		correlationId := correlation.FromContext(ctx)
-		addedEvent, err := dbClient.AddEvent(e)
+		_, span := tracing.StartSpanOfKind(ctx, tracing.KindClient, "database AddEvent")
+		addedEvent, err := dbClient.AddEvent(stored)
+		span.End(err)
 		if err != nil {
 			return "", errors.NewCommonEdgeXWrapper(err)
 		}
-		e = addedEvent
+		if len(stored.Readings) == len(e.Readings) {
+			e = addedEvent
+		} else {
+			// the bus-only readings are still published along with the persisted ones
+			e.Id = addedEvent.Id
+			e.Created = addedEvent.Created
+		}
+		// the record is timestamped with the time it is exported at, close to the time the event was persisted at
+		dataContainer.KafkaExporterFrom(dic.Get).Export(e.DeviceName, 0, dtos.FromEventModelToDTO(addedEvent))
 
 		lc.Debug(fmt.Sprintf(
 			"Event created on DB successfully. Event-id: %s, Correlation-id: %s ",
@@ -54,6 +84,14 @@ func AddEvent(e models.Event, ctx context.Context, dic *di.Container) (id string
 		))
 	}
 
+	rollups := dataContainer.RollupMaintainerFrom(dic.Get)
+	received := db.MakeTimestamp()
+	for _, r := range e.Readings {
+		if reading, ok := r.(models.SimpleReading); ok {
+			rollups.AddReading(e.DeviceName, reading.ResourceName, reading.Value, received)
+		}
+	}
+
 	//convert Event model to Event DTO
 	eventDTO := dtos.FromEventModelToDTO(e)
 	putEventOnQueue(eventDTO, ctx, dic) // Push event DTO to message bus for App Services to consume
@@ -82,7 +120,10 @@ func putEventOnQueue(evt dtos.Event, ctx context.Context, dic *di.Container) {
 	}
 
 	msgEnvelope := msgTypes.NewMessageEnvelope(data, ctx)
+	_, span := tracing.StartSpanOfKind(ctx, tracing.KindProducer, "publish "+configuration.MessageQueue.Topic)
+	span.SetTag(tracing.MessagingDestinationTag, configuration.MessageQueue.Topic)
 	err = msgClient.Publish(msgEnvelope, configuration.MessageQueue.Topic)
+	span.End(err)
 	if err != nil {
 		lc.Error(fmt.Sprintf("Unable to send message for V2 API event. Correlation-id: %s, Device Name: %s, Error: %v",
 			correlationId, evt.DeviceName, err))
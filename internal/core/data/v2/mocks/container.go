@@ -14,21 +14,11 @@ import (
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
 	"github.com/edgexfoundry/go-mod-bootstrap/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
-
-	"github.com/edgexfoundry/go-mod-messaging/messaging"
-	msgTypes "github.com/edgexfoundry/go-mod-messaging/pkg/types"
 )
 
 // NewMockDIC function returns a mock bootstrap di Container
 func NewMockDIC() *di.Container {
-	msgClient, _ := messaging.NewMessageClient(msgTypes.MessageBusConfig{
-		PublishHost: msgTypes.HostInfo{
-			Host:     "*",
-			Protocol: "tcp",
-			Port:     5563,
-		},
-		Type: "zero",
-	})
+	msgClient := NewMockMessageClient()
 
 	return di.NewContainer(di.ServiceConstructorMap{
 		dataContainer.ConfigurationName: func(get di.Get) interface{} {
@@ -0,0 +1,49 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mocks
+
+import (
+	"sync"
+
+	msgTypes "github.com/edgexfoundry/go-mod-messaging/pkg/types"
+)
+
+// MockMessageClient keeps the messages published in memory, in place of a message bus.
+type MockMessageClient struct {
+	mutex     sync.Mutex
+	published map[string][]msgTypes.MessageEnvelope
+}
+
+// NewMockMessageClient returns a MockMessageClient which nothing was published to yet.
+func NewMockMessageClient() *MockMessageClient {
+	return &MockMessageClient{published: make(map[string][]msgTypes.MessageEnvelope)}
+}
+
+func (c *MockMessageClient) Connect() error {
+	return nil
+}
+
+func (c *MockMessageClient) Publish(message msgTypes.MessageEnvelope, topic string) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.published[topic] = append(c.published[topic], message)
+	return nil
+}
+
+func (c *MockMessageClient) Subscribe(_ []msgTypes.TopicChannel, _ chan error) error {
+	return nil
+}
+
+func (c *MockMessageClient) Disconnect() error {
+	return nil
+}
+
+// Published returns the messages published on the topic, in the order they were.
+func (c *MockMessageClient) Published(topic string) []msgTypes.MessageEnvelope {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.published[topic]
+}
@@ -3,11 +3,14 @@ package v2
 import (
 	"net/http"
 
+	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/ingestion"
 	dataController "github.com/edgexfoundry/edgex-go/internal/core/data/v2/controller/http"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
 	commonController "github.com/edgexfoundry/edgex-go/internal/pkg/v2/controller/http"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
 	v2Constant "github.com/edgexfoundry/go-mod-core-contracts/v2"
 
 	"github.com/gorilla/mux"
@@ -16,15 +19,18 @@ import (
 func LoadRestRoutes(r *mux.Router, dic *di.Container) {
 	// v2 API routes
 	// Common
-	cc := commonController.NewV2CommonController(dic)
+	cc := commonController.NewV2CommonController(dic, clients.CoreDataServiceKey)
 	r.HandleFunc(v2Constant.ApiPingRoute, cc.Ping).Methods(http.MethodGet)
 	r.HandleFunc(v2Constant.ApiVersionRoute, cc.Version).Methods(http.MethodGet)
 	r.HandleFunc(v2Constant.ApiConfigRoute, cc.Config).Methods(http.MethodGet)
+	r.HandleFunc(v2Constant.ApiConfigRoute, cc.PatchConfig).Methods(http.MethodPatch)
 	r.HandleFunc(v2Constant.ApiMetricsRoute, cc.Metrics).Methods(http.MethodGet)
 
 	// Events
 	ec := dataController.NewEventController(dic)
-	r.HandleFunc(v2Constant.ApiEventRoute, ec.AddEvent).Methods(http.MethodPost)
+	r.HandleFunc(v2Constant.ApiEventRoute, func(w http.ResponseWriter, r *http.Request) {
+		ingestion.Observe(dataContainer.IngestionMetricsFrom(dic.Get), ingestion.HTTPv2, w, r, ec.AddEvent)
+	}).Methods(http.MethodPost)
 	r.HandleFunc(v2Constant.ApiEventIdRoute, ec.EventById).Methods(http.MethodGet)
 	r.HandleFunc(v2Constant.ApiEventIdRoute, ec.DeleteEventById).Methods(http.MethodDelete)
 	r.HandleFunc(v2Constant.ApiEventCountRoute, ec.EventTotalCount).Methods(http.MethodGet)
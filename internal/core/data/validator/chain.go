@@ -0,0 +1,100 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validator
+
+import (
+	"sync"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/errors"
+)
+
+// Statistics summarizes the outcome of the validation of the incoming events.
+type Statistics struct {
+	// Validators are the names of the validators run on the events, in order
+	Validators []string
+	// Validated is the number of events which went through the validators
+	Validated uint64
+	// Bypassed is the number of events accepted without validation while validation was bypassed
+	Bypassed uint64
+	// Rejected is the number of events rejected by each validator
+	Rejected map[string]uint64
+}
+
+// Chain runs the configured validators on the incoming events and keeps count of the events they reject.
+type Chain struct {
+	names      []string
+	validators []Validator
+	mutex      sync.Mutex
+	validated  uint64
+	bypassed   uint64
+	rejected   map[string]uint64
+}
+
+// NewChain creates the validators registered with the given names, passing each the parameters configured for it.
+func NewChain(names []string, parameters map[string]map[string]string) (*Chain, error) {
+	c := &Chain{rejected: make(map[string]uint64)}
+	for _, name := range names {
+		v, err := create(name, parameters[name])
+		if err != nil {
+			return nil, err
+		}
+		c.names = append(c.names, name)
+		c.validators = append(c.validators, v)
+		c.rejected[name] = 0
+	}
+	return c, nil
+}
+
+// Validate runs the validators on the event in order and returns an ErrEventRejected for the first one rejecting it.
+// When bypass is set the event is accepted without being validated. A nil chain accepts every event.
+func (c *Chain) Validate(e Event, bypass bool) error {
+	if c == nil || len(c.validators) == 0 {
+		return nil
+	}
+
+	if bypass {
+		c.mutex.Lock()
+		c.bypassed++
+		c.mutex.Unlock()
+		return nil
+	}
+
+	for i, v := range c.validators {
+		if err := v.Validate(e); err != nil {
+			c.mutex.Lock()
+			c.validated++
+			c.rejected[c.names[i]]++
+			c.mutex.Unlock()
+			return errors.NewErrEventRejected(c.names[i], err)
+		}
+	}
+
+	c.mutex.Lock()
+	c.validated++
+	c.mutex.Unlock()
+	return nil
+}
+
+// Statistics returns the outcome of the validation of the events received so far.
+func (c *Chain) Statistics() Statistics {
+	if c == nil {
+		return Statistics{Validators: []string{}, Rejected: map[string]uint64{}}
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	s := Statistics{
+		Validators: append([]string{}, c.names...),
+		Validated:  c.validated,
+		Bypassed:   c.bypassed,
+		Rejected:   make(map[string]uint64, len(c.rejected)),
+	}
+	for name, count := range c.rejected {
+		s.Rejected[name] = count
+	}
+	return s
+}
@@ -0,0 +1,79 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// RangeValidatorName is the name of the built-in validator rejecting the events with an implausible numeric reading.
+const RangeValidatorName = "range"
+
+func init() {
+	Register(RangeValidatorName, newRangeValidator)
+}
+
+type valueRange struct {
+	min float64
+	max float64
+}
+
+// rangeValidator rejects the events with a reading outside of the range configured for the reading name. Its
+// parameters map a reading name to an inclusive range written 'min..max', e.g. Temperature = '-40..125'.
+type rangeValidator struct {
+	ranges map[string]valueRange
+}
+
+func newRangeValidator(parameters map[string]string) (Validator, error) {
+	v := rangeValidator{ranges: make(map[string]valueRange, len(parameters))}
+	for name, value := range parameters {
+		r, err := parseRange(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid range for reading '%s': %v", name, err)
+		}
+		v.ranges[name] = r
+	}
+	return v, nil
+}
+
+func parseRange(value string) (valueRange, error) {
+	bounds := strings.Split(value, "..")
+	if len(bounds) != 2 {
+		return valueRange{}, fmt.Errorf("'%s' is not written 'min..max'", value)
+	}
+	min, err := strconv.ParseFloat(strings.TrimSpace(bounds[0]), 64)
+	if err != nil {
+		return valueRange{}, err
+	}
+	max, err := strconv.ParseFloat(strings.TrimSpace(bounds[1]), 64)
+	if err != nil {
+		return valueRange{}, err
+	}
+	if min > max {
+		return valueRange{}, fmt.Errorf("minimum %v is greater than maximum %v", min, max)
+	}
+	return valueRange{min: min, max: max}, nil
+}
+
+func (v rangeValidator) Validate(e Event) error {
+	for _, reading := range e.Readings {
+		r, ok := v.ranges[reading.Name]
+		if !ok {
+			continue
+		}
+		value, err := strconv.ParseFloat(reading.Value, 64)
+		if err != nil {
+			return fmt.Errorf("reading '%s' value '%s' is not numeric", reading.Name, reading.Value)
+		}
+		if value < r.min || value > r.max {
+			return fmt.Errorf("reading '%s' value %v is outside of the plausible range %v..%v",
+				reading.Name, value, r.min, r.max)
+		}
+	}
+	return nil
+}
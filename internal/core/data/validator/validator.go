@@ -0,0 +1,121 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package validator provides the registration point of the validators run on every incoming event before it is
+// persisted. Validators are compiled into the service, register themselves under a name from an init function and
+// are selected by name in the EventValidation configuration.
+package validator
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+// Reading is the part of a reading inspected by the validators, independent of the API version it was received with.
+type Reading struct {
+	Name        string
+	ValueType   string
+	Value       string
+	BinaryValue []byte
+}
+
+// Event is the part of an event inspected by the validators, independent of the API version it was received with.
+type Event struct {
+	Device   string
+	Origin   int64
+	Readings []Reading
+}
+
+// Validator checks an incoming event.
+type Validator interface {
+	// Validate returns the reason the event is rejected, nil when it is accepted.
+	Validate(e Event) error
+}
+
+// Factory creates a validator from the parameters configured for it.
+type Factory func(parameters map[string]string) (Validator, error)
+
+var (
+	registryMutex sync.RWMutex
+	registry      = make(map[string]Factory)
+)
+
+// Register makes a validator available under the given name. It is meant to be called from the init function of the
+// package implementing the validator and panics if the name is already taken.
+func Register(name string, factory Factory) {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+
+	if factory == nil {
+		panic("validator: Register factory is nil for " + name)
+	}
+	if _, exists := registry[name]; exists {
+		panic("validator: Register called twice for " + name)
+	}
+	registry[name] = factory
+}
+
+// Registered returns the sorted names of the registered validators.
+func Registered() []string {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func create(name string, parameters map[string]string) (Validator, error) {
+	registryMutex.RLock()
+	factory, ok := registry[name]
+	registryMutex.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("no validator registered as '%s', registered validators are %v", name, Registered())
+	}
+	v, err := factory(parameters)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create validator '%s': %v", name, err)
+	}
+	return v, nil
+}
+
+// FromV1 returns the part of a V1 API event inspected by the validators.
+func FromV1(e contract.Event) Event {
+	event := Event{Device: e.Device, Origin: e.Origin, Readings: make([]Reading, len(e.Readings))}
+	for i, r := range e.Readings {
+		event.Readings[i] = Reading{Name: r.Name, ValueType: r.ValueType, Value: r.Value, BinaryValue: r.BinaryValue}
+	}
+	return event
+}
+
+// FromV2 returns the part of a V2 API event inspected by the validators.
+func FromV2(e models.Event) Event {
+	event := Event{Device: e.DeviceName, Origin: e.Origin, Readings: make([]Reading, 0, len(e.Readings))}
+	for _, r := range e.Readings {
+		switch reading := r.(type) {
+		case models.SimpleReading:
+			event.Readings = append(event.Readings, Reading{
+				Name:      reading.ResourceName,
+				ValueType: reading.ValueType,
+				Value:     reading.Value,
+			})
+		case models.BinaryReading:
+			event.Readings = append(event.Readings, Reading{
+				Name:        reading.ResourceName,
+				ValueType:   reading.ValueType,
+				BinaryValue: reading.BinaryValue,
+			})
+		}
+	}
+	return event
+}
@@ -0,0 +1,165 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validator
+
+import (
+	"errors"
+	"testing"
+
+	dataErrors "github.com/edgexfoundry/edgex-go/internal/core/data/errors"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRejectAllName = "test-reject-all"
+
+func init() {
+	Register(testRejectAllName, func(parameters map[string]string) (Validator, error) {
+		return rejectAll{reason: parameters["reason"]}, nil
+	})
+}
+
+type rejectAll struct {
+	reason string
+}
+
+func (v rejectAll) Validate(e Event) error {
+	return errors.New(v.reason)
+}
+
+func temperatureEvent(value string) Event {
+	return Event{Device: "thermometer", Readings: []Reading{{Name: "Temperature", Value: value}}}
+}
+
+func TestRegisterTwicePanics(t *testing.T) {
+	assert.Panics(t, func() { Register(RangeValidatorName, newRangeValidator) })
+	assert.Contains(t, Registered(), RangeValidatorName)
+}
+
+func TestNewChain(t *testing.T) {
+	tests := []struct {
+		name          string
+		validators    []string
+		parameters    map[string]map[string]string
+		expectedError bool
+	}{
+		{"no validators", nil, nil, false},
+		{"range", []string{RangeValidatorName}, map[string]map[string]string{RangeValidatorName: {"Temperature": "-40..125"}}, false},
+		{"unregistered", []string{"unknown"}, nil, true},
+		{"invalid range", []string{RangeValidatorName}, map[string]map[string]string{RangeValidatorName: {"Temperature": "125"}}, true},
+		{"inverted range", []string{RangeValidatorName}, map[string]map[string]string{RangeValidatorName: {"Temperature": "125..-40"}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewChain(tt.validators, tt.parameters)
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestChainValidate(t *testing.T) {
+	chain, err := NewChain(
+		[]string{RangeValidatorName, testRejectAllName},
+		map[string]map[string]string{
+			RangeValidatorName: {"Temperature": "-40..125"},
+			testRejectAllName:  {"reason": "rejected"},
+		})
+	require.NoError(t, err)
+
+	err = chain.Validate(temperatureEvent("200"), false)
+	require.Error(t, err)
+	assert.IsType(t, dataErrors.ErrEventRejected{}, err)
+	assert.Contains(t, err.Error(), RangeValidatorName)
+
+	err = chain.Validate(temperatureEvent("20"), false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), testRejectAllName)
+
+	assert.NoError(t, chain.Validate(temperatureEvent("200"), true))
+
+	assert.Equal(t, Statistics{
+		Validators: []string{RangeValidatorName, testRejectAllName},
+		Validated:  2,
+		Bypassed:   1,
+		Rejected:   map[string]uint64{RangeValidatorName: 1, testRejectAllName: 1},
+	}, chain.Statistics())
+}
+
+func TestNilChainAcceptsEvents(t *testing.T) {
+	var chain *Chain
+	assert.NoError(t, chain.Validate(temperatureEvent("200"), false))
+	assert.Empty(t, chain.Statistics().Validators)
+}
+
+func TestRangeValidator(t *testing.T) {
+	v, err := newRangeValidator(map[string]string{"Temperature": "-40..125"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		event    Event
+		accepted bool
+	}{
+		{"within range", temperatureEvent("20.5"), true},
+		{"lower bound", temperatureEvent("-40"), true},
+		{"upper bound", temperatureEvent("125"), true},
+		{"below range", temperatureEvent("-41"), false},
+		{"above range", temperatureEvent("126"), false},
+		{"not numeric", temperatureEvent("hot"), false},
+		{"other reading", Event{Readings: []Reading{{Name: "Humidity", Value: "200"}}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Validate(tt.event)
+			assert.Equal(t, tt.accepted, err == nil)
+		})
+	}
+}
+
+func TestFromEvents(t *testing.T) {
+	expected := Event{
+		Device: "thermometer",
+		Origin: 1,
+		Readings: []Reading{
+			{Name: "Temperature", ValueType: "Float32", Value: "20.5"},
+			{Name: "Image", ValueType: "Binary", BinaryValue: []byte{1, 2}},
+		},
+	}
+
+	v1 := contract.Event{
+		Device: "thermometer",
+		Origin: 1,
+		Readings: []contract.Reading{
+			{Name: "Temperature", ValueType: "Float32", Value: "20.5"},
+			{Name: "Image", ValueType: "Binary", BinaryValue: []byte{1, 2}},
+		},
+	}
+	assert.Equal(t, expected, FromV1(v1))
+
+	v2 := models.Event{
+		DeviceName: "thermometer",
+		Origin:     1,
+		Readings: []models.Reading{
+			models.SimpleReading{
+				BaseReading: models.BaseReading{ResourceName: "Temperature", ValueType: "Float32"},
+				Value:       "20.5",
+			},
+			models.BinaryReading{
+				BaseReading: models.BaseReading{ResourceName: "Image", ValueType: "Binary"},
+				BinaryValue: []byte{1, 2},
+			},
+		},
+	}
+	assert.Equal(t, expected, FromV2(v2))
+}
@@ -0,0 +1,144 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package virtual defines the virtual resources, such as the daily energy consumption summing the interval readings of
+// a meter, computed from the rollups of a real resource and queried as if they were real resources, so that every
+// dashboard doesn't have to duplicate the aggregation.
+package virtual
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/models"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// The aggregates of the rollups a virtual resource can expose.
+const (
+	AggregateSum   = "sum"
+	AggregateAvg   = "avg"
+	AggregateMin   = "min"
+	AggregateMax   = "max"
+	AggregateCount = "count"
+)
+
+// ResourceInfo defines a virtual resource as an aggregate of the rollups of the period of a real resource.
+type ResourceInfo struct {
+	// Name is the name of the readings of the virtual resource, e.g. DailyEnergy
+	Name string
+	// Source is the name of the real resource whose rollups are aggregated, e.g. Energy
+	Source string
+	// Period is the period of the rollups, hourly or daily
+	Period string
+	// Aggregate is the aggregate of the rollups exposed as the value of the readings: sum, avg, min, max or count
+	Aggregate string
+}
+
+// Resources are the virtual resources by name. A nil Resources defines none.
+type Resources struct {
+	resources map[string]ResourceInfo
+}
+
+// NewResources validates the definitions of the virtual resources.
+func NewResources(infos []ResourceInfo) (*Resources, error) {
+	r := &Resources{resources: make(map[string]ResourceInfo, len(infos))}
+	for _, info := range infos {
+		if info.Name == "" || info.Source == "" {
+			return nil, fmt.Errorf("virtual resource '%s' requires a name and a source", info.Name)
+		}
+		if info.Name == info.Source {
+			return nil, fmt.Errorf("virtual resource '%s' can't be its own source", info.Name)
+		}
+		if _, ok := r.resources[info.Name]; ok {
+			return nil, fmt.Errorf("virtual resource '%s' is defined more than once", info.Name)
+		}
+		if _, err := models.PeriodLength(info.Period); err != nil {
+			return nil, fmt.Errorf("virtual resource '%s': %s", info.Name, err.Error())
+		}
+		switch info.Aggregate {
+		case AggregateSum, AggregateAvg, AggregateMin, AggregateMax, AggregateCount:
+		default:
+			return nil, fmt.Errorf("virtual resource '%s': unknown aggregate '%s'", info.Name, info.Aggregate)
+		}
+		r.resources[info.Name] = info
+	}
+	return r, nil
+}
+
+// Lookup returns the definition of the virtual resource of the name, if any.
+func (r *Resources) Lookup(name string) (ResourceInfo, bool) {
+	if r == nil {
+		return ResourceInfo{}, false
+	}
+	info, ok := r.resources[name]
+	return info, ok
+}
+
+// Names returns the names of the virtual resources, sorted.
+func (r *Resources) Names() []string {
+	if r == nil {
+		return nil
+	}
+	names := make([]string, 0, len(r.resources))
+	for name := range r.resources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Readings returns the limit most recent readings of the virtual resource for the device, newest first, one per
+// rollup of its source. The origin of a reading is the start of its rollup, in nanoseconds like the origin of the
+// readings of the device services, and it is created when its rollup was last modified.
+func Readings(dbClient interfaces.DBClient, info ResourceInfo, device string, limit int) ([]contract.Reading, error) {
+	rollups, err := dbClient.RollupsByDeviceAndName(info.Period, device, info.Source, 0, -1, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	readings := make([]contract.Reading, 0, limit)
+	for i := len(rollups) - 1; i >= 0 && len(readings) < limit; i-- {
+		readings = append(readings, NewReading(info, rollups[i]))
+	}
+	return readings, nil
+}
+
+// NewReading returns the reading of the virtual resource for a rollup of its source.
+func NewReading(info ResourceInfo, r models.Rollup) contract.Reading {
+	reading := contract.Reading{
+		Id:            r.ID,
+		Device:        r.Device,
+		Name:          info.Name,
+		Origin:        r.Start * int64(time.Millisecond),
+		Created:       r.Modified,
+		Modified:      r.Modified,
+		ValueType:     contract.ValueTypeFloat64,
+		FloatEncoding: contract.ENotation,
+	}
+
+	var v float64
+	switch info.Aggregate {
+	case AggregateSum:
+		v = r.Sum
+	case AggregateAvg:
+		v = r.Avg
+	case AggregateMin:
+		v = r.Min
+	case AggregateMax:
+		v = r.Max
+	case AggregateCount:
+		reading.ValueType = contract.ValueTypeInt64
+		reading.FloatEncoding = ""
+		reading.Value = strconv.FormatInt(r.Count, 10)
+		return reading
+	}
+	reading.Value = strconv.FormatFloat(v, 'e', -1, 64)
+	return reading
+}
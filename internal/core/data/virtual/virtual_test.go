@@ -0,0 +1,112 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package virtual
+
+import (
+	"errors"
+	"testing"
+
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/models"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var dailyEnergy = ResourceInfo{Name: "DailyEnergy", Source: "Energy", Period: models.RollupDaily, Aggregate: AggregateSum}
+
+func TestNewResourcesRejectsInvalidDefinitions(t *testing.T) {
+	tests := []struct {
+		name  string
+		infos []ResourceInfo
+	}{
+		{"missing source", []ResourceInfo{{Name: "DailyEnergy", Period: models.RollupDaily, Aggregate: AggregateSum}}},
+		{"own source", []ResourceInfo{{Name: "Energy", Source: "Energy", Period: models.RollupDaily, Aggregate: AggregateSum}}},
+		{"unknown period", []ResourceInfo{{Name: "DailyEnergy", Source: "Energy", Period: "weekly", Aggregate: AggregateSum}}},
+		{"unknown aggregate", []ResourceInfo{{Name: "DailyEnergy", Source: "Energy", Period: models.RollupDaily, Aggregate: "median"}}},
+		{"duplicate", []ResourceInfo{dailyEnergy, dailyEnergy}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewResources(tt.infos)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestLookup(t *testing.T) {
+	r, err := NewResources([]ResourceInfo{dailyEnergy})
+	require.NoError(t, err)
+
+	info, ok := r.Lookup("DailyEnergy")
+	assert.True(t, ok)
+	assert.Equal(t, dailyEnergy, info)
+	_, ok = r.Lookup("Energy")
+	assert.False(t, ok)
+	assert.Equal(t, []string{"DailyEnergy"}, r.Names())
+
+	var none *Resources
+	_, ok = none.Lookup("DailyEnergy")
+	assert.False(t, ok)
+}
+
+func TestReadings(t *testing.T) {
+	rollups := []models.Rollup{
+		{ID: "1", Device: "meter", Name: "Energy", Period: models.RollupDaily, Start: 0, Count: 24, Sum: 10.5, Modified: 10},
+		{ID: "2", Device: "meter", Name: "Energy", Period: models.RollupDaily, Start: 86400000, Count: 24, Sum: 12, Modified: 20},
+		{ID: "3", Device: "meter", Name: "Energy", Period: models.RollupDaily, Start: 172800000, Count: 12, Sum: 6.25, Modified: 30},
+	}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("RollupsByDeviceAndName", models.RollupDaily, "meter", "Energy", int64(0), int64(-1), 0).
+		Return(rollups, nil)
+
+	readings, err := Readings(dbClientMock, dailyEnergy, "meter", 2)
+	require.NoError(t, err)
+	require.Len(t, readings, 2, "the readings are limited")
+
+	assert.Equal(t, "3", readings[0].Id, "the readings are newest first")
+	assert.Equal(t, "DailyEnergy", readings[0].Name)
+	assert.Equal(t, "meter", readings[0].Device)
+	assert.Equal(t, "6.25e+00", readings[0].Value)
+	assert.Equal(t, contract.ValueTypeFloat64, readings[0].ValueType)
+	assert.Equal(t, int64(172800000000000), readings[0].Origin)
+	assert.Equal(t, "2", readings[1].Id)
+}
+
+func TestReadingsError(t *testing.T) {
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("RollupsByDeviceAndName", models.RollupDaily, "meter", "Energy", int64(0), int64(-1), 0).
+		Return(nil, errors.New("test error"))
+
+	_, err := Readings(dbClientMock, dailyEnergy, "meter", 10)
+	assert.Error(t, err)
+}
+
+func TestNewReadingAggregates(t *testing.T) {
+	r := models.Rollup{Device: "meter", Name: "Energy", Count: 4, Min: 1, Max: 4, Sum: 10, Avg: 2.5}
+	tests := []struct {
+		aggregate string
+		value     string
+		valueType string
+	}{
+		{AggregateSum, "1e+01", contract.ValueTypeFloat64},
+		{AggregateAvg, "2.5e+00", contract.ValueTypeFloat64},
+		{AggregateMin, "1e+00", contract.ValueTypeFloat64},
+		{AggregateMax, "4e+00", contract.ValueTypeFloat64},
+		{AggregateCount, "4", contract.ValueTypeInt64},
+	}
+	for _, tt := range tests {
+		t.Run(tt.aggregate, func(t *testing.T) {
+			info := dailyEnergy
+			info.Aggregate = tt.aggregate
+			reading := NewReading(info, r)
+			assert.Equal(t, tt.value, reading.Value)
+			assert.Equal(t, tt.valueType, reading.ValueType)
+		})
+	}
+}
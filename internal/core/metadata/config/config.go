@@ -15,18 +15,56 @@
 package config
 
 import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/authz"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dependency"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/gateway"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/httptuning"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/mtls"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/readygate"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/selftest"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/slo"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/sysevent"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/telemetry"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/tracing"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/unixsocket"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/warmup"
+
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
 )
 
 // Struct used to parse the JSON configuration file
 type ConfigurationStruct struct {
-	Writable      WritableInfo
-	Clients       map[string]bootstrapConfig.ClientInfo
-	Databases     map[string]bootstrapConfig.Database
-	Notifications NotificationInfo
-	Registry      bootstrapConfig.RegistryInfo
-	Service       bootstrapConfig.ServiceInfo
-	SecretStore   bootstrapConfig.SecretStoreInfo
+	Writable         WritableInfo
+	Clients          map[string]bootstrapConfig.ClientInfo
+	Databases        map[string]bootstrapConfig.Database
+	DatabaseDecoding db.DecodingInfo
+	Notifications    NotificationInfo
+	ProfileImport    ProfileImportInfo
+	ProfileLint      ProfileLintInfo
+	Replication      ReplicationInfo
+	Registry         bootstrapConfig.RegistryInfo
+	Service          bootstrapConfig.ServiceInfo
+	SecretStore      bootstrapConfig.SecretStoreInfo
+	SLO              slo.SLOInfo
+	Authorization    authz.AuthorizationInfo
+	RBAC             authz.RBACInfo
+	UnixSocket       unixsocket.UnixSocketInfo
+	HttpTuning       httptuning.HttpTuningInfo
+	MutualTLS        mtls.MutualTLSInfo
+	Tracing          tracing.TracingInfo
+	Telemetry        telemetry.TelemetryInfo
+	Audit            audit.AuditInfo
+	DependencyCheck  dependency.DependencyCheckInfo
+	Gateway          gateway.GatewayInfo
+	Warmup           warmup.WarmupInfo
+	SelfTest         selftest.SelfTestInfo
+	SystemEvents     sysevent.SystemEventsInfo
+	ReadyGate        readygate.ReadyGateInfo
+
+	// resolveWritable resolves the secret references of the writable configuration received from the registry
+	resolveWritable func(writable interface{}) bool
 }
 
 type WritableInfo struct {
@@ -44,6 +82,54 @@ type NotificationInfo struct {
 	Slug              string
 }
 
+// ProfileImportInfo provides properties related to importing device profiles from a URI
+type ProfileImportInfo struct {
+	// Timeout is the maximum duration of fetching a device profile, e.g. '10s'
+	Timeout string
+	// MaxFileSize is the maximum size in bytes of a fetched device profile
+	MaxFileSize int64
+	// Registries are the device profile registries profiles are imported from, by name; a URI outside all of them is
+	// rejected
+	Registries map[string]ProfileRegistryInfo
+}
+
+// ProfileRegistryInfo provides properties related to a device profile registry
+type ProfileRegistryInfo struct {
+	// Url is the base URL of the registry, which the URIs of its device profiles start with,
+	// e.g. 'https://profiles.example.com/edgex/'
+	Url string
+	// SecretPath is the secret store path of the username and password sent as basic authentication credentials to
+	// the registry; none are sent when it is empty
+	SecretPath string
+}
+
+// ProfileLintInfo provides properties related to linting device profiles
+type ProfileLintInfo struct {
+	// RulesFile is the path of the YAML file describing the lint rules; the default rules apply when it is empty
+	RulesFile string
+}
+
+// Replication modes of the metadata service
+const (
+	ReplicationModePrimary = "primary"
+	ReplicationModeStandby = "standby"
+)
+
+// ReplicationInfo provides properties related to replicating the metadata to a warm standby instance
+type ReplicationInfo struct {
+	// Mode is 'primary' to send the metadata changes to the standby, 'standby' to accept them, or empty to disable
+	// the replication
+	Mode string
+	// StandbyUrl is the base URL of the standby core-metadata, used in primary mode
+	StandbyUrl string
+	// Interval is how often the primary sends the standby the changes it hasn't applied yet, e.g. '1s'
+	Interval string
+	// Timeout is the maximum duration of a request to the standby, e.g. '5s'
+	Timeout string
+	// BatchSize is the maximum number of changes sent to the standby in a single request
+	BatchSize int
+}
+
 // UpdateFromRaw converts configuration received from the registry to a service-specific configuration struct which is
 // then used to overwrite the service's existing configuration struct.
 func (c *ConfigurationStruct) UpdateFromRaw(rawConfig interface{}) bool {
@@ -65,15 +151,22 @@ func (c *ConfigurationStruct) EmptyWritablePtr() interface{} {
 }
 
 // UpdateWritableFromRaw converts configuration received from the registry to a service-specific WritableInfo struct
-// which is then used to overwrite the service's existing configuration's WritableInfo struct.
+// which is then used to overwrite the service's existing configuration's WritableInfo struct, once its secret
+// references are resolved.
 func (c *ConfigurationStruct) UpdateWritableFromRaw(rawWritable interface{}) bool {
 	writable, ok := rawWritable.(*WritableInfo)
-	if ok {
+	if ok && (c.resolveWritable == nil || c.resolveWritable(writable)) {
 		c.Writable = *writable
 	}
 	return ok
 }
 
+// SetWritableResolver sets the function resolving the secret references of the writable configuration received from
+// the registry, which is only applied when they are.
+func (c *ConfigurationStruct) SetWritableResolver(resolve func(writable interface{}) bool) {
+	c.resolveWritable = resolve
+}
+
 // GetBootstrap returns the configuration elements required by the bootstrap.  Currently, a copy of the configuration
 // data is returned.  This is intended to be temporary -- since ConfigurationStruct drives the configuration.toml's
 // structure -- until we can make backwards-breaking configuration.toml changes (which would consolidate these fields
@@ -102,3 +195,8 @@ func (c *ConfigurationStruct) GetRegistryInfo() bootstrapConfig.RegistryInfo {
 func (c *ConfigurationStruct) GetDatabaseInfo() map[string]bootstrapConfig.Database {
 	return c.Databases
 }
+
+// GetDatabaseDecodePolicy returns the decode policy of the objects read from the database.
+func (c *ConfigurationStruct) GetDatabaseDecodePolicy() string {
+	return c.DatabaseDecoding.Policy
+}
@@ -43,8 +43,12 @@ const (
 	MODEL               = "model"
 	MANUFACTURER        = "manufacturer"
 	YAML                = "yaml"
+	DRAFT               = "draft"
+	LIMIT               = "limit"
 	DEVICEREPORT        = "devicereport"
 	DEVICENAME          = "devicename"
+	RENAME              = "rename"
+	NEWNAME             = "newname"
 	DEVICESERVICE       = "deviceservice"
 	TOPIC               = "topic"
 	PORT                = "port"
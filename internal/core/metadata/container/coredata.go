@@ -27,3 +27,11 @@ var CoreDataValueDescriptorClientName = di.TypeInstanceToName((*coredata.ValueDe
 func CoreDataValueDescriptorClientFrom(get di.Get) coredata.ValueDescriptorClient {
 	return get(CoreDataValueDescriptorClientName).(coredata.ValueDescriptorClient)
 }
+
+// CoreDataEventClientName contains the name of the CoreDataEventClient's implementation in the DIC.
+var CoreDataEventClientName = di.TypeInstanceToName((*coredata.EventClient)(nil))
+
+// CoreDataEventClientFrom helper function queries the DIC and returns the CoreDataEventClient's implementation.
+func CoreDataEventClientFrom(get di.Get) coredata.EventClient {
+	return get(CoreDataEventClientName).(coredata.EventClient)
+}
@@ -16,13 +16,17 @@ package metadata
 
 import (
 	"context"
-
+	"fmt"
 	"sync"
+	"time"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/urlclient/local"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2"
+	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/replication"
 	errorContainer "github.com/edgexfoundry/edgex-go/internal/pkg/container"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/errorconcept"
 
@@ -71,12 +75,51 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _
 			return coredata.NewValueDescriptorClient(
 				local.New(configuration.Clients["CoreData"].Url() + clients.ApiValueDescriptorRoute))
 		},
+		container.CoreDataEventClientName: func(get di.Get) interface{} {
+			return coredata.NewEventClient(local.New(configuration.Clients["CoreData"].Url() + clients.ApiEventRoute))
+		},
 		container.NotificationsClientName: func(get di.Get) interface{} {
 			return notifications.NewNotificationsClient(
 				local.New(configuration.Clients["Notifications"].Url() + clients.ApiNotificationRoute))
 
 		},
+		v2MetadataContainer.ReplicationTrackerName: func(get di.Get) interface{} {
+			return replication.NewTracker()
+		},
 	})
 
+	if configuration.Replication.Mode == config.ReplicationModePrimary {
+		return startReplication(ctx, wg, configuration.Replication, dic)
+	}
+
+	return true
+}
+
+// startReplication starts sending the metadata changes to the standby instance in the background
+func startReplication(ctx context.Context, wg *sync.WaitGroup, info config.ReplicationInfo, dic *di.Container) bool {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	if info.StandbyUrl == "" {
+		lc.Error("Replication StandbyUrl is required in primary mode")
+		return false
+	}
+	interval, err := time.ParseDuration(info.Interval)
+	if err != nil || interval <= 0 {
+		lc.Error(fmt.Sprintf("invalid Replication Interval %s", info.Interval))
+		return false
+	}
+	timeout, err := time.ParseDuration(info.Timeout)
+	if err != nil {
+		lc.Error(fmt.Sprintf("invalid Replication Timeout %s", info.Timeout))
+		return false
+	}
+
+	replicator := replication.NewReplicator(info.StandbyUrl, info.BatchSize, timeout, v2MetadataContainer.DBClientFrom(dic.Get), lc)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		replicator.Run(ctx, interval)
+		lc.Info("Metadata replication stopped")
+	}()
+	lc.Info(fmt.Sprintf("Replicating metadata to the standby at %s", info.StandbyUrl))
 	return true
 }
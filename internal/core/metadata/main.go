@@ -23,15 +23,29 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/authz"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers/database"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers/dependencies"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers/secret"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/gateway"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/httptuning"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/mtls"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/readygate"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/secretref"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/selftest"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/slo"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/sysevent"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/telemetry"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/tracing"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/unixsocket"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/bootstrap/handlers"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/warmup"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/flags"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/handlers/httpserver"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/handlers/message"
-	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/handlers/secret"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/handlers/testing"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/interfaces"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
@@ -51,7 +65,13 @@ func Main(ctx context.Context, cancel context.CancelFunc, router *mux.Router, re
 	//      ....
 	//      flags.Parse(os.Args[1:])
 	//
+	var migrateDryRun string
+	var repairDecoding string
+	var repairDecodingDryRun bool
 	f := flags.New()
+	f.FlagSet.StringVar(&migrateDryRun, handlers.MigrateDryRunFlag, "", handlers.MigrateDryRunUsage)
+	f.FlagSet.StringVar(&repairDecoding, database.RepairDecodingFlag, "", database.RepairDecodingUsage)
+	f.FlagSet.BoolVar(&repairDecodingDryRun, database.RepairDecodingDryRunFlag, false, database.RepairDecodingDryRunUsage)
 	f.Parse(os.Args[1:])
 
 	configuration := &config.ConfigurationStruct{}
@@ -62,6 +82,49 @@ func Main(ctx context.Context, cancel context.CancelFunc, router *mux.Router, re
 	})
 
 	httpServer := httpserver.NewBootstrap(router, true)
+	readyGate := readygate.NewBootstrap(clients.CoreMetaDataServiceKey, &configuration.ReadyGate)
+	tunedServer := httptuning.NewBootstrap(router, &configuration.Service, &configuration.HttpTuning, httpServer)
+	unixSocket := unixsocket.NewBootstrap(router, &configuration.UnixSocket, tunedServer)
+
+	bootstrapHandlers := []interfaces.BootstrapHandler{
+		secret.NewSecret().BootstrapHandler,
+		secretref.NewBootstrap(configuration).BootstrapHandler,
+		readyGate.WaitHandler,
+		mtls.NewBootstrap(&configuration.Service, &configuration.SecretStore, &configuration.MutualTLS).BootstrapHandler,
+		database.NewDatabase(unixSocket, configuration).BootstrapHandler,
+		handlers.NewDatabase(unixSocket, configuration, v2MetadataContainer.DBClientInterfaceName).BootstrapHandler, // add v2 db client bootstrap handler
+		NewBootstrap(router).BootstrapHandler,
+		dependencies.NewBootstrap(configuration, &configuration.DependencyCheck).BootstrapHandler,
+		tracing.NewBootstrap(router, clients.CoreMetaDataServiceKey, &configuration.Tracing).BootstrapHandler,
+		slo.NewBootstrap(router, clients.CoreMetaDataServiceKey, configuration, &configuration.SLO).BootstrapHandler,
+		audit.NewBootstrap(router, clients.CoreMetaDataServiceKey, &configuration.Audit).BootstrapHandler,
+		authz.NewBootstrap(router, clients.CoreMetaDataServiceKey, &configuration.RBAC, &configuration.Authorization).BootstrapHandler,
+		gateway.NewBootstrap(clients.CoreMetaDataServiceKey, configuration, &configuration.Gateway).BootstrapHandler,
+		telemetry.NewBootstrap(clients.CoreMetaDataServiceKey, &configuration.Telemetry).BootstrapHandler,
+		warmup.NewBootstrap(&configuration.Warmup, warmupLoaders).BootstrapHandler,
+		selftest.NewBootstrap(router, &configuration.SelfTest).BootstrapHandler,
+		sysevent.NewBootstrap(router, clients.CoreMetaDataServiceKey, &configuration.Writable, &configuration.SystemEvents).BootstrapHandler,
+		unixSocket.BootstrapHandler,
+		readyGate.AnnounceHandler,
+		message.NewBootstrap(clients.CoreMetaDataServiceKey, edgex.Version).BootstrapHandler,
+		testing.NewBootstrap(unixSocket, readyStream).BootstrapHandler,
+	}
+	if migrateDryRun != "" {
+		// only connect to the database, to scan the existing data and exit
+		bootstrapHandlers = []interfaces.BootstrapHandler{
+			secret.NewSecret().BootstrapHandler,
+			handlers.NewDatabase(unixSocket, configuration, v2MetadataContainer.DBClientInterfaceName).BootstrapHandler,
+			handlers.NewMigrationDryRun(migrateDryRun, v2MetadataContainer.DBClientInterfaceName).BootstrapHandler,
+		}
+	}
+	if repairDecoding != "" {
+		// only connect to the database, to repair the stored objects and exit
+		bootstrapHandlers = []interfaces.BootstrapHandler{
+			secret.NewSecret().BootstrapHandler,
+			database.NewDatabase(unixSocket, configuration).BootstrapHandler,
+			database.NewDecodingRepair(repairDecoding, repairDecodingDryRun).BootstrapHandler,
+		}
+	}
 
 	bootstrap.Run(
 		ctx,
@@ -72,14 +135,5 @@ func Main(ctx context.Context, cancel context.CancelFunc, router *mux.Router, re
 		configuration,
 		startupTimer,
 		dic,
-		[]interfaces.BootstrapHandler{
-			secret.NewSecret().BootstrapHandler,
-			database.NewDatabase(httpServer, configuration).BootstrapHandler,
-			handlers.NewDatabase(httpServer, configuration, v2MetadataContainer.DBClientInterfaceName).BootstrapHandler, // add v2 db client bootstrap handler
-			NewBootstrap(router).BootstrapHandler,
-			telemetry.BootstrapHandler,
-			httpServer.BootstrapHandler,
-			message.NewBootstrap(clients.CoreMetaDataServiceKey, edgex.Version).BootstrapHandler,
-			testing.NewBootstrap(httpServer, readyStream).BootstrapHandler,
-		})
+		bootstrapHandlers)
 }
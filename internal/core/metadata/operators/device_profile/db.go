@@ -15,6 +15,8 @@
 package device_profile
 
 import (
+	"context"
+
 	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
 )
 
@@ -66,3 +68,8 @@ type DeviceProfileUpdater interface {
 type ProvisionWatcherLoader interface {
 	GetProvisionWatchersByProfileId(id string) ([]contract.ProvisionWatcher, error)
 }
+
+// EventLoader retrieves the events emitted by a device from core data, newest first.
+type EventLoader interface {
+	EventsForDevice(ctx context.Context, device string, limit int) ([]contract.Event, error)
+}
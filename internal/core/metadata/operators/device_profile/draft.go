@@ -0,0 +1,183 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package device_profile
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/errors"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+const (
+	// DraftLabel labels the device profiles drafted from events, to be reviewed before their upload.
+	DraftLabel = "draft"
+	// UnitsTagPrefix prefixes the event tags giving the units of the readings of a resource, e.g. units.Temperature.
+	UnitsTagPrefix = "units."
+)
+
+// DraftProfileExecutor drafts a device profile.
+type DraftProfileExecutor interface {
+	Execute() (contract.DeviceProfile, error)
+}
+
+// draftProfile encapsulates the data needed in order to draft a device profile from the events of a device.
+type draftProfile struct {
+	ctx    context.Context
+	name   string
+	device string
+	limit  int
+	loader EventLoader
+}
+
+// Execute drafts the device profile from the latest events of the device.
+func (op draftProfile) Execute() (contract.DeviceProfile, error) {
+	events, err := op.loader.EventsForDevice(op.ctx, op.device, op.limit)
+	if err != nil {
+		return contract.DeviceProfile{}, err
+	}
+	if len(events) == 0 {
+		return contract.DeviceProfile{}, errors.NewErrItemNotFound(op.device)
+	}
+	return DraftProfile(op.name, op.device, events), nil
+}
+
+// NewDraftProfileExecutor creates a DraftProfileExecutor drafting a device profile named name from the latest limit
+// events of the device.
+func NewDraftProfileExecutor(
+	ctx context.Context,
+	name string,
+	device string,
+	limit int,
+	loader EventLoader) DraftProfileExecutor {
+
+	return draftProfile{ctx: ctx, name: name, device: device, limit: limit, loader: loader}
+}
+
+// observedResource accumulates what the readings of a resource tell about it.
+type observedResource struct {
+	name          string
+	types         map[string]bool
+	floatEncoding string
+	mediaType     string
+	units         string
+}
+
+// DraftProfile drafts a device profile named name describing the resources of the readings of the events emitted by
+// the device. The value type of a resource is the type given by its readings, or inferred from their values when they
+// have none, widened to Int64 when several integer types are read, to Float64 when both integers and floats are read
+// and to String when the readings disagree otherwise. The units of a resource are taken from the event tag named UnitsTagPrefix followed by the resource name.
+// The resources are ordered as first read, the oldest events first.
+func DraftProfile(name string, device string, events []contract.Event) contract.DeviceProfile {
+	sorted := make([]contract.Event, len(events))
+	copy(sorted, events)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Origin < sorted[j].Origin })
+
+	var observed []*observedResource
+	byName := make(map[string]*observedResource)
+	for _, e := range sorted {
+		for _, r := range e.Readings {
+			resource, ok := byName[r.Name]
+			if !ok {
+				resource = &observedResource{name: r.Name, types: make(map[string]bool)}
+				byName[r.Name] = resource
+				observed = append(observed, resource)
+			}
+			resource.types[readingType(r)] = true
+			if r.FloatEncoding != "" {
+				resource.floatEncoding = r.FloatEncoding
+			}
+			if r.MediaType != "" {
+				resource.mediaType = r.MediaType
+			}
+			if units, ok := e.Tags[UnitsTagPrefix+r.Name]; ok {
+				resource.units = units
+			}
+		}
+	}
+
+	profile := contract.DeviceProfile{
+		DescribedObject: contract.DescribedObject{
+			Description: fmt.Sprintf("Drafted from %d events of device %s", len(events), device),
+		},
+		Name:   name,
+		Labels: []string{DraftLabel},
+	}
+	for _, resource := range observed {
+		valueType := widenedType(resource.types)
+		value := contract.PropertyValue{Type: valueType, ReadWrite: "R"}
+		switch valueType {
+		case contract.ValueTypeFloat32, contract.ValueTypeFloat64:
+			value.FloatEncoding = resource.floatEncoding
+			if value.FloatEncoding == "" {
+				value.FloatEncoding = contract.ENotation
+			}
+		case contract.ValueTypeBinary:
+			value.MediaType = resource.mediaType
+		}
+
+		dr := contract.DeviceResource{
+			Description: fmt.Sprintf("%s read from device %s", resource.name, device),
+			Name:        resource.name,
+			Properties:  contract.ProfileProperty{Value: value},
+		}
+		if resource.units != "" {
+			dr.Properties.Units = contract.Units{
+				Type:         contract.ValueTypeString,
+				ReadWrite:    "R",
+				DefaultValue: resource.units,
+			}
+		}
+		profile.DeviceResources = append(profile.DeviceResources, dr)
+	}
+	return profile
+}
+
+// readingType returns the value type of the reading, inferred from its value when the reading doesn't give it.
+func readingType(r contract.Reading) string {
+	if r.ValueType != "" {
+		return r.ValueType
+	}
+	if len(r.BinaryValue) > 0 {
+		return contract.ValueTypeBinary
+	}
+	if _, err := strconv.ParseBool(r.Value); err == nil && strings.ContainsAny(r.Value, "tTfF") {
+		return contract.ValueTypeBool
+	}
+	if _, err := strconv.ParseInt(r.Value, 10, 64); err == nil {
+		return contract.ValueTypeInt64
+	}
+	if _, err := strconv.ParseFloat(r.Value, 64); err == nil {
+		return contract.ValueTypeFloat64
+	}
+	return contract.ValueTypeString
+}
+
+// widenedType returns the value type able to hold the values of all the types read.
+func widenedType(types map[string]bool) string {
+	if len(types) == 1 {
+		for t := range types {
+			return t
+		}
+	}
+	widened := contract.ValueTypeInt64
+	for t := range types {
+		switch t {
+		case contract.ValueTypeUint8, contract.ValueTypeUint16, contract.ValueTypeUint32, contract.ValueTypeUint64,
+			contract.ValueTypeInt8, contract.ValueTypeInt16, contract.ValueTypeInt32, contract.ValueTypeInt64:
+		case contract.ValueTypeFloat32, contract.ValueTypeFloat64:
+			widened = contract.ValueTypeFloat64
+		default:
+			return contract.ValueTypeString
+		}
+	}
+	return widened
+}
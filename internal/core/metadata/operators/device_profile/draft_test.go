@@ -0,0 +1,110 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package device_profile
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	metadataErrors "github.com/edgexfoundry/edgex-go/internal/core/metadata/errors"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/operators/device_profile/mocks"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDraftProfile(t *testing.T) {
+	// newest first, as returned by core data
+	events := []contract.Event{
+		{
+			Origin: 2,
+			Tags:   map[string]string{UnitsTagPrefix + "Temperature": "degC"},
+			Readings: []contract.Reading{
+				{Name: "Temperature", Value: "21.5"},
+				{Name: "Mode", Value: "cooling"},
+				{Name: "Snapshot", BinaryValue: []byte{1, 2}, MediaType: "image/jpeg"},
+			},
+		},
+		{
+			Origin: 1,
+			Readings: []contract.Reading{
+				{Name: "Temperature", Value: "21"},
+				{Name: "Running", Value: "true"},
+				{Name: "Mode", Value: "12"},
+				{Name: "Fan", Value: "3", ValueType: contract.ValueTypeUint8},
+			},
+		},
+	}
+
+	profile := DraftProfile("thermostat-profile", "thermostat", events)
+	assert.Equal(t, "thermostat-profile", profile.Name)
+	assert.Equal(t, []string{DraftLabel}, profile.Labels)
+
+	var names []string
+	values := make(map[string]contract.PropertyValue)
+	units := make(map[string]string)
+	for _, r := range profile.DeviceResources {
+		names = append(names, r.Name)
+		values[r.Name] = r.Properties.Value
+		units[r.Name] = r.Properties.Units.DefaultValue
+	}
+	assert.Equal(t, []string{"Temperature", "Running", "Mode", "Fan", "Snapshot"}, names)
+
+	assert.Equal(t, contract.ValueTypeFloat64, values["Temperature"].Type, "integers and floats widen to Float64")
+	assert.Equal(t, contract.ENotation, values["Temperature"].FloatEncoding)
+	assert.Equal(t, "degC", units["Temperature"])
+	assert.Equal(t, contract.ValueTypeBool, values["Running"].Type)
+	assert.Equal(t, contract.ValueTypeString, values["Mode"].Type, "disagreeing readings widen to String")
+	assert.Equal(t, contract.ValueTypeUint8, values["Fan"].Type, "the value type of the readings is kept")
+	assert.Equal(t, "", units["Fan"])
+	assert.Equal(t, contract.ValueTypeBinary, values["Snapshot"].Type)
+	assert.Equal(t, "image/jpeg", values["Snapshot"].MediaType)
+	assert.Equal(t, "R", values["Snapshot"].ReadWrite)
+}
+
+func TestWidenedType(t *testing.T) {
+	tests := []struct {
+		name     string
+		types    []string
+		expected string
+	}{
+		{"single", []string{contract.ValueTypeInt16}, contract.ValueTypeInt16},
+		{"integers", []string{contract.ValueTypeInt16, contract.ValueTypeUint32}, contract.ValueTypeInt64},
+		{"numbers", []string{contract.ValueTypeInt64, contract.ValueTypeFloat32}, contract.ValueTypeFloat64},
+		{"mixed", []string{contract.ValueTypeInt64, contract.ValueTypeBool}, contract.ValueTypeString},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			types := make(map[string]bool)
+			for _, typ := range tt.types {
+				types[typ] = true
+			}
+			assert.Equal(t, tt.expected, widenedType(types))
+		})
+	}
+}
+
+func TestDraftProfileExecutor(t *testing.T) {
+	loader := &mocks.EventLoader{}
+	events := []contract.Event{{Readings: []contract.Reading{{Name: "Temperature", Value: "21"}}}}
+	loader.On("EventsForDevice", context.Background(), "thermostat", 10).Return(events, nil)
+	loader.On("EventsForDevice", context.Background(), "silent", 10).Return([]contract.Event{}, nil)
+	loader.On("EventsForDevice", context.Background(), "unreachable", 10).Return(nil, errors.New("unreachable"))
+
+	profile, err := NewDraftProfileExecutor(context.Background(), "draft", "thermostat", 10, loader).Execute()
+	require.NoError(t, err)
+	require.Len(t, profile.DeviceResources, 1)
+	assert.Equal(t, contract.ValueTypeInt64, profile.DeviceResources[0].Properties.Value.Type)
+
+	_, err = NewDraftProfileExecutor(context.Background(), "draft", "silent", 10, loader).Execute()
+	assert.IsType(t, metadataErrors.ErrItemNotFound{}, err)
+
+	_, err = NewDraftProfileExecutor(context.Background(), "draft", "unreachable", 10, loader).Execute()
+	assert.Error(t, err)
+}
@@ -19,6 +19,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strconv"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/errors"
@@ -575,6 +576,70 @@ func restGetYamlProfileById(
 	w.Write(out)
 }
 
+// restDraftProfileFromEvents drafts a device profile from the latest events emitted by a device, fetched from core
+// data, for an operator to review and upload. It is output in a YAML formatted string. The profile is named after the
+// name query parameter, or the device followed by -profile.
+// 400 - the limit is invalid
+// 404 - the device emitted no event
+// 413 - the limit exceeds MaxResultCount
+// api/v1/deviceprofile/draft/device/{name}/{limit}
+func restDraftProfileFromEvents(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	loader device_profile.EventLoader,
+	errorHandler errorconcept.ErrorHandler,
+	configuration *config.ConfigurationStruct) {
+
+	vars := mux.Vars(r)
+	device, err := url.QueryUnescape(vars[NAME])
+	if err != nil {
+		errorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return
+	}
+	limit, err := strconv.Atoi(vars[LIMIT])
+	if err != nil || limit <= 0 {
+		errorHandler.Handle(w, errors.NewErrBadRequest(vars[LIMIT]), errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return
+	}
+	if limit > configuration.Service.MaxResultCount {
+		errorHandler.Handle(
+			w,
+			errors.NewErrLimitExceeded(configuration.Service.MaxResultCount),
+			errorconcept.Common.LimitExceeded)
+		return
+	}
+
+	name := r.URL.Query().Get(NAME)
+	if name == "" {
+		name = device + "-profile"
+	}
+
+	op := device_profile.NewDraftProfileExecutor(r.Context(), name, device, limit, loader)
+	dp, err := op.Execute()
+	if err != nil {
+		lc.Error(err.Error())
+		errorHandler.HandleManyVariants(
+			w,
+			err,
+			[]errorconcept.ErrorConceptType{
+				errorconcept.Common.ItemNotFound,
+				errorconcept.NewServiceClientHttpError(err),
+			},
+			errorconcept.Default.ServiceUnavailable)
+		return
+	}
+
+	out, err := yaml.Marshal(dp)
+	if err != nil {
+		errorHandler.Handle(w, err, errorconcept.DeviceProfile.MarshalYaml)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(out)
+}
+
 // Notify the associated device services for changes in the device profile
 func notifyProfileAssociates(
 	dp models.DeviceProfile,
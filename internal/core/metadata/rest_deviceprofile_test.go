@@ -14,6 +14,7 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/errors"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/interfaces"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/interfaces/mocks"
+	profileMocks "github.com/edgexfoundry/edgex-go/internal/core/metadata/operators/device_profile/mocks"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/errorconcept"
 
@@ -456,6 +457,77 @@ func TestGetYamlProfileByName(t *testing.T) {
 	}
 }
 
+func TestDraftProfileFromEvents(t *testing.T) {
+	device := "thermostat"
+	events := []contract.Event{
+		{Device: device, Readings: []contract.Reading{{Name: "Temperature", Value: "21.5"}}},
+	}
+	loader := &profileMocks.EventLoader{}
+	loader.On("EventsForDevice", mock.Anything, device, 10).Return(events, nil)
+	loader.On("EventsForDevice", mock.Anything, "silent", 10).Return([]contract.Event{}, nil)
+	loader.On("EventsForDevice", mock.Anything, "unreachable", 10).Return(nil, TestError)
+
+	tests := []struct {
+		name           string
+		request        *http.Request
+		expectedStatus int
+	}{
+		{
+			"OK",
+			createRequestWithPathParameters(http.MethodGet, map[string]string{NAME: device, LIMIT: "10"}),
+			http.StatusOK,
+		},
+		{
+			"Invalid limit",
+			createRequestWithPathParameters(http.MethodGet, map[string]string{NAME: device, LIMIT: "0"}),
+			http.StatusBadRequest,
+		},
+		{
+			"Limit exceeded",
+			createRequestWithPathParameters(http.MethodGet, map[string]string{NAME: device, LIMIT: "11"}),
+			http.StatusRequestEntityTooLarge,
+		},
+		{
+			"No event",
+			createRequestWithPathParameters(http.MethodGet, map[string]string{NAME: "silent", LIMIT: "10"}),
+			http.StatusNotFound,
+		},
+		{
+			"Core data error",
+			createRequestWithPathParameters(http.MethodGet, map[string]string{NAME: "unreachable", LIMIT: "10"}),
+			http.StatusServiceUnavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			restDraftProfileFromEvents(
+				rr,
+				tt.request,
+				logger.NewMockClient(),
+				loader,
+				errorconcept.NewErrorHandler(logger.NewMockClient()),
+				&metadataConfig.ConfigurationStruct{Service: bootstrapConfig.ServiceInfo{MaxResultCount: 10}})
+			response := rr.Result()
+			if response.StatusCode != tt.expectedStatus {
+				t.Errorf("status code mismatch -- expected %v got %v", tt.expectedStatus, response.StatusCode)
+				return
+			}
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var dp contract.DeviceProfile
+			if err := yaml.NewDecoder(response.Body).Decode(&dp); err != nil {
+				t.Fatal(err)
+			}
+			if dp.Name != device+"-profile" || len(dp.DeviceResources) != 1 {
+				t.Errorf("unexpected draft %v", dp)
+			}
+		})
+	}
+}
+
 func TestGetProfileByLabel(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -0,0 +1,143 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/errors"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/operators/device"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/errorconcept"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/notifications"
+
+	"github.com/gorilla/mux"
+)
+
+// Rename the device and move its events, readings and rollups in core-data to the new name, so that its history is
+// still queried under its name. Renaming again a device already renamed resumes the move of its data.
+// 404 - device not found
+// 409 - duplicate name
+// 503 - the data couldn't be moved by core-data
+// api/v1/device/name/{name}/rename/{newname}
+func restRenameDevice(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	errorHandler errorconcept.ErrorHandler,
+	nc notifications.NotificationsClient,
+	configuration *config.ConfigurationStruct) {
+
+	defer r.Body.Close()
+
+	vars := mux.Vars(r)
+	name, err := url.QueryUnescape(vars[NAME])
+	if err != nil {
+		errorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return
+	}
+	newName, err := url.QueryUnescape(vars[NEWNAME])
+	if err != nil {
+		errorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return
+	}
+	if newName == name {
+		err = errors.NewErrBadRequest(fmt.Sprintf("device %s already has the name %s", name, newName))
+		errorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	d, err := dbClient.GetDeviceByName(name)
+	switch {
+	case err == db.ErrNotFound:
+		// The device may have been renamed without its data having been moved
+		_, err = dbClient.GetDeviceByName(newName)
+		if err != nil {
+			if err == db.ErrNotFound {
+				err = errors.NewErrItemNotFound(fmt.Sprintf("device not found: %s", name))
+			}
+			errorHandler.HandleOneVariant(w, err, errorconcept.Common.ItemNotFound, errorconcept.Default.InternalServerError)
+			return
+		}
+		lc.Info(fmt.Sprintf("Device %s already renamed to %s, moving its data", name, newName))
+
+	case err != nil:
+		errorHandler.Handle(w, err, errorconcept.Default.InternalServerError)
+		return
+
+	default:
+		ch := make(chan device.DeviceEvent)
+		defer close(ch)
+
+		requester, err := device.NewRequester(device.Http, lc, ctx)
+		if err != nil {
+			errorHandler.Handle(w, err, errorconcept.Device.RequesterError)
+			return
+		}
+
+		notifier := device.NewNotifier(ch, nc, configuration.Notifications, dbClient, requester, lc, ctx)
+		go notifier.Execute()
+
+		d.Name = newName
+		err = device.NewUpdateDevice(ch, dbClient, d, lc).Execute()
+		if err != nil {
+			errorHandler.HandleManyVariants(
+				w,
+				err,
+				[]errorconcept.ErrorConceptType{
+					errorconcept.Common.DuplicateName,
+					errorconcept.Common.ItemNotFound,
+				},
+				errorconcept.Default.InternalServerError)
+			return
+		}
+	}
+
+	err = renameDeviceData(ctx, name, newName, configuration)
+	if err != nil {
+		lc.Error(err.Error())
+		errorHandler.Handle(w, err, errorconcept.Default.ServiceUnavailable)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("true"))
+}
+
+// renameDeviceData asks core-data to move the events, readings and rollups of the device to its new name.
+func renameDeviceData(ctx context.Context, from string, to string, configuration *config.ConfigurationStruct) error {
+	u := configuration.Clients["CoreData"].Url() + clients.ApiEventRoute + "/" + DEVICE + "/" + url.PathEscape(from) +
+		"/" + RENAME + "/" + url.PathEscape(to)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, u, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set(clients.CorrelationHeader, correlation.FromContext(ctx))
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to move the data of device %s to %s: %s", from, to, err.Error())
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("failed to move the data of device %s to %s, status code %d: %s", from, to, resp.StatusCode, body)
+	}
+	return nil
+}
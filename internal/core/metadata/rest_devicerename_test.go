@@ -0,0 +1,100 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metadata
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	metadataConfig "github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/errorconcept"
+
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestRenameDevice(t *testing.T) {
+	tests := []struct {
+		name           string
+		oldName        string
+		newName        string
+		oldFound       bool
+		newFound       bool
+		coreDataStatus int
+		expectedStatus int
+	}{
+		{"resumed", "old", "new", false, true, http.StatusOK, http.StatusOK},
+		{"core-data failure", "old", "new", false, true, http.StatusInternalServerError, http.StatusServiceUnavailable},
+		{"not found", "old", "new", false, false, http.StatusOK, http.StatusNotFound},
+		{"same name", "old", "old", true, true, http.StatusOK, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var renamed string
+			coreData := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				renamed = r.URL.Path
+				w.WriteHeader(tt.coreDataStatus)
+			}))
+			defer coreData.Close()
+
+			dbClientMock := &mocks.DBClient{}
+			if tt.oldFound {
+				dbClientMock.On("GetDeviceByName", tt.oldName).Return(contract.Device{Name: tt.oldName}, nil)
+			} else {
+				dbClientMock.On("GetDeviceByName", tt.oldName).Return(contract.Device{}, db.ErrNotFound)
+			}
+			if tt.newFound {
+				dbClientMock.On("GetDeviceByName", tt.newName).Return(contract.Device{Name: tt.newName}, nil)
+			} else {
+				dbClientMock.On("GetDeviceByName", tt.newName).Return(contract.Device{}, db.ErrNotFound)
+			}
+
+			req := httptest.NewRequest(http.MethodPut, "/", nil)
+			req = mux.SetURLVars(req, map[string]string{NAME: tt.oldName, NEWNAME: tt.newName})
+			rr := httptest.NewRecorder()
+			lc := logger.NewMockClient()
+			restRenameDevice(
+				rr,
+				req,
+				lc,
+				dbClientMock,
+				errorconcept.NewErrorHandler(lc),
+				nil,
+				testRenameConfiguration(t, coreData.URL))
+
+			assert.Equal(t, tt.expectedStatus, rr.Result().StatusCode)
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, clients.ApiEventRoute+"/device/old/rename/new", renamed)
+			}
+		})
+	}
+}
+
+func testRenameConfiguration(t *testing.T, coreDataUrl string) *metadataConfig.ConfigurationStruct {
+	u, err := url.Parse(coreDataUrl)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(u.Port())
+	require.NoError(t, err)
+
+	return &metadataConfig.ConfigurationStruct{
+		Clients: map[string]bootstrapConfig.ClientInfo{
+			"CoreData": {Protocol: u.Scheme, Host: u.Hostname(), Port: port},
+		},
+	}
+}
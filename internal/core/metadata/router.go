@@ -21,6 +21,8 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
 	errorContainer "github.com/edgexfoundry/edgex-go/internal/pkg/container"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dependency"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/secretref"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/telemetry"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
@@ -35,16 +37,19 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 	// Ping Resource
 	r.HandleFunc(
 		clients.ApiPingRoute,
-		func(w http.ResponseWriter, _ *http.Request) {
-			w.Header().Set(clients.ContentType, clients.ContentTypeText)
-			_, _ = w.Write([]byte("pong"))
+		func(w http.ResponseWriter, r *http.Request) {
+			dependency.Ping(
+				w,
+				r,
+				errorContainer.DependencyCheckerFrom(dic.Get),
+				bootstrapContainer.LoggingClientFrom(dic.Get))
 		}).Methods(http.MethodGet)
 
 	// Configuration
 	r.HandleFunc(
 		clients.ApiConfigRoute,
 		func(w http.ResponseWriter, _ *http.Request) {
-			pkg.Encode(metadataContainer.ConfigurationFrom(dic.Get), w, bootstrapContainer.LoggingClientFrom(dic.Get))
+			secretref.EncodeConfiguration(metadataContainer.ConfigurationFrom(dic.Get), w, dic)
 		}).Methods(http.MethodGet)
 
 	// Metrics
@@ -267,6 +272,18 @@ func loadDeviceRoutes(b *mux.Router, dic *di.Container) {
 				metadataContainer.NotificationsClientFrom(dic.Get),
 				metadataContainer.ConfigurationFrom(dic.Get))
 		}).Methods(http.MethodDelete)
+	n.HandleFunc(
+		"/{"+NAME+"}/"+RENAME+"/{"+NEWNAME+"}",
+		func(w http.ResponseWriter, r *http.Request) {
+			restRenameDevice(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get),
+				errorContainer.ErrorHandlerFrom(dic.Get),
+				metadataContainer.NotificationsClientFrom(dic.Get),
+				metadataContainer.ConfigurationFrom(dic.Get))
+		}).Methods(http.MethodPut)
 	n.HandleFunc(
 		"/{"+NAME+"}",
 		func(w http.ResponseWriter, r *http.Request) {
@@ -411,6 +428,17 @@ func loadDeviceProfileRoutes(b *mux.Router, dic *di.Container) {
 				metadataContainer.CoreDataValueDescriptorClientFrom(dic.Get),
 				metadataContainer.ConfigurationFrom(dic.Get))
 		}).Methods(http.MethodPost)
+	dp.HandleFunc(
+		"/"+DRAFT+"/"+DEVICE+"/{"+NAME+"}/{"+LIMIT+":[0-9]+}",
+		func(w http.ResponseWriter, r *http.Request) {
+			restDraftProfileFromEvents(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				metadataContainer.CoreDataEventClientFrom(dic.Get),
+				errorContainer.ErrorHandlerFrom(dic.Get),
+				metadataContainer.ConfigurationFrom(dic.Get))
+		}).Methods(http.MethodGet)
 	dp.HandleFunc(
 		"/"+MODEL+"/{"+MODEL+"}",
 		func(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,34 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"fmt"
+
+	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+)
+
+// MetadataChangesByTimeRange returns, for every metadata object changed within the time range, the consolidated
+// difference between its state before the first change and its state after the last one
+func MetadataChangesByTimeRange(start int, end int, dic *di.Container) (diffs []pkgModels.ObjectDiff, edgeXerr errors.EdgeX) {
+	if end < start {
+		return diffs, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("end's value %v is not allowed to be less than start's value %v", end, start), nil)
+	}
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	changes, edgeXerr := dbClient.MetadataChangesByTimeRange(start, end)
+	if edgeXerr != nil {
+		return diffs, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	diffs, err := pkgModels.ConsolidateChanges(changes)
+	if err != nil {
+		return diffs, errors.NewCommonEdgeX(errors.KindServerError, "metadata change format parsing failed", err)
+	}
+	return diffs, nil
+}
@@ -11,6 +11,7 @@ import (
 
 	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/di"
@@ -28,6 +29,10 @@ func AddDevice(d models.Device, ctx context.Context, dic *di.Container) (id stri
 	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
 	lc := container.LoggingClientFrom(dic.Get)
 
+	if _, _, err := pkgModels.ParseDeviceLocation(d.Location); err != nil {
+		return id, errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid device location", err)
+	}
+
 	exists, edgeXerr := dbClient.DeviceServiceNameExists(d.ServiceName)
 	if edgeXerr != nil {
 		return id, errors.NewCommonEdgeXWrapper(edgeXerr)
@@ -65,11 +70,15 @@ func DeleteDeviceById(id string, dic *di.Container) errors.EdgeX {
 		return errors.NewCommonEdgeX(errors.KindInvalidId, "fail to parse id as an UUID", err)
 	}
 	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
-	err = dbClient.DeleteDeviceById(id)
-	if err != nil {
-		return errors.NewCommonEdgeXWrapper(err)
+	device, edgeXerr := dbClient.DeviceById(id)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
-	return nil
+	edgeXerr = dbClient.DeleteDeviceById(id)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return deleteDeviceAliases(device.Name, dic)
 }
 
 // DeleteDeviceByName deletes the device by name
@@ -82,6 +91,17 @@ func DeleteDeviceByName(name string, dic *di.Container) errors.EdgeX {
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	return deleteDeviceAliases(name, dic)
+}
+
+// deleteDeviceAliases releases the aliases of a deleted device. The aliases aren't deleted along with the device in
+// the database since a device is deleted and added again when patched.
+func deleteDeviceAliases(name string, dic *di.Container) errors.EdgeX {
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	err := dbClient.DeleteDeviceAliases(name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
 	return nil
 }
 
@@ -166,6 +186,10 @@ func PatchDevice(dto dtos.UpdateDevice, ctx context.Context, dic *di.Container)
 
 	requests.ReplaceDeviceModelFieldsWithDTO(&device, dto)
 
+	if _, _, err := pkgModels.ParseDeviceLocation(device.Location); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid device location", err)
+	}
+
 	exists, edgeXerr := dbClient.DeviceServiceNameExists(device.ServiceName)
 	if edgeXerr != nil {
 		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("device service '%s' existence check failed", device.ServiceName), edgeXerr)
@@ -224,3 +248,88 @@ func DeviceByName(name string, dic *di.Container) (device dtos.Device, err error
 	device = dtos.FromDeviceModelToDTO(d)
 	return device, nil
 }
+
+// DevicesNear query the devices located within the radius (in meters) of the specified coordinates, nearest first
+func DevicesNear(offset int, limit int, latitude float64, longitude float64, radius float64, dic *di.Container) (devices []dtos.Device, err errors.EdgeX) {
+	if radius <= 0 {
+		return devices, errors.NewCommonEdgeX(errors.KindContractInvalid, "radius must be greater than zero", nil)
+	}
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	deviceModels, err := dbClient.DevicesNear(offset, limit, latitude, longitude, radius)
+	if err != nil {
+		return devices, errors.NewCommonEdgeXWrapper(err)
+	}
+	devices = make([]dtos.Device, len(deviceModels))
+	for i, d := range deviceModels {
+		devices[i] = dtos.FromDeviceModelToDTO(d)
+	}
+	return devices, nil
+}
+
+// DevicesByZone query the devices with offset, limit, and the zone of their location
+func DevicesByZone(offset int, limit int, zone string, dic *di.Container) (devices []dtos.Device, err errors.EdgeX) {
+	if zone == "" {
+		return devices, errors.NewCommonEdgeX(errors.KindContractInvalid, "zone is empty", nil)
+	}
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	deviceModels, err := dbClient.DevicesByZone(offset, limit, zone)
+	if err != nil {
+		return devices, errors.NewCommonEdgeXWrapper(err)
+	}
+	devices = make([]dtos.Device, len(deviceModels))
+	for i, d := range deviceModels {
+		devices[i] = dtos.FromDeviceModelToDTO(d)
+	}
+	return devices, nil
+}
+
+// SetDeviceAliases replaces the aliases of the device by name, e.g. its asset tag or a legacy SCADA tag
+func SetDeviceAliases(name string, aliases []string, ctx context.Context, dic *di.Container) errors.EdgeX {
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	if err := pkgModels.ValidateDeviceAliases(name, aliases); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid device aliases", err)
+	}
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	lc := container.LoggingClientFrom(dic.Get)
+
+	edgeXerr := dbClient.SetDeviceAliases(name, aliases)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	lc.Debug(fmt.Sprintf(
+		"Device aliases set on DB successfully. Device name: %s, Correlation-ID: %s ",
+		name,
+		correlation.FromContext(ctx),
+	))
+	return nil
+}
+
+// DeviceAliases query the aliases of the device by name
+func DeviceAliases(name string, dic *di.Container) (aliases []string, err errors.EdgeX) {
+	if name == "" {
+		return aliases, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	aliases, err = dbClient.DeviceAliases(name)
+	if err != nil {
+		return aliases, errors.NewCommonEdgeXWrapper(err)
+	}
+	return aliases, nil
+}
+
+// DeviceByAlias query the device by one of its aliases
+func DeviceByAlias(alias string, dic *di.Container) (device dtos.Device, err errors.EdgeX) {
+	if alias == "" {
+		return device, errors.NewCommonEdgeX(errors.KindContractInvalid, "alias is empty", nil)
+	}
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	d, err := dbClient.DeviceByAlias(alias)
+	if err != nil {
+		return device, errors.NewCommonEdgeXWrapper(err)
+	}
+	device = dtos.FromDeviceModelToDTO(d)
+	return device, nil
+}
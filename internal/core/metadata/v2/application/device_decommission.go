@@ -0,0 +1,262 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+// DecommissionDevice decommissions the device by name in a single call: it locks the device and removes its
+// autoevents, archives and/or deletes its events in core data according to the data policy, then deletes the
+// subscriptions labelled with the device alone and removes the label of the device from the other subscriptions.
+// The decommissioning stops at the first step failing. The certificate recording the outcome of every step is stored
+// and returned whether the decommissioning succeeds or not; decommissioning the device again resumes it.
+func DecommissionDevice(
+	name string,
+	dataPolicy string,
+	reason string,
+	requestedBy string,
+	ctx context.Context,
+	dic *di.Container) (certificate pkgModels.DecommissionCertificate, edgeXerr errors.EdgeX) {
+
+	if name == "" {
+		return certificate, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	if err := pkgModels.ValidateDataPolicy(dataPolicy); err != nil {
+		return certificate, errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid data policy", err)
+	}
+	if dataPolicy == "" {
+		dataPolicy = pkgModels.DataPolicyRetain
+	}
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	lc := container.LoggingClientFrom(dic.Get)
+
+	device, edgeXerr := dbClient.DeviceByName(name)
+	if edgeXerr != nil {
+		return certificate, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	certificate = pkgModels.DecommissionCertificate{
+		DeviceId:    device.Id,
+		DeviceName:  device.Name,
+		ProfileName: device.ProfileName,
+		ServiceName: device.ServiceName,
+		DataPolicy:  dataPolicy,
+		Reason:      reason,
+		RequestedBy: requestedBy,
+		Started:     common.MakeTimestamp(),
+	}
+	edgeXerr = decommissionDevice(device, &certificate, ctx, dic)
+
+	certificate.Completed = common.MakeTimestamp()
+	certificate.Status = pkgModels.DecommissionCompleted
+	if edgeXerr != nil {
+		certificate.Status = pkgModels.DecommissionFailed
+	}
+	digest, err := certificate.ComputeDigest()
+	if err != nil {
+		return certificate, errors.NewCommonEdgeX(errors.KindServerError, "decommission certificate digest failed", err)
+	}
+	certificate.Digest = digest
+	if err := dbClient.AddDecommissionCertificate(certificate); err != nil {
+		lc.Error(fmt.Sprintf("failed to store the decommission certificate of device %s: %s", name, err.Error()))
+		if edgeXerr == nil {
+			edgeXerr = errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+
+	if edgeXerr != nil {
+		return certificate, edgeXerr
+	}
+	lc.Info(fmt.Sprintf(
+		"Device %s decommissioned with data policy %s. Correlation-ID: %s ",
+		name,
+		dataPolicy,
+		correlation.FromContext(ctx),
+	))
+	return certificate, nil
+}
+
+// DecommissionCertificate query the latest decommission certificate of the device by name
+func DecommissionCertificate(name string, dic *di.Container) (certificate pkgModels.DecommissionCertificate, err errors.EdgeX) {
+	if name == "" {
+		return certificate, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	certificate, err = dbClient.DecommissionCertificate(name)
+	if err != nil {
+		return certificate, errors.NewCommonEdgeXWrapper(err)
+	}
+	return certificate, nil
+}
+
+// decommissionDevice takes the steps of the decommissioning in order, recording their outcome in the certificate,
+// until one of them fails.
+func decommissionDevice(device models.Device, certificate *pkgModels.DecommissionCertificate, ctx context.Context, dic *di.Container) errors.EdgeX {
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	configuration := metadataContainer.ConfigurationFrom(dic.Get)
+	coreDataUrl := configuration.Clients["CoreData"].Url() + clients.ApiEventRoute + "/device/" + url.PathEscape(device.Name)
+	notificationsUrl := configuration.Clients["Notifications"].Url() + clients.ApiSubscriptionRoute
+
+	// The device is locked and its autoevents removed with a single write, recorded in the metadata change log like
+	// any other update of the device.
+	if device.AdminState != models.Locked || len(device.AutoEvents) > 0 {
+		for _, autoEvent := range device.AutoEvents {
+			certificate.RemovedAutoEvents = append(certificate.RemovedAutoEvents, autoEvent.Resource)
+		}
+		device.AdminState = models.Locked
+		device.AutoEvents = nil
+		edgeXerr := dbClient.DeleteDeviceById(device.Id)
+		if edgeXerr == nil {
+			_, edgeXerr = dbClient.AddDevice(device)
+		}
+		if edgeXerr != nil {
+			recordStep(certificate, pkgModels.DecommissionStepLock, pkgModels.DecommissionFailed, edgeXerr.Error())
+			return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to lock device %s", device.Name), edgeXerr)
+		}
+	}
+	recordStep(certificate, pkgModels.DecommissionStepLock, pkgModels.DecommissionCompleted, "")
+	recordStep(certificate, pkgModels.DecommissionStepRemoveAutoEvents, pkgModels.DecommissionCompleted,
+		fmt.Sprintf("%d autoevents removed", len(certificate.RemovedAutoEvents)))
+
+	if !pkgModels.ArchivesData(certificate.DataPolicy) {
+		recordStep(certificate, pkgModels.DecommissionStepArchiveData, pkgModels.DecommissionSkipped, "")
+	} else {
+		var archive pkgModels.DataArchive
+		if _, err := callService(ctx, http.MethodPost, coreDataUrl+"/archive", nil, &archive); err != nil {
+			recordStep(certificate, pkgModels.DecommissionStepArchiveData, pkgModels.DecommissionFailed, err.Error())
+			return errors.NewCommonEdgeX(errors.KindServiceUnavailable, fmt.Sprintf("fail to archive the events of device %s", device.Name), err)
+		}
+		certificate.Archive = &archive
+		recordStep(certificate, pkgModels.DecommissionStepArchiveData, pkgModels.DecommissionCompleted,
+			fmt.Sprintf("%d events archived to %s", archive.Events, archive.File))
+	}
+
+	if !pkgModels.DeletesData(certificate.DataPolicy) {
+		recordStep(certificate, pkgModels.DecommissionStepDeleteData, pkgModels.DecommissionSkipped, "")
+	} else {
+		if _, err := callService(ctx, http.MethodDelete, coreDataUrl, nil, &certificate.DeletedEvents); err != nil {
+			recordStep(certificate, pkgModels.DecommissionStepDeleteData, pkgModels.DecommissionFailed, err.Error())
+			return errors.NewCommonEdgeX(errors.KindServiceUnavailable, fmt.Sprintf("fail to delete the events of device %s", device.Name), err)
+		}
+		recordStep(certificate, pkgModels.DecommissionStepDeleteData, pkgModels.DecommissionCompleted,
+			fmt.Sprintf("%d events deleted", certificate.DeletedEvents))
+	}
+
+	if err := removeDeviceSubscriptions(device.Name, notificationsUrl, certificate, ctx); err != nil {
+		recordStep(certificate, pkgModels.DecommissionStepRemoveSubscriptions, pkgModels.DecommissionFailed, err.Error())
+		return errors.NewCommonEdgeX(errors.KindServiceUnavailable, fmt.Sprintf("fail to remove the subscriptions of device %s", device.Name), err)
+	}
+	recordStep(certificate, pkgModels.DecommissionStepRemoveSubscriptions, pkgModels.DecommissionCompleted,
+		fmt.Sprintf("%d subscriptions deleted, %d updated", len(certificate.DeletedSubscriptions), len(certificate.UpdatedSubscriptions)))
+	return nil
+}
+
+// removeDeviceSubscriptions deletes the subscriptions labelled with the device alone and removes the label of the
+// device from the other subscriptions labelled with it.
+func removeDeviceSubscriptions(name string, notificationsUrl string, certificate *pkgModels.DecommissionCertificate, ctx context.Context) error {
+	var subscriptions []contract.Subscription
+	statusCode, err := callService(ctx, http.MethodGet, notificationsUrl+"/labels/"+url.PathEscape(name), nil, &subscriptions)
+	if statusCode == http.StatusNotFound {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	for _, s := range subscriptions {
+		var labels []string
+		for _, label := range s.SubscribedLabels {
+			if label != name {
+				labels = append(labels, label)
+			}
+		}
+		if len(labels) == 0 {
+			if _, err = callService(ctx, http.MethodDelete, notificationsUrl+"/slug/"+url.PathEscape(s.Slug), nil, nil); err != nil {
+				return err
+			}
+			certificate.DeletedSubscriptions = append(certificate.DeletedSubscriptions, s.Slug)
+			continue
+		}
+		s.SubscribedLabels = labels
+		if _, err = callService(ctx, http.MethodPut, notificationsUrl, s, nil); err != nil {
+			return err
+		}
+		certificate.UpdatedSubscriptions = append(certificate.UpdatedSubscriptions, s.Slug)
+	}
+	return nil
+}
+
+// recordStep records the outcome of a step of the decommissioning in the certificate.
+func recordStep(certificate *pkgModels.DecommissionCertificate, name string, status string, detail string) {
+	certificate.Steps = append(certificate.Steps, pkgModels.DecommissionStep{
+		Name:      name,
+		Status:    status,
+		Detail:    detail,
+		Completed: common.MakeTimestamp(),
+	})
+}
+
+// callService sends a request, with the JSON body when not nil, to another EdgeX service and decodes the JSON response
+// into result when not nil. It returns the status code of the response, which is an error unless 2xx.
+func callService(ctx context.Context, method string, u string, body interface{}, result interface{}) (int, error) {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return 0, err
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, reader)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set(clients.CorrelationHeader, correlation.FromContext(ctx))
+	if body != nil {
+		req.Header.Set(clients.ContentType, clients.ContentTypeJSON)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return resp.StatusCode, err
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return resp.StatusCode, fmt.Errorf("%s %s failed with status %d: %s", method, u, resp.StatusCode, string(bytes.TrimSpace(data)))
+	}
+	if result != nil {
+		if err = json.Unmarshal(data, result); err != nil {
+			return resp.StatusCode, fmt.Errorf("unable to decode the response of %s %s: %w", method, u, err)
+		}
+	}
+	return resp.StatusCode, nil
+}
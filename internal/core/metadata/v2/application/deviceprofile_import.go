@@ -0,0 +1,137 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	bootstrapContainer "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+)
+
+const defaultProfileImportTimeout = 10 * time.Second
+
+// FetchDeviceProfileYaml downloads the YAML content of a device profile from the HTTP(S) URI, which must be within one
+// of the device profile registries of the configuration. When the registry has a SecretPath, the username and
+// password stored under that path of the secret store are sent as basic authentication credentials.
+func FetchDeviceProfileYaml(uri string, ctx context.Context, dic *di.Container) ([]byte, errors.EdgeX) {
+	lc := container.LoggingClientFrom(dic.Get)
+	info := metadataContainer.ConfigurationFrom(dic.Get).ProfileImport
+
+	parsedUri, err := url.Parse(uri)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid device profile uri %s", uri), err)
+	}
+	scheme := strings.ToLower(parsedUri.Scheme)
+	if (scheme != "http" && scheme != "https") || parsedUri.Host == "" || parsedUri.User != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device profile uri %s must be an absolute http or https uri without credentials", uri), nil)
+	}
+	// the path is cleaned so that dot segments can't escape the base URL of the registry
+	parsedUri.Path = path.Clean("/" + parsedUri.Path)
+	parsedUri.RawPath = ""
+	registry, ok := findProfileRegistry(info.Registries, parsedUri)
+	if !ok {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device profile uri %s is not within a configured device profile registry", uri), nil)
+	}
+
+	timeout := defaultProfileImportTimeout
+	if info.Timeout != "" {
+		timeout, err = time.ParseDuration(info.Timeout)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("invalid ProfileImport Timeout %s", info.Timeout), err)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, parsedUri.String(), nil)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to create device profile request", err)
+	}
+	req.Header.Set(clients.CorrelationHeader, correlation.FromContext(ctx))
+
+	if registry.SecretPath != "" {
+		secrets, err := bootstrapContainer.SecretProviderFrom(dic.Get).GetSecrets(registry.SecretPath, "username", "password")
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to retrieve the credentials of the device profile registry from secret path %s", registry.SecretPath), err)
+		}
+		req.SetBasicAuth(secrets["username"], secrets["password"])
+	}
+
+	client := &http.Client{
+		Timeout: timeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 10 {
+				return fmt.Errorf("stopped after %d redirects", len(via))
+			}
+			if !withinProfileRegistry(registry, req.URL) {
+				return fmt.Errorf("redirected to %s, outside the device profile registry", req.URL.Redacted())
+			}
+			return nil
+		},
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServiceUnavailable, fmt.Sprintf("failed to fetch device profile from %s", uri), err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.NewCommonEdgeX(errors.KindServiceUnavailable, fmt.Sprintf("failed to fetch device profile from %s, status code %d", uri, resp.StatusCode), nil)
+	}
+
+	var body io.Reader = resp.Body
+	if info.MaxFileSize > 0 {
+		// read one extra byte so oversized content can be told apart from content of exactly the maximum size
+		body = io.LimitReader(resp.Body, info.MaxFileSize+1)
+	}
+	data, err := ioutil.ReadAll(body)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to read device profile from %s", uri), err)
+	}
+	if info.MaxFileSize > 0 && int64(len(data)) > info.MaxFileSize {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device profile from %s exceeds the maximum size of %d bytes", uri, info.MaxFileSize), nil)
+	}
+
+	lc.Debug(fmt.Sprintf("Fetched device profile from %s. Correlation-id: %s", uri, correlation.FromContext(ctx)))
+	return data, nil
+}
+
+// findProfileRegistry returns the registry the URI is within.
+func findProfileRegistry(registries map[string]config.ProfileRegistryInfo, uri *url.URL) (config.ProfileRegistryInfo, bool) {
+	for _, registry := range registries {
+		if withinProfileRegistry(registry, uri) {
+			return registry, true
+		}
+	}
+	return config.ProfileRegistryInfo{}, false
+}
+
+// withinProfileRegistry reports whether the URI has the same scheme and host as the base URL of the registry, and a
+// path starting with its path.
+func withinProfileRegistry(registry config.ProfileRegistryInfo, uri *url.URL) bool {
+	base, err := url.Parse(registry.Url)
+	if err != nil || base.Host == "" {
+		return false
+	}
+	if !strings.EqualFold(base.Scheme, uri.Scheme) || !strings.EqualFold(base.Host, uri.Host) {
+		return false
+	}
+	return strings.HasPrefix(path.Clean("/"+uri.Path)+"/", strings.TrimSuffix(base.Path, "/")+"/")
+}
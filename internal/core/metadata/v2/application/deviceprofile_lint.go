@@ -0,0 +1,37 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/lint"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+)
+
+// LintDeviceProfile checks the device profile against the configured lint rules. The rules file is read on every
+// call, so that rule changes apply without restarting the service.
+func LintDeviceProfile(dp dtos.DeviceProfile, dic *di.Container) (report lint.Report, edgeXerr errors.EdgeX) {
+	config := metadataContainer.ConfigurationFrom(dic.Get).ProfileLint
+	linter, err := lint.LoadLinter(config.RulesFile)
+	if err != nil {
+		return report, errors.NewCommonEdgeX(errors.KindServerError, "failed to load the device profile lint rules", err)
+	}
+	return linter.Lint(dp), nil
+}
+
+// LintDeviceProfileByName checks the stored device profile with the name against the configured lint rules
+func LintDeviceProfileByName(name string, ctx context.Context, dic *di.Container) (report lint.Report, edgeXerr errors.EdgeX) {
+	dp, edgeXerr := DeviceProfileByName(name, ctx, dic)
+	if edgeXerr != nil {
+		return report, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return LintDeviceProfile(dp, dic)
+}
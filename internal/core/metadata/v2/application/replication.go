@@ -0,0 +1,121 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/infrastructure/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/replication"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+// ReplicationPosition returns the position of the last metadata change applied by this standby instance
+func ReplicationPosition(dic *di.Container) (position replication.Position, edgeXerr errors.EdgeX) {
+	if edgeXerr = checkStandby(dic); edgeXerr != nil {
+		return position, edgeXerr
+	}
+	return v2MetadataContainer.ReplicationTrackerFrom(dic.Get).Position(), nil
+}
+
+// ApplyMetadataChanges applies the metadata changes received from the primary instance, skipping the ones applied
+// already, and returns the new replication position
+func ApplyMetadataChanges(changes []pkgModels.MetadataChange, dic *di.Container) (position replication.Position, edgeXerr errors.EdgeX) {
+	if edgeXerr = checkStandby(dic); edgeXerr != nil {
+		return position, edgeXerr
+	}
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	tracker := v2MetadataContainer.ReplicationTrackerFrom(dic.Get)
+	position, err := tracker.Apply(changes, func(change pkgModels.MetadataChange) error {
+		return applyMetadataChange(change, dbClient)
+	})
+	if err != nil {
+		return position, errors.NewCommonEdgeXWrapper(err)
+	}
+	return position, nil
+}
+
+func checkStandby(dic *di.Container) errors.EdgeX {
+	mode := metadataContainer.ConfigurationFrom(dic.Get).Replication.Mode
+	if mode != config.ReplicationModeStandby {
+		return errors.NewCommonEdgeX(errors.KindServiceUnavailable, "the metadata service is not configured as a replication standby", nil)
+	}
+	return nil
+}
+
+// applyMetadataChange writes the state of the object after the change. Applying a change twice leaves the same state:
+// a deletion ignores a missing object and an addition first removes the object with the same id or name.
+func applyMetadataChange(change pkgModels.MetadataChange, dbClient interfaces.DBClient) errors.EdgeX {
+	deleted := len(change.After) == 0
+	switch change.ObjectType {
+	case pkgModels.DeviceServiceObject:
+		edgeXerr := ignoreMissing(dbClient.DeleteDeviceServiceById(change.ObjectId))
+		if deleted || edgeXerr != nil {
+			return edgeXerr
+		}
+		var ds models.DeviceService
+		if edgeXerr = unmarshalChange(change, &ds); edgeXerr != nil {
+			return edgeXerr
+		}
+		if edgeXerr = ignoreMissing(dbClient.DeleteDeviceServiceByName(ds.Name)); edgeXerr != nil {
+			return edgeXerr
+		}
+		_, edgeXerr = dbClient.AddDeviceService(ds)
+		return edgeXerr
+	case pkgModels.DeviceProfileObject:
+		edgeXerr := ignoreMissing(dbClient.DeleteDeviceProfileById(change.ObjectId))
+		if deleted || edgeXerr != nil {
+			return edgeXerr
+		}
+		var dp models.DeviceProfile
+		if edgeXerr = unmarshalChange(change, &dp); edgeXerr != nil {
+			return edgeXerr
+		}
+		if edgeXerr = ignoreMissing(dbClient.DeleteDeviceProfileByName(dp.Name)); edgeXerr != nil {
+			return edgeXerr
+		}
+		_, edgeXerr = dbClient.AddDeviceProfile(dp)
+		return edgeXerr
+	case pkgModels.DeviceObject:
+		edgeXerr := ignoreMissing(dbClient.DeleteDeviceById(change.ObjectId))
+		if deleted || edgeXerr != nil {
+			return edgeXerr
+		}
+		var d models.Device
+		if edgeXerr = unmarshalChange(change, &d); edgeXerr != nil {
+			return edgeXerr
+		}
+		if edgeXerr = ignoreMissing(dbClient.DeleteDeviceByName(d.Name)); edgeXerr != nil {
+			return edgeXerr
+		}
+		_, edgeXerr = dbClient.AddDevice(d)
+		return edgeXerr
+	default:
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unknown metadata object type %s in change %d", change.ObjectType, change.Sequence), nil)
+	}
+}
+
+func unmarshalChange(change pkgModels.MetadataChange, object interface{}) errors.EdgeX {
+	if err := json.Unmarshal(change.After, object); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse the %s of change %d", change.ObjectType, change.Sequence), err)
+	}
+	return nil
+}
+
+func ignoreMissing(edgeXerr errors.EdgeX) errors.EdgeX {
+	if edgeXerr != nil && errors.Kind(edgeXerr) != errors.KindEntityDoesNotExist {
+		return edgeXerr
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/replication"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// ReplicationTrackerName contains the name of the replication.Tracker implementation in the DIC.
+var ReplicationTrackerName = di.TypeInstanceToName(replication.Tracker{})
+
+// ReplicationTrackerFrom helper function queries the DIC and returns the replication.Tracker implementation.
+func ReplicationTrackerFrom(get di.Get) *replication.Tracker {
+	return get(ReplicationTrackerName).(*replication.Tracker)
+}
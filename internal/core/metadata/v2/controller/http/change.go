@@ -0,0 +1,82 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/application"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/utils"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+)
+
+// MetadataChangesResponse defines the response of the metadata changes API
+type MetadataChangesResponse struct {
+	commonDTO.BaseResponse `json:",inline"`
+	Start                  int                    `json:"start"`
+	End                    int                    `json:"end"`
+	Changes                []pkgModels.ObjectDiff `json:"changes"`
+}
+
+type MetadataChangeController struct {
+	dic *di.Container
+}
+
+// NewMetadataChangeController creates and initializes an MetadataChangeController
+func NewMetadataChangeController(dic *di.Container) *MetadataChangeController {
+	return &MetadataChangeController{
+		dic: dic,
+	}
+}
+
+// MetadataChangesByTimeRange returns what was added, modified and deleted in the metadata between start and end
+func (mc *MetadataChangeController) MetadataChangesByTimeRange(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(mc.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	var response interface{}
+	var statusCode int
+
+	start, err := utils.ParsePathParamToInt(r, v2.Start)
+	var end int
+	if err == nil {
+		end, err = utils.ParsePathParamToInt(r, v2.End)
+	}
+	var diffs []pkgModels.ObjectDiff
+	if err == nil {
+		diffs, err = application.MetadataChangesByTimeRange(start, end, mc.dic)
+	}
+
+	if err != nil {
+		if errors.Kind(err) != errors.KindEntityDoesNotExist {
+			lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		}
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+		statusCode = err.Code()
+	} else {
+		response = MetadataChangesResponse{
+			BaseResponse: commonDTO.NewBaseResponse("", "", http.StatusOK),
+			Start:        start,
+			End:          end,
+			Changes:      diffs,
+		}
+		statusCode = http.StatusOK
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
@@ -0,0 +1,75 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/constant"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetadataChangesByTimeRange(t *testing.T) {
+	added, err := pkgModels.NewMetadataChange(pkgModels.DeviceObject, ExampleUUID, TestDeviceName, 150, nil, map[string]interface{}{"name": TestDeviceName})
+	require.NoError(t, err)
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("MetadataChangesByTimeRange", 100, 200).Return([]pkgModels.MetadataChange{added}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewMetadataChangeController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		start              string
+		end                string
+		expectedStatusCode int
+		expectedChanges    int
+	}{
+		{"Valid - time range", "100", "200", http.StatusOK, 1},
+		{"Invalid - start is not a number", "abc", "200", http.StatusBadRequest, 0},
+		{"Invalid - end before start", "200", "100", http.StatusBadRequest, 0},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, constant.ApiMetadataChangeByTimeRangeRoute, http.NoBody)
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{v2.Start: testCase.start, v2.End: testCase.end})
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.MetadataChangesByTimeRange)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			var res MetadataChangesResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedChanges, len(res.Changes), "Change count not as expected")
+			if testCase.expectedChanges > 0 {
+				assert.Equal(t, pkgModels.ChangeAdded, res.Changes[0].Action)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/constant"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetDeviceAliases(t *testing.T) {
+	device := dtos.ToDeviceModel(buildTestDeviceRequest().Device)
+	aliases := []string{"ASSET-0042", "PLC1.TT101"}
+	takenAliases := []string{"ASSET-0007"}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("SetDeviceAliases", device.Name, aliases).Return(nil)
+	dbClientMock.On("SetDeviceAliases", device.Name, takenAliases).Return(errors.NewCommonEdgeX(errors.KindDuplicateName, "device alias ASSET-0007 is already used by device other", nil))
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeviceController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		aliases            []string
+		expectedStatusCode int
+	}{
+		{"Valid - set device aliases", aliases, http.StatusOK},
+		{"Invalid - duplicated alias", []string{"ASSET-0042", "ASSET-0042"}, http.StatusBadRequest},
+		{"Invalid - alias of another device", takenAliases, http.StatusConflict},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			body, err := json.Marshal(DeviceAliasesRequest{BaseRequest: common.BaseRequest{RequestId: ExampleUUID}, Aliases: testCase.aliases})
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPut, constant.ApiDeviceAliasesByNameRoute, bytes.NewReader(body))
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{v2.Name: device.Name})
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.SetDeviceAliases)
+			handler.ServeHTTP(recorder, req)
+			var res common.BaseResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+			assert.Equal(t, ExampleUUID, res.RequestId, "RequestID not as expected")
+		})
+	}
+}
+
+func TestDeviceByAlias(t *testing.T) {
+	device := dtos.ToDeviceModel(buildTestDeviceRequest().Device)
+	alias := "ASSET-0042"
+	notFoundAlias := "ASSET-9999"
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceByAlias", alias).Return(device, nil)
+	dbClientMock.On("DeviceByAlias", notFoundAlias).Return(models.Device{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device alias doesn't exist in the database", nil))
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeviceController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		alias              string
+		expectedStatusCode int
+	}{
+		{"Valid - find device by alias", alias, http.StatusOK},
+		{"Invalid - alias parameter is empty", "", http.StatusBadRequest},
+		{"Invalid - device not found by alias", notFoundAlias, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, constant.ApiDeviceByAliasRoute, http.NoBody)
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{constant.Alias: testCase.alias})
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.DeviceByAlias)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				var res responseDTO.DeviceResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, device.Name, res.Device.Name, "Name not as expected")
+			}
+		})
+	}
+}
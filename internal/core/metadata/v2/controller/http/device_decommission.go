@@ -0,0 +1,121 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/application"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/utils"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+
+	"github.com/gorilla/mux"
+)
+
+// DecommissionDeviceRequest defines the request decommissioning a device. DataPolicy is one of retain, the default,
+// archive, delete and archiveAndDelete.
+type DecommissionDeviceRequest struct {
+	commonDTO.BaseRequest `json:",inline"`
+	DataPolicy            string `json:"dataPolicy,omitempty"`
+	Reason                string `json:"reason,omitempty"`
+	RequestedBy           string `json:"requestedBy,omitempty"`
+}
+
+// DecommissionCertificateResponse defines the response carrying the decommission certificate of a device
+type DecommissionCertificateResponse struct {
+	commonDTO.BaseResponse `json:",inline"`
+	Certificate            pkgModels.DecommissionCertificate `json:"certificate"`
+}
+
+func (dc *DeviceController) DecommissionDevice(w http.ResponseWriter, r *http.Request) {
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	// URL parameters
+	vars := mux.Vars(r)
+	name := vars[v2.Name]
+
+	var request DecommissionDeviceRequest
+	var certificate pkgModels.DecommissionCertificate
+	var err errors.EdgeX
+	if decodeErr := json.NewDecoder(r.Body).Decode(&request); decodeErr != nil {
+		err = errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode the decommission request", decodeErr)
+	} else {
+		certificate, err = application.DecommissionDevice(name, request.DataPolicy, request.Reason, request.RequestedBy, ctx, dc.dic)
+	}
+
+	var response interface{}
+	var statusCode int
+	if err != nil {
+		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		statusCode = err.Code()
+		if certificate.Started == 0 {
+			response = commonDTO.NewBaseResponse(request.RequestId, err.Message(), statusCode)
+		} else {
+			// the decommissioning started, the certificate tells which steps were taken
+			response = DecommissionCertificateResponse{
+				BaseResponse: commonDTO.NewBaseResponse(request.RequestId, err.Message(), statusCode),
+				Certificate:  certificate,
+			}
+		}
+	} else {
+		statusCode = http.StatusOK
+		response = DecommissionCertificateResponse{
+			BaseResponse: commonDTO.NewBaseResponse(request.RequestId, "", statusCode),
+			Certificate:  certificate,
+		}
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
+
+func (dc *DeviceController) DecommissionCertificate(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	// URL parameters
+	vars := mux.Vars(r)
+	name := vars[v2.Name]
+
+	var response interface{}
+	var statusCode int
+
+	certificate, err := application.DecommissionCertificate(name, dc.dic)
+	if err != nil {
+		if errors.Kind(err) != errors.KindEntityDoesNotExist {
+			lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		}
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+		statusCode = err.Code()
+	} else {
+		response = DecommissionCertificateResponse{
+			BaseResponse: commonDTO.NewBaseResponse("", "", http.StatusOK),
+			Certificate:  certificate,
+		}
+		statusCode = http.StatusOK
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
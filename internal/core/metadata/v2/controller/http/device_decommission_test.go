@@ -0,0 +1,296 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/constant"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeServices plays core data and support notifications, recording the requests received.
+type fakeServices struct {
+	mutex         sync.Mutex
+	requests      []string
+	updated       []contract.Subscription
+	failArchive   bool
+	subscriptions []contract.Subscription
+}
+
+func (f *fakeServices) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.requests = append(f.requests, r.Method+" "+r.URL.EscapedPath())
+
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/api/v1/event/device/"+TestDeviceName+"/archive":
+		if f.failArchive {
+			http.Error(w, "disk full", http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write([]byte(`{"device":"TestDevice","file":"archive/TestDevice.jsonl.gz","events":5,"sha256":"abc","created":1}`))
+	case r.Method == http.MethodDelete && r.URL.Path == "/api/v1/event/device/"+TestDeviceName:
+		_, _ = w.Write([]byte("5"))
+	case r.Method == http.MethodGet && r.URL.Path == "/api/v1/subscription/labels/"+TestDeviceName:
+		if len(f.subscriptions) == 0 {
+			http.Error(w, "Subscription not found", http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(f.subscriptions)
+	case r.Method == http.MethodDelete:
+	case r.Method == http.MethodPut && r.URL.Path == "/api/v1/subscription":
+		var s contract.Subscription
+		_ = json.NewDecoder(r.Body).Decode(&s)
+		f.updated = append(f.updated, s)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func decommissionDic(t *testing.T, services *fakeServices, dbClientMock *dbMock.DBClient) (*di.Container, func()) {
+	server := httptest.NewServer(services)
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(u.Port())
+	require.NoError(t, err)
+	client := bootstrapConfig.ClientInfo{Protocol: "http", Host: u.Hostname(), Port: port}
+
+	dic := mockDic()
+	dic.Update(di.ServiceConstructorMap{
+		metadataContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				Clients: map[string]bootstrapConfig.ClientInfo{"CoreData": client, "Notifications": client},
+			}
+		},
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	return dic, server.Close
+}
+
+func decommissionRequest(t *testing.T, name string, policy string) *http.Request {
+	body, err := json.Marshal(DecommissionDeviceRequest{
+		BaseRequest: common.BaseRequest{RequestId: ExampleUUID},
+		DataPolicy:  policy,
+		Reason:      "replaced by a new thermostat",
+		RequestedBy: "operator",
+	})
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodPost, constant.ApiDeviceDecommissionByNameRoute, bytes.NewReader(body))
+	require.NoError(t, err)
+	return mux.SetURLVars(req, map[string]string{v2.Name: name})
+}
+
+func TestDecommissionDevice(t *testing.T) {
+	device := models.Device{
+		Id:          ExampleUUID,
+		Name:        TestDeviceName,
+		ServiceName: TestDeviceServiceName,
+		ProfileName: TestDeviceProfileName,
+		AdminState:  models.Unlocked,
+		AutoEvents:  []models.AutoEvent{{Resource: "Temperature", Frequency: "10s"}},
+	}
+	locked := device
+	locked.AdminState = models.Locked
+	locked.AutoEvents = nil
+
+	services := &fakeServices{subscriptions: []contract.Subscription{
+		{Slug: "thermostat-alarms", SubscribedLabels: []string{TestDeviceName}},
+		{Slug: "site-alarms", SubscribedLabels: []string{TestDeviceName, "site"}},
+	}}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceByName", TestDeviceName).Return(device, nil)
+	dbClientMock.On("DeleteDeviceById", ExampleUUID).Return(nil)
+	dbClientMock.On("AddDevice", locked).Return(locked, nil)
+	var stored pkgModels.DecommissionCertificate
+	dbClientMock.On("AddDecommissionCertificate", mock.Anything).Run(func(args mock.Arguments) {
+		stored = args.Get(0).(pkgModels.DecommissionCertificate)
+	}).Return(nil)
+	dic, closeServer := decommissionDic(t, services, dbClientMock)
+	defer closeServer()
+
+	controller := NewDeviceController(dic)
+	recorder := httptest.NewRecorder()
+	handler := http.HandlerFunc(controller.DecommissionDevice)
+	handler.ServeHTTP(recorder, decommissionRequest(t, TestDeviceName, pkgModels.DataPolicyArchiveAndDelete))
+
+	var res DecommissionCertificateResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+	assert.Equal(t, http.StatusOK, recorder.Result().StatusCode, "HTTP status code not as expected")
+	assert.Equal(t, ExampleUUID, res.RequestId, "RequestID not as expected")
+	dbClientMock.AssertExpectations(t)
+
+	certificate := res.Certificate
+	assert.Equal(t, stored, certificate, "the certificate returned is the one stored")
+	assert.Equal(t, pkgModels.DecommissionCompleted, certificate.Status)
+	assert.Equal(t, pkgModels.DataPolicyArchiveAndDelete, certificate.DataPolicy)
+	assert.Equal(t, "operator", certificate.RequestedBy)
+	assert.Equal(t, []string{"Temperature"}, certificate.RemovedAutoEvents)
+	require.NotNil(t, certificate.Archive)
+	assert.Equal(t, 5, certificate.Archive.Events)
+	assert.Equal(t, 5, certificate.DeletedEvents)
+	assert.Equal(t, []string{"thermostat-alarms"}, certificate.DeletedSubscriptions)
+	assert.Equal(t, []string{"site-alarms"}, certificate.UpdatedSubscriptions)
+	require.Len(t, services.updated, 1)
+	assert.Equal(t, []string{"site"}, services.updated[0].SubscribedLabels)
+
+	var steps []string
+	for _, step := range certificate.Steps {
+		assert.Equal(t, pkgModels.DecommissionCompleted, step.Status, step.Name)
+		steps = append(steps, step.Name)
+	}
+	assert.Equal(t, []string{
+		pkgModels.DecommissionStepLock,
+		pkgModels.DecommissionStepRemoveAutoEvents,
+		pkgModels.DecommissionStepArchiveData,
+		pkgModels.DecommissionStepDeleteData,
+		pkgModels.DecommissionStepRemoveSubscriptions,
+	}, steps)
+
+	digest, err := certificate.ComputeDigest()
+	require.NoError(t, err)
+	assert.Equal(t, digest, certificate.Digest)
+	assert.Equal(t, []string{
+		"POST /api/v1/event/device/TestDevice/archive",
+		"DELETE /api/v1/event/device/TestDevice",
+		"GET /api/v1/subscription/labels/TestDevice",
+		"DELETE /api/v1/subscription/slug/thermostat-alarms",
+		"PUT /api/v1/subscription",
+	}, services.requests)
+}
+
+func TestDecommissionDeviceArchiveFailure(t *testing.T) {
+	device := models.Device{Id: ExampleUUID, Name: TestDeviceName, AdminState: models.Locked}
+
+	services := &fakeServices{failArchive: true}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceByName", TestDeviceName).Return(device, nil)
+	dbClientMock.On("AddDecommissionCertificate", mock.Anything).Return(nil)
+	dic, closeServer := decommissionDic(t, services, dbClientMock)
+	defer closeServer()
+
+	controller := NewDeviceController(dic)
+	recorder := httptest.NewRecorder()
+	handler := http.HandlerFunc(controller.DecommissionDevice)
+	handler.ServeHTTP(recorder, decommissionRequest(t, TestDeviceName, pkgModels.DataPolicyArchiveAndDelete))
+
+	var res DecommissionCertificateResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Result().StatusCode, "HTTP status code not as expected")
+	assert.Equal(t, pkgModels.DecommissionFailed, res.Certificate.Status)
+	require.Len(t, res.Certificate.Steps, 3)
+	assert.Equal(t, pkgModels.DecommissionFailed, res.Certificate.Steps[2].Status)
+	assert.Contains(t, res.Certificate.Steps[2].Detail, "disk full")
+	assert.Equal(t, []string{"POST /api/v1/event/device/TestDevice/archive"}, services.requests,
+		"the events aren't deleted when they couldn't be archived")
+	dbClientMock.AssertNotCalled(t, "DeleteDeviceById", mock.Anything)
+	dbClientMock.AssertExpectations(t)
+}
+
+func TestDecommissionDeviceInvalid(t *testing.T) {
+	notFound := errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device doesn't exist", nil)
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceByName", "unknown").Return(models.Device{}, notFound)
+	dic, closeServer := decommissionDic(t, &fakeServices{}, dbClientMock)
+	defer closeServer()
+
+	controller := NewDeviceController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		deviceName         string
+		policy             string
+		expectedStatusCode int
+	}{
+		{"Invalid - unknown data policy", TestDeviceName, "shred", http.StatusBadRequest},
+		{"Invalid - empty name", "", pkgModels.DataPolicyRetain, http.StatusBadRequest},
+		{"Not found - unknown device", "unknown", pkgModels.DataPolicyRetain, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.DecommissionDevice)
+			handler.ServeHTTP(recorder, decommissionRequest(t, testCase.deviceName, testCase.policy))
+
+			var res common.BaseResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+		})
+	}
+	dbClientMock.AssertNotCalled(t, "AddDecommissionCertificate", mock.Anything)
+}
+
+func TestDecommissionCertificate(t *testing.T) {
+	certificate := pkgModels.DecommissionCertificate{DeviceName: TestDeviceName, Status: pkgModels.DecommissionCompleted}
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DecommissionCertificate", TestDeviceName).Return(certificate, nil)
+	dbClientMock.On("DecommissionCertificate", "active").Return(pkgModels.DecommissionCertificate{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device active was never decommissioned", nil))
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeviceController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		deviceName         string
+		expectedStatusCode int
+	}{
+		{"Valid - decommissioned device", TestDeviceName, http.StatusOK},
+		{"Not found - device never decommissioned", "active", http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, constant.ApiDeviceDecommissionByNameRoute, http.NoBody)
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{v2.Name: testCase.deviceName})
+
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.DecommissionCertificate)
+			handler.ServeHTTP(recorder, req)
+			var res DecommissionCertificateResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				assert.Equal(t, certificate, res.Certificate)
+			}
+		})
+	}
+}
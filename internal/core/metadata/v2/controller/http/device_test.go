@@ -15,6 +15,7 @@ import (
 
 	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/constant"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/errors"
@@ -222,8 +223,10 @@ func TestDeleteDeviceById(t *testing.T) {
 
 	dic := mockDic()
 	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceById", device.Id).Return(device, nil)
+	dbClientMock.On("DeviceById", notFoundId).Return(models.Device{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device doesn't exist in the database", nil))
 	dbClientMock.On("DeleteDeviceById", device.Id).Return(nil)
-	dbClientMock.On("DeleteDeviceById", notFoundId).Return(errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device doesn't exist in the database", nil))
+	dbClientMock.On("DeleteDeviceAliases", device.Name).Return(nil)
 	dic.Update(di.ServiceConstructorMap{
 		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
 			return dbClientMock
@@ -281,6 +284,7 @@ func TestDeleteDeviceByName(t *testing.T) {
 	dbClientMock := &dbMock.DBClient{}
 	dbClientMock.On("DeleteDeviceByName", device.Name).Return(nil)
 	dbClientMock.On("DeleteDeviceByName", notFoundName).Return(errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device doesn't exist in the database", nil))
+	dbClientMock.On("DeleteDeviceAliases", device.Name).Return(nil)
 	dic.Update(di.ServiceConstructorMap{
 		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
 			return dbClientMock
@@ -801,3 +805,108 @@ func TestDeviceByName(t *testing.T) {
 		})
 	}
 }
+
+func TestDevicesNear(t *testing.T) {
+	device := dtos.ToDeviceModel(buildTestDeviceRequest().Device)
+	devices := []models.Device{device, device}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DevicesNear", 0, 10, 25.03, 121.56, 500.0).Return(devices, nil)
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		latitude           string
+		longitude          string
+		radius             string
+		errorExpected      bool
+		expectedCount      int
+		expectedStatusCode int
+	}{
+		{"Valid - get devices near", "25.03", "121.56", "500", false, 2, http.StatusOK},
+		{"Invalid - latitude missing", "", "121.56", "500", true, 0, http.StatusBadRequest},
+		{"Invalid - latitude out of range", "90", "121.56", "500", true, 0, http.StatusBadRequest},
+		{"Invalid - longitude not a number", "25.03", "east", "500", true, 0, http.StatusBadRequest},
+		{"Invalid - zero radius", "25.03", "121.56", "0", true, 0, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, constant.ApiDeviceNearRoute, http.NoBody)
+			query := req.URL.Query()
+			query.Add(v2.Offset, "0")
+			query.Add(v2.Limit, "10")
+			if len(testCase.latitude) > 0 {
+				query.Add(constant.Latitude, testCase.latitude)
+			}
+			query.Add(constant.Longitude, testCase.longitude)
+			query.Add(constant.Radius, testCase.radius)
+			req.URL.RawQuery = query.Encode()
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.DevicesNear)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			if testCase.errorExpected {
+				var res common.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			} else {
+				var res responseDTO.MultiDevicesResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedCount, len(res.Devices), "Device count not as expected")
+				assert.Empty(t, res.Message, "Message should be empty when it is successful")
+			}
+		})
+	}
+}
+
+func TestDevicesByZone(t *testing.T) {
+	device := dtos.ToDeviceModel(buildTestDeviceRequest().Device)
+	testZone := "building-7"
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DevicesByZone", 0, 10, testZone).Return([]models.Device{device}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceController(dic)
+	assert.NotNil(t, controller)
+
+	req, err := http.NewRequest(http.MethodGet, constant.ApiDeviceByZoneRoute, http.NoBody)
+	require.NoError(t, err)
+	query := req.URL.Query()
+	query.Add(v2.Offset, "0")
+	query.Add(v2.Limit, "10")
+	req.URL.RawQuery = query.Encode()
+	req = mux.SetURLVars(req, map[string]string{constant.Zone: testZone})
+
+	// Act
+	recorder := httptest.NewRecorder()
+	handler := http.HandlerFunc(controller.DevicesByZone)
+	handler.ServeHTTP(recorder, req)
+
+	// Assert
+	var res responseDTO.MultiDevicesResponse
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, recorder.Result().StatusCode, "HTTP status code not as expected")
+	assert.Equal(t, 1, len(res.Devices), "Device count not as expected")
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/io"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/constant"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/utils"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
@@ -148,7 +149,7 @@ func (dc *DeviceProfileController) AddDeviceProfileByYaml(w http.ResponseWriter,
 	var addDeviceProfileResponse interface{}
 	var statusCode int
 
-	deviceProfileDTO, err := dc.reader.ReadDeviceProfileYaml(r)
+	deviceProfileDTO, err := dc.readDeviceProfileYaml(r)
 	if err != nil {
 		addDeviceProfileResponse = commonDTO.NewBaseResponse(
 			"",
@@ -196,7 +197,7 @@ func (dc *DeviceProfileController) UpdateDeviceProfileByYaml(w http.ResponseWrit
 	var response interface{}
 	var statusCode int
 
-	deviceProfileDTO, err := dc.reader.ReadDeviceProfileYaml(r)
+	deviceProfileDTO, err := dc.readDeviceProfileYaml(r)
 	if err != nil {
 		response = commonDTO.NewBaseResponse(
 			"",
@@ -231,6 +232,21 @@ func (dc *DeviceProfileController) UpdateDeviceProfileByYaml(w http.ResponseWrit
 	pkg.Encode(response, w, lc)
 }
 
+// readDeviceProfileYaml reads the device profile YAML either from the uploaded file or, when the url form value is
+// specified, from the HTTP(S) URI it refers to
+func (dc *DeviceProfileController) readDeviceProfileYaml(r *http.Request) (dtos.DeviceProfile, errors.EdgeX) {
+	uri := r.FormValue(constant.Url)
+	if uri == "" {
+		return dc.reader.ReadDeviceProfileYaml(r)
+	}
+
+	data, err := application.FetchDeviceProfileYaml(uri, r.Context(), dc.dic)
+	if err != nil {
+		return dtos.DeviceProfile{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	return io.UnmarshalDeviceProfileYaml(data)
+}
+
 func (dc *DeviceProfileController) DeviceProfileByName(w http.ResponseWriter, r *http.Request) {
 	lc := container.LoggingClientFrom(dc.dic.Get)
 	ctx := r.Context()
@@ -0,0 +1,105 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/lint"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/utils"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+
+	"github.com/gorilla/mux"
+)
+
+// DeviceProfileLintResponse defines the response of the device profile lint APIs. Valid is false when the device
+// profile violates rules of error severity; warnings don't make it invalid.
+type DeviceProfileLintResponse struct {
+	commonDTO.BaseResponse `json:",inline"`
+	ProfileName            string `json:"profileName"`
+	Valid                  bool   `json:"valid"`
+	lint.Report
+}
+
+func newDeviceProfileLintResponse(profileName string, report lint.Report) DeviceProfileLintResponse {
+	return DeviceProfileLintResponse{
+		BaseResponse: commonDTO.NewBaseResponse("", "", http.StatusOK),
+		ProfileName:  profileName,
+		Valid:        report.Errors == 0,
+		Report:       report,
+	}
+}
+
+// LintDeviceProfile lints the device profile YAML file, uploaded or fetched from a URI like when adding a device
+// profile, without storing it
+func (dc *DeviceProfileController) LintDeviceProfile(w http.ResponseWriter, r *http.Request) {
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	var response interface{}
+	var statusCode int
+
+	deviceProfileDTO, err := dc.readDeviceProfileYaml(r)
+	var report lint.Report
+	if err == nil {
+		report, err = application.LintDeviceProfile(deviceProfileDTO, dc.dic)
+	}
+	if err != nil {
+		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+		statusCode = err.Code()
+	} else {
+		response = newDeviceProfileLintResponse(deviceProfileDTO.Name, report)
+		statusCode = http.StatusOK
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
+
+// LintDeviceProfileByName lints the stored device profile with {name}
+func (dc *DeviceProfileController) LintDeviceProfileByName(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	// URL parameters
+	vars := mux.Vars(r)
+	name := vars[v2.Name]
+
+	var response interface{}
+	var statusCode int
+
+	report, err := application.LintDeviceProfileByName(name, ctx, dc.dic)
+	if err != nil {
+		if errors.Kind(err) != errors.KindEntityDoesNotExist {
+			lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		}
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+		statusCode = err.Code()
+	} else {
+		response = newDeviceProfileLintResponse(name, report)
+		statusCode = http.StatusOK
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
@@ -0,0 +1,98 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/constant"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	contractsV2 "github.com/edgexfoundry/go-mod-core-contracts/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+)
+
+func TestLintDeviceProfile(t *testing.T) {
+	dic := mockDic()
+	controller := NewDeviceProfileController(dic)
+	assert.NotNil(t, controller)
+
+	valid, err := yaml.Marshal(buildTestDeviceProfileRequest().Profile)
+	require.NoError(t, err)
+	req, err := createDeviceProfileRequestWithFile(valid)
+	require.NoError(t, err)
+
+	// Act
+	recorder := httptest.NewRecorder()
+	handler := http.HandlerFunc(controller.LintDeviceProfile)
+	handler.ServeHTTP(recorder, req)
+	var res DeviceProfileLintResponse
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, recorder.Result().StatusCode, "HTTP status code not as expected")
+	assert.Equal(t, TestDeviceProfileName, res.ProfileName)
+	assert.True(t, res.Valid, "warnings should not make the device profile invalid")
+	assert.Equal(t, 1, res.Warnings, "the INT16 device resource without units should be reported")
+}
+
+func TestLintDeviceProfileByName(t *testing.T) {
+	deviceProfile := dtos.ToDeviceProfileModel(buildTestDeviceProfileRequest().Profile)
+	notFoundName := "notFoundName"
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceProfileByName", deviceProfile.Name).Return(deviceProfile, nil)
+	dbClientMock.On("DeviceProfileByName", notFoundName).Return(models.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device profile doesn't exist in the database", nil))
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceProfileController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		deviceProfileName  string
+		expectedStatusCode int
+	}{
+		{"Valid - lint stored device profile", deviceProfile.Name, http.StatusOK},
+		{"Invalid - device profile not found", notFoundName, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, constant.ApiDeviceProfileLintByNameRoute, http.NoBody)
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{contractsV2.Name: testCase.deviceProfileName})
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.LintDeviceProfileByName)
+			handler.ServeHTTP(recorder, req)
+			var res DeviceProfileLintResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "BaseResponse status code not as expected")
+		})
+	}
+}
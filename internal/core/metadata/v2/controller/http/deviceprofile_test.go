@@ -22,6 +22,9 @@ import (
 	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/infrastructure/interfaces/mocks"
+	bootstrapContainer "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
+	secretMock "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/constant"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/di"
@@ -639,6 +642,96 @@ func TestAddDeviceProfileByYaml_MissingFile(t *testing.T) {
 	assert.Contains(t, res.Message, "missing yaml file")
 }
 
+func createDeviceProfileRequestWithUrl(url string) *http.Request {
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	_ = writer.WriteField(constant.Url, url)
+	_ = writer.Close()
+
+	req, _ := http.NewRequest(http.MethodPost, contractsV2.ApiDeviceProfileRoute+"/uploadfile", body)
+	req.Header.Set(clients.ContentType, writer.FormDataContentType())
+	return req
+}
+
+func TestAddDeviceProfileByYaml_FromUrl(t *testing.T) {
+	deviceProfileDTO := buildTestDeviceProfileRequest().Profile
+	deviceProfileModel := dtos.ToDeviceProfileModel(deviceProfileDTO)
+	valid, err := yaml.Marshal(deviceProfileDTO)
+	require.NoError(t, err)
+
+	registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if username, password, ok := r.BasicAuth(); !ok || username != "registry" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/profiles/valid.yaml":
+			_, _ = w.Write(valid)
+		case "/profiles/invalid.yaml":
+			_, _ = w.Write([]byte("name: [unterminated"))
+		case "/profiles/redirect.yaml":
+			http.Redirect(w, r, "/private/valid.yaml", http.StatusFound)
+		case "/private/valid.yaml":
+			_, _ = w.Write(valid)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer registry.Close()
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AddDeviceProfile", deviceProfileModel).Return(deviceProfileModel, nil)
+	secretProviderMock := &secretMock.SecretProvider{}
+	secretProviderMock.On("GetSecrets", "profileregistry", "username", "password").
+		Return(map[string]string{"username": "registry", "password": "secret"}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		bootstrapContainer.SecretProviderName: func(get di.Get) interface{} {
+			return secretProviderMock
+		},
+	})
+	metadataContainer.ConfigurationFrom(dic.Get).ProfileImport.Registries = map[string]config.ProfileRegistryInfo{
+		"Test": {Url: registry.URL + "/profiles/", SecretPath: "profileregistry"},
+	}
+	controller := NewDeviceProfileController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		url                string
+		expectedStatusCode int
+	}{
+		{"Valid - profile fetched from url", registry.URL + "/profiles/valid.yaml", http.StatusCreated},
+		{"Invalid - malformed yaml", registry.URL + "/profiles/invalid.yaml", http.StatusBadRequest},
+		{"Invalid - profile not found", registry.URL + "/profiles/missing.yaml", http.StatusServiceUnavailable},
+		{"Invalid - unsupported scheme", "ftp://localhost/profile.yaml", http.StatusBadRequest},
+		{"Invalid - outside the registries", registry.URL + "/private/valid.yaml", http.StatusBadRequest},
+		{"Invalid - escaping the registry", registry.URL + "/profiles/../private/valid.yaml", http.StatusBadRequest},
+		{"Invalid - other host", "http://169.254.169.254/profiles/valid.yaml", http.StatusBadRequest},
+		{"Invalid - redirected outside the registry", registry.URL + "/profiles/redirect.yaml", http.StatusServiceUnavailable},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req := createDeviceProfileRequestWithUrl(testCase.url)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.AddDeviceProfileByYaml)
+			handler.ServeHTTP(recorder, req)
+			var res common.BaseWithIdResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "BaseResponse status code not as expected")
+		})
+	}
+}
+
 func TestUpdateDeviceProfileByYaml(t *testing.T) {
 	deviceProfile := buildTestDeviceProfileRequest().Profile
 
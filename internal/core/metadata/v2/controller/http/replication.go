@@ -0,0 +1,89 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/replication"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/utils"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+)
+
+// ReplicationPositionResponse defines the response of the replication APIs of a standby instance
+type ReplicationPositionResponse struct {
+	commonDTO.BaseResponse `json:",inline"`
+	Position               replication.Position `json:"position"`
+}
+
+type ReplicationController struct {
+	dic *di.Container
+}
+
+// NewReplicationController creates and initializes an ReplicationController
+func NewReplicationController(dic *di.Container) *ReplicationController {
+	return &ReplicationController{
+		dic: dic,
+	}
+}
+
+// ReplicationPosition returns the position of the last metadata change applied by this standby instance
+func (rc *ReplicationController) ReplicationPosition(w http.ResponseWriter, r *http.Request) {
+	position, err := application.ReplicationPosition(rc.dic)
+	rc.writePosition(w, r, position, err)
+}
+
+// ApplyMetadataChanges applies the metadata changes sent by the primary instance
+func (rc *ReplicationController) ApplyMetadataChanges(w http.ResponseWriter, r *http.Request) {
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	var position replication.Position
+	var changes []pkgModels.MetadataChange
+	var err errors.EdgeX
+	if decodeErr := json.NewDecoder(r.Body).Decode(&changes); decodeErr != nil {
+		err = errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode the metadata changes", decodeErr)
+	} else {
+		position, err = application.ApplyMetadataChanges(changes, rc.dic)
+	}
+	rc.writePosition(w, r, position, err)
+}
+
+func (rc *ReplicationController) writePosition(w http.ResponseWriter, r *http.Request, position replication.Position, err errors.EdgeX) {
+	lc := container.LoggingClientFrom(rc.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	var response interface{}
+	var statusCode int
+
+	if err != nil {
+		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+		statusCode = err.Code()
+	} else {
+		response = ReplicationPositionResponse{
+			BaseResponse: commonDTO.NewBaseResponse("", "", http.StatusOK),
+			Position:     position,
+		}
+		statusCode = http.StatusOK
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
@@ -0,0 +1,120 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/replication"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/constant"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func replicationDic(mode string, dbClientMock *dbMock.DBClient) *di.Container {
+	dic := mockDic()
+	dic.Update(di.ServiceConstructorMap{
+		metadataContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				Replication: config.ReplicationInfo{Mode: mode},
+			}
+		},
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		v2MetadataContainer.ReplicationTrackerName: func(get di.Get) interface{} {
+			return replication.NewTracker()
+		},
+	})
+	return dic
+}
+
+func TestApplyMetadataChanges(t *testing.T) {
+	device := models.Device{Id: ExampleUUID, Name: TestDeviceName, ServiceName: TestDeviceServiceName, ProfileName: TestDeviceProfileName}
+	added, err := pkgModels.NewMetadataChange(pkgModels.DeviceObject, ExampleUUID, TestDeviceName, 150, nil, device)
+	require.NoError(t, err)
+	added.Sequence = 7
+	deleted, err := pkgModels.NewMetadataChange(pkgModels.DeviceServiceObject, ExampleUUID, TestDeviceServiceName, 160, map[string]interface{}{"name": TestDeviceServiceName}, nil)
+	require.NoError(t, err)
+	deleted.Sequence = 8
+	unknown := pkgModels.MetadataChange{Sequence: 9, ObjectType: "Unknown", After: json.RawMessage(`{}`)}
+
+	notFound := errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil)
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeleteDeviceById", ExampleUUID).Return(nil)
+	dbClientMock.On("DeleteDeviceByName", TestDeviceName).Return(notFound)
+	dbClientMock.On("AddDevice", mock.AnythingOfType("models.Device")).Return(device, nil)
+	dbClientMock.On("DeleteDeviceServiceById", ExampleUUID).Return(notFound)
+
+	tests := []struct {
+		name               string
+		mode               string
+		changes            []pkgModels.MetadataChange
+		expectedStatusCode int
+		expectedSequence   int64
+	}{
+		{"Valid - add and delete", config.ReplicationModeStandby, []pkgModels.MetadataChange{deleted, added}, http.StatusOK, 8},
+		{"Invalid - unknown object type", config.ReplicationModeStandby, []pkgModels.MetadataChange{added, unknown}, http.StatusBadRequest, 0},
+		{"Invalid - not a standby", config.ReplicationModePrimary, []pkgModels.MetadataChange{added}, http.StatusServiceUnavailable, 0},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			controller := NewReplicationController(replicationDic(testCase.mode, dbClientMock))
+			body, err := json.Marshal(testCase.changes)
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPost, constant.ApiReplicationChangeRoute, bytes.NewReader(body))
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.ApplyMetadataChanges)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			var res ReplicationPositionResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedSequence, res.Position.Sequence, "Replication position not as expected")
+		})
+	}
+}
+
+func TestReplicationPosition(t *testing.T) {
+	dbClientMock := &dbMock.DBClient{}
+	dic := replicationDic(config.ReplicationModeStandby, dbClientMock)
+	tracker := v2MetadataContainer.ReplicationTrackerFrom(dic.Get)
+	_, err := tracker.Apply([]pkgModels.MetadataChange{{Sequence: 3, Timestamp: 300}}, func(pkgModels.MetadataChange) error { return nil })
+	require.NoError(t, err)
+	controller := NewReplicationController(dic)
+
+	req, err := http.NewRequest(http.MethodGet, constant.ApiReplicationPositionRoute, http.NoBody)
+	require.NoError(t, err)
+	recorder := httptest.NewRecorder()
+	handler := http.HandlerFunc(controller.ReplicationPosition)
+	handler.ServeHTTP(recorder, req)
+
+	var res ReplicationPositionResponse
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, recorder.Result().StatusCode)
+	assert.Equal(t, replication.Position{Sequence: 3, Timestamp: 300}, res.Position)
+}
@@ -6,6 +6,8 @@
 package interfaces
 
 import (
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+
 	"github.com/edgexfoundry/go-mod-core-contracts/errors"
 	model "github.com/edgexfoundry/go-mod-core-contracts/v2/models"
 )
@@ -40,4 +42,14 @@ type DBClient interface {
 	DeviceById(id string) (model.Device, errors.EdgeX)
 	DeviceByName(name string) (model.Device, errors.EdgeX)
 	AllDevices(offset int, limit int, labels []string) ([]model.Device, errors.EdgeX)
+	DevicesNear(offset int, limit int, latitude float64, longitude float64, radius float64) ([]model.Device, errors.EdgeX)
+	DevicesByZone(offset int, limit int, zone string) ([]model.Device, errors.EdgeX)
+	SetDeviceAliases(name string, aliases []string) errors.EdgeX
+	DeviceAliases(name string) ([]string, errors.EdgeX)
+	DeviceByAlias(alias string) (model.Device, errors.EdgeX)
+	DeleteDeviceAliases(name string) errors.EdgeX
+	AddDecommissionCertificate(certificate pkgModels.DecommissionCertificate) errors.EdgeX
+	DecommissionCertificate(name string) (pkgModels.DecommissionCertificate, errors.EdgeX)
+
+	MetadataChangesByTimeRange(start int, end int) ([]pkgModels.MetadataChange, errors.EdgeX)
 }
@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
 	errors "github.com/edgexfoundry/go-mod-core-contracts/errors"
 
 	mock "github.com/stretchr/testify/mock"
@@ -15,6 +16,22 @@ type DBClient struct {
 	mock.Mock
 }
 
+// AddDecommissionCertificate provides a mock function with given fields: certificate
+func (_m *DBClient) AddDecommissionCertificate(certificate pkgModels.DecommissionCertificate) errors.EdgeX {
+	ret := _m.Called(certificate)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(pkgModels.DecommissionCertificate) errors.EdgeX); ok {
+		r0 = rf(certificate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // AddDevice provides a mock function with given fields: d
 func (_m *DBClient) AddDevice(d models.Device) (models.Device, errors.EdgeX) {
 	ret := _m.Called(d)
@@ -164,6 +181,45 @@ func (_m *DBClient) CloseSession() {
 	_m.Called()
 }
 
+// DecommissionCertificate provides a mock function with given fields: name
+func (_m *DBClient) DecommissionCertificate(name string) (pkgModels.DecommissionCertificate, errors.EdgeX) {
+	ret := _m.Called(name)
+
+	var r0 pkgModels.DecommissionCertificate
+	if rf, ok := ret.Get(0).(func(string) pkgModels.DecommissionCertificate); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(pkgModels.DecommissionCertificate)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteDeviceAliases provides a mock function with given fields: name
+func (_m *DBClient) DeleteDeviceAliases(name string) errors.EdgeX {
+	ret := _m.Called(name)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // DeleteDeviceById provides a mock function with given fields: id
 func (_m *DBClient) DeleteDeviceById(id string) errors.EdgeX {
 	ret := _m.Called(id)
@@ -260,6 +316,54 @@ func (_m *DBClient) DeleteDeviceServiceByName(name string) errors.EdgeX {
 	return r0
 }
 
+// DeviceAliases provides a mock function with given fields: name
+func (_m *DBClient) DeviceAliases(name string) ([]string, errors.EdgeX) {
+	ret := _m.Called(name)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeviceByAlias provides a mock function with given fields: alias
+func (_m *DBClient) DeviceByAlias(alias string) (models.Device, errors.EdgeX) {
+	ret := _m.Called(alias)
+
+	var r0 models.Device
+	if rf, ok := ret.Get(0).(func(string) models.Device); ok {
+		r0 = rf(alias)
+	} else {
+		r0 = ret.Get(0).(models.Device)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(alias)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // DeviceById provides a mock function with given fields: id
 func (_m *DBClient) DeviceById(id string) (models.Device, errors.EdgeX) {
 	ret := _m.Called(id)
@@ -398,13 +502,13 @@ func (_m *DBClient) DeviceProfileNameExists(name string) (bool, errors.EdgeX) {
 	return r0, r1
 }
 
-// DeviceProfilesByModel provides a mock function with given fields: offset, limit, model
-func (_m *DBClient) DeviceProfilesByModel(offset int, limit int, model string) ([]models.DeviceProfile, errors.EdgeX) {
-	ret := _m.Called(offset, limit, model)
+// DeviceProfilesByManufacturer provides a mock function with given fields: offset, limit, manufacturer
+func (_m *DBClient) DeviceProfilesByManufacturer(offset int, limit int, manufacturer string) ([]models.DeviceProfile, errors.EdgeX) {
+	ret := _m.Called(offset, limit, manufacturer)
 
 	var r0 []models.DeviceProfile
 	if rf, ok := ret.Get(0).(func(int, int, string) []models.DeviceProfile); ok {
-		r0 = rf(offset, limit, model)
+		r0 = rf(offset, limit, manufacturer)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.DeviceProfile)
@@ -413,7 +517,7 @@ func (_m *DBClient) DeviceProfilesByModel(offset int, limit int, model string) (
 
 	var r1 errors.EdgeX
 	if rf, ok := ret.Get(1).(func(int, int, string) errors.EdgeX); ok {
-		r1 = rf(offset, limit, model)
+		r1 = rf(offset, limit, manufacturer)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(errors.EdgeX)
@@ -423,13 +527,13 @@ func (_m *DBClient) DeviceProfilesByModel(offset int, limit int, model string) (
 	return r0, r1
 }
 
-// DeviceProfilesByManufacturer provides a mock function with given fields: offset, limit, manufacturer
-func (_m *DBClient) DeviceProfilesByManufacturer(offset int, limit int, manufacturer string) ([]models.DeviceProfile, errors.EdgeX) {
-	ret := _m.Called(offset, limit, manufacturer)
+// DeviceProfilesByModel provides a mock function with given fields: offset, limit, model
+func (_m *DBClient) DeviceProfilesByModel(offset int, limit int, model string) ([]models.DeviceProfile, errors.EdgeX) {
+	ret := _m.Called(offset, limit, model)
 
 	var r0 []models.DeviceProfile
 	if rf, ok := ret.Get(0).(func(int, int, string) []models.DeviceProfile); ok {
-		r0 = rf(offset, limit, manufacturer)
+		r0 = rf(offset, limit, model)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.DeviceProfile)
@@ -438,7 +542,7 @@ func (_m *DBClient) DeviceProfilesByManufacturer(offset int, limit int, manufact
 
 	var r1 errors.EdgeX
 	if rf, ok := ret.Get(1).(func(int, int, string) errors.EdgeX); ok {
-		r1 = rf(offset, limit, manufacturer)
+		r1 = rf(offset, limit, model)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(errors.EdgeX)
@@ -542,6 +646,97 @@ func (_m *DBClient) DevicesByServiceName(offset int, limit int, name string) ([]
 	return r0, r1
 }
 
+// DevicesByZone provides a mock function with given fields: offset, limit, zone
+func (_m *DBClient) DevicesByZone(offset int, limit int, zone string) ([]models.Device, errors.EdgeX) {
+	ret := _m.Called(offset, limit, zone)
+
+	var r0 []models.Device
+	if rf, ok := ret.Get(0).(func(int, int, string) []models.Device); ok {
+		r0 = rf(offset, limit, zone)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Device)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int, string) errors.EdgeX); ok {
+		r1 = rf(offset, limit, zone)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DevicesNear provides a mock function with given fields: offset, limit, latitude, longitude, radius
+func (_m *DBClient) DevicesNear(offset int, limit int, latitude float64, longitude float64, radius float64) ([]models.Device, errors.EdgeX) {
+	ret := _m.Called(offset, limit, latitude, longitude, radius)
+
+	var r0 []models.Device
+	if rf, ok := ret.Get(0).(func(int, int, float64, float64, float64) []models.Device); ok {
+		r0 = rf(offset, limit, latitude, longitude, radius)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Device)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int, float64, float64, float64) errors.EdgeX); ok {
+		r1 = rf(offset, limit, latitude, longitude, radius)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// MetadataChangesByTimeRange provides a mock function with given fields: start, end
+func (_m *DBClient) MetadataChangesByTimeRange(start int, end int) ([]pkgModels.MetadataChange, errors.EdgeX) {
+	ret := _m.Called(start, end)
+
+	var r0 []pkgModels.MetadataChange
+	if rf, ok := ret.Get(0).(func(int, int) []pkgModels.MetadataChange); ok {
+		r0 = rf(start, end)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pkgModels.MetadataChange)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int) errors.EdgeX); ok {
+		r1 = rf(start, end)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// SetDeviceAliases provides a mock function with given fields: name, aliases
+func (_m *DBClient) SetDeviceAliases(name string, aliases []string) errors.EdgeX {
+	ret := _m.Called(name, aliases)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, []string) errors.EdgeX); ok {
+		r0 = rf(name, aliases)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // UpdateDeviceProfile provides a mock function with given fields: e
 func (_m *DBClient) UpdateDeviceProfile(e models.DeviceProfile) errors.EdgeX {
 	ret := _m.Called(e)
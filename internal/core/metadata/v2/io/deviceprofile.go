@@ -60,13 +60,20 @@ func (jsonDeviceProfileReader) ReadDeviceProfileYaml(r *http.Request) (dtos.Devi
 	if err != nil {
 		return dtos.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindServerError, "failed to read yaml file", err)
 	}
+
+	return UnmarshalDeviceProfileYaml(data)
+}
+
+// UnmarshalDeviceProfileYaml converts and validates the YAML content of a device profile, regardless of where the
+// content was obtained from
+func UnmarshalDeviceProfileYaml(data []byte) (dtos.DeviceProfile, errors.EdgeX) {
 	if len(data) == 0 {
-		return dtos.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "yaml file is empty", err)
+		return dtos.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "yaml file is empty", nil)
 	}
 
 	var dp dtos.DeviceProfile
 
-	err = yaml.Unmarshal(data, &dp)
+	err := yaml.Unmarshal(data, &dp)
 	if err != nil {
 		return dtos.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to unmarshal yaml file", err)
 	}
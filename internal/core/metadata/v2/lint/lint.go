@@ -0,0 +1,188 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package lint checks device profiles against organizational rules, such as naming conventions or required units,
+// which go beyond the validation of the device profile contract. Rules are described in a YAML rules file and each
+// kind of rule is implemented by a Checker, so that new kinds of rules can be plugged in with RegisterChecker.
+package lint
+
+import (
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Severities of a rule
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+)
+
+// Parts of a device profile a rule can target
+const (
+	TargetProfile        = "profile"
+	TargetDeviceResource = "deviceResource"
+	TargetDeviceCommand  = "deviceCommand"
+	TargetCoreCommand    = "coreCommand"
+)
+
+// Rule is an organizational rule device profiles are checked against. Which of the optional fields are used depends
+// on the kind of the rule.
+type Rule struct {
+	Name     string   `yaml:"name"`
+	Kind     string   `yaml:"kind"`
+	Severity string   `yaml:"severity"`
+	Targets  []string `yaml:"targets,omitempty"`
+	// Pattern is the regular expression names have to match, used by the namePattern kind
+	Pattern string `yaml:"pattern,omitempty"`
+	// ValueTypes are the value types the rule applies to, used by the requiredUnits and forbiddenValueTypes kinds
+	ValueTypes []string `yaml:"valueTypes,omitempty"`
+
+	pattern *regexp.Regexp
+}
+
+// Regexp returns the compiled Pattern of the rule, nil when the rule has no pattern.
+func (r Rule) Regexp() *regexp.Regexp {
+	return r.pattern
+}
+
+// RuleSet is the content of a rules file.
+type RuleSet struct {
+	Rules []Rule `yaml:"rules"`
+}
+
+// Violation is a part of a device profile which doesn't comply with a rule.
+type Violation struct {
+	// Location identifies the part of the device profile, e.g. deviceResources[Temperature]
+	Location string
+	Message  string
+}
+
+// Finding is a violation reported by a rule.
+type Finding struct {
+	Rule     string `json:"rule"`
+	Severity string `json:"severity"`
+	Location string `json:"location"`
+	Message  string `json:"message"`
+}
+
+// Report is the result of linting a device profile.
+type Report struct {
+	Errors   int       `json:"errors"`
+	Warnings int       `json:"warnings"`
+	Findings []Finding `json:"findings"`
+}
+
+// Checker returns the violations of rule by the device profile.
+type Checker func(rule Rule, profile dtos.DeviceProfile) []Violation
+
+var checkersMutex sync.RWMutex
+var checkers = map[string]Checker{
+	namePatternKind:         checkNamePattern,
+	requiredDescriptionKind: checkRequiredDescription,
+	requiredUnitsKind:       checkRequiredUnits,
+	forbiddenValueTypesKind: checkForbiddenValueTypes,
+}
+
+// RegisterChecker makes a kind of rule available to the rules files, replacing the checker of the kind if any.
+func RegisterChecker(kind string, checker Checker) {
+	checkersMutex.Lock()
+	defer checkersMutex.Unlock()
+	checkers[kind] = checker
+}
+
+func checkerOf(kind string) (Checker, bool) {
+	checkersMutex.RLock()
+	defer checkersMutex.RUnlock()
+	checker, ok := checkers[kind]
+	return checker, ok
+}
+
+// Linter checks device profiles against a set of rules.
+type Linter struct {
+	rules []Rule
+}
+
+// NewLinter validates the rules and creates a Linter checking them.
+func NewLinter(rules []Rule) (*Linter, error) {
+	validated := make([]Rule, len(rules))
+	for i, rule := range rules {
+		if rule.Name == "" {
+			return nil, fmt.Errorf("rule #%d has no name", i+1)
+		}
+		if _, ok := checkerOf(rule.Kind); !ok {
+			return nil, fmt.Errorf("rule %s has unknown kind '%s'", rule.Name, rule.Kind)
+		}
+		if rule.Severity == "" {
+			rule.Severity = SeverityWarning
+		}
+		if rule.Severity != SeverityError && rule.Severity != SeverityWarning {
+			return nil, fmt.Errorf("rule %s has invalid severity '%s'", rule.Name, rule.Severity)
+		}
+		for _, target := range rule.Targets {
+			switch target {
+			case TargetProfile, TargetDeviceResource, TargetDeviceCommand, TargetCoreCommand:
+			default:
+				return nil, fmt.Errorf("rule %s has invalid target '%s'", rule.Name, target)
+			}
+		}
+		if rule.Pattern != "" {
+			pattern, err := regexp.Compile(rule.Pattern)
+			if err != nil {
+				return nil, fmt.Errorf("rule %s has invalid pattern: %s", rule.Name, err.Error())
+			}
+			rule.pattern = pattern
+		}
+		validated[i] = rule
+	}
+	return &Linter{rules: validated}, nil
+}
+
+// LoadLinter creates a Linter checking the rules of the YAML rules file, or the default rules when path is empty.
+func LoadLinter(path string) (*Linter, error) {
+	if path == "" {
+		return NewLinter(DefaultRules())
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read lint rules file %s: %s", path, err.Error())
+	}
+	var ruleSet RuleSet
+	if err := yaml.Unmarshal(data, &ruleSet); err != nil {
+		return nil, fmt.Errorf("failed to parse lint rules file %s: %s", path, err.Error())
+	}
+	return NewLinter(ruleSet.Rules)
+}
+
+// Lint checks the device profile against every rule of the linter.
+func (l *Linter) Lint(profile dtos.DeviceProfile) Report {
+	report := Report{Findings: []Finding{}}
+	for _, rule := range l.rules {
+		checker, ok := checkerOf(rule.Kind)
+		if !ok {
+			continue
+		}
+		for _, violation := range checker(rule, profile) {
+			report.Findings = append(report.Findings, Finding{
+				Rule:     rule.Name,
+				Severity: rule.Severity,
+				Location: violation.Location,
+				Message:  violation.Message,
+			})
+			if rule.Severity == SeverityError {
+				report.Errors++
+			} else {
+				report.Warnings++
+			}
+		}
+	}
+	return report
+}
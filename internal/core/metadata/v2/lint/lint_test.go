@@ -0,0 +1,110 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lint
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testProfile() dtos.DeviceProfile {
+	return dtos.DeviceProfile{
+		Name:        "Thermostat_Profile",
+		Description: "Thermostat",
+		DeviceResources: []dtos.DeviceResource{
+			{Name: "temperature", Description: "Room temperature", Properties: dtos.PropertyValue{Type: "Float32", ReadWrite: "R", Units: "degC"}},
+			{Name: "humidity", Properties: dtos.PropertyValue{Type: "Int16", ReadWrite: "R"}},
+			{Name: "firmware", Description: "Firmware image", Properties: dtos.PropertyValue{Type: "Binary", ReadWrite: "W"}},
+		},
+		DeviceCommands: []dtos.ProfileResource{{Name: "readings"}},
+	}
+}
+
+func TestDefaultRules(t *testing.T) {
+	linter, err := LoadLinter("")
+	require.NoError(t, err)
+
+	report := linter.Lint(testProfile())
+	assert.Equal(t, 0, report.Errors)
+	require.Equal(t, 2, report.Warnings)
+	assert.Equal(t, Finding{
+		Rule:     "description-present",
+		Severity: SeverityWarning,
+		Location: "deviceResources[humidity]",
+		Message:  "description is missing",
+	}, report.Findings[0])
+	assert.Equal(t, "numeric-units", report.Findings[1].Rule)
+	assert.Equal(t, "deviceResources[humidity]", report.Findings[1].Location)
+}
+
+func TestLoadLinterFromRulesFile(t *testing.T) {
+	rules := `
+rules:
+  - name: kebab-case-names
+    kind: namePattern
+    severity: error
+    targets: [profile, deviceCommand]
+    pattern: '^[a-z0-9]+(-[a-z0-9]+)*$'
+  - name: no-binary
+    kind: forbiddenValueTypes
+    severity: error
+    valueTypes: [binary]
+`
+	dir, err := ioutil.TempDir("", "lint")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "rules.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(rules), 0600))
+
+	linter, err := LoadLinter(path)
+	require.NoError(t, err)
+
+	report := linter.Lint(testProfile())
+	assert.Equal(t, 2, report.Errors)
+	assert.Equal(t, 0, report.Warnings)
+	require.Len(t, report.Findings, 2)
+	assert.Equal(t, "profile", report.Findings[0].Location)
+	assert.Equal(t, "deviceResources[firmware]", report.Findings[1].Location)
+}
+
+func TestInvalidRules(t *testing.T) {
+	tests := []struct {
+		name string
+		rule Rule
+	}{
+		{"no name", Rule{Kind: requiredUnitsKind}},
+		{"unknown kind", Rule{Name: "rule", Kind: "unknown"}},
+		{"invalid severity", Rule{Name: "rule", Kind: requiredUnitsKind, Severity: "fatal"}},
+		{"invalid target", Rule{Name: "rule", Kind: namePatternKind, Targets: []string{"device"}}},
+		{"invalid pattern", Rule{Name: "rule", Kind: namePatternKind, Pattern: "[a-"}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := NewLinter([]Rule{testCase.rule})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestRegisterChecker(t *testing.T) {
+	RegisterChecker("maxResources", func(rule Rule, profile dtos.DeviceProfile) []Violation {
+		if len(profile.DeviceResources) > 2 {
+			return []Violation{{Location: "profile", Message: "too many device resources"}}
+		}
+		return nil
+	})
+
+	linter, err := NewLinter([]Rule{{Name: "small-profiles", Kind: "maxResources", Severity: SeverityError}})
+	require.NoError(t, err)
+	assert.Equal(t, 1, linter.Lint(testProfile()).Errors)
+}
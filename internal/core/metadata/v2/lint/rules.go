@@ -0,0 +1,154 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lint
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+)
+
+// Kinds of the built-in rules
+const (
+	namePatternKind         = "namePattern"
+	requiredDescriptionKind = "requiredDescription"
+	requiredUnitsKind       = "requiredUnits"
+	forbiddenValueTypesKind = "forbiddenValueTypes"
+)
+
+// numericValueTypes are the value types requiring units when a requiredUnits rule doesn't list value types
+var numericValueTypes = []string{
+	"Uint8", "Uint16", "Uint32", "Uint64",
+	"Int8", "Int16", "Int32", "Int64",
+	"Float32", "Float64",
+}
+
+// DefaultRules returns the rules applied when no rules file is configured.
+func DefaultRules() []Rule {
+	return []Rule{
+		{
+			Name:     "description-present",
+			Kind:     requiredDescriptionKind,
+			Severity: SeverityWarning,
+			Targets:  []string{TargetProfile, TargetDeviceResource},
+		},
+		{
+			Name:     "numeric-units",
+			Kind:     requiredUnitsKind,
+			Severity: SeverityWarning,
+		},
+	}
+}
+
+// part is a named part of a device profile
+type part struct {
+	location       string
+	name           string
+	description    string
+	hasDescription bool
+}
+
+// partsOf returns the parts of the device profile targeted by the rule, or by defaultTargets if the rule has none.
+func partsOf(profile dtos.DeviceProfile, rule Rule, defaultTargets ...string) []part {
+	targets := rule.Targets
+	if len(targets) == 0 {
+		targets = defaultTargets
+	}
+
+	var parts []part
+	for _, target := range targets {
+		switch target {
+		case TargetProfile:
+			parts = append(parts, part{"profile", profile.Name, profile.Description, true})
+		case TargetDeviceResource:
+			for _, dr := range profile.DeviceResources {
+				parts = append(parts, part{fmt.Sprintf("deviceResources[%s]", dr.Name), dr.Name, dr.Description, true})
+			}
+		case TargetDeviceCommand:
+			for _, pr := range profile.DeviceCommands {
+				parts = append(parts, part{location: fmt.Sprintf("deviceCommands[%s]", pr.Name), name: pr.Name})
+			}
+		case TargetCoreCommand:
+			for _, c := range profile.CoreCommands {
+				parts = append(parts, part{location: fmt.Sprintf("coreCommands[%s]", c.Name), name: c.Name})
+			}
+		}
+	}
+	return parts
+}
+
+// containsValueType reports whether valueType is one of valueTypes, ignoring the case.
+func containsValueType(valueTypes []string, valueType string) bool {
+	for _, t := range valueTypes {
+		if strings.EqualFold(t, valueType) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkNamePattern reports the names which don't match the pattern of the rule.
+func checkNamePattern(rule Rule, profile dtos.DeviceProfile) []Violation {
+	if rule.Regexp() == nil {
+		return nil
+	}
+
+	var violations []Violation
+	for _, p := range partsOf(profile, rule, TargetProfile, TargetDeviceResource, TargetDeviceCommand, TargetCoreCommand) {
+		if !rule.Regexp().MatchString(p.name) {
+			violations = append(violations, Violation{
+				Location: p.location,
+				Message:  fmt.Sprintf("name '%s' does not match the pattern %s", p.name, rule.Pattern),
+			})
+		}
+	}
+	return violations
+}
+
+// checkRequiredDescription reports the profile or device resources without description.
+func checkRequiredDescription(rule Rule, profile dtos.DeviceProfile) []Violation {
+	var violations []Violation
+	for _, p := range partsOf(profile, rule, TargetProfile, TargetDeviceResource) {
+		if p.hasDescription && strings.TrimSpace(p.description) == "" {
+			violations = append(violations, Violation{Location: p.location, Message: "description is missing"})
+		}
+	}
+	return violations
+}
+
+// checkRequiredUnits reports the device resources of the value types of the rule, numeric by default, without units.
+func checkRequiredUnits(rule Rule, profile dtos.DeviceProfile) []Violation {
+	valueTypes := rule.ValueTypes
+	if len(valueTypes) == 0 {
+		valueTypes = numericValueTypes
+	}
+
+	var violations []Violation
+	for _, dr := range profile.DeviceResources {
+		if containsValueType(valueTypes, dr.Properties.Type) && strings.TrimSpace(dr.Properties.Units) == "" {
+			violations = append(violations, Violation{
+				Location: fmt.Sprintf("deviceResources[%s]", dr.Name),
+				Message:  fmt.Sprintf("units are missing for value type %s", dr.Properties.Type),
+			})
+		}
+	}
+	return violations
+}
+
+// checkForbiddenValueTypes reports the device resources of the value types of the rule.
+func checkForbiddenValueTypes(rule Rule, profile dtos.DeviceProfile) []Violation {
+	var violations []Violation
+	for _, dr := range profile.DeviceResources {
+		if containsValueType(rule.ValueTypes, dr.Properties.Type) {
+			violations = append(violations, Violation{
+				Location: fmt.Sprintf("deviceResources[%s]", dr.Name),
+				Message:  fmt.Sprintf("value type %s is not allowed", dr.Properties.Type),
+			})
+		}
+	}
+	return violations
+}
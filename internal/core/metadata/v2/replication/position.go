@@ -0,0 +1,63 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package replication streams the metadata change feed of a primary core-metadata to a warm standby instance, which
+// applies the changes to its own database so that it can take over without shared storage.
+package replication
+
+import (
+	"sort"
+	"sync"
+
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+)
+
+// Position identifies the last metadata change applied by a standby instance
+type Position struct {
+	Sequence  int64 `json:"sequence"`
+	Timestamp int64 `json:"timestamp"`
+}
+
+// Tracker keeps the replication position of a standby instance and serializes the application of changes. The
+// position is kept in memory only; after a restart the standby is sent the whole change feed again, which converges
+// to the same state as applying a change is idempotent.
+type Tracker struct {
+	mutex    sync.Mutex
+	position Position
+}
+
+// NewTracker creates a Tracker at the beginning of the change feed
+func NewTracker() *Tracker {
+	return &Tracker{}
+}
+
+// Position returns the position of the last applied change
+func (t *Tracker) Position() Position {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.position
+}
+
+// Apply applies the changes not applied yet in sequence order and advances the position past each one. It stops at
+// the first change failing to apply, so that it is sent again with the next batch.
+func (t *Tracker) Apply(changes []pkgModels.MetadataChange, apply func(pkgModels.MetadataChange) error) (Position, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	sorted := make([]pkgModels.MetadataChange, len(changes))
+	copy(sorted, changes)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Sequence < sorted[j].Sequence })
+
+	for _, change := range sorted {
+		if change.Sequence <= t.position.Sequence {
+			continue
+		}
+		if err := apply(change); err != nil {
+			return t.position, err
+		}
+		t.position = Position{Sequence: change.Sequence, Timestamp: change.Timestamp}
+	}
+	return t.position, nil
+}
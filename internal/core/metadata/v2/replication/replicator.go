@@ -0,0 +1,155 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package replication
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/infrastructure/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/constant"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+)
+
+const defaultBatchSize = 100
+
+// positionResponse is the part of the standby's position response the replicator relies on
+type positionResponse struct {
+	Position Position `json:"position"`
+}
+
+// Replicator runs on the primary instance and sends the metadata changes the standby instance hasn't applied yet
+type Replicator struct {
+	standbyUrl string
+	batchSize  int
+	dbClient   interfaces.DBClient
+	httpClient *http.Client
+	lc         logger.LoggingClient
+}
+
+// NewReplicator creates a Replicator sending the changes to the standby core-metadata at standbyUrl in batches of at
+// most batchSize changes
+func NewReplicator(standbyUrl string, batchSize int, timeout time.Duration, dbClient interfaces.DBClient, lc logger.LoggingClient) *Replicator {
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+	return &Replicator{
+		standbyUrl: strings.TrimSuffix(standbyUrl, "/"),
+		batchSize:  batchSize,
+		dbClient:   dbClient,
+		httpClient: &http.Client{Timeout: timeout},
+		lc:         lc,
+	}
+}
+
+// Run replicates the changes every interval until the context is done. A failed replication is logged and retried
+// at the next interval, starting over from the position reported by the standby.
+func (r *Replicator) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := r.Replicate(ctx); err != nil {
+			r.lc.Warn(fmt.Sprintf("metadata replication to %s failed: %v", r.standbyUrl, err))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Replicate sends the standby every change recorded since its position and returns once it is up to date
+func (r *Replicator) Replicate(ctx context.Context) error {
+	position, err := r.standbyPosition(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	changes, edgeXerr := r.dbClient.MetadataChangesByTimeRange(int(position.Timestamp), int(now))
+	if edgeXerr != nil {
+		return edgeXerr
+	}
+	pending := make([]pkgModels.MetadataChange, 0, len(changes))
+	for _, change := range changes {
+		if change.Sequence > position.Sequence {
+			pending = append(pending, change)
+		}
+	}
+	sort.SliceStable(pending, func(i, j int) bool { return pending[i].Sequence < pending[j].Sequence })
+
+	for start := 0; start < len(pending); start += r.batchSize {
+		end := start + r.batchSize
+		if end > len(pending) {
+			end = len(pending)
+		}
+		position, err = r.sendChanges(ctx, pending[start:end])
+		if err != nil {
+			return err
+		}
+		if position.Sequence < pending[end-1].Sequence {
+			return fmt.Errorf("standby stopped applying changes at sequence %d", position.Sequence)
+		}
+	}
+	if len(pending) > 0 {
+		r.lc.Debug(fmt.Sprintf("replicated %d metadata changes to %s", len(pending), r.standbyUrl))
+	}
+	return nil
+}
+
+func (r *Replicator) standbyPosition(ctx context.Context) (Position, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.standbyUrl+constant.ApiReplicationPositionRoute, http.NoBody)
+	if err != nil {
+		return Position{}, err
+	}
+	return r.do(req)
+}
+
+func (r *Replicator) sendChanges(ctx context.Context, changes []pkgModels.MetadataChange) (Position, error) {
+	body, err := json.Marshal(changes)
+	if err != nil {
+		return Position{}, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.standbyUrl+constant.ApiReplicationChangeRoute, bytes.NewReader(body))
+	if err != nil {
+		return Position{}, err
+	}
+	req.Header.Set(clients.ContentType, clients.ContentTypeJSON)
+	return r.do(req)
+}
+
+// do sends the request to the standby and decodes the position it responds with
+func (r *Replicator) do(req *http.Request) (Position, error) {
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return Position{}, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return Position{}, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return Position{}, fmt.Errorf("standby responded with status %d: %s", resp.StatusCode, string(body))
+	}
+	var response positionResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return Position{}, err
+	}
+	return response.Position, nil
+}
@@ -0,0 +1,113 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package replication
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/constant"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestChange(sequence int64, timestamp int64, name string) pkgModels.MetadataChange {
+	return pkgModels.MetadataChange{
+		Sequence:   sequence,
+		Timestamp:  timestamp,
+		ObjectType: pkgModels.DeviceObject,
+		ObjectId:   name,
+		ObjectName: name,
+		After:      json.RawMessage(`{"name":"` + name + `"}`),
+	}
+}
+
+func TestTrackerApply(t *testing.T) {
+	tracker := NewTracker()
+	var applied []int64
+	apply := func(change pkgModels.MetadataChange) error {
+		applied = append(applied, change.Sequence)
+		return nil
+	}
+
+	position, err := tracker.Apply([]pkgModels.MetadataChange{newTestChange(2, 20, "b"), newTestChange(1, 10, "a")}, apply)
+	require.NoError(t, err)
+	assert.Equal(t, Position{Sequence: 2, Timestamp: 20}, position)
+	assert.Equal(t, []int64{1, 2}, applied)
+
+	// changes applied already are skipped
+	position, err = tracker.Apply([]pkgModels.MetadataChange{newTestChange(2, 20, "b"), newTestChange(3, 30, "c")}, apply)
+	require.NoError(t, err)
+	assert.Equal(t, Position{Sequence: 3, Timestamp: 30}, position)
+	assert.Equal(t, []int64{1, 2, 3}, applied)
+
+	// the position stops before the first failing change
+	failing := func(change pkgModels.MetadataChange) error {
+		if change.Sequence == 5 {
+			return assert.AnError
+		}
+		return nil
+	}
+	position, err = tracker.Apply([]pkgModels.MetadataChange{newTestChange(4, 40, "d"), newTestChange(5, 50, "e")}, failing)
+	assert.Error(t, err)
+	assert.Equal(t, Position{Sequence: 4, Timestamp: 40}, position)
+	assert.Equal(t, position, tracker.Position())
+}
+
+func TestReplicatorReplicate(t *testing.T) {
+	tracker := NewTracker()
+	_, err := tracker.Apply([]pkgModels.MetadataChange{newTestChange(1, 100, "a")}, func(pkgModels.MetadataChange) error { return nil })
+	require.NoError(t, err)
+
+	var batches int
+	standby := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == constant.ApiReplicationChangeRoute {
+			var changes []pkgModels.MetadataChange
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&changes))
+			_, _ = tracker.Apply(changes, func(pkgModels.MetadataChange) error { return nil })
+			batches++
+		} else if r.URL.Path != constant.ApiReplicationPositionRoute {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(positionResponse{Position: tracker.Position()})
+	}))
+	defer standby.Close()
+
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("MetadataChangesByTimeRange", 100, mock.Anything).Return([]pkgModels.MetadataChange{
+		newTestChange(4, 120, "d"),
+		newTestChange(1, 100, "a"),
+		newTestChange(2, 100, "b"),
+		newTestChange(3, 110, "c"),
+	}, nil)
+
+	replicator := NewReplicator(standby.URL, 2, time.Second, dbClientMock, logger.NewMockClient())
+	require.NoError(t, replicator.Replicate(context.Background()))
+	assert.Equal(t, Position{Sequence: 4, Timestamp: 120}, tracker.Position())
+	assert.Equal(t, 2, batches, "the three pending changes should be sent in two batches")
+}
+
+func TestReplicatorReplicateStandbyUnavailable(t *testing.T) {
+	standby := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer standby.Close()
+
+	dbClientMock := &dbMock.DBClient{}
+	replicator := NewReplicator(standby.URL, 0, time.Second, dbClientMock, logger.NewMockClient())
+	assert.Error(t, replicator.Replicate(context.Background()))
+	dbClientMock.AssertNotCalled(t, "MetadataChangesByTimeRange", mock.Anything, mock.Anything)
+}
@@ -5,9 +5,11 @@ import (
 
 	metadataController "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/controller/http"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/constant"
 	commonController "github.com/edgexfoundry/edgex-go/internal/pkg/v2/controller/http"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
 	v2Constant "github.com/edgexfoundry/go-mod-core-contracts/v2"
 
 	"github.com/gorilla/mux"
@@ -16,10 +18,11 @@ import (
 func LoadRestRoutes(r *mux.Router, dic *di.Container) {
 	// v2 API routes
 	// Common
-	cc := commonController.NewV2CommonController(dic)
+	cc := commonController.NewV2CommonController(dic, clients.CoreMetaDataServiceKey)
 	r.HandleFunc(v2Constant.ApiPingRoute, cc.Ping).Methods(http.MethodGet)
 	r.HandleFunc(v2Constant.ApiVersionRoute, cc.Version).Methods(http.MethodGet)
 	r.HandleFunc(v2Constant.ApiConfigRoute, cc.Config).Methods(http.MethodGet)
+	r.HandleFunc(v2Constant.ApiConfigRoute, cc.PatchConfig).Methods(http.MethodPatch)
 	r.HandleFunc(v2Constant.ApiMetricsRoute, cc.Metrics).Methods(http.MethodGet)
 
 	// Device Profile
@@ -34,6 +37,8 @@ func LoadRestRoutes(r *mux.Router, dic *di.Container) {
 	r.HandleFunc(v2Constant.ApiAllDeviceProfileRoute, dc.AllDeviceProfiles).Methods(http.MethodGet)
 	r.HandleFunc(v2Constant.ApiDeviceProfileByModelRoute, dc.DeviceProfilesByModel).Methods(http.MethodGet)
 	r.HandleFunc(v2Constant.ApiDeviceProfileByManufacturerRoute, dc.DeviceProfilesByManufacturer).Methods(http.MethodGet)
+	r.HandleFunc(constant.ApiDeviceProfileLintRoute, dc.LintDeviceProfile).Methods(http.MethodPost)
+	r.HandleFunc(constant.ApiDeviceProfileLintByNameRoute, dc.LintDeviceProfileByName).Methods(http.MethodGet)
 
 	// Device Service
 	ds := metadataController.NewDeviceServiceController(dic)
@@ -55,6 +60,22 @@ func LoadRestRoutes(r *mux.Router, dic *di.Container) {
 	r.HandleFunc(v2Constant.ApiDeviceRoute, d.PatchDevice).Methods(http.MethodPatch)
 	r.HandleFunc(v2Constant.ApiAllDeviceRoute, d.AllDevices).Methods(http.MethodGet)
 	r.HandleFunc(v2Constant.ApiDeviceByNameRoute, d.DeviceByName).Methods(http.MethodGet)
+	r.HandleFunc(constant.ApiDeviceNearRoute, d.DevicesNear).Methods(http.MethodGet)
+	r.HandleFunc(constant.ApiDeviceByZoneRoute, d.DevicesByZone).Methods(http.MethodGet)
+	r.HandleFunc(constant.ApiDeviceByAliasRoute, d.DeviceByAlias).Methods(http.MethodGet)
+	r.HandleFunc(constant.ApiDeviceAliasesByNameRoute, d.DeviceAliases).Methods(http.MethodGet)
+	r.HandleFunc(constant.ApiDeviceAliasesByNameRoute, d.SetDeviceAliases).Methods(http.MethodPut)
+	r.HandleFunc(constant.ApiDeviceDecommissionByNameRoute, d.DecommissionDevice).Methods(http.MethodPost)
+	r.HandleFunc(constant.ApiDeviceDecommissionByNameRoute, d.DecommissionCertificate).Methods(http.MethodGet)
+
+	// Metadata Change
+	mc := metadataController.NewMetadataChangeController(dic)
+	r.HandleFunc(constant.ApiMetadataChangeByTimeRangeRoute, mc.MetadataChangesByTimeRange).Methods(http.MethodGet)
+
+	// Replication
+	rc := metadataController.NewReplicationController(dic)
+	r.HandleFunc(constant.ApiReplicationPositionRoute, rc.ReplicationPosition).Methods(http.MethodGet)
+	r.HandleFunc(constant.ApiReplicationChangeRoute, rc.ApplyMetadataChanges).Methods(http.MethodPost)
 
 	r.Use(correlation.ManageHeader)
 	r.Use(correlation.OnResponseComplete)
@@ -0,0 +1,44 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metadata
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/warmup"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// warmupLoaders returns the loaders reading the device profiles, the device services and the devices at startup.
+// core-metadata holding no cache of its own, they warm up the connections to the database and its working set.
+func warmupLoaders(dic *di.Container) []warmup.Loader {
+	dbClient := container.DBClientFrom(dic.Get)
+	return []warmup.Loader{
+		{
+			Name: "device profiles",
+			Load: func(context.Context) (int, error) {
+				profiles, err := dbClient.GetAllDeviceProfiles()
+				return len(profiles), err
+			},
+		},
+		{
+			Name: "device services",
+			Load: func(context.Context) (int, error) {
+				services, err := dbClient.GetAllDeviceServices()
+				return len(services), err
+			},
+		},
+		{
+			Name: "devices",
+			Load: func(context.Context) (int, error) {
+				devices, err := dbClient.GetAllDevices()
+				return len(devices), err
+			},
+		},
+	}
+}
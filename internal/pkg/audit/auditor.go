@@ -0,0 +1,213 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package audit records the security-relevant requests answered by a service, such as the denied requests, the
+// configuration changes, the deletions and the device commands, to an audit trail written to a file or published on
+// the message bus.
+package audit
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/trustedproxy"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+
+	"github.com/gorilla/mux"
+)
+
+const (
+	// AuthenticationCategory is the category of the requests denied with 401
+	AuthenticationCategory = "authentication"
+	// AuthorizationCategory is the category of the requests denied with 403
+	AuthorizationCategory = "authorization"
+
+	// Anonymous is the actor of the requests without identity
+	Anonymous = "anonymous"
+
+	SuccessOutcome = "success"
+	DeniedOutcome  = "denied"
+	FailureOutcome = "failure"
+)
+
+// Record is an audited request.
+type Record struct {
+	Timestamp     int64  `json:"timestamp"`
+	Service       string `json:"service"`
+	Category      string `json:"category"`
+	Actor         string `json:"actor"`
+	SourceIP      string `json:"sourceIp"`
+	Method        string `json:"method"`
+	Route         string `json:"route"`
+	Path          string `json:"path"`
+	StatusCode    int    `json:"statusCode"`
+	Outcome       string `json:"outcome"`
+	CorrelationId string `json:"correlationId,omitempty"`
+}
+
+// rule is a parsed RuleInfo.
+type rule struct {
+	route    string
+	prefix   bool
+	methods  map[string]bool
+	category string
+}
+
+// matches tells whether the rule applies to the requests of method matched by the route template.
+func (r rule) matches(route string, method string) bool {
+	if len(r.methods) > 0 && !r.methods[method] {
+		return false
+	}
+	if r.prefix {
+		return strings.HasPrefix(route, r.route)
+	}
+	return route == r.route
+}
+
+// recorder records the audited requests, as the Sink does.
+type recorder interface {
+	Record(record Record)
+}
+
+// Auditor selects the audited requests and records them.
+type Auditor struct {
+	serviceKey     string
+	identityHeader string
+	proxies        *trustedproxy.Proxies
+	rules          []rule
+	recorder       recorder
+}
+
+// NewAuditor creates an Auditor recording the requests of serviceKey selected by the rules of info, validating them
+// and the trusted proxies.
+func NewAuditor(serviceKey string, info AuditInfo, recorder recorder) (*Auditor, error) {
+	proxies, err := trustedproxy.NewProxies(info.TrustedProxies)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &Auditor{
+		serviceKey:     serviceKey,
+		identityHeader: info.IdentityHeader,
+		proxies:        proxies,
+		recorder:       recorder,
+	}
+	for i, r := range info.Rules {
+		if r.Route == "" || r.Category == "" {
+			return nil, fmt.Errorf("no route or category for the Audit rule #%d", i+1)
+		}
+		parsed := rule{route: strings.TrimSuffix(r.Route, "*"), prefix: strings.HasSuffix(r.Route, "*"), category: r.Category}
+		if len(r.Methods) > 0 {
+			parsed.methods = make(map[string]bool, len(r.Methods))
+			for _, method := range r.Methods {
+				parsed.methods[strings.ToUpper(method)] = true
+			}
+		}
+		a.rules = append(a.rules, parsed)
+	}
+	return a, nil
+}
+
+// Category returns the category of the requests of method matched by the route template, from the first rule matching
+// them, or an empty category when none does.
+func (a *Auditor) Category(route string, method string) string {
+	for _, r := range a.rules {
+		if r.matches(route, method) {
+			return r.category
+		}
+	}
+	return ""
+}
+
+// Actor returns the user authenticated by the gateway, trusted when r is received from a trusted proxy, or else the
+// common name of the client certificate of r, or Anonymous.
+func (a *Auditor) Actor(r *http.Request) string {
+	if a.identityHeader != "" {
+		host, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			host = r.RemoteAddr
+		}
+		user := strings.TrimSpace(r.Header.Get(a.identityHeader))
+		if user != "" && a.proxies.Trusts(net.ParseIP(host)) {
+			return user
+		}
+	}
+	if r.TLS != nil && len(r.TLS.PeerCertificates) > 0 && r.TLS.PeerCertificates[0].Subject.CommonName != "" {
+		return r.TLS.PeerCertificates[0].Subject.CommonName
+	}
+	return Anonymous
+}
+
+// statusRecorder captures the status code a handler answers with
+type statusRecorder struct {
+	http.ResponseWriter
+	statusCode int
+}
+
+func (r *statusRecorder) WriteHeader(statusCode int) {
+	r.statusCode = statusCode
+	r.ResponseWriter.WriteHeader(statusCode)
+}
+
+// Middleware records the requests matched by a rule and the requests denied with 401 or 403 once they're answered.
+// It runs ahead of the authorization middlewares for their denials to be recorded.
+func (a *Auditor) Middleware() mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route := r.URL.Path
+			if current := mux.CurrentRoute(r); current != nil {
+				if template, err := current.GetPathTemplate(); err == nil {
+					route = template
+				}
+			}
+
+			recorder := &statusRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+			next.ServeHTTP(recorder, r)
+			a.Record(r, route, recorder.statusCode)
+		})
+	}
+}
+
+// Record records r, matched by the route template and answered with statusCode, when it is matched by a rule or
+// denied with 401 or 403. It is nil-safe, for the requests received through another transport than the router, such
+// as the message bus, to be recorded whether auditing is enabled or not.
+func (a *Auditor) Record(r *http.Request, route string, statusCode int) {
+	if a == nil {
+		return
+	}
+
+	category := a.Category(route, r.Method)
+	outcome := SuccessOutcome
+	switch {
+	case statusCode == http.StatusUnauthorized:
+		category, outcome = AuthenticationCategory, DeniedOutcome
+	case statusCode == http.StatusForbidden:
+		category, outcome = AuthorizationCategory, DeniedOutcome
+	case statusCode >= http.StatusBadRequest:
+		outcome = FailureOutcome
+	}
+	if category == "" {
+		return
+	}
+
+	sourceIP, _ := a.proxies.Resolve(r)
+	a.recorder.Record(Record{
+		Timestamp:     time.Now().UnixNano() / int64(time.Millisecond),
+		Service:       a.serviceKey,
+		Category:      category,
+		Actor:         a.Actor(r),
+		SourceIP:      sourceIP,
+		Method:        r.Method,
+		Route:         route,
+		Path:          r.URL.Path,
+		StatusCode:    statusCode,
+		Outcome:       outcome,
+		CorrelationId: r.Header.Get(clients.CorrelationHeader),
+	})
+}
@@ -0,0 +1,149 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordsStub struct {
+	records []Record
+}
+
+func (s *recordsStub) Record(record Record) {
+	s.records = append(s.records, record)
+}
+
+func newAuditedRouter(t *testing.T, records *recordsStub) *mux.Router {
+	auditor, err := NewAuditor("edgex-core-command", AuditInfo{
+		IdentityHeader: "X-Consumer-Username",
+		TrustedProxies: []string{"10.0.0.1"},
+		Rules: []RuleInfo{
+			{Route: "/api/v1/device/{id}/command/{commandid}", Methods: []string{"get", "put"}, Category: "command"},
+			{Route: "/api/v1/*", Methods: []string{"DELETE"}, Category: "delete"},
+		},
+	}, records)
+	require.NoError(t, err)
+
+	router := mux.NewRouter()
+	router.Use(auditor.Middleware())
+	router.HandleFunc("/api/v1/device/{id}/command/{commandid}", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Consumer-Username") == "intruder" {
+			http.Error(w, "forbidden", http.StatusForbidden)
+		}
+	})
+	router.HandleFunc("/api/v1/device/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	return router
+}
+
+func TestNewAuditorValidation(t *testing.T) {
+	_, err := NewAuditor("edgex-core-data", AuditInfo{Rules: []RuleInfo{{Route: "*"}}}, &recordsStub{})
+	assert.Error(t, err)
+	_, err = NewAuditor("edgex-core-data", AuditInfo{TrustedProxies: []string{"gateway"}}, &recordsStub{})
+	assert.Error(t, err)
+}
+
+func TestMiddleware(t *testing.T) {
+	records := &recordsStub{}
+	router := newAuditedRouter(t, records)
+
+	command := httptest.NewRequest(http.MethodPut, "/api/v1/device/d1/command/c1", nil)
+	command.RemoteAddr = "10.0.0.1:4000"
+	command.Header.Set("X-Consumer-Username", "operator")
+	command.Header.Set("X-Forwarded-For", "192.168.1.20")
+	router.ServeHTTP(httptest.NewRecorder(), command)
+
+	denied := httptest.NewRequest(http.MethodGet, "/api/v1/device/d1/command/c1", nil)
+	denied.RemoteAddr = "10.0.0.1:4000"
+	denied.Header.Set("X-Consumer-Username", "intruder")
+	router.ServeHTTP(httptest.NewRecorder(), denied)
+
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/v1/device/d1", nil))
+
+	spoofed := httptest.NewRequest(http.MethodDelete, "/api/v1/device/d1", nil)
+	spoofed.RemoteAddr = "192.168.1.30:4000"
+	spoofed.Header.Set("X-Consumer-Username", "admin")
+	router.ServeHTTP(httptest.NewRecorder(), spoofed)
+
+	require.Len(t, records.records, 3, "the reads matched by no rule should not be audited")
+
+	assert.Equal(t, "command", records.records[0].Category)
+	assert.Equal(t, "operator", records.records[0].Actor)
+	assert.Equal(t, "192.168.1.20", records.records[0].SourceIP, "the client should be read from the trusted proxy")
+	assert.Equal(t, "/api/v1/device/{id}/command/{commandid}", records.records[0].Route)
+	assert.Equal(t, SuccessOutcome, records.records[0].Outcome)
+	assert.Equal(t, "edgex-core-command", records.records[0].Service)
+
+	assert.Equal(t, AuthorizationCategory, records.records[1].Category)
+	assert.Equal(t, DeniedOutcome, records.records[1].Outcome)
+	assert.Equal(t, "intruder", records.records[1].Actor)
+
+	assert.Equal(t, "delete", records.records[2].Category)
+	assert.Equal(t, Anonymous, records.records[2].Actor, "the identity should only be trusted from the proxies")
+	assert.Equal(t, "192.168.1.30", records.records[2].SourceIP)
+	assert.Equal(t, FailureOutcome, records.records[2].Outcome)
+	assert.Equal(t, http.StatusNotFound, records.records[2].StatusCode)
+}
+
+func TestActorFromClientCertificate(t *testing.T) {
+	auditor, err := NewAuditor("edgex-core-data", AuditInfo{IdentityHeader: "X-Consumer-Username"}, &recordsStub{})
+	require.NoError(t, err)
+
+	r := httptest.NewRequest(http.MethodDelete, "/api/v1/event/id/e1", nil)
+	r.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{{Subject: pkix.Name{CommonName: "device-virtual"}}}}
+	assert.Equal(t, "device-virtual", auditor.Actor(r))
+}
+
+func TestSinkFileOutput(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	output, err := NewFileOutput(path)
+	require.NoError(t, err)
+
+	sink := NewSink(10, []Output{output}, logger.NewMockClient())
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	sink.Run(ctx, wg)
+
+	sink.Record(Record{Category: "delete", Actor: "admin", Outcome: SuccessOutcome})
+	sink.Record(Record{Category: "command", Actor: "operator", Outcome: FailureOutcome})
+	cancel()
+	wg.Wait()
+	sink.Record(Record{Category: "delete", Actor: "late"})
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer func() { _ = file.Close() }()
+	var written []Record
+	for scanner := bufio.NewScanner(file); scanner.Scan(); {
+		var record Record
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		written = append(written, record)
+	}
+	require.Len(t, written, 2, "the records waiting should be written before stopping, and none after")
+	assert.Equal(t, "admin", written[0].Actor)
+	assert.Equal(t, "operator", written[1].Actor)
+}
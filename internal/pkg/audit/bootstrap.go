@@ -0,0 +1,136 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/messaging"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	msgTypes "github.com/edgexfoundry/go-mod-messaging/pkg/types"
+
+	"github.com/gorilla/mux"
+)
+
+// AuditorName contains the name of the *Auditor implementation in the DIC.
+var AuditorName = di.TypeInstanceToName(Auditor{})
+
+// AuditorFrom helper function queries the DIC and returns the *Auditor implementation, or nil when auditing is
+// disabled.
+func AuditorFrom(get di.Get) *Auditor {
+	auditor, _ := get(AuditorName).(*Auditor)
+	return auditor
+}
+
+// Bootstrap contains references to dependencies required by the BootstrapHandler.
+type Bootstrap struct {
+	router     *mux.Router
+	serviceKey string
+	info       *AuditInfo
+}
+
+// NewBootstrap is a factory method that returns an initialized Bootstrap receiver struct.
+func NewBootstrap(router *mux.Router, serviceKey string, info *AuditInfo) *Bootstrap {
+	return &Bootstrap{
+		router:     router,
+		serviceKey: serviceKey,
+		info:       info,
+	}
+}
+
+// BootstrapHandler fulfills the BootstrapHandler contract. When auditing is enabled, the audited requests answered by
+// the router are recorded to the enabled outputs in the background, and the Auditor is added to the DIC for the
+// requests received otherwise.
+func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, startupTimer startup.Timer, dic *di.Container) bool {
+	if !b.info.Enabled {
+		return true
+	}
+
+	lc := container.LoggingClientFrom(dic.Get)
+	outputs, ok := b.outputs(startupTimer, lc)
+	if !ok {
+		return false
+	}
+	if len(outputs) == 0 {
+		lc.Error("no Audit output enabled to record the requests to")
+		return false
+	}
+
+	sink := NewSink(b.info.BufferSize, outputs, lc)
+	auditor, err := NewAuditor(b.serviceKey, *b.info, sink)
+	if err != nil {
+		lc.Error(err.Error())
+		return false
+	}
+	sink.Run(ctx, wg)
+	b.router.Use(auditor.Middleware())
+	dic.Update(di.ServiceConstructorMap{
+		AuditorName: func(get di.Get) interface{} {
+			return auditor
+		},
+	})
+
+	names := make([]string, len(outputs))
+	for i, output := range outputs {
+		names[i] = output.Name()
+	}
+	lc.Info(fmt.Sprintf("Auditing the requests to %s, with %d rules", strings.Join(names, ", "), len(b.info.Rules)))
+	return true
+}
+
+// outputs creates the outputs enabled, connecting to the message bus when the records are published on it.
+func (b *Bootstrap) outputs(startupTimer startup.Timer, lc logger.LoggingClient) ([]Output, bool) {
+	var outputs []Output
+
+	if info := b.info.File; info.Enabled {
+		output, err := NewFileOutput(info.Path)
+		if err != nil {
+			lc.Error(fmt.Sprintf("failed to open the audit file: %s", err.Error()))
+			return nil, false
+		}
+		outputs = append(outputs, output)
+	}
+
+	if info := b.info.MessageBus; info.Enabled {
+		msgClient, err := messaging.NewMessageClient(
+			msgTypes.MessageBusConfig{
+				PublishHost: msgTypes.HostInfo{
+					Host:     info.Host,
+					Port:     info.Port,
+					Protocol: info.Protocol,
+				},
+				Type:     info.Type,
+				Optional: info.Optional,
+			})
+		if err != nil {
+			lc.Error(fmt.Sprintf("failed to create the audit messaging client: %s", err.Error()))
+			return nil, false
+		}
+		for startupTimer.HasNotElapsed() {
+			if err = msgClient.Connect(); err == nil {
+				break
+			}
+			lc.Warn(fmt.Sprintf("couldn't connect to the audit message bus: %s", err.Error()))
+			startupTimer.SleepForInterval()
+		}
+		if err != nil {
+			lc.Error("failed to connect to the audit message bus in allotted time")
+			return nil, false
+		}
+		outputs = append(outputs, NewMessageBusOutput(info.Topic, msgClient))
+	}
+
+	return outputs, true
+}
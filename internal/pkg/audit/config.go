@@ -0,0 +1,59 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+// AuditInfo provides properties related to recording the security-relevant requests answered by the service in an
+// audit trail, with their actor, source address and outcome
+type AuditInfo struct {
+	// Enabled indicates whether the requests are audited
+	Enabled bool
+	// IdentityHeader carries the name of the user authenticated by the gateway, e.g. 'X-Consumer-Username'; without
+	// it, the actor of a request is the common name of its client certificate, if any
+	IdentityHeader string
+	// TrustedProxies are the addresses and CIDR ranges of the gateways whose identity and forwarded headers are
+	// trusted
+	TrustedProxies []string
+	// Rules select the audited requests and their category, the first rule matching the route and method of a
+	// request applying; the requests denied with 401 or 403 are audited whether a rule matches them or not
+	Rules []RuleInfo
+	// BufferSize is the number of records waiting to be written, beyond which the records are dropped and logged
+	BufferSize int
+	// File appends the records to a file
+	File FileOutputInfo
+	// MessageBus publishes the records on a topic of the message bus
+	MessageBus MessageBusOutputInfo
+}
+
+// RuleInfo audits the requests of a route
+type RuleInfo struct {
+	// Route is the route template of the requests, e.g. '/api/v1/device/{id}', a template ending with '*' matching the
+	// routes it prefixes and '*' matching all of them
+	Route string
+	// Methods are the HTTP methods of the requests, all of them when empty
+	Methods []string
+	// Category is recorded along with the requests, e.g. 'configuration', 'delete' or 'command'
+	Category string
+}
+
+// FileOutputInfo provides properties related to appending the records to a file, one JSON record per line
+type FileOutputInfo struct {
+	Enabled bool
+	// Path is the file the records are appended to, created with mode 0600 when missing
+	Path string
+}
+
+// MessageBusOutputInfo provides properties related to publishing the records on the message bus
+type MessageBusOutputInfo struct {
+	Enabled bool
+	// Type is the message bus implementation, e.g. 'zero', 'mqtt' or 'redisstreams'
+	Type     string
+	Protocol string
+	Host     string
+	Port     int
+	// Topic the records are published on, e.g. 'edgex/audit'
+	Topic    string
+	Optional map[string]string
+}
@@ -0,0 +1,177 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	msgTypes "github.com/edgexfoundry/go-mod-messaging/pkg/types"
+)
+
+// defaultBufferSize is the number of records waiting to be written, when none is configured.
+const defaultBufferSize = 1024
+
+// Output writes the records to an audit trail.
+type Output interface {
+	Name() string
+	Write(record Record, payload []byte) error
+	Close() error
+}
+
+// Sink writes the records to the outputs in the background, in the order they're recorded, so that auditing a request
+// doesn't delay its response.
+type Sink struct {
+	records chan Record
+	outputs []Output
+	lc      logger.LoggingClient
+
+	mutex   sync.Mutex
+	stopped bool
+}
+
+// NewSink creates a Sink writing to the outputs, with up to bufferSize records waiting to be written.
+func NewSink(bufferSize int, outputs []Output, lc logger.LoggingClient) *Sink {
+	if bufferSize <= 0 {
+		bufferSize = defaultBufferSize
+	}
+	return &Sink{
+		records: make(chan Record, bufferSize),
+		outputs: outputs,
+		lc:      lc,
+	}
+}
+
+// Record queues the record to be written. The record is dropped and logged when the buffer is full, or once the
+// service is stopping.
+func (s *Sink) Record(record Record) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if !s.stopped {
+		select {
+		case s.records <- record:
+			return
+		default:
+		}
+	}
+	s.lc.Error(fmt.Sprintf(
+		"audit record dropped: %s %s by '%s' from %s, %s",
+		record.Method, record.Path, record.Actor, record.SourceIP, record.Outcome))
+}
+
+// Run writes the records until ctx is done, then writes the ones still waiting and closes the outputs.
+func (s *Sink) Run(ctx context.Context, wg *sync.WaitGroup) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case record := <-s.records:
+				s.write(record)
+			case <-ctx.Done():
+				s.mutex.Lock()
+				s.stopped = true
+				close(s.records)
+				s.mutex.Unlock()
+
+				for record := range s.records {
+					s.write(record)
+				}
+				for _, output := range s.outputs {
+					if err := output.Close(); err != nil {
+						s.lc.Error(fmt.Sprintf("failed to close the %s audit output: %s", output.Name(), err.Error()))
+					}
+				}
+				return
+			}
+		}
+	}()
+}
+
+// write writes the record to every output, a failing output not preventing the others from being written to.
+func (s *Sink) write(record Record) {
+	payload, err := json.Marshal(record)
+	if err != nil {
+		s.lc.Error(fmt.Sprintf("failed to encode the audit record: %s", err.Error()))
+		return
+	}
+	for _, output := range s.outputs {
+		if err := output.Write(record, payload); err != nil {
+			s.lc.Error(fmt.Sprintf("failed to write the audit record to %s: %s", output.Name(), err.Error()))
+		}
+	}
+}
+
+// FileOutput appends the records to a file, one JSON record per line.
+type FileOutput struct {
+	file *os.File
+}
+
+// NewFileOutput opens the file at path for appending, creating it when missing.
+func NewFileOutput(path string) (*FileOutput, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return &FileOutput{file: file}, nil
+}
+
+func (o *FileOutput) Name() string {
+	return "file"
+}
+
+// Write appends the record as a single line, written at once for the lines not to interleave.
+func (o *FileOutput) Write(_ Record, payload []byte) error {
+	_, err := o.file.Write(append(payload, '\n'))
+	return err
+}
+
+func (o *FileOutput) Close() error {
+	return o.file.Close()
+}
+
+// publisher publishes messages on the message bus, as the message client does.
+type publisher interface {
+	Publish(message msgTypes.MessageEnvelope, topic string) error
+	Disconnect() error
+}
+
+// MessageBusOutput publishes the records on a topic of the message bus.
+type MessageBusOutput struct {
+	topic     string
+	publisher publisher
+}
+
+// NewMessageBusOutput creates a MessageBusOutput publishing the records on topic.
+func NewMessageBusOutput(topic string, publisher publisher) *MessageBusOutput {
+	return &MessageBusOutput{
+		topic:     topic,
+		publisher: publisher,
+	}
+}
+
+func (o *MessageBusOutput) Name() string {
+	return "message bus"
+}
+
+// Write publishes the record as a JSON message, with the correlation ID of its request.
+func (o *MessageBusOutput) Write(record Record, payload []byte) error {
+	return o.publisher.Publish(msgTypes.MessageEnvelope{
+		CorrelationID: record.CorrelationId,
+		ContentType:   clients.ContentTypeJSON,
+		Payload:       payload,
+	}, o.topic)
+}
+
+func (o *MessageBusOutput) Close() error {
+	return o.publisher.Disconnect()
+}
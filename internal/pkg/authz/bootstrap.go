@@ -0,0 +1,79 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+
+	"github.com/gorilla/mux"
+)
+
+// GuardName contains the name of the *Guard implementation in the DIC.
+var GuardName = di.TypeInstanceToName(Guard{})
+
+// GuardFrom helper function queries the DIC and returns the *Guard implementation, or nil when the authorization
+// isn't bootstrapped.
+func GuardFrom(get di.Get) *Guard {
+	guard, _ := get(GuardName).(*Guard)
+	return guard
+}
+
+// Bootstrap contains references to dependencies required by the BootstrapHandler.
+type Bootstrap struct {
+	router     *mux.Router
+	serviceKey string
+	rbacInfo   *RBACInfo
+	agentInfo  *AuthorizationInfo
+}
+
+// NewBootstrap is a factory method that returns an initialized Bootstrap receiver struct.
+func NewBootstrap(router *mux.Router, serviceKey string, rbacInfo *RBACInfo, agentInfo *AuthorizationInfo) *Bootstrap {
+	return &Bootstrap{
+		router:     router,
+		serviceKey: serviceKey,
+		rbacInfo:   rbacInfo,
+		agentInfo:  agentInfo,
+	}
+}
+
+// BootstrapHandler fulfills the BootstrapHandler contract. It adds the Guard of the service to the DIC, and when RBAC
+// or the policy agent is enabled, every request answered by the router is first authorized by it.
+func (b *Bootstrap) BootstrapHandler(_ context.Context, _ *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
+	lc := container.LoggingClientFrom(dic.Get)
+	guard, err := NewGuard(b.serviceKey, *b.rbacInfo, *b.agentInfo, lc)
+	if err != nil {
+		lc.Error(err.Error())
+		return false
+	}
+	dic.Update(di.ServiceConstructorMap{
+		GuardName: func(get di.Get) interface{} {
+			return guard
+		},
+	})
+	if !guard.Enabled() {
+		return true
+	}
+	b.router.Use(guard.Middleware())
+
+	if b.rbacInfo.Enabled {
+		lc.Info(fmt.Sprintf("Authorizing requests from the role of the identity in %s, with %d policies",
+			b.rbacInfo.IdentityHeader, len(b.rbacInfo.Policies)))
+	}
+	if b.agentInfo.Enabled {
+		mode := "closed"
+		if b.agentInfo.FailOpen {
+			mode = "open"
+		}
+		lc.Info(fmt.Sprintf("Authorizing requests with the policy agent at %s, failing %s", b.agentInfo.Url, mode))
+	}
+	return true
+}
@@ -0,0 +1,63 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+// AuthorizationInfo provides properties related to delegating the authorization of the requests to an Open Policy
+// Agent
+type AuthorizationInfo struct {
+	// Enabled indicates whether the requests are authorized by the policy agent
+	Enabled bool
+	// Url is the decision endpoint of the policy agent, e.g. 'http://localhost:8181/v1/data/edgex/authz/allow'
+	Url string
+	// Timeout is how long a decision is waited for, e.g. '2s'
+	Timeout string
+	// CacheTTL is how long a decision is reused for identical requests, e.g. '30s'; decisions aren't cached when empty
+	CacheTTL string
+	// CacheSize is the maximum number of decisions cached
+	CacheSize int
+	// FailOpen indicates whether the requests are allowed when the policy agent can't be reached, otherwise they
+	// are rejected with 503
+	FailOpen bool
+	// SkipPaths are the route templates never submitted to the policy agent, e.g. '/api/v1/ping'
+	SkipPaths []string
+}
+
+// RBACInfo provides properties related to authorizing the requests from the role of their authenticated identity
+type RBACInfo struct {
+	// Enabled indicates whether the requests are authorized from the role of their identity
+	Enabled bool
+	// IdentityHeader carries the name of the user authenticated by the gateway, e.g. 'X-Consumer-Username'
+	IdentityHeader string
+	// GroupsHeader carries the comma separated groups of the user authenticated by the gateway, e.g.
+	// 'X-Consumer-Groups'
+	GroupsHeader string
+	// TrustedProxies are the addresses and CIDR ranges of the gateways whose identity headers are trusted; the
+	// requests received from elsewhere are anonymous
+	TrustedProxies []string
+	// Users maps the names of the users to their role, reader, operator or admin; the users with neither a role nor
+	// a group with one are rejected with 403
+	Users map[string]string
+	// Groups maps the groups to the role of their users, a user in several groups getting the highest of their roles
+	Groups map[string]string
+	// AnonymousRole is the role of the requests without identity, which are rejected with 401 when empty
+	AnonymousRole string
+	// Policies are the roles required by the routes, the first policy matching the route and method of a request
+	// applying; the requests matched by none require the admin role
+	Policies []PolicyInfo
+	// SkipPaths are the route templates never authorized, e.g. '/api/v1/ping'
+	SkipPaths []string
+}
+
+// PolicyInfo requires a role for the requests of a route
+type PolicyInfo struct {
+	// Route is the route template of the requests, e.g. '/api/v1/event/id/{id}', a template ending with '*' matching
+	// the routes it prefixes and '*' matching all of them
+	Route string
+	// Methods are the HTTP methods of the requests, all of them when empty
+	Methods []string
+	// Role is the lowest role allowed, reader, operator or admin
+	Role string
+}
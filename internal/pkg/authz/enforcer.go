@@ -0,0 +1,144 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/trustedproxy"
+)
+
+// policy is a parsed PolicyInfo.
+type policy struct {
+	route   string
+	prefix  bool
+	methods map[string]bool
+	role    Role
+}
+
+// matches tells whether the policy applies to the requests of method matched by the route template.
+func (p policy) matches(route string, method string) bool {
+	if len(p.methods) > 0 && !p.methods[method] {
+		return false
+	}
+	if p.prefix {
+		return strings.HasPrefix(route, p.route)
+	}
+	return route == p.route
+}
+
+// Enforcer authorizes the requests from the role of the identity authenticated by the gateway, reader, operator or
+// admin, against the policies of the routes defined in the configuration: it resolves the role of the requests and
+// the role required by their routes.
+type Enforcer struct {
+	identityHeader string
+	groupsHeader   string
+	proxies        *trustedproxy.Proxies
+	users          map[string]Role
+	groups         map[string]Role
+	anonymous      Role
+	policies       []policy
+	skipPaths      map[string]bool
+}
+
+// NewEnforcer creates an Enforcer, validating the roles, policies and trusted proxies of info.
+func NewEnforcer(info RBACInfo) (*Enforcer, error) {
+	if info.IdentityHeader == "" {
+		return nil, errors.New("no RBAC IdentityHeader configured")
+	}
+	proxies, err := trustedproxy.NewProxies(info.TrustedProxies)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &Enforcer{
+		identityHeader: info.IdentityHeader,
+		groupsHeader:   info.GroupsHeader,
+		proxies:        proxies,
+		users:          make(map[string]Role, len(info.Users)),
+		groups:         make(map[string]Role, len(info.Groups)),
+		skipPaths:      make(map[string]bool, len(info.SkipPaths)),
+	}
+	for user, name := range info.Users {
+		if e.users[user], err = ParseRole(name); err != nil {
+			return nil, fmt.Errorf("invalid role of the RBAC user '%s': %v", user, err)
+		}
+	}
+	for group, name := range info.Groups {
+		if e.groups[group], err = ParseRole(name); err != nil {
+			return nil, fmt.Errorf("invalid role of the RBAC group '%s': %v", group, err)
+		}
+	}
+	if info.AnonymousRole != "" {
+		if e.anonymous, err = ParseRole(info.AnonymousRole); err != nil {
+			return nil, fmt.Errorf("invalid RBAC AnonymousRole: %v", err)
+		}
+	}
+	for i, p := range info.Policies {
+		if p.Route == "" {
+			return nil, fmt.Errorf("no route for the RBAC policy #%d", i+1)
+		}
+		parsed := policy{route: strings.TrimSuffix(p.Route, "*"), prefix: strings.HasSuffix(p.Route, "*")}
+		if parsed.role, err = ParseRole(p.Role); err != nil {
+			return nil, fmt.Errorf("invalid role of the RBAC policy of route '%s': %v", p.Route, err)
+		}
+		if len(p.Methods) > 0 {
+			parsed.methods = make(map[string]bool, len(p.Methods))
+			for _, method := range p.Methods {
+				parsed.methods[strings.ToUpper(method)] = true
+			}
+		}
+		e.policies = append(e.policies, parsed)
+	}
+	for _, path := range info.SkipPaths {
+		e.skipPaths[path] = true
+	}
+	return e, nil
+}
+
+// Skips reports whether the requests of the route template are never authorized.
+func (e *Enforcer) Skips(route string) bool {
+	return e.skipPaths[route]
+}
+
+// Identify returns the user of r and their role, the highest of the role of the user and the roles of their groups.
+// The identity headers are only trusted when r is received from a trusted proxy; otherwise, or when they are absent,
+// the request is anonymous and the user empty. A user with neither a role nor a group with one has the role None.
+func (e *Enforcer) Identify(r *http.Request) (string, Role) {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	user := strings.TrimSpace(r.Header.Get(e.identityHeader))
+	if user == "" || !e.proxies.Trusts(net.ParseIP(host)) {
+		return "", e.anonymous
+	}
+
+	role := e.users[user]
+	if e.groupsHeader != "" {
+		for _, group := range strings.Split(r.Header.Get(e.groupsHeader), ",") {
+			if groupRole := e.groups[strings.TrimSpace(group)]; groupRole > role {
+				role = groupRole
+			}
+		}
+	}
+	return user, role
+}
+
+// Required returns the role required by the requests of method matched by the route template, from the first policy
+// matching them, or admin when none does.
+func (e *Enforcer) Required(route string, method string) Role {
+	for _, p := range e.policies {
+		if p.matches(route, method) {
+			return p.role
+		}
+	}
+	return Admin
+}
@@ -0,0 +1,113 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const gatewayAddr = "10.0.0.2:41234"
+
+func newTestInfo() RBACInfo {
+	return RBACInfo{
+		Enabled:        true,
+		IdentityHeader: "X-Consumer-Username",
+		GroupsHeader:   "X-Consumer-Groups",
+		TrustedProxies: []string{"10.0.0.0/24"},
+		Users:          map[string]string{"alice": "admin", "dashboard": "reader"},
+		Groups:         map[string]string{"operators": "operator", "viewers": "reader"},
+		Policies: []PolicyInfo{
+			{Route: "/api/v1/event*", Methods: []string{"delete"}, Role: "operator"},
+			{Route: "*", Methods: []string{"GET", "HEAD"}, Role: "reader"},
+			{Route: "/api/v1/deviceprofile", Methods: []string{"POST", "PUT"}, Role: "operator"},
+		},
+		SkipPaths: []string{"/api/v1/ping"},
+	}
+}
+
+func newTestRequest(method string, path string, remoteAddr string, user string, groups string) *http.Request {
+	r := httptest.NewRequest(method, path, nil)
+	r.RemoteAddr = remoteAddr
+	if user != "" {
+		r.Header.Set("X-Consumer-Username", user)
+	}
+	if groups != "" {
+		r.Header.Set("X-Consumer-Groups", groups)
+	}
+	return r
+}
+
+func TestNewEnforcerValidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(info *RBACInfo)
+	}{
+		{"no identity header", func(info *RBACInfo) { info.IdentityHeader = "" }},
+		{"invalid trusted proxy", func(info *RBACInfo) { info.TrustedProxies = []string{"gateway"} }},
+		{"invalid user role", func(info *RBACInfo) { info.Users["bob"] = "root" }},
+		{"invalid group role", func(info *RBACInfo) { info.Groups["guests"] = "none" }},
+		{"invalid anonymous role", func(info *RBACInfo) { info.AnonymousRole = "guest" }},
+		{"policy without route", func(info *RBACInfo) { info.Policies = append(info.Policies, PolicyInfo{Role: "admin"}) }},
+		{"invalid policy role", func(info *RBACInfo) { info.Policies[0].Role = "" }},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			info := newTestInfo()
+			testCase.modify(&info)
+			_, err := NewEnforcer(info)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestIdentify(t *testing.T) {
+	enforcer, err := NewEnforcer(newTestInfo())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		request      *http.Request
+		expectedUser string
+		expectedRole Role
+	}{
+		{"user role", newTestRequest(http.MethodGet, "/", gatewayAddr, "alice", ""), "alice", Admin},
+		{"group role", newTestRequest(http.MethodGet, "/", gatewayAddr, "bob", "viewers"), "bob", Reader},
+		{"highest role", newTestRequest(http.MethodGet, "/", gatewayAddr, "dashboard", "viewers, operators"), "dashboard", Operator},
+		{"unknown user", newTestRequest(http.MethodGet, "/", gatewayAddr, "mallory", "guests"), "mallory", None},
+		{"no identity", newTestRequest(http.MethodGet, "/", gatewayAddr, "", ""), "", None},
+		{"untrusted peer", newTestRequest(http.MethodGet, "/", "192.168.1.10:41234", "alice", ""), "", None},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			user, role := enforcer.Identify(testCase.request)
+			assert.Equal(t, testCase.expectedUser, user)
+			assert.Equal(t, testCase.expectedRole, role)
+		})
+	}
+
+	info := newTestInfo()
+	info.AnonymousRole = "Reader"
+	enforcer, err = NewEnforcer(info)
+	require.NoError(t, err)
+	_, role := enforcer.Identify(newTestRequest(http.MethodGet, "/", "192.168.1.10:41234", "alice", ""))
+	assert.Equal(t, Reader, role, "the requests without trusted identity should be anonymous")
+}
+
+func TestRequired(t *testing.T) {
+	enforcer, err := NewEnforcer(newTestInfo())
+	require.NoError(t, err)
+
+	assert.Equal(t, Operator, enforcer.Required("/api/v1/event/id/{id}", http.MethodDelete))
+	assert.Equal(t, Reader, enforcer.Required("/api/v1/event/id/{id}", http.MethodGet))
+	assert.Equal(t, Operator, enforcer.Required("/api/v1/deviceprofile", http.MethodPost))
+	assert.Equal(t, Admin, enforcer.Required("/api/v1/deviceprofile/id/{id}", http.MethodDelete),
+		"the requests matched by no policy should require admin")
+}
@@ -0,0 +1,133 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package authz authorizes the requests answered by a service, from the role of the identity authenticated by the
+// gateway (RBAC) and by delegating the decision to an Open Policy Agent, whether they're received by its router or
+// through another transport such as the message bus.
+package authz
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/gorilla/mux"
+)
+
+type userKey struct{}
+
+// WithUser returns a copy of ctx carrying the user authenticated for the request.
+func WithUser(ctx context.Context, user string) context.Context {
+	return context.WithValue(ctx, userKey{}, user)
+}
+
+// UserFrom returns the user authenticated for the request of ctx, which is empty for the anonymous requests or when
+// RBAC is disabled.
+func UserFrom(ctx context.Context) string {
+	user, _ := ctx.Value(userKey{}).(string)
+	return user
+}
+
+// Guard applies the authorizations enabled for a service to its requests. Its enforcer is nil when RBAC is disabled,
+// and its agent when the policy agent is.
+type Guard struct {
+	enforcer *Enforcer
+	agent    *PolicyAgent
+	lc       logger.LoggingClient
+}
+
+// NewGuard creates a Guard applying the authorizations enabled by rbacInfo and agentInfo to the requests answered
+// by the service.
+func NewGuard(serviceKey string, rbacInfo RBACInfo, agentInfo AuthorizationInfo, lc logger.LoggingClient) (*Guard, error) {
+	g := &Guard{lc: lc}
+	var err error
+	if rbacInfo.Enabled {
+		if g.enforcer, err = NewEnforcer(rbacInfo); err != nil {
+			return nil, err
+		}
+	}
+	if agentInfo.Enabled {
+		if g.agent, err = NewPolicyAgent(serviceKey, agentInfo); err != nil {
+			return nil, err
+		}
+	}
+	return g, nil
+}
+
+// Enabled reports whether the requests are authorized at all, so that the sensitive requests can be refused when
+// they're not. It is nil-safe.
+func (g *Guard) Enabled() bool {
+	return g != nil && (g.enforcer != nil || g.agent != nil)
+}
+
+// Authorize returns the user authenticated for r, matched by the route template, along with http.StatusOK when r is
+// allowed, or otherwise the status it is rejected with: 401 when it has no identity and anonymous requests have no
+// role, 403 when its user has no role, a role below the one required by the route or is denied by the policy agent,
+// and 503 when the policy agent can't be reached unless FailOpen is set.
+func (g *Guard) Authorize(r *http.Request, route string) (string, int) {
+	correlationId := r.Header.Get(clients.CorrelationHeader)
+
+	var user string
+	if g.enforcer != nil && !g.enforcer.Skips(route) {
+		var role Role
+		user, role = g.enforcer.Identify(r)
+		if role == None && user == "" {
+			return "", http.StatusUnauthorized
+		}
+		if required := g.enforcer.Required(route, r.Method); role < required {
+			g.lc.Debug(
+				fmt.Sprintf("%s %s denied to '%s' with role %s, %s required", r.Method, r.URL.Path, user, role, required),
+				clients.CorrelationHeader,
+				correlationId)
+			return user, http.StatusForbidden
+		}
+	}
+
+	if g.agent != nil && !g.agent.Skips(route) {
+		allowed, err := g.agent.Authorize(g.agent.NewInput(r, route))
+		if err != nil {
+			g.lc.Error(fmt.Sprintf("authorization of %s %s failed: %v", r.Method, r.URL.Path, err), clients.CorrelationHeader, correlationId)
+			if !allowed {
+				return user, http.StatusServiceUnavailable
+			}
+		}
+		if !allowed {
+			g.lc.Debug(fmt.Sprintf("%s %s denied by the policy agent", r.Method, r.URL.Path), clients.CorrelationHeader, correlationId)
+			return user, http.StatusForbidden
+		}
+	}
+	return user, http.StatusOK
+}
+
+// Middleware rejects the requests which aren't authorized before they reach their handler, and passes the user
+// authenticated for the others in their context.
+func (g *Guard) Middleware() mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route := r.URL.Path
+			if current := mux.CurrentRoute(r); current != nil {
+				if template, err := current.GetPathTemplate(); err == nil {
+					route = template
+				}
+			}
+
+			user, status := g.Authorize(r, route)
+			switch status {
+			case http.StatusOK:
+				next.ServeHTTP(w, r.WithContext(WithUser(r.Context(), user)))
+			case http.StatusUnauthorized:
+				w.Header().Set("WWW-Authenticate", "Bearer")
+				http.Error(w, "unauthorized", status)
+			case http.StatusServiceUnavailable:
+				http.Error(w, "authorization service unavailable", status)
+			default:
+				http.Error(w, "forbidden", status)
+			}
+		})
+	}
+}
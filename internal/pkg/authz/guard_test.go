@@ -0,0 +1,114 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserFrom(t *testing.T) {
+	assert.Empty(t, UserFrom(context.Background()))
+	assert.Equal(t, "alice", UserFrom(WithUser(context.Background(), "alice")))
+}
+
+func TestGuardEnabled(t *testing.T) {
+	var guard *Guard
+	assert.False(t, guard.Enabled())
+
+	guard, err := NewGuard("edgex-core-data", RBACInfo{}, AuthorizationInfo{}, logger.NewMockClient())
+	require.NoError(t, err)
+	assert.False(t, guard.Enabled())
+
+	guard, err = NewGuard("edgex-core-data", newTestInfo(), AuthorizationInfo{}, logger.NewMockClient())
+	require.NoError(t, err)
+	assert.True(t, guard.Enabled())
+
+	_, err = NewGuard("edgex-core-data", RBACInfo{Enabled: true}, AuthorizationInfo{}, logger.NewMockClient())
+	assert.Error(t, err)
+}
+
+func TestMiddlewareRBAC(t *testing.T) {
+	guard, err := NewGuard("edgex-core-data", newTestInfo(), AuthorizationInfo{}, logger.NewMockClient())
+	require.NoError(t, err)
+
+	router := mux.NewRouter()
+	router.Use(guard.Middleware())
+	var user string
+	ok := func(w http.ResponseWriter, r *http.Request) {
+		user = UserFrom(r.Context())
+		w.WriteHeader(http.StatusOK)
+	}
+	router.HandleFunc("/api/v1/ping", ok).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/event/id/{id}", ok).Methods(http.MethodGet, http.MethodDelete)
+	router.HandleFunc("/api/v1/deviceprofile/id/{id}", ok).Methods(http.MethodDelete)
+
+	tests := []struct {
+		name           string
+		request        *http.Request
+		expectedStatus int
+		expectedUser   string
+	}{
+		{"skipped", newTestRequest(http.MethodGet, "/api/v1/ping", "192.168.1.10:41234", "", ""), http.StatusOK, ""},
+		{"anonymous", newTestRequest(http.MethodGet, "/api/v1/event/id/e1", gatewayAddr, "", ""), http.StatusUnauthorized, ""},
+		{"unknown user", newTestRequest(http.MethodGet, "/api/v1/event/id/e1", gatewayAddr, "mallory", "guests"), http.StatusForbidden, ""},
+		{"reader reads", newTestRequest(http.MethodGet, "/api/v1/event/id/e1", gatewayAddr, "dashboard", ""), http.StatusOK, "dashboard"},
+		{"reader deletes", newTestRequest(http.MethodDelete, "/api/v1/event/id/e1", gatewayAddr, "dashboard", ""), http.StatusForbidden, ""},
+		{"operator deletes event", newTestRequest(http.MethodDelete, "/api/v1/event/id/e1", gatewayAddr, "bob", "operators"), http.StatusOK, "bob"},
+		{"operator deletes profile", newTestRequest(http.MethodDelete, "/api/v1/deviceprofile/id/p1", gatewayAddr, "bob", "operators"), http.StatusForbidden, ""},
+		{"admin deletes profile", newTestRequest(http.MethodDelete, "/api/v1/deviceprofile/id/p1", gatewayAddr, "alice", ""), http.StatusOK, "alice"},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			user = ""
+			rr := httptest.NewRecorder()
+			router.ServeHTTP(rr, testCase.request)
+			assert.Equal(t, testCase.expectedStatus, rr.Code)
+			assert.Equal(t, testCase.expectedUser, user)
+		})
+	}
+}
+
+func TestMiddlewarePolicyAgent(t *testing.T) {
+	server, queries := newTestAgent(t, func(allow bool) interface{} { return allow })
+	unreachable := httptest.NewServer(http.NotFoundHandler())
+	unreachable.Close()
+
+	tests := []struct {
+		name           string
+		info           AuthorizationInfo
+		token          string
+		expectedStatus int
+	}{
+		{"allowed", AuthorizationInfo{Enabled: true, Url: server.URL}, "admin", http.StatusOK},
+		{"denied", AuthorizationInfo{Enabled: true, Url: server.URL}, "guest", http.StatusForbidden},
+		{"skipped", AuthorizationInfo{Enabled: true, Url: server.URL, SkipPaths: []string{testRoute}}, "guest", http.StatusOK},
+		{"unreachable, fail closed", AuthorizationInfo{Enabled: true, Url: unreachable.URL}, "admin", http.StatusServiceUnavailable},
+		{"unreachable, fail open", AuthorizationInfo{Enabled: true, Url: unreachable.URL, FailOpen: true}, "guest", http.StatusOK},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			guard, err := NewGuard("edgex-core-metadata", RBACInfo{}, testCase.info, logger.NewMockClient())
+			require.NoError(t, err)
+			router := mux.NewRouter()
+			router.HandleFunc(testRoute, func(w http.ResponseWriter, r *http.Request) {}).Methods(http.MethodGet)
+			router.Use(guard.Middleware())
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, newTestAgentRequest(testCase.token))
+			assert.Equal(t, testCase.expectedStatus, recorder.Code)
+		})
+	}
+	assert.Equal(t, 2, *queries)
+}
@@ -0,0 +1,213 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const defaultTimeout = 2 * time.Second
+
+// Input describes the request submitted to the policy agent as the input of the decision
+type Input struct {
+	Service  string   `json:"service"`
+	Method   string   `json:"method"`
+	Path     string   `json:"path"`
+	Route    string   `json:"route"`
+	Segments []string `json:"segments"`
+	Token    string   `json:"token,omitempty"`
+}
+
+type decision struct {
+	allowed bool
+	expires time.Time
+}
+
+// PolicyAgent delegates the authorization of the requests to an Open Policy Agent, caching its decisions locally so
+// that the agent isn't queried for every identical request.
+type PolicyAgent struct {
+	serviceKey string
+	url        string
+	client     *http.Client
+	ttl        time.Duration
+	size       int
+	failOpen   bool
+	skipPaths  map[string]bool
+
+	mutex     sync.Mutex
+	decisions map[string]decision
+	now       func() time.Time
+}
+
+// NewPolicyAgent creates an PolicyAgent for the requests answered by the service, validating the durations of info.
+func NewPolicyAgent(serviceKey string, info AuthorizationInfo) (*PolicyAgent, error) {
+	if info.Url == "" {
+		return nil, errors.New("no Authorization Url configured")
+	}
+	timeout := defaultTimeout
+	if info.Timeout != "" {
+		var err error
+		if timeout, err = time.ParseDuration(info.Timeout); err != nil || timeout <= 0 {
+			return nil, fmt.Errorf("invalid Authorization Timeout '%s'", info.Timeout)
+		}
+	}
+	var ttl time.Duration
+	if info.CacheTTL != "" {
+		var err error
+		if ttl, err = time.ParseDuration(info.CacheTTL); err != nil || ttl < 0 {
+			return nil, fmt.Errorf("invalid Authorization CacheTTL '%s'", info.CacheTTL)
+		}
+	}
+
+	skipPaths := make(map[string]bool, len(info.SkipPaths))
+	for _, path := range info.SkipPaths {
+		skipPaths[path] = true
+	}
+	return &PolicyAgent{
+		serviceKey: serviceKey,
+		url:        info.Url,
+		client:     &http.Client{Timeout: timeout},
+		ttl:        ttl,
+		size:       info.CacheSize,
+		failOpen:   info.FailOpen,
+		skipPaths:  skipPaths,
+		decisions:  make(map[string]decision),
+		now:        time.Now,
+	}, nil
+}
+
+// Skips reports whether the requests of the route template are never submitted to the policy agent.
+func (a *PolicyAgent) Skips(route string) bool {
+	return a.skipPaths[route]
+}
+
+// NewInput describes the request, matched by the route template, as the input of a decision. The token is read from
+// the Authorization header of the request.
+func (a *PolicyAgent) NewInput(r *http.Request, route string) Input {
+	return Input{
+		Service:  a.serviceKey,
+		Method:   r.Method,
+		Path:     r.URL.Path,
+		Route:    route,
+		Segments: strings.Split(strings.Trim(r.URL.Path, "/"), "/"),
+		Token:    strings.TrimSpace(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")),
+	}
+}
+
+// Authorize returns whether the policy agent allows the request described by input. When the agent can't be reached
+// or answers an unexpected response, the error is returned along with the fallback decision of the FailOpen setting.
+func (a *PolicyAgent) Authorize(input Input) (bool, error) {
+	body, err := json.Marshal(struct {
+		Input Input `json:"input"`
+	}{input})
+	if err != nil {
+		return a.failOpen, err
+	}
+
+	hash := sha256.Sum256(body)
+	key := hex.EncodeToString(hash[:])
+	if allowed, ok := a.cached(key); ok {
+		return allowed, nil
+	}
+
+	allowed, err := a.query(body)
+	if err != nil {
+		return a.failOpen, err
+	}
+	a.cache(key, allowed)
+	return allowed, nil
+}
+
+// query posts the input to the policy agent and reads its decision, either a boolean result or a result object with
+// an allow field. An undefined result denies the request.
+func (a *PolicyAgent) query(body []byte) (bool, error) {
+	resp, err := a.client.Post(a.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return false, fmt.Errorf("unable to query the policy agent: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("policy agent answered with status %d", resp.StatusCode)
+	}
+
+	var response struct {
+		Result json.RawMessage `json:"result"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return false, fmt.Errorf("unable to decode the decision of the policy agent: %v", err)
+	}
+	if len(response.Result) == 0 {
+		return false, nil
+	}
+
+	var allowed bool
+	if err = json.Unmarshal(response.Result, &allowed); err == nil {
+		return allowed, nil
+	}
+	var result struct {
+		Allow bool `json:"allow"`
+	}
+	if err = json.Unmarshal(response.Result, &result); err != nil {
+		return false, fmt.Errorf("unexpected decision of the policy agent: %s", string(response.Result))
+	}
+	return result.Allow, nil
+}
+
+func (a *PolicyAgent) cached(key string) (bool, bool) {
+	if a.ttl <= 0 {
+		return false, false
+	}
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	d, ok := a.decisions[key]
+	if !ok {
+		return false, false
+	}
+	if !a.now().Before(d.expires) {
+		delete(a.decisions, key)
+		return false, false
+	}
+	return d.allowed, true
+}
+
+// cache keeps the decision for the TTL. When the cache is full, the expired decisions are evicted first and an
+// arbitrary one otherwise.
+func (a *PolicyAgent) cache(key string, allowed bool) {
+	if a.ttl <= 0 || a.size <= 0 {
+		return
+	}
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	now := a.now()
+	if len(a.decisions) >= a.size {
+		for k, d := range a.decisions {
+			if !now.Before(d.expires) {
+				delete(a.decisions, k)
+			}
+		}
+	}
+	if len(a.decisions) >= a.size {
+		for k := range a.decisions {
+			delete(a.decisions, k)
+			break
+		}
+	}
+	a.decisions[key] = decision{allowed: allowed, expires: now.Add(a.ttl)}
+}
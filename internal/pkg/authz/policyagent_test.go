@@ -0,0 +1,135 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRoute = "/api/v1/device/name/{name}"
+
+// newTestAgent starts a policy agent allowing the requests of the admin token and counting the queries
+func newTestAgent(t *testing.T, result func(allow bool) interface{}) (*httptest.Server, *int) {
+	queries := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries++
+		var body struct {
+			Input Input `json:"input"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"result": result(body.Input.Token == "admin")})
+	}))
+	t.Cleanup(server.Close)
+	return server, &queries
+}
+
+func newTestAgentRequest(token string) *http.Request {
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/device/name/d1", nil)
+	if token != "" {
+		r.Header.Set("Authorization", "Bearer "+token)
+	}
+	return r
+}
+
+func TestNewPolicyAgentValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		info AuthorizationInfo
+	}{
+		{"no url", AuthorizationInfo{}},
+		{"invalid timeout", AuthorizationInfo{Url: "http://localhost:8181", Timeout: "soon"}},
+		{"invalid cache ttl", AuthorizationInfo{Url: "http://localhost:8181", CacheTTL: "-1s"}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := NewPolicyAgent("edgex-core-metadata", testCase.info)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	results := map[string]func(allow bool) interface{}{
+		"boolean result": func(allow bool) interface{} { return allow },
+		"object result":  func(allow bool) interface{} { return map[string]bool{"allow": allow} },
+	}
+	for name, result := range results {
+		t.Run(name, func(t *testing.T) {
+			server, _ := newTestAgent(t, result)
+			authorizer, err := NewPolicyAgent("edgex-core-metadata", AuthorizationInfo{Url: server.URL})
+			require.NoError(t, err)
+
+			allowed, err := authorizer.Authorize(authorizer.NewInput(newTestAgentRequest("admin"), testRoute))
+			require.NoError(t, err)
+			assert.True(t, allowed)
+
+			allowed, err = authorizer.Authorize(authorizer.NewInput(newTestAgentRequest("guest"), testRoute))
+			require.NoError(t, err)
+			assert.False(t, allowed)
+		})
+	}
+}
+
+func TestAuthorizeUndefinedResult(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+	authorizer, err := NewPolicyAgent("edgex-core-metadata", AuthorizationInfo{Url: server.URL})
+	require.NoError(t, err)
+
+	allowed, err := authorizer.Authorize(authorizer.NewInput(newTestAgentRequest("admin"), testRoute))
+	require.NoError(t, err)
+	assert.False(t, allowed)
+}
+
+func TestAuthorizeCache(t *testing.T) {
+	server, queries := newTestAgent(t, func(allow bool) interface{} { return allow })
+	authorizer, err := NewPolicyAgent("edgex-core-metadata", AuthorizationInfo{Url: server.URL, CacheTTL: "30s", CacheSize: 1})
+	require.NoError(t, err)
+	now := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+	authorizer.now = func() time.Time { return now }
+
+	admin := authorizer.NewInput(newTestAgentRequest("admin"), testRoute)
+	guest := authorizer.NewInput(newTestAgentRequest("guest"), testRoute)
+
+	for i := 0; i < 3; i++ {
+		allowed, err := authorizer.Authorize(admin)
+		require.NoError(t, err)
+		assert.True(t, allowed)
+	}
+	assert.Equal(t, 1, *queries, "identical requests should reuse the cached decision")
+
+	now = now.Add(31 * time.Second)
+	_, _ = authorizer.Authorize(admin)
+	assert.Equal(t, 2, *queries, "expired decisions should be queried again")
+
+	_, _ = authorizer.Authorize(guest)
+	_, _ = authorizer.Authorize(admin)
+	assert.Equal(t, 4, *queries, "the cache should hold no more than CacheSize decisions")
+}
+
+func TestAuthorizeUnreachable(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+
+	for _, failOpen := range []bool{true, false} {
+		authorizer, err := NewPolicyAgent("edgex-core-metadata", AuthorizationInfo{Url: url, FailOpen: failOpen})
+		require.NoError(t, err)
+
+		allowed, err := authorizer.Authorize(authorizer.NewInput(newTestAgentRequest("admin"), testRoute))
+		assert.Error(t, err)
+		assert.Equal(t, failOpen, allowed)
+	}
+}
@@ -0,0 +1,46 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Role grants the rights of the roles below it along with its own.
+type Role int
+
+const (
+	// None is the role of the requests without identity when no anonymous role is configured
+	None Role = iota
+	// Reader reads the data and the metadata
+	Reader
+	// Operator also adds and updates them
+	Operator
+	// Admin is allowed every request
+	Admin
+)
+
+var roleNames = map[Role]string{
+	None:     "none",
+	Reader:   "reader",
+	Operator: "operator",
+	Admin:    "admin",
+}
+
+// ParseRole returns the role named s, case insensitively.
+func ParseRole(s string) (Role, error) {
+	for role, name := range roleNames {
+		if role != None && strings.EqualFold(strings.TrimSpace(s), name) {
+			return role, nil
+		}
+	}
+	return None, fmt.Errorf("unknown role '%s', expected reader, operator or admin", s)
+}
+
+func (r Role) String() string {
+	return roleNames[r]
+}
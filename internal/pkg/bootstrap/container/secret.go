@@ -0,0 +1,20 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/interfaces"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// SecretProviderName contains the name of the interfaces.SecretProvider implementation in the DIC.
+var SecretProviderName = di.TypeInstanceToName((*interfaces.SecretProvider)(nil))
+
+// SecretProviderFrom helper function queries the DIC and returns the interfaces.SecretProvider implementation.
+func SecretProviderFrom(get di.Get) interfaces.SecretProvider {
+	return get(SecretProviderName).(interfaces.SecretProvider)
+}
@@ -16,6 +16,7 @@ package database
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -65,15 +66,20 @@ func NewDatabaseForCoreData(httpServer httpServer, database interfaces.Database)
 	}
 }
 
-// Return the dbClient interface
+// Return the dbClient interface, of a client of its own rather than the one shared by the process when dedicated.
 func (d Database) newDBClient(
 	lc logger.LoggingClient,
-	credentials bootstrapConfig.Credentials) (dbInterfaces.DBClient, error) {
+	databaseInfo bootstrapConfig.Database,
+	credentials bootstrapConfig.Credentials,
+	dedicated bool) (dbInterfaces.DBClient, error) {
 
-	databaseInfo := d.database.GetDatabaseInfo()["Primary"]
 	switch databaseInfo.Type {
 	// Deprecated: Mongo functionality is deprecated as of the Geneva release.
 	case db.MongoDB:
+		if dedicated {
+			// the readings of the events are de-referenced with the Mongo client of the process
+			return nil, errors.New("a second Mongo database can't be connected along with the first one")
+		}
 		return mongo.NewClient(
 			db.Configuration{
 				Host:         databaseInfo.Host,
@@ -89,9 +95,17 @@ func (d Database) newDBClient(
 			Port:     databaseInfo.Port,
 			Password: credentials.Password,
 		}
+		if decoding, ok := d.database.(interfaces.DatabaseDecoding); ok {
+			conf.DecodePolicy = decoding.GetDatabaseDecodePolicy()
+		}
 
-		if d.isCoreData {
+		switch {
+		case d.isCoreData && dedicated:
+			return redis.NewDedicatedCoreDataClient(conf, lc)
+		case d.isCoreData:
 			return redis.NewCoreDataClient(conf, lc)
+		case dedicated:
+			return redis.NewDedicatedClient(conf, lc)
 		}
 		return redis.NewClient(conf, lc)
 	default:
@@ -99,20 +113,27 @@ func (d Database) newDBClient(
 	}
 }
 
-// BootstrapHandler fulfills the BootstrapHandler contract and initializes the database.
-func (d Database) BootstrapHandler(
-	ctx context.Context,
-	wg *sync.WaitGroup,
-	startupTimer startup.Timer,
-	dic *di.Container) bool {
+// Connect creates a client of the named database of the configuration, retrying until the startup timer elapses.
+func (d Database) Connect(name string, startupTimer startup.Timer, dic *di.Container) (dbInterfaces.DBClient, bool) {
+	return d.connect(name, false, startupTimer, dic)
+}
+
+// ConnectDedicated creates a client of its own, rather than the one shared by the process, of the named database of
+// the configuration, so that it can be connected along with another database of the same type. It retries until the
+// startup timer elapses.
+func (d Database) ConnectDedicated(name string, startupTimer startup.Timer, dic *di.Container) (dbInterfaces.DBClient, bool) {
+	return d.connect(name, true, startupTimer, dic)
+}
 
+func (d Database) connect(name string, dedicated bool, startupTimer startup.Timer, dic *di.Container) (dbInterfaces.DBClient, bool) {
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	databaseInfo := d.database.GetDatabaseInfo()[name]
 
 	// get database credentials.
 	var credentials bootstrapConfig.Credentials
 	for startupTimer.HasNotElapsed() {
 		var err error
-		credentials, err = bootstrapContainer.CredentialsProviderFrom(dic.Get).GetDatabaseCredentials(d.database.GetDatabaseInfo()["Primary"])
+		credentials, err = bootstrapContainer.CredentialsProviderFrom(dic.Get).GetDatabaseCredentials(databaseInfo)
 		if err == nil {
 			break
 		}
@@ -124,7 +145,7 @@ func (d Database) BootstrapHandler(
 	var dbClient dbInterfaces.DBClient
 	for startupTimer.HasNotElapsed() {
 		var err error
-		dbClient, err = d.newDBClient(lc, credentials)
+		dbClient, err = d.newDBClient(lc, databaseInfo, credentials, dedicated)
 		if err == nil {
 			break
 		}
@@ -135,6 +156,21 @@ func (d Database) BootstrapHandler(
 
 	if dbClient == nil {
 		lc.Error(fmt.Sprintf("failed to create database client in allotted time"))
+		return nil, false
+	}
+	return dbClient, true
+}
+
+// BootstrapHandler fulfills the BootstrapHandler contract and initializes the database.
+func (d Database) BootstrapHandler(
+	ctx context.Context,
+	wg *sync.WaitGroup,
+	startupTimer startup.Timer,
+	dic *di.Container) bool {
+
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	dbClient, ok := d.Connect("Primary", startupTimer, dic)
+	if !ok {
 		return false
 	}
 
@@ -0,0 +1,102 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+const (
+	// RepairDecodingFlag is the command-line flag naming the file the report of the decoding repair is written to.
+	RepairDecodingFlag = "repair-decoding"
+	// RepairDecodingUsage describes the RepairDecodingFlag.
+	RepairDecodingUsage = "Rewrite the stored objects failing the strict decoding without their unknown fields, write a JSON report to the given file and exit"
+	// RepairDecodingDryRunFlag is the command-line flag turning the decoding repair into a dry run.
+	RepairDecodingDryRunFlag = "repair-decoding-dry-run"
+	// RepairDecodingDryRunUsage describes the RepairDecodingDryRunFlag.
+	RepairDecodingDryRunUsage = "Only report the stored objects the decoding repair would rewrite"
+)
+
+// decodingRepairer is implemented by the database clients able to repair the stored objects failing the strict
+// decoding.
+type decodingRepairer interface {
+	RepairDecoding(dryRun bool) (db.DecodingRepairReport, error)
+}
+
+// DecodingRepair contains references to dependencies required by the decoding repair bootstrap implementation.
+type DecodingRepair struct {
+	reportPath string
+	dryRun     bool
+}
+
+// NewDecodingRepair is a factory method that returns an initialized DecodingRepair receiver struct writing its report
+// to reportPath.
+func NewDecodingRepair(reportPath string, dryRun bool) DecodingRepair {
+	return DecodingRepair{
+		reportPath: reportPath,
+		dryRun:     dryRun,
+	}
+}
+
+// BootstrapHandler fulfills the BootstrapHandler contract. It repairs the stored objects with the database client,
+// writes the report and exits instead of completing the startup.
+func (r DecodingRepair) BootstrapHandler(
+	_ context.Context,
+	_ *sync.WaitGroup,
+	_ startup.Timer,
+	dic *di.Container) bool {
+
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	repairer, ok := container.DBClientFrom(dic.Get).(decodingRepairer)
+	if !ok {
+		lc.Error("the decoding repair isn't supported by the configured database")
+		return false
+	}
+
+	lc.Info(fmt.Sprintf("Decoding repair started, dry run: %t", r.dryRun))
+	report, err := repairer.RepairDecoding(r.dryRun)
+	if err != nil {
+		lc.Error(fmt.Sprintf("decoding repair failed: %s", err.Error()))
+		return false
+	}
+
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		lc.Error(fmt.Sprintf("unable to encode the decoding repair report: %s", err.Error()))
+		return false
+	}
+	if err = ioutil.WriteFile(r.reportPath, data, 0644); err != nil {
+		lc.Error(fmt.Sprintf("unable to write the decoding repair report to %s: %s", r.reportPath, err.Error()))
+		return false
+	}
+
+	var repaired, failed int
+	for _, collection := range report.Collections {
+		repaired += collection.Repaired
+		failed += len(collection.Failures)
+	}
+	lc.Info(fmt.Sprintf(
+		"Decoding repair completed: %d objects repaired, %d not decodable, report written to %s",
+		repaired,
+		failed,
+		r.reportPath))
+
+	os.Exit(0)
+	return true
+}
@@ -0,0 +1,116 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dependencies
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	pkgContainer "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dependency"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/interfaces"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+const (
+	defaultInterval = 30 * time.Second
+	defaultTimeout  = 5 * time.Second
+)
+
+// messageBus is implemented by the configurations of the services connecting to the broker of a message bus.
+type messageBus interface {
+	// MessageBusAddress returns the host:port of the broker, and false when the service doesn't connect to one
+	MessageBusAddress() (string, bool)
+}
+
+// Bootstrap contains references to dependencies required by the BootstrapHandler.
+type Bootstrap struct {
+	configuration interfaces.Configuration
+	info          *dependency.DependencyCheckInfo
+}
+
+// NewBootstrap is a factory method that returns an initialized Bootstrap receiver struct.
+func NewBootstrap(configuration interfaces.Configuration, info *dependency.DependencyCheckInfo) *Bootstrap {
+	return &Bootstrap{
+		configuration: configuration,
+		info:          info,
+	}
+}
+
+// BootstrapHandler fulfills the BootstrapHandler contract. When the dependency check is enabled, the dependencies of
+// the service found in the DIC and its configuration are checked in the background, for the ping to report their
+// latencies. It must run after the database is connected.
+func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
+	if !b.info.Enabled {
+		return true
+	}
+
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	interval, ok := parseDuration(b.info.Interval, defaultInterval)
+	if !ok {
+		lc.Error(fmt.Sprintf("invalid DependencyCheck Interval '%s'", b.info.Interval))
+		return false
+	}
+	timeout, ok := parseDuration(b.info.Timeout, defaultTimeout)
+	if !ok {
+		lc.Error(fmt.Sprintf("invalid DependencyCheck Timeout '%s'", b.info.Timeout))
+		return false
+	}
+
+	var probes []dependency.Probe
+	if pinger, ok := dic.Get(pkgContainer.DBClientInterfaceName).(dependency.Pinger); ok {
+		probes = append(probes, dependency.DatabaseProbe("database", pinger))
+	}
+	if registryClient := bootstrapContainer.RegistryFrom(dic.Get); registryClient != nil {
+		probes = append(probes, dependency.RegistryProbe(registryClient))
+	}
+	if secretStore := b.configuration.GetBootstrap().SecretStore; secretStore.Host != "" &&
+		os.Getenv("EDGEX_SECURITY_SECRET_STORE") != "false" {
+		probe, err := dependency.SecretStoreProbe(secretStore)
+		if err != nil {
+			lc.Error(err.Error())
+			return false
+		}
+		probes = append(probes, probe)
+	}
+	if bus, ok := b.configuration.(messageBus); ok {
+		if address, ok := bus.MessageBusAddress(); ok {
+			probes = append(probes, dependency.DialProbe("messagebus", address))
+		}
+	}
+
+	checker := dependency.NewChecker(probes, timeout)
+	checker.Start(ctx, wg, interval)
+	dic.Update(di.ServiceConstructorMap{
+		container.DependencyCheckerName: func(get di.Get) interface{} {
+			return checker
+		},
+	})
+
+	names := make([]string, len(probes))
+	for i, probe := range probes {
+		names[i] = probe.Name
+	}
+	lc.Info(fmt.Sprintf("Checking the dependencies [%s] every %s", strings.Join(names, ", "), interval))
+	return true
+}
+
+// parseDuration parses the duration, fallback when empty.
+func parseDuration(value string, fallback time.Duration) (time.Duration, bool) {
+	if value == "" {
+		return fallback, true
+	}
+	duration, err := time.ParseDuration(value)
+	return duration, err == nil && duration > 0
+}
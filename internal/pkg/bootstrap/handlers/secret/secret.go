@@ -0,0 +1,169 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package secret
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/handlers/secret/client"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+
+	"github.com/edgexfoundry/go-mod-secrets/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/pkg/providers/vault"
+	"github.com/edgexfoundry/go-mod-secrets/pkg/token/authtokenloader"
+	"github.com/edgexfoundry/go-mod-secrets/pkg/token/fileioperformer"
+)
+
+// ErrSecurityDisabled is returned when reading a secret while the secret store is disabled.
+var ErrSecurityDisabled = errors.New("the secret store is disabled, EDGEX_SECURITY_SECRET_STORE is false")
+
+// SecretProvider reads the secrets of the secret store. Besides the credentials and certificates the bootstrap secret
+// provider reads, it reads the secrets stored under any path with any keys.
+type SecretProvider struct {
+	secretClient pkg.SecretClient
+}
+
+// NewSecret is a factory method that returns an initialized SecretProvider receiver struct.
+func NewSecret() *SecretProvider {
+	return &SecretProvider{}
+}
+
+// BootstrapHandler fulfills the BootstrapHandler contract. It replaces the bootstrap secret handler: it creates the
+// client of the secret store when security is enabled, and adds the provider to the DIC as the credentials,
+// certificate and secret provider, so it must run first.
+func (s *SecretProvider) BootstrapHandler(
+	ctx context.Context,
+	_ *sync.WaitGroup,
+	startupTimer startup.Timer,
+	dic *di.Container) bool {
+
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	if isSecurityEnabled() {
+		var err error
+
+		lc.Info("Creating SecretClient")
+
+		secretStoreConfig := bootstrapContainer.ConfigurationFrom(dic.Get).GetBootstrap().SecretStore
+		for startupTimer.HasNotElapsed() {
+			var secretConfig vault.SecretConfig
+			secretConfig, err = getSecretConfig(secretStoreConfig)
+			if err == nil {
+				var secretClient pkg.SecretClient
+				secretClient, err = client.NewVault(ctx, secretConfig, lc).Get(secretStoreConfig)
+				if err == nil {
+					s.secretClient = secretClient
+					lc.Info("Created SecretClient")
+					break
+				}
+			}
+
+			lc.Warn(fmt.Sprintf("Retryable failure while creating SecretClient: %s", err.Error()))
+			startupTimer.SleepForInterval()
+		}
+
+		if err != nil {
+			lc.Error(fmt.Sprintf("unable to create SecretClient: %s", err.Error()))
+			return false
+		}
+	}
+
+	dic.Update(di.ServiceConstructorMap{
+		bootstrapContainer.CredentialsProviderName: func(get di.Get) interface{} {
+			return s
+		},
+		bootstrapContainer.CertificateProviderName: func(get di.Get) interface{} {
+			return s
+		},
+		container.SecretProviderName: func(get di.Get) interface{} {
+			return s
+		},
+	})
+
+	return true
+}
+
+// GetSecrets returns the values of the keys of the secret stored under path, or all of them when no key is given. It
+// fails when security is disabled, as there is no secret store to read them from.
+func (s *SecretProvider) GetSecrets(path string, keys ...string) (map[string]string, error) {
+	if s.secretClient == nil {
+		return nil, ErrSecurityDisabled
+	}
+	return s.secretClient.GetSecrets(path, keys...)
+}
+
+// GetDatabaseCredentials returns the username and password stored under the path given as the database type, or the
+// credentials of the configuration when security is disabled, as the bootstrap secret provider does.
+func (s *SecretProvider) GetDatabaseCredentials(database config.Database) (config.Credentials, error) {
+	if s.secretClient == nil {
+		return config.Credentials{
+			Username: database.Username,
+			Password: database.Password,
+		}, nil
+	}
+
+	secrets, err := s.secretClient.GetSecrets(database.Type, "username", "password")
+	if err != nil {
+		return config.Credentials{}, err
+	}
+	return config.Credentials{
+		Username: secrets["username"],
+		Password: secrets["password"],
+	}, nil
+}
+
+// GetCertificateKeyPair returns the certificate and key stored under path.
+func (s *SecretProvider) GetCertificateKeyPair(path string) (config.CertKeyPair, error) {
+	secrets, err := s.GetSecrets(path, "cert", "key")
+	if err != nil {
+		return config.CertKeyPair{}, err
+	}
+	return config.CertKeyPair{
+		Cert: secrets["cert"],
+		Key:  secrets["key"],
+	}, nil
+}
+
+// getSecretConfig creates a SecretConfig based on the SecretStoreInfo configuration properties, the token read from
+// the TokenFile overriding the Authentication.AuthToken value.
+func getSecretConfig(secretStoreInfo config.SecretStoreInfo) (vault.SecretConfig, error) {
+	secretConfig := vault.SecretConfig{
+		Host:                    secretStoreInfo.Host,
+		Port:                    secretStoreInfo.Port,
+		Path:                    secretStoreInfo.Path,
+		Protocol:                secretStoreInfo.Protocol,
+		Namespace:               secretStoreInfo.Namespace,
+		RootCaCertPath:          secretStoreInfo.RootCaCertPath,
+		ServerName:              secretStoreInfo.ServerName,
+		Authentication:          secretStoreInfo.Authentication,
+		AdditionalRetryAttempts: secretStoreInfo.AdditionalRetryAttempts,
+		RetryWaitPeriod:         secretStoreInfo.RetryWaitPeriod,
+	}
+	if secretStoreInfo.TokenFile == "" {
+		return secretConfig, nil
+	}
+
+	token, err := authtokenloader.NewAuthTokenLoader(fileioperformer.NewDefaultFileIoPerformer()).Load(secretStoreInfo.TokenFile)
+	if err != nil {
+		return secretConfig, err
+	}
+	secretConfig.Authentication.AuthToken = token
+	return secretConfig, nil
+}
+
+// isSecurityEnabled determines if security has been enabled.
+func isSecurityEnabled() bool {
+	return os.Getenv("EDGEX_SECURITY_SECRET_STORE") != "false"
+}
@@ -21,3 +21,9 @@ type Database interface {
 	// GetDatabaseInfo returns a database information map.
 	GetDatabaseInfo() map[string]config.Database
 }
+
+// DatabaseDecoding is implemented by the configurations setting how the objects read from the database are decoded.
+type DatabaseDecoding interface {
+	// GetDatabaseDecodePolicy returns the decode policy of the objects read from the database, lenient when empty.
+	GetDatabaseDecodePolicy() string
+}
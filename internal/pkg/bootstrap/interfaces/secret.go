@@ -0,0 +1,12 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package interfaces
+
+// SecretProvider interface provides an abstraction for reading the secrets of the secret store.
+type SecretProvider interface {
+	// GetSecrets returns the values of the keys of the secret stored under path, or all of them when no key is given.
+	GetSecrets(path string, keys ...string) (map[string]string, error)
+}
@@ -0,0 +1,25 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dependency"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// DependencyCheckerName contains the name of the dependency.Checker implementation in the DIC.
+var DependencyCheckerName = di.TypeInstanceToName(dependency.Checker{})
+
+// DependencyCheckerFrom helper function queries the DIC and returns the dependency.Checker implementation, nil when
+// the dependencies aren't checked.
+func DependencyCheckerFrom(get di.Get) *dependency.Checker {
+	checker, ok := get(DependencyCheckerName).(*dependency.Checker)
+	if !ok {
+		return nil
+	}
+	return checker
+}
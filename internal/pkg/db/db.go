@@ -32,6 +32,7 @@ const (
 	EventsCollection          = "event"
 	ReadingsCollection        = "reading"
 	ValueDescriptorCollection = "valueDescriptor"
+	RollupCollection          = "rollup"
 
 	//Export
 	ExportCollection = "exportConfiguration"
@@ -45,15 +46,28 @@ const (
 	DeviceService    = "deviceService"
 	Addressable      = "addressable"
 	Command          = "command"
+	CommandHistory   = "commandHistory"
 	DeviceReport     = "deviceReport"
 	ProvisionWatcher = "provisionWatcher"
 	Interval         = "interval"
 	IntervalAction   = "intervalAction"
+	SchedulerLeader  = "schedulerLeader"
 
 	// Notification
-	Notification = "notification"
-	Subscription = "subscription"
-	Transmission = "transmission"
+	Notification         = "notification"
+	Subscription         = "subscription"
+	Transmission         = "transmission"
+	SeverityMapping      = "severityMapping"
+	SubscriptionFilter   = "subscriptionFilter"
+	Template             = "template"
+	SubscriptionTemplate = "subscriptionTemplate"
+	EscalationPolicy     = "escalationPolicy"
+	Escalation           = "escalation"
+	RetryPolicy          = "retryPolicy"
+	DeliveryReceipt      = "deliveryReceipt"
+	TransmissionHistory  = "transmissionHistory"
+	RoutingPolicy        = "routingPolicy"
+	ResendCancellation   = "resendCancellation"
 )
 
 var (
@@ -62,6 +76,7 @@ var (
 	ErrInvalidObjectId     = errors.New("Invalid object ID")
 	ErrNotUnique           = errors.New("Resource already exists")
 	ErrCommandStillInUse   = errors.New("Command is still in use by device profiles")
+	ErrTemplateStillInUse  = errors.New("Template is still in use by subscriptions")
 	ErrSlugEmpty           = errors.New("Slug is nil or empty")
 	ErrNameEmpty           = errors.New("Name is required")
 )
@@ -75,6 +90,8 @@ type Configuration struct {
 	Username     string
 	Password     string
 	BatchSize    int
+	// DecodePolicy of the objects read, lenient when empty
+	DecodePolicy string
 }
 
 func MakeTimestamp() int64 {
@@ -0,0 +1,82 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+)
+
+// Decode policies of the objects read from the database
+const (
+	// DecodeLenient ignores the fields of a stored object unknown to the service, e.g. left by another version of it,
+	// and counts the objects decoded in spite of them. It is the default.
+	DecodeLenient = "lenient"
+	// DecodeStrict fails decoding a stored object with fields unknown to the service.
+	DecodeStrict = "strict"
+)
+
+// lenientDecodes is the number of objects decoded in spite of their unknown fields since the service started
+var lenientDecodes int64
+
+// DecodingInfo configures how the objects read from the database are decoded.
+type DecodingInfo struct {
+	// Policy is lenient, the default, or strict.
+	Policy string
+}
+
+// ValidateDecodePolicy checks the decode policy of the objects read from the database, lenient when empty.
+func ValidateDecodePolicy(policy string) error {
+	switch policy {
+	case "", DecodeLenient, DecodeStrict:
+		return nil
+	}
+	return fmt.Errorf("unknown decode policy %s, expected %s or %s", policy, DecodeLenient, DecodeStrict)
+}
+
+// ErrUnknownFields is the error of a stored object with fields unknown to the service, decoded with the strict policy.
+type ErrUnknownFields struct {
+	Fields []string
+}
+
+func (e ErrUnknownFields) Error() string {
+	return fmt.Sprintf("stored object has unknown fields %s", strings.Join(e.Fields, ", "))
+}
+
+// RecordLenientDecode counts an object decoded in spite of its unknown fields.
+func RecordLenientDecode() int64 {
+	return atomic.AddInt64(&lenientDecodes, 1)
+}
+
+// LenientDecodes returns the number of objects decoded in spite of their unknown fields since the service started.
+func LenientDecodes() int64 {
+	return atomic.LoadInt64(&lenientDecodes)
+}
+
+// DecodingRepairFailure describes a stored object which couldn't be repaired, and why.
+type DecodingRepairFailure struct {
+	Id     string `json:"id"`
+	Reason string `json:"reason"`
+}
+
+// DecodingRepairCollection reports the repair of the objects of a collection failing the strict decoding.
+type DecodingRepairCollection struct {
+	Name     string                  `json:"name"`
+	Objects  int                     `json:"objects"`
+	Repaired int                     `json:"repaired"`
+	Fields   []string                `json:"fields,omitempty"`
+	Failures []DecodingRepairFailure `json:"failures,omitempty"`
+}
+
+// DecodingRepairReport is the outcome of the repair of the stored objects failing the strict decoding, rewritten
+// without their unknown fields. Nothing is rewritten by a dry run. Times are epoch milliseconds.
+type DecodingRepairReport struct {
+	DryRun      bool                       `json:"dryRun"`
+	Started     int64                      `json:"started"`
+	Completed   int64                      `json:"completed"`
+	Collections []DecodingRepairCollection `json:"collections"`
+}
@@ -0,0 +1,56 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+// Phases of the answer of a query
+const (
+	// PhaseScan reads a range of an index
+	PhaseScan = "scan"
+	// PhaseFilter checks the members read from an index against another index
+	PhaseFilter = "filter"
+	// PhaseIntersect intersects indexes into a temporary one
+	PhaseIntersect = "intersect"
+	// PhaseFetch reads the objects listed by an index
+	PhaseFetch = "fetch"
+	// PhaseCleanup deletes the temporary indexes
+	PhaseCleanup = "cleanup"
+	// PhaseProcess is the time not spent waiting for the database, decoding the objects and encoding the response
+	PhaseProcess = "process"
+)
+
+// QueryIndex is an index read to answer a query.
+type QueryIndex struct {
+	// Name is the key of the index, with the IDs of the objects it is specific to replaced by '{id}'
+	Name string `json:"name"`
+	// Keys is the number of indexes of the name read, more than one for the indexes specific to objects
+	Keys int `json:"keys"`
+	// Members is the number of entries of the indexes of the name
+	Members int64 `json:"members"`
+}
+
+// QueryPhase is a step of the answer of a query, totalling the commands of the same kind sent to the database for
+// the same indexes.
+type QueryPhase struct {
+	Name    string   `json:"name"`
+	Command string   `json:"command,omitempty"`
+	Indexes []string `json:"indexes,omitempty"`
+	// Calls is the number of commands sent
+	Calls int `json:"calls"`
+	// Scanned is the number of index members or objects the commands read
+	Scanned int64   `json:"scanned"`
+	Ms      float64 `json:"ms"`
+}
+
+// QueryExplanation explains how the database answered a query, given instead of its results: the indexes read, and
+// the members scanned and the time spent in each phase, in order.
+type QueryExplanation struct {
+	Database string       `json:"database"`
+	Indexes  []QueryIndex `json:"indexes"`
+	Phases   []QueryPhase `json:"phases"`
+	// Returned is the number of results of the query
+	Returned int     `json:"returned"`
+	Ms       float64 `json:"ms"`
+}
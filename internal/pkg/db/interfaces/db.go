@@ -15,7 +15,11 @@
 package interfaces
 
 import (
+	"time"
+
+	data "github.com/edgexfoundry/edgex-go/internal/core/data/models"
 	correlation "github.com/edgexfoundry/edgex-go/internal/pkg/correlation/models"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
 
 	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
 )
@@ -35,8 +39,10 @@ type DBClient interface {
 	EventsByChecksum(checksum string) ([]contract.Event, error)
 	EventCount() (int, error)
 	EventCountByDeviceId(id string) (int, error)
+	EventCountsByDevice(device string, start int64, end int64, window int64) ([]int64, error)
 	DeleteEventById(id string) error
 	DeleteEventsByDevice(deviceId string) (int, error)
+	RenameDeviceData(from string, to string) (int, error)
 	EventsForDeviceLimit(id string, limit int) ([]contract.Event, error)
 	EventsForDevice(id string) ([]contract.Event, error)
 	EventsByCreationTime(startTime, endTime int64, limit int) ([]contract.Event, error)
@@ -76,6 +82,14 @@ type DBClient interface {
 	ValueDescriptorsByType(t string) ([]contract.ValueDescriptor, error)
 	ScrubAllValueDescriptors() error
 
+	/*
+		Rollups
+	*/
+	MergeRollup(r data.Rollup) error
+	RollupsByDevice(period string, device string, start int64, end int64, limit int) ([]data.Rollup, error)
+	RollupsByDeviceAndName(period string, device string, name string, start int64, end int64, limit int) ([]data.Rollup, error)
+	DeleteRollupsBefore(period string, start int64) error
+
 	/*
 		Device Reports
 	*/
@@ -213,9 +227,24 @@ type DBClient interface {
 	GetTransmissionsByStart(start int64, limit int) ([]contract.Transmission, error)
 	GetTransmissionsByEnd(end int64, limit int) ([]contract.Transmission, error)
 	GetTransmissionsByStatus(limit int, status contract.TransmissionStatus) ([]contract.Transmission, error)
+	GetTransmissionsByStatusAndTimeRange(status contract.TransmissionStatus, start int64, end int64, limit int) ([]contract.Transmission, error)
 
 	Cleanup() error
 	CleanupOld(age int) error
+	DeleteProcessedNotificationsByModifiedRange(severity contract.NotificationsSeverity, start int64, end int64) (int, error)
+	DeleteTransmissionsByModifiedRange(start int64, end int64) (int, error)
+
+	/*
+		Transmission Histories
+	*/
+	AddTransmissionHistoryRecord(id string, r contract.TransmissionRecord) error
+	GetTransmissionHistory(id string) ([]contract.TransmissionRecord, error)
+
+	/*
+		Resend Cancellations
+	*/
+	SetResendCancellation(slug string, cancelled int64) error
+	GetResendCancellation(slug string) (int64, error)
 
 	/*
 		Intervals
@@ -241,6 +270,38 @@ type DBClient interface {
 	UpdateIntervalAction(action contract.IntervalAction) error
 	DeleteIntervalActionById(id string) error
 
+	/*
+		Interval Scheduling
+	*/
+	IntervalTimezones() (map[string]string, error)
+	SetIntervalTimezone(id string, timezone string) error
+	IntervalMisfirePolicies() (map[string]string, error)
+	SetIntervalMisfirePolicy(id string, policy string) error
+	IntervalLastRuns() (map[string]int64, error)
+	SetIntervalLastRun(id string, lastRun int64) error
+	IntervalMaxIterations() (map[string]int64, error)
+	SetIntervalMaxIterations(id string, maxIterations int64) error
+	IntervalRemainingIterations() (map[string]int64, error)
+	SetIntervalRemainingIterations(id string, remaining int64) error
+	IntervalActionPolicies() (map[string]schedulerModels.ExecutionPolicy, error)
+	SetIntervalActionPolicy(id string, policy schedulerModels.ExecutionPolicy) error
+	IntervalActionDependencies() (map[string][]string, error)
+	SetIntervalActionDependencies(id string, runAfter []string) error
+	IntervalActionAuthHeaders() (map[string][]schedulerModels.AuthHeader, error)
+	SetIntervalActionAuthHeaders(id string, headers []schedulerModels.AuthHeader) error
+
+	/*
+		Interval Action Executions
+	*/
+	AddIntervalActionExecution(e schedulerModels.IntervalActionExecution, expired int64, max int) (string, error)
+	IntervalActionExecutionsByName(name string, limit int) ([]schedulerModels.IntervalActionExecution, error)
+
+	/*
+		Scheduler Leader Election
+	*/
+	AcquireSchedulerLease(instance string, ttl time.Duration) (bool, error)
+	ReleaseSchedulerLease(instance string) error
+
 	ScrubAllIntervalActions() (int, error)
 	ScrubAllIntervals() (int, error)
 }
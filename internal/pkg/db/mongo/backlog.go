@@ -0,0 +1,50 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mongo
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db/mongo/models"
+	notifications "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+	"github.com/globalsign/mgo/bson"
+)
+
+// GetNotificationBacklog counts the notifications by status and the failed transmissions by channel type, finding the
+// oldest NEW notification by sorting them by creation.
+func (mc MongoClient) GetNotificationBacklog() (notifications.NotificationBacklog, error) {
+	s := mc.getSessionCopy()
+	defer s.Close()
+
+	b := notifications.NewNotificationBacklog()
+	col := s.DB(mc.database.Name).C(NOTIFICATION_COLLECTION)
+	for _, status := range notifications.BacklogStatuses {
+		count, err := col.Find(bson.M{"status": status}).Count()
+		if err != nil {
+			return b, errorMap(err)
+		}
+		b.Notifications[status] = count
+	}
+
+	var oldest models.Notification
+	err := errorMap(col.Find(bson.M{"status": contract.New}).Sort("created").One(&oldest))
+	if err == nil {
+		b.AddPending(oldest.Created)
+	} else if err != db.ErrNotFound {
+		return b, err
+	}
+
+	var pending []models.Transmission
+	err = s.DB(mc.database.Name).C(TRANSMISSION_COLLECTION).Find(bson.M{"status": contract.Failed}).All(&pending)
+	if err != nil {
+		return b, errorMap(err)
+	}
+	for _, t := range pending {
+		b.AddPendingTransmission(t.ToContract())
+	}
+	return b, nil
+}
@@ -195,6 +195,25 @@ func (mc MongoClient) DeleteEventsByDevice(deviceId string) (int, error) {
 	return i.Removed, err
 }
 
+// Move the events and readings of a device to its new name
+func (mc MongoClient) RenameDeviceData(from string, to string) (int, error) {
+	s := mc.getSessionCopy()
+	defer s.Close()
+
+	rename := bson.M{"$set": bson.M{"device": to}}
+	_, err := s.DB(mc.database.Name).C(db.ReadingsCollection).UpdateAll(bson.M{"device": from}, rename)
+	if err != nil {
+		return 0, err
+	}
+
+	i, err := s.DB(mc.database.Name).C(db.EventsCollection).UpdateAll(bson.M{"device": from}, rename)
+	if err != nil {
+		return 0, err
+	}
+
+	return i.Updated, nil
+}
+
 // Get a list of events based on the device id and limit
 func (mc MongoClient) EventsForDeviceLimit(id string, limit int) ([]contract.Event, error) {
 	return mc.mapEvents(mc.getEventsLimit(bson.M{"device": id}, limit))
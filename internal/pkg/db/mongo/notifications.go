@@ -405,6 +405,10 @@ func (mc MongoClient) GetTransmissionsByStatus(limit int, status contract.Transm
 	return mc.getTransmissionsLimit(bson.M{"status": status}, limit)
 }
 
+func (mc MongoClient) GetTransmissionsByStatusAndTimeRange(status contract.TransmissionStatus, start int64, end int64, limit int) ([]contract.Transmission, error) {
+	return mc.getTransmissionsLimit(bson.M{"status": status, "created": bson.M{"$gte": start, "$lte": end}}, limit)
+}
+
 func (mc MongoClient) getTransmission(q bson.M) (c contract.Transmission, err error) {
 	s := mc.getSessionCopy()
 	defer s.Close()
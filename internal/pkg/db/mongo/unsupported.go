@@ -0,0 +1,309 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mongo
+
+import (
+	"time"
+
+	command "github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	data "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	notifications "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+	scheduler "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// The functions in this file satisfy DBClient for functionality introduced after Mongo was deprecated in the Geneva
+// release. They are intentionally not implemented and report db.ErrUnsupportedDatabase; Redis should be used instead.
+
+func (mc MongoClient) GetSeverityMappings() ([]notifications.SeverityMapping, error) {
+	return nil, db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) GetSeverityMappingByName(name string) (notifications.SeverityMapping, error) {
+	return notifications.SeverityMapping{}, db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) AddSeverityMapping(m notifications.SeverityMapping) (string, error) {
+	return "", db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) UpdateSeverityMapping(m notifications.SeverityMapping) error {
+	return db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) DeleteSeverityMappingByName(name string) error {
+	return db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) GetSubscriptionFilterBySlug(slug string) (notifications.SubscriptionFilter, error) {
+	return notifications.SubscriptionFilter{}, db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) AddSubscriptionFilter(f notifications.SubscriptionFilter) (string, error) {
+	return "", db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) UpdateSubscriptionFilter(f notifications.SubscriptionFilter) error {
+	return db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) DeleteSubscriptionFilterBySlug(slug string) error {
+	return db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) AddCommandHistory(h command.CommandHistory) (string, error) {
+	return "", db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) GetCommandHistory(start int64, end int64, limit int) ([]command.CommandHistory, error) {
+	return nil, db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) GetCommandHistoryByDeviceId(id string, start int64, end int64, limit int) ([]command.CommandHistory, error) {
+	return nil, db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) GetCommandHistoryByDeviceName(name string, start int64, end int64, limit int) ([]command.CommandHistory, error) {
+	return nil, db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) DeleteCommandHistoryBefore(expired int64, max int) (int, error) {
+	return 0, db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) GetTemplates() ([]notifications.Template, error) {
+	return nil, db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) GetTemplateByName(name string) (notifications.Template, error) {
+	return notifications.Template{}, db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) AddTemplate(t notifications.Template) (string, error) {
+	return "", db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) UpdateTemplate(t notifications.Template) error {
+	return db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) DeleteTemplateByName(name string) error {
+	return db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) GetSubscriptionTemplate(slug string) (string, error) {
+	return "", db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) SetSubscriptionTemplate(slug string, template string) error {
+	return db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) DeleteSubscriptionTemplate(slug string) error {
+	return db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) GetEscalationPolicyBySlug(slug string) (notifications.EscalationPolicy, error) {
+	return notifications.EscalationPolicy{}, db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) AddEscalationPolicy(p notifications.EscalationPolicy) (string, error) {
+	return "", db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) UpdateEscalationPolicy(p notifications.EscalationPolicy) error {
+	return db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) DeleteEscalationPolicyBySlug(slug string) error {
+	return db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) AddEscalation(e notifications.Escalation) (string, error) {
+	return "", db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) UpdateEscalation(e notifications.Escalation) error {
+	return db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) GetEscalationByTransmission(id string) (notifications.Escalation, error) {
+	return notifications.Escalation{}, db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) GetDueEscalations(deadline int64) ([]notifications.Escalation, error) {
+	return nil, db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) GetRetryPolicyBySlug(slug string) (notifications.RetryPolicy, error) {
+	return notifications.RetryPolicy{}, db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) AddRetryPolicy(p notifications.RetryPolicy) (string, error) {
+	return "", db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) UpdateRetryPolicy(p notifications.RetryPolicy) error {
+	return db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) DeleteRetryPolicyBySlug(slug string) error {
+	return db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) GetRoutingPolicyBySlug(slug string) (notifications.RoutingPolicy, error) {
+	return notifications.RoutingPolicy{}, db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) AddRoutingPolicy(p notifications.RoutingPolicy) (string, error) {
+	return "", db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) UpdateRoutingPolicy(p notifications.RoutingPolicy) error {
+	return db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) DeleteRoutingPolicyBySlug(slug string) error {
+	return db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) AddDeliveryReceipt(r notifications.DeliveryReceipt) (string, error) {
+	return "", db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) GetDeliveryReceiptByTransmission(id string) (notifications.DeliveryReceipt, error) {
+	return notifications.DeliveryReceipt{}, db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) AddTransmissionHistoryRecord(id string, r contract.TransmissionRecord) error {
+	return db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) GetTransmissionHistory(id string) ([]contract.TransmissionRecord, error) {
+	return nil, db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) SetResendCancellation(slug string, cancelled int64) error {
+	return db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) GetResendCancellation(slug string) (int64, error) {
+	return 0, db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) DeleteProcessedNotificationsByModifiedRange(
+	severity contract.NotificationsSeverity,
+	start int64,
+	end int64) (int, error) {
+
+	return 0, db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) DeleteTransmissionsByModifiedRange(start int64, end int64) (int, error) {
+	return 0, db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) MergeRollup(r data.Rollup) error {
+	return db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) RollupsByDevice(period string, device string, start int64, end int64, limit int) ([]data.Rollup, error) {
+	return nil, db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) RollupsByDeviceAndName(period string, device string, name string, start int64, end int64, limit int) ([]data.Rollup, error) {
+	return nil, db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) DeleteRollupsBefore(period string, start int64) error {
+	return db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) EventCountsByDevice(device string, start int64, end int64, window int64) ([]int64, error) {
+	return nil, db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) IntervalTimezones() (map[string]string, error) {
+	return nil, db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) SetIntervalTimezone(id string, timezone string) error {
+	return db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) IntervalMisfirePolicies() (map[string]string, error) {
+	return nil, db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) SetIntervalMisfirePolicy(id string, policy string) error {
+	return db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) IntervalLastRuns() (map[string]int64, error) {
+	return nil, db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) SetIntervalLastRun(id string, lastRun int64) error {
+	return db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) IntervalMaxIterations() (map[string]int64, error) {
+	return nil, db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) SetIntervalMaxIterations(id string, maxIterations int64) error {
+	return db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) IntervalRemainingIterations() (map[string]int64, error) {
+	return nil, db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) SetIntervalRemainingIterations(id string, remaining int64) error {
+	return db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) IntervalActionPolicies() (map[string]scheduler.ExecutionPolicy, error) {
+	return nil, db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) SetIntervalActionPolicy(id string, policy scheduler.ExecutionPolicy) error {
+	return db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) IntervalActionDependencies() (map[string][]string, error) {
+	return nil, db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) SetIntervalActionDependencies(id string, runAfter []string) error {
+	return db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) IntervalActionAuthHeaders() (map[string][]scheduler.AuthHeader, error) {
+	return nil, db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) SetIntervalActionAuthHeaders(id string, headers []scheduler.AuthHeader) error {
+	return db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) AcquireSchedulerLease(instance string, ttl time.Duration) (bool, error) {
+	return false, db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) ReleaseSchedulerLease(instance string) error {
+	return db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) AddIntervalActionExecution(e scheduler.IntervalActionExecution, expired int64, max int) (string, error) {
+	return "", db.ErrUnsupportedDatabase
+}
+
+func (mc MongoClient) IntervalActionExecutionsByName(name string, limit int) ([]scheduler.IntervalActionExecution, error) {
+	return nil, db.ErrUnsupportedDatabase
+}
@@ -0,0 +1,58 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	notifications "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+	"github.com/gomodule/redigo/redis"
+)
+
+// ******************************* NOTIFICATION BACKLOG **********************************
+
+// GetNotificationBacklog counts the notifications by status from their status indexes, and the failed transmissions by
+// channel type, finding the oldest NEW notification through the intersection of its status index with the creation
+// index.
+func (c Client) GetNotificationBacklog() (notifications.NotificationBacklog, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	b := notifications.NewNotificationBacklog()
+	for _, status := range notifications.BacklogStatuses {
+		count, err := redis.Int(conn.Do("ZCARD", db.Notification+":status:"+string(status)))
+		if err != nil {
+			return b, err
+		}
+		b.Notifications[status] = count
+	}
+
+	objects, err := getObjectsByScoreIntersection(conn, db.Notification+":created", db.Notification+":status:"+contract.New, 0, -1, 1)
+	if err != nil {
+		return b, err
+	}
+	oldest, err := unmarshalNotifications(objects)
+	if err != nil {
+		return b, err
+	}
+	for _, n := range oldest {
+		b.AddPending(n.Created)
+	}
+
+	objects, err = getObjectsByRange(conn, db.Transmission+":status:"+string(contract.Failed), 0, -1)
+	if err != nil {
+		return b, err
+	}
+	pending, err := unmarshalTransmissions(objects)
+	if err != nil {
+		return b, err
+	}
+	for _, t := range pending {
+		b.AddPendingTransmission(t)
+	}
+	return b, nil
+}
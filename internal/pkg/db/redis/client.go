@@ -17,16 +17,21 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strconv"
 	"sync"
 	"time"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
 
 	"github.com/gomodule/redigo/redis"
+	"github.com/google/uuid"
 
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
 )
 
+// probeExpiry is how long a probe record is kept should it not be deleted
+const probeExpiry = time.Minute
+
 var currClient *Client // a singleton so Readings can be de-referenced
 var once sync.Once
 
@@ -35,6 +40,10 @@ type Client struct {
 	Pool          *redis.Pool // A thread-safe pool of connections to Redis
 	BatchSize     int
 	loggingClient logger.LoggingClient
+	// deleteEvents and deleteReadings carry the devices whose renamed events and readings are deleted in the
+	// background, by the CoreDataClient
+	deleteEvents   chan string
+	deleteReadings chan string
 }
 
 type CoreDataClient struct {
@@ -43,63 +52,41 @@ type CoreDataClient struct {
 }
 
 func NewCoreDataClient(config db.Configuration, logger logger.LoggingClient) (*CoreDataClient, error) {
-	var err error
-	dc := &CoreDataClient{}
-	dc.Client, err = NewClient(config, logger)
+	client, err := NewClient(config, logger)
 	if err != nil {
 		return nil, err
 	}
-	dc.logger = logger
+	return newCoreDataClient(client, logger), nil
+}
+
+// NewDedicatedCoreDataClient returns a CoreDataClient of its own, as NewDedicatedClient does.
+func NewDedicatedCoreDataClient(config db.Configuration, logger logger.LoggingClient) (*CoreDataClient, error) {
+	client, err := NewDedicatedClient(config, logger)
+	if err != nil {
+		return nil, err
+	}
+	return newCoreDataClient(client, logger), nil
+}
+
+func newCoreDataClient(client *Client, logger logger.LoggingClient) *CoreDataClient {
+	dc := &CoreDataClient{Client: client, logger: logger}
 	// Background process for deleting device readings and events.
 	// This only needs to be running for core-data since this is the service responsible for handling the deletion
 	// of events
 	go dc.AsyncDeleteEvents()
 	go dc.AsyncDeleteReadings()
-
-	return dc, err
+	return dc
 }
 
 // Return a pointer to the Redis client
 func NewClient(config db.Configuration, lc logger.LoggingClient) (*Client, error) {
-	once.Do(func() {
-		connectionString := fmt.Sprintf("%s:%d", config.Host, config.Port)
-		opts := []redis.DialOption{
-			redis.DialConnectTimeout(time.Duration(config.Timeout) * time.Millisecond),
-		}
-		if os.Getenv("EDGEX_SECURITY_SECRET_STORE") != "false" {
-			opts = append(opts, redis.DialPassword(config.Password))
-		}
+	if err := db.ValidateDecodePolicy(config.DecodePolicy); err != nil {
+		return nil, err
+	}
+	decodeStrict = config.DecodePolicy == db.DecodeStrict
 
-		dialFunc := func() (redis.Conn, error) {
-			conn, err := redis.Dial(
-				"tcp", connectionString, opts...,
-			)
-			if err != nil {
-				return nil, fmt.Errorf("Could not dial Redis: %s", err)
-			}
-			return conn, nil
-		}
-		// Default the batch size to 1,000 if not set
-		batchSize := 1000
-		if config.BatchSize != 0 {
-			batchSize = config.BatchSize
-		}
-		currClient = &Client{
-			Pool: &redis.Pool{
-				IdleTimeout: 0,
-				/* The current implementation processes nested structs using concurrent connections.
-				 * With the deepest nesting level being 3, three shall be the number of maximum open
-				 * idle connections in the pool, to allow reuse.
-				 * TODO: Once we have a concurrent benchmark, this should be revisited.
-				 * TODO: Longer term, once the objects are clean of external dependencies, the use
-				 * of another serializer should make this moot.
-				 */
-				MaxIdle: 10,
-				Dial:    dialFunc,
-			},
-			BatchSize:     batchSize,
-			loggingClient: lc,
-		}
+	once.Do(func() {
+		currClient = newClient(config, lc)
 	})
 
 	// Test connectivity now so don't have failures later when doing lazy connect.
@@ -110,6 +97,64 @@ func NewClient(config db.Configuration, lc logger.LoggingClient) (*Client, error
 	return currClient, nil
 }
 
+// NewDedicatedClient returns a client of its own rather than the one shared by the process, e.g. to connect to a
+// second Redis database along with the one of NewClient.
+func NewDedicatedClient(config db.Configuration, lc logger.LoggingClient) (*Client, error) {
+	if err := db.ValidateDecodePolicy(config.DecodePolicy); err != nil {
+		return nil, err
+	}
+	decodeStrict = config.DecodePolicy == db.DecodeStrict
+
+	client := newClient(config, lc)
+	if _, err := client.Pool.Dial(); err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+func newClient(config db.Configuration, lc logger.LoggingClient) *Client {
+	connectionString := fmt.Sprintf("%s:%d", config.Host, config.Port)
+	opts := []redis.DialOption{
+		redis.DialConnectTimeout(time.Duration(config.Timeout) * time.Millisecond),
+	}
+	if os.Getenv("EDGEX_SECURITY_SECRET_STORE") != "false" {
+		opts = append(opts, redis.DialPassword(config.Password))
+	}
+
+	dialFunc := func() (redis.Conn, error) {
+		conn, err := redis.Dial(
+			"tcp", connectionString, opts...,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("Could not dial Redis: %s", err)
+		}
+		return conn, nil
+	}
+	// Default the batch size to 1,000 if not set
+	batchSize := 1000
+	if config.BatchSize != 0 {
+		batchSize = config.BatchSize
+	}
+	return &Client{
+		Pool: &redis.Pool{
+			IdleTimeout: 0,
+			/* The current implementation processes nested structs using concurrent connections.
+			 * With the deepest nesting level being 3, three shall be the number of maximum open
+			 * idle connections in the pool, to allow reuse.
+			 * TODO: Once we have a concurrent benchmark, this should be revisited.
+			 * TODO: Longer term, once the objects are clean of external dependencies, the use
+			 * of another serializer should make this moot.
+			 */
+			MaxIdle: 10,
+			Dial:    dialFunc,
+		},
+		BatchSize:      batchSize,
+		loggingClient:  lc,
+		deleteEvents:   make(chan string, 50),
+		deleteReadings: make(chan string, 50),
+	}
+}
+
 // Connect connects to Redis
 func (c *Client) Connect() error {
 	return nil
@@ -118,8 +163,47 @@ func (c *Client) Connect() error {
 // CloseSession closes the connections to Redis
 func (c *Client) CloseSession() {
 	_ = c.Pool.Close()
-	currClient = nil
-	once = sync.Once{}
+	if c == currClient {
+		currClient = nil
+		once = sync.Once{}
+	}
+}
+
+// Ping sends a PING to Redis over a connection of the pool
+func (c *Client) Ping() error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	_, err := conn.Do("PING")
+	return err
+}
+
+// Probe writes a probe record to the collection, reads it back and deletes it, checking that Redis accepts writes. The
+// record expires after probeExpiry should it not be deleted.
+func (c *Client) Probe(collection string) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	key := collection + ":selftest:" + uuid.New().String()
+	value := strconv.FormatInt(time.Now().UnixNano(), 10)
+	if _, err := conn.Do("SET", key, value, "EX", int(probeExpiry/time.Second)); err != nil {
+		return fmt.Errorf("failed to write the probe record of %s: %v", collection, err)
+	}
+	read, err := redis.String(conn.Do("GET", key))
+	if err != nil {
+		return fmt.Errorf("failed to read the probe record of %s: %v", collection, err)
+	}
+	if read != value {
+		return fmt.Errorf("read %s instead of %s from the probe record of %s", read, value, collection)
+	}
+	deleted, err := redis.Int(conn.Do("DEL", key))
+	if err != nil {
+		return fmt.Errorf("failed to delete the probe record of %s: %v", collection, err)
+	}
+	if deleted != 1 {
+		return fmt.Errorf("the probe record of %s vanished before being deleted", collection)
+	}
+	return nil
 }
 
 // getConnection gets a connection from the pool
@@ -0,0 +1,143 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	command "github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/google/uuid"
+)
+
+// ******************************* COMMAND HISTORY **********************************
+
+// commandHistoryBatch is the number of commands deleted from the history at a time.
+const commandHistoryBatch = 1000
+
+func (c *Client) AddCommandHistory(h command.CommandHistory) (string, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if h.ID == "" {
+		h.ID = uuid.New().String()
+	}
+	if h.Created == 0 {
+		h.Created = db.MakeTimestamp()
+	}
+
+	obj, err := marshalObject(h)
+	if err != nil {
+		return "", err
+	}
+
+	_ = conn.Send("MULTI")
+	_ = conn.Send("SET", h.ID, obj)
+	_ = conn.Send("ZADD", db.CommandHistory, h.Created, h.ID)
+	_ = conn.Send("ZADD", db.CommandHistory+":deviceId:"+h.DeviceId, h.Created, h.ID)
+	_ = conn.Send("ZADD", db.CommandHistory+":deviceName:"+h.DeviceName, h.Created, h.ID)
+	_, err = conn.Do("EXEC")
+	if err != nil {
+		return "", err
+	}
+	return h.ID, nil
+}
+
+// Delete the commands issued before expired, in epoch milliseconds, when it is positive, then the oldest ones beyond
+// max when max is positive, commandHistoryBatch at a time, and return how many were deleted.
+func (c *Client) DeleteCommandHistoryBefore(expired int64, max int) (int, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	deleted := 0
+	for {
+		var ids []interface{}
+		var err error
+		if expired > 0 {
+			ids, err = redis.Values(conn.Do("ZRANGEBYSCORE", db.CommandHistory, "-inf", expired-1, "LIMIT", 0, commandHistoryBatch))
+			if err != nil && err != redis.ErrNil {
+				return deleted, err
+			}
+		}
+		if len(ids) == 0 && max > 0 {
+			count, err := redis.Int(conn.Do("ZCARD", db.CommandHistory))
+			if err != nil {
+				return deleted, err
+			}
+			if excess := count - max; excess > 0 {
+				if excess > commandHistoryBatch {
+					excess = commandHistoryBatch
+				}
+				ids, err = redis.Values(conn.Do("ZRANGE", db.CommandHistory, 0, excess-1))
+				if err != nil && err != redis.ErrNil {
+					return deleted, err
+				}
+			}
+		}
+		if len(ids) == 0 {
+			return deleted, nil
+		}
+
+		if err = deleteCommandHistory(conn, ids); err != nil {
+			return deleted, err
+		}
+		deleted += len(ids)
+	}
+}
+
+// deleteCommandHistory deletes the commands of ids from the history, along with their indexes.
+func deleteCommandHistory(conn redis.Conn, ids []interface{}) error {
+	objects, err := redis.ByteSlices(conn.Do("MGET", ids...))
+	if err != nil {
+		return err
+	}
+
+	_ = conn.Send("MULTI")
+	for i, id := range ids {
+		_ = conn.Send("DEL", id)
+		_ = conn.Send("ZREM", db.CommandHistory, id)
+		var h command.CommandHistory
+		if objects[i] == nil || unmarshalObject(objects[i], &h) != nil {
+			continue
+		}
+		_ = conn.Send("ZREM", db.CommandHistory+":deviceId:"+h.DeviceId, id)
+		_ = conn.Send("ZREM", db.CommandHistory+":deviceName:"+h.DeviceName, id)
+	}
+	_, err = conn.Do("EXEC")
+	return err
+}
+
+// Return the commands issued between start and end, limited by limit; a negative end is unbounded.
+func (c *Client) GetCommandHistory(start int64, end int64, limit int) ([]command.CommandHistory, error) {
+	return c.getCommandHistory(db.CommandHistory, start, end, limit)
+}
+
+func (c *Client) GetCommandHistoryByDeviceId(id string, start int64, end int64, limit int) ([]command.CommandHistory, error) {
+	return c.getCommandHistory(db.CommandHistory+":deviceId:"+id, start, end, limit)
+}
+
+func (c *Client) GetCommandHistoryByDeviceName(name string, start int64, end int64, limit int) ([]command.CommandHistory, error) {
+	return c.getCommandHistory(db.CommandHistory+":deviceName:"+name, start, end, limit)
+}
+
+func (c *Client) getCommandHistory(key string, start int64, end int64, limit int) ([]command.CommandHistory, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	objects, err := getObjectsByScore(conn, key, start, end, limit)
+	if err != nil && err != redis.ErrNil {
+		return nil, err
+	}
+
+	history := make([]command.CommandHistory, len(objects))
+	for i, object := range objects {
+		err = unmarshalObject(object, &history[i])
+		if err != nil {
+			return []command.CommandHistory{}, err
+		}
+	}
+	return history, nil
+}
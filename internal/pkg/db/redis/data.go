@@ -29,10 +29,6 @@ import (
 
 var emptyBinaryValue = make([]byte, 0)
 
-// deleteReadingsChannel channel used to delete readings asynchronously
-var deleteReadingsChannel = make(chan string, 50)
-var deleteEventsChannel = make(chan string, 50)
-
 const (
 	DeletedEventsCollection   = "gc:" + db.EventsCollection
 	DeletedReadingsCollection = "gc:" + db.ReadingsCollection
@@ -55,7 +51,7 @@ func (c *Client) Events() (events []contract.Event, err error) {
 		}
 	}
 	events = make([]contract.Event, len(objects))
-	err = unmarshalEvents(objects, events)
+	err = unmarshalEvents(conn, objects, events)
 	if err != nil {
 		return events, err
 	}
@@ -76,7 +72,7 @@ func (c *Client) EventsWithLimit(limit int) (events []contract.Event, err error)
 		}
 	}
 	events = make([]contract.Event, len(objects))
-	err = unmarshalEvents(objects, events)
+	err = unmarshalEvents(conn, objects, events)
 	if err != nil {
 		return events, err
 	}
@@ -162,7 +158,7 @@ func (c *Client) EventsByChecksum(checksum string) (events []contract.Event, err
 		}
 	}
 	events = make([]contract.Event, len(objects))
-	err = unmarshalEvents(objects, events)
+	err = unmarshalEvents(conn, objects, events)
 	if err != nil {
 		return events, err
 	}
@@ -200,6 +196,28 @@ func (c *Client) EventCountByDeviceId(id string) (count int, err error) {
 	return count, nil
 }
 
+// Get the number of events of the device created during each window of window milliseconds from start up to end,
+// excluded
+func (c *Client) EventCountsByDevice(device string, start int64, end int64, window int64) (counts []int64, err error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if window <= 0 || end <= start {
+		return []int64{}, nil
+	}
+
+	key := db.EventsCollection + ":device:" + device
+	_ = conn.Send("MULTI")
+	for from := start; from < end; from += window {
+		to := from + window
+		if to > end {
+			to = end
+		}
+		_ = conn.Send("ZCOUNT", key, from, to-1)
+	}
+	return redis.Int64s(conn.Do("EXEC"))
+}
+
 // Delete an event by ID. Readings are not deleted as this should be handled by the contract layer
 // 404 - Event not found
 // 503 - Unexpected problems
@@ -246,7 +264,7 @@ func (c *Client) DeleteEventsByDevice(deviceId string) (int, error) {
 	}
 
 	err = conn.Send("EXEC")
-	deleteEventsChannel <- deviceId
+	c.deleteEvents <- deviceId
 
 	return len(ids), nil
 }
@@ -257,7 +275,7 @@ func (c *CoreDataClient) AsyncDeleteEvents() {
 	c.logger.Debug("Starting background event deletion process")
 	for {
 		select {
-		case device, ok := <-deleteEventsChannel:
+		case device, ok := <-c.deleteEvents:
 			if ok {
 				c.logger.Debug("Deleting event data for device: " + device)
 				startTime := time.Now()
@@ -347,7 +365,7 @@ func (c *Client) EventsForDeviceLimit(id string, limit int) (events []contract.E
 	}
 
 	events = make([]contract.Event, len(objects))
-	err = unmarshalEvents(objects, events)
+	err = unmarshalEvents(conn, objects, events)
 	if err != nil {
 		return events, err
 	}
@@ -381,7 +399,7 @@ func (c *Client) EventsByCreationTime(startTime, endTime int64, limit int) (even
 	}
 
 	events = make([]contract.Event, len(objects))
-	err = unmarshalEvents(objects, events)
+	err = unmarshalEvents(conn, objects, events)
 	if err != nil {
 		return events, err
 	}
@@ -446,7 +464,7 @@ func (c *Client) EventsPushed() (events []contract.Event, err error) {
 	}
 
 	events = make([]contract.Event, len(objects))
-	err = unmarshalEvents(objects, events)
+	err = unmarshalEvents(conn, objects, events)
 	if err != nil {
 		return events, err
 	}
@@ -614,7 +632,7 @@ func (c *Client) DeleteReadingsByDevice(device string) error {
 	}
 
 	err = conn.Send("EXEC")
-	deleteReadingsChannel <- device
+	c.deleteReadings <- device
 
 	return nil
 }
@@ -625,7 +643,7 @@ func (c *CoreDataClient) AsyncDeleteReadings() {
 	c.logger.Debug("Starting background event deletion process")
 	for {
 		select {
-		case device, ok := <-deleteReadingsChannel:
+		case device, ok := <-c.deleteReadings:
 			if ok {
 				c.logger.Debug("Deleting reading data for device: " + device)
 				startTime := time.Now()
@@ -1142,7 +1160,7 @@ func eventByID(conn redis.Conn, id string) (event contract.Event, err error) {
 		return event, err
 	}
 
-	event, err = unmarshalEvent(obj)
+	event, err = unmarshalEvent(conn, obj)
 	if err != nil {
 		return event, err
 	}
@@ -1158,7 +1176,7 @@ func eventByChecksum(conn redis.Conn, checksum string) (events []contract.Event,
 		}
 	}
 	events = make([]contract.Event, len(objects))
-	err = unmarshalEvents(objects, events)
+	err = unmarshalEvents(conn, objects, events)
 	if err != nil {
 		return events, err
 	}
@@ -0,0 +1,261 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// decodeStrict tells whether the objects read are decoded with the strict policy, failing on their unknown fields
+var decodeStrict bool
+
+// warnedUnknownFields holds the unknown fields already logged, by type, so that they are logged once
+var warnedUnknownFields sync.Map
+
+// jsonFieldsByType caches the JSON fields of the types decoded, by type
+var jsonFieldsByType sync.Map
+
+// decodingRepairTargets lists the collections repaired, whose objects are stored under their id and listed in the
+// sorted set named after the collection, along with the type their objects are decoded into.
+var decodingRepairTargets = []struct {
+	collection string
+	newObject  func() interface{}
+}{
+	{db.Addressable, func() interface{} { return &contract.Addressable{} }},
+	{db.DeviceService, func() interface{} { return &redisDeviceService{} }},
+	{db.DeviceProfile, func() interface{} { return &redisDeviceProfile{} }},
+	{db.Device, func() interface{} { return &redisDevice{} }},
+	{db.ProvisionWatcher, func() interface{} { return &redisProvisionWatcher{} }},
+	{db.DeviceReport, func() interface{} { return &contract.DeviceReport{} }},
+	{db.Command, func() interface{} { return &contract.Command{} }},
+	{db.Interval, func() interface{} { return &contract.Interval{} }},
+	{db.IntervalAction, func() interface{} { return &contract.IntervalAction{} }},
+}
+
+// checkUnknownFields applies the decode policy to the object decoded into out: it fails when the object has unknown
+// fields and the policy is strict, otherwise the object is counted and its unknown fields logged once per type.
+func checkUnknownFields(in []byte, out interface{}) error {
+	fields, err := unknownFields(in, out)
+	if err != nil || len(fields) == 0 {
+		return err
+	}
+	if decodeStrict {
+		return db.ErrUnknownFields{Fields: fields}
+	}
+
+	count := db.RecordLenientDecode()
+	key := fmt.Sprintf("%T:%s", out, strings.Join(fields, ","))
+	if _, logged := warnedUnknownFields.LoadOrStore(key, true); !logged && currClient != nil && currClient.loggingClient != nil {
+		currClient.loggingClient.Warn(fmt.Sprintf(
+			"ignored the unknown fields %s of a stored %T, %d objects decoded in spite of their unknown fields so far",
+			strings.Join(fields, ", "),
+			out,
+			count))
+	}
+	return nil
+}
+
+// unknownFields returns the paths of the fields of the JSON object which aren't decoded into out, sorted.
+func unknownFields(in []byte, out interface{}) ([]string, error) {
+	var v interface{}
+	if err := json.Unmarshal(in, &v); err != nil {
+		return nil, err
+	}
+
+	var fields []string
+	collectUnknownFields(v, reflect.TypeOf(out), "", &fields)
+	sort.Strings(fields)
+	return fields, nil
+}
+
+func collectUnknownFields(v interface{}, t reflect.Type, path string, fields *[]string) {
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil {
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		object, ok := v.(map[string]interface{})
+		if !ok {
+			return
+		}
+		known := jsonFields(t)
+		for key, value := range object {
+			fieldPath := key
+			if path != "" {
+				fieldPath = path + "." + key
+			}
+			fieldType, ok := lookupJSONField(known, key)
+			if !ok {
+				*fields = append(*fields, fieldPath)
+				continue
+			}
+			collectUnknownFields(value, fieldType, fieldPath, fields)
+		}
+	case reflect.Slice, reflect.Array:
+		items, ok := v.([]interface{})
+		if !ok {
+			return
+		}
+		for i, item := range items {
+			collectUnknownFields(item, t.Elem(), path+"["+strconv.Itoa(i)+"]", fields)
+		}
+	case reflect.Map:
+		object, ok := v.(map[string]interface{})
+		if !ok {
+			return
+		}
+		for key, value := range object {
+			collectUnknownFields(value, t.Elem(), path+"."+key, fields)
+		}
+	}
+}
+
+// jsonFields returns the types of the fields of the struct type by JSON name, the fields of the embedded structs
+// without a name being promoted as encoding/json does.
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	if fields, ok := jsonFieldsByType.Load(t); ok {
+		return fields.(map[string]reflect.Type)
+	}
+
+	fields := make(map[string]reflect.Type)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		if field.Anonymous && name == "" && fieldType.Kind() == reflect.Struct {
+			for embeddedName, embeddedType := range jsonFields(fieldType) {
+				if _, exists := fields[embeddedName]; !exists {
+					fields[embeddedName] = embeddedType
+				}
+			}
+			continue
+		}
+		if field.PkgPath != "" {
+			// unexported
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = field.Type
+	}
+
+	jsonFieldsByType.Store(t, fields)
+	return fields
+}
+
+// lookupJSONField returns the type of the field by JSON name, matched case-insensitively as encoding/json does.
+func lookupJSONField(fields map[string]reflect.Type, name string) (reflect.Type, bool) {
+	if t, ok := fields[name]; ok {
+		return t, true
+	}
+	for fieldName, t := range fields {
+		if strings.EqualFold(fieldName, name) {
+			return t, true
+		}
+	}
+	return nil, false
+}
+
+// RepairDecoding rewrites the stored metadata and scheduler objects failing the strict decoding without their unknown
+// fields, and reports the objects repaired along with those which can't be decoded at all. A dry run only reports them.
+func (c *Client) RepairDecoding(dryRun bool) (db.DecodingRepairReport, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	report := db.DecodingRepairReport{DryRun: dryRun, Started: db.MakeTimestamp()}
+	for _, target := range decodingRepairTargets {
+		collection, err := repairCollection(conn, target.collection, target.newObject, dryRun)
+		if err != nil {
+			return report, err
+		}
+		report.Collections = append(report.Collections, collection)
+	}
+	report.Completed = db.MakeTimestamp()
+	return report, nil
+}
+
+func repairCollection(
+	conn redis.Conn,
+	name string,
+	newObject func() interface{},
+	dryRun bool) (db.DecodingRepairCollection, error) {
+
+	collection := db.DecodingRepairCollection{Name: name}
+	ids, err := redis.Strings(conn.Do("ZRANGE", name, 0, -1))
+	if err != nil {
+		return collection, fmt.Errorf("listing the %s objects failed: %s", name, err.Error())
+	}
+
+	fields := make(map[string]bool)
+	for _, id := range ids {
+		object, err := redis.Bytes(conn.Do("GET", id))
+		if err == redis.ErrNil {
+			continue
+		} else if err != nil {
+			return collection, fmt.Errorf("reading the %s object %s failed: %s", name, id, err.Error())
+		}
+		collection.Objects++
+
+		out := newObject()
+		unknown, err := unknownFields(object, out)
+		if err == nil {
+			err = json.Unmarshal(object, out)
+		}
+		if err != nil {
+			collection.Failures = append(collection.Failures, db.DecodingRepairFailure{Id: id, Reason: err.Error()})
+			continue
+		}
+		if len(unknown) == 0 {
+			continue
+		}
+		for _, field := range unknown {
+			fields[field] = true
+		}
+
+		if !dryRun {
+			repaired, err := marshalObject(out)
+			if err != nil {
+				collection.Failures = append(collection.Failures, db.DecodingRepairFailure{Id: id, Reason: err.Error()})
+				continue
+			}
+			if _, err = conn.Do("SET", id, repaired); err != nil {
+				return collection, fmt.Errorf("rewriting the %s object %s failed: %s", name, id, err.Error())
+			}
+		}
+		collection.Repaired++
+	}
+
+	for field := range fields {
+		collection.Fields = append(collection.Fields, field)
+	}
+	sort.Strings(collection.Fields)
+	return collection, nil
+}
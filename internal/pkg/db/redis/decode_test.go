@@ -0,0 +1,73 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testDecodeItem struct {
+	Name  string `json:"name"`
+	Value int    `json:"value,omitempty"`
+}
+
+type testDecodeEmbedded struct {
+	Id string
+}
+
+type testDecodeObject struct {
+	testDecodeEmbedded
+	Label   string                    `json:"label"`
+	Skipped string                    `json:"-"`
+	Items   []testDecodeItem          `json:"items"`
+	ByName  map[string]testDecodeItem `json:"byName"`
+	Extra   interface{}               `json:"extra"`
+}
+
+func TestUnknownFields(t *testing.T) {
+	tests := []struct {
+		name     string
+		json     string
+		expected []string
+	}{
+		{"Known fields", `{"Id":"1","label":"l","items":[{"name":"n","value":1}],"byName":{"n":{"name":"n"}},"extra":{"any":1}}`, nil},
+		{"Case insensitive", `{"id":"1","LABEL":"l"}`, nil},
+		{"Unknown field", `{"Id":"1","color":"red"}`, []string{"color"}},
+		{"Ignored field", `{"Skipped":"s"}`, []string{"Skipped"}},
+		{"Unknown nested fields", `{"items":[{"name":"n"},{"unit":"C"}],"byName":{"n":{"old":true}}}`, []string{"byName.n.old", "items[1].unit"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fields, err := unknownFields([]byte(tt.json), &testDecodeObject{})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, fields)
+		})
+	}
+}
+
+func TestUnmarshalObjectDecodePolicy(t *testing.T) {
+	defer func() { decodeStrict = false }()
+	in := []byte(`{"Id":"1","label":"l","color":"red"}`)
+
+	decodeStrict = false
+	before := db.LenientDecodes()
+	var o testDecodeObject
+	require.NoError(t, unmarshalObject(in, &o))
+	assert.Equal(t, "l", o.Label)
+	assert.Equal(t, before+1, db.LenientDecodes())
+
+	decodeStrict = true
+	err := unmarshalObject(in, &o)
+	require.Error(t, err)
+	assert.Equal(t, db.ErrUnknownFields{Fields: []string{"color"}}, err)
+
+	assert.NoError(t, unmarshalObject([]byte(`{"Id":"1"}`), &o))
+}
@@ -0,0 +1,247 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	notifications "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// ******************************* ESCALATION POLICIES **********************************
+func (c Client) GetEscalationPolicyBySlug(slug string) (p notifications.EscalationPolicy, err error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	err = getObjectByHash(conn, db.EscalationPolicy+":slug", slug, unmarshalObject, &p)
+	return p, err
+}
+
+func (c Client) AddEscalationPolicy(p notifications.EscalationPolicy) (string, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	err := addEscalationPolicy(conn, &p)
+	if err != nil {
+		return "", err
+	}
+	return p.ID, nil
+}
+
+func (c Client) UpdateEscalationPolicy(p notifications.EscalationPolicy) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	var existing notifications.EscalationPolicy
+	err := getObjectByHash(conn, db.EscalationPolicy+":slug", p.Subscription, unmarshalObject, &existing)
+	if err != nil {
+		return err
+	}
+
+	err = deleteEscalationPolicy(conn, existing)
+	if err != nil {
+		return err
+	}
+
+	p.ID = existing.ID
+	p.Created = existing.Created
+	p.Modified = db.MakeTimestamp()
+	return addEscalationPolicy(conn, &p)
+}
+
+func (c Client) DeleteEscalationPolicyBySlug(slug string) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	var p notifications.EscalationPolicy
+	err := getObjectByHash(conn, db.EscalationPolicy+":slug", slug, unmarshalObject, &p)
+	if err != nil {
+		return err
+	}
+	return deleteEscalationPolicy(conn, p)
+}
+
+func addEscalationPolicy(conn redis.Conn, p *notifications.EscalationPolicy) error {
+	exists, err := redis.Bool(conn.Do("HEXISTS", db.EscalationPolicy+":slug", p.Subscription))
+	if err != nil {
+		return err
+	} else if exists {
+		return errors.Errorf("%v, subscription=%v", db.ErrNotUnique, p.Subscription)
+	}
+
+	if p.Created == 0 {
+		p.Created = db.MakeTimestamp()
+		p.Modified = p.Created
+	}
+
+	if p.ID == "" {
+		p.ID = uuid.New().String()
+	}
+
+	obj, err := marshalObject(p)
+	if err != nil {
+		return err
+	}
+
+	_ = conn.Send("MULTI")
+	_ = conn.Send("SET", p.ID, obj)
+	_ = conn.Send("ZADD", db.EscalationPolicy, 0, p.ID)
+	_ = conn.Send("HSET", db.EscalationPolicy+":slug", p.Subscription, p.ID)
+	_, err = conn.Do("EXEC")
+
+	return err
+}
+
+func deleteEscalationPolicy(conn redis.Conn, p notifications.EscalationPolicy) error {
+	_ = conn.Send("MULTI")
+	_ = conn.Send("DEL", p.ID)
+	_ = conn.Send("ZREM", db.EscalationPolicy, p.ID)
+	_ = conn.Send("HDEL", db.EscalationPolicy+":slug", p.Subscription)
+	_, err := conn.Do("EXEC")
+
+	return err
+}
+
+// deleteEscalationPolicyBySlug removes the escalation policy of a deleted subscription, if it had one.
+func deleteEscalationPolicyBySlug(conn redis.Conn, slug string) error {
+	var p notifications.EscalationPolicy
+	err := getObjectByHash(conn, db.EscalationPolicy+":slug", slug, unmarshalObject, &p)
+	if err != nil {
+		if err == db.ErrNotFound {
+			return nil
+		}
+		return err
+	}
+	return deleteEscalationPolicy(conn, p)
+}
+
+// ******************************* ESCALATIONS **********************************
+func (c Client) AddEscalation(e notifications.Escalation) (string, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	err := addEscalation(conn, &e)
+	if err != nil {
+		return "", err
+	}
+	return e.ID, nil
+}
+
+func (c Client) UpdateEscalation(e notifications.Escalation) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	var existing notifications.Escalation
+	err := getObjectById(conn, e.ID, unmarshalObject, &existing)
+	if err != nil {
+		return err
+	}
+
+	err = deleteEscalation(conn, existing)
+	if err != nil {
+		return err
+	}
+
+	e.Created = existing.Created
+	e.Modified = db.MakeTimestamp()
+	return addEscalation(conn, &e)
+}
+
+func (c Client) GetEscalationByTransmission(id string) (e notifications.Escalation, err error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	err = getObjectByHash(conn, db.Escalation+":transmission", id, unmarshalObject, &e)
+	return e, err
+}
+
+// GetDueEscalations returns the active escalations whose deadline is not later than the given one.
+func (c Client) GetDueEscalations(deadline int64) ([]notifications.Escalation, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	objects, err := getObjectsByScore(conn, db.Escalation+":deadline", 0, deadline, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	escalations := make([]notifications.Escalation, len(objects))
+	for i, object := range objects {
+		err = unmarshalObject(object, &escalations[i])
+		if err != nil {
+			return []notifications.Escalation{}, err
+		}
+	}
+	return escalations, nil
+}
+
+func addEscalation(conn redis.Conn, e *notifications.Escalation) error {
+	if e.Created == 0 {
+		e.Created = db.MakeTimestamp()
+		e.Modified = e.Created
+	}
+
+	if e.ID == "" {
+		e.ID = uuid.New().String()
+	}
+
+	obj, err := marshalObject(e)
+	if err != nil {
+		return err
+	}
+
+	_ = conn.Send("MULTI")
+	_ = conn.Send("SET", e.ID, obj)
+	_ = conn.Send("ZADD", db.Escalation, 0, e.ID)
+	_ = conn.Send("ZADD", db.Escalation+":notification:"+e.Notification, e.Created, e.ID)
+	if e.Status == notifications.EscalationActive {
+		_ = conn.Send("ZADD", db.Escalation+":deadline", e.Deadline, e.ID)
+	}
+	for _, t := range e.Transmissions {
+		_ = conn.Send("HSET", db.Escalation+":transmission", t, e.ID)
+	}
+	_, err = conn.Do("EXEC")
+
+	return err
+}
+
+func deleteEscalation(conn redis.Conn, e notifications.Escalation) error {
+	_ = conn.Send("MULTI")
+	_ = conn.Send("DEL", e.ID)
+	_ = conn.Send("ZREM", db.Escalation, e.ID)
+	_ = conn.Send("ZREM", db.Escalation+":notification:"+e.Notification, e.ID)
+	_ = conn.Send("ZREM", db.Escalation+":deadline", e.ID)
+	for _, t := range e.Transmissions {
+		_ = conn.Send("HDEL", db.Escalation+":transmission", t)
+	}
+	_, err := conn.Do("EXEC")
+
+	return err
+}
+
+// deleteEscalationsByNotification removes the escalations of a deleted notification.
+func deleteEscalationsByNotification(conn redis.Conn, slug string) error {
+	objects, err := getObjectsByRange(conn, db.Escalation+":notification:"+slug, 0, -1)
+	if err != nil {
+		return err
+	}
+
+	for _, object := range objects {
+		var e notifications.Escalation
+		err = unmarshalObject(object, &e)
+		if err != nil {
+			return err
+		}
+		err = deleteEscalation(conn, e)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
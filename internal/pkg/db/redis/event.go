@@ -50,10 +50,10 @@ func marshalEvent(event correlation.Event) (out []byte, err error) {
 	return marshalObject(s)
 }
 
-func unmarshalEvents(objects [][]byte, events []contract.Event) (err error) {
+func unmarshalEvents(conn redis.Conn, objects [][]byte, events []contract.Event) (err error) {
 	for i, o := range objects {
 		if len(o) > 0 {
-			events[i], err = unmarshalEvent(o)
+			events[i], err = unmarshalEvent(conn, o)
 			if err != nil {
 				return err
 			}
@@ -63,7 +63,8 @@ func unmarshalEvents(objects [][]byte, events []contract.Event) (err error) {
 	return nil
 }
 
-func unmarshalEvent(o []byte) (contract.Event, error) {
+// unmarshalEvent decodes an event, along with its readings read with conn.
+func unmarshalEvent(conn redis.Conn, o []byte) (contract.Event, error) {
 	s, err := unmarshalRedisEvent(o)
 	if err != nil {
 		return contract.Event{}, err
@@ -79,12 +80,6 @@ func unmarshalEvent(o []byte) (contract.Event, error) {
 		Tags:     s.Tags,
 	}
 
-	conn, err := getConnection()
-	if err != nil {
-		return contract.Event{}, err
-	}
-	defer conn.Close()
-
 	objects, err := getObjectsByRange(conn, db.EventsCollection+":readings:"+s.ID, 0, -1)
 	if err != nil {
 		if err != redis.ErrNil {
@@ -0,0 +1,305 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db/interfaces"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/google/uuid"
+)
+
+// intersectionIndex names the temporary indexes intersecting others in the explanations.
+const intersectionIndex = "{intersection}"
+
+// commandPhases are the phases of the commands sent to answer the queries, the others being named after the command.
+var commandPhases = map[string]string{
+	"ZRANGE":           db.PhaseScan,
+	"ZREVRANGE":        db.PhaseScan,
+	"ZRANGEBYSCORE":    db.PhaseScan,
+	"ZREVRANGEBYSCORE": db.PhaseScan,
+	"ZSCORE":           db.PhaseFilter,
+	"ZINTERSTORE":      db.PhaseIntersect,
+	"GET":              db.PhaseFetch,
+	"MGET":             db.PhaseFetch,
+	"DEL":              db.PhaseCleanup,
+	"UNLINK":           db.PhaseCleanup,
+}
+
+// Explain answers query with a client recording the commands it sends to Redis, and explains how the query was
+// answered instead of returning its results. The query returns the number of its results. The sizes of the indexes
+// read are counted once the query is answered.
+func (c *Client) Explain(query func(client interfaces.DBClient) (int, error)) (db.QueryExplanation, error) {
+	recorder := newQueryRecorder()
+	pool := &redis.Pool{
+		Dial: func() (redis.Conn, error) {
+			conn := c.Pool.Get()
+			if err := conn.Err(); err != nil {
+				_ = conn.Close()
+				return nil, err
+			}
+			return &recordingConn{Conn: conn, recorder: recorder}, nil
+		},
+	}
+	defer pool.Close()
+
+	started := time.Now()
+	returned, err := query(&Client{
+		Pool:           pool,
+		BatchSize:      c.BatchSize,
+		loggingClient:  c.loggingClient,
+		deleteEvents:   c.deleteEvents,
+		deleteReadings: c.deleteReadings,
+	})
+	elapsed := time.Since(started)
+	if err != nil {
+		return db.QueryExplanation{}, err
+	}
+
+	conn := c.Pool.Get()
+	defer conn.Close()
+	indexes, err := recorder.indexes(conn)
+	if err != nil {
+		return db.QueryExplanation{}, err
+	}
+	return recorder.explanation(indexes, returned, elapsed), nil
+}
+
+// recordedCommand is a command sent to Redis.
+type recordedCommand struct {
+	name string
+	args []interface{}
+}
+
+// recordingConn records the commands sent through the connection, each of the commands pipelined once they are
+// flushed.
+type recordingConn struct {
+	redis.Conn
+	recorder *queryRecorder
+	pending  []recordedCommand
+	// lastIndex is the index last scanned, listing the objects fetched next
+	lastIndex string
+}
+
+func (c *recordingConn) Send(command string, args ...interface{}) error {
+	c.pending = append(c.pending, recordedCommand{name: command, args: args})
+	return c.Conn.Send(command, args...)
+}
+
+func (c *recordingConn) Do(command string, args ...interface{}) (interface{}, error) {
+	commands := c.pending
+	c.pending = nil
+	if command != "" {
+		commands = append(commands, recordedCommand{name: command, args: args})
+	}
+
+	started := time.Now()
+	reply, err := c.Conn.Do(command, args...)
+	elapsed := time.Since(started)
+	if len(commands) == 0 {
+		return reply, err
+	}
+
+	// the round trip of a pipeline is shared by its commands
+	replies := []interface{}{reply}
+	if command == "" {
+		replies, _ = reply.([]interface{})
+	}
+	for i, cmd := range commands {
+		var r interface{}
+		if i < len(replies) {
+			r = replies[i]
+		}
+		c.lastIndex = c.recorder.record(cmd, r, elapsed/time.Duration(len(commands)), c.lastIndex)
+	}
+	return reply, err
+}
+
+// queryRecorder records the commands sent to Redis to answer a query, totalled by phase.
+type queryRecorder struct {
+	mutex  sync.Mutex
+	phases []*db.QueryPhase
+	// keys are the keys of the indexes read, by index name
+	keys       map[string]map[string]bool
+	indexNames []string
+	temporary  map[string]bool
+}
+
+func newQueryRecorder() *queryRecorder {
+	return &queryRecorder{
+		keys:      make(map[string]map[string]bool),
+		temporary: make(map[string]bool),
+	}
+}
+
+// record adds a command answered with reply in elapsed to its phase, and returns the index last scanned once it's
+// sent, given lastIndex before.
+func (r *queryRecorder) record(cmd recordedCommand, reply interface{}, elapsed time.Duration, lastIndex string) string {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	name := strings.ToUpper(cmd.name)
+	phase, ok := commandPhases[name]
+	if !ok {
+		phase = strings.ToLower(name)
+	}
+
+	var indexes []string
+	scanned := int64(1)
+	switch phase {
+	case db.PhaseScan, db.PhaseFilter:
+		if len(cmd.args) > 0 {
+			lastIndex = r.readIndex(keyOf(cmd.args[0]))
+			indexes = []string{lastIndex}
+		}
+	case db.PhaseIntersect:
+		// ZINTERSTORE destination numkeys key [key ...]
+		if len(cmd.args) > 1 {
+			r.temporary[keyOf(cmd.args[0])] = true
+			count, _ := strconv.Atoi(fmt.Sprint(cmd.args[1]))
+			for i := 2; i < 2+count && i < len(cmd.args); i++ {
+				indexes = append(indexes, r.readIndex(keyOf(cmd.args[i])))
+			}
+		}
+	case db.PhaseFetch:
+		if lastIndex != "" {
+			indexes = []string{lastIndex}
+		}
+		if name == "MGET" {
+			scanned = int64(len(cmd.args))
+		}
+	}
+	switch v := reply.(type) {
+	case []interface{}:
+		if phase != db.PhaseFetch {
+			scanned = int64(len(v))
+		}
+	case int64:
+		if phase == db.PhaseIntersect {
+			scanned = v
+		}
+	}
+
+	r.add(phase, name, indexes, scanned, elapsed)
+	return lastIndex
+}
+
+// add adds a command to the phase of the same name, command and indexes, added once first seen.
+func (r *queryRecorder) add(name string, command string, indexes []string, scanned int64, elapsed time.Duration) {
+	var phase *db.QueryPhase
+	for _, p := range r.phases {
+		if p.Name == name && p.Command == command && strings.Join(p.Indexes, " ") == strings.Join(indexes, " ") {
+			phase = p
+			break
+		}
+	}
+	if phase == nil {
+		phase = &db.QueryPhase{Name: name, Command: command, Indexes: indexes}
+		r.phases = append(r.phases, phase)
+	}
+	phase.Calls++
+	phase.Scanned += scanned
+	phase.Ms += milliseconds(elapsed)
+}
+
+// readIndex records the index of key as read, and returns its name.
+func (r *queryRecorder) readIndex(key string) string {
+	if r.temporary[key] {
+		return intersectionIndex
+	}
+	name := indexName(key)
+	if _, ok := r.keys[name]; !ok {
+		r.keys[name] = make(map[string]bool)
+		r.indexNames = append(r.indexNames, name)
+	}
+	r.keys[name][key] = true
+	return name
+}
+
+// indexes counts the members of the indexes read with conn, in the order they were first read.
+func (r *queryRecorder) indexes(conn redis.Conn) ([]db.QueryIndex, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	indexes := make([]db.QueryIndex, len(r.indexNames))
+	for i, name := range r.indexNames {
+		indexes[i] = db.QueryIndex{Name: name, Keys: len(r.keys[name])}
+		for key := range r.keys[name] {
+			if err := conn.Send("ZCARD", key); err != nil {
+				return nil, err
+			}
+		}
+	}
+	counts, err := redis.Int64s(conn.Do(""))
+	if err != nil && err != redis.ErrNil {
+		return nil, err
+	}
+
+	next := 0
+	for i := range indexes {
+		for j := 0; j < indexes[i].Keys && next < len(counts); j++ {
+			indexes[i].Members += counts[next]
+			next++
+		}
+	}
+	return indexes, nil
+}
+
+// explanation returns the explanation of a query answered with returned results in elapsed.
+func (r *queryRecorder) explanation(indexes []db.QueryIndex, returned int, elapsed time.Duration) db.QueryExplanation {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	explanation := db.QueryExplanation{
+		Database: db.RedisDB,
+		Indexes:  indexes,
+		Phases:   make([]db.QueryPhase, 0, len(r.phases)+1),
+		Returned: returned,
+		Ms:       milliseconds(elapsed),
+	}
+	process := explanation.Ms
+	for _, p := range r.phases {
+		explanation.Phases = append(explanation.Phases, *p)
+		process -= p.Ms
+	}
+	if process < 0 {
+		process = 0
+	}
+	explanation.Phases = append(explanation.Phases, db.QueryPhase{Name: db.PhaseProcess, Ms: process})
+	return explanation
+}
+
+// keyOf returns the key given as the argument of a command.
+func keyOf(arg interface{}) string {
+	key, _ := redis.String(arg, nil)
+	return key
+}
+
+// indexName returns the name of the index of key, with the IDs of the objects it's specific to, e.g. the readings of
+// an event, replaced by '{id}'.
+func indexName(key string) string {
+	segments := strings.Split(key, ":")
+	for i, segment := range segments {
+		if len(segment) == 36 {
+			if _, err := uuid.Parse(segment); err == nil {
+				segments[i] = "{id}"
+			}
+		}
+	}
+	return strings.Join(segments, ":")
+}
+
+// milliseconds returns d in milliseconds.
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
@@ -0,0 +1,201 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db/interfaces"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// scriptedConn answers the commands of the queries from sorted sets and objects held in memory, the members of the
+// sorted sets being scored by their position.
+type scriptedConn struct {
+	sets    map[string][]string
+	objects map[string]string
+	pending []interface{}
+}
+
+func (c *scriptedConn) Close() error { return nil }
+
+func (c *scriptedConn) Err() error { return nil }
+
+func (c *scriptedConn) Flush() error { return nil }
+
+func (c *scriptedConn) Receive() (interface{}, error) { return nil, nil }
+
+func (c *scriptedConn) Send(command string, args ...interface{}) error {
+	c.pending = append(c.pending, c.reply(command, args))
+	return nil
+}
+
+func (c *scriptedConn) Do(command string, args ...interface{}) (interface{}, error) {
+	if command == "" {
+		replies := c.pending
+		c.pending = nil
+		return replies, nil
+	}
+	return c.reply(command, args), nil
+}
+
+func (c *scriptedConn) reply(command string, args []interface{}) interface{} {
+	key := keyOf(args[0])
+	switch command {
+	case "ZRANGE", "ZREVRANGE", "ZRANGEBYSCORE":
+		members := c.sets[key]
+		if command == "ZRANGEBYSCORE" && len(args) == 6 {
+			if limit := args[5].(int); limit < len(members) {
+				members = members[:limit]
+			}
+		}
+		reply := make([]interface{}, len(members))
+		for i, m := range members {
+			reply[i] = []byte(m)
+		}
+		return reply
+	case "ZSCORE":
+		if score := c.score(key, keyOf(args[1])); score >= 0 {
+			return []byte(fmt.Sprint(score))
+		}
+		return nil
+	case "ZINTERSTORE":
+		// the sets intersected are given as two
+		var intersection []string
+		for _, m := range c.sets[keyOf(args[2])] {
+			if c.score(keyOf(args[3]), m) >= 0 {
+				intersection = append(intersection, m)
+			}
+		}
+		c.sets[key] = intersection
+		return int64(len(intersection))
+	case "ZCARD":
+		return int64(len(c.sets[key]))
+	case "MGET":
+		reply := make([]interface{}, len(args))
+		for i, id := range args {
+			reply[i] = []byte(c.objects[keyOf(id)])
+		}
+		return reply
+	case "DEL":
+		delete(c.sets, key)
+		return int64(1)
+	}
+	return nil
+}
+
+// score returns the score of member in the sorted set of key, or -1 when it isn't a member.
+func (c *scriptedConn) score(key string, member string) int {
+	for i, m := range c.sets[key] {
+		if m == member {
+			return i
+		}
+	}
+	return -1
+}
+
+func newScriptedClient() *Client {
+	conn := &scriptedConn{
+		sets: map[string][]string{
+			"reading:created":     {"r1", "r2", "r3", "r4"},
+			"reading:device:dev1": {"r1", "r3"},
+			"reading:name:temp":   {"r1", "r2", "r3"},
+			"event:readings:2b0c5e6c-4b1e-4a8a-9f6a-1f2b3c4d5e6f": {"r1"},
+			"event:readings:9d8c7b6a-5f4e-4d3c-8b2a-1a2b3c4d5e6f": {"r2", "r3"},
+		},
+		objects: map[string]string{"r1": "{}", "r2": "{}", "r3": "{}", "r4": "{}"},
+	}
+	return &Client{Pool: &redis.Pool{Dial: func() (redis.Conn, error) { return conn, nil }}}
+}
+
+func TestExplain(t *testing.T) {
+	explanation, err := newScriptedClient().Explain(func(client interfaces.DBClient) (int, error) {
+		conn := client.(*Client).Pool.Get()
+		defer conn.Close()
+
+		created, err := getObjectsByScore(conn, db.ReadingsCollection+":created", 0, -1, 3)
+		if err != nil {
+			return 0, err
+		}
+		sorted, err := getObjectsByValuesSorted(conn, 5, "reading:device:dev1", "reading:name:temp")
+		if err != nil {
+			return 0, err
+		}
+		for _, id := range []string{"2b0c5e6c-4b1e-4a8a-9f6a-1f2b3c4d5e6f", "9d8c7b6a-5f4e-4d3c-8b2a-1a2b3c4d5e6f"} {
+			if _, err = getObjectsByRange(conn, db.EventsCollection+":readings:"+id, 0, -1); err != nil {
+				return 0, err
+			}
+		}
+		return len(created) + len(sorted), nil
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, db.RedisDB, explanation.Database)
+	assert.Equal(t, 5, explanation.Returned)
+	assert.Equal(t, []db.QueryIndex{
+		{Name: "reading:created", Keys: 1, Members: 4},
+		{Name: "reading:device:dev1", Keys: 1, Members: 2},
+		{Name: "reading:name:temp", Keys: 1, Members: 3},
+		{Name: "event:readings:{id}", Keys: 2, Members: 3},
+	}, explanation.Indexes, "the indexes specific to events should be counted together")
+
+	type phase struct {
+		name    string
+		command string
+		indexes []string
+		calls   int
+		scanned int64
+	}
+	var phases []phase
+	for _, p := range explanation.Phases {
+		phases = append(phases, phase{p.Name, p.Command, p.Indexes, p.Calls, p.Scanned})
+		assert.True(t, p.Ms >= 0)
+	}
+	assert.Equal(t, []phase{
+		{db.PhaseScan, "ZRANGEBYSCORE", []string{"reading:created"}, 1, 3},
+		{db.PhaseFetch, "MGET", []string{"reading:created"}, 1, 3},
+		{db.PhaseIntersect, "ZINTERSTORE", []string{"reading:device:dev1", "reading:name:temp"}, 1, 2},
+		{db.PhaseScan, "ZREVRANGE", []string{intersectionIndex}, 1, 2},
+		{db.PhaseFetch, "MGET", []string{intersectionIndex}, 1, 2},
+		{db.PhaseCleanup, "DEL", nil, 1, 1},
+		{db.PhaseScan, "ZRANGE", []string{"event:readings:{id}"}, 2, 3},
+		{db.PhaseFetch, "MGET", []string{"event:readings:{id}"}, 2, 3},
+		{db.PhaseProcess, "", nil, 0, 0},
+	}, phases)
+}
+
+func TestExplainPipeline(t *testing.T) {
+	explanation, err := newScriptedClient().Explain(func(client interfaces.DBClient) (int, error) {
+		conn := client.(*Client).Pool.Get()
+		defer conn.Close()
+
+		objects, err := getObjectsByRangeFilter(conn, "reading:created", "reading:device:dev1", 0, -1)
+		return len(objects), err
+	})
+	require.NoError(t, err)
+
+	require.Len(t, explanation.Phases, 4)
+	filter := explanation.Phases[1]
+	assert.Equal(t, db.PhaseFilter, filter.Name)
+	assert.Equal(t, []string{"reading:device:dev1"}, filter.Indexes)
+	assert.Equal(t, 4, filter.Calls, "every pipelined command should be counted")
+	assert.Equal(t, int64(4), filter.Scanned)
+	assert.Equal(t, []string{"reading:device:dev1"}, explanation.Phases[2].Indexes,
+		"the objects should be fetched from the index last read")
+	assert.Equal(t, 2, explanation.Returned)
+}
+
+func TestIndexName(t *testing.T) {
+	assert.Equal(t, "event:readings:{id}", indexName("event:readings:2b0c5e6c-4b1e-4a8a-9f6a-1f2b3c4d5e6f"))
+	assert.Equal(t, "reading:name:2b0c5e6c", indexName("reading:name:2b0c5e6c"))
+	assert.Equal(t, "reading:device:thermostat-2b0c5e6c-4b1e-4a8a-9f6a",
+		indexName("reading:device:thermostat-2b0c5e6c-4b1e-4a8a-9f6a"))
+}
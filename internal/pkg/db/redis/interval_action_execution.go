@@ -0,0 +1,74 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db/redis/models"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/google/uuid"
+)
+
+// ************************* INTERVAL ACTION EXECUTIONS *******************************
+
+// Add the execution of an interval action, then drop its executions started before expired, in epoch milliseconds,
+// and the oldest ones beyond max when max is positive.
+func (c *Client) AddIntervalActionExecution(e schedulerModels.IntervalActionExecution, expired int64, max int) (string, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if e.ID == "" {
+		e.ID = uuid.New().String()
+	}
+	if e.Started == 0 {
+		e.Started = db.MakeTimestamp()
+	}
+
+	obj, err := marshalObject(e)
+	if err != nil {
+		return "", err
+	}
+
+	key := models.IntervalActionExecutionKey + ":" + e.IntervalAction
+	_ = conn.Send("MULTI")
+	_ = conn.Send("ZADD", key, e.Started, obj)
+	if expired > 0 {
+		_ = conn.Send("ZREMRANGEBYSCORE", key, "-inf", expired-1)
+	}
+	if max > 0 {
+		_ = conn.Send("ZREMRANGEBYRANK", key, 0, -(max + 1))
+	}
+	if _, err = conn.Do("EXEC"); err != nil {
+		return "", err
+	}
+	return e.ID, nil
+}
+
+// Return the executions of the interval action by name, the latest first, up to the number specified; all of them
+// when the limit isn't positive
+func (c *Client) IntervalActionExecutionsByName(name string, limit int) ([]schedulerModels.IntervalActionExecution, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	stop := limit - 1
+	if limit <= 0 {
+		stop = -1
+	}
+	objects, err := redis.ByteSlices(conn.Do("ZREVRANGE", models.IntervalActionExecutionKey+":"+name, 0, stop))
+	if err != nil && err != redis.ErrNil {
+		return nil, err
+	}
+
+	executions := make([]schedulerModels.IntervalActionExecution, len(objects))
+	for i, object := range objects {
+		if err = unmarshalObject(object, &executions[i]); err != nil {
+			return []schedulerModels.IntervalActionExecution{}, err
+		}
+	}
+	return executions, nil
+}
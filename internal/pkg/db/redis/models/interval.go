@@ -22,6 +22,19 @@ import (
 const (
 	IntervalKey     = db.Interval
 	IntervalNameKey = db.Interval + ":name"
+	// IntervalTimezoneKey is the hash of the timezones of the intervals scheduled in a named timezone, by interval id.
+	IntervalTimezoneKey = db.Interval + ":timezone"
+	// IntervalMisfireKey is the hash of the misfire policies of the intervals which don't skip their missed runs, by
+	// interval id.
+	IntervalMisfireKey = db.Interval + ":misfire"
+	// IntervalLastRunKey is the hash of the time of the last scheduled run of the intervals, by interval id.
+	IntervalLastRunKey = db.Interval + ":lastrun"
+	// IntervalMaxIterationsKey is the hash of the maximum number of runs of the intervals limited to a number of runs,
+	// by interval id.
+	IntervalMaxIterationsKey = db.Interval + ":maxiterations"
+	// IntervalRemainingKey is the hash of the number of runs left to the intervals limited to a number of runs, by
+	// interval id.
+	IntervalRemainingKey = db.Interval + ":remaining"
 )
 
 var intervalKeys = []string{IntervalKey, IntervalNameKey}
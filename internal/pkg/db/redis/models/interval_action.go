@@ -24,6 +24,18 @@ const (
 	IntervalActionNameKey   = db.IntervalAction + ":name"
 	IntervalActionParentKey = db.IntervalAction + ":parent"
 	IntervalActionTargetKey = db.IntervalAction + ":target"
+	// IntervalActionExecutionKey prefixes the sorted sets of the executions of the interval actions, by action name,
+	// scored by their start time.
+	IntervalActionExecutionKey = db.IntervalAction + ":execution"
+	// IntervalActionPolicyKey is the hash of the execution policies of the interval actions which aren't executed with
+	// the default one, by action id.
+	IntervalActionPolicyKey = db.IntervalAction + ":policy"
+	// IntervalActionDependencyKey is the hash of the names of the interval actions each interval action with runAfter
+	// dependencies runs after, by action id.
+	IntervalActionDependencyKey = db.IntervalAction + ":runafter"
+	// IntervalActionAuthHeaderKey is the hash of the secret-backed headers of the interval actions which have some, by
+	// action id.
+	IntervalActionAuthHeaderKey = db.IntervalAction + ":authheaders"
 )
 
 var intervalActionKeys = []string{IntervalActionKey, IntervalActionNameKey, IntervalActionParentKey, IntervalActionTargetKey}
@@ -307,14 +307,26 @@ func (c Client) UpdateSubscription(s contract.Subscription) error {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
+	var previous contract.Subscription
+	err := getObjectById(conn, s.ID, unmarshalObject, &previous)
+	if err != nil {
+		return err
+	}
+
 	// update modified, delete and add subscription
-	err := deleteSubscription(conn, s.ID)
+	err = deleteSubscription(conn, s.ID)
 	if err != nil {
 		return nil
 	}
 
 	s.Modified = db.MakeTimestamp()
-	return addSubscription(conn, &s)
+	if err = addSubscription(conn, &s); err != nil {
+		return err
+	}
+	if previous.Slug != s.Slug {
+		return moveSubscriptionFilter(conn, previous.Slug, s.Slug)
+	}
+	return nil
 }
 
 func (c Client) GetSubscriptions() ([]contract.Subscription, error) {
@@ -350,7 +362,45 @@ func (c *Client) DeleteSubscriptionById(id string) error {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	return deleteSubscription(conn, id)
+	var s contract.Subscription
+	err := getObjectById(conn, id, unmarshalObject, &s)
+	if err != nil {
+		return err
+	}
+
+	err = deleteSubscription(conn, id)
+	if err != nil {
+		return err
+	}
+
+	err = deleteSubscriptionFilterBySlug(conn, s.Slug)
+	if err != nil {
+		return err
+	}
+
+	err = deleteEscalationPolicyBySlug(conn, s.Slug)
+	if err != nil {
+		return err
+	}
+
+	err = deleteRetryPolicyBySlug(conn, s.Slug)
+	if err != nil {
+		return err
+	}
+
+	err = deleteRoutingPolicyBySlug(conn, s.Slug)
+	if err != nil {
+		return err
+	}
+
+	// the subscription no longer references its template, if it had one
+	_, err = conn.Do("HDEL", db.SubscriptionTemplate, s.Slug)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.Do("HDEL", db.ResendCancellation, s.Slug)
+	return err
 }
 
 func (c Client) GetSubscriptionBySlug(slug string) (s contract.Subscription, err error) {
@@ -459,8 +509,39 @@ func (c Client) DeleteSubscriptionBySlug(slug string) error {
 		return err
 	}
 
-	return deleteSubscription(conn, s.ID)
+	err = deleteSubscription(conn, s.ID)
+	if err != nil {
+		return err
+	}
+
+	err = deleteSubscriptionFilterBySlug(conn, s.Slug)
+	if err != nil {
+		return err
+	}
+
+	err = deleteEscalationPolicyBySlug(conn, s.Slug)
+	if err != nil {
+		return err
+	}
+
+	err = deleteRetryPolicyBySlug(conn, s.Slug)
+	if err != nil {
+		return err
+	}
+
+	err = deleteRoutingPolicyBySlug(conn, s.Slug)
+	if err != nil {
+		return err
+	}
 
+	// the subscription no longer references its template, if it had one
+	_, err = conn.Do("HDEL", db.SubscriptionTemplate, s.Slug)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.Do("HDEL", db.ResendCancellation, s.Slug)
+	return err
 }
 
 // ******************************* TRANSMISSIONS **********************************
@@ -582,6 +663,20 @@ func (c Client) GetTransmissionsByStatus(limit int, status contract.Transmission
 	return transmissions, nil
 }
 
+// GetTransmissionsByStatusAndTimeRange returns the transmissions of the status created between start and end, in
+// milliseconds, intersecting the status set with the creation set within redis rather than filtering client-side.
+func (c Client) GetTransmissionsByStatusAndTimeRange(status contract.TransmissionStatus, start int64, end int64, limit int) (transmissions []contract.Transmission, err error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	objects, err := getObjectsByScoreIntersection(conn, db.Transmission+":created", db.Transmission+":status:"+string(status), start, end, limit)
+	if err != nil {
+		return transmissions, err
+	}
+
+	return unmarshalTransmissions(objects)
+}
+
 // DeleteTransmission delete old transmission with specified status
 func (c Client) DeleteTransmission(age int64, status contract.TransmissionStatus) error {
 	conn := c.Pool.Get()
@@ -604,6 +699,10 @@ func (c Client) DeleteTransmission(age int64, status contract.TransmissionStatus
 		if err != nil {
 			return err
 		}
+		_, err = conn.Do("DEL", transmissionHistoryKey(transmission.ID))
+		if err != nil {
+			return err
+		}
 	}
 	return err
 }
@@ -661,6 +760,14 @@ func (c Client) CleanupOld(age int) error {
 			if err != nil {
 				return err
 			}
+			_, err = conn.Do("DEL", transmissionHistoryKey(transmission.ID))
+			if err != nil {
+				return err
+			}
+		}
+		err = deleteEscalationsByNotification(conn, notification.Slug)
+		if err != nil {
+			return err
 		}
 	}
 
@@ -719,17 +826,7 @@ func deleteNotification(conn redis.Conn, id string) error {
 	}
 
 	_ = conn.Send("MULTI")
-	_ = conn.Send("DEL", id)
-	_ = conn.Send("ZREM", db.Notification, id)
-	_ = conn.Send("HDEL", db.Notification+":slug", n.Slug)
-	_ = conn.Send("ZREM", db.Notification+":sender:"+n.Sender, id)
-	_ = conn.Send("ZREM", db.Notification+":status:"+n.Status, id)
-	_ = conn.Send("ZREM", db.Notification+":severity:"+n.Severity, id)
-	_ = conn.Send("ZREM", db.Notification+":created", id)
-	_ = conn.Send("ZREM", db.Notification+":modified", id)
-	for _, label := range n.Labels {
-		_ = conn.Send("ZREM", db.Notification+":label:"+label, id)
-	}
+	sendDeleteNotification(conn, n)
 	_, err = conn.Do("EXEC")
 
 	return err
@@ -839,13 +936,7 @@ func deleteTransmission(conn redis.Conn, id string) error {
 	}
 
 	_ = conn.Send("MULTI")
-	_ = conn.Send("DEL", id)
-	_ = conn.Send("ZREM", db.Transmission, id)
-	_ = conn.Send("ZREM", db.Transmission+":slug"+t.Notification.Slug, t.Notification.Slug)
-	_ = conn.Send("ZREM", db.Transmission+":status:"+t.Status, id)
-	_ = conn.Send("ZREM", db.Transmission+":resendcount", id)
-	_ = conn.Send("ZREM", db.Transmission+":created", id)
-	_ = conn.Send("ZREM", db.Transmission+":modified", id)
+	sendDeleteTransmission(conn, t)
 	_, err = conn.Do("EXEC")
 
 	return err
@@ -861,6 +952,10 @@ func (c Client) deleteTransmissionBySlug(conn redis.Conn, slug string) error {
 		if err != nil {
 			return err
 		}
+		_, err = conn.Do("DEL", transmissionHistoryKey(transmission.ID))
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
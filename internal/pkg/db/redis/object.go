@@ -24,6 +24,10 @@ func marshalObject(in interface{}) (out []byte, err error) {
 	return json.Marshal(in)
 }
 
+// unmarshalObject decodes a stored object according to the decode policy, see checkUnknownFields.
 func unmarshalObject(in []byte, out interface{}) (err error) {
-	return json.Unmarshal(in, out)
+	if err = json.Unmarshal(in, out); err != nil {
+		return err
+	}
+	return checkUnknownFields(in, out)
 }
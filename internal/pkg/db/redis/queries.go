@@ -315,6 +315,22 @@ func getObjectsByValuesSorted(conn redis.Conn, limit int, vals ...string) ([][]b
 	return objects, nil
 }
 
+// getObjectsByScoreIntersection returns the objects of key, also members of filter, whose score in key lies between
+// start and end. The intersection keeps the scores of key and is stored in a temporary set deleted once read.
+func getObjectsByScoreIntersection(conn redis.Conn, key string, filter string, start, end int64, limit int) ([][]byte, error) {
+	cacheSet := uuid.New().String()
+
+	_, err := conn.Do("ZINTERSTORE", cacheSet, 2, key, filter, "WEIGHTS", 1, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_, _ = conn.Do("DEL", cacheSet)
+	}()
+
+	return getObjectsByScore(conn, cacheSet, start, end, limit)
+}
+
 func validateKeyExists(conn redis.Conn, key string) error {
 	count, err := redis.Int(conn.Do("EXISTS", key))
 	if err != nil {
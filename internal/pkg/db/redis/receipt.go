@@ -0,0 +1,49 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	notifications "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/google/uuid"
+)
+
+// ******************************* DELIVERY RECEIPTS **********************************
+
+// AddDeliveryReceipt stores the receipt of its transmission, replacing the one of a previous attempt. The receipt is
+// stored under a key derived from the transmission so that it is deleted along with it.
+func (c Client) AddDeliveryReceipt(r notifications.DeliveryReceipt) (string, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if r.ID == "" {
+		r.ID = uuid.New().String()
+	}
+	r.Created = db.MakeTimestamp()
+
+	obj, err := marshalObject(r)
+	if err != nil {
+		return "", err
+	}
+	_, err = conn.Do("SET", deliveryReceiptKey(r.Transmission), obj)
+	if err != nil {
+		return "", err
+	}
+	return r.ID, nil
+}
+
+func (c Client) GetDeliveryReceiptByTransmission(id string) (r notifications.DeliveryReceipt, err error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	err = getObjectById(conn, deliveryReceiptKey(id), unmarshalObject, &r)
+	return r, err
+}
+
+func deliveryReceiptKey(transmission string) string {
+	return db.DeliveryReceipt + ":transmission:" + transmission
+}
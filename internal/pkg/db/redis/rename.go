@@ -0,0 +1,170 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	data "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+	"github.com/gomodule/redigo/redis"
+)
+
+// renameBatchSize is the number of objects moved to the new name of a device by each transaction.
+const renameBatchSize = 1000
+
+// ***************************** DEVICE RENAME ******************************
+
+// RenameDeviceData moves the events, readings and rollups of a device to its new name. The objects are moved in
+// batches, each batch in a transaction, so that an interrupted rename is resumed by renaming again.
+func (c *Client) RenameDeviceData(from string, to string) (int, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, err := renameIndexedObjects(
+		conn,
+		db.EventsCollection+":device:"+from,
+		db.EventsCollection+":device:"+to,
+		func(o []byte) (string, int64, []byte, error) {
+			e, err := unmarshalRedisEvent(o)
+			if err != nil {
+				return "", 0, nil, err
+			}
+			e.Device = to
+			m, err := marshalObject(e)
+			return e.ID, e.Created, m, err
+		})
+	if err != nil {
+		return count, err
+	}
+
+	_, err = renameIndexedObjects(
+		conn,
+		db.ReadingsCollection+":device:"+from,
+		db.ReadingsCollection+":device:"+to,
+		func(o []byte) (string, int64, []byte, error) {
+			var r contract.Reading
+			err := unmarshalObject(o, &r)
+			if err != nil {
+				return "", 0, nil, err
+			}
+			r.Device = to
+			m, err := marshalObject(r)
+			return r.Id, r.Created, m, err
+		})
+	if err != nil {
+		return count, err
+	}
+
+	for _, period := range data.RollupPeriods {
+		err = renameRollups(conn, period, from, to)
+		if err != nil {
+			return count, err
+		}
+	}
+	return count, nil
+}
+
+// renameIndexedObjects rewrites the objects indexed by the from key with rename and moves them to the to key, scored
+// by the score returned by rename. Returns the number of objects moved.
+func renameIndexedObjects(
+	conn redis.Conn,
+	from string,
+	to string,
+	rename func(o []byte) (id string, score int64, m []byte, err error)) (int, error) {
+
+	count := 0
+	for {
+		ids, err := redis.Values(conn.Do("ZRANGE", from, 0, renameBatchSize-1))
+		if err != nil && err != redis.ErrNil {
+			return count, err
+		}
+		if len(ids) == 0 {
+			return count, nil
+		}
+		objects, err := redis.ByteSlices(conn.Do("MGET", ids...))
+		if err != nil {
+			return count, err
+		}
+
+		_ = conn.Send("MULTI")
+		moved := 0
+		for i, o := range objects {
+			// the index may outlive the object it refers to
+			if o == nil {
+				_ = conn.Send("ZREM", from, ids[i])
+				continue
+			}
+			id, score, m, err := rename(o)
+			if err != nil {
+				_, _ = conn.Do("DISCARD")
+				return count, err
+			}
+			_ = conn.Send("SET", id, m)
+			_ = conn.Send("ZADD", to, score, id)
+			_ = conn.Send("ZREM", from, ids[i])
+			moved++
+		}
+		_, err = conn.Do("EXEC")
+		if err != nil {
+			return count, err
+		}
+		count += moved
+	}
+}
+
+// renameRollups moves the rollups of the period of a device to its new name, merging them into the rollups of the
+// same start already stored for that name, if any.
+func renameRollups(conn redis.Conn, period string, from string, to string) error {
+	objects, err := getObjectsByScore(conn, rollupDeviceKey(period, from), 0, -1, 0)
+	if err != nil && err != redis.ErrNil {
+		return err
+	}
+
+	for _, object := range objects {
+		var r data.Rollup
+		err = unmarshalObject(object, &r)
+		if err != nil {
+			return err
+		}
+
+		existing, err := getObjectsByScore(conn, rollupSeriesKey(period, to, r.Name), r.Start, r.Start, 1)
+		if err != nil && err != redis.ErrNil {
+			return err
+		}
+		moved := r
+		moved.Device = to
+		if len(existing) > 0 {
+			err = unmarshalObject(existing[0], &moved)
+			if err != nil {
+				return err
+			}
+			moved.Merge(r)
+			moved.Modified = db.MakeTimestamp()
+		}
+		m, err := marshalObject(moved)
+		if err != nil {
+			return err
+		}
+
+		_ = conn.Send("MULTI")
+		if moved.ID != r.ID {
+			_ = conn.Send("DEL", r.ID)
+			_ = conn.Send("ZREM", db.RollupCollection+":"+period, r.ID)
+		}
+		_ = conn.Send("ZREM", rollupDeviceKey(period, from), r.ID)
+		_ = conn.Send("ZREM", rollupSeriesKey(period, from, r.Name), r.ID)
+		_ = conn.Send("SET", moved.ID, m)
+		_ = conn.Send("ZADD", db.RollupCollection+":"+period, moved.Start, moved.ID)
+		_ = conn.Send("ZADD", rollupDeviceKey(period, to), moved.Start, moved.ID)
+		_ = conn.Send("ZADD", rollupSeriesKey(period, to, moved.Name), moved.Start, moved.ID)
+		_, err = conn.Do("EXEC")
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
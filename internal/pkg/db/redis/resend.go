@@ -0,0 +1,37 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// ******************************* RESEND CANCELLATIONS **********************************
+
+// SetResendCancellation records when the pending resends of the failed transmissions of the subscription were
+// cancelled. The cancellation is deleted along with the subscription.
+func (c Client) SetResendCancellation(slug string, cancelled int64) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	_, err := conn.Do("HSET", db.ResendCancellation, slug, cancelled)
+	return err
+}
+
+// GetResendCancellation returns when the pending resends of the subscription were last cancelled, 0 when they never
+// were.
+func (c Client) GetResendCancellation(slug string) (int64, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	cancelled, err := redis.Int64(conn.Do("HGET", db.ResendCancellation, slug))
+	if err == redis.ErrNil {
+		return 0, nil
+	}
+	return cancelled, err
+}
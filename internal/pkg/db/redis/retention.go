@@ -0,0 +1,213 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// retentionBatchSize is the number of notifications read and deleted per transaction by the bulk deletions, so that a
+// large backlog doesn't block the database in a single transaction.
+const retentionBatchSize = 500
+
+// ******************************* RETENTION **********************************
+
+// DeleteProcessedNotificationsByModifiedRange deletes the processed notifications of the severity last modified
+// between start and end, in milliseconds, along with their transmissions and escalations, and returns the number of
+// notifications deleted.
+func (c Client) DeleteProcessedNotificationsByModifiedRange(
+	severity contract.NotificationsSeverity,
+	start int64,
+	end int64) (int, error) {
+
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	ids, err := idsByScoreRange(conn, db.Notification+":modified", start, end)
+	if err != nil {
+		return 0, err
+	}
+
+	deleted := 0
+	for i := 0; i < len(ids); i += retentionBatchSize {
+		last := i + retentionBatchSize
+		if last > len(ids) {
+			last = len(ids)
+		}
+		count, err := deleteProcessedNotifications(conn, ids[i:last], severity)
+		deleted += count
+		if err != nil {
+			return deleted, err
+		}
+	}
+	return deleted, nil
+}
+
+// DeleteTransmissionsByModifiedRange deletes the transmissions last modified between start and end, in milliseconds,
+// whatever their notification, and returns the number of transmissions deleted.
+func (c Client) DeleteTransmissionsByModifiedRange(start int64, end int64) (int, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	ids, err := idsByScoreRange(conn, db.Transmission+":modified", start, end)
+	if err != nil {
+		return 0, err
+	}
+
+	deleted := 0
+	for i := 0; i < len(ids); i += retentionBatchSize {
+		last := i + retentionBatchSize
+		if last > len(ids) {
+			last = len(ids)
+		}
+		transmissions, err := transmissionsByIds(conn, ids[i:last])
+		if err != nil {
+			return deleted, err
+		}
+
+		_ = conn.Send("MULTI")
+		for _, t := range transmissions {
+			sendDeleteTransmission(conn, t)
+			sendDeleteTransmissionHistory(conn, t.ID)
+		}
+		_, err = conn.Do("EXEC")
+		if err != nil {
+			return deleted, err
+		}
+		deleted += len(transmissions)
+	}
+	return deleted, nil
+}
+
+// idsByScoreRange returns the members of the sorted set scored between start and end, or up to the highest score
+// when end is negative.
+func idsByScoreRange(conn redis.Conn, key string, start int64, end int64) ([]string, error) {
+	var max interface{} = end
+	if end < 0 {
+		max = "+inf"
+	}
+	ids, err := redis.Strings(conn.Do("ZRANGEBYSCORE", key, start, max))
+	if err != nil && err != redis.ErrNil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// deleteProcessedNotifications deletes the processed notifications of the severity among those of the ids, along with
+// their transmissions, in a single transaction, and then their escalations.
+func deleteProcessedNotifications(conn redis.Conn, ids []string, severity contract.NotificationsSeverity) (int, error) {
+	objects, err := redis.ByteSlices(conn.Do("MGET", redis.Args{}.AddFlat(ids)...))
+	if err != nil {
+		return 0, err
+	}
+
+	var notifications []contract.Notification
+	var transmissions []contract.Transmission
+	for _, object := range objects {
+		if len(object) == 0 {
+			continue
+		}
+		var n contract.Notification
+		err = unmarshalObject(object, &n)
+		if err != nil {
+			return 0, err
+		}
+		if n.Status != contract.Processed || n.Severity != severity {
+			continue
+		}
+		notifications = append(notifications, n)
+
+		tids, err := idsByScoreRange(conn, db.Transmission+":slug:"+n.Slug, 0, -1)
+		if err != nil {
+			return 0, err
+		}
+		ts, err := transmissionsByIds(conn, tids)
+		if err != nil {
+			return 0, err
+		}
+		transmissions = append(transmissions, ts...)
+	}
+	if len(notifications) == 0 {
+		return 0, nil
+	}
+
+	_ = conn.Send("MULTI")
+	for _, n := range notifications {
+		sendDeleteNotification(conn, n)
+	}
+	for _, t := range transmissions {
+		sendDeleteTransmission(conn, t)
+		sendDeleteTransmissionHistory(conn, t.ID)
+	}
+	_, err = conn.Do("EXEC")
+	if err != nil {
+		return 0, err
+	}
+
+	for _, n := range notifications {
+		err = deleteEscalationsByNotification(conn, n.Slug)
+		if err != nil {
+			return len(notifications), err
+		}
+	}
+	return len(notifications), nil
+}
+
+func transmissionsByIds(conn redis.Conn, ids []string) ([]contract.Transmission, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	objects, err := redis.ByteSlices(conn.Do("MGET", redis.Args{}.AddFlat(ids)...))
+	if err != nil {
+		return nil, err
+	}
+
+	transmissions := make([]contract.Transmission, 0, len(objects))
+	for _, object := range objects {
+		if len(object) == 0 {
+			continue
+		}
+		var t contract.Transmission
+		err = unmarshalObject(object, &t)
+		if err != nil {
+			return nil, err
+		}
+		transmissions = append(transmissions, t)
+	}
+	return transmissions, nil
+}
+
+// sendDeleteNotification queues the deletion of the notification and of its index entries in the current transaction.
+func sendDeleteNotification(conn redis.Conn, n contract.Notification) {
+	_ = conn.Send("DEL", n.ID)
+	_ = conn.Send("ZREM", db.Notification, n.ID)
+	_ = conn.Send("HDEL", db.Notification+":slug", n.Slug)
+	_ = conn.Send("ZREM", db.Notification+":sender:"+n.Sender, n.ID)
+	_ = conn.Send("ZREM", db.Notification+":status:"+n.Status, n.ID)
+	_ = conn.Send("ZREM", db.Notification+":severity:"+n.Severity, n.ID)
+	_ = conn.Send("ZREM", db.Notification+":created", n.ID)
+	_ = conn.Send("ZREM", db.Notification+":modified", n.ID)
+	for _, label := range n.Labels {
+		_ = conn.Send("ZREM", db.Notification+":label:"+label, n.ID)
+	}
+}
+
+// sendDeleteTransmission queues the deletion of the transmission, of its index entries and of its delivery receipt in
+// the current transaction.
+func sendDeleteTransmission(conn redis.Conn, t contract.Transmission) {
+	_ = conn.Send("DEL", t.ID)
+	_ = conn.Send("ZREM", db.Transmission, t.ID)
+	_ = conn.Send("ZREM", db.Transmission+":slug:"+t.Notification.Slug, t.ID)
+	_ = conn.Send("ZREM", db.Transmission+":status:"+t.Status, t.ID)
+	_ = conn.Send("ZREM", db.Transmission+":resendcount", t.ID)
+	_ = conn.Send("ZREM", db.Transmission+":created", t.ID)
+	_ = conn.Send("ZREM", db.Transmission+":modified", t.ID)
+	_ = conn.Send("DEL", deliveryReceiptKey(t.ID))
+}
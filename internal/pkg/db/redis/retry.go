@@ -0,0 +1,122 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	notifications "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// ******************************* RETRY POLICIES **********************************
+func (c Client) GetRetryPolicyBySlug(slug string) (p notifications.RetryPolicy, err error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	err = getObjectByHash(conn, db.RetryPolicy+":slug", slug, unmarshalObject, &p)
+	return p, err
+}
+
+func (c Client) AddRetryPolicy(p notifications.RetryPolicy) (string, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	err := addRetryPolicy(conn, &p)
+	if err != nil {
+		return "", err
+	}
+	return p.ID, nil
+}
+
+func (c Client) UpdateRetryPolicy(p notifications.RetryPolicy) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	var existing notifications.RetryPolicy
+	err := getObjectByHash(conn, db.RetryPolicy+":slug", p.Subscription, unmarshalObject, &existing)
+	if err != nil {
+		return err
+	}
+
+	err = deleteRetryPolicy(conn, existing)
+	if err != nil {
+		return err
+	}
+
+	p.ID = existing.ID
+	p.Created = existing.Created
+	p.Modified = db.MakeTimestamp()
+	return addRetryPolicy(conn, &p)
+}
+
+func (c Client) DeleteRetryPolicyBySlug(slug string) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	var p notifications.RetryPolicy
+	err := getObjectByHash(conn, db.RetryPolicy+":slug", slug, unmarshalObject, &p)
+	if err != nil {
+		return err
+	}
+	return deleteRetryPolicy(conn, p)
+}
+
+func addRetryPolicy(conn redis.Conn, p *notifications.RetryPolicy) error {
+	exists, err := redis.Bool(conn.Do("HEXISTS", db.RetryPolicy+":slug", p.Subscription))
+	if err != nil {
+		return err
+	} else if exists {
+		return errors.Errorf("%v, subscription=%v", db.ErrNotUnique, p.Subscription)
+	}
+
+	if p.Created == 0 {
+		p.Created = db.MakeTimestamp()
+		p.Modified = p.Created
+	}
+
+	if p.ID == "" {
+		p.ID = uuid.New().String()
+	}
+
+	obj, err := marshalObject(p)
+	if err != nil {
+		return err
+	}
+
+	_ = conn.Send("MULTI")
+	_ = conn.Send("SET", p.ID, obj)
+	_ = conn.Send("ZADD", db.RetryPolicy, 0, p.ID)
+	_ = conn.Send("HSET", db.RetryPolicy+":slug", p.Subscription, p.ID)
+	_, err = conn.Do("EXEC")
+
+	return err
+}
+
+func deleteRetryPolicy(conn redis.Conn, p notifications.RetryPolicy) error {
+	_ = conn.Send("MULTI")
+	_ = conn.Send("DEL", p.ID)
+	_ = conn.Send("ZREM", db.RetryPolicy, p.ID)
+	_ = conn.Send("HDEL", db.RetryPolicy+":slug", p.Subscription)
+	_, err := conn.Do("EXEC")
+
+	return err
+}
+
+// deleteRetryPolicyBySlug removes the retry policy of a deleted subscription, if it had one.
+func deleteRetryPolicyBySlug(conn redis.Conn, slug string) error {
+	var p notifications.RetryPolicy
+	err := getObjectByHash(conn, db.RetryPolicy+":slug", slug, unmarshalObject, &p)
+	if err != nil {
+		if err == db.ErrNotFound {
+			return nil
+		}
+		return err
+	}
+	return deleteRetryPolicy(conn, p)
+}
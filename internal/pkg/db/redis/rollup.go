@@ -0,0 +1,122 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	data "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/google/uuid"
+)
+
+// ******************************* ROLLUPS **********************************
+
+// MergeRollup merges the rollup into the stored one. The stored rollup is read, merged and written back without a
+// transaction spanning both, hence the rollups are meant to be merged by a single writer.
+func (c *Client) MergeRollup(r data.Rollup) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	return mergeRollup(conn, r)
+}
+
+func (c *Client) RollupsByDevice(period string, device string, start int64, end int64, limit int) ([]data.Rollup, error) {
+	return c.getRollups(rollupDeviceKey(period, device), start, end, limit)
+}
+
+func (c *Client) RollupsByDeviceAndName(period string, device string, name string, start int64, end int64, limit int) ([]data.Rollup, error) {
+	return c.getRollups(rollupSeriesKey(period, device, name), start, end, limit)
+}
+
+func (c *Client) DeleteRollupsBefore(period string, start int64) error {
+	if start <= 0 {
+		return nil
+	}
+
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	objects, err := getObjectsByScore(conn, db.RollupCollection+":"+period, 0, start-1, -1)
+	if err != nil {
+		return err
+	}
+
+	_ = conn.Send("MULTI")
+	for _, object := range objects {
+		var r data.Rollup
+		err = unmarshalObject(object, &r)
+		if err != nil {
+			_, _ = conn.Do("DISCARD")
+			return err
+		}
+		_ = conn.Send("DEL", r.ID)
+		_ = conn.Send("ZREM", db.RollupCollection+":"+r.Period, r.ID)
+		_ = conn.Send("ZREM", rollupDeviceKey(r.Period, r.Device), r.ID)
+		_ = conn.Send("ZREM", rollupSeriesKey(r.Period, r.Device, r.Name), r.ID)
+	}
+	_, err = conn.Do("EXEC")
+
+	return err
+}
+
+func (c *Client) getRollups(key string, start int64, end int64, limit int) ([]data.Rollup, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	objects, err := getObjectsByScore(conn, key, start, end, limit)
+	if err != nil && err != redis.ErrNil {
+		return nil, err
+	}
+
+	rollups := make([]data.Rollup, len(objects))
+	for i, object := range objects {
+		err = unmarshalObject(object, &rollups[i])
+		if err != nil {
+			return []data.Rollup{}, err
+		}
+	}
+	return rollups, nil
+}
+
+func mergeRollup(conn redis.Conn, r data.Rollup) error {
+	objects, err := getObjectsByScore(conn, rollupSeriesKey(r.Period, r.Device, r.Name), r.Start, r.Start, 1)
+	if err != nil && err != redis.ErrNil {
+		return err
+	}
+
+	merged := data.Rollup{ID: uuid.New().String(), Device: r.Device, Name: r.Name, Period: r.Period, Start: r.Start}
+	if len(objects) > 0 {
+		err = unmarshalObject(objects[0], &merged)
+		if err != nil {
+			return err
+		}
+	}
+	merged.Merge(r)
+	merged.Modified = db.MakeTimestamp()
+
+	obj, err := marshalObject(merged)
+	if err != nil {
+		return err
+	}
+
+	_ = conn.Send("MULTI")
+	_ = conn.Send("SET", merged.ID, obj)
+	_ = conn.Send("ZADD", db.RollupCollection+":"+merged.Period, merged.Start, merged.ID)
+	_ = conn.Send("ZADD", rollupDeviceKey(merged.Period, merged.Device), merged.Start, merged.ID)
+	_ = conn.Send("ZADD", rollupSeriesKey(merged.Period, merged.Device, merged.Name), merged.Start, merged.ID)
+	_, err = conn.Do("EXEC")
+
+	return err
+}
+
+func rollupDeviceKey(period string, device string) string {
+	return db.RollupCollection + ":" + period + ":device:" + device
+}
+
+func rollupSeriesKey(period string, device string, name string) string {
+	return rollupDeviceKey(period, device) + ":name:" + name
+}
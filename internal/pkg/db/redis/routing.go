@@ -0,0 +1,122 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	notifications "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// ******************************* ROUTING POLICIES **********************************
+func (c Client) GetRoutingPolicyBySlug(slug string) (p notifications.RoutingPolicy, err error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	err = getObjectByHash(conn, db.RoutingPolicy+":slug", slug, unmarshalObject, &p)
+	return p, err
+}
+
+func (c Client) AddRoutingPolicy(p notifications.RoutingPolicy) (string, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	err := addRoutingPolicy(conn, &p)
+	if err != nil {
+		return "", err
+	}
+	return p.ID, nil
+}
+
+func (c Client) UpdateRoutingPolicy(p notifications.RoutingPolicy) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	var existing notifications.RoutingPolicy
+	err := getObjectByHash(conn, db.RoutingPolicy+":slug", p.Subscription, unmarshalObject, &existing)
+	if err != nil {
+		return err
+	}
+
+	err = deleteRoutingPolicy(conn, existing)
+	if err != nil {
+		return err
+	}
+
+	p.ID = existing.ID
+	p.Created = existing.Created
+	p.Modified = db.MakeTimestamp()
+	return addRoutingPolicy(conn, &p)
+}
+
+func (c Client) DeleteRoutingPolicyBySlug(slug string) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	var p notifications.RoutingPolicy
+	err := getObjectByHash(conn, db.RoutingPolicy+":slug", slug, unmarshalObject, &p)
+	if err != nil {
+		return err
+	}
+	return deleteRoutingPolicy(conn, p)
+}
+
+func addRoutingPolicy(conn redis.Conn, p *notifications.RoutingPolicy) error {
+	exists, err := redis.Bool(conn.Do("HEXISTS", db.RoutingPolicy+":slug", p.Subscription))
+	if err != nil {
+		return err
+	} else if exists {
+		return errors.Errorf("%v, subscription=%v", db.ErrNotUnique, p.Subscription)
+	}
+
+	if p.Created == 0 {
+		p.Created = db.MakeTimestamp()
+		p.Modified = p.Created
+	}
+
+	if p.ID == "" {
+		p.ID = uuid.New().String()
+	}
+
+	obj, err := marshalObject(p)
+	if err != nil {
+		return err
+	}
+
+	_ = conn.Send("MULTI")
+	_ = conn.Send("SET", p.ID, obj)
+	_ = conn.Send("ZADD", db.RoutingPolicy, 0, p.ID)
+	_ = conn.Send("HSET", db.RoutingPolicy+":slug", p.Subscription, p.ID)
+	_, err = conn.Do("EXEC")
+
+	return err
+}
+
+func deleteRoutingPolicy(conn redis.Conn, p notifications.RoutingPolicy) error {
+	_ = conn.Send("MULTI")
+	_ = conn.Send("DEL", p.ID)
+	_ = conn.Send("ZREM", db.RoutingPolicy, p.ID)
+	_ = conn.Send("HDEL", db.RoutingPolicy+":slug", p.Subscription)
+	_, err := conn.Do("EXEC")
+
+	return err
+}
+
+// deleteRoutingPolicyBySlug removes the routing policy of a deleted subscription, if it had one.
+func deleteRoutingPolicyBySlug(conn redis.Conn, slug string) error {
+	var p notifications.RoutingPolicy
+	err := getObjectByHash(conn, db.RoutingPolicy+":slug", slug, unmarshalObject, &p)
+	if err != nil {
+		if err == db.ErrNotFound {
+			return nil
+		}
+		return err
+	}
+	return deleteRoutingPolicy(conn, p)
+}
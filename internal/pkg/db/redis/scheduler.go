@@ -19,6 +19,7 @@ import (
 
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db/redis/models"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
 	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
 	"github.com/gomodule/redigo/redis"
 	"github.com/google/uuid"
@@ -181,12 +182,117 @@ func (c *Client) DeleteIntervalById(id string) (err error) {
 
 	_ = conn.Send("MULTI")
 	deleteObject(interval, id, conn)
+	_ = conn.Send("HDEL", models.IntervalTimezoneKey, id)
+	_ = conn.Send("HDEL", models.IntervalMisfireKey, id)
+	_ = conn.Send("HDEL", models.IntervalLastRunKey, id)
+	_ = conn.Send("HDEL", models.IntervalMaxIterationsKey, id)
+	_ = conn.Send("HDEL", models.IntervalRemainingKey, id)
 
 	_, err = conn.Do("EXEC")
 
 	return err
 }
 
+// Return the timezone of the schedule interval(s) scheduled in a named timezone, by interval ID
+func (c *Client) IntervalTimezones() (timezones map[string]string, err error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	return redis.StringMap(conn.Do("HGETALL", models.IntervalTimezoneKey))
+}
+
+// Set the timezone of a schedule interval by ID, an empty timezone scheduling it in UTC
+func (c *Client) SetIntervalTimezone(id string, timezone string) (err error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if timezone == "" {
+		_, err = conn.Do("HDEL", models.IntervalTimezoneKey, id)
+		return err
+	}
+	_, err = conn.Do("HSET", models.IntervalTimezoneKey, id, timezone)
+	return err
+}
+
+// Return the misfire policy of the schedule interval(s) which don't skip their missed runs, by interval ID
+func (c *Client) IntervalMisfirePolicies() (policies map[string]string, err error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	return redis.StringMap(conn.Do("HGETALL", models.IntervalMisfireKey))
+}
+
+// Set the misfire policy of a schedule interval by ID, an empty policy skipping its missed runs
+func (c *Client) SetIntervalMisfirePolicy(id string, policy string) (err error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if policy == "" {
+		_, err = conn.Do("HDEL", models.IntervalMisfireKey, id)
+		return err
+	}
+	_, err = conn.Do("HSET", models.IntervalMisfireKey, id, policy)
+	return err
+}
+
+// Return the time of the last scheduled run of the schedule interval(s), in epoch milliseconds, by interval ID
+func (c *Client) IntervalLastRuns() (lastRuns map[string]int64, err error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	return redis.Int64Map(conn.Do("HGETALL", models.IntervalLastRunKey))
+}
+
+// Set the time of the last scheduled run of a schedule interval by ID, in epoch milliseconds
+func (c *Client) SetIntervalLastRun(id string, lastRun int64) (err error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	_, err = conn.Do("HSET", models.IntervalLastRunKey, id, lastRun)
+	return err
+}
+
+// Return the maximum number of runs of the schedule interval(s) limited to a number of runs, by interval ID
+func (c *Client) IntervalMaxIterations() (maxIterations map[string]int64, err error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	return redis.Int64Map(conn.Do("HGETALL", models.IntervalMaxIterationsKey))
+}
+
+// Set the maximum number of runs of a schedule interval by ID, 0 for no limit, which restarts the count of its runs
+func (c *Client) SetIntervalMaxIterations(id string, maxIterations int64) (err error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	_ = conn.Send("MULTI")
+	if maxIterations == 0 {
+		_ = conn.Send("HDEL", models.IntervalMaxIterationsKey, id)
+	} else {
+		_ = conn.Send("HSET", models.IntervalMaxIterationsKey, id, maxIterations)
+	}
+	_ = conn.Send("HDEL", models.IntervalRemainingKey, id)
+	_, err = conn.Do("EXEC")
+	return err
+}
+
+// Return the number of runs left to the schedule interval(s) limited to a number of runs, by interval ID
+func (c *Client) IntervalRemainingIterations() (remaining map[string]int64, err error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	return redis.Int64Map(conn.Do("HGETALL", models.IntervalRemainingKey))
+}
+
+// Set the number of runs left to a schedule interval limited to a number of runs by ID
+func (c *Client) SetIntervalRemainingIterations(id string, remaining int64) (err error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	_, err = conn.Do("HSET", models.IntervalRemainingKey, id, remaining)
+	return err
+}
+
 // Scrub all scheduler intervals from the database (only used in test)
 func (c *Client) ScrubAllIntervals() (count int, err error) {
 	conn := c.Pool.Get()
@@ -201,6 +307,16 @@ func (c *Client) ScrubAllIntervals() (count int, err error) {
 		}
 	}
 
+	if _, err = conn.Do(
+		"DEL",
+		models.IntervalTimezoneKey,
+		models.IntervalMisfireKey,
+		models.IntervalLastRunKey,
+		models.IntervalMaxIterationsKey,
+		models.IntervalRemainingKey); err != nil {
+		return -1, err
+	}
+
 	return 0, nil
 }
 
@@ -404,6 +520,10 @@ func (c *Client) DeleteIntervalActionById(id string) (err error) {
 
 	_ = conn.Send("MULTI")
 	deleteObject(action, id, conn)
+	_ = conn.Send("DEL", models.IntervalActionExecutionKey+":"+check.Name)
+	_ = conn.Send("HDEL", models.IntervalActionPolicyKey, id)
+	_ = conn.Send("HDEL", models.IntervalActionDependencyKey, id)
+	_ = conn.Send("HDEL", models.IntervalActionAuthHeaderKey, id)
 
 	_, err = conn.Do("EXEC")
 
@@ -424,5 +544,125 @@ func (c *Client) ScrubAllIntervalActions() (count int, err error) {
 		}
 	}
 
+	_, err = conn.Do("DEL", models.IntervalActionPolicyKey, models.IntervalActionDependencyKey, models.IntervalActionAuthHeaderKey)
+	if err != nil {
+		return -1, err
+	}
+
 	return 0, nil
 }
+
+// Return the execution policy of the schedule interval action(s) which aren't executed with the default one, by action
+// ID
+func (c *Client) IntervalActionPolicies() (policies map[string]schedulerModels.ExecutionPolicy, err error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	objects, err := redis.StringMap(conn.Do("HGETALL", models.IntervalActionPolicyKey))
+	if err != nil {
+		return nil, err
+	}
+	policies = make(map[string]schedulerModels.ExecutionPolicy, len(objects))
+	for id, object := range objects {
+		var policy schedulerModels.ExecutionPolicy
+		if err = unmarshalObject([]byte(object), &policy); err != nil {
+			return nil, err
+		}
+		policies[id] = policy
+	}
+	return policies, nil
+}
+
+// Set the execution policy of a schedule interval action by ID, the default policy removing it
+func (c *Client) SetIntervalActionPolicy(id string, policy schedulerModels.ExecutionPolicy) (err error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if policy.IsDefault() {
+		_, err = conn.Do("HDEL", models.IntervalActionPolicyKey, id)
+		return err
+	}
+	obj, err := marshalObject(policy)
+	if err != nil {
+		return err
+	}
+	_, err = conn.Do("HSET", models.IntervalActionPolicyKey, id, obj)
+	return err
+}
+
+// Return the names of the schedule interval action(s) each interval action with runAfter dependencies runs after, by
+// action ID
+func (c *Client) IntervalActionDependencies() (dependencies map[string][]string, err error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	objects, err := redis.StringMap(conn.Do("HGETALL", models.IntervalActionDependencyKey))
+	if err != nil {
+		return nil, err
+	}
+	dependencies = make(map[string][]string, len(objects))
+	for id, object := range objects {
+		var runAfter []string
+		if err = unmarshalObject([]byte(object), &runAfter); err != nil {
+			return nil, err
+		}
+		dependencies[id] = runAfter
+	}
+	return dependencies, nil
+}
+
+// Set the names of the schedule interval action(s) an interval action runs after by ID, no dependency removing them
+func (c *Client) SetIntervalActionDependencies(id string, runAfter []string) (err error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if len(runAfter) == 0 {
+		_, err = conn.Do("HDEL", models.IntervalActionDependencyKey, id)
+		return err
+	}
+	obj, err := marshalObject(runAfter)
+	if err != nil {
+		return err
+	}
+	_, err = conn.Do("HSET", models.IntervalActionDependencyKey, id, obj)
+	return err
+}
+
+// Return the headers injected from the secret store into the requests of the schedule interval action(s) which have
+// some, by action ID
+func (c *Client) IntervalActionAuthHeaders() (headers map[string][]schedulerModels.AuthHeader, err error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	objects, err := redis.StringMap(conn.Do("HGETALL", models.IntervalActionAuthHeaderKey))
+	if err != nil {
+		return nil, err
+	}
+	headers = make(map[string][]schedulerModels.AuthHeader, len(objects))
+	for id, object := range objects {
+		var actionHeaders []schedulerModels.AuthHeader
+		if err = unmarshalObject([]byte(object), &actionHeaders); err != nil {
+			return nil, err
+		}
+		headers[id] = actionHeaders
+	}
+	return headers, nil
+}
+
+// Set the headers injected from the secret store into the requests of a schedule interval action by ID, no header
+// removing them
+func (c *Client) SetIntervalActionAuthHeaders(id string, headers []schedulerModels.AuthHeader) (err error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if len(headers) == 0 {
+		_, err = conn.Do("HDEL", models.IntervalActionAuthHeaderKey, id)
+		return err
+	}
+	obj, err := marshalObject(headers)
+	if err != nil {
+		return err
+	}
+	_, err = conn.Do("HSET", models.IntervalActionAuthHeaderKey, id, obj)
+	return err
+}
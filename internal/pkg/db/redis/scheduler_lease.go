@@ -0,0 +1,38 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// ******************************* SCHEDULER LEADER ELECTION **********************************
+
+// AcquireSchedulerLease acquires the lease of the leader of the scheduler instances for the instance, or renews it when
+// the instance already holds it, for ttl. It returns whether the instance holds the lease, which expires unless renewed
+// so that another instance takes over when the leader stops.
+func (c *Client) AcquireSchedulerLease(instance string, ttl time.Duration) (bool, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	s := scripts["acquireLease"]
+	return redis.Bool(s.Do(conn, db.SchedulerLeader, instance, ttl.Milliseconds()))
+}
+
+// ReleaseSchedulerLease frees the lease of the leader when the instance holds it, so that another instance takes over
+// without waiting for the lease to expire.
+func (c *Client) ReleaseSchedulerLease(instance string) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	s := scripts["releaseLease"]
+	_, err := s.Do(conn, db.SchedulerLeader, instance)
+	return err
+}
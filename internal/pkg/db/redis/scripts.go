@@ -108,6 +108,26 @@ const (
 		end
 	until c == 0
 	`
+	// scriptAcquireLease sets the holder of the lease at KEYS[1] to ARGV[1] for ARGV[2] milliseconds when it is free or
+	// already held by ARGV[1], returning 1, and 0 when held by another holder.
+	scriptAcquireLease = `
+	local holder = redis.call('GET', KEYS[1])
+	if holder == false then
+		redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2])
+		return 1
+	elseif holder == ARGV[1] then
+		redis.call('PEXPIRE', KEYS[1], ARGV[2])
+		return 1
+	end
+	return 0
+	`
+	// scriptReleaseLease frees the lease at KEYS[1] when it is held by ARGV[1].
+	scriptReleaseLease = `
+	if redis.call('GET', KEYS[1]) == ARGV[1] then
+		return redis.call('DEL', KEYS[1])
+	end
+	return 0
+	`
 )
 
 var scripts = map[string]redis.Script{
@@ -116,6 +136,8 @@ var scripts = map[string]redis.Script{
 	"getObjectsByScore":       *redis.NewScript(1, scriptGetObjectsByScore),
 	"unlinkZsetMembers":       *redis.NewScript(1, scriptUnlinkZsetMembers),
 	"unlinkCollection":        *redis.NewScript(0, scriptUnlinkCollection),
+	"acquireLease":            *redis.NewScript(1, scriptAcquireLease),
+	"releaseLease":            *redis.NewScript(1, scriptReleaseLease),
 }
 
 func getObjectsByRangeLua(conn redis.Conn, key string, start, end int) (objects [][]byte, err error) {
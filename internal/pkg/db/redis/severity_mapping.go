@@ -0,0 +1,129 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"fmt"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	notifications "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/google/uuid"
+)
+
+// ******************************* SEVERITY MAPPINGS **********************************
+func (c Client) GetSeverityMappings() ([]notifications.SeverityMapping, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	objects, err := getObjectsByRange(conn, db.SeverityMapping, 0, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	mappings := make([]notifications.SeverityMapping, len(objects))
+	for i, object := range objects {
+		err = unmarshalObject(object, &mappings[i])
+		if err != nil {
+			return []notifications.SeverityMapping{}, err
+		}
+	}
+	return mappings, nil
+}
+
+func (c Client) GetSeverityMappingByName(name string) (m notifications.SeverityMapping, err error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	err = getObjectByHash(conn, db.SeverityMapping+":name", name, unmarshalObject, &m)
+	return m, err
+}
+
+func (c Client) AddSeverityMapping(m notifications.SeverityMapping) (string, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	err := addSeverityMapping(conn, &m)
+	if err != nil {
+		return "", err
+	}
+	return m.ID, nil
+}
+
+func (c Client) UpdateSeverityMapping(m notifications.SeverityMapping) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	var existing notifications.SeverityMapping
+	err := getObjectByHash(conn, db.SeverityMapping+":name", m.Name, unmarshalObject, &existing)
+	if err != nil {
+		return err
+	}
+
+	err = deleteSeverityMapping(conn, existing)
+	if err != nil {
+		return err
+	}
+
+	m.ID = existing.ID
+	m.Created = existing.Created
+	m.Modified = db.MakeTimestamp()
+	return addSeverityMapping(conn, &m)
+}
+
+func (c Client) DeleteSeverityMappingByName(name string) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	var m notifications.SeverityMapping
+	err := getObjectByHash(conn, db.SeverityMapping+":name", name, unmarshalObject, &m)
+	if err != nil {
+		return err
+	}
+	return deleteSeverityMapping(conn, m)
+}
+
+func addSeverityMapping(conn redis.Conn, m *notifications.SeverityMapping) error {
+	exists, err := redis.Bool(conn.Do("HEXISTS", db.SeverityMapping+":name", m.Name))
+	if err != nil {
+		return err
+	} else if exists {
+		return fmt.Errorf("%w, name=%v", db.ErrNotUnique, m.Name)
+	}
+
+	if m.Created == 0 {
+		m.Created = db.MakeTimestamp()
+		m.Modified = m.Created
+	}
+
+	if m.ID == "" {
+		m.ID = uuid.New().String()
+	}
+
+	obj, err := marshalObject(m)
+	if err != nil {
+		return err
+	}
+
+	_ = conn.Send("MULTI")
+	_ = conn.Send("SET", m.ID, obj)
+	_ = conn.Send("ZADD", db.SeverityMapping, 0, m.ID)
+	_ = conn.Send("HSET", db.SeverityMapping+":name", m.Name, m.ID)
+	_, err = conn.Do("EXEC")
+
+	return err
+}
+
+func deleteSeverityMapping(conn redis.Conn, m notifications.SeverityMapping) error {
+	_ = conn.Send("MULTI")
+	_ = conn.Send("DEL", m.ID)
+	_ = conn.Send("ZREM", db.SeverityMapping, m.ID)
+	_ = conn.Send("HDEL", db.SeverityMapping+":name", m.Name)
+	_, err := conn.Do("EXEC")
+
+	return err
+}
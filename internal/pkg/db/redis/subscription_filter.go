@@ -0,0 +1,143 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	notifications "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// ******************************* SUBSCRIPTION FILTERS **********************************
+func (c Client) GetSubscriptionFilterBySlug(slug string) (f notifications.SubscriptionFilter, err error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	err = getObjectByHash(conn, db.SubscriptionFilter+":slug", slug, unmarshalObject, &f)
+	return f, err
+}
+
+func (c Client) AddSubscriptionFilter(f notifications.SubscriptionFilter) (string, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	err := addSubscriptionFilter(conn, &f)
+	if err != nil {
+		return "", err
+	}
+	return f.ID, nil
+}
+
+func (c Client) UpdateSubscriptionFilter(f notifications.SubscriptionFilter) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	var existing notifications.SubscriptionFilter
+	err := getObjectByHash(conn, db.SubscriptionFilter+":slug", f.Subscription, unmarshalObject, &existing)
+	if err != nil {
+		return err
+	}
+
+	err = deleteSubscriptionFilter(conn, existing)
+	if err != nil {
+		return err
+	}
+
+	f.ID = existing.ID
+	f.Created = existing.Created
+	f.Modified = db.MakeTimestamp()
+	return addSubscriptionFilter(conn, &f)
+}
+
+func (c Client) DeleteSubscriptionFilterBySlug(slug string) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	var f notifications.SubscriptionFilter
+	err := getObjectByHash(conn, db.SubscriptionFilter+":slug", slug, unmarshalObject, &f)
+	if err != nil {
+		return err
+	}
+	return deleteSubscriptionFilter(conn, f)
+}
+
+func addSubscriptionFilter(conn redis.Conn, f *notifications.SubscriptionFilter) error {
+	exists, err := redis.Bool(conn.Do("HEXISTS", db.SubscriptionFilter+":slug", f.Subscription))
+	if err != nil {
+		return err
+	} else if exists {
+		return errors.Errorf("%v, subscription=%v", db.ErrNotUnique, f.Subscription)
+	}
+
+	if f.Created == 0 {
+		f.Created = db.MakeTimestamp()
+		f.Modified = f.Created
+	}
+
+	if f.ID == "" {
+		f.ID = uuid.New().String()
+	}
+
+	obj, err := marshalObject(f)
+	if err != nil {
+		return err
+	}
+
+	_ = conn.Send("MULTI")
+	_ = conn.Send("SET", f.ID, obj)
+	_ = conn.Send("ZADD", db.SubscriptionFilter, 0, f.ID)
+	_ = conn.Send("HSET", db.SubscriptionFilter+":slug", f.Subscription, f.ID)
+	_, err = conn.Do("EXEC")
+
+	return err
+}
+
+func deleteSubscriptionFilter(conn redis.Conn, f notifications.SubscriptionFilter) error {
+	_ = conn.Send("MULTI")
+	_ = conn.Send("DEL", f.ID)
+	_ = conn.Send("ZREM", db.SubscriptionFilter, f.ID)
+	_ = conn.Send("HDEL", db.SubscriptionFilter+":slug", f.Subscription)
+	_, err := conn.Do("EXEC")
+
+	return err
+}
+
+// moveSubscriptionFilter moves the filter of a subscription whose slug changed to its new slug, if it had one.
+func moveSubscriptionFilter(conn redis.Conn, from string, to string) error {
+	var f notifications.SubscriptionFilter
+	err := getObjectByHash(conn, db.SubscriptionFilter+":slug", from, unmarshalObject, &f)
+	if err != nil {
+		if err == db.ErrNotFound {
+			return nil
+		}
+		return err
+	}
+
+	err = deleteSubscriptionFilter(conn, f)
+	if err != nil {
+		return err
+	}
+
+	f.Subscription = to
+	f.Modified = db.MakeTimestamp()
+	return addSubscriptionFilter(conn, &f)
+}
+
+// deleteSubscriptionFilterBySlug removes the filter of a deleted subscription, if it had one.
+func deleteSubscriptionFilterBySlug(conn redis.Conn, slug string) error {
+	var f notifications.SubscriptionFilter
+	err := getObjectByHash(conn, db.SubscriptionFilter+":slug", slug, unmarshalObject, &f)
+	if err != nil {
+		if err == db.ErrNotFound {
+			return nil
+		}
+		return err
+	}
+	return deleteSubscriptionFilter(conn, f)
+}
@@ -0,0 +1,175 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	notifications "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// ******************************* TEMPLATES **********************************
+func (c Client) GetTemplates() ([]notifications.Template, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	objects, err := getObjectsByRange(conn, db.Template, 0, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make([]notifications.Template, len(objects))
+	for i, object := range objects {
+		err = unmarshalObject(object, &templates[i])
+		if err != nil {
+			return []notifications.Template{}, err
+		}
+	}
+	return templates, nil
+}
+
+func (c Client) GetTemplateByName(name string) (t notifications.Template, err error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	err = getObjectByHash(conn, db.Template+":name", name, unmarshalObject, &t)
+	return t, err
+}
+
+func (c Client) AddTemplate(t notifications.Template) (string, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	err := addTemplate(conn, &t)
+	if err != nil {
+		return "", err
+	}
+	return t.ID, nil
+}
+
+func (c Client) UpdateTemplate(t notifications.Template) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	var existing notifications.Template
+	err := getObjectByHash(conn, db.Template+":name", t.Name, unmarshalObject, &existing)
+	if err != nil {
+		return err
+	}
+
+	err = deleteTemplate(conn, existing)
+	if err != nil {
+		return err
+	}
+
+	t.ID = existing.ID
+	t.Created = existing.Created
+	t.Modified = db.MakeTimestamp()
+	return addTemplate(conn, &t)
+}
+
+// DeleteTemplateByName deletes the template unless subscriptions still reference it.
+func (c Client) DeleteTemplateByName(name string) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	var t notifications.Template
+	err := getObjectByHash(conn, db.Template+":name", name, unmarshalObject, &t)
+	if err != nil {
+		return err
+	}
+
+	references, err := redis.Strings(conn.Do("HVALS", db.SubscriptionTemplate))
+	if err != nil {
+		return err
+	}
+	for _, reference := range references {
+		if reference == name {
+			return db.ErrTemplateStillInUse
+		}
+	}
+
+	return deleteTemplate(conn, t)
+}
+
+// GetSubscriptionTemplate returns the name of the template referenced by the subscription.
+func (c Client) GetSubscriptionTemplate(slug string) (string, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	name, err := redis.String(conn.Do("HGET", db.SubscriptionTemplate, slug))
+	if err == redis.ErrNil {
+		return "", db.ErrNotFound
+	}
+	return name, err
+}
+
+// SetSubscriptionTemplate makes the subscription reference the named template, replacing the template it referenced.
+func (c Client) SetSubscriptionTemplate(slug string, template string) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	_, err := conn.Do("HSET", db.SubscriptionTemplate, slug, template)
+	return err
+}
+
+func (c Client) DeleteSubscriptionTemplate(slug string) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	deleted, err := redis.Int(conn.Do("HDEL", db.SubscriptionTemplate, slug))
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return db.ErrNotFound
+	}
+	return nil
+}
+
+func addTemplate(conn redis.Conn, t *notifications.Template) error {
+	exists, err := redis.Bool(conn.Do("HEXISTS", db.Template+":name", t.Name))
+	if err != nil {
+		return err
+	} else if exists {
+		return errors.Errorf("%v, name=%v", db.ErrNotUnique, t.Name)
+	}
+
+	if t.Created == 0 {
+		t.Created = db.MakeTimestamp()
+		t.Modified = t.Created
+	}
+
+	if t.ID == "" {
+		t.ID = uuid.New().String()
+	}
+
+	obj, err := marshalObject(t)
+	if err != nil {
+		return err
+	}
+
+	_ = conn.Send("MULTI")
+	_ = conn.Send("SET", t.ID, obj)
+	_ = conn.Send("ZADD", db.Template, 0, t.ID)
+	_ = conn.Send("HSET", db.Template+":name", t.Name, t.ID)
+	_, err = conn.Do("EXEC")
+
+	return err
+}
+
+func deleteTemplate(conn redis.Conn, t notifications.Template) error {
+	_ = conn.Send("MULTI")
+	_ = conn.Send("DEL", t.ID)
+	_ = conn.Send("ZREM", db.Template, t.ID)
+	_ = conn.Send("HDEL", db.Template+":name", t.Name)
+	_, err := conn.Do("EXEC")
+
+	return err
+}
@@ -0,0 +1,60 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+	"github.com/gomodule/redigo/redis"
+)
+
+// ******************************* TRANSMISSION HISTORIES **********************************
+
+// AddTransmissionHistoryRecord appends the record to the full history of the transmission, kept aside from the
+// transmission so that its records can be pruned. The history is deleted along with the transmission.
+func (c Client) AddTransmissionHistoryRecord(id string, r contract.TransmissionRecord) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	obj, err := marshalObject(r)
+	if err != nil {
+		return err
+	}
+	_, err = conn.Do("RPUSH", transmissionHistoryKey(id), obj)
+	return err
+}
+
+// GetTransmissionHistory returns the full history of the transmission, the oldest record first, empty when none was
+// stored.
+func (c Client) GetTransmissionHistory(id string) ([]contract.TransmissionRecord, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	objects, err := redis.ByteSlices(conn.Do("LRANGE", transmissionHistoryKey(id), 0, -1))
+	if err != nil && err != redis.ErrNil {
+		return nil, err
+	}
+
+	records := make([]contract.TransmissionRecord, len(objects))
+	for i, object := range objects {
+		if err = unmarshalObject(object, &records[i]); err != nil {
+			return []contract.TransmissionRecord{}, err
+		}
+	}
+	return records, nil
+}
+
+// sendDeleteTransmissionHistory queues the deletion of the history of the transmission in the current transaction. The
+// history isn't deleted along with the delivery receipt by sendDeleteTransmission since the transmissions are updated
+// by replacing them.
+func sendDeleteTransmissionHistory(conn redis.Conn, id string) {
+	_ = conn.Send("DEL", transmissionHistoryKey(id))
+}
+
+func transmissionHistoryKey(transmission string) string {
+	return db.TransmissionHistory + ":transmission:" + transmission
+}
@@ -0,0 +1,109 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package dependency measures the round-trip latencies to the dependencies of a service, such as its database, the
+// registry, the secret store and the message bus, for the ping to reveal which of them is slow or down.
+package dependency
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Probe measures the round trip to a dependency, failing when the dependency is unavailable.
+type Probe struct {
+	Name  string
+	Check func(ctx context.Context) error
+}
+
+// Result is the outcome of the last check of a dependency.
+type Result struct {
+	Name string `json:"name"`
+	// LatencyMs is the round-trip latency in milliseconds, up to the timeout when the check timed out
+	LatencyMs float64 `json:"latencyMs"`
+	Healthy   bool    `json:"healthy"`
+	Error     string  `json:"error,omitempty"`
+}
+
+// Checker checks the dependencies of the service periodically, and keeps the results of the last check.
+type Checker struct {
+	probes  []Probe
+	timeout time.Duration
+
+	mutex   sync.RWMutex
+	checked int64
+	results []Result
+}
+
+// NewChecker creates a Checker bounding each check of the probes by timeout.
+func NewChecker(probes []Probe, timeout time.Duration) *Checker {
+	return &Checker{
+		probes:  probes,
+		timeout: timeout,
+		results: []Result{},
+	}
+}
+
+// Check checks the dependencies concurrently, for the latency of each not to depend on the others.
+func (c *Checker) Check(ctx context.Context) {
+	results := make([]Result, len(c.probes))
+	wg := sync.WaitGroup{}
+	for i, probe := range c.probes {
+		wg.Add(1)
+		go func(i int, probe Probe) {
+			defer wg.Done()
+
+			ctx, cancel := context.WithTimeout(ctx, c.timeout)
+			defer cancel()
+			started := time.Now()
+			err := probe.Check(ctx)
+			results[i] = Result{
+				Name:      probe.Name,
+				LatencyMs: float64(time.Since(started).Microseconds()) / 1000,
+				Healthy:   err == nil,
+			}
+			if err != nil {
+				results[i].Error = err.Error()
+			}
+		}(i, probe)
+	}
+	wg.Wait()
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.checked = time.Now().UnixNano() / int64(time.Millisecond)
+	c.results = results
+}
+
+// Start checks the dependencies right away, then every interval until ctx is done.
+func (c *Checker) Start(ctx context.Context, wg *sync.WaitGroup, interval time.Duration) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			c.Check(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Results returns when the dependencies were last checked, in milliseconds, and the results of that check, none when
+// the checker is nil or the dependencies haven't been checked yet.
+func (c *Checker) Results() (int64, []Result) {
+	if c == nil {
+		return 0, []Result{}
+	}
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.checked, c.results
+}
@@ -0,0 +1,133 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dependency
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type pingerStub func() error
+
+func (p pingerStub) Ping() error {
+	return p()
+}
+
+func TestCheck(t *testing.T) {
+	blocked := make(chan struct{})
+	defer close(blocked)
+
+	checker := NewChecker([]Probe{
+		DatabaseProbe("database", pingerStub(func() error { return nil })),
+		DatabaseProbe("down", pingerStub(func() error { return errors.New("connection refused") })),
+		DatabaseProbe("slow", pingerStub(func() error {
+			<-blocked
+			return nil
+		})),
+	}, 50*time.Millisecond)
+
+	checked, results := checker.Results()
+	assert.Zero(t, checked)
+	assert.Empty(t, results)
+
+	checker.Check(context.Background())
+	checked, results = checker.Results()
+	assert.NotZero(t, checked)
+	require.Len(t, results, 3)
+
+	assert.Equal(t, "database", results[0].Name)
+	assert.True(t, results[0].Healthy)
+	assert.Empty(t, results[0].Error)
+
+	assert.False(t, results[1].Healthy)
+	assert.Equal(t, "connection refused", results[1].Error)
+
+	assert.False(t, results[2].Healthy, "the probe past the timeout should be reported down")
+	assert.Equal(t, context.DeadlineExceeded.Error(), results[2].Error)
+	assert.GreaterOrEqual(t, results[2].LatencyMs, float64(50))
+}
+
+func TestResultsWithoutChecker(t *testing.T) {
+	var checker *Checker
+	checked, results := checker.Results()
+	assert.Zero(t, checked)
+	assert.Empty(t, results)
+}
+
+func TestDialProbe(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+
+	assert.NoError(t, DialProbe("messagebus", address).Check(context.Background()))
+	require.NoError(t, listener.Close())
+	assert.Error(t, DialProbe("messagebus", address).Check(context.Background()))
+}
+
+func TestSecretStoreProbe(t *testing.T) {
+	status := int32(http.StatusOK)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, secretStoreHealthPath, r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get("standbyok"))
+		w.WriteHeader(int(atomic.LoadInt32(&status)))
+	}))
+	defer server.Close()
+
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(serverURL.Port())
+	require.NoError(t, err)
+	probe, err := SecretStoreProbe(bootstrapConfig.SecretStoreInfo{Protocol: "http", Host: serverURL.Hostname(), Port: port})
+	require.NoError(t, err)
+
+	assert.NoError(t, probe.Check(context.Background()))
+	atomic.StoreInt32(&status, http.StatusServiceUnavailable)
+	assert.Error(t, probe.Check(context.Background()), "a sealed secret store should be unhealthy")
+
+	_, err = SecretStoreProbe(bootstrapConfig.SecretStoreInfo{RootCaCertPath: "/nonexistent/ca.pem"})
+	assert.Error(t, err)
+}
+
+func TestPing(t *testing.T) {
+	checker := NewChecker([]Probe{DatabaseProbe("database", pingerStub(func() error { return nil }))}, time.Second)
+	checker.Check(context.Background())
+
+	w := httptest.NewRecorder()
+	Ping(w, httptest.NewRequest(http.MethodGet, "/api/v1/ping", nil), checker, logger.NewMockClient())
+	assert.Equal(t, "pong", w.Body.String())
+
+	w = httptest.NewRecorder()
+	Ping(w, httptest.NewRequest(http.MethodGet, "/api/v1/ping?dependencies=true", nil), checker, logger.NewMockClient())
+	var response PingResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "pong", response.Ping)
+	assert.NotZero(t, response.Checked)
+	require.Len(t, response.Dependencies, 1)
+	assert.Equal(t, "database", response.Dependencies[0].Name)
+
+	w = httptest.NewRecorder()
+	Ping(w, httptest.NewRequest(http.MethodGet, "/api/v1/ping?dependencies=true", nil), nil, logger.NewMockClient())
+	response = PingResponse{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Zero(t, response.Checked, "the dependencies should be reported unchecked when the check is disabled")
+	assert.Empty(t, response.Dependencies)
+}
@@ -0,0 +1,17 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dependency
+
+// DependencyCheckInfo provides properties related to measuring the round-trip latencies to the dependencies of the
+// service, reported by the ping
+type DependencyCheckInfo struct {
+	// Enabled indicates whether the dependencies are checked
+	Enabled bool
+	// Interval between the checks of the dependencies, e.g. '30s'
+	Interval string
+	// Timeout of the check of a dependency, after which it's reported as down, e.g. '5s'
+	Timeout string
+}
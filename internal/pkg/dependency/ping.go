@@ -0,0 +1,40 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dependency
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+)
+
+// DependenciesParam requests the latencies of the dependencies along with the ping
+const DependenciesParam = "dependencies"
+
+// PingResponse is the ping answered when the dependencies are requested.
+type PingResponse struct {
+	Ping string `json:"ping"`
+	// Checked is when the dependencies were last checked, in milliseconds, 0 when they aren't checked
+	Checked      int64    `json:"checked"`
+	Dependencies []Result `json:"dependencies"`
+}
+
+// Ping answers "pong", or, when requested with ?dependencies=true, the results of the last check of the dependencies
+// of the service by checker, without checking them again for the ping to stay cheap.
+func Ping(w http.ResponseWriter, r *http.Request, checker *Checker, lc logger.LoggingClient) {
+	if requested, _ := strconv.ParseBool(r.URL.Query().Get(DependenciesParam)); !requested {
+		w.Header().Set(clients.ContentType, clients.ContentTypeText)
+		_, _ = w.Write([]byte("pong"))
+		return
+	}
+
+	checked, results := checker.Results()
+	pkg.Encode(PingResponse{Ping: "pong", Checked: checked, Dependencies: results}, w, lc)
+}
@@ -0,0 +1,121 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dependency
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+
+	"github.com/edgexfoundry/go-mod-registry/registry"
+)
+
+// secretStoreHealthPath answers the health of Vault without authentication
+const secretStoreHealthPath = "/v1/sys/health"
+
+// Pinger is implemented by the database clients able to ping their database, as the Redis one is.
+type Pinger interface {
+	Ping() error
+}
+
+// withContext runs check until ctx is done, for the checks unaware of ctx to be bounded by its timeout as well.
+func withContext(ctx context.Context, check func() error) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- check()
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// DatabaseProbe pings the database.
+func DatabaseProbe(name string, pinger Pinger) Probe {
+	return Probe{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			return withContext(ctx, pinger.Ping)
+		},
+	}
+}
+
+// RegistryProbe checks that the registry is alive.
+func RegistryProbe(client registry.Client) Probe {
+	return Probe{
+		Name: "registry",
+		Check: func(ctx context.Context) error {
+			return withContext(ctx, func() error {
+				if !client.IsAlive() {
+					return errors.New("registry not alive")
+				}
+				return nil
+			})
+		},
+	}
+}
+
+// SecretStoreProbe reads the health of the secret store, trusting its root CA certificate when configured. A standby
+// secret store is healthy.
+func SecretStoreProbe(info bootstrapConfig.SecretStoreInfo) (Probe, error) {
+	client := &http.Client{}
+	if info.RootCaCertPath != "" {
+		certificate, err := ioutil.ReadFile(info.RootCaCertPath)
+		if err != nil {
+			return Probe{}, fmt.Errorf("failed to read the secret store CA certificate: %s", err.Error())
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(certificate) {
+			return Probe{}, fmt.Errorf("no certificate in the secret store CA certificate %s", info.RootCaCertPath)
+		}
+		client.Transport = &http.Transport{
+			TLSClientConfig: &tls.Config{RootCAs: pool, ServerName: info.ServerName},
+		}
+	}
+	url := fmt.Sprintf("%s://%s:%d%s?standbyok=true&perfstandbyok=true", info.Protocol, info.Host, info.Port, secretStoreHealthPath)
+
+	return Probe{
+		Name: "secretstore",
+		Check: func(ctx context.Context) error {
+			request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+			if err != nil {
+				return err
+			}
+			response, err := client.Do(request)
+			if err != nil {
+				return err
+			}
+			_ = response.Body.Close()
+			if response.StatusCode != http.StatusOK {
+				return fmt.Errorf("secret store unhealthy, status %d", response.StatusCode)
+			}
+			return nil
+		},
+	}, nil
+}
+
+// DialProbe connects to the address over TCP, e.g. to the broker of the message bus.
+func DialProbe(name string, address string) Probe {
+	return Probe{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", address)
+			if err != nil {
+				return err
+			}
+			return conn.Close()
+		},
+	}
+}
@@ -14,7 +14,9 @@
 package pkg
 
 import (
+	"crypto/sha256"
 	"encoding/json"
+	"fmt"
 	"net/http"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/clients"
@@ -33,3 +35,27 @@ func Encode(i interface{}, w http.ResponseWriter, LoggingClient logger.LoggingCl
 		return
 	}
 }
+
+// EncodeCanonical writes i as indented JSON, in a stable form so that two documents describing the same state are
+// identical and their differences show up line by line. The ETag of the response is the digest of the document, so
+// that clients polling it get a 304 Not Modified while the state doesn't change.
+func EncodeCanonical(i interface{}, w http.ResponseWriter, r *http.Request, LoggingClient logger.LoggingClient) {
+	data, err := json.MarshalIndent(i, "", "  ")
+	if err != nil {
+		LoggingClient.Error("Error encoding the data: " + err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	data = append(data, '\n')
+
+	etag := fmt.Sprintf("\"%x\"", sha256.Sum256(data))
+	w.Header().Set("ETag", etag)
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+	if _, err = w.Write(data); err != nil {
+		LoggingClient.Error("Error writing the data: " + err.Error())
+	}
+}
@@ -25,6 +25,11 @@ var Command commandErrorConcept
 // ValueDescriptorsErrorConcept represents the accessor for the value-descriptor-specific error concepts
 type commandErrorConcept struct {
 	NotAssociatedWithDevice commandNotAssociatedWithDevice
+	InvalidParameters       commandInvalidParameters
+	DeviceBusy              commandDeviceBusy
+	ServiceUnavailable      commandServiceUnavailable
+	BadRequest              commandBadRequest
+	ResponseTooLarge        commandResponseTooLarge
 }
 
 type commandNotAssociatedWithDevice struct{}
@@ -41,3 +46,78 @@ func (r commandNotAssociatedWithDevice) isA(err error) bool {
 func (r commandNotAssociatedWithDevice) message(err error) string {
 	return err.Error()
 }
+
+type commandInvalidParameters struct{}
+
+func (r commandInvalidParameters) httpErrorCode() int {
+	return http.StatusBadRequest
+}
+
+func (r commandInvalidParameters) isA(err error) bool {
+	_, ok := err.(errors.ErrInvalidCommandParameters)
+	return ok
+}
+
+func (r commandInvalidParameters) message(err error) string {
+	return err.Error()
+}
+
+type commandDeviceBusy struct{}
+
+func (r commandDeviceBusy) httpErrorCode() int {
+	return http.StatusTooManyRequests
+}
+
+func (r commandDeviceBusy) isA(err error) bool {
+	_, ok := err.(errors.ErrDeviceBusy)
+	return ok
+}
+
+func (r commandDeviceBusy) message(err error) string {
+	return err.Error()
+}
+
+type commandServiceUnavailable struct{}
+
+func (r commandServiceUnavailable) httpErrorCode() int {
+	return http.StatusServiceUnavailable
+}
+
+func (r commandServiceUnavailable) isA(err error) bool {
+	_, ok := err.(errors.ErrServiceUnavailable)
+	return ok
+}
+
+func (r commandServiceUnavailable) message(err error) string {
+	return err.Error()
+}
+
+type commandBadRequest struct{}
+
+func (r commandBadRequest) httpErrorCode() int {
+	return http.StatusBadRequest
+}
+
+func (r commandBadRequest) isA(err error) bool {
+	_, ok := err.(errors.ErrBadRequest)
+	return ok
+}
+
+func (r commandBadRequest) message(err error) string {
+	return err.Error()
+}
+
+type commandResponseTooLarge struct{}
+
+func (r commandResponseTooLarge) httpErrorCode() int {
+	return http.StatusBadGateway
+}
+
+func (r commandResponseTooLarge) isA(err error) bool {
+	_, ok := err.(errors.ErrResponseTooLarge)
+	return ok
+}
+
+func (r commandResponseTooLarge) message(err error) string {
+	return err.Error()
+}
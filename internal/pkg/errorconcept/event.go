@@ -23,7 +23,11 @@ var Events eventErrorConcept
 
 // eventErrorConcept represents the accessor for the event-specific error concepts
 type eventErrorConcept struct {
-	NotFound eventNotFound
+	NotFound     eventNotFound
+	Rejected     eventRejected
+	TooLarge     eventTooLarge
+	RateExceeded eventRateExceeded
+	NotPublished eventNotPublished
 }
 
 type eventNotFound struct{}
@@ -40,3 +44,63 @@ func (r eventNotFound) isA(err error) bool {
 func (r eventNotFound) message(err error) string {
 	return err.Error()
 }
+
+type eventRejected struct{}
+
+func (r eventRejected) httpErrorCode() int {
+	return http.StatusBadRequest
+}
+
+func (r eventRejected) isA(err error) bool {
+	_, ok := err.(errors.ErrEventRejected)
+	return ok
+}
+
+func (r eventRejected) message(err error) string {
+	return err.Error()
+}
+
+type eventTooLarge struct{}
+
+func (r eventTooLarge) httpErrorCode() int {
+	return http.StatusRequestEntityTooLarge
+}
+
+func (r eventTooLarge) isA(err error) bool {
+	_, ok := err.(errors.ErrEventTooLarge)
+	return ok
+}
+
+func (r eventTooLarge) message(err error) string {
+	return err.Error()
+}
+
+type eventRateExceeded struct{}
+
+func (r eventRateExceeded) httpErrorCode() int {
+	return http.StatusTooManyRequests
+}
+
+func (r eventRateExceeded) isA(err error) bool {
+	_, ok := err.(errors.ErrEventRateExceeded)
+	return ok
+}
+
+func (r eventRateExceeded) message(err error) string {
+	return err.Error()
+}
+
+type eventNotPublished struct{}
+
+func (r eventNotPublished) httpErrorCode() int {
+	return http.StatusServiceUnavailable
+}
+
+func (r eventNotPublished) isA(err error) bool {
+	_, ok := err.(errors.ErrEventNotPublished)
+	return ok
+}
+
+func (r eventNotPublished) message(err error) string {
+	return err.Error()
+}
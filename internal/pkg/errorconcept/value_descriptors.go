@@ -24,13 +24,14 @@ var ValueDescriptors valueDescriptorsErrorConcept
 
 // ValueDescriptorsErrorConcept represents the accessor for the value-descriptor-specific error concepts
 type valueDescriptorsErrorConcept struct {
-	DuplicateName valueDescriptorDuplicateName
-	SingleInUse   valueDescriptorInUse
-	MultipleInUse valueDescriptorsInUse
-	Invalid       valueDescriptorInvalid
-	LimitExceeded valueDescriptorLimitExceeded
-	NotFound      valueDescriptorNotFound
-	NotFoundInDB  valueDescriptorDBNotFound
+	DuplicateName    valueDescriptorDuplicateName
+	SingleInUse      valueDescriptorInUse
+	MultipleInUse    valueDescriptorsInUse
+	Invalid          valueDescriptorInvalid
+	LimitExceeded    valueDescriptorLimitExceeded
+	NotFound         valueDescriptorNotFound
+	NotFoundInDB     valueDescriptorDBNotFound
+	UnsupportedUnits unsupportedUnits
 }
 
 type valueDescriptorDuplicateName struct{}
@@ -137,3 +138,18 @@ func (r valueDescriptorDBNotFound) isA(err error) bool {
 func (r valueDescriptorDBNotFound) message(err error) string {
 	return "Value descriptor not found for reading"
 }
+
+type unsupportedUnits struct{}
+
+func (r unsupportedUnits) httpErrorCode() int {
+	return http.StatusBadRequest
+}
+
+func (r unsupportedUnits) isA(err error) bool {
+	_, ok := err.(errors.ErrUnsupportedUnits)
+	return ok
+}
+
+func (r unsupportedUnits) message(err error) string {
+	return err.Error()
+}
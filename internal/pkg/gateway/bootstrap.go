@@ -0,0 +1,68 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/interfaces"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// Bootstrap contains references to dependencies required by the BootstrapHandler.
+type Bootstrap struct {
+	serviceKey    string
+	configuration interfaces.Configuration
+	info          *GatewayInfo
+}
+
+// NewBootstrap is a factory method that returns an initialized Bootstrap receiver struct.
+func NewBootstrap(serviceKey string, configuration interfaces.Configuration, info *GatewayInfo) *Bootstrap {
+	return &Bootstrap{
+		serviceKey:    serviceKey,
+		configuration: configuration,
+		info:          info,
+	}
+}
+
+// BootstrapHandler fulfills the BootstrapHandler contract. When enabled, it publishes the route manifest of the
+// service, locating it by the Service of its configuration.
+func (b *Bootstrap) BootstrapHandler(_ context.Context, _ *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
+	if !b.info.Enabled {
+		return true
+	}
+
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	manifest := b.manifest()
+	if err := WriteManifest(b.info.ManifestDir, manifest); err != nil {
+		lc.Error(fmt.Sprintf("failed to publish the route manifest: %s", err.Error()))
+		return false
+	}
+
+	lc.Info(fmt.Sprintf("Published the route manifest of %s for the paths %v to %s", manifest.Name, manifest.Paths,
+		b.info.ManifestDir))
+	return true
+}
+
+func (b *Bootstrap) manifest() Manifest {
+	service := b.configuration.GetBootstrap().Service
+	paths := b.info.Paths
+	if len(paths) == 0 {
+		paths = []string{"/" + b.info.Name}
+	}
+	return Manifest{
+		Service:  b.serviceKey,
+		Name:     b.info.Name,
+		Protocol: service.Protocol,
+		Host:     service.Host,
+		Port:     service.Port,
+		Paths:    paths,
+	}
+}
@@ -0,0 +1,19 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gateway
+
+// GatewayInfo provides properties related to publishing the route manifest of the service, from which
+// security-proxy-setup configures the routes of the API gateway to the service.
+type GatewayInfo struct {
+	// Enabled indicates whether the route manifest is published at startup
+	Enabled bool
+	// ManifestDir is the directory, shared with security-proxy-setup, the manifest is written to
+	ManifestDir string
+	// Name is the name of the gateway service and route, e.g. 'coredata'
+	Name string
+	// Paths are the paths routed to the service, stripped before the requests are forwarded; '/<Name>' when empty
+	Paths []string
+}
@@ -0,0 +1,120 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package gateway publishes the route manifests of the services, describing how the API gateway routes requests to
+// them, and reads them back for security-proxy-setup to configure the gateway without knowing the services upfront.
+package gateway
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// manifestExtension is the extension of the manifest files
+const manifestExtension = ".json"
+
+// Manifest describes the routes of the API gateway to a service.
+type Manifest struct {
+	// Service is the key of the service publishing the manifest, e.g. 'edgex-core-data'
+	Service  string   `json:"service"`
+	Name     string   `json:"name"`
+	Protocol string   `json:"protocol"`
+	Host     string   `json:"host"`
+	Port     int      `json:"port"`
+	Paths    []string `json:"paths"`
+}
+
+// Validate checks that the manifest names the service and its routes, and locates the service.
+func (m Manifest) Validate() error {
+	switch {
+	case m.Name == "" || strings.ContainsAny(m.Name, "/ "):
+		return fmt.Errorf("invalid route name '%s'", m.Name)
+	case m.Host == "" || m.Port <= 0:
+		return fmt.Errorf("no host and port for route %s", m.Name)
+	case len(m.Paths) == 0:
+		return fmt.Errorf("no path for route %s", m.Name)
+	}
+	for _, path := range m.Paths {
+		if !strings.HasPrefix(path, "/") {
+			return fmt.Errorf("path '%s' of route %s should start with /", path, m.Name)
+		}
+	}
+	return nil
+}
+
+// WriteManifest writes the manifest to dir, named after its service, replacing the one published before in a single
+// step so that it's never read partially written.
+func WriteManifest(dir string, manifest Manifest) error {
+	if manifest.Service == "" {
+		return errors.New("no service for the route manifest")
+	}
+	if err := manifest.Validate(); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	file, err := ioutil.TempFile(dir, "."+manifest.Service)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	if _, err = file.Write(data); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err = file.Close(); err != nil {
+		return err
+	}
+	if err = os.Chmod(file.Name(), 0644); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), filepath.Join(dir, manifest.Service+manifestExtension))
+}
+
+// ReadManifests reads the manifests published to dir, sorted by name. A missing dir holds no manifest.
+func ReadManifests(dir string) ([]Manifest, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var manifests []Manifest
+	for _, file := range files {
+		if file.IsDir() || strings.HasPrefix(file.Name(), ".") || filepath.Ext(file.Name()) != manifestExtension {
+			continue
+		}
+		data, err := ioutil.ReadFile(filepath.Join(dir, file.Name()))
+		if err != nil {
+			return nil, err
+		}
+		var manifest Manifest
+		if err = json.Unmarshal(data, &manifest); err != nil {
+			return nil, fmt.Errorf("malformed route manifest %s: %s", file.Name(), err.Error())
+		}
+		if err = manifest.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid route manifest %s: %s", file.Name(), err.Error())
+		}
+		manifests = append(manifests, manifest)
+	}
+	sort.Slice(manifests, func(i, j int) bool {
+		return manifests[i].Name < manifests[j].Name
+	})
+	return manifests, nil
+}
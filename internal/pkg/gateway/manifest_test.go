@@ -0,0 +1,77 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gateway
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteAndReadManifests(t *testing.T) {
+	dir, err := ioutil.TempDir("", "routes")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	manifests, err := ReadManifests(filepath.Join(dir, "missing"))
+	require.NoError(t, err)
+	assert.Empty(t, manifests)
+
+	notifications := Manifest{Service: "edgex-support-notifications", Name: "notifications", Protocol: "http",
+		Host: "edgex-support-notifications", Port: 48060, Paths: []string{"/notifications"}}
+	data := Manifest{Service: "edgex-core-data", Name: "coredata", Protocol: "http", Host: "edgex-core-data",
+		Port: 48080, Paths: []string{"/coredata", "/core-data"}}
+	require.NoError(t, WriteManifest(dir, notifications))
+	require.NoError(t, WriteManifest(dir, data))
+	data.Port = 48081
+	require.NoError(t, WriteManifest(dir, data), "the manifest should be replaced when published again")
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, ".edgex-core-command123"), []byte("{"), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "README"), []byte("routes"), 0644))
+
+	manifests, err = ReadManifests(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []Manifest{data, notifications}, manifests)
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "edgex-broken.json"), []byte("{"), 0644))
+	_, err = ReadManifests(dir)
+	assert.Error(t, err)
+}
+
+func TestWriteManifestValidation(t *testing.T) {
+	valid := Manifest{Service: "edgex-core-data", Name: "coredata", Protocol: "http", Host: "localhost", Port: 48080,
+		Paths: []string{"/coredata"}}
+
+	tests := []struct {
+		name   string
+		modify func(m *Manifest)
+	}{
+		{"no service", func(m *Manifest) { m.Service = "" }},
+		{"no name", func(m *Manifest) { m.Name = "" }},
+		{"name with slash", func(m *Manifest) { m.Name = "core/data" }},
+		{"no host", func(m *Manifest) { m.Host = "" }},
+		{"no port", func(m *Manifest) { m.Port = 0 }},
+		{"no path", func(m *Manifest) { m.Paths = nil }},
+		{"relative path", func(m *Manifest) { m.Paths = []string{"coredata"} }},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "routes")
+			require.NoError(t, err)
+			defer os.RemoveAll(dir)
+
+			manifest := valid
+			testCase.modify(&manifest)
+			assert.Error(t, WriteManifest(dir, manifest))
+			files, err := ioutil.ReadDir(dir)
+			require.NoError(t, err)
+			assert.Empty(t, files)
+		})
+	}
+}
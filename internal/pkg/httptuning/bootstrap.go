@@ -0,0 +1,106 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package httptuning
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/mtls"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+
+	"github.com/gorilla/mux"
+)
+
+// defaultServer defines the contract of the default HTTP server bootstrap handler.
+type defaultServer interface {
+	BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, startupTimer startup.Timer, dic *di.Container) bool
+	IsRunning() bool
+}
+
+// Bootstrap contains references to dependencies required by the BootstrapHandler.
+type Bootstrap struct {
+	router   *mux.Router
+	service  *bootstrapConfig.ServiceInfo
+	info     *HttpTuningInfo
+	fallback defaultServer
+	mutex    sync.RWMutex
+	server   *Server
+}
+
+// NewBootstrap is a factory method that returns an initialized Bootstrap receiver struct.
+func NewBootstrap(
+	router *mux.Router,
+	service *bootstrapConfig.ServiceInfo,
+	info *HttpTuningInfo,
+	fallback defaultServer) *Bootstrap {
+
+	return &Bootstrap{
+		router:   router,
+		service:  service,
+		info:     info,
+		fallback: fallback,
+	}
+}
+
+// BootstrapHandler fulfills the BootstrapHandler contract, in place of the one of the default HTTP server. When the
+// tuning is enabled, the router is served on the TCP port of the service with its settings. When mutual TLS is enabled,
+// the router is served over HTTPS with the certificate of the service, with the default settings unless tuned.
+func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, startupTimer startup.Timer, dic *di.Container) bool {
+	certificates := mtls.CertificatesFrom(dic.Get)
+	if !b.info.Enabled && certificates == nil {
+		return b.fallback.BootstrapHandler(ctx, wg, startupTimer, dic)
+	}
+	info := *b.info
+	if !info.Enabled {
+		info = HttpTuningInfo{KeepAlive: true}
+	}
+
+	lc := container.LoggingClientFrom(dic.Get)
+	host := b.service.ServerBindAddr
+	if host == "" {
+		host = b.service.Host
+	}
+	addr := host + ":" + strconv.Itoa(b.service.Port)
+	secure := os.Getenv("EDGEX_SECURITY_SECRET_STORE") != "false" || certificates != nil
+	if info.HTTP2 && secure {
+		lc.Info("Not serving HTTP/2 in cleartext in secure mode")
+	}
+
+	server := NewServer(addr, time.Duration(b.service.Timeout)*time.Millisecond, info, secure, b.router, lc)
+	scheme := "http"
+	if certificates != nil {
+		server.WithTLS(certificates.ServerConfig())
+		scheme = "https"
+	}
+	if err := server.Start(ctx, wg); err != nil {
+		lc.Error(fmt.Sprintf("unable to serve on %s: %v", addr, err))
+		return false
+	}
+	b.mutex.Lock()
+	b.server = server
+	b.mutex.Unlock()
+	lc.Info("Web server starting (" + scheme + "://" + addr + ")")
+	return true
+}
+
+// IsRunning reports whether the API is served on the TCP port.
+func (b *Bootstrap) IsRunning() bool {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+	if b.server != nil {
+		return b.server.IsRunning()
+	}
+	return b.fallback.IsRunning()
+}
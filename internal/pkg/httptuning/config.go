@@ -0,0 +1,27 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package httptuning
+
+// HttpTuningInfo provides properties related to serving the HTTP API on the TCP port with HTTP/2 and keep-alive
+// settings tuned for the clients sending many requests per second, such as the device services posting events
+type HttpTuningInfo struct {
+	// Enabled indicates whether the API is served with the settings below, in place of the default HTTP server
+	Enabled bool
+	// HTTP2 indicates whether HTTP/2 is served in cleartext (h2c) alongside HTTP/1.1; it's only served in non-secure
+	// mode, where the clients reach the service directly rather than through the API gateway
+	HTTP2 bool
+	// MaxConcurrentStreams is the number of requests an HTTP/2 client may have in flight on a connection, 250 when 0
+	MaxConcurrentStreams uint32
+	// KeepAlive indicates whether the connections are kept open between requests
+	KeepAlive bool
+	// IdleTimeout is how long an idle connection is kept open, e.g. '120s'; the service timeout when empty
+	IdleTimeout string
+	// TcpKeepAlive is the period of the TCP keep-alive probes of the connections, e.g. '30s'; '0s' disables them
+	TcpKeepAlive string
+	// MaxRequestsPerConnection is the number of HTTP/1.1 requests served on a connection before it's closed, so that
+	// the clients get spread again on the instances behind a load balancer; unlimited when 0
+	MaxRequestsPerConnection int
+}
@@ -0,0 +1,189 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package httptuning
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+)
+
+// defaultMaxConcurrentStreams is the number of requests an HTTP/2 client may have in flight on a connection, when
+// none is configured.
+const defaultMaxConcurrentStreams = 250
+
+// Server serves an HTTP handler on a TCP address with the HTTP/2 and keep-alive settings of its info.
+type Server struct {
+	addr     string
+	timeout  time.Duration
+	info     HttpTuningInfo
+	secure   bool
+	handler  http.Handler
+	lc       logger.LoggingClient
+	tls      *tls.Config
+	mutex    sync.RWMutex
+	running  bool
+	listener net.Listener
+}
+
+// NewServer returns a server of the handler on the address, whose requests time out after the timeout. HTTP/2 is
+// never served in cleartext when secure.
+func NewServer(
+	addr string,
+	timeout time.Duration,
+	info HttpTuningInfo,
+	secure bool,
+	handler http.Handler,
+	lc logger.LoggingClient) *Server {
+
+	return &Server{
+		addr:    addr,
+		timeout: timeout,
+		info:    info,
+		secure:  secure,
+		handler: handler,
+		lc:      lc,
+	}
+}
+
+// WithTLS makes the server serve HTTPS with the TLS configuration, rather than HTTP, and returns it.
+func (s *Server) WithTLS(config *tls.Config) *Server {
+	s.tls = config
+	return s
+}
+
+// Start listens on the address and serves the requests until ctx is done.
+func (s *Server) Start(ctx context.Context, wg *sync.WaitGroup) error {
+	idleTimeout, err := parseDuration("idle timeout", s.info.IdleTimeout)
+	if err != nil {
+		return err
+	}
+	if idleTimeout == 0 {
+		idleTimeout = s.timeout
+	}
+	tcpKeepAlive, err := parseDuration("TCP keep-alive", s.info.TcpKeepAlive)
+	if err != nil {
+		return err
+	}
+	if s.info.TcpKeepAlive != "" && tcpKeepAlive == 0 {
+		tcpKeepAlive = -1
+	}
+
+	listener, err := (&net.ListenConfig{KeepAlive: tcpKeepAlive}).Listen(ctx, "tcp", s.addr)
+	if err != nil {
+		return err
+	}
+
+	if s.tls != nil {
+		listener = tls.NewListener(listener, s.tls)
+	}
+
+	handler := s.limitRequests(s.handler)
+	if s.info.HTTP2 && !s.secure {
+		maxStreams := s.info.MaxConcurrentStreams
+		if maxStreams == 0 {
+			maxStreams = defaultMaxConcurrentStreams
+		}
+		handler = h2c.NewHandler(handler, &http2.Server{MaxConcurrentStreams: maxStreams, IdleTimeout: idleTimeout})
+	}
+	server := &http.Server{
+		Handler:      handler,
+		ReadTimeout:  s.timeout,
+		WriteTimeout: s.timeout,
+		IdleTimeout:  idleTimeout,
+		ConnContext:  withRequestCount,
+	}
+	server.SetKeepAlivesEnabled(s.info.KeepAlive)
+
+	s.mutex.Lock()
+	s.listener = listener
+	s.running = true
+	s.mutex.Unlock()
+
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+
+		<-ctx.Done()
+		_ = server.Shutdown(context.Background())
+	}()
+	go func() {
+		defer wg.Done()
+		defer s.setRunning(false)
+
+		if err := server.Serve(listener); err != http.ErrServerClosed {
+			s.lc.Error(fmt.Sprintf("web server on %s stopped: %v", s.addr, err))
+		}
+	}()
+	return nil
+}
+
+// Addr returns the address the server listens on, once started.
+func (s *Server) Addr() net.Addr {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	if s.listener == nil {
+		return nil
+	}
+	return s.listener.Addr()
+}
+
+// IsRunning reports whether the server is serving requests.
+func (s *Server) IsRunning() bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.running
+}
+
+func (s *Server) setRunning(running bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.running = running
+}
+
+// limitRequests closes the HTTP/1.1 connections once they served MaxRequestsPerConnection requests. The streams of
+// an HTTP/2 connection are limited by MaxConcurrentStreams instead.
+func (s *Server) limitRequests(next http.Handler) http.Handler {
+	if s.info.MaxRequestsPerConnection <= 0 {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if count, ok := r.Context().Value(requestCountKey{}).(*int64); ok && r.ProtoMajor == 1 {
+			if atomic.AddInt64(count, 1) >= int64(s.info.MaxRequestsPerConnection) {
+				w.Header().Set("Connection", "close")
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+type requestCountKey struct{}
+
+// withRequestCount stores the counter of the requests served on the connection in the context of its requests.
+func withRequestCount(ctx context.Context, _ net.Conn) context.Context {
+	return context.WithValue(ctx, requestCountKey{}, new(int64))
+}
+
+func parseDuration(name string, value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid HTTP tuning %s '%s'", name, value)
+	}
+	return d, nil
+}
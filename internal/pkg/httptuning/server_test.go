@@ -0,0 +1,121 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package httptuning
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"golang.org/x/net/http2"
+)
+
+func startServer(t *testing.T, info HttpTuningInfo, secure bool) (string, func()) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) })
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	server := NewServer("127.0.0.1:0", 5*time.Second, info, secure, handler, logger.NewMockClient())
+	require.NoError(t, server.Start(ctx, wg))
+	assert.True(t, server.IsRunning())
+
+	return "http://" + server.Addr().String() + "/api/v1/ping", func() {
+		cancel()
+		wg.Wait()
+		assert.False(t, server.IsRunning())
+	}
+}
+
+// h2cClient speaks HTTP/2 in cleartext with prior knowledge, as the device services do.
+func h2cClient() *http.Client {
+	return &http.Client{Transport: &http2.Transport{
+		AllowHTTP: true,
+		DialTLS: func(network, addr string, _ *tls.Config) (net.Conn, error) {
+			return net.Dial(network, addr)
+		},
+	}}
+}
+
+func TestServerHTTP2(t *testing.T) {
+	tests := []struct {
+		name        string
+		http2       bool
+		secure      bool
+		expectError bool
+	}{
+		{"h2c", true, false, false},
+		{"HTTP/2 disabled", false, false, true},
+		{"Secure mode", true, true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			url, stop := startServer(t, HttpTuningInfo{Enabled: true, HTTP2: tt.http2, KeepAlive: true}, tt.secure)
+			defer stop()
+
+			resp, err := h2cClient().Get(url)
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			_ = resp.Body.Close()
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, 2, resp.ProtoMajor)
+
+			// the HTTP/1.1 clients are still served
+			resp, err = http.Get(url)
+			require.NoError(t, err)
+			_ = resp.Body.Close()
+			assert.Equal(t, 1, resp.ProtoMajor)
+		})
+	}
+}
+
+func TestServerKeepAlive(t *testing.T) {
+	tests := []struct {
+		name           string
+		keepAlive      bool
+		maxRequests    int
+		expectedClosed []bool
+	}{
+		{"Kept alive", true, 0, []bool{false, false, false}},
+		{"Max requests per connection", true, 2, []bool{false, true, false}},
+		{"Keep-alive disabled", false, 0, []bool{true, true, true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := HttpTuningInfo{Enabled: true, KeepAlive: tt.keepAlive, MaxRequestsPerConnection: tt.maxRequests}
+			url, stop := startServer(t, info, false)
+			defer stop()
+
+			client := &http.Client{Transport: &http.Transport{}}
+			for i, expectedClosed := range tt.expectedClosed {
+				resp, err := client.Get(url)
+				require.NoError(t, err)
+				_ = resp.Body.Close()
+				assert.Equal(t, expectedClosed, resp.Close, "request %d", i+1)
+			}
+		})
+	}
+}
+
+func TestServerInvalidDuration(t *testing.T) {
+	for _, info := range []HttpTuningInfo{{IdleTimeout: "invalid"}, {TcpKeepAlive: "-1s"}} {
+		server := NewServer("127.0.0.1:0", time.Second, info, false, http.NotFoundHandler(), logger.NewMockClient())
+		assert.Error(t, server.Start(context.Background(), &sync.WaitGroup{}))
+		assert.False(t, server.IsRunning())
+	}
+}
@@ -0,0 +1,19 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// +build !no_zmq
+
+package messaging
+
+import (
+	"github.com/edgexfoundry/go-mod-messaging/messaging"
+	"github.com/edgexfoundry/go-mod-messaging/pkg/types"
+)
+
+// NewMessageClient creates the client of the message bus of the configured type, either ZeroMQ, MQTT or Redis
+// Streams, with go-mod-messaging.
+func NewMessageClient(config types.MessageBusConfig) (MessageClient, error) {
+	return messaging.NewMessageClient(config)
+}
@@ -0,0 +1,20 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// +build no_zmq
+
+package messaging
+
+import (
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-messaging/pkg/types"
+)
+
+// NewMessageClient fails for every type of message bus. go-mod-messaging creates its MQTT and Redis Streams clients in
+// the same factory as its ZeroMQ one, and keeps them internal, so none of them can be built without cgo.
+func NewMessageClient(config types.MessageBusConfig) (MessageClient, error) {
+	return nil, fmt.Errorf("no message bus, of type '%s' or any other, in the builds with the no_zmq tag", config.Type)
+}
@@ -0,0 +1,36 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package messaging creates the message bus clients of the services with go-mod-messaging. The builds with the no_zmq
+// tag leave go-mod-messaging out, as its factory links in the ZeroMQ bindings, which need cgo, so the services can be
+// built with CGO_ENABLED=0; those builds have no message bus.
+package messaging
+
+import (
+	"github.com/edgexfoundry/go-mod-messaging/pkg/types"
+)
+
+const (
+	// ZeroMQ is the type of the ZeroMQ message bus.
+	ZeroMQ = "zero"
+	// MQTT is the type of the MQTT message bus.
+	MQTT = "mqtt"
+	// RedisStreams is the type of the Redis Streams message bus.
+	RedisStreams = "redisstreams"
+)
+
+// MessageClient publishes and subscribes to the topics of a message bus. It has the methods of the go-mod-messaging
+// MessageClient, which it is in the builds with a message bus.
+type MessageClient interface {
+	// Connect connects to the message bus.
+	Connect() error
+	// Publish sends the message to the topic.
+	Publish(message types.MessageEnvelope, topic string) error
+	// Subscribe sends the messages received on each topic to its channel, and the errors receiving them to
+	// messageErrors.
+	Subscribe(topics []types.TopicChannel, messageErrors chan error) error
+	// Disconnect closes the connections to the message bus.
+	Disconnect() error
+}
@@ -0,0 +1,77 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mtls
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// CertificatesName contains the name of the Certificates implementation in the DIC.
+var CertificatesName = di.TypeInstanceToName(Certificates{})
+
+// CertificatesFrom helper function queries the DIC and returns the Certificates implementation, which is nil when
+// mutual TLS isn't enabled.
+func CertificatesFrom(get di.Get) *Certificates {
+	certificates, _ := get(CertificatesName).(*Certificates)
+	return certificates
+}
+
+// Bootstrap contains references to dependencies required by the BootstrapHandler.
+type Bootstrap struct {
+	service     *bootstrapConfig.ServiceInfo
+	secretStore *bootstrapConfig.SecretStoreInfo
+	info        *MutualTLSInfo
+}
+
+// NewBootstrap is a factory method that returns an initialized Bootstrap receiver struct.
+func NewBootstrap(
+	service *bootstrapConfig.ServiceInfo,
+	secretStore *bootstrapConfig.SecretStoreInfo,
+	info *MutualTLSInfo) *Bootstrap {
+
+	return &Bootstrap{
+		service:     service,
+		secretStore: secretStore,
+		info:        info,
+	}
+}
+
+// BootstrapHandler fulfills the BootstrapHandler contract. When mutual TLS is enabled, the certificate of the service
+// is loaded, reloaded as it's rotated, and added to the DIC for the service to serve its API and call the other
+// services with.
+func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
+	if !b.info.Enabled {
+		return true
+	}
+
+	lc := container.LoggingClientFrom(dic.Get)
+	certificates, err := NewCertificates(*b.info, *b.secretStore, b.service.Host, lc)
+	if err != nil {
+		lc.Error(fmt.Sprintf("failed to load the certificate of the service: %v", err))
+		return false
+	}
+	certificates.Start(ctx, wg)
+	dic.Update(di.ServiceConstructorMap{
+		CertificatesName: func(get di.Get) interface{} {
+			return certificates
+		},
+	})
+
+	leaf := certificates.Leaf()
+	lc.Info(fmt.Sprintf(
+		"Using the certificate of %s for mutual TLS, valid until %s",
+		leaf.Subject.CommonName,
+		leaf.NotAfter.Format(time.RFC3339)))
+	return true
+}
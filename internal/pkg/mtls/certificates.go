@@ -0,0 +1,188 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package mtls provides the certificate a service serves its API over HTTPS with and presents on the calls to the
+// other services, for them to authenticate each other. The certificate is issued by the PKI of the secret store, or
+// read from files, and reloaded as it's rotated without restarting the service.
+package mtls
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+)
+
+// defaultReloadInterval is how often the certificate is checked for its renewal or rotation, when none is configured.
+const defaultReloadInterval = time.Minute
+
+// Certificates holds the current certificate of the service, along with the certificate authorities trusted with it.
+type Certificates struct {
+	source     source
+	interval   time.Duration
+	clientAuth tls.ClientAuthType
+	lc         logger.LoggingClient
+
+	mutex     sync.RWMutex
+	current   bundle
+	clientCAs *x509.CertPool
+	transport *http.Transport
+}
+
+// NewCertificates loads the certificate of info, issued by the PKI of the secret store unless its files are
+// configured. The certificate is issued for the host when info has no common name.
+func NewCertificates(
+	info MutualTLSInfo,
+	secretStore bootstrapConfig.SecretStoreInfo,
+	host string,
+	lc logger.LoggingClient) (*Certificates, error) {
+
+	interval := defaultReloadInterval
+	if info.ReloadInterval != "" {
+		var err error
+		if interval, err = time.ParseDuration(info.ReloadInterval); err != nil || interval <= 0 {
+			return nil, fmt.Errorf("invalid MutualTLS ReloadInterval '%s'", info.ReloadInterval)
+		}
+	}
+
+	var s source
+	var err error
+	if info.CertFile != "" {
+		s, err = newFileSource(info)
+	} else {
+		s, err = newPKISource(info, secretStore, host)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Certificates{source: s, interval: interval, clientAuth: tls.VerifyClientCertIfGiven, lc: lc}
+	if info.RequireClientCert {
+		c.clientAuth = tls.RequireAndVerifyClientCert
+	}
+	if err = c.reload(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// reload loads the certificate again and replaces the current one.
+func (c *Certificates) reload() error {
+	b, err := c.source.load()
+	if err != nil {
+		return err
+	}
+
+	// the other services are trusted along with the public ones, such as the targets of the REST notifications
+	roots, err := x509.SystemCertPool()
+	if err != nil {
+		roots = x509.NewCertPool()
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		Certificates: []tls.Certificate{b.cert},
+		RootCAs:      b.pool(roots),
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	c.mutex.Lock()
+	previous := c.transport
+	c.current = b
+	c.clientCAs = b.pool(x509.NewCertPool())
+	c.transport = transport
+	c.mutex.Unlock()
+
+	if previous != nil {
+		previous.CloseIdleConnections()
+	}
+	return nil
+}
+
+// Start checks the certificate every reload interval until ctx is done, and loads it again once it's due for renewal
+// or rotated. A failed reload is retried on the next check, the current certificate being kept meanwhile.
+func (c *Certificates) Start(ctx context.Context, wg *sync.WaitGroup) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(c.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				if !c.source.due(now, c.Leaf()) {
+					continue
+				}
+				if err := c.reload(); err != nil {
+					c.lc.Error(fmt.Sprintf("failed to reload the certificate: %v", err))
+					continue
+				}
+				c.lc.Info(fmt.Sprintf("Reloaded the certificate, valid until %s", c.Leaf().NotAfter.Format(time.RFC3339)))
+			}
+		}
+	}()
+}
+
+// Leaf returns the current certificate.
+func (c *Certificates) Leaf() *x509.Certificate {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.current.cert.Leaf
+}
+
+// ServerConfig returns the TLS configuration of a server presenting the current certificate, and verifying the
+// certificates of its clients against the trusted certificate authorities.
+func (c *Certificates) ServerConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			c.mutex.RLock()
+			defer c.mutex.RUnlock()
+			return &tls.Config{
+				Certificates: []tls.Certificate{c.current.cert},
+				ClientCAs:    c.clientCAs,
+				ClientAuth:   c.clientAuth,
+				MinVersion:   tls.VersionTLS12,
+			}, nil
+		},
+	}
+}
+
+// Transport returns the transport of the calls to the other services, presenting the current certificate. A nil
+// Certificates returns the default transport, so that the calls are made without presenting any.
+func (c *Certificates) Transport() http.RoundTripper {
+	if c == nil {
+		return http.DefaultTransport
+	}
+	return roundTripper{c}
+}
+
+// Client returns a client of the other services presenting the current certificate, whose requests time out after
+// the timeout, if any. A nil Certificates returns a client presenting none.
+func (c *Certificates) Client(timeout time.Duration) *http.Client {
+	return &http.Client{Transport: c.Transport(), Timeout: timeout}
+}
+
+// roundTripper makes the calls with the transport of the current certificate, the transport being replaced along with
+// the certificate.
+type roundTripper struct {
+	c *Certificates
+}
+
+func (t roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.c.mutex.RLock()
+	transport := t.c.transport
+	t.c.mutex.RUnlock()
+	return transport.RoundTrip(req)
+}
@@ -0,0 +1,209 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mtls
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testCA struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPEM []byte
+	serial  int64
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "EdgeX Test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCA{cert: cert, key: key, certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), serial: 1}
+}
+
+// issue returns the PEM certificate and key of the common name, valid for the validity.
+func (ca *testCA) issue(t *testing.T, commonName string, validity time.Duration) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	ca.serial++
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(ca.serial),
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     []string{commonName},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(validity),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+// writeFiles writes the certificate of the common name, its key and the certificate authority to dir, and returns
+// their configuration.
+func (ca *testCA) writeFiles(t *testing.T, dir string, commonName string) MutualTLSInfo {
+	certPEM, keyPEM := ca.issue(t, commonName, time.Hour)
+	info := MutualTLSInfo{
+		Enabled:  true,
+		CertFile: filepath.Join(dir, commonName+".crt"),
+		KeyFile:  filepath.Join(dir, commonName+".key"),
+		CAFile:   filepath.Join(dir, "ca.crt"),
+	}
+	require.NoError(t, ioutil.WriteFile(info.CertFile, certPEM, 0600))
+	require.NoError(t, ioutil.WriteFile(info.KeyFile, keyPEM, 0600))
+	require.NoError(t, ioutil.WriteFile(info.CAFile, ca.certPEM, 0600))
+	return info
+}
+
+func TestNewCertificatesValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		info MutualTLSInfo
+	}{
+		{"no PKI role", MutualTLSInfo{PKIPath: "pki"}},
+		{"no key file", MutualTLSInfo{CertFile: "service.crt"}},
+		{"missing files", MutualTLSInfo{CertFile: "service.crt", KeyFile: "service.key"}},
+		{"invalid reload interval", MutualTLSInfo{PKIPath: "pki", Role: "service", ReloadInterval: "often"}},
+		{"invalid renewal", MutualTLSInfo{PKIPath: "pki", Role: "service", RenewBefore: "-1h"}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := NewCertificates(testCase.info, bootstrapConfig.SecretStoreInfo{}, "localhost", logger.NewMockClient())
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestFileRotation(t *testing.T) {
+	ca := newTestCA(t)
+	dir := t.TempDir()
+	info := ca.writeFiles(t, dir, "core-command")
+	certificates, err := NewCertificates(info, bootstrapConfig.SecretStoreInfo{}, "localhost", logger.NewMockClient())
+	require.NoError(t, err)
+	assert.Equal(t, "core-command", certificates.Leaf().Subject.CommonName)
+	assert.False(t, certificates.source.due(time.Now(), certificates.Leaf()))
+
+	certPEM, keyPEM := ca.issue(t, "core-command-rotated", time.Hour)
+	require.NoError(t, ioutil.WriteFile(info.CertFile, certPEM, 0600))
+	require.NoError(t, ioutil.WriteFile(info.KeyFile, keyPEM, 0600))
+	later := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(info.CertFile, later, later))
+	require.NoError(t, os.Chtimes(info.KeyFile, later, later))
+
+	require.True(t, certificates.source.due(time.Now(), certificates.Leaf()), "the rotated files should be reloaded")
+	require.NoError(t, certificates.reload())
+	assert.Equal(t, "core-command-rotated", certificates.Leaf().Subject.CommonName)
+}
+
+func TestMutualTLS(t *testing.T) {
+	ca := newTestCA(t)
+	dir := t.TempDir()
+	serverInfo := ca.writeFiles(t, dir, "core-command")
+	serverInfo.RequireClientCert = true
+	server, err := NewCertificates(serverInfo, bootstrapConfig.SecretStoreInfo{}, "localhost", logger.NewMockClient())
+	require.NoError(t, err)
+	client, err := NewCertificates(ca.writeFiles(t, dir, "device-virtual"), bootstrapConfig.SecretStoreInfo{}, "localhost", logger.NewMockClient())
+	require.NoError(t, err)
+
+	var peer string
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		peer = r.TLS.PeerCertificates[0].Subject.CommonName
+		w.WriteHeader(http.StatusOK)
+	}))
+	ts.TLS = server.ServerConfig()
+	ts.StartTLS()
+	defer ts.Close()
+
+	resp, err := client.Client(5 * time.Second).Get(ts.URL)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "device-virtual", peer)
+
+	var none *Certificates
+	_, err = none.Client(5 * time.Second).Get(ts.URL)
+	assert.Error(t, err, "the server certificate should not be trusted without the certificate authority")
+}
+
+func TestPKIIssuance(t *testing.T) {
+	ca := newTestCA(t)
+	var requested issueRequest
+	vault := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/pki/issue/edgex-service" || r.Header.Get("X-Vault-Token") != "s.token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&requested)
+		certPEM, keyPEM := ca.issue(t, requested.CommonName, 3*time.Hour)
+		var issued issueResponse
+		issued.Data.Certificate = string(certPEM)
+		issued.Data.PrivateKey = string(keyPEM)
+		issued.Data.IssuingCA = string(ca.certPEM)
+		_ = json.NewEncoder(w).Encode(issued)
+	}))
+	defer vault.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "secrets-token.json")
+	require.NoError(t, ioutil.WriteFile(tokenFile, []byte(`{"auth":{"client_token":"s.token"}}`), 0600))
+	host, port, _ := net.SplitHostPort(vault.Listener.Addr().String())
+	portNumber, _ := strconv.Atoi(port)
+	secretStore := bootstrapConfig.SecretStoreInfo{Protocol: "http", Host: host, Port: portNumber, TokenFile: tokenFile}
+
+	info := MutualTLSInfo{Enabled: true, PKIPath: "/pki/", Role: "edgex-service", AltNames: []string{"edgex-core-command", "10.0.0.5"}}
+	certificates, err := NewCertificates(info, secretStore, "localhost", logger.NewMockClient())
+	require.NoError(t, err)
+	assert.Equal(t, "localhost", certificates.Leaf().Subject.CommonName, "the certificate should be issued for the host")
+	assert.Equal(t, "edgex-core-command", requested.AltNames)
+	assert.Equal(t, "10.0.0.5", requested.IPSans)
+
+	leaf := certificates.Leaf()
+	assert.False(t, certificates.source.due(time.Now(), leaf))
+	assert.True(t, certificates.source.due(leaf.NotAfter.Add(-time.Hour), leaf),
+		"the certificate should be renewed in the last third of its validity")
+
+	info.Role = "unknown"
+	_, err = NewCertificates(info, secretStore, "localhost", logger.NewMockClient())
+	assert.Error(t, err)
+}
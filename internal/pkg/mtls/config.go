@@ -0,0 +1,39 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mtls
+
+// MutualTLSInfo provides properties related to serving the API over HTTPS and presenting a client certificate on the
+// calls to the other services, with a certificate issued by the PKI of the secret store or read from files
+type MutualTLSInfo struct {
+	// Enabled indicates whether the certificate is loaded, the API served over HTTPS and the certificate presented
+	Enabled bool
+	// PKIPath is the mount path of the PKI secrets engine of the secret store issuing the certificate, e.g. 'pki'
+	PKIPath string
+	// Role is the role of the PKI the certificate is issued with
+	Role string
+	// CommonName is the name the certificate is issued for, the host of the service when empty
+	CommonName string
+	// AltNames are the other DNS names and IP addresses the certificate is issued for
+	AltNames []string
+	// TTL is the validity of the issued certificate, e.g. '72h'; the one of the role when empty
+	TTL string
+	// RenewBefore is how long before it expires the certificate is issued again, e.g. '24h'; a third of its validity
+	// when empty
+	RenewBefore string
+	// CertFile is the PEM file of the certificate, read in place of issuing one when set, e.g. when it's written by an
+	// agent of the secret store
+	CertFile string
+	// KeyFile is the PEM file of the private key of the certificate of CertFile
+	KeyFile string
+	// CAFile is the PEM file of the certificate authorities trusted with the certificate of CertFile
+	CAFile string
+	// ReloadInterval is how often the certificate is checked for its renewal or the rotation of its files, '1m' when
+	// empty
+	ReloadInterval string
+	// RequireClientCert indicates whether the clients must present a certificate issued by a trusted authority; when
+	// false, the certificate of the clients presenting one is still verified
+	RequireClientCert bool
+}
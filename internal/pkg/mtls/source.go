@@ -0,0 +1,296 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mtls
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+)
+
+// bundle is a certificate along with the certificate authorities trusted with it.
+type bundle struct {
+	cert tls.Certificate
+	cas  []*x509.Certificate
+}
+
+// pool returns the pool of the certificate authorities of the bundle, added to base.
+func (b bundle) pool(base *x509.CertPool) *x509.CertPool {
+	for _, ca := range b.cas {
+		base.AddCert(ca)
+	}
+	return base
+}
+
+// source loads the certificate of the service.
+type source interface {
+	// load returns the current certificate.
+	load() (bundle, error)
+	// due reports whether the certificate must be loaded again, leaf being the one loaded last.
+	due(now time.Time, leaf *x509.Certificate) bool
+}
+
+// newBundle parses the PEM certificate, its key and the PEM certificate authorities. The certificates following the
+// first one of certPEM, which complete its chain, are trusted as well.
+func newBundle(certPEM []byte, keyPEM []byte, caPEM []byte) (bundle, error) {
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return bundle{}, err
+	}
+	if cert.Leaf, err = x509.ParseCertificate(cert.Certificate[0]); err != nil {
+		return bundle{}, err
+	}
+
+	b := bundle{cert: cert}
+	for _, der := range cert.Certificate[1:] {
+		if ca, err := x509.ParseCertificate(der); err == nil {
+			b.cas = append(b.cas, ca)
+		}
+	}
+	for block, rest := pem.Decode(caPEM); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		ca, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return bundle{}, fmt.Errorf("invalid certificate authority: %v", err)
+		}
+		b.cas = append(b.cas, ca)
+	}
+	if len(b.cas) == 0 {
+		return bundle{}, errors.New("no certificate authority found")
+	}
+	return b, nil
+}
+
+// fileSource reads the certificate from PEM files, which are read again once modified.
+type fileSource struct {
+	certFile string
+	keyFile  string
+	caFile   string
+	modified map[string]time.Time
+}
+
+func newFileSource(info MutualTLSInfo) (*fileSource, error) {
+	if info.KeyFile == "" {
+		return nil, errors.New("no MutualTLS KeyFile configured along with the CertFile")
+	}
+	return &fileSource{
+		certFile: info.CertFile,
+		keyFile:  info.KeyFile,
+		caFile:   info.CAFile,
+		modified: make(map[string]time.Time),
+	}, nil
+}
+
+func (s *fileSource) files() []string {
+	files := []string{s.certFile, s.keyFile}
+	if s.caFile != "" {
+		files = append(files, s.caFile)
+	}
+	return files
+}
+
+func (s *fileSource) load() (bundle, error) {
+	contents := make([][]byte, 3)
+	for i, file := range s.files() {
+		stat, err := os.Stat(file)
+		if err != nil {
+			return bundle{}, err
+		}
+		if contents[i], err = ioutil.ReadFile(file); err != nil {
+			return bundle{}, err
+		}
+		s.modified[file] = stat.ModTime()
+	}
+	b, err := newBundle(contents[0], contents[1], contents[2])
+	if err != nil {
+		return bundle{}, fmt.Errorf("invalid certificate in %s: %v", s.certFile, err)
+	}
+	return b, nil
+}
+
+// due reports whether any of the files was modified since it was read. The files being rotated one after the other,
+// a rotation in progress is loaded again on the next check.
+func (s *fileSource) due(_ time.Time, _ *x509.Certificate) bool {
+	for _, file := range s.files() {
+		stat, err := os.Stat(file)
+		if err == nil && !stat.ModTime().Equal(s.modified[file]) {
+			return true
+		}
+	}
+	return false
+}
+
+// issueRequest is the request of a certificate to the PKI secrets engine of the secret store.
+type issueRequest struct {
+	CommonName string `json:"common_name"`
+	AltNames   string `json:"alt_names,omitempty"`
+	IPSans     string `json:"ip_sans,omitempty"`
+	TTL        string `json:"ttl,omitempty"`
+}
+
+// issueResponse is the certificate issued by the PKI secrets engine of the secret store.
+type issueResponse struct {
+	Data struct {
+		Certificate string   `json:"certificate"`
+		PrivateKey  string   `json:"private_key"`
+		IssuingCA   string   `json:"issuing_ca"`
+		CAChain     []string `json:"ca_chain"`
+	} `json:"data"`
+}
+
+// pkiSource issues the certificate from the PKI secrets engine of the secret store, and issues a new one before it
+// expires.
+type pkiSource struct {
+	url         string
+	tokenFile   string
+	request     issueRequest
+	renewBefore time.Duration
+	client      *http.Client
+}
+
+func newPKISource(info MutualTLSInfo, secretStore bootstrapConfig.SecretStoreInfo, host string) (*pkiSource, error) {
+	if info.PKIPath == "" || info.Role == "" {
+		return nil, errors.New("no MutualTLS PKIPath and Role, nor CertFile, configured")
+	}
+	var renewBefore time.Duration
+	if info.RenewBefore != "" {
+		var err error
+		if renewBefore, err = time.ParseDuration(info.RenewBefore); err != nil || renewBefore < 0 {
+			return nil, fmt.Errorf("invalid MutualTLS RenewBefore '%s'", info.RenewBefore)
+		}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if secretStore.RootCaCertPath != "" {
+		caPEM, err := ioutil.ReadFile(secretStore.RootCaCertPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the certificate authority of the secret store: %v", err)
+		}
+		roots := x509.NewCertPool()
+		if !roots.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no certificate authority found in %s", secretStore.RootCaCertPath)
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: roots, ServerName: secretStore.ServerName}
+	}
+
+	request := issueRequest{CommonName: info.CommonName, TTL: info.TTL}
+	if request.CommonName == "" {
+		request.CommonName = host
+	}
+	var altNames, ipSans []string
+	for _, name := range append([]string{request.CommonName}, info.AltNames...) {
+		if net.ParseIP(name) != nil {
+			ipSans = append(ipSans, name)
+		} else if name != request.CommonName {
+			altNames = append(altNames, name)
+		}
+	}
+	request.AltNames = strings.Join(altNames, ",")
+	request.IPSans = strings.Join(ipSans, ",")
+
+	return &pkiSource{
+		url: fmt.Sprintf(
+			"%s://%s/v1/%s/issue/%s",
+			secretStore.Protocol,
+			net.JoinHostPort(secretStore.Host, strconv.Itoa(secretStore.Port)),
+			strings.Trim(info.PKIPath, "/"),
+			info.Role),
+		tokenFile:   secretStore.TokenFile,
+		request:     request,
+		renewBefore: renewBefore,
+		client:      &http.Client{Transport: transport, Timeout: 30 * time.Second},
+	}, nil
+}
+
+// readToken reads the token of the service from the token file of the secret store, again on every issuance as it's
+// renewed.
+func (s *pkiSource) readToken() (string, error) {
+	data, err := ioutil.ReadFile(s.tokenFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the secret store token: %v", err)
+	}
+	var response struct {
+		RootToken string `json:"root_token"`
+		Auth      struct {
+			ClientToken string `json:"client_token"`
+		} `json:"auth"`
+	}
+	if err = json.Unmarshal(data, &response); err != nil {
+		return "", fmt.Errorf("failed to decode the secret store token file %s: %v", s.tokenFile, err)
+	}
+	if response.Auth.ClientToken != "" {
+		return response.Auth.ClientToken, nil
+	}
+	if response.RootToken != "" {
+		return response.RootToken, nil
+	}
+	return "", fmt.Errorf("no secret store token found in %s", s.tokenFile)
+}
+
+func (s *pkiSource) load() (bundle, error) {
+	token, err := s.readToken()
+	if err != nil {
+		return bundle{}, err
+	}
+	body, err := json.Marshal(s.request)
+	if err != nil {
+		return bundle{}, err
+	}
+	req, err := http.NewRequest(http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return bundle{}, err
+	}
+	req.Header.Set("X-Vault-Token", token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return bundle{}, fmt.Errorf("failed to issue the certificate: %v", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return bundle{}, fmt.Errorf("failed to issue the certificate, status code %d", resp.StatusCode)
+	}
+
+	var issued issueResponse
+	if err = json.NewDecoder(resp.Body).Decode(&issued); err != nil {
+		return bundle{}, fmt.Errorf("failed to decode the certificate issued: %v", err)
+	}
+	caPEM := issued.Data.IssuingCA
+	for _, ca := range issued.Data.CAChain {
+		caPEM += "\n" + ca
+	}
+	b, err := newBundle([]byte(issued.Data.Certificate), []byte(issued.Data.PrivateKey), []byte(caPEM))
+	if err != nil {
+		return bundle{}, fmt.Errorf("invalid certificate issued: %v", err)
+	}
+	return b, nil
+}
+
+// due reports whether the certificate expires within the renewal delay, a third of its validity when none is
+// configured.
+func (s *pkiSource) due(now time.Time, leaf *x509.Certificate) bool {
+	renewBefore := s.renewBefore
+	if renewBefore == 0 {
+		renewBefore = leaf.NotAfter.Sub(leaf.NotBefore) / 3
+	}
+	return !now.Before(leaf.NotAfter.Add(-renewBefore))
+}
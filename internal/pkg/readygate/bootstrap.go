@@ -0,0 +1,148 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package readygate
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/messaging"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	msgTypes "github.com/edgexfoundry/go-mod-messaging/pkg/types"
+)
+
+const (
+	defaultTimeout  = 60 * time.Second
+	defaultInterval = 5 * time.Second
+)
+
+// Bootstrap contains references to dependencies required by the WaitHandler and the AnnounceHandler.
+type Bootstrap struct {
+	serviceKey string
+	info       *ReadyGateInfo
+}
+
+// NewBootstrap is a factory method that returns an initialized Bootstrap receiver struct.
+func NewBootstrap(serviceKey string, info *ReadyGateInfo) *Bootstrap {
+	return &Bootstrap{
+		serviceKey: serviceKey,
+		info:       info,
+	}
+}
+
+// WaitHandler fulfills the BootstrapHandler contract. When the gate is enabled, it holds the startup until the
+// services of WaitFor have published their ReadyToRun signal, failing it once the Timeout elapses. It must run before
+// the handlers depending on those services.
+func (b *Bootstrap) WaitHandler(ctx context.Context, _ *sync.WaitGroup, startupTimer startup.Timer, dic *di.Container) bool {
+	if !b.info.Enabled || len(b.info.WaitFor) == 0 {
+		return true
+	}
+
+	lc := container.LoggingClientFrom(dic.Get)
+	timeout, ok := parseDuration(b.info.Timeout, defaultTimeout, "Timeout", lc)
+	if !ok {
+		return false
+	}
+	msgClient, err := connectMessageBus(b.info.MessageBus, startupTimer, lc)
+	if err != nil {
+		lc.Error(err.Error())
+		return false
+	}
+	defer func() {
+		if err := msgClient.Disconnect(); err != nil {
+			lc.Warn(fmt.Sprintf("failed to disconnect from the ready gate message bus: %s", err.Error()))
+		}
+	}()
+
+	lc.Info(fmt.Sprintf("Waiting for the ReadyToRun signal of %v", b.info.WaitFor))
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	if err := Wait(waitCtx, topic(b.info.MessageBus), b.info.WaitFor, msgClient, lc); err != nil {
+		lc.Error(err.Error())
+		return false
+	}
+	return true
+}
+
+// AnnounceHandler fulfills the BootstrapHandler contract. When the gate is enabled, it publishes the ReadyToRun
+// signal of the service every Interval until it stops. It must run last, once the service is ready to serve requests.
+func (b *Bootstrap) AnnounceHandler(ctx context.Context, wg *sync.WaitGroup, startupTimer startup.Timer, dic *di.Container) bool {
+	if !b.info.Enabled {
+		return true
+	}
+
+	lc := container.LoggingClientFrom(dic.Get)
+	interval, ok := parseDuration(b.info.Interval, defaultInterval, "Interval", lc)
+	if !ok {
+		return false
+	}
+	msgClient, err := connectMessageBus(b.info.MessageBus, startupTimer, lc)
+	if err != nil {
+		lc.Error(err.Error())
+		return false
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		Announce(ctx, topic(b.info.MessageBus), b.serviceKey, interval, msgClient, lc)
+		if err := msgClient.Disconnect(); err != nil {
+			lc.Warn(fmt.Sprintf("failed to disconnect from the ready gate message bus: %s", err.Error()))
+		}
+	}()
+	return true
+}
+
+func topic(info MessageBusInfo) string {
+	if info.Topic == "" {
+		return DefaultTopic
+	}
+	return info.Topic
+}
+
+func parseDuration(value string, defaultValue time.Duration, name string, lc logger.LoggingClient) (time.Duration, bool) {
+	if value == "" {
+		return defaultValue, true
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration <= 0 {
+		lc.Error(fmt.Sprintf("invalid ReadyGate %s '%s'", name, value))
+		return 0, false
+	}
+	return duration, true
+}
+
+// connectMessageBus connects the client publishing and receiving the signals through the broker, retrying until the
+// startup timer elapses.
+func connectMessageBus(info MessageBusInfo, startupTimer startup.Timer, lc logger.LoggingClient) (messaging.MessageClient, error) {
+	host := msgTypes.HostInfo{Host: info.Host, Port: info.Port, Protocol: info.Protocol}
+	msgClient, err := messaging.NewMessageClient(msgTypes.MessageBusConfig{
+		PublishHost:   host,
+		SubscribeHost: host,
+		Type:          info.Type,
+		Optional:      info.Optional,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the ready gate messaging client: %v", err)
+	}
+	for startupTimer.HasNotElapsed() {
+		if err = msgClient.Connect(); err == nil {
+			return msgClient, nil
+		}
+		lc.Warn(fmt.Sprintf("couldn't connect to the ready gate message bus: %s", err.Error()))
+		startupTimer.SleepForInterval()
+	}
+	return nil, fmt.Errorf("failed to connect to the ready gate message bus in allotted time")
+}
@@ -0,0 +1,35 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package readygate
+
+// ReadyGateInfo provides properties related to gating the startup of the service on the readiness of the services it
+// depends on, signalled over the message bus instead of by their listening on a TCP port, for deployments such as
+// Kubernetes where the start order of the services differs from docker-compose
+type ReadyGateInfo struct {
+	// Enabled indicates whether the service publishes its ReadyToRun signal and waits for the one of the WaitFor services
+	Enabled bool
+	// WaitFor are the keys of the services whose ReadyToRun signal the startup waits for, e.g. ['edgex-core-metadata']
+	WaitFor []string
+	// Timeout bounds the wait, e.g. '60s', the startup failing once it elapses
+	Timeout string
+	// Interval is how often the ReadyToRun signal of the service is published once it's ready, e.g. '5s', for the
+	// services starting later to receive it too
+	Interval string
+	// MessageBus carries the ReadyToRun signals
+	MessageBus MessageBusInfo
+}
+
+// MessageBusInfo provides properties related to the message bus carrying the ReadyToRun signals
+type MessageBusInfo struct {
+	// Type is the message bus implementation, e.g. 'mqtt' or 'redisstreams', through a broker all the services share
+	Type     string
+	Protocol string
+	Host     string
+	Port     int
+	// Topic the signals are published on, DefaultTopic when empty
+	Topic    string
+	Optional map[string]string
+}
@@ -0,0 +1,116 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package readygate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/common"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	msgTypes "github.com/edgexfoundry/go-mod-messaging/pkg/types"
+
+	"github.com/google/uuid"
+)
+
+// DefaultTopic is the topic the ReadyToRun signals are published on when none is configured.
+const DefaultTopic = "edgex/ready"
+
+// ReadyToRun is the signal a service publishes once it's ready to serve requests.
+type ReadyToRun struct {
+	// Service is the key of the service, e.g. 'edgex-core-metadata'
+	Service string `json:"service"`
+	// Created is when the signal was published, in milliseconds
+	Created int64 `json:"created"`
+}
+
+// subscriber receives messages from the message bus, as the message client does.
+type subscriber interface {
+	Subscribe(topics []msgTypes.TopicChannel, messageErrors chan error) error
+}
+
+// publisher publishes messages on the message bus, as the message client does.
+type publisher interface {
+	Publish(message msgTypes.MessageEnvelope, topic string) error
+}
+
+// Wait subscribes to the topic and returns once the ReadyToRun signal of every service of waitFor is received, or
+// an error once ctx is done.
+func Wait(ctx context.Context, topic string, waitFor []string, client subscriber, lc logger.LoggingClient) error {
+	pending := make(map[string]bool)
+	for _, service := range waitFor {
+		pending[service] = true
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	messages := make(chan msgTypes.MessageEnvelope, 16)
+	messageErrors := make(chan error, 1)
+	if err := client.Subscribe([]msgTypes.TopicChannel{{Topic: topic, Messages: messages}}, messageErrors); err != nil {
+		return fmt.Errorf("failed to subscribe to topic '%s': %v", topic, err)
+	}
+
+	for len(pending) > 0 {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%s not ready to run: %v", strings.Join(sortedKeys(pending), ", "), ctx.Err())
+		case err := <-messageErrors:
+			lc.Warn(fmt.Sprintf("failed to receive a ReadyToRun signal: %s", err.Error()))
+		case message := <-messages:
+			var signal ReadyToRun
+			if err := json.Unmarshal(message.Payload, &signal); err != nil {
+				lc.Warn(fmt.Sprintf("ignoring malformed ReadyToRun signal: %s", err.Error()))
+				continue
+			}
+			if pending[signal.Service] {
+				delete(pending, signal.Service)
+				lc.Info(fmt.Sprintf("Service %s is ready to run", signal.Service))
+			}
+		}
+	}
+	return nil
+}
+
+// Announce publishes the ReadyToRun signal of the service on the topic every interval until ctx is done, as a late
+// subscriber doesn't receive the signals published before it subscribed.
+func Announce(ctx context.Context, topic string, serviceKey string, interval time.Duration, client publisher, lc logger.LoggingClient) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		payload, _ := json.Marshal(ReadyToRun{Service: serviceKey, Created: common.MakeTimestamp()})
+		err := client.Publish(msgTypes.MessageEnvelope{
+			CorrelationID: uuid.New().String(),
+			ContentType:   clients.ContentTypeJSON,
+			Payload:       payload,
+		}, topic)
+		if err != nil {
+			lc.Warn(fmt.Sprintf("failed to publish the ReadyToRun signal on topic '%s': %s", topic, err.Error()))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
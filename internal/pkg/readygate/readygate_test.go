@@ -0,0 +1,117 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package readygate
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	msgTypes "github.com/edgexfoundry/go-mod-messaging/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeBus delivers the messages published on a topic to its subscriber, dropping them when it's not keeping up.
+type fakeBus struct {
+	mutex    sync.Mutex
+	messages chan msgTypes.MessageEnvelope
+	topics   []string
+}
+
+func (b *fakeBus) Subscribe(topics []msgTypes.TopicChannel, _ chan error) error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.messages = topics[0].Messages
+	return nil
+}
+
+func (b *fakeBus) Publish(message msgTypes.MessageEnvelope, topic string) error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.topics = append(b.topics, topic)
+	if b.messages != nil {
+		select {
+		case b.messages <- message:
+		default:
+		}
+	}
+	return nil
+}
+
+func signal(t *testing.T, service string) msgTypes.MessageEnvelope {
+	payload, err := json.Marshal(ReadyToRun{Service: service})
+	require.NoError(t, err)
+	return msgTypes.MessageEnvelope{Payload: payload}
+}
+
+func TestWait(t *testing.T) {
+	bus := &fakeBus{}
+	done := make(chan error, 1)
+	go func() {
+		done <- Wait(context.Background(), DefaultTopic, []string{"edgex-core-metadata", "edgex-core-data"}, bus, logger.MockLogger{})
+	}()
+
+	require.Eventually(t, func() bool {
+		bus.mutex.Lock()
+		defer bus.mutex.Unlock()
+		return bus.messages != nil
+	}, time.Second, time.Millisecond)
+	require.NoError(t, bus.Publish(signal(t, "edgex-core-metadata"), DefaultTopic))
+	require.NoError(t, bus.Publish(msgTypes.MessageEnvelope{Payload: []byte("not json")}, DefaultTopic))
+	require.NoError(t, bus.Publish(signal(t, "edgex-support-scheduler"), DefaultTopic))
+	select {
+	case err := <-done:
+		t.Fatalf("returned before every service is ready: %v", err)
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	require.NoError(t, bus.Publish(signal(t, "edgex-core-data"), DefaultTopic))
+	assert.NoError(t, <-done)
+}
+
+func TestWaitTimeout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := Wait(ctx, DefaultTopic, []string{"edgex-core-metadata", "edgex-core-data"}, &fakeBus{}, logger.MockLogger{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "edgex-core-data, edgex-core-metadata not ready to run")
+}
+
+func TestWaitNothing(t *testing.T) {
+	assert.NoError(t, Wait(context.Background(), DefaultTopic, nil, &fakeBus{}, logger.MockLogger{}))
+}
+
+func TestAnnounce(t *testing.T) {
+	bus := &fakeBus{}
+	bus.messages = make(chan msgTypes.MessageEnvelope, 16)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		Announce(ctx, "edgex/ready", "edgex-core-data", time.Millisecond, bus, logger.MockLogger{})
+		close(done)
+	}()
+
+	for i := 0; i < 2; i++ {
+		message := <-bus.messages
+		var received ReadyToRun
+		require.NoError(t, json.Unmarshal(message.Payload, &received))
+		assert.Equal(t, "edgex-core-data", received.Service)
+		assert.NotZero(t, received.Created)
+	}
+	cancel()
+	<-done
+
+	bus.mutex.Lock()
+	defer bus.mutex.Unlock()
+	assert.Equal(t, "edgex/ready", bus.topics[0])
+}
@@ -0,0 +1,95 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package secretref
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	bootstrapContainer "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/writable"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+)
+
+// WritableResolvable is implemented by the configurations resolving the secret references of the updates of their
+// writable configuration before applying them.
+type WritableResolvable interface {
+	// SetWritableResolver sets the function resolving the references of an update of the writable configuration,
+	// which is only applied when it returns true.
+	SetWritableResolver(resolve func(writable interface{}) bool)
+}
+
+// Bootstrap contains references to dependencies required by the BootstrapHandler.
+type Bootstrap struct {
+	configuration interface{}
+}
+
+// NewBootstrap is a factory method that returns an initialized Bootstrap receiver struct. The configuration is a
+// pointer to the service's configuration.
+func NewBootstrap(configuration interface{}) *Bootstrap {
+	return &Bootstrap{
+		configuration: configuration,
+	}
+}
+
+// BootstrapHandler fulfills the BootstrapHandler contract. It resolves the secret references of the configuration,
+// failing when one of them can't be, so it must run right after the secret handler adding the secret provider to the
+// DIC, before any other handler uses the configuration. The references of the updates of the writable configuration
+// are resolved as they are applied, an update with a reference which can't be resolved being ignored. The references
+// resolved are added to the DIC, for the configuration to be served without its secrets.
+func (b *Bootstrap) BootstrapHandler(_ context.Context, _ *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
+	lc := container.LoggingClientFrom(dic.Get)
+	resolver := NewResolver(bootstrapContainer.SecretProviderFrom(dic.Get))
+
+	resolved, err := resolver.Resolve(b.configuration, "")
+	logResolved(resolved, lc)
+	if err != nil {
+		lc.Error(fmt.Sprintf("failed to resolve the secret references of the configuration: %s", err.Error()))
+		return false
+	}
+	references := &References{values: resolved}
+
+	if configuration, ok := b.configuration.(WritableResolvable); ok {
+		configuration.SetWritableResolver(func(update interface{}) bool {
+			resolved, err := resolver.Resolve(update, writable.Section)
+			logResolved(resolved, lc)
+			if err != nil {
+				lc.Error(fmt.Sprintf("ignoring the update of the writable configuration: %s", err.Error()))
+				return false
+			}
+			references.replace(writable.Section, resolved)
+			return true
+		})
+	}
+
+	dic.Update(di.ServiceConstructorMap{
+		ReferencesName: func(get di.Get) interface{} {
+			return references
+		},
+	})
+	return true
+}
+
+// logResolved logs the names of the values resolved.
+func logResolved(resolved map[string]string, lc logger.LoggingClient) {
+	if len(resolved) == 0 {
+		return
+	}
+	names := make([]string, 0, len(resolved))
+	for name := range resolved {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	lc.Info(fmt.Sprintf("Resolved the secret references of %s", strings.Join(names, ", ")))
+}
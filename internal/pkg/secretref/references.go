@@ -0,0 +1,70 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package secretref
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// ReferencesName contains the name of the References in the DIC.
+var ReferencesName = di.TypeInstanceToName(References{})
+
+// ReferencesFrom helper function queries the DIC and returns the References, nil when the configuration has none.
+func ReferencesFrom(get di.Get) *References {
+	references, _ := get(ReferencesName).(*References)
+	return references
+}
+
+// References are the secret references resolved in the configuration, by the name of their value.
+type References struct {
+	mutex  sync.RWMutex
+	values map[string]string
+}
+
+// Redact returns a copy of configuration with the values resolved replaced by their reference, or the configuration
+// itself when none was resolved.
+func (r *References) Redact(configuration interface{}) (interface{}, error) {
+	if r == nil {
+		return configuration, nil
+	}
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return Redact(configuration, r.values)
+}
+
+// replace replaces the references of the values named under section with resolved.
+func (r *References) replace(section string, resolved map[string]string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	for name := range r.values {
+		if name == section || strings.HasPrefix(name, section+".") || strings.HasPrefix(name, section+"[") {
+			delete(r.values, name)
+		}
+	}
+	for name, reference := range resolved {
+		r.values[name] = reference
+	}
+}
+
+// EncodeConfiguration writes the configuration as JSON, with the values resolved from the secret references of the
+// DIC replaced by their reference.
+func EncodeConfiguration(configuration interface{}, w http.ResponseWriter, dic *di.Container) {
+	lc := container.LoggingClientFrom(dic.Get)
+	redacted, err := ReferencesFrom(dic.Get).Redact(configuration)
+	if err != nil {
+		lc.Error("Error redacting the secrets of the configuration: " + err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	pkg.Encode(redacted, w, lc)
+}
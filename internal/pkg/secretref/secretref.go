@@ -0,0 +1,205 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package secretref resolves the configuration values referring to secrets of the secret store, written as
+// 'secret://<path>#<key>', so that the secrets appear in plaintext neither in the configuration files nor in the
+// registry.
+package secretref
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/interfaces"
+)
+
+const (
+	// Scheme prefixes the configuration values referring to secrets
+	Scheme = "secret://"
+
+	// DefaultKey is the key of the secret referred to by a reference naming none
+	DefaultKey = "password"
+)
+
+// Reference is a configuration value referring to the Key of the secret stored under Path.
+type Reference struct {
+	Path string
+	Key  string
+}
+
+// Parse parses the value as a reference, reporting false when it isn't one. The key is the DefaultKey when omitted.
+func Parse(value string) (Reference, bool, error) {
+	if !strings.HasPrefix(value, Scheme) {
+		return Reference{}, false, nil
+	}
+
+	ref := Reference{Path: strings.TrimPrefix(value, Scheme), Key: DefaultKey}
+	if i := strings.LastIndex(ref.Path, "#"); i >= 0 {
+		ref.Path, ref.Key = ref.Path[:i], ref.Path[i+1:]
+	}
+	if ref.Path == "" {
+		return ref, true, fmt.Errorf("secret reference '%s' has no path", value)
+	}
+	if ref.Key == "" {
+		return ref, true, fmt.Errorf("secret reference '%s' has an empty key", value)
+	}
+	return ref, true, nil
+}
+
+// Resolver replaces the references among the values of a configuration with their secrets.
+type Resolver struct {
+	secrets interfaces.SecretProvider
+}
+
+// NewResolver creates a Resolver reading the secrets with secrets.
+func NewResolver(secrets interfaces.SecretProvider) *Resolver {
+	return &Resolver{secrets: secrets}
+}
+
+// Resolve replaces the references among the string values of target, a pointer to a struct named name, including the
+// values of its nested structs, slices and maps, with their secrets, and returns the references resolved by the name
+// of their value. It stops at the first reference which can't be resolved, which is left as is.
+func (r *Resolver) Resolve(target interface{}, name string) (map[string]string, error) {
+	value := reflect.ValueOf(target)
+	if value.Kind() != reflect.Ptr || value.IsNil() {
+		return nil, fmt.Errorf("can't resolve the secret references of %T, which isn't a pointer", target)
+	}
+
+	resolved := make(map[string]string)
+	secrets := make(map[string]map[string]string)
+	err := walk(value.Elem(), name, func(value string, name string) (string, bool, error) {
+		ref, ok, err := Parse(value)
+		if !ok || err != nil {
+			if err != nil {
+				err = fmt.Errorf("%s: %v", name, err)
+			}
+			return "", false, err
+		}
+
+		secret, ok := secrets[ref.Path]
+		if !ok {
+			secret, err = r.secrets.GetSecrets(ref.Path)
+			if err != nil {
+				return "", false, fmt.Errorf("unable to retrieve the secret '%s' of %s: %v", ref.Path, name, err)
+			}
+			secrets[ref.Path] = secret
+		}
+		if secret[ref.Key] == "" {
+			return "", false, fmt.Errorf("no %s stored under '%s' for %s", ref.Key, ref.Path, name)
+		}
+		resolved[name] = value
+		return secret[ref.Key], true, nil
+	})
+	return resolved, err
+}
+
+// Redact returns a copy of configuration, a pointer to a struct, with the values named in references replaced by
+// their reference, so that the secrets they were resolved to aren't disclosed. The configuration itself is returned
+// when there are no references.
+func Redact(configuration interface{}, references map[string]string) (interface{}, error) {
+	if len(references) == 0 {
+		return configuration, nil
+	}
+	value := reflect.ValueOf(configuration)
+	if value.Kind() != reflect.Ptr || value.IsNil() {
+		return nil, fmt.Errorf("can't redact the secrets of %T, which isn't a pointer", configuration)
+	}
+
+	encoded, err := json.Marshal(configuration)
+	if err != nil {
+		return nil, err
+	}
+	redacted := reflect.New(value.Type().Elem())
+	if err = json.Unmarshal(encoded, redacted.Interface()); err != nil {
+		return nil, err
+	}
+	err = walk(redacted.Elem(), "", func(value string, name string) (string, bool, error) {
+		ref, ok := references[name]
+		return ref, ok, nil
+	})
+	return redacted.Interface(), err
+}
+
+// replaceFunc returns the replacement of the string value named name, and false to leave it as is.
+type replaceFunc func(value string, name string) (string, bool, error)
+
+// walk replaces the string values of the value named name, which must be settable when it's a string, including the
+// values of its nested structs, slices and maps. It stops at the first error.
+func walk(value reflect.Value, name string, replace replaceFunc) error {
+	_, err := walkValue(value, name, replace)
+	return err
+}
+
+// walkValue walks the value, reporting whether any of its strings was replaced.
+func walkValue(value reflect.Value, name string, replace replaceFunc) (bool, error) {
+	switch value.Kind() {
+	case reflect.String:
+		replaced, ok, err := replace(value.String(), name)
+		if ok {
+			value.SetString(replaced)
+		}
+		return ok, err
+	case reflect.Ptr, reflect.Interface:
+		if value.IsNil() {
+			return false, nil
+		}
+		elem := value.Elem()
+		if value.Kind() == reflect.Interface && elem.Kind() != reflect.Ptr {
+			// the value held by an interface can't be set
+			return false, nil
+		}
+		return walkValue(elem, name, replace)
+	case reflect.Struct:
+		changed := false
+		for i := 0; i < value.NumField(); i++ {
+			if field := value.Type().Field(i); field.PkgPath == "" {
+				ok, err := walkValue(value.Field(i), join(name, field.Name), replace)
+				changed = changed || ok
+				if err != nil {
+					return changed, err
+				}
+			}
+		}
+		return changed, nil
+	case reflect.Slice, reflect.Array:
+		changed := false
+		for i := 0; i < value.Len(); i++ {
+			ok, err := walkValue(value.Index(i), fmt.Sprintf("%s[%d]", name, i), replace)
+			changed = changed || ok
+			if err != nil {
+				return changed, err
+			}
+		}
+		return changed, nil
+	case reflect.Map:
+		changed := false
+		iter := value.MapRange()
+		for iter.Next() {
+			// the values of a map can't be set, so they are walked on a copy put back when it changed
+			elem := reflect.New(value.Type().Elem()).Elem()
+			elem.Set(iter.Value())
+			ok, err := walkValue(elem, join(name, fmt.Sprint(iter.Key().Interface())), replace)
+			if ok {
+				value.SetMapIndex(iter.Key(), elem)
+				changed = true
+			}
+			if err != nil {
+				return changed, err
+			}
+		}
+		return changed, nil
+	}
+	return false, nil
+}
+
+// join names the field of the value named name.
+func join(name string, field string) string {
+	if name == "" {
+		return field
+	}
+	return name + "." + field
+}
@@ -0,0 +1,250 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package secretref
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	bootstrapContainer "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// secretsStub returns the secrets stored by path, counting the reads.
+type secretsStub struct {
+	stored map[string]map[string]string
+	reads  int
+}
+
+func (s *secretsStub) GetSecrets(path string, _ ...string) (map[string]string, error) {
+	s.reads++
+	secret, ok := s.stored[path]
+	if !ok {
+		return nil, errors.New("secret not found")
+	}
+	return secret, nil
+}
+
+type smtpInfo struct {
+	Host     string
+	Username string
+	Password string
+}
+
+type testConfiguration struct {
+	Smtp     smtpInfo
+	Clients  map[string]smtpInfo
+	Optional map[string]string
+	Topics   []string
+	Port     int
+	internal string
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected Reference
+		isRef    bool
+		isError  bool
+	}{
+		{"plain value", "password", Reference{}, false, false},
+		{"password", "secret://smtp#password", Reference{Path: "smtp", Key: "password"}, true, false},
+		{"username", "secret://mqtt/broker#username", Reference{Path: "mqtt/broker", Key: "username"}, true, false},
+		{"other key", "secret://smtp#token", Reference{Path: "smtp", Key: "token"}, true, false},
+		{"no key", "secret://smtp", Reference{Path: "smtp", Key: DefaultKey}, true, false},
+		{"no path", "secret://#password", Reference{}, true, true},
+		{"empty key", "secret://smtp#", Reference{}, true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ref, isRef, err := Parse(tt.value)
+			assert.Equal(t, tt.isRef, isRef)
+			if tt.isError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, ref)
+		})
+	}
+}
+
+func TestResolve(t *testing.T) {
+	secrets := &secretsStub{stored: map[string]map[string]string{
+		"smtp": {"username": "alerts@example.com", "password": "smtp-secret"},
+		"mqtt": {"username": "edgex", "password": "mqtt-secret", "clientid": "edgex-command"},
+	}}
+	configuration := testConfiguration{
+		Smtp:     smtpInfo{Host: "smtp.example.com", Username: "secret://smtp#username", Password: "secret://smtp#password"},
+		Clients:  map[string]smtpInfo{"Broker": {Host: "broker", Password: "secret://mqtt"}},
+		Optional: map[string]string{"Password": "secret://mqtt#password", "ClientId": "secret://mqtt#clientid"},
+		Topics:   []string{"events", "secret://mqtt#username"},
+		Port:     587,
+		internal: "secret://smtp#password",
+	}
+
+	resolved, err := NewResolver(secrets).Resolve(&configuration, "")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"Smtp.Username":           "secret://smtp#username",
+		"Smtp.Password":           "secret://smtp#password",
+		"Clients.Broker.Password": "secret://mqtt",
+		"Optional.Password":       "secret://mqtt#password",
+		"Optional.ClientId":       "secret://mqtt#clientid",
+		"Topics[1]":               "secret://mqtt#username",
+	}, resolved)
+	assert.Equal(t, smtpInfo{Host: "smtp.example.com", Username: "alerts@example.com", Password: "smtp-secret"},
+		configuration.Smtp)
+	assert.Equal(t, smtpInfo{Host: "broker", Password: "mqtt-secret"}, configuration.Clients["Broker"])
+	assert.Equal(t, map[string]string{"Password": "mqtt-secret", "ClientId": "edgex-command"}, configuration.Optional)
+	assert.Equal(t, []string{"events", "edgex"}, configuration.Topics)
+	assert.Equal(t, "secret://smtp#password", configuration.internal, "unexported fields should be left alone")
+	assert.Equal(t, 2, secrets.reads, "each secret should be read once")
+
+	resolved, err = NewResolver(secrets).Resolve(&configuration, "")
+	require.NoError(t, err)
+	assert.Empty(t, resolved)
+
+	resolved, err = NewResolver(secrets).Resolve(&smtpInfo{Password: "secret://smtp"}, "Writable")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"Writable.Password": "secret://smtp"}, resolved)
+}
+
+func TestResolveFailure(t *testing.T) {
+	secrets := &secretsStub{stored: map[string]map[string]string{
+		"smtp": {"password": "smtp-secret"},
+	}}
+
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{"missing secret", "secret://unknown#password"},
+		{"missing key", "secret://smtp#username"},
+		{"invalid reference", "secret://smtp#"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configuration := testConfiguration{Smtp: smtpInfo{Password: tt.value}}
+			_, err := NewResolver(secrets).Resolve(&configuration, "")
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "Smtp.Password")
+			assert.Equal(t, tt.value, configuration.Smtp.Password)
+		})
+	}
+
+	_, err := NewResolver(secrets).Resolve(testConfiguration{}, "")
+	assert.Error(t, err)
+}
+
+func TestRedact(t *testing.T) {
+	configuration := &testConfiguration{
+		Smtp:     smtpInfo{Host: "smtp.example.com", Username: "alerts@example.com", Password: "smtp-secret"},
+		Optional: map[string]string{"Password": "mqtt-secret", "ClientId": "command"},
+		Topics:   []string{"events", "edgex"},
+	}
+	references := map[string]string{
+		"Smtp.Password":     "secret://smtp#password",
+		"Optional.Password": "secret://mqtt",
+		"Topics[1]":         "secret://mqtt#username",
+	}
+
+	redacted, err := Redact(configuration, references)
+	require.NoError(t, err)
+	assert.Equal(t, &testConfiguration{
+		Smtp:     smtpInfo{Host: "smtp.example.com", Username: "alerts@example.com", Password: "secret://smtp#password"},
+		Optional: map[string]string{"Password": "secret://mqtt", "ClientId": "command"},
+		Topics:   []string{"events", "secret://mqtt#username"},
+	}, redacted)
+	assert.Equal(t, "smtp-secret", configuration.Smtp.Password, "the configuration should be left intact")
+	assert.Equal(t, "mqtt-secret", configuration.Optional["Password"], "the configuration should be left intact")
+
+	redacted, err = Redact(configuration, nil)
+	require.NoError(t, err)
+	assert.Same(t, configuration, redacted)
+}
+
+func TestReferencesReplace(t *testing.T) {
+	references := &References{values: map[string]string{
+		"Writable.Smtp.Password": "secret://smtp",
+		"WritableFlags":          "secret://flags",
+		"Databases.Primary":      "secret://redisdb",
+	}}
+	references.replace("Writable", map[string]string{"Writable.Token": "secret://token#value"})
+	assert.Equal(t, map[string]string{
+		"Writable.Token":    "secret://token#value",
+		"WritableFlags":     "secret://flags",
+		"Databases.Primary": "secret://redisdb",
+	}, references.values)
+
+	var none *References
+	configuration := &testConfiguration{}
+	redacted, err := none.Redact(configuration)
+	require.NoError(t, err)
+	assert.Same(t, configuration, redacted)
+}
+
+// writableConfiguration applies the updates of its writable configuration once resolved, as the services do.
+type writableConfiguration struct {
+	Writable        smtpInfo
+	Smtp            smtpInfo
+	resolveWritable func(writable interface{}) bool
+}
+
+func (c *writableConfiguration) SetWritableResolver(resolve func(writable interface{}) bool) {
+	c.resolveWritable = resolve
+}
+
+func (c *writableConfiguration) update(writable smtpInfo) {
+	if c.resolveWritable(&writable) {
+		c.Writable = writable
+	}
+}
+
+func TestBootstrapHandler(t *testing.T) {
+	secrets := &secretsStub{stored: map[string]map[string]string{
+		"smtp": {"password": "smtp-secret", "token": "smtp-token"},
+	}}
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		bootstrapContainer.SecretProviderName: func(get di.Get) interface{} {
+			return secrets
+		},
+	})
+	configuration := &writableConfiguration{
+		Writable: smtpInfo{Password: "secret://smtp"},
+		Smtp:     smtpInfo{Password: "secret://smtp#token"},
+	}
+
+	require.True(t, NewBootstrap(configuration).BootstrapHandler(context.Background(), &sync.WaitGroup{}, startup.NewStartUpTimer("unit-test"), dic))
+	assert.Equal(t, "smtp-secret", configuration.Writable.Password)
+	assert.Equal(t, "smtp-token", configuration.Smtp.Password)
+
+	configuration.update(smtpInfo{Host: "smtp.example.com", Password: "secret://smtp#token"})
+	assert.Equal(t, smtpInfo{Host: "smtp.example.com", Password: "smtp-token"}, configuration.Writable)
+
+	configuration.update(smtpInfo{Host: "ignored", Password: "secret://unknown"})
+	assert.Equal(t, "smtp.example.com", configuration.Writable.Host, "an update which can't be resolved should be ignored")
+
+	redacted, err := ReferencesFrom(dic.Get).Redact(configuration)
+	require.NoError(t, err)
+	assert.Equal(t, "secret://smtp#token", redacted.(*writableConfiguration).Writable.Password)
+	assert.Equal(t, "secret://smtp#token", redacted.(*writableConfiguration).Smtp.Password)
+	assert.Equal(t, "smtp.example.com", redacted.(*writableConfiguration).Writable.Host)
+}
@@ -0,0 +1,160 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package selftest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	pkgContainer "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/messaging"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	msgTypes "github.com/edgexfoundry/go-mod-messaging/pkg/types"
+
+	"github.com/gorilla/mux"
+)
+
+const defaultTimeout = 5 * time.Second
+
+// ApiReadyRoute reports whether the service is ready, along with the results of its self-test
+const ApiReadyRoute = clients.ApiBase + "/ready"
+
+// ReadyResponse is the response of the readiness endpoint.
+type ReadyResponse struct {
+	Ready bool `json:"ready"`
+	// Ran is when the self-test ran, in milliseconds, 0 when it's disabled
+	Ran      int64    `json:"ran"`
+	SelfTest []Result `json:"selfTest"`
+}
+
+// Bootstrap contains references to dependencies required by the BootstrapHandler.
+type Bootstrap struct {
+	router *mux.Router
+	info   *SelfTestInfo
+}
+
+// NewBootstrap is a factory method that returns an initialized Bootstrap receiver struct.
+func NewBootstrap(router *mux.Router, info *SelfTestInfo) *Bootstrap {
+	return &Bootstrap{
+		router: router,
+		info:   info,
+	}
+}
+
+// BootstrapHandler fulfills the BootstrapHandler contract. It adds the readiness endpoint and, when the self-test is
+// enabled, runs it, so it must run after the database is connected and before the service reports ready. A failed
+// test is logged and reported by the readiness endpoint, and fails the startup when FailStartup is set.
+func (b *Bootstrap) BootstrapHandler(ctx context.Context, _ *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
+	lc := container.LoggingClientFrom(dic.Get)
+	if !b.info.Enabled {
+		b.addRoute(nil, lc)
+		return true
+	}
+
+	timeout := defaultTimeout
+	if b.info.Timeout != "" {
+		var err error
+		if timeout, err = time.ParseDuration(b.info.Timeout); err != nil || timeout <= 0 {
+			lc.Error(fmt.Sprintf("invalid SelfTest Timeout '%s'", b.info.Timeout))
+			return false
+		}
+	}
+
+	var tests []Test
+	if len(b.info.Collections) > 0 {
+		database := dic.Get(pkgContainer.DBClientInterfaceName)
+		for _, collection := range b.info.Collections {
+			tests = append(tests, DatabaseTest(collection, database))
+		}
+	}
+	if b.info.SecretPath != "" {
+		tests = append(tests, SecretTest(b.info.SecretPath, container.CredentialsProviderFrom(dic.Get)))
+	}
+	if info := b.info.MessageBus; info.Enabled {
+		msgClient, err := connectMessageBus(info)
+		if err != nil {
+			tests = append(tests, failedTest("messagebus."+info.Topic, err))
+		} else {
+			defer func() {
+				if err := msgClient.Disconnect(); err != nil {
+					lc.Warn(fmt.Sprintf("failed to disconnect from the self-test message bus: %s", err.Error()))
+				}
+			}()
+			tests = append(tests, MessageBusTest(info.Topic, msgClient))
+		}
+	}
+
+	suite := NewSuite(tests, timeout)
+	passed := true
+	for _, result := range suite.Run(ctx) {
+		if !result.Passed {
+			passed = false
+			lc.Error(fmt.Sprintf("self-test %s failed in %.1fms: %s", result.Name, result.DurationMs, result.Error))
+			continue
+		}
+		lc.Info(fmt.Sprintf("Self-test %s passed in %.1fms", result.Name, result.DurationMs))
+	}
+	b.addRoute(suite, lc)
+	return passed || !b.info.FailStartup
+}
+
+// addRoute adds the readiness endpoint reporting the results of the suite.
+func (b *Bootstrap) addRoute(suite *Suite, lc logger.LoggingClient) {
+	b.router.HandleFunc(ApiReadyRoute, func(w http.ResponseWriter, r *http.Request) {
+		Ready(w, suite, lc)
+	}).Methods(http.MethodGet)
+}
+
+// Ready answers whether the service is ready, with the results of the self-test, with 503 when a test failed.
+func Ready(w http.ResponseWriter, suite *Suite, lc logger.LoggingClient) {
+	ran, results := suite.Results()
+	response := ReadyResponse{Ready: suite.Ready(), Ran: ran, SelfTest: results}
+
+	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+	if !response.Ready {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	pkg.Encode(response, w, lc)
+}
+
+// connectMessageBus connects the client sending the loopback message, publishing and subscribing on the same host.
+func connectMessageBus(info MessageBusProbeInfo) (messaging.MessageClient, error) {
+	host := msgTypes.HostInfo{Host: info.Host, Port: info.Port, Protocol: info.Protocol}
+	msgClient, err := messaging.NewMessageClient(msgTypes.MessageBusConfig{
+		PublishHost:   host,
+		SubscribeHost: host,
+		Type:          info.Type,
+		Optional:      info.Optional,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the messaging client: %v", err)
+	}
+	if err = msgClient.Connect(); err != nil {
+		return nil, fmt.Errorf("failed to connect to the message bus: %v", err)
+	}
+	return msgClient, nil
+}
+
+// failedTest is the test of a dependency which couldn't even be set up.
+func failedTest(name string, err error) Test {
+	return Test{
+		Name: name,
+		Run: func(context.Context) error {
+			return err
+		},
+	}
+}
@@ -0,0 +1,36 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package selftest
+
+// SelfTestInfo provides properties related to testing the dependencies of the service at startup, the results being
+// reported by the readiness endpoint
+type SelfTestInfo struct {
+	// Enabled indicates whether the self-test runs at startup
+	Enabled bool
+	// Collections of the database a probe record is written to, read back from and deleted from
+	Collections []string
+	// SecretPath is the path of a secret the service must be able to read from the secret store, none when empty
+	SecretPath string
+	// MessageBus sends a loopback message through the message bus
+	MessageBus MessageBusProbeInfo
+	// Timeout of every test, e.g. '5s'
+	Timeout string
+	// FailStartup indicates whether the service fails to start when a test fails, instead of reporting not ready
+	FailStartup bool
+}
+
+// MessageBusProbeInfo provides properties related to sending a loopback message through the message bus
+type MessageBusProbeInfo struct {
+	Enabled bool
+	// Type is the message bus implementation, e.g. 'zero', 'mqtt' or 'redisstreams'
+	Type     string
+	Protocol string
+	Host     string
+	Port     int
+	// Topic the loopback message is published on and received from, e.g. 'edgex/selftest'
+	Topic    string
+	Optional map[string]string
+}
@@ -0,0 +1,134 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package selftest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+
+	msgTypes "github.com/edgexfoundry/go-mod-messaging/pkg/types"
+
+	"github.com/google/uuid"
+)
+
+// loopbackInterval is how often the loopback message is published until it's received, as the subscription may take
+// a while to be established
+const loopbackInterval = 200 * time.Millisecond
+
+// Prober is implemented by the database clients able to write a probe record to a collection, read it back and
+// delete it, as the Redis one is.
+type Prober interface {
+	Probe(collection string) error
+}
+
+// CredentialsProvider retrieves the username and password stored under a path of the secret store, as the bootstrap
+// credentials provider does for the databases.
+type CredentialsProvider interface {
+	GetDatabaseCredentials(database bootstrapConfig.Database) (bootstrapConfig.Credentials, error)
+}
+
+// messageClient publishes and receives messages on the message bus, as the message client does.
+type messageClient interface {
+	Publish(message msgTypes.MessageEnvelope, topic string) error
+	Subscribe(topics []msgTypes.TopicChannel, messageErrors chan error) error
+}
+
+// withContext runs test until ctx is done, for the tests unaware of ctx to be bounded by its timeout as well.
+func withContext(ctx context.Context, test func() error) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- test()
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// DatabaseTest writes a probe record to the collection of the database, reads it back and deletes it. It fails when
+// the database can't probe its collections.
+func DatabaseTest(collection string, database interface{}) Test {
+	return Test{
+		Name: "database." + collection,
+		Run: func(ctx context.Context) error {
+			prober, ok := database.(Prober)
+			if !ok {
+				return errors.New("the database doesn't support probe records")
+			}
+			return withContext(ctx, func() error {
+				return prober.Probe(collection)
+			})
+		},
+	}
+}
+
+// SecretTest reads the secret stored under the path of the secret store. When security is disabled the credentials
+// provider returns empty credentials, so the test fails.
+func SecretTest(path string, credentials CredentialsProvider) Test {
+	return Test{
+		Name: "secret." + path,
+		Run: func(ctx context.Context) error {
+			return withContext(ctx, func() error {
+				secret, err := credentials.GetDatabaseCredentials(bootstrapConfig.Database{Type: path})
+				if err != nil {
+					return err
+				}
+				if secret.Username == "" && secret.Password == "" {
+					return fmt.Errorf("no secret stored under '%s'", path)
+				}
+				return nil
+			})
+		},
+	}
+}
+
+// MessageBusTest publishes a message on the topic of the message bus and waits for the client to receive it back.
+func MessageBusTest(topic string, client messageClient) Test {
+	return Test{
+		Name: "messagebus." + topic,
+		Run: func(ctx context.Context) error {
+			messages := make(chan msgTypes.MessageEnvelope, 16)
+			messageErrors := make(chan error, 1)
+			if err := client.Subscribe([]msgTypes.TopicChannel{{Topic: topic, Messages: messages}}, messageErrors); err != nil {
+				return fmt.Errorf("failed to subscribe to topic '%s': %v", topic, err)
+			}
+
+			correlationID := uuid.New().String()
+			ticker := time.NewTicker(loopbackInterval)
+			defer ticker.Stop()
+			for {
+				err := client.Publish(msgTypes.MessageEnvelope{
+					CorrelationID: correlationID,
+					ContentType:   clients.ContentTypeText,
+					Payload:       []byte("selftest"),
+				}, topic)
+				if err != nil {
+					return fmt.Errorf("failed to publish on topic '%s': %v", topic, err)
+				}
+
+				select {
+				case <-ctx.Done():
+					return fmt.Errorf("the loopback message wasn't received: %v", ctx.Err())
+				case err := <-messageErrors:
+					return fmt.Errorf("failed to receive the loopback message: %v", err)
+				case message := <-messages:
+					if message.CorrelationID == correlationID {
+						return nil
+					}
+				case <-ticker.C:
+				}
+			}
+		},
+	}
+}
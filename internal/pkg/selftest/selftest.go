@@ -0,0 +1,120 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package selftest tests the dependencies of a service at startup, such as writing to its database, reading its
+// secrets or sending through the message bus, so that a misconfiguration is caught before traffic arrives.
+package selftest
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Test exercises a dependency of the service, failing when the dependency can't be used.
+type Test struct {
+	Name string
+	Run  func(ctx context.Context) error
+}
+
+// Result is the outcome of a Test.
+type Result struct {
+	Name   string `json:"name"`
+	Passed bool   `json:"passed"`
+	// DurationMs is how long the test took in milliseconds, up to the timeout when it timed out
+	DurationMs float64 `json:"durationMs"`
+	Error      string  `json:"error,omitempty"`
+}
+
+// Suite runs the tests of the service and keeps their results. A nil Suite, when the self-test is disabled, has no
+// results and is ready.
+type Suite struct {
+	tests   []Test
+	timeout time.Duration
+
+	mutex   sync.RWMutex
+	ran     int64
+	results []Result
+}
+
+// NewSuite creates a Suite bounding each of the tests by timeout.
+func NewSuite(tests []Test, timeout time.Duration) *Suite {
+	return &Suite{
+		tests:   tests,
+		timeout: timeout,
+		results: []Result{},
+	}
+}
+
+// Run runs the tests one after the other, so that their results don't depend on each other, and returns the results.
+func (s *Suite) Run(ctx context.Context) []Result {
+	results := make([]Result, len(s.tests))
+	for i, test := range s.tests {
+		results[i] = s.run(ctx, test)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.ran = time.Now().UnixNano() / int64(time.Millisecond)
+	s.results = results
+	return results
+}
+
+// run runs the test, until the timeout when it's unaware of ctx.
+func (s *Suite) run(ctx context.Context, test Test) Result {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	done := make(chan error, 1)
+	start := time.Now()
+	go func() {
+		done <- test.Run(ctx)
+	}()
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	result := Result{
+		Name:       test.Name,
+		Passed:     err == nil,
+		DurationMs: float64(time.Since(start)) / float64(time.Millisecond),
+	}
+	if err != nil {
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// Results returns when the tests last ran, in milliseconds, 0 when they didn't run, and their results.
+func (s *Suite) Results() (int64, []Result) {
+	if s == nil {
+		return 0, []Result{}
+	}
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.ran, s.results
+}
+
+// Ready reports whether the tests ran and all passed.
+func (s *Suite) Ready() bool {
+	if s == nil {
+		return true
+	}
+
+	ran, results := s.Results()
+	if ran == 0 {
+		return false
+	}
+	for _, result := range results {
+		if !result.Passed {
+			return false
+		}
+	}
+	return true
+}
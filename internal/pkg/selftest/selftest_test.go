@@ -0,0 +1,152 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package selftest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	msgTypes "github.com/edgexfoundry/go-mod-messaging/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type proberStub func(collection string) error
+
+func (p proberStub) Probe(collection string) error {
+	return p(collection)
+}
+
+type credentialsStub map[string]bootstrapConfig.Credentials
+
+func (s credentialsStub) GetDatabaseCredentials(database bootstrapConfig.Database) (bootstrapConfig.Credentials, error) {
+	credentials, ok := s[database.Type]
+	if !ok {
+		return bootstrapConfig.Credentials{}, errors.New("secret not found")
+	}
+	return credentials, nil
+}
+
+// loopbackClient delivers the messages published on a topic to its subscriber, dropping the first ones as a
+// subscription being established would.
+type loopbackClient struct {
+	dropped  int
+	channels map[string]chan msgTypes.MessageEnvelope
+}
+
+func (c *loopbackClient) Subscribe(topics []msgTypes.TopicChannel, _ chan error) error {
+	for _, topic := range topics {
+		c.channels[topic.Topic] = topic.Messages
+	}
+	return nil
+}
+
+func (c *loopbackClient) Publish(message msgTypes.MessageEnvelope, topic string) error {
+	if c.dropped > 0 {
+		c.dropped--
+		return nil
+	}
+	if messages, ok := c.channels[topic]; ok {
+		messages <- message
+	}
+	return nil
+}
+
+func TestSuite(t *testing.T) {
+	blocked := make(chan struct{})
+	defer close(blocked)
+
+	prober := proberStub(func(collection string) error {
+		if collection == "readonly" {
+			return errors.New("READONLY You can't write against a read only replica")
+		}
+		return nil
+	})
+	suite := NewSuite([]Test{
+		DatabaseTest("event", prober),
+		DatabaseTest("readonly", prober),
+		DatabaseTest("event", struct{}{}),
+		SecretTest("smtp", credentialsStub{"smtp": {Password: "secret"}}),
+		SecretTest("mqtt", credentialsStub{}),
+		MessageBusTest("edgex/selftest", &loopbackClient{dropped: 1, channels: map[string]chan msgTypes.MessageEnvelope{}}),
+		MessageBusTest("edgex/selftest", &loopbackClient{channels: map[string]chan msgTypes.MessageEnvelope{}}),
+		{Name: "slow", Run: func(context.Context) error {
+			<-blocked
+			return nil
+		}},
+	}, 500*time.Millisecond)
+
+	ran, results := suite.Results()
+	assert.Zero(t, ran)
+	assert.Empty(t, results)
+	assert.False(t, suite.Ready(), "the suite shouldn't be ready before it ran")
+
+	results = suite.Run(context.Background())
+	require.Len(t, results, 8)
+	expected := []struct {
+		name   string
+		passed bool
+	}{
+		{"database.event", true},
+		{"database.readonly", false},
+		{"database.event", false},
+		{"secret.smtp", true},
+		{"secret.mqtt", false},
+		{"messagebus.edgex/selftest", true},
+		{"messagebus.edgex/selftest", true},
+		{"slow", false},
+	}
+	for i, e := range expected {
+		assert.Equal(t, e.name, results[i].Name)
+		assert.Equal(t, e.passed, results[i].Passed, results[i].Name)
+		assert.Equal(t, e.passed, results[i].Error == "", results[i].Name)
+	}
+	assert.GreaterOrEqual(t, results[7].DurationMs, float64(500))
+
+	ran, _ = suite.Results()
+	assert.NotZero(t, ran)
+	assert.False(t, suite.Ready())
+}
+
+func TestReady(t *testing.T) {
+	passing := NewSuite([]Test{{Name: "ok", Run: func(context.Context) error { return nil }}}, time.Second)
+	passing.Run(context.Background())
+	failing := NewSuite([]Test{{Name: "down", Run: func(context.Context) error { return errors.New("down") }}}, time.Second)
+	failing.Run(context.Background())
+
+	tests := []struct {
+		name           string
+		suite          *Suite
+		expectedStatus int
+		expectedCount  int
+	}{
+		{"disabled", nil, http.StatusOK, 0},
+		{"passed", passing, http.StatusOK, 1},
+		{"failed", failing, http.StatusServiceUnavailable, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			Ready(rr, tt.suite, logger.NewMockClient())
+			require.Equal(t, tt.expectedStatus, rr.Code)
+
+			var response ReadyResponse
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+			assert.Equal(t, tt.expectedStatus == http.StatusOK, response.Ready)
+			assert.Len(t, response.SelfTest, tt.expectedCount)
+		})
+	}
+}
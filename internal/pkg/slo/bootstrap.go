@@ -0,0 +1,128 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package slo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/interfaces"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/notifications"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/urlclient/local"
+
+	"github.com/gorilla/mux"
+)
+
+// ApiSLORoute returns the compliance of the service's endpoints with their objectives
+const ApiSLORoute = clients.ApiBase + "/slo"
+
+const defaultCheckInterval = time.Minute
+
+// Bootstrap contains references to dependencies required by the BootstrapHandler.
+type Bootstrap struct {
+	router        *mux.Router
+	serviceKey    string
+	configuration interfaces.Configuration
+	info          *SLOInfo
+}
+
+// NewBootstrap is a factory method that returns an initialized Bootstrap receiver struct.
+func NewBootstrap(router *mux.Router, serviceKey string, configuration interfaces.Configuration, info *SLOInfo) *Bootstrap {
+	return &Bootstrap{
+		router:        router,
+		serviceKey:    serviceKey,
+		configuration: configuration,
+		info:          info,
+	}
+}
+
+// BootstrapHandler fulfills the BootstrapHandler contract. When the objectives are enabled, it starts tracking the
+// requests answered by the router and checking the burn rates of the error budgets in the background. Alerts are
+// sent to support-notifications when the Notifications client is configured, and logged otherwise.
+func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
+	if !b.info.Enabled {
+		return true
+	}
+
+	lc := container.LoggingClientFrom(dic.Get)
+	tracker, err := NewTracker(*b.info)
+	if err != nil {
+		lc.Error(err.Error())
+		return false
+	}
+	checkInterval := defaultCheckInterval
+	if b.info.CheckInterval != "" {
+		if checkInterval, err = time.ParseDuration(b.info.CheckInterval); err != nil || checkInterval <= 0 {
+			lc.Error(fmt.Sprintf("invalid SLO CheckInterval '%s'", b.info.CheckInterval))
+			return false
+		}
+	}
+
+	b.router.Use(tracker.Middleware)
+	b.router.HandleFunc(ApiSLORoute, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+		pkg.Encode(tracker.Status(), w, lc)
+	}).Methods(http.MethodGet)
+
+	var nc notifications.NotificationsClient
+	if client, ok := b.configuration.GetBootstrap().Clients["Notifications"]; ok {
+		nc = notifications.NewNotificationsClient(local.New(client.Url() + clients.ApiNotificationRoute))
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(checkInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				for _, alert := range tracker.CheckBurnRates() {
+					b.raise(ctx, alert, nc, lc)
+				}
+			}
+		}
+	}()
+
+	lc.Info(fmt.Sprintf("Tracking %d service level objectives", len(b.info.Objectives)))
+	return true
+}
+
+// raise logs the alert and sends it to support-notifications
+func (b *Bootstrap) raise(ctx context.Context, alert Alert, nc notifications.NotificationsClient, lc logger.LoggingClient) {
+	content := fmt.Sprintf("The error budget of the objective %s of %s (%s %s) burns %.1f times faster than sustainable, above the threshold of %.1f",
+		alert.Objective, b.serviceKey, alert.Method, alert.Path, alert.BurnRate, alert.Threshold)
+	lc.Warn(content)
+	if nc == nil {
+		return
+	}
+
+	err := nc.SendNotification(ctx, notifications.Notification{
+		Slug:        fmt.Sprintf("slo-%s-%s-%d", b.serviceKey, alert.Objective, time.Now().UnixNano()/int64(time.Millisecond)),
+		Sender:      b.serviceKey,
+		Category:    notifications.SW_HEALTH,
+		Severity:    notifications.CRITICAL,
+		Description: fmt.Sprintf("Service level objective %s of %s at risk", alert.Objective, b.serviceKey),
+		Content:     content,
+		Labels:      []string{"slo", b.serviceKey},
+	})
+	if err != nil {
+		lc.Error(fmt.Sprintf("unable to send the alert of the objective %s: %v", alert.Objective, err))
+	}
+}
@@ -0,0 +1,36 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package slo
+
+// SLOInfo provides properties related to tracking the service level objectives of the service's endpoints
+type SLOInfo struct {
+	// Enabled indicates whether the objectives are tracked
+	Enabled bool
+	// Window is the period over which the compliance with the objectives is computed, e.g. '24h'
+	Window string
+	// AlertWindow is the period over which the burn rate of the error budget is computed for alerting, e.g. '1h'
+	AlertWindow string
+	// BurnRateThreshold is the burn rate raising an alert, i.e. how many times faster than sustainable the error
+	// budget is consumed
+	BurnRateThreshold float64
+	// CheckInterval is how often the burn rates are checked, e.g. '1m'
+	CheckInterval string
+	// Objectives are keyed by their name
+	Objectives map[string]ObjectiveInfo
+}
+
+// ObjectiveInfo describes the objective of a single endpoint
+type ObjectiveInfo struct {
+	// Method is the HTTP method of the endpoint, any method when empty
+	Method string
+	// Path is the route template of the endpoint, e.g. '/api/v1/event/device/{deviceId}/{limit}'
+	Path string
+	// Target is the fraction of requests which have to be good, e.g. 0.99
+	Target float64
+	// Latency is the duration a good request is answered within, e.g. '500ms'; any duration is good when empty.
+	// Requests answered with a 5xx status code are never good.
+	Latency string
+}
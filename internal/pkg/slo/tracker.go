@@ -0,0 +1,241 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package slo tracks the compliance of a service's endpoints with their service level objectives, from the requests
+// the service answers, and alerts when the error budget of an objective burns too fast.
+package slo
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// bucketDuration is the granularity the requests are counted with
+	bucketDuration = time.Minute
+	// shortWindowRatio is the ratio of the alert window over which the burn rate has to exceed the threshold as well,
+	// so that an alert is not raised anymore once the budget stopped burning
+	shortWindowRatio = 12
+	// minAlertRequests is the number of requests in the alert window below which no alert is raised, to avoid
+	// alerting on a handful of failed requests
+	minAlertRequests = 10
+)
+
+// ObjectiveStatus is the compliance of an endpoint with its objective
+type ObjectiveStatus struct {
+	Name   string  `json:"name"`
+	Method string  `json:"method,omitempty"`
+	Path   string  `json:"path"`
+	Target float64 `json:"target"`
+	// Requests and BadRequests are counted over the compliance window
+	Requests    int     `json:"requests"`
+	BadRequests int     `json:"badRequests"`
+	Compliance  float64 `json:"compliance"`
+	// ErrorBudgetRemaining is the fraction of the error budget of the compliance window not consumed yet; it is
+	// negative once the objective is missed
+	ErrorBudgetRemaining float64 `json:"errorBudgetRemaining"`
+	// BurnRate is computed over the alert window
+	BurnRate float64 `json:"burnRate"`
+	Alerting bool    `json:"alerting"`
+}
+
+// Alert is raised when the error budget of an objective starts burning too fast
+type Alert struct {
+	Objective string
+	Method    string
+	Path      string
+	BurnRate  float64
+	Threshold float64
+}
+
+type bucket struct {
+	index int64
+	total int
+	bad   int
+}
+
+type objective struct {
+	name     string
+	method   string
+	path     string
+	target   float64
+	latency  time.Duration
+	buckets  []bucket
+	alerting bool
+}
+
+// Tracker counts the good and bad requests of each objective
+type Tracker struct {
+	window            time.Duration
+	alertWindow       time.Duration
+	burnRateThreshold float64
+	now               func() time.Time
+
+	mutex      sync.Mutex
+	objectives []*objective
+}
+
+// NewTracker creates a Tracker for the configured objectives
+func NewTracker(info SLOInfo) (*Tracker, error) {
+	window, err := time.ParseDuration(info.Window)
+	if err != nil || window < bucketDuration {
+		return nil, fmt.Errorf("invalid SLO Window '%s', it must be at least %s", info.Window, bucketDuration)
+	}
+	alertWindow, err := time.ParseDuration(info.AlertWindow)
+	if err != nil || alertWindow < bucketDuration || alertWindow > window {
+		return nil, fmt.Errorf("invalid SLO AlertWindow '%s', it must be between %s and the Window", info.AlertWindow, bucketDuration)
+	}
+	if info.BurnRateThreshold <= 0 {
+		return nil, fmt.Errorf("invalid SLO BurnRateThreshold %g, it must be positive", info.BurnRateThreshold)
+	}
+
+	t := &Tracker{
+		window:            window,
+		alertWindow:       alertWindow,
+		burnRateThreshold: info.BurnRateThreshold,
+		now:               time.Now,
+	}
+	for name, o := range info.Objectives {
+		if o.Path == "" {
+			return nil, fmt.Errorf("objective %s has no Path", name)
+		}
+		if o.Target <= 0 || o.Target >= 1 {
+			return nil, fmt.Errorf("objective %s has an invalid Target %g, it must be between 0 and 1 exclusive", name, o.Target)
+		}
+		var latency time.Duration
+		if o.Latency != "" {
+			if latency, err = time.ParseDuration(o.Latency); err != nil {
+				return nil, fmt.Errorf("objective %s has an invalid Latency '%s': %v", name, o.Latency, err)
+			}
+		}
+		t.objectives = append(t.objectives, &objective{
+			name:    name,
+			method:  strings.ToUpper(o.Method),
+			path:    o.Path,
+			target:  o.Target,
+			latency: latency,
+			buckets: make([]bucket, bucketsIn(window)),
+		})
+	}
+	sort.Slice(t.objectives, func(i, j int) bool { return t.objectives[i].name < t.objectives[j].name })
+	return t, nil
+}
+
+// bucketsIn returns the number of buckets spanning the duration
+func bucketsIn(d time.Duration) int {
+	return int(math.Ceil(float64(d) / float64(bucketDuration)))
+}
+
+// Record counts a request answered with the status code in the duration against the objectives of its endpoint
+func (t *Tracker) Record(method string, path string, statusCode int, duration time.Duration) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	index := t.now().UnixNano() / int64(bucketDuration)
+	for _, o := range t.objectives {
+		if o.path != path || (o.method != "" && o.method != method) {
+			continue
+		}
+		b := &o.buckets[index%int64(len(o.buckets))]
+		if b.index != index {
+			*b = bucket{index: index}
+		}
+		b.total++
+		if statusCode >= http.StatusInternalServerError || (o.latency > 0 && duration > o.latency) {
+			b.bad++
+		}
+	}
+}
+
+// count sums the requests of the objective over the last buckets
+func (o *objective) count(now int64, buckets int) (total int, bad int) {
+	for _, b := range o.buckets {
+		if b.index > now-int64(buckets) && b.index <= now {
+			total += b.total
+			bad += b.bad
+		}
+	}
+	return total, bad
+}
+
+// burnRate is how many times faster than sustainable over the compliance window the error budget is consumed
+func (o *objective) burnRate(total int, bad int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(bad) / float64(total) / (1 - o.target)
+}
+
+// Status returns the compliance of every objective
+func (t *Tracker) Status() []ObjectiveStatus {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	now := t.now().UnixNano() / int64(bucketDuration)
+	statuses := make([]ObjectiveStatus, len(t.objectives))
+	for i, o := range t.objectives {
+		total, bad := o.count(now, bucketsIn(t.window))
+		alertTotal, alertBad := o.count(now, bucketsIn(t.alertWindow))
+		status := ObjectiveStatus{
+			Name:                 o.name,
+			Method:               o.method,
+			Path:                 o.path,
+			Target:               o.target,
+			Requests:             total,
+			BadRequests:          bad,
+			Compliance:           1,
+			ErrorBudgetRemaining: 1,
+			BurnRate:             o.burnRate(alertTotal, alertBad),
+			Alerting:             o.alerting,
+		}
+		if total > 0 {
+			status.Compliance = float64(total-bad) / float64(total)
+			status.ErrorBudgetRemaining = 1 - o.burnRate(total, bad)
+		}
+		statuses[i] = status
+	}
+	return statuses
+}
+
+// CheckBurnRates returns an alert for every objective whose error budget started burning faster than the threshold,
+// both over the alert window and over the last twelfth of it. An objective alerts again only once it recovered.
+func (t *Tracker) CheckBurnRates() []Alert {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	now := t.now().UnixNano() / int64(bucketDuration)
+	alertBuckets := bucketsIn(t.alertWindow)
+	shortBuckets := alertBuckets / shortWindowRatio
+	if shortBuckets < 1 {
+		shortBuckets = 1
+	}
+
+	var alerts []Alert
+	for _, o := range t.objectives {
+		total, bad := o.count(now, alertBuckets)
+		shortTotal, shortBad := o.count(now, shortBuckets)
+		burnRate := o.burnRate(total, bad)
+		burning := total >= minAlertRequests &&
+			burnRate >= t.burnRateThreshold &&
+			o.burnRate(shortTotal, shortBad) >= t.burnRateThreshold
+
+		if burning && !o.alerting {
+			alerts = append(alerts, Alert{
+				Objective: o.name,
+				Method:    o.method,
+				Path:      o.path,
+				BurnRate:  burnRate,
+				Threshold: t.burnRateThreshold,
+			})
+		}
+		o.alerting = burning
+	}
+	return alerts
+}
@@ -0,0 +1,128 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package slo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPath = "/api/v1/event/{id}"
+
+func newTestTracker(t *testing.T) (*Tracker, func(time.Duration)) {
+	tracker, err := NewTracker(SLOInfo{
+		Window:            "24h",
+		AlertWindow:       "1h",
+		BurnRateThreshold: 10,
+		Objectives: map[string]ObjectiveInfo{
+			"GetEvent": {Method: "get", Path: testPath, Target: 0.99, Latency: "100ms"},
+		},
+	})
+	require.NoError(t, err)
+	now := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+	tracker.now = func() time.Time { return now }
+	return tracker, func(d time.Duration) { now = now.Add(d) }
+}
+
+func record(tracker *Tracker, good int, bad int) {
+	for i := 0; i < good; i++ {
+		tracker.Record(http.MethodGet, testPath, http.StatusOK, time.Millisecond)
+	}
+	for i := 0; i < bad; i++ {
+		tracker.Record(http.MethodGet, testPath, http.StatusInternalServerError, time.Millisecond)
+	}
+}
+
+func TestNewTrackerValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		info SLOInfo
+	}{
+		{"invalid window", SLOInfo{Window: "soon", AlertWindow: "1h", BurnRateThreshold: 1}},
+		{"alert window longer than window", SLOInfo{Window: "1h", AlertWindow: "2h", BurnRateThreshold: 1}},
+		{"no burn rate threshold", SLOInfo{Window: "24h", AlertWindow: "1h"}},
+		{"target of 1", SLOInfo{Window: "24h", AlertWindow: "1h", BurnRateThreshold: 1,
+			Objectives: map[string]ObjectiveInfo{"o": {Path: testPath, Target: 1}}}},
+		{"invalid latency", SLOInfo{Window: "24h", AlertWindow: "1h", BurnRateThreshold: 1,
+			Objectives: map[string]ObjectiveInfo{"o": {Path: testPath, Target: 0.9, Latency: "fast"}}}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := NewTracker(testCase.info)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestTrackerStatus(t *testing.T) {
+	tracker, advance := newTestTracker(t)
+
+	record(tracker, 95, 3)
+	tracker.Record(http.MethodGet, testPath, http.StatusOK, time.Second)
+	tracker.Record(http.MethodPost, testPath, http.StatusInternalServerError, time.Millisecond)
+	tracker.Record(http.MethodGet, "/api/v1/other", http.StatusInternalServerError, time.Millisecond)
+
+	status := tracker.Status()
+	require.Len(t, status, 1)
+	assert.Equal(t, 99, status[0].Requests)
+	assert.Equal(t, 4, status[0].BadRequests, "slow requests should count as bad")
+	assert.InDelta(t, 95.0/99, status[0].Compliance, 1e-9)
+	assert.True(t, status[0].ErrorBudgetRemaining < 0, "the objective should be missed")
+
+	// requests older than the window are forgotten
+	advance(25 * time.Hour)
+	status = tracker.Status()
+	assert.Equal(t, 0, status[0].Requests)
+	assert.Equal(t, 1.0, status[0].Compliance)
+}
+
+func TestTrackerCheckBurnRates(t *testing.T) {
+	tracker, advance := newTestTracker(t)
+
+	record(tracker, 4, 5)
+	assert.Empty(t, tracker.CheckBurnRates(), "too few requests should not raise an alert")
+
+	record(tracker, 81, 10)
+	alerts := tracker.CheckBurnRates()
+	require.Len(t, alerts, 1)
+	assert.Equal(t, "GetEvent", alerts[0].Objective)
+	assert.InDelta(t, 15.0, alerts[0].BurnRate, 1e-9)
+	assert.Empty(t, tracker.CheckBurnRates(), "an objective should alert once while burning")
+
+	// the budget stopped burning recently: the alert clears although the alert window still burns
+	advance(10 * time.Minute)
+	record(tracker, 10, 0)
+	assert.Empty(t, tracker.CheckBurnRates())
+	assert.False(t, tracker.Status()[0].Alerting)
+
+	record(tracker, 0, 20)
+	assert.Len(t, tracker.CheckBurnRates(), 1)
+}
+
+func TestMiddleware(t *testing.T) {
+	tracker, _ := newTestTracker(t)
+	router := mux.NewRouter()
+	router.Use(tracker.Middleware)
+	router.HandleFunc(testPath, func(w http.ResponseWriter, r *http.Request) {
+		if mux.Vars(r)["id"] == "broken" {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}).Methods(http.MethodGet)
+
+	for _, id := range []string{"1", "2", "broken"} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/v1/event/"+id, nil))
+	}
+
+	status := tracker.Status()
+	assert.Equal(t, 3, status[0].Requests)
+	assert.Equal(t, 1, status[0].BadRequests)
+}
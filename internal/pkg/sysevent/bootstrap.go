@@ -0,0 +1,151 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sysevent
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	pkgContainer "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/messaging"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	v2 "github.com/edgexfoundry/go-mod-core-contracts/v2"
+
+	msgTypes "github.com/edgexfoundry/go-mod-messaging/pkg/types"
+
+	"github.com/gorilla/mux"
+)
+
+// ApiSystemEventsRoute returns the recent history of the system events of the service
+const ApiSystemEventsRoute = v2.ApiBase + "/system/events"
+
+const defaultCheckInterval = 10 * time.Second
+
+// Bootstrap contains references to dependencies required by the BootstrapHandler.
+type Bootstrap struct {
+	router     *mux.Router
+	serviceKey string
+	writable   interface{}
+	info       *SystemEventsInfo
+}
+
+// NewBootstrap is a factory method that returns an initialized Bootstrap receiver struct.
+func NewBootstrap(router *mux.Router, serviceKey string, writable interface{}, info *SystemEventsInfo) *Bootstrap {
+	return &Bootstrap{
+		router:     router,
+		serviceKey: serviceKey,
+		writable:   writable,
+		info:       info,
+	}
+}
+
+// BootstrapHandler fulfills the BootstrapHandler contract. When the system events are enabled, it records the start
+// of the service, then checks its configuration and health in the background until it stops, and adds the endpoint
+// returning their history. It must run after the database is connected.
+func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, startupTimer startup.Timer, dic *di.Container) bool {
+	if !b.info.Enabled {
+		return true
+	}
+
+	lc := container.LoggingClientFrom(dic.Get)
+	checkInterval := defaultCheckInterval
+	if b.info.CheckInterval != "" {
+		var err error
+		if checkInterval, err = time.ParseDuration(b.info.CheckInterval); err != nil || checkInterval <= 0 {
+			lc.Error(fmt.Sprintf("invalid SystemEvents CheckInterval '%s'", b.info.CheckInterval))
+			return false
+		}
+	}
+
+	recorder, err := NewRecorder(b.serviceKey, b.info.HistorySize, b.info.File, lc)
+	if err != nil {
+		lc.Error(err.Error())
+		return false
+	}
+	var msgClient messaging.MessageClient
+	if info := b.info.MessageBus; info.Enabled {
+		if msgClient, err = connectMessageBus(info, startupTimer, lc); err != nil {
+			lc.Error(err.Error())
+			return false
+		}
+		topic := info.Topic
+		if topic == "" {
+			topic = DefaultTopic
+		}
+		recorder.PublishTo(topic, msgClient)
+	}
+
+	database, _ := dic.Get(pkgContainer.DBClientInterfaceName).(Pinger)
+	watcher := NewWatcher(recorder, b.writable, database)
+	recorder.Record(Start, "")
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(checkInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				watcher.Check()
+			case <-ctx.Done():
+				recorder.Record(Stop, "")
+				if msgClient != nil {
+					if err := msgClient.Disconnect(); err != nil {
+						lc.Warn(fmt.Sprintf("failed to disconnect from the system events message bus: %s", err.Error()))
+					}
+				}
+				return
+			}
+		}
+	}()
+
+	b.router.HandleFunc(ApiSystemEventsRoute, func(w http.ResponseWriter, r *http.Request) {
+		Events(w, recorder, lc)
+	}).Methods(http.MethodGet)
+	return true
+}
+
+// Events answers the history of the system events, from the oldest to the most recent.
+func Events(w http.ResponseWriter, recorder *Recorder, lc logger.LoggingClient) {
+	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+	pkg.Encode(recorder.Events(), w, lc)
+}
+
+// connectMessageBus connects the client publishing the events, retrying until the startup timer elapses.
+func connectMessageBus(info MessageBusInfo, startupTimer startup.Timer, lc logger.LoggingClient) (messaging.MessageClient, error) {
+	msgClient, err := messaging.NewMessageClient(
+		msgTypes.MessageBusConfig{
+			PublishHost: msgTypes.HostInfo{
+				Host:     info.Host,
+				Port:     info.Port,
+				Protocol: info.Protocol,
+			},
+			Type:     info.Type,
+			Optional: info.Optional,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the system events messaging client: %v", err)
+	}
+	for startupTimer.HasNotElapsed() {
+		if err = msgClient.Connect(); err == nil {
+			return msgClient, nil
+		}
+		lc.Warn(fmt.Sprintf("couldn't connect to the system events message bus: %s", err.Error()))
+		startupTimer.SleepForInterval()
+	}
+	return nil, fmt.Errorf("failed to connect to the system events message bus in allotted time")
+}
@@ -0,0 +1,34 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sysevent
+
+// SystemEventsInfo provides properties related to recording the lifecycle events of the service, i.e. its start,
+// stop, configuration reloads and health transitions, for operators to follow what changed on the node
+type SystemEventsInfo struct {
+	// Enabled indicates whether the system events are recorded
+	Enabled bool
+	// HistorySize is the number of most recent events kept and returned by the system events endpoint
+	HistorySize int
+	// File persists the history across restarts, none when empty
+	File string
+	// CheckInterval is how often the health of the service and its configuration are checked, e.g. '10s'
+	CheckInterval string
+	// MessageBus publishes the events on a topic of the message bus
+	MessageBus MessageBusInfo
+}
+
+// MessageBusInfo provides properties related to publishing the events on the message bus
+type MessageBusInfo struct {
+	Enabled bool
+	// Type is the message bus implementation, e.g. 'zero', 'mqtt' or 'redisstreams'
+	Type     string
+	Protocol string
+	Host     string
+	Port     int
+	// Topic the events are published on, DefaultTopic when empty
+	Topic    string
+	Optional map[string]string
+}
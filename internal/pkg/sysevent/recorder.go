@@ -0,0 +1,184 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package sysevent records the lifecycle events of a service, publishing them on the message bus and keeping their
+// recent history, so that operators get a timeline of what changed on the node.
+package sysevent
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/common"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	msgTypes "github.com/edgexfoundry/go-mod-messaging/pkg/types"
+
+	"github.com/google/uuid"
+)
+
+// Types of the system events
+const (
+	Start        = "start"
+	Stop         = "stop"
+	ConfigReload = "config-reload"
+	Health       = "health"
+)
+
+// DefaultTopic is the well-known topic the system events are published on.
+const DefaultTopic = "edgex/system/events"
+
+// defaultHistorySize is the number of events kept, when none is configured.
+const defaultHistorySize = 100
+
+// Event is a lifecycle event of a service.
+type Event struct {
+	Id      string `json:"id"`
+	Service string `json:"service"`
+	Type    string `json:"type"`
+	// Details describe the event, e.g. the new health of the service
+	Details string `json:"details,omitempty"`
+	// Created is when the event happened, in milliseconds
+	Created int64 `json:"created"`
+}
+
+// publisher publishes messages on the message bus, as the message client does.
+type publisher interface {
+	Publish(message msgTypes.MessageEnvelope, topic string) error
+}
+
+// Recorder records the events of a service, keeping the most recent ones and publishing each of them when a
+// publisher is set.
+type Recorder struct {
+	serviceKey string
+	size       int
+	file       string
+	topic      string
+	publisher  publisher
+	lc         logger.LoggingClient
+
+	mutex  sync.Mutex
+	events []Event
+}
+
+// NewRecorder creates a Recorder of the events of the service keeping the size most recent ones, persisted to file
+// unless it's empty. The history persisted by a previous run is loaded from file.
+func NewRecorder(serviceKey string, size int, file string, lc logger.LoggingClient) (*Recorder, error) {
+	if size <= 0 {
+		size = defaultHistorySize
+	}
+	r := &Recorder{
+		serviceKey: serviceKey,
+		size:       size,
+		file:       file,
+		lc:         lc,
+	}
+	if file == "" {
+		return r, nil
+	}
+
+	content, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return r, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the system events history: %v", err)
+	}
+	if err = json.Unmarshal(content, &r.events); err != nil {
+		return nil, fmt.Errorf("failed to decode the system events history %s: %v", file, err)
+	}
+	r.trim()
+	return r, nil
+}
+
+// PublishTo publishes the events recorded from now on the topic.
+func (r *Recorder) PublishTo(topic string, publisher publisher) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.topic = topic
+	r.publisher = publisher
+}
+
+// Record records an event of the type, persisting the history and publishing the event. A failure to persist or
+// publish it is logged, the event being kept in the history regardless.
+func (r *Recorder) Record(eventType string, details string) Event {
+	event := Event{
+		Id:      uuid.New().String(),
+		Service: r.serviceKey,
+		Type:    eventType,
+		Details: details,
+		Created: common.MakeTimestamp(),
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.events = append(r.events, event)
+	r.trim()
+	if r.file != "" {
+		if err := r.save(); err != nil {
+			r.lc.Error(fmt.Sprintf("failed to persist the system events history: %s", err.Error()))
+		}
+	}
+	if r.publisher != nil {
+		if err := r.publish(event); err != nil {
+			r.lc.Error(fmt.Sprintf("failed to publish the %s system event: %s", eventType, err.Error()))
+		}
+	}
+	return event
+}
+
+// Events returns the history, from the oldest event to the most recent.
+func (r *Recorder) Events() []Event {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return append([]Event{}, r.events...)
+}
+
+// trim drops the oldest events beyond the size of the history.
+func (r *Recorder) trim() {
+	if excess := len(r.events) - r.size; excess > 0 {
+		r.events = append([]Event{}, r.events[excess:]...)
+	}
+}
+
+// save writes the history to a temporary file renamed over the file, for a crash not to leave it truncated.
+func (r *Recorder) save() error {
+	content, err := json.Marshal(r.events)
+	if err != nil {
+		return err
+	}
+	temp, err := ioutil.TempFile(filepath.Dir(r.file), filepath.Base(r.file)+".*")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(temp.Name()) }()
+	if _, err = temp.Write(content); err != nil {
+		_ = temp.Close()
+		return err
+	}
+	if err = temp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(temp.Name(), r.file)
+}
+
+// publish publishes the event as a JSON message, correlated by its id.
+func (r *Recorder) publish(event Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return r.publisher.Publish(msgTypes.MessageEnvelope{
+		CorrelationID: event.Id,
+		ContentType:   clients.ContentTypeJSON,
+		Payload:       payload,
+	}, r.topic)
+}
@@ -0,0 +1,126 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sysevent
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	msgTypes "github.com/edgexfoundry/go-mod-messaging/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakePublisher struct {
+	topics   []string
+	messages []msgTypes.MessageEnvelope
+}
+
+func (p *fakePublisher) Publish(message msgTypes.MessageEnvelope, topic string) error {
+	p.topics = append(p.topics, topic)
+	p.messages = append(p.messages, message)
+	return nil
+}
+
+type fakeDatabase struct {
+	err error
+}
+
+func (d *fakeDatabase) Ping() error {
+	return d.err
+}
+
+func eventTypes(events []Event) []string {
+	types := make([]string, len(events))
+	for i, event := range events {
+		types[i] = event.Type
+	}
+	return types
+}
+
+func TestRecorderHistory(t *testing.T) {
+	recorder, err := NewRecorder("edgex-core-data", 2, "", logger.MockLogger{})
+	require.NoError(t, err)
+	publisher := &fakePublisher{}
+	recorder.PublishTo(DefaultTopic, publisher)
+
+	event := recorder.Record(Start, "")
+	assert.Equal(t, "edgex-core-data", event.Service)
+	assert.NotEmpty(t, event.Id)
+	assert.NotZero(t, event.Created)
+	recorder.Record(ConfigReload, "")
+	recorder.Record(Stop, "")
+
+	assert.Equal(t, []string{ConfigReload, Stop}, eventTypes(recorder.Events()), "the oldest events should be dropped")
+	require.Len(t, publisher.messages, 3)
+	assert.Equal(t, []string{DefaultTopic, DefaultTopic, DefaultTopic}, publisher.topics)
+	var published Event
+	require.NoError(t, json.Unmarshal(publisher.messages[0].Payload, &published))
+	assert.Equal(t, event, published)
+	assert.Equal(t, event.Id, publisher.messages[0].CorrelationID)
+}
+
+func TestRecorderFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sysevent")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "events.json")
+
+	recorder, err := NewRecorder("edgex-core-data", 2, file, logger.MockLogger{})
+	require.NoError(t, err)
+	recorder.Record(Start, "")
+	recorder.Record(Stop, "")
+
+	restarted, err := NewRecorder("edgex-core-data", 2, file, logger.MockLogger{})
+	require.NoError(t, err)
+	assert.Equal(t, recorder.Events(), restarted.Events(), "the history should survive a restart")
+	restarted.Record(Start, "")
+	assert.Equal(t, []string{Stop, Start}, eventTypes(restarted.Events()))
+
+	require.NoError(t, ioutil.WriteFile(file, []byte("not json"), 0600))
+	_, err = NewRecorder("edgex-core-data", 2, file, logger.MockLogger{})
+	assert.Error(t, err)
+}
+
+func TestWatcher(t *testing.T) {
+	type writableInfo struct {
+		LogLevel string
+	}
+	writable := &writableInfo{LogLevel: "INFO"}
+	database := &fakeDatabase{}
+	recorder, err := NewRecorder("edgex-core-data", 10, "", logger.MockLogger{})
+	require.NoError(t, err)
+	watcher := NewWatcher(recorder, writable, database)
+
+	watcher.Check()
+	assert.Empty(t, recorder.Events(), "nothing should be recorded while nothing changes")
+
+	writable.LogLevel = "DEBUG"
+	database.err = errors.New("connection refused")
+	watcher.Check()
+	watcher.Check()
+	database.err = nil
+	watcher.Check()
+
+	events := recorder.Events()
+	assert.Equal(t, []string{ConfigReload, Health, Health}, eventTypes(events))
+	assert.Equal(t, "unhealthy: the database is unreachable: connection refused", events[1].Details)
+	assert.Equal(t, "healthy", events[2].Details)
+}
+
+func TestWatcherWithoutDatabase(t *testing.T) {
+	recorder, err := NewRecorder("edgex-core-command", 10, "", logger.MockLogger{})
+	require.NoError(t, err)
+	NewWatcher(recorder, &struct{}{}, nil).Check()
+	assert.Empty(t, recorder.Events())
+}
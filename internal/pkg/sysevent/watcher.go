@@ -0,0 +1,63 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sysevent
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Pinger is implemented by the database clients able to check their connection, as the Redis one is.
+type Pinger interface {
+	Ping() error
+}
+
+// Watcher records the configuration reloads and health transitions of the service, detected by comparing every check
+// with the previous one.
+type Watcher struct {
+	recorder *Recorder
+	writable interface{}
+	database Pinger
+
+	snapshot []byte
+	healthy  bool
+}
+
+// NewWatcher creates a Watcher of the writable section of the configuration, reloaded in place by the bootstrap, and
+// of the health of the database, not checked when nil. The service is healthy until a check tells otherwise.
+func NewWatcher(recorder *Recorder, writable interface{}, database Pinger) *Watcher {
+	w := &Watcher{
+		recorder: recorder,
+		writable: writable,
+		database: database,
+		healthy:  true,
+	}
+	w.snapshot, _ = json.Marshal(writable)
+	return w
+}
+
+// Check records a configuration reload when the writable section changed since the previous check, and a health
+// transition when the database became unreachable or reachable again.
+func (w *Watcher) Check() {
+	if snapshot, err := json.Marshal(w.writable); err == nil && !bytes.Equal(snapshot, w.snapshot) {
+		w.snapshot = snapshot
+		w.recorder.Record(ConfigReload, "the writable configuration changed")
+	}
+
+	if w.database == nil {
+		return
+	}
+	err := w.database.Ping()
+	switch {
+	case err != nil && w.healthy:
+		w.healthy = false
+		w.recorder.Record(Health, fmt.Sprintf("unhealthy: the database is unreachable: %s", err.Error()))
+	case err == nil && !w.healthy:
+		w.healthy = true
+		w.recorder.Record(Health, "healthy")
+	}
+}
@@ -0,0 +1,138 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/messaging"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	msgTypes "github.com/edgexfoundry/go-mod-messaging/pkg/types"
+)
+
+const (
+	defaultReportInterval = 30 * time.Second
+	defaultExportTimeout  = 5 * time.Second
+)
+
+// Bootstrap contains references to dependencies required by the BootstrapHandler.
+type Bootstrap struct {
+	serviceKey string
+	info       *TelemetryInfo
+}
+
+// NewBootstrap is a factory method that returns an initialized Bootstrap receiver struct.
+func NewBootstrap(serviceKey string, info *TelemetryInfo) *Bootstrap {
+	return &Bootstrap{
+		serviceKey: serviceKey,
+		info:       info,
+	}
+}
+
+// BootstrapHandler fulfills the BootstrapHandler contract. Along with sampling the CPU usage as BootstrapHandler does,
+// it reports the metrics to the enabled exporters in the background.
+func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, startupTimer startup.Timer, dic *di.Container) bool {
+	if !BootstrapHandler(ctx, wg, startupTimer, dic) {
+		return false
+	}
+
+	lc := container.LoggingClientFrom(dic.Get)
+	interval := defaultReportInterval
+	if b.info.Interval != "" {
+		var err error
+		if interval, err = time.ParseDuration(b.info.Interval); err != nil || interval <= 0 {
+			lc.Error(fmt.Sprintf("invalid Telemetry Interval '%s'", b.info.Interval))
+			return false
+		}
+	}
+	timeout := defaultExportTimeout
+	if b.info.ExportTimeout != "" {
+		var err error
+		if timeout, err = time.ParseDuration(b.info.ExportTimeout); err != nil || timeout <= 0 {
+			lc.Error(fmt.Sprintf("invalid Telemetry ExportTimeout '%s'", b.info.ExportTimeout))
+			return false
+		}
+	}
+
+	exporters, ok := b.exporters(startupTimer, lc)
+	if !ok {
+		return false
+	}
+	if len(exporters) == 0 {
+		return true
+	}
+
+	NewReporter(interval, timeout, b.info, exporters).Run(ctx, wg, lc)
+
+	names := make([]string, len(exporters))
+	for i, exporter := range exporters {
+		names[i] = exporter.Name()
+	}
+	lc.Info(fmt.Sprintf("Reporting the metrics every %s to %s", interval, strings.Join(names, ", ")))
+	return true
+}
+
+// exporters creates the exporters enabled, connecting to the message bus when the metrics are published on it.
+func (b *Bootstrap) exporters(startupTimer startup.Timer, lc logger.LoggingClient) ([]Exporter, bool) {
+	var exporters []Exporter
+
+	if info := b.info.MessageBus; info.Enabled {
+		msgClient, err := messaging.NewMessageClient(
+			msgTypes.MessageBusConfig{
+				PublishHost: msgTypes.HostInfo{
+					Host:     info.Host,
+					Port:     info.Port,
+					Protocol: info.Protocol,
+				},
+				Type:     info.Type,
+				Optional: info.Optional,
+			})
+		if err != nil {
+			lc.Error(fmt.Sprintf("failed to create the telemetry messaging client: %s", err.Error()))
+			return nil, false
+		}
+		for startupTimer.HasNotElapsed() {
+			if err = msgClient.Connect(); err == nil {
+				break
+			}
+			lc.Warn(fmt.Sprintf("couldn't connect to the telemetry message bus: %s", err.Error()))
+			startupTimer.SleepForInterval()
+		}
+		if err != nil {
+			lc.Error("failed to connect to the telemetry message bus in allotted time")
+			return nil, false
+		}
+		exporters = append(exporters, NewMessageBusExporter(b.serviceKey, info.Topic, msgClient))
+	}
+
+	if info := b.info.OTLP; info.Enabled {
+		if info.Endpoint == "" {
+			lc.Error("no Telemetry OTLP Endpoint to push the metrics to")
+			return nil, false
+		}
+		exporters = append(exporters, NewOTLPExporter(b.serviceKey, info.Endpoint, info.Headers))
+	}
+
+	if info := b.info.StatsD; info.Enabled {
+		if info.Address == "" {
+			lc.Error("no Telemetry StatsD Address to send the metrics to")
+			return nil, false
+		}
+		exporters = append(exporters, NewStatsDExporter(info.Address, info.Prefix))
+	}
+
+	return exporters, true
+}
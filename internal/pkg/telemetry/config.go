@@ -0,0 +1,55 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package telemetry
+
+// TelemetryInfo provides properties related to reporting the metrics of the service to the enabled exporters, in
+// addition to serving them on the metrics endpoint
+type TelemetryInfo struct {
+	// Interval is how often the metrics are reported, e.g. '30s'
+	Interval string
+	// ExportTimeout bounds every export, e.g. '5s'
+	ExportTimeout string
+	// Metrics enables or disables the reporting of the metrics by name, e.g. 'memory.alloc'; the metrics not listed
+	// are reported
+	Metrics map[string]bool
+	// MessageBus publishes the metrics on a topic of the message bus
+	MessageBus MessageBusExporterInfo
+	// OTLP pushes the metrics to an OpenTelemetry collector
+	OTLP OTLPExporterInfo
+	// StatsD sends the metrics to a StatsD daemon
+	StatsD StatsDExporterInfo
+}
+
+// MessageBusExporterInfo provides properties related to publishing the metrics on the message bus
+type MessageBusExporterInfo struct {
+	Enabled bool
+	// Type is the message bus implementation, e.g. 'zero', 'mqtt' or 'redisstreams'
+	Type     string
+	Protocol string
+	Host     string
+	Port     int
+	// Topic the metrics are published on, e.g. 'edgex/telemetry'
+	Topic    string
+	Optional map[string]string
+}
+
+// OTLPExporterInfo provides properties related to pushing the metrics to an OpenTelemetry collector
+type OTLPExporterInfo struct {
+	Enabled bool
+	// Endpoint is the OTLP/HTTP metrics endpoint of the collector, e.g. 'http://localhost:4318/v1/metrics'
+	Endpoint string
+	// Headers are added to every push, e.g. the authorization of the collector
+	Headers map[string]string
+}
+
+// StatsDExporterInfo provides properties related to sending the metrics to a StatsD daemon
+type StatsDExporterInfo struct {
+	Enabled bool
+	// Address is the host:port of the daemon, reached over UDP, e.g. 'localhost:8125'
+	Address string
+	// Prefix is prepended to the name of the metrics, e.g. 'edgex.core-data.'
+	Prefix string
+}
@@ -0,0 +1,258 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+
+	msgTypes "github.com/edgexfoundry/go-mod-messaging/pkg/types"
+)
+
+// Exporter reports the metrics of the service, measured at the given time, to a monitoring system.
+type Exporter interface {
+	Name() string
+	Export(ctx context.Context, metrics []Metric, measured time.Time) error
+}
+
+// publisher publishes messages on the message bus, as the message client does.
+type publisher interface {
+	Publish(message msgTypes.MessageEnvelope, topic string) error
+}
+
+// metricsReport is the payload of the metrics published on the message bus.
+type metricsReport struct {
+	Service   string             `json:"service"`
+	Timestamp int64              `json:"timestamp"`
+	Metrics   map[string]float64 `json:"metrics"`
+}
+
+// MessageBusExporter publishes the metrics on a topic of the message bus.
+type MessageBusExporter struct {
+	serviceKey string
+	topic      string
+	publisher  publisher
+}
+
+// NewMessageBusExporter creates a MessageBusExporter publishing the metrics of serviceKey on topic.
+func NewMessageBusExporter(serviceKey string, topic string, publisher publisher) *MessageBusExporter {
+	return &MessageBusExporter{
+		serviceKey: serviceKey,
+		topic:      topic,
+		publisher:  publisher,
+	}
+}
+
+func (e *MessageBusExporter) Name() string {
+	return "message bus"
+}
+
+// Export publishes the metrics as a single JSON message.
+func (e *MessageBusExporter) Export(_ context.Context, metrics []Metric, measured time.Time) error {
+	report := metricsReport{
+		Service:   e.serviceKey,
+		Timestamp: measured.UnixNano() / int64(time.Millisecond),
+		Metrics:   make(map[string]float64, len(metrics)),
+	}
+	for _, m := range metrics {
+		report.Metrics[m.Name] = m.Value
+	}
+	payload, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+	return e.publisher.Publish(msgTypes.MessageEnvelope{
+		ContentType: clients.ContentTypeJSON,
+		Payload:     payload,
+	}, e.topic)
+}
+
+// OTLPExporter pushes the metrics to an OpenTelemetry collector, in the JSON encoding of OTLP/HTTP.
+type OTLPExporter struct {
+	serviceKey string
+	endpoint   string
+	headers    map[string]string
+	client     *http.Client
+	started    time.Time
+}
+
+// NewOTLPExporter creates an OTLPExporter pushing the metrics of serviceKey to the collector endpoint.
+func NewOTLPExporter(serviceKey string, endpoint string, headers map[string]string) *OTLPExporter {
+	return &OTLPExporter{
+		serviceKey: serviceKey,
+		endpoint:   endpoint,
+		headers:    headers,
+		client:     &http.Client{},
+		started:    time.Now(),
+	}
+}
+
+func (e *OTLPExporter) Name() string {
+	return "OTLP"
+}
+
+// Export pushes the metrics, the gauges as gauges and the counters as cumulative monotonic sums since the start of the
+// service.
+func (e *OTLPExporter) Export(ctx context.Context, metrics []Metric, measured time.Time) error {
+	now := strconv.FormatInt(measured.UnixNano(), 10)
+	start := strconv.FormatInt(e.started.UnixNano(), 10)
+	otlpMetrics := make([]map[string]interface{}, 0, len(metrics))
+	for _, m := range metrics {
+		point := map[string]interface{}{"timeUnixNano": now, "asDouble": m.Value}
+		metric := map[string]interface{}{"name": m.Name}
+		switch m.Kind {
+		case Counter:
+			point["startTimeUnixNano"] = start
+			metric["sum"] = map[string]interface{}{
+				"dataPoints": []interface{}{point},
+				// cumulative
+				"aggregationTemporality": 2,
+				"isMonotonic":            true,
+			}
+		default:
+			metric["gauge"] = map[string]interface{}{"dataPoints": []interface{}{point}}
+		}
+		otlpMetrics = append(otlpMetrics, metric)
+	}
+	body, err := json.Marshal(map[string]interface{}{
+		"resourceMetrics": []interface{}{map[string]interface{}{
+			"resource": map[string]interface{}{
+				"attributes": []interface{}{map[string]interface{}{
+					"key":   "service.name",
+					"value": map[string]interface{}{"stringValue": e.serviceKey},
+				}},
+			},
+			"scopeMetrics": []interface{}{map[string]interface{}{
+				"scope":   map[string]interface{}{"name": "edgex-go/telemetry"},
+				"metrics": otlpMetrics,
+			}},
+		}},
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set(clients.ContentType, clients.ContentTypeJSON)
+	for name, value := range e.headers {
+		req.Header.Set(name, value)
+	}
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		message, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("the collector answered %s: %s", resp.Status, strings.TrimSpace(string(message)))
+	}
+	return nil
+}
+
+// StatsDExporter sends the metrics to a StatsD daemon over UDP.
+type StatsDExporter struct {
+	address string
+	prefix  string
+
+	mutex sync.Mutex
+	// previous holds the values of the counters last sent, as StatsD counters are increments
+	previous map[string]float64
+}
+
+// NewStatsDExporter creates a StatsDExporter sending the metrics to the daemon at address, their name prefixed by
+// prefix.
+func NewStatsDExporter(address string, prefix string) *StatsDExporter {
+	return &StatsDExporter{
+		address:  address,
+		prefix:   strings.TrimSuffix(prefix, "."),
+		previous: make(map[string]float64),
+	}
+}
+
+func (e *StatsDExporter) Name() string {
+	return "StatsD"
+}
+
+// Export sends the gauges as they are and the increments of the counters since their previous export.
+func (e *StatsDExporter) Export(ctx context.Context, metrics []Metric, _ time.Time) error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	var lines []string
+	sent := make(map[string]float64)
+	for _, m := range metrics {
+		name := m.Name
+		if e.prefix != "" {
+			name = e.prefix + "." + name
+		}
+		switch m.Kind {
+		case Counter:
+			increment := m.Value - e.previous[m.Name]
+			sent[m.Name] = m.Value
+			if increment < 0 {
+				// the counter was reset
+				increment = m.Value
+			}
+			lines = append(lines, name+":"+strconv.FormatFloat(increment, 'f', -1, 64)+"|c")
+		default:
+			lines = append(lines, name+":"+strconv.FormatFloat(m.Value, 'f', -1, 64)+"|g")
+		}
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "udp", e.address)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetWriteDeadline(deadline)
+	}
+	// one metric per line, in packets small enough not to be fragmented
+	for _, packet := range packets(lines, 512) {
+		if _, err := conn.Write([]byte(packet)); err != nil {
+			return err
+		}
+	}
+	for name, value := range sent {
+		e.previous[name] = value
+	}
+	return nil
+}
+
+// packets joins the lines into packets of at most size bytes, unless a single line is larger.
+func packets(lines []string, size int) []string {
+	var packets []string
+	var current string
+	for _, line := range lines {
+		if current != "" && len(current)+1+len(line) > size {
+			packets = append(packets, current)
+			current = ""
+		}
+		if current != "" {
+			current += "\n"
+		}
+		current += line
+	}
+	if current != "" {
+		packets = append(packets, current)
+	}
+	return packets
+}
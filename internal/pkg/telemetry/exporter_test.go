@@ -0,0 +1,154 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package telemetry
+
+import (
+	"context"
+	"encoding/json"
+	goErrors "errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	msgTypes "github.com/edgexfoundry/go-mod-messaging/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testMetrics = []Metric{
+	{Name: MemoryAllocMetric, Kind: Gauge, Value: 2048},
+	{Name: MemoryMallocsMetric, Kind: Counter, Value: 100},
+	{Name: CpuBusyAvgMetric, Kind: Gauge, Value: 12.5},
+}
+
+// recordingPublisher records the messages published
+type recordingPublisher struct {
+	messages []msgTypes.MessageEnvelope
+	topics   []string
+	err      error
+}
+
+func (p *recordingPublisher) Publish(message msgTypes.MessageEnvelope, topic string) error {
+	p.messages = append(p.messages, message)
+	p.topics = append(p.topics, topic)
+	return p.err
+}
+
+func TestEnabled(t *testing.T) {
+	metrics := NewSystemUsage().Metrics()
+	assert.Len(t, Enabled(metrics, nil), len(metrics), "all the metrics should be reported by default")
+
+	reported := Enabled(metrics, map[string]bool{MemoryAllocMetric: false, CpuBusyAvgMetric: true})
+	assert.Len(t, reported, len(metrics)-1)
+	for _, m := range reported {
+		assert.NotEqual(t, MemoryAllocMetric, m.Name)
+	}
+}
+
+func TestMessageBusExporter(t *testing.T) {
+	publisher := &recordingPublisher{}
+	exporter := NewMessageBusExporter("edgex-core-data", "edgex/telemetry", publisher)
+	require.NoError(t, exporter.Export(context.Background(), testMetrics, time.Unix(1600000000, 0)))
+
+	require.Len(t, publisher.messages, 1)
+	assert.Equal(t, "edgex/telemetry", publisher.topics[0])
+	report := metricsReport{}
+	require.NoError(t, json.Unmarshal(publisher.messages[0].Payload, &report))
+	assert.Equal(t, "edgex-core-data", report.Service)
+	assert.Equal(t, int64(1600000000000), report.Timestamp)
+	assert.Equal(t, 12.5, report.Metrics[CpuBusyAvgMetric])
+}
+
+func TestOTLPExporter(t *testing.T) {
+	var received map[string]interface{}
+	var authorization string
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+	}))
+	defer collector.Close()
+
+	exporter := NewOTLPExporter("edgex-core-data", collector.URL, map[string]string{"Authorization": "Bearer token"})
+	require.NoError(t, exporter.Export(context.Background(), testMetrics, time.Unix(1600000000, 0)))
+	assert.Equal(t, "Bearer token", authorization)
+
+	resource := received["resourceMetrics"].([]interface{})[0].(map[string]interface{})
+	attribute := resource["resource"].(map[string]interface{})["attributes"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "edgex-core-data", attribute["value"].(map[string]interface{})["stringValue"])
+	metrics := resource["scopeMetrics"].([]interface{})[0].(map[string]interface{})["metrics"].([]interface{})
+	require.Len(t, metrics, 3)
+
+	gauge := metrics[0].(map[string]interface{})
+	assert.Equal(t, MemoryAllocMetric, gauge["name"])
+	point := gauge["gauge"].(map[string]interface{})["dataPoints"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "1600000000000000000", point["timeUnixNano"])
+	assert.Equal(t, float64(2048), point["asDouble"])
+
+	sum := metrics[1].(map[string]interface{})["sum"].(map[string]interface{})
+	assert.Equal(t, true, sum["isMonotonic"])
+	assert.Equal(t, float64(2), sum["aggregationTemporality"])
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer failing.Close()
+	assert.Error(t, NewOTLPExporter("edgex-core-data", failing.URL, nil).Export(context.Background(), testMetrics, time.Now()))
+}
+
+func TestStatsDExporter(t *testing.T) {
+	daemon, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer daemon.Close()
+
+	receive := func() []string {
+		buffer := make([]byte, 1024)
+		require.NoError(t, daemon.SetReadDeadline(time.Now().Add(time.Second)))
+		n, _, err := daemon.ReadFrom(buffer)
+		require.NoError(t, err)
+		return strings.Split(string(buffer[:n]), "\n")
+	}
+
+	exporter := NewStatsDExporter(daemon.LocalAddr().String(), "edgex.core-data.")
+	require.NoError(t, exporter.Export(context.Background(), testMetrics, time.Now()))
+	assert.Equal(t, []string{
+		"edgex.core-data.memory.alloc:2048|g",
+		"edgex.core-data.memory.mallocs:100|c",
+		"edgex.core-data.cpu.busy_avg:12.5|g",
+	}, receive())
+
+	next := []Metric{{Name: MemoryMallocsMetric, Kind: Counter, Value: 130}}
+	require.NoError(t, exporter.Export(context.Background(), next, time.Now()))
+	assert.Equal(t, []string{"edgex.core-data.memory.mallocs:30|c"}, receive(), "only the increment should be sent")
+}
+
+func TestPackets(t *testing.T) {
+	assert.Equal(t, []string{"a:1|g\nb:2|g", "c:3|g"}, packets([]string{"a:1|g", "b:2|g", "c:3|g"}, 11))
+	assert.Equal(t, []string{"long:1|g"}, packets([]string{"long:1|g"}, 4))
+	assert.Empty(t, packets(nil, 512))
+}
+
+func TestReporterReport(t *testing.T) {
+	failing := &recordingPublisher{err: goErrors.New("bus unavailable")}
+	publisher := &recordingPublisher{}
+	info := &TelemetryInfo{Metrics: map[string]bool{MemoryAllocMetric: false}}
+	reporter := NewReporter(time.Minute, time.Second, info, []Exporter{
+		NewMessageBusExporter("edgex-core-data", "edgex/telemetry", failing),
+		NewMessageBusExporter("edgex-core-data", "edgex/telemetry", publisher),
+	})
+
+	reporter.Report(context.Background(), logger.NewMockClient())
+	require.Len(t, publisher.messages, 1, "a failing exporter shouldn't prevent the others")
+	report := metricsReport{}
+	require.NoError(t, json.Unmarshal(publisher.messages[0].Payload, &report))
+	assert.NotContains(t, report.Metrics, MemoryAllocMetric)
+	assert.Contains(t, report.Metrics, MemorySysMetric)
+}
@@ -0,0 +1,61 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package telemetry
+
+// MetricKind tells how the value of a metric evolves.
+type MetricKind int
+
+const (
+	// Gauge is a metric whose value goes up and down
+	Gauge MetricKind = iota
+	// Counter is a metric whose value only increases, since the service started
+	Counter
+)
+
+// Metric is a measure of the service reported to the exporters.
+type Metric struct {
+	Name  string
+	Kind  MetricKind
+	Value float64
+}
+
+// Names of the metrics reported from the SystemUsage.
+const (
+	MemoryAllocMetric       = "memory.alloc"
+	MemoryTotalAllocMetric  = "memory.total_alloc"
+	MemorySysMetric         = "memory.sys"
+	MemoryMallocsMetric     = "memory.mallocs"
+	MemoryFreesMetric       = "memory.frees"
+	MemoryLiveObjectsMetric = "memory.live_objects"
+	CpuBusyAvgMetric        = "cpu.busy_avg"
+	LenientDecodesMetric    = "db.lenient_decodes"
+)
+
+// Metrics returns the SystemUsage as the metrics reported to the exporters.
+func (s SystemUsage) Metrics() []Metric {
+	return []Metric{
+		{Name: MemoryAllocMetric, Kind: Gauge, Value: float64(s.Memory.Alloc)},
+		{Name: MemoryTotalAllocMetric, Kind: Counter, Value: float64(s.Memory.TotalAlloc)},
+		{Name: MemorySysMetric, Kind: Gauge, Value: float64(s.Memory.Sys)},
+		{Name: MemoryMallocsMetric, Kind: Counter, Value: float64(s.Memory.Mallocs)},
+		{Name: MemoryFreesMetric, Kind: Counter, Value: float64(s.Memory.Frees)},
+		{Name: MemoryLiveObjectsMetric, Kind: Gauge, Value: float64(s.Memory.LiveObjects)},
+		{Name: CpuBusyAvgMetric, Kind: Gauge, Value: s.CpuBusyAvg},
+		{Name: LenientDecodesMetric, Kind: Counter, Value: float64(s.LenientDecodes)},
+	}
+}
+
+// Enabled returns the metrics not disabled by enabled, keyed by metric name.
+func Enabled(metrics []Metric, enabled map[string]bool) []Metric {
+	var reported []Metric
+	for _, m := range metrics {
+		if on, listed := enabled[m.Name]; listed && !on {
+			continue
+		}
+		reported = append(reported, m)
+	}
+	return reported
+}
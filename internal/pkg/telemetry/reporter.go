@@ -0,0 +1,69 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+)
+
+// Reporter reports the enabled metrics of the service to the exporters at every interval.
+type Reporter struct {
+	interval  time.Duration
+	timeout   time.Duration
+	info      *TelemetryInfo
+	exporters []Exporter
+}
+
+// NewReporter creates a Reporter. The info points into the service's configuration, so that the metrics enabled are
+// updated along with it.
+func NewReporter(interval time.Duration, timeout time.Duration, info *TelemetryInfo, exporters []Exporter) *Reporter {
+	return &Reporter{
+		interval:  interval,
+		timeout:   timeout,
+		info:      info,
+		exporters: exporters,
+	}
+}
+
+// Report measures the metrics and exports them to every exporter, a failing exporter not preventing the others.
+func (r *Reporter) Report(ctx context.Context, lc logger.LoggingClient) {
+	metrics := Enabled(NewSystemUsage().Metrics(), r.info.Metrics)
+	if len(metrics) == 0 {
+		return
+	}
+	measured := time.Now()
+	for _, exporter := range r.exporters {
+		exportCtx, cancel := context.WithTimeout(ctx, r.timeout)
+		if err := exporter.Export(exportCtx, metrics, measured); err != nil {
+			lc.Error(fmt.Sprintf("unable to export the metrics to %s: %v", exporter.Name(), err))
+		}
+		cancel()
+	}
+}
+
+// Run reports the metrics at every interval until ctx is done.
+func (r *Reporter) Run(ctx context.Context, wg *sync.WaitGroup, lc logger.LoggingClient) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				r.Report(ctx, lc)
+			}
+		}
+	}()
+}
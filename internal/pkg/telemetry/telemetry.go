@@ -20,6 +20,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
 	"github.com/edgexfoundry/go-mod-bootstrap/di"
@@ -28,6 +30,8 @@ import (
 type SystemUsage struct {
 	Memory     memoryUsage
 	CpuBusyAvg float64
+	// LenientDecodes is the number of objects read from the database decoded in spite of their unknown fields
+	LenientDecodes int64
 }
 
 type memoryUsage struct {
@@ -67,6 +71,7 @@ func NewSystemUsage() (s SystemUsage) {
 	s.Memory.LiveObjects = s.Memory.Mallocs - s.Memory.Frees
 
 	s.CpuBusyAvg = usageAvg
+	s.LenientDecodes = db.LenientDecodes()
 
 	return s
 }
@@ -0,0 +1,65 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+
+	"github.com/gorilla/mux"
+)
+
+// Bootstrap contains references to dependencies required by the BootstrapHandler.
+type Bootstrap struct {
+	router     *mux.Router
+	serviceKey string
+	info       *TracingInfo
+}
+
+// NewBootstrap is a factory method that returns an initialized Bootstrap receiver struct.
+func NewBootstrap(router *mux.Router, serviceKey string, info *TracingInfo) *Bootstrap {
+	return &Bootstrap{
+		router:     router,
+		serviceKey: serviceKey,
+		info:       info,
+	}
+}
+
+// BootstrapHandler fulfills the BootstrapHandler contract. When the tracing is enabled, every request answered by the
+// router is traced, continuing the trace of its caller, and the traces of the failed or slow requests are exported in
+// the background.
+func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
+	if !b.info.Enabled {
+		return true
+	}
+
+	lc := container.LoggingClientFrom(dic.Get)
+	exporter, err := NewExporter(*b.info, b.serviceKey, lc)
+	if err != nil {
+		lc.Error(err.Error())
+		return false
+	}
+	tracer, err := NewTracer(*b.info, exporter)
+	if err != nil {
+		lc.Error(err.Error())
+		return false
+	}
+
+	b.router.Use(tracer.Middleware)
+	tracer.Run(ctx, wg, lc)
+
+	destination := "the log"
+	if b.info.Endpoint != "" {
+		destination = b.info.Endpoint
+	}
+	lc.Info(fmt.Sprintf("Tracing the requests, exporting the failed or slow ones to %s", destination))
+	return true
+}
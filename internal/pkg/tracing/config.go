@@ -0,0 +1,30 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+// TracingInfo provides properties related to tracing the requests answered by the service. The spans of every request
+// are buffered until it ends, and its trace is only exported when it ended in error or was slow.
+type TracingInfo struct {
+	// Enabled indicates whether the requests are traced
+	Enabled bool
+	// Exporter is the format the traces are exported to the Endpoint in, 'zipkin' for the Zipkin v2 JSON format, the
+	// default, or 'otlp' for OTLP/HTTP in the JSON encoding
+	Exporter string
+	// Endpoint receives the exported traces, e.g. 'http://localhost:9411/api/v2/spans' for Zipkin or
+	// 'http://localhost:4318/v1/traces' for an OpenTelemetry collector; the traces are logged when empty
+	Endpoint string
+	// LatencyThreshold is the duration of the requests beyond which their trace is exported, e.g. '1s'; only the
+	// traces ending in error are exported when empty
+	LatencyThreshold string
+	// MaxSpansPerTrace is the number of spans buffered for a request beyond which its other spans are dropped
+	MaxSpansPerTrace int
+	// MaxPendingTraces is the number of requests traced at once beyond which the other requests aren't traced
+	MaxPendingTraces int
+	// ExportQueueSize is the number of traces waiting for their export beyond which the other traces are dropped
+	ExportQueueSize int
+	// ExportTimeout bounds every export to the Endpoint, e.g. '5s'
+	ExportTimeout string
+}
@@ -0,0 +1,288 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+)
+
+// Exporter sends the sampled traces, root span first, to where they are looked at.
+type Exporter interface {
+	Export(ctx context.Context, spans []Span) error
+}
+
+// Exporters of the TracingInfo
+const (
+	ZipkinExporterName = "zipkin"
+	OTLPExporterName   = "otlp"
+)
+
+// NewExporter creates the exporter of the traces of serviceName given by info, logging them with lc when info has no
+// Endpoint.
+func NewExporter(info TracingInfo, serviceName string, lc logger.LoggingClient) (Exporter, error) {
+	switch {
+	case info.Exporter != "" && info.Exporter != ZipkinExporterName && info.Exporter != OTLPExporterName:
+		return nil, fmt.Errorf("invalid tracing Exporter '%s'", info.Exporter)
+	case info.Endpoint == "":
+		return NewLogExporter(lc), nil
+	case info.Exporter == OTLPExporterName:
+		return NewOTLPExporter(info.Endpoint, serviceName), nil
+	default:
+		return NewZipkinExporter(info.Endpoint, serviceName), nil
+	}
+}
+
+// zipkinEndpoint is the service the spans were recorded by.
+type zipkinEndpoint struct {
+	ServiceName string `json:"serviceName"`
+}
+
+// zipkinSpan is a span in the Zipkin v2 JSON format, accepted by Zipkin and Jaeger alike.
+type zipkinSpan struct {
+	TraceId       string            `json:"traceId"`
+	Id            string            `json:"id"`
+	ParentId      string            `json:"parentId,omitempty"`
+	Name          string            `json:"name"`
+	Kind          string            `json:"kind,omitempty"`
+	Timestamp     int64             `json:"timestamp"`
+	Duration      int64             `json:"duration"`
+	LocalEndpoint zipkinEndpoint    `json:"localEndpoint"`
+	Tags          map[string]string `json:"tags,omitempty"`
+}
+
+// ZipkinExporter posts the traces to the spans endpoint of a Zipkin compatible collector.
+type ZipkinExporter struct {
+	endpoint    string
+	serviceName string
+	client      *http.Client
+}
+
+// NewZipkinExporter creates a ZipkinExporter posting the traces of serviceName to endpoint.
+func NewZipkinExporter(endpoint string, serviceName string) *ZipkinExporter {
+	return &ZipkinExporter{
+		endpoint:    endpoint,
+		serviceName: serviceName,
+		client:      &http.Client{},
+	}
+}
+
+// Export posts the spans of a trace.
+func (e *ZipkinExporter) Export(ctx context.Context, spans []Span) error {
+	zipkinSpans := make([]zipkinSpan, len(spans))
+	for i, s := range spans {
+		tags := s.Tags
+		if s.Error != "" {
+			tags = make(map[string]string, len(s.Tags)+1)
+			for k, v := range s.Tags {
+				tags[k] = v
+			}
+			tags["error"] = s.Error
+		}
+		zipkinSpans[i] = zipkinSpan{
+			TraceId:       s.TraceId,
+			Id:            s.Id,
+			ParentId:      s.ParentId,
+			Name:          s.Name,
+			Timestamp:     s.Start.UnixNano() / int64(time.Microsecond),
+			Duration:      int64(s.Duration / time.Microsecond),
+			LocalEndpoint: zipkinEndpoint{ServiceName: e.serviceName},
+			Tags:          tags,
+		}
+		// Zipkin has no kind for the internal spans
+		if s.Kind != KindInternal {
+			zipkinSpans[i].Kind = string(s.Kind)
+		}
+	}
+
+	body, err := json.Marshal(zipkinSpans)
+	if err != nil {
+		return err
+	}
+	return post(ctx, e.client, e.endpoint, body)
+}
+
+// otlpSpanKinds are the values of the span kinds in OTLP.
+var otlpSpanKinds = map[Kind]int{
+	KindInternal: 1,
+	KindServer:   2,
+	KindClient:   3,
+	KindProducer: 4,
+}
+
+// otlpStatusError is the status of the failed spans in OTLP.
+const otlpStatusError = 2
+
+// otlpValue is a string attribute value in the OTLP JSON encoding.
+type otlpValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+// otlpAttribute is an attribute of a resource or a span in the OTLP JSON encoding.
+type otlpAttribute struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+// otlpStatus is the status of a span in the OTLP JSON encoding, unset when it succeeded.
+type otlpStatus struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// otlpSpan is a span in the OTLP JSON encoding, whose IDs are hex encoded and timestamps given in nanoseconds.
+type otlpSpan struct {
+	TraceId           string          `json:"traceId"`
+	SpanId            string          `json:"spanId"`
+	ParentSpanId      string          `json:"parentSpanId,omitempty"`
+	Name              string          `json:"name"`
+	Kind              int             `json:"kind"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+	Attributes        []otlpAttribute `json:"attributes,omitempty"`
+	Status            otlpStatus      `json:"status"`
+}
+
+// otlpScope is the instrumentation the spans were recorded by.
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpAttribute `json:"attributes"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+// otlpTraces is the request exporting spans to an OTLP/HTTP collector, in the JSON encoding.
+type otlpTraces struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+// OTLPExporter posts the traces to the traces endpoint of an OpenTelemetry collector, with OTLP/HTTP in the JSON
+// encoding.
+type OTLPExporter struct {
+	endpoint    string
+	serviceName string
+	client      *http.Client
+}
+
+// NewOTLPExporter creates an OTLPExporter posting the traces of serviceName to endpoint, e.g.
+// 'http://localhost:4318/v1/traces'.
+func NewOTLPExporter(endpoint string, serviceName string) *OTLPExporter {
+	return &OTLPExporter{
+		endpoint:    endpoint,
+		serviceName: serviceName,
+		client:      &http.Client{},
+	}
+}
+
+// Export posts the spans of a trace.
+func (e *OTLPExporter) Export(ctx context.Context, spans []Span) error {
+	otlpSpans := make([]otlpSpan, len(spans))
+	for i, s := range spans {
+		attributes := make([]otlpAttribute, 0, len(s.Tags))
+		for k, v := range s.Tags {
+			attributes = append(attributes, otlpAttribute{Key: k, Value: otlpValue{StringValue: v}})
+		}
+		sort.Slice(attributes, func(i, j int) bool { return attributes[i].Key < attributes[j].Key })
+		kind, ok := otlpSpanKinds[s.Kind]
+		if !ok {
+			kind = otlpSpanKinds[KindInternal]
+		}
+		otlpSpans[i] = otlpSpan{
+			TraceId:           s.TraceId,
+			SpanId:            s.Id,
+			ParentSpanId:      s.ParentId,
+			Name:              s.Name,
+			Kind:              kind,
+			StartTimeUnixNano: strconv.FormatInt(s.Start.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(s.Start.Add(s.Duration).UnixNano(), 10),
+			Attributes:        attributes,
+		}
+		if s.Error != "" {
+			otlpSpans[i].Status = otlpStatus{Code: otlpStatusError, Message: s.Error}
+		}
+	}
+
+	body, err := json.Marshal(otlpTraces{
+		ResourceSpans: []otlpResourceSpans{{
+			Resource: otlpResource{Attributes: []otlpAttribute{
+				{Key: "service.name", Value: otlpValue{StringValue: e.serviceName}},
+			}},
+			ScopeSpans: []otlpScopeSpans{{
+				Scope: otlpScope{Name: "github.com/edgexfoundry/edgex-go"},
+				Spans: otlpSpans,
+			}},
+		}},
+	})
+	if err != nil {
+		return err
+	}
+	return post(ctx, e.client, e.endpoint, body)
+}
+
+// post sends the JSON encoded spans to the endpoint of a collector.
+func post(ctx context.Context, client *http.Client, endpoint string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set(clients.ContentType, clients.ContentTypeJSON)
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		message, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("the collector answered %s: %s", resp.Status, strings.TrimSpace(string(message)))
+	}
+	return nil
+}
+
+// LogExporter logs the traces, for when there is no collector.
+type LogExporter struct {
+	lc logger.LoggingClient
+}
+
+// NewLogExporter creates a LogExporter logging the traces with lc.
+func NewLogExporter(lc logger.LoggingClient) *LogExporter {
+	return &LogExporter{lc: lc}
+}
+
+// Export logs every span of a trace.
+func (e *LogExporter) Export(_ context.Context, spans []Span) error {
+	root := spans[0]
+	e.lc.Info(fmt.Sprintf("trace %s of %s sampled on %s, took %s", root.TraceId, root.Name, root.Tags[SamplingReasonTag], root.Duration))
+	for _, s := range spans {
+		message := fmt.Sprintf("span %s %s of trace %s took %s", s.Id, s.Name, s.TraceId, s.Duration)
+		if s.Error != "" {
+			message += ", failed: " + s.Error
+		}
+		e.lc.Info(message)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+
+	"github.com/gorilla/mux"
+)
+
+// statusRecorder captures the status code a handler answers with
+type statusRecorder struct {
+	http.ResponseWriter
+	statusCode int
+}
+
+func (r *statusRecorder) WriteHeader(statusCode int) {
+	r.statusCode = statusCode
+	r.ResponseWriter.WriteHeader(statusCode)
+}
+
+// Middleware traces every request answered by the router, continuing the trace of the caller given by the traceparent
+// header of the request, if any. Its root span fails when it's answered with a 5xx status code.
+func (t *Tracer) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		correlationId := correlation.FromContext(r.Context())
+		if correlationId == "" {
+			correlationId = r.Header.Get(clients.CorrelationHeader)
+		}
+		path := r.URL.Path
+		if route := mux.CurrentRoute(r); route != nil {
+			if template, err := route.GetPathTemplate(); err == nil {
+				path = template
+			}
+		}
+
+		ctx, span := t.StartTrace(r.Context(), correlationId, r.Header.Get(TraceParentHeader), r.Method+" "+path)
+		if span == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		recorder := &statusRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+		next.ServeHTTP(recorder, r.WithContext(ctx))
+
+		span.SetTag("http.method", r.Method)
+		span.SetTag("http.path", r.URL.Path)
+		span.SetTag("http.status_code", strconv.Itoa(recorder.statusCode))
+		var err error
+		if recorder.statusCode >= http.StatusInternalServerError {
+			err = fmt.Errorf("answered %d %s", recorder.statusCode, http.StatusText(recorder.statusCode))
+		}
+		span.End(err)
+	})
+}
@@ -0,0 +1,57 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"encoding/hex"
+	"net/http"
+	"strings"
+)
+
+// TraceParentHeader is the header of the W3C Trace Context carrying the trace and the span of the caller of a request.
+const TraceParentHeader = "traceparent"
+
+// Inject sets the traceparent header of a request sent by the service to the span of ctx, for the service receiving it
+// to continue the trace. The request is sampled as far as the service knows, the spans of every traced request being
+// recorded even though only the failed or slow traces are exported. Nothing is set when the request of ctx isn't
+// traced.
+func Inject(ctx context.Context, header http.Header) {
+	span := FromContext(ctx)
+	if span == nil {
+		return
+	}
+	header.Set(TraceParentHeader, "00-"+span.TraceId+"-"+span.Id+"-01")
+}
+
+// parseTraceParent returns the trace ID and the parent span ID of a traceparent header, and whether it's valid.
+func parseTraceParent(traceParent string) (traceId string, parentId string, ok bool) {
+	fields := strings.Split(strings.TrimSpace(traceParent), "-")
+	if len(fields) < 4 {
+		return "", "", false
+	}
+	version, traceId, parentId, flags := fields[0], fields[1], fields[2], fields[3]
+	// the later versions only add fields
+	if !isHex(version, 1) || version == "ff" || (version == "00" && len(fields) != 4) {
+		return "", "", false
+	}
+	if !isHex(traceId, 16) || !isHex(parentId, 8) || !isHex(flags, 1) {
+		return "", "", false
+	}
+	if strings.Trim(traceId, "0") == "" || strings.Trim(parentId, "0") == "" {
+		return "", "", false
+	}
+	return traceId, parentId, true
+}
+
+// isHex tells whether value is the lowercase hex encoding of size bytes.
+func isHex(value string, size int) bool {
+	if len(value) != 2*size || strings.ToLower(value) != value {
+		return false
+	}
+	_, err := hex.DecodeString(value)
+	return err == nil
+}
@@ -0,0 +1,164 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+type contextKey int
+
+const spanKey contextKey = iota
+
+// Kind tells the role of a span in the trace, as in OpenTelemetry.
+type Kind string
+
+const (
+	// KindInternal is an operation within the service
+	KindInternal Kind = "INTERNAL"
+	// KindServer is the handling of a request received by the service
+	KindServer Kind = "SERVER"
+	// KindClient is a request sent by the service to another service or a database
+	KindClient Kind = "CLIENT"
+	// KindProducer is a message published by the service
+	KindProducer Kind = "PRODUCER"
+)
+
+// MessagingDestinationTag is the tag of a producer span naming the topic the message is published to.
+const MessagingDestinationTag = "messaging.destination"
+
+// Span is a timed operation of a traced request. The methods of a nil Span, returned when the request isn't traced,
+// do nothing.
+type Span struct {
+	TraceId string
+	Id      string
+	// ParentId is the span of the trace the span is a child of, empty for the root span of a trace started by the
+	// service and the span of the caller for a trace continued by the service
+	ParentId string
+	Name     string
+	Kind     Kind
+	Start    time.Time
+	Duration time.Duration
+	// Error is the failure the operation ended with, empty when it succeeded
+	Error string
+	Tags  map[string]string
+
+	trace *trace
+	ended bool
+}
+
+// trace buffers the spans of a request until its root span ends.
+type trace struct {
+	tracer  *Tracer
+	mutex   sync.Mutex
+	root    *Span
+	spans   []*Span
+	dropped int
+	done    bool
+}
+
+// StartSpan starts an internal span named name, child of the span of ctx, and returns the context of the new span. The
+// span is nil when the request of ctx isn't traced.
+func StartSpan(ctx context.Context, name string) (context.Context, *Span) {
+	return StartSpanOfKind(ctx, KindInternal, name)
+}
+
+// StartSpanOfKind starts a span of kind named name, child of the span of ctx, and returns the context of the new span.
+// The span is nil when the request of ctx isn't traced.
+func StartSpanOfKind(ctx context.Context, kind Kind, name string) (context.Context, *Span) {
+	parent := FromContext(ctx)
+	if parent == nil {
+		return ctx, nil
+	}
+	span := parent.trace.start(name, kind, parent.TraceId, parent.Id)
+	if span == nil {
+		return ctx, nil
+	}
+	return context.WithValue(ctx, spanKey, span), span
+}
+
+// FromContext returns the current span of ctx, nil when the request of ctx isn't traced.
+func FromContext(ctx context.Context) *Span {
+	span, _ := ctx.Value(spanKey).(*Span)
+	return span
+}
+
+// SetTag annotates the span with key and value.
+func (s *Span) SetTag(key string, value string) {
+	if s == nil {
+		return
+	}
+	s.trace.mutex.Lock()
+	defer s.trace.mutex.Unlock()
+	s.Tags[key] = value
+}
+
+// End ends the span, failed with err when not nil. The trace is complete once its root span ends, and the spans
+// ending after it are ignored.
+func (s *Span) End(err error) {
+	if s == nil {
+		return
+	}
+	t := s.trace
+	t.mutex.Lock()
+	if s.ended || t.done {
+		t.mutex.Unlock()
+		return
+	}
+	s.ended = true
+	s.Duration = time.Since(s.Start)
+	if err != nil {
+		s.Error = err.Error()
+	}
+	root := s == t.root
+	if root {
+		t.done = true
+	}
+	t.mutex.Unlock()
+
+	if root {
+		t.tracer.finish(t, s)
+	}
+}
+
+// start adds a new span to the trace, unless it's complete or has too many spans. The first span is the root span.
+func (t *trace) start(name string, kind Kind, traceId string, parentId string) *Span {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if t.done {
+		return nil
+	}
+	if len(t.spans) >= t.tracer.maxSpans {
+		t.dropped++
+		return nil
+	}
+	span := &Span{
+		TraceId:  traceId,
+		Id:       newId(8),
+		ParentId: parentId,
+		Name:     name,
+		Kind:     kind,
+		Start:    time.Now(),
+		Tags:     make(map[string]string),
+		trace:    t,
+	}
+	if t.root == nil {
+		t.root = span
+	}
+	t.spans = append(t.spans, span)
+	return span
+}
+
+// newId returns a random identifier of size bytes, hex encoded.
+func newId(size int) string {
+	id := make([]byte, size)
+	_, _ = rand.Read(id)
+	return hex.EncodeToString(id)
+}
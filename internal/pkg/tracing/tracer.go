@@ -0,0 +1,212 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+)
+
+const (
+	defaultMaxSpansPerTrace = 64
+	defaultMaxPendingTraces = 256
+	defaultExportQueueSize  = 32
+	defaultExportTimeout    = 5 * time.Second
+)
+
+// Tags of the root span telling why its trace was exported.
+const (
+	SamplingReasonTag = "sampling.reason"
+	DroppedSpansTag   = "sampling.dropped_spans"
+	CorrelationIdTag  = "correlation.id"
+)
+
+// Stats counts the traces handled by the Tracer.
+type Stats struct {
+	// Traced is the number of requests traced
+	Traced uint64
+	// Exported is the number of traces sent to the exporter
+	Exported uint64
+	// Discarded is the number of traces neither failed nor slow, never exported
+	Discarded uint64
+	// Untraced is the number of requests not traced as MaxPendingTraces were
+	Untraced uint64
+	// Dropped is the number of traces sampled but dropped as the export queue was full
+	Dropped uint64
+}
+
+// Tracer traces the requests, buffering the spans of each request until it ends. Only the traces of the requests which
+// ended in error or exceeded the latency threshold are exported, keeping the cost of the export affordable while
+// retaining the traces worth looking at.
+type Tracer struct {
+	latencyThreshold time.Duration
+	maxSpans         int
+	maxPending       int
+	exportTimeout    time.Duration
+	exporter         Exporter
+	exports          chan []Span
+
+	mutex   sync.Mutex
+	pending int
+	stats   Stats
+}
+
+// NewTracer creates a Tracer exporting the sampled traces with exporter.
+func NewTracer(info TracingInfo, exporter Exporter) (*Tracer, error) {
+	t := &Tracer{
+		maxSpans:      defaultMaxSpansPerTrace,
+		maxPending:    defaultMaxPendingTraces,
+		exportTimeout: defaultExportTimeout,
+		exporter:      exporter,
+	}
+	var err error
+	if info.LatencyThreshold != "" {
+		if t.latencyThreshold, err = time.ParseDuration(info.LatencyThreshold); err != nil || t.latencyThreshold <= 0 {
+			return nil, fmt.Errorf("invalid tracing LatencyThreshold '%s'", info.LatencyThreshold)
+		}
+	}
+	if info.ExportTimeout != "" {
+		if t.exportTimeout, err = time.ParseDuration(info.ExportTimeout); err != nil || t.exportTimeout <= 0 {
+			return nil, fmt.Errorf("invalid tracing ExportTimeout '%s'", info.ExportTimeout)
+		}
+	}
+	if info.MaxSpansPerTrace < 0 || info.MaxPendingTraces < 0 || info.ExportQueueSize < 0 {
+		return nil, errors.New("the tracing MaxSpansPerTrace, MaxPendingTraces and ExportQueueSize can't be negative")
+	}
+	if info.MaxSpansPerTrace > 0 {
+		t.maxSpans = info.MaxSpansPerTrace
+	}
+	if info.MaxPendingTraces > 0 {
+		t.maxPending = info.MaxPendingTraces
+	}
+	queueSize := defaultExportQueueSize
+	if info.ExportQueueSize > 0 {
+		queueSize = info.ExportQueueSize
+	}
+	t.exports = make(chan []Span, queueSize)
+	return t, nil
+}
+
+// StartTrace starts the root span of the trace of a request, identified by its correlation ID, and returns the context
+// of the span. The trace of the caller is continued when traceParent is its valid W3C traceparent header, and a new
+// trace is started otherwise. The span is nil when the request isn't traced as too many requests already are.
+func (t *Tracer) StartTrace(ctx context.Context, correlationId string, traceParent string, name string) (context.Context, *Span) {
+	t.mutex.Lock()
+	if t.pending >= t.maxPending {
+		t.stats.Untraced++
+		t.mutex.Unlock()
+		return ctx, nil
+	}
+	t.pending++
+	t.stats.Traced++
+	t.mutex.Unlock()
+
+	tr := &trace{tracer: t}
+	traceId, parentId, continued := parseTraceParent(traceParent)
+	if !continued {
+		traceId = traceIdOf(correlationId)
+	}
+	span := tr.start(name, KindServer, traceId, parentId)
+	if correlationId != "" && traceId != strings.ToLower(strings.ReplaceAll(correlationId, "-", "")) {
+		span.Tags[CorrelationIdTag] = correlationId
+	}
+	return context.WithValue(ctx, spanKey, span), span
+}
+
+// Stats returns the counts of the traces handled so far.
+func (t *Tracer) Stats() Stats {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.stats
+}
+
+// Run exports the sampled traces until ctx is done.
+func (t *Tracer) Run(ctx context.Context, wg *sync.WaitGroup, lc logger.LoggingClient) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case spans := <-t.exports:
+				exportCtx, cancel := context.WithTimeout(ctx, t.exportTimeout)
+				if err := t.exporter.Export(exportCtx, spans); err != nil {
+					lc.Error(fmt.Sprintf("unable to export the trace %s: %v", spans[0].TraceId, err))
+				}
+				cancel()
+			}
+		}
+	}()
+}
+
+// finish samples the trace once its root span has ended: it's queued for its export when one of its spans failed or
+// the request was slow, and discarded otherwise.
+func (t *Tracer) finish(tr *trace, root *Span) {
+	tr.mutex.Lock()
+	var reason string
+	spans := make([]Span, 0, len(tr.spans))
+	for _, s := range tr.spans {
+		if !s.ended {
+			continue
+		}
+		if s.Error != "" && reason == "" {
+			reason = "error"
+		}
+		span := *s
+		span.Tags = make(map[string]string, len(s.Tags))
+		for k, v := range s.Tags {
+			span.Tags[k] = v
+		}
+		spans = append(spans, span)
+	}
+	dropped := tr.dropped
+	tr.mutex.Unlock()
+
+	if reason == "" && t.latencyThreshold > 0 && root.Duration >= t.latencyThreshold {
+		reason = "latency"
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.pending--
+	if reason == "" {
+		t.stats.Discarded++
+		return
+	}
+
+	// the root span is the first one
+	spans[0].Tags[SamplingReasonTag] = reason
+	if dropped > 0 {
+		spans[0].Tags[DroppedSpansTag] = strconv.Itoa(dropped)
+	}
+	select {
+	case t.exports <- spans:
+		t.stats.Exported++
+	default:
+		t.stats.Dropped++
+	}
+}
+
+// traceIdOf returns the trace ID of a request, its correlation ID when it's an UUID so that the trace is found from
+// the logs, or a random ID otherwise.
+func traceIdOf(correlationId string) string {
+	id := strings.ReplaceAll(correlationId, "-", "")
+	if len(id) == 32 {
+		if _, err := hex.DecodeString(id); err == nil {
+			return strings.ToLower(id)
+		}
+	}
+	return newId(16)
+}
@@ -0,0 +1,325 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	goErrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingExporter records the exported traces
+type recordingExporter struct {
+	mutex  sync.Mutex
+	traces [][]Span
+}
+
+func (e *recordingExporter) Export(_ context.Context, spans []Span) error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.traces = append(e.traces, spans)
+	return nil
+}
+
+// queued returns the traces waiting for their export
+func queued(t *Tracer) [][]Span {
+	var traces [][]Span
+	for {
+		select {
+		case spans := <-t.exports:
+			traces = append(traces, spans)
+		default:
+			return traces
+		}
+	}
+}
+
+func TestTailSampling(t *testing.T) {
+	tracer, err := NewTracer(TracingInfo{LatencyThreshold: "20ms"}, &recordingExporter{})
+	require.NoError(t, err)
+
+	// a fast request which succeeded is discarded
+	ctx, root := tracer.StartTrace(context.Background(), "", "", "GET /api/v1/device")
+	_, child := StartSpan(ctx, "database")
+	child.End(nil)
+	root.End(nil)
+	assert.Empty(t, queued(tracer))
+
+	// a request with a failed span is exported, along with all its spans
+	ctx, root = tracer.StartTrace(context.Background(), "", "", "GET /api/v1/device")
+	_, child = StartSpan(ctx, "device service")
+	child.End(goErrors.New("timeout"))
+	root.End(nil)
+	traces := queued(tracer)
+	require.Len(t, traces, 1)
+	require.Len(t, traces[0], 2)
+	assert.Equal(t, "error", traces[0][0].Tags[SamplingReasonTag])
+	assert.Equal(t, traces[0][0].Id, traces[0][1].ParentId)
+	assert.Equal(t, "timeout", traces[0][1].Error)
+
+	// a slow request is exported
+	_, root = tracer.StartTrace(context.Background(), "", "", "GET /api/v1/device")
+	time.Sleep(25 * time.Millisecond)
+	root.End(nil)
+	traces = queued(tracer)
+	require.Len(t, traces, 1)
+	assert.Equal(t, "latency", traces[0][0].Tags[SamplingReasonTag])
+
+	assert.Equal(t, Stats{Traced: 3, Exported: 2, Discarded: 1}, tracer.Stats())
+}
+
+func TestTraceLimits(t *testing.T) {
+	tracer, err := NewTracer(TracingInfo{MaxSpansPerTrace: 2, MaxPendingTraces: 1, ExportQueueSize: 1}, &recordingExporter{})
+	require.NoError(t, err)
+
+	ctx, root := tracer.StartTrace(context.Background(), "", "", "PUT /api/v1/device/{id}/command/{commandId}")
+	_, untraced := tracer.StartTrace(context.Background(), "", "", "GET /api/v1/device")
+	assert.Nil(t, untraced, "requests beyond MaxPendingTraces shouldn't be traced")
+	for i := 0; i < 3; i++ {
+		_, child := StartSpan(ctx, "device service")
+		child.End(goErrors.New("unreachable"))
+	}
+	root.End(nil)
+
+	_, root = tracer.StartTrace(context.Background(), "", "", "GET /api/v1/device")
+	root.End(goErrors.New("failed"))
+
+	traces := queued(tracer)
+	require.Len(t, traces, 1, "traces beyond ExportQueueSize should be dropped")
+	assert.Len(t, traces[0], 2)
+	assert.Equal(t, "2", traces[0][0].Tags[DroppedSpansTag])
+	assert.Equal(t, Stats{Traced: 2, Exported: 1, Untraced: 1, Dropped: 1}, tracer.Stats())
+}
+
+func TestUntracedSpans(t *testing.T) {
+	ctx, span := StartSpan(context.Background(), "device service")
+	assert.Nil(t, span)
+	assert.Nil(t, FromContext(ctx))
+	span.SetTag("device", "thermostat")
+	span.End(goErrors.New("ignored"))
+}
+
+func TestTraceId(t *testing.T) {
+	tracer, err := NewTracer(TracingInfo{}, &recordingExporter{})
+	require.NoError(t, err)
+
+	_, root := tracer.StartTrace(context.Background(), "1E6A9A22-5F3C-4B7A-9C3B-3D0F6C2B1A90", "", "GET /api/v1/ping")
+	assert.Equal(t, "1e6a9a225f3c4b7a9c3b3d0f6c2b1a90", root.TraceId)
+	assert.Empty(t, root.Tags[CorrelationIdTag])
+
+	_, root = tracer.StartTrace(context.Background(), "my-request", "", "GET /api/v1/ping")
+	assert.Len(t, root.TraceId, 32)
+	assert.Equal(t, "my-request", root.Tags[CorrelationIdTag])
+}
+
+func TestMiddleware(t *testing.T) {
+	tracer, err := NewTracer(TracingInfo{}, &recordingExporter{})
+	require.NoError(t, err)
+
+	handler := tracer.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, span := StartSpan(r.Context(), "handler")
+		span.End(nil)
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	}))
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/ok", nil))
+	assert.Empty(t, queued(tracer))
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/fail", nil))
+	traces := queued(tracer)
+	require.Len(t, traces, 1)
+	require.Len(t, traces[0], 2)
+	assert.Equal(t, "502", traces[0][0].Tags["http.status_code"])
+	assert.NotEmpty(t, traces[0][0].Error)
+}
+
+func TestRun(t *testing.T) {
+	exporter := &recordingExporter{}
+	tracer, err := NewTracer(TracingInfo{}, exporter)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	tracer.Run(ctx, wg, logger.NewMockClient())
+
+	_, root := tracer.StartTrace(context.Background(), "", "", "GET /api/v1/device")
+	root.End(goErrors.New("failed"))
+	assert.Eventually(t, func() bool {
+		exporter.mutex.Lock()
+		defer exporter.mutex.Unlock()
+		return len(exporter.traces) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	cancel()
+	wg.Wait()
+}
+
+func TestZipkinExporter(t *testing.T) {
+	var received []zipkinSpan
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer collector.Close()
+
+	start := time.Unix(1600000000, 0)
+	spans := []Span{
+		{TraceId: "1e6a9a225f3c4b7a9c3b3d0f6c2b1a90", Id: "a1b2c3d4e5f60718", Name: "GET /api/v1/device", Kind: KindServer, Start: start, Duration: 1500 * time.Microsecond, Tags: map[string]string{SamplingReasonTag: "error"}},
+		{TraceId: "1e6a9a225f3c4b7a9c3b3d0f6c2b1a90", Id: "0102030405060708", ParentId: "a1b2c3d4e5f60718", Name: "device service", Kind: KindClient, Start: start, Duration: time.Millisecond, Error: "timeout", Tags: map[string]string{}},
+	}
+	require.NoError(t, NewZipkinExporter(collector.URL, "edgex-core-command").Export(context.Background(), spans))
+
+	require.Len(t, received, 2)
+	assert.Equal(t, "SERVER", received[0].Kind)
+	assert.Equal(t, int64(1600000000000000), received[0].Timestamp)
+	assert.Equal(t, int64(1500), received[0].Duration)
+	assert.Equal(t, "edgex-core-command", received[0].LocalEndpoint.ServiceName)
+	assert.Equal(t, "a1b2c3d4e5f60718", received[1].ParentId)
+	assert.Equal(t, "CLIENT", received[1].Kind)
+	assert.Equal(t, "timeout", received[1].Tags["error"])
+	assert.Empty(t, spans[1].Tags, "the spans exported shouldn't be modified")
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer failing.Close()
+	assert.Error(t, NewZipkinExporter(failing.URL, "edgex-core-command").Export(context.Background(), spans))
+}
+
+func TestOTLPExporter(t *testing.T) {
+	var received otlpTraces
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+	}))
+	defer collector.Close()
+
+	start := time.Unix(1600000000, 0)
+	spans := []Span{
+		{TraceId: "1e6a9a225f3c4b7a9c3b3d0f6c2b1a90", Id: "a1b2c3d4e5f60718", Name: "POST /api/v1/event", Kind: KindServer, Start: start, Duration: 1500 * time.Microsecond, Tags: map[string]string{SamplingReasonTag: "latency", "http.method": "POST"}},
+		{TraceId: "1e6a9a225f3c4b7a9c3b3d0f6c2b1a90", Id: "0102030405060708", ParentId: "a1b2c3d4e5f60718", Name: "publish events", Kind: KindProducer, Start: start, Duration: time.Millisecond, Error: "disconnected", Tags: map[string]string{}},
+	}
+	require.NoError(t, NewOTLPExporter(collector.URL, "edgex-core-data").Export(context.Background(), spans))
+
+	require.Len(t, received.ResourceSpans, 1)
+	assert.Equal(t, []otlpAttribute{{Key: "service.name", Value: otlpValue{StringValue: "edgex-core-data"}}},
+		received.ResourceSpans[0].Resource.Attributes)
+	require.Len(t, received.ResourceSpans[0].ScopeSpans, 1)
+	exported := received.ResourceSpans[0].ScopeSpans[0].Spans
+	require.Len(t, exported, 2)
+	assert.Equal(t, otlpSpan{
+		TraceId:           "1e6a9a225f3c4b7a9c3b3d0f6c2b1a90",
+		SpanId:            "a1b2c3d4e5f60718",
+		Name:              "POST /api/v1/event",
+		Kind:              2,
+		StartTimeUnixNano: "1600000000000000000",
+		EndTimeUnixNano:   "1600000000001500000",
+		Attributes: []otlpAttribute{
+			{Key: "http.method", Value: otlpValue{StringValue: "POST"}},
+			{Key: SamplingReasonTag, Value: otlpValue{StringValue: "latency"}},
+		},
+	}, exported[0])
+	assert.Equal(t, "a1b2c3d4e5f60718", exported[1].ParentSpanId)
+	assert.Equal(t, 4, exported[1].Kind)
+	assert.Equal(t, otlpStatus{Code: otlpStatusError, Message: "disconnected"}, exported[1].Status)
+}
+
+func TestNewExporter(t *testing.T) {
+	lc := logger.NewMockClient()
+
+	exporter, err := NewExporter(TracingInfo{Exporter: OTLPExporterName}, "edgex-core-data", lc)
+	require.NoError(t, err)
+	assert.IsType(t, &LogExporter{}, exporter, "the traces should be logged without an endpoint")
+
+	exporter, err = NewExporter(TracingInfo{Exporter: OTLPExporterName, Endpoint: "http://localhost:4318/v1/traces"}, "edgex-core-data", lc)
+	require.NoError(t, err)
+	assert.IsType(t, &OTLPExporter{}, exporter)
+
+	exporter, err = NewExporter(TracingInfo{Endpoint: "http://localhost:9411/api/v2/spans"}, "edgex-core-data", lc)
+	require.NoError(t, err)
+	assert.IsType(t, &ZipkinExporter{}, exporter)
+
+	_, err = NewExporter(TracingInfo{Exporter: "jaeger", Endpoint: "http://localhost:14268"}, "edgex-core-data", lc)
+	assert.Error(t, err)
+}
+
+func TestContinuedTrace(t *testing.T) {
+	tracer, err := NewTracer(TracingInfo{}, &recordingExporter{})
+	require.NoError(t, err)
+
+	traceParent := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	ctx, root := tracer.StartTrace(context.Background(), "my-request", traceParent, "PUT /api/v1/device/{id}/command/{commandId}")
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", root.TraceId)
+	assert.Equal(t, "00f067aa0ba902b7", root.ParentId)
+	assert.Equal(t, KindServer, root.Kind)
+
+	ctx, child := StartSpanOfKind(ctx, KindClient, "device service")
+	header := http.Header{}
+	Inject(ctx, header)
+	assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-"+child.Id+"-01", header.Get(TraceParentHeader))
+	child.End(goErrors.New("timeout"))
+	root.End(nil)
+
+	traces := queued(tracer)
+	require.Len(t, traces, 1, "the trace should be complete once its root span ends, even with a remote parent")
+	require.Len(t, traces[0], 2)
+	assert.Equal(t, "error", traces[0][0].Tags[SamplingReasonTag])
+	assert.Equal(t, KindClient, traces[0][1].Kind)
+
+	header = http.Header{}
+	Inject(context.Background(), header)
+	assert.Empty(t, header, "nothing should be injected for an untraced request")
+}
+
+func TestParseTraceParent(t *testing.T) {
+	traceId, parentId, ok := parseTraceParent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00")
+	assert.True(t, ok)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", traceId)
+	assert.Equal(t, "00f067aa0ba902b7", parentId)
+
+	_, _, ok = parseTraceParent("01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-future")
+	assert.True(t, ok, "the fields added by later versions should be ignored")
+
+	for _, invalid := range []string{
+		"",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra",
+		"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+		"00-4bf92f3577b34da6-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902zz-01",
+	} {
+		_, _, ok = parseTraceParent(invalid)
+		assert.False(t, ok, invalid)
+	}
+}
+
+func TestNewTracerInvalidInfo(t *testing.T) {
+	for _, info := range []TracingInfo{
+		{LatencyThreshold: "fast"},
+		{LatencyThreshold: "-1s"},
+		{ExportTimeout: "0s"},
+		{MaxSpansPerTrace: -1},
+	} {
+		_, err := NewTracer(info, &recordingExporter{})
+		assert.Error(t, err, info)
+	}
+}
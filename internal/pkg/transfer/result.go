@@ -0,0 +1,63 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package transfer holds what the import endpoints of the services, which replicate the configuration of one site to
+// others, have in common.
+package transfer
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+)
+
+// ImportResult reports, per entry of an imported document, whether it was created, updated or rejected.
+type ImportResult struct {
+	Created []string          `json:"created"`
+	Updated []string          `json:"updated"`
+	Failed  map[string]string `json:"failed,omitempty"`
+}
+
+// NewImportResult returns an empty ImportResult, whose created and updated entries are encoded as empty lists.
+func NewImportResult() *ImportResult {
+	return &ImportResult{Created: []string{}, Updated: []string{}}
+}
+
+// Create reports the entry of key as created.
+func (r *ImportResult) Create(key string) {
+	r.Created = append(r.Created, key)
+}
+
+// Update reports the entry of key as updated.
+func (r *ImportResult) Update(key string) {
+	r.Updated = append(r.Updated, key)
+}
+
+// Fail reports the entry of key as rejected because of err.
+func (r *ImportResult) Fail(key string, err error) {
+	if r.Failed == nil {
+		r.Failed = make(map[string]string)
+	}
+	r.Failed[key] = err.Error()
+}
+
+// Write logs the rejected entries and answers with the result, with 207 when some entries were rejected.
+func (r *ImportResult) Write(w http.ResponseWriter, lc logger.LoggingClient) {
+	status := http.StatusOK
+	if len(r.Failed) > 0 {
+		for key, reason := range r.Failed {
+			lc.Error(fmt.Sprintf("Failed to import %s: %s", key, reason))
+		}
+		status = http.StatusMultiStatus
+	}
+	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(r); err != nil {
+		lc.Error("Error encoding the data: " + err.Error())
+	}
+}
@@ -0,0 +1,38 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package transfer
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportResultWrite(t *testing.T) {
+	rr := httptest.NewRecorder()
+	NewImportResult().Write(rr, logger.NewMockClient())
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.JSONEq(t, `{"created":[],"updated":[]}`, rr.Body.String())
+
+	result := NewImportResult()
+	result.Create("interval/daily")
+	result.Update("interval/hourly")
+	result.Fail("intervalAction/purge", errors.New("interval is required"))
+	rr = httptest.NewRecorder()
+	result.Write(rr, logger.NewMockClient())
+	assert.Equal(t, http.StatusMultiStatus, rr.Code)
+
+	var written ImportResult
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&written))
+	assert.Equal(t, *result, written)
+}
@@ -0,0 +1,52 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package trustedproxy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+
+	"github.com/gorilla/mux"
+)
+
+// Bootstrap contains references to dependencies required by the BootstrapHandler.
+type Bootstrap struct {
+	router *mux.Router
+	info   *TrustedProxyInfo
+}
+
+// NewBootstrap is a factory method that returns an initialized Bootstrap receiver struct.
+func NewBootstrap(router *mux.Router, info *TrustedProxyInfo) *Bootstrap {
+	return &Bootstrap{
+		router: router,
+		info:   info,
+	}
+}
+
+// BootstrapHandler fulfills the BootstrapHandler contract. When enabled, the client and scheme of every request
+// answered by the router are resolved from the forwarded headers of the trusted proxies.
+func (b *Bootstrap) BootstrapHandler(_ context.Context, _ *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
+	if !b.info.Enabled {
+		return true
+	}
+
+	lc := container.LoggingClientFrom(dic.Get)
+	proxies, err := NewProxies(b.info.Proxies)
+	if err != nil {
+		lc.Error(err.Error())
+		return false
+	}
+	b.router.Use(proxies.Middleware())
+
+	lc.Info(fmt.Sprintf("Honoring the forwarded headers of the trusted proxies %s", strings.Join(b.info.Proxies, ", ")))
+	return true
+}
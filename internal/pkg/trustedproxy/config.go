@@ -0,0 +1,16 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package trustedproxy
+
+// TrustedProxyInfo provides properties related to the reverse proxies, e.g. the API gateway, the requests are received
+// through
+type TrustedProxyInfo struct {
+	// Enabled indicates whether the X-Forwarded-For and X-Forwarded-Proto headers are honored
+	Enabled bool
+	// Proxies are the addresses or CIDR ranges of the trusted proxies, e.g. '172.17.0.0/16'; the forwarded headers of
+	// the requests received from any other address are ignored
+	Proxies []string
+}
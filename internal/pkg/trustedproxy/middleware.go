@@ -0,0 +1,26 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package trustedproxy
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+// Middleware records the client and scheme of the requests in their context, read from the forwarded headers when the
+// requests are received from a trusted proxy, before they reach their handler.
+func (p *Proxies) Middleware() mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			client, scheme := p.Resolve(r)
+			ctx := context.WithValue(r.Context(), clientIPKey, client)
+			ctx = context.WithValue(ctx, schemeKey, scheme)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
@@ -0,0 +1,169 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package trustedproxy
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+const (
+	ForwardedForHeader   = "X-Forwarded-For"
+	ForwardedProtoHeader = "X-Forwarded-Proto"
+)
+
+type contextKey int
+
+const (
+	clientIPKey contextKey = iota
+	schemeKey
+)
+
+// Proxies are the trusted reverse proxies, whose forwarded headers tell the client and scheme of the requests.
+type Proxies struct {
+	networks []*net.IPNet
+}
+
+// NewProxies parses the addresses and CIDR ranges of the trusted proxies.
+func NewProxies(addresses []string) (*Proxies, error) {
+	p := &Proxies{}
+	for _, address := range addresses {
+		address = strings.TrimSpace(address)
+		if strings.Contains(address, "/") {
+			_, network, err := net.ParseCIDR(address)
+			if err != nil {
+				return nil, fmt.Errorf("invalid trusted proxy range '%s': %v", address, err)
+			}
+			p.networks = append(p.networks, network)
+			continue
+		}
+		ip := net.ParseIP(address)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid trusted proxy address '%s'", address)
+		}
+		bits := 8 * net.IPv4len
+		if ip.To4() == nil {
+			bits = 8 * net.IPv6len
+		}
+		p.networks = append(p.networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+	}
+	return p, nil
+}
+
+// Trusts tells whether ip is the address of a trusted proxy.
+func (p *Proxies) Trusts(ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+	for _, network := range p.networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// Resolve returns the address of the client and the scheme of r. When r is received from a trusted proxy, the client
+// is the last address of X-Forwarded-For which isn't a trusted proxy, and the scheme is the one of X-Forwarded-Proto;
+// otherwise they are the peer of the connection and its scheme.
+func (p *Proxies) Resolve(r *http.Request) (string, string) {
+	client, scheme := remoteIP(r), connectionScheme(r)
+	if !p.Trusts(net.ParseIP(client)) {
+		return client, scheme
+	}
+
+	hops := forwardedFor(r)
+	for i := len(hops) - 1; i >= 0; i-- {
+		ip := net.ParseIP(hops[i])
+		if ip == nil {
+			// the hops before a malformed one can't be relied upon
+			break
+		}
+		client = ip.String()
+		if !p.Trusts(ip) {
+			break
+		}
+	}
+	if proto := forwardedProto(r); proto != "" {
+		scheme = proto
+	}
+	return client, scheme
+}
+
+// forwardedFor returns the addresses of X-Forwarded-For, from the client to the last proxy, across all the headers.
+func forwardedFor(r *http.Request) []string {
+	var hops []string
+	for _, header := range r.Header.Values(ForwardedForHeader) {
+		for _, hop := range strings.Split(header, ",") {
+			hops = append(hops, strings.TrimSpace(hop))
+		}
+	}
+	return hops
+}
+
+// forwardedProto returns the scheme of X-Forwarded-Proto set by the nearest proxy, when it's http or https.
+func forwardedProto(r *http.Request) string {
+	values := r.Header.Values(ForwardedProtoHeader)
+	if len(values) == 0 {
+		return ""
+	}
+	protos := strings.Split(values[len(values)-1], ",")
+	proto := strings.ToLower(strings.TrimSpace(protos[len(protos)-1]))
+	if proto != "http" && proto != "https" {
+		return ""
+	}
+	return proto
+}
+
+// remoteIP returns the address of the peer of the connection of r.
+func remoteIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func connectionScheme(r *http.Request) string {
+	if r.TLS != nil {
+		return "https"
+	}
+	return "http"
+}
+
+// ClientIP returns the address of the client of r, as resolved by the middleware from the forwarded headers, or the
+// peer of the connection when the request didn't go through the middleware.
+func ClientIP(r *http.Request) string {
+	if client, ok := r.Context().Value(clientIPKey).(string); ok {
+		return client
+	}
+	return remoteIP(r)
+}
+
+// Scheme returns the scheme the client sent r with, as resolved by the middleware from the forwarded headers, or the
+// scheme of the connection when the request didn't go through the middleware.
+func Scheme(r *http.Request) string {
+	if scheme, ok := r.Context().Value(schemeKey).(string); ok {
+		return scheme
+	}
+	return connectionScheme(r)
+}
+
+// URL returns serviceUrl, e.g. 'http://localhost:48082', with the scheme the client of the request of ctx used, so that
+// the URLs generated from it are reachable through the proxy.
+func URL(ctx context.Context, serviceUrl string) string {
+	scheme, ok := ctx.Value(schemeKey).(string)
+	if !ok {
+		return serviceUrl
+	}
+	if i := strings.Index(serviceUrl, "://"); i >= 0 {
+		return scheme + serviceUrl[i:]
+	}
+	return serviceUrl
+}
@@ -0,0 +1,97 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package trustedproxy
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProxies(t *testing.T) {
+	_, err := NewProxies([]string{"10.0.0.1", "172.17.0.0/16", "::1", "fd00::/8"})
+	require.NoError(t, err)
+
+	for _, address := range []string{"gateway", "10.0.0.0/33", "10.0.0"} {
+		_, err := NewProxies([]string{address})
+		assert.Error(t, err, address)
+	}
+}
+
+func TestResolve(t *testing.T) {
+	proxies, err := NewProxies([]string{"10.0.0.1", "172.17.0.0/16"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		remoteAddr     string
+		forwardedFor   []string
+		forwardedProto string
+		expectedClient string
+		expectedScheme string
+	}{
+		{"Direct request", "192.168.1.5:51000", nil, "", "192.168.1.5", "http"},
+		{"Untrusted peer", "192.168.1.5:51000", []string{"1.2.3.4"}, "https", "192.168.1.5", "http"},
+		{"Trusted proxy", "10.0.0.1:51000", []string{"1.2.3.4"}, "https", "1.2.3.4", "https"},
+		{"Trusted range", "172.17.0.3:51000", []string{"1.2.3.4"}, "", "1.2.3.4", "http"},
+		{"Chain of proxies", "10.0.0.1:51000", []string{"1.2.3.4, 172.17.0.2"}, "https", "1.2.3.4", "https"},
+		{"Spoofed hop", "10.0.0.1:51000", []string{"6.6.6.6, 1.2.3.4"}, "", "1.2.3.4", "http"},
+		{"Several headers", "10.0.0.1:51000", []string{"6.6.6.6", "1.2.3.4, 172.17.0.2"}, "", "1.2.3.4", "http"},
+		{"Only proxies", "10.0.0.1:51000", []string{"172.17.0.2"}, "", "172.17.0.2", "http"},
+		{"Malformed hop", "10.0.0.1:51000", []string{"1.2.3.4, unknown"}, "", "10.0.0.1", "http"},
+		{"No forwarded header", "10.0.0.1:51000", nil, "", "10.0.0.1", "http"},
+		{"Unknown scheme", "10.0.0.1:51000", []string{"1.2.3.4"}, "ftp", "1.2.3.4", "http"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/device", nil)
+			req.RemoteAddr = tt.remoteAddr
+			for _, value := range tt.forwardedFor {
+				req.Header.Add(ForwardedForHeader, value)
+			}
+			if tt.forwardedProto != "" {
+				req.Header.Set(ForwardedProtoHeader, tt.forwardedProto)
+			}
+
+			client, scheme := proxies.Resolve(req)
+			assert.Equal(t, tt.expectedClient, client)
+			assert.Equal(t, tt.expectedScheme, scheme)
+		})
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	proxies, err := NewProxies([]string{"10.0.0.1"})
+	require.NoError(t, err)
+
+	var client, scheme, url string
+	handler := proxies.Middleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		client, scheme = ClientIP(r), Scheme(r)
+		url = URL(r.Context(), "http://localhost:48082")
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/device", nil)
+	req.RemoteAddr = "10.0.0.1:51000"
+	req.Header.Set(ForwardedForHeader, "1.2.3.4")
+	req.Header.Set(ForwardedProtoHeader, "https")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	assert.Equal(t, "1.2.3.4", client)
+	assert.Equal(t, "https", scheme)
+	assert.Equal(t, "https://localhost:48082", url)
+}
+
+func TestWithoutMiddleware(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/device", nil)
+	req.RemoteAddr = "192.168.1.5:51000"
+	req.Header.Set(ForwardedForHeader, "1.2.3.4")
+	req.Header.Set(ForwardedProtoHeader, "https")
+	assert.Equal(t, "192.168.1.5", ClientIP(req))
+	assert.Equal(t, "http", Scheme(req))
+	assert.Equal(t, "http://localhost:48082", URL(req.Context(), "http://localhost:48082"))
+}
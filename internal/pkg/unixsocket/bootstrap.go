@@ -0,0 +1,74 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package unixsocket
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+
+	"github.com/gorilla/mux"
+)
+
+// tcpServer defines the contract of the HTTP server bootstrap handler serving the API on the TCP port.
+type tcpServer interface {
+	BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, startupTimer startup.Timer, dic *di.Container) bool
+	IsRunning() bool
+}
+
+// Bootstrap contains references to dependencies required by the BootstrapHandler.
+type Bootstrap struct {
+	router *mux.Router
+	info   *UnixSocketInfo
+	tcp    tcpServer
+	mutex  sync.RWMutex
+	server *Server
+}
+
+// NewBootstrap is a factory method that returns an initialized Bootstrap receiver struct.
+func NewBootstrap(router *mux.Router, info *UnixSocketInfo, tcp tcpServer) *Bootstrap {
+	return &Bootstrap{
+		router: router,
+		info:   info,
+		tcp:    tcp,
+	}
+}
+
+// BootstrapHandler fulfills the BootstrapHandler contract, in place of the one of the TCP server. When the socket is
+// enabled, the router is served on it, and on the TCP port as well unless TcpDisabled is set.
+func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, startupTimer startup.Timer, dic *di.Container) bool {
+	if !b.info.Enabled {
+		return b.tcp.BootstrapHandler(ctx, wg, startupTimer, dic)
+	}
+
+	lc := container.LoggingClientFrom(dic.Get)
+	server := NewServer(*b.info, b.router, lc)
+	if err := server.Start(ctx, wg); err != nil {
+		lc.Error(fmt.Sprintf("unable to serve on unix socket %s: %v", b.info.Path, err))
+		return false
+	}
+	b.mutex.Lock()
+	b.server = server
+	b.mutex.Unlock()
+	lc.Info("Serving on unix socket " + b.info.Path)
+
+	if b.info.TcpDisabled {
+		lc.Info("Not serving on the TCP port, disabled in favor of the unix socket")
+		return true
+	}
+	return b.tcp.BootstrapHandler(ctx, wg, startupTimer, dic)
+}
+
+// IsRunning reports whether the API is served, on the TCP port or on the socket.
+func (b *Bootstrap) IsRunning() bool {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+	return b.tcp.IsRunning() || (b.server != nil && b.server.IsRunning())
+}
@@ -0,0 +1,23 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package unixsocket
+
+// UnixSocketInfo provides properties related to serving the HTTP API on a Unix domain socket, for single-host
+// deployments that don't want the API exposed on the network
+type UnixSocketInfo struct {
+	// Enabled indicates whether the API is served on the socket
+	Enabled bool
+	// Path of the socket file, e.g. '/run/edgex/core-data.sock'
+	Path string
+	// Mode is the octal file mode of the socket, e.g. '0660'
+	Mode string
+	// TcpDisabled indicates whether the API is served on the socket only, instead of in addition to the TCP port
+	TcpDisabled bool
+	// AllowedUids and AllowedGids are the users and groups of the peer processes allowed to send requests on the
+	// socket; when both are empty, only the user running the service and root are allowed
+	AllowedUids []int
+	AllowedGids []int
+}
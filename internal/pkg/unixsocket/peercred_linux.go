@@ -0,0 +1,40 @@
+// +build linux
+
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package unixsocket
+
+import (
+	"fmt"
+	"net"
+	"syscall"
+)
+
+// peerCredentials returns the credentials of the process at the other end of the connection, as the kernel recorded
+// them when the connection was made.
+func peerCredentials(conn net.Conn) (Credentials, error) {
+	unixConn, ok := conn.(*net.UnixConn)
+	if !ok {
+		return Credentials{}, fmt.Errorf("%s connection has no peer credentials", conn.LocalAddr().Network())
+	}
+	raw, err := unixConn.SyscallConn()
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	var ucred *syscall.Ucred
+	var credErr error
+	err = raw.Control(func(fd uintptr) {
+		ucred, credErr = syscall.GetsockoptUcred(int(fd), syscall.SOL_SOCKET, syscall.SO_PEERCRED)
+	})
+	if err != nil {
+		return Credentials{}, err
+	}
+	if credErr != nil {
+		return Credentials{}, credErr
+	}
+	return Credentials{Pid: int(ucred.Pid), Uid: int(ucred.Uid), Gid: int(ucred.Gid)}, nil
+}
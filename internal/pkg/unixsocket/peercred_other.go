@@ -0,0 +1,18 @@
+// +build !linux
+
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package unixsocket
+
+import (
+	"errors"
+	"net"
+)
+
+// peerCredentials isn't implemented outside of Linux, so that every request on the socket is denied.
+func peerCredentials(_ net.Conn) (Credentials, error) {
+	return Credentials{}, errors.New("peer credentials are only supported on Linux")
+}
@@ -0,0 +1,202 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package unixsocket
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+)
+
+// defaultMode lets the user and the group of the service connect to the socket.
+const defaultMode = 0660
+
+// Credentials identify the process at the other end of a connection to the socket.
+type Credentials struct {
+	Pid int
+	Uid int
+	Gid int
+}
+
+// Policy decides which peer processes are allowed to send requests on the socket.
+type Policy struct {
+	uids map[int]bool
+	gids map[int]bool
+}
+
+// NewPolicy returns the policy allowing the users and groups of the info, or the user running the service and root
+// when none is.
+func NewPolicy(info UnixSocketInfo) Policy {
+	p := Policy{uids: map[int]bool{}, gids: map[int]bool{}}
+	if len(info.AllowedUids) == 0 && len(info.AllowedGids) == 0 {
+		p.uids[os.Getuid()] = true
+		p.uids[0] = true
+	}
+	for _, uid := range info.AllowedUids {
+		p.uids[uid] = true
+	}
+	for _, gid := range info.AllowedGids {
+		p.gids[gid] = true
+	}
+	return p
+}
+
+// Allows reports whether the peer process is allowed, by its user or its group.
+func (p Policy) Allows(c Credentials) bool {
+	return p.uids[c.Uid] || p.gids[c.Gid]
+}
+
+// Server serves an HTTP handler on a Unix domain socket to the peer processes allowed by its policy.
+type Server struct {
+	info    UnixSocketInfo
+	handler http.Handler
+	lc      logger.LoggingClient
+	mutex   sync.RWMutex
+	running bool
+}
+
+// NewServer returns a server of the handler on the socket of the info.
+func NewServer(info UnixSocketInfo, handler http.Handler, lc logger.LoggingClient) *Server {
+	return &Server{
+		info:    info,
+		handler: handler,
+		lc:      lc,
+	}
+}
+
+// Start listens on the socket, replacing the one a previous run may have left behind, and serves the requests until
+// ctx is done. The socket file is removed once the server is shut down.
+func (s *Server) Start(ctx context.Context, wg *sync.WaitGroup) error {
+	mode, err := parseMode(s.info.Mode)
+	if err != nil {
+		return err
+	}
+	if err = removeStaleSocket(s.info.Path); err != nil {
+		return err
+	}
+
+	listener, err := net.Listen("unix", s.info.Path)
+	if err != nil {
+		return err
+	}
+	if err = os.Chmod(s.info.Path, mode); err != nil {
+		_ = listener.Close()
+		return err
+	}
+
+	server := &http.Server{
+		Handler:     s.authorize(NewPolicy(s.info), s.handler),
+		ConnContext: withPeerCredentials,
+	}
+	s.setRunning(true)
+
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+
+		<-ctx.Done()
+		_ = server.Shutdown(context.Background())
+	}()
+	go func() {
+		defer wg.Done()
+		defer s.setRunning(false)
+
+		if err := server.Serve(listener); err != http.ErrServerClosed {
+			s.lc.Error(fmt.Sprintf("unix socket %s server stopped: %v", s.info.Path, err))
+		}
+	}()
+	return nil
+}
+
+// IsRunning reports whether the server is serving requests.
+func (s *Server) IsRunning() bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.running
+}
+
+func (s *Server) setRunning(running bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.running = running
+}
+
+// authorize answers 403 to the requests of the peer processes the policy doesn't allow, or whose credentials are
+// unknown, before they reach the handler.
+func (s *Server) authorize(policy Policy, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p, ok := r.Context().Value(peerKey{}).(peer)
+		if !ok || p.err != nil {
+			s.lc.Error(fmt.Sprintf("%s %s denied on the unix socket, unknown peer: %v", r.Method, r.URL.Path, p.err))
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+		if !policy.Allows(p.credentials) {
+			s.lc.Warn(fmt.Sprintf(
+				"%s %s denied on the unix socket to pid %d, uid %d, gid %d",
+				r.Method,
+				r.URL.Path,
+				p.credentials.Pid,
+				p.credentials.Uid,
+				p.credentials.Gid))
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// PeerCredentials returns the credentials of the peer process which sent the request on the socket, if known.
+func PeerCredentials(r *http.Request) (Credentials, bool) {
+	p, ok := r.Context().Value(peerKey{}).(peer)
+	return p.credentials, ok && p.err == nil
+}
+
+type peerKey struct{}
+
+// peer holds the credentials of the peer process of a connection, or why they are unknown.
+type peer struct {
+	credentials Credentials
+	err         error
+}
+
+// withPeerCredentials stores the credentials of the peer process in the context of the requests of the connection.
+func withPeerCredentials(ctx context.Context, conn net.Conn) context.Context {
+	credentials, err := peerCredentials(conn)
+	return context.WithValue(ctx, peerKey{}, peer{credentials: credentials, err: err})
+}
+
+func parseMode(mode string) (os.FileMode, error) {
+	if mode == "" {
+		return defaultMode, nil
+	}
+	m, err := strconv.ParseUint(mode, 8, 32)
+	if err != nil || m > 0777 {
+		return 0, fmt.Errorf("invalid unix socket mode '%s'", mode)
+	}
+	return os.FileMode(m), nil
+}
+
+// removeStaleSocket removes the socket left at the path by a previous run, refusing to remove any other kind of file.
+func removeStaleSocket(path string) error {
+	info, err := os.Lstat(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if info.Mode()&os.ModeSocket == 0 {
+		return fmt.Errorf("%s exists and is not a unix socket", path)
+	}
+	return os.Remove(path)
+}
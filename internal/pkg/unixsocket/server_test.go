@@ -0,0 +1,109 @@
+// +build linux
+
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package unixsocket
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer(t *testing.T) {
+	uid := os.Getuid()
+	tests := []struct {
+		name           string
+		allowedUids    []int
+		expectedStatus int
+	}{
+		{"OK default policy", nil, http.StatusOK},
+		{"OK allowed uid", []int{uid}, http.StatusOK},
+		{"Forbidden uid", []int{uid + 1}, http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "test.sock")
+			info := UnixSocketInfo{Enabled: true, Path: path, Mode: "0600", AllowedUids: tt.allowedUids}
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) })
+
+			ctx, cancel := context.WithCancel(context.Background())
+			wg := &sync.WaitGroup{}
+			server := NewServer(info, handler, logger.NewMockClient())
+			require.NoError(t, server.Start(ctx, wg))
+			assert.True(t, server.IsRunning())
+
+			stat, err := os.Stat(path)
+			require.NoError(t, err)
+			assert.Equal(t, os.FileMode(0600), stat.Mode().Perm())
+
+			client := http.Client{Transport: &http.Transport{
+				DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+					return (&net.Dialer{}).DialContext(ctx, "unix", path)
+				},
+			}}
+			resp, err := client.Get("http://unix/api/v1/ping")
+			require.NoError(t, err)
+			_ = resp.Body.Close()
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			cancel()
+			wg.Wait()
+			assert.False(t, server.IsRunning())
+			_, err = os.Stat(path)
+			assert.True(t, os.IsNotExist(err), "socket removed on shutdown")
+		})
+	}
+}
+
+func TestServerStaleSocket(t *testing.T) {
+	dir := t.TempDir()
+	info := UnixSocketInfo{Enabled: true, Path: filepath.Join(dir, "test.sock")}
+	handler := http.NotFoundHandler()
+
+	// a previous run that didn't clean up its socket
+	listener, err := net.Listen("unix", info.Path)
+	require.NoError(t, err)
+	listener.(*net.UnixListener).SetUnlinkOnClose(false)
+	require.NoError(t, listener.Close())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	require.NoError(t, NewServer(info, handler, logger.NewMockClient()).Start(ctx, wg))
+	cancel()
+	wg.Wait()
+
+	// any other file is left alone
+	info.Path = filepath.Join(dir, "regular")
+	require.NoError(t, ioutil.WriteFile(info.Path, nil, 0600))
+	assert.Error(t, NewServer(info, handler, logger.NewMockClient()).Start(context.Background(), wg))
+}
+
+func TestParseMode(t *testing.T) {
+	mode, err := parseMode("")
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0660), mode)
+
+	mode, err = parseMode("0666")
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0666), mode)
+
+	_, err = parseMode("rw-rw----")
+	assert.Error(t, err)
+	_, err = parseMode("7777")
+	assert.Error(t, err)
+}
@@ -0,0 +1,103 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+)
+
+const (
+	// MigrateDryRunFlag is the command-line flag naming the file the report of the migration dry run is written to.
+	MigrateDryRunFlag = "migrate-dry-run"
+	// MigrateDryRunUsage describes the MigrateDryRunFlag.
+	MigrateDryRunUsage = "Scan the existing data for the migration to the v2 keys, write a JSON report to the given file and exit"
+
+	// migrationIncompatibleExitCode is the exit code of a dry run which found objects which can't be migrated as is.
+	migrationIncompatibleExitCode = 2
+)
+
+// migrationDryRunner is implemented by the database clients able to scan the existing data for the migration.
+type migrationDryRunner interface {
+	MetadataMigrationDryRun() (pkgModels.MigrationReport, errors.EdgeX)
+}
+
+// MigrationDryRun contains references to dependencies required by the migration dry run bootstrap implementation.
+type MigrationDryRun struct {
+	reportPath            string
+	dBClientInterfaceName string
+}
+
+// NewMigrationDryRun is a factory method that returns an initialized MigrationDryRun receiver struct writing its report
+// to reportPath.
+func NewMigrationDryRun(reportPath string, dBClientInterfaceName string) MigrationDryRun {
+	return MigrationDryRun{
+		reportPath:            reportPath,
+		dBClientInterfaceName: dBClientInterfaceName,
+	}
+}
+
+// BootstrapHandler fulfills the BootstrapHandler contract. It scans the existing data with the database client, writes
+// the report and exits, with the status 2 when objects can't be migrated as is, instead of completing the startup.
+func (m MigrationDryRun) BootstrapHandler(
+	_ context.Context,
+	_ *sync.WaitGroup,
+	_ startup.Timer,
+	dic *di.Container) bool {
+
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	runner, ok := dic.Get(m.dBClientInterfaceName).(migrationDryRunner)
+	if !ok {
+		lc.Error("the migration dry run isn't supported by the configured database")
+		return false
+	}
+
+	lc.Info("Migration dry run started, the existing data is only read")
+	report, edgeXerr := runner.MetadataMigrationDryRun()
+	if edgeXerr != nil {
+		lc.Error(fmt.Sprintf("migration dry run failed: %s", edgeXerr.Error()))
+		return false
+	}
+
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		lc.Error(fmt.Sprintf("unable to encode the migration report: %s", err.Error()))
+		return false
+	}
+	if err = ioutil.WriteFile(m.reportPath, data, 0644); err != nil {
+		lc.Error(fmt.Sprintf("unable to write the migration report to %s: %s", m.reportPath, err.Error()))
+		return false
+	}
+
+	lc.Info(fmt.Sprintf(
+		"Migration dry run completed: %d objects, %d bytes, estimated duration %s and additional space %d bytes, "+
+			"%d incompatibilities, report written to %s",
+		report.Objects,
+		report.Bytes,
+		report.EstimatedDuration,
+		report.EstimatedBytes,
+		len(report.Incompatibilities),
+		m.reportPath))
+
+	if !report.Compatible {
+		os.Exit(migrationIncompatibleExitCode)
+	}
+	os.Exit(0)
+	return true
+}
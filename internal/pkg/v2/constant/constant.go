@@ -0,0 +1,75 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package constant contains the v2 API routes and parameters which are specific to this repository and not (yet)
+// defined by go-mod-core-contracts.
+package constant
+
+import (
+	v2 "github.com/edgexfoundry/go-mod-core-contracts/v2"
+)
+
+// Routes
+const (
+	ApiDeviceNearRoute               = v2.ApiDeviceRoute + "/" + Near
+	ApiDeviceByZoneRoute             = v2.ApiDeviceRoute + "/" + Zone + "/{" + Zone + "}"
+	ApiDeviceByAliasRoute            = v2.ApiDeviceRoute + "/" + Alias + "/{" + Alias + "}"
+	ApiDeviceAliasesByNameRoute      = v2.ApiDeviceByNameRoute + "/" + Alias
+	ApiDeviceDecommissionByNameRoute = v2.ApiDeviceByNameRoute + "/" + Decommission
+	ApiDeviceCommandsByNameRoute     = v2.ApiDeviceByNameRoute + "/" + Commands + "/" + Describe
+
+	ApiCommandJobByIdRoute       = v2.ApiBase + "/" + Command + "/" + Job + "/{" + v2.Id + "}"
+	ApiScheduledCommandRoute     = v2.ApiBase + "/" + Command + "/" + Scheduled
+	ApiScheduledCommandByIdRoute = ApiScheduledCommandRoute + "/{" + v2.Id + "}"
+
+	ApiIntervalNextRunsByNameRoute      = v2.ApiBase + "/" + Interval + "/" + v2.Name + "/{" + v2.Name + "}/" + Next
+	ApiIntervalActionHistoryByNameRoute = v2.ApiBase + "/" + IntervalAction + "/" + v2.Name + "/{" + v2.Name + "}/" + History
+
+	ApiDeviceProfileLintRoute       = v2.ApiDeviceProfileRoute + "/" + Lint
+	ApiDeviceProfileLintByNameRoute = v2.ApiDeviceProfileByNameRoute + "/" + Lint
+
+	ApiMetadataChangeByTimeRangeRoute = v2.ApiBase + "/" + Change + "/" + v2.Start + "/{" + v2.Start + "}/" + v2.End + "/{" + v2.End + "}"
+
+	ApiReplicationChangeRoute   = v2.ApiBase + "/" + Replication + "/" + Change
+	ApiReplicationPositionRoute = v2.ApiBase + "/" + Replication + "/" + Position
+
+	ApiNotificationBacklogRoute = v2.ApiBase + "/" + Notification + "/" + Backlog
+)
+
+// Path and query parameters
+const (
+	Near      = "near"
+	Zone      = "zone"
+	Latitude  = "latitude"
+	Longitude = "longitude"
+	Radius    = "radius"
+	Alias     = "alias"
+
+	Decommission = "decommission"
+
+	Url = "url"
+
+	Command   = "command"
+	Job       = "job"
+	Scheduled = "scheduled"
+	Commands  = "commands"
+	Describe  = "describe"
+
+	Interval       = "interval"
+	IntervalAction = "intervalaction"
+	Next           = "next"
+	Count          = "count"
+	Timezone       = "tz"
+	History        = "history"
+
+	Change = "change"
+	Lint   = "lint"
+
+	Replication = "replication"
+	Position    = "position"
+
+	Notification = "notification"
+	Backlog      = "backlog"
+)
@@ -8,29 +8,54 @@ package http
 import (
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"sync"
 
 	"github.com/edgexfoundry/edgex-go"
+	"github.com/edgexfoundry/edgex-go/internal"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/authz"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/secretref"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/telemetry"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/writable"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/interfaces"
 	"github.com/edgexfoundry/go-mod-bootstrap/di"
 
+	"github.com/edgexfoundry/go-mod-configuration/pkg/types"
+
 	"github.com/edgexfoundry/go-mod-core-contracts/clients"
 	"github.com/edgexfoundry/go-mod-core-contracts/errors"
 	contractsV2 "github.com/edgexfoundry/go-mod-core-contracts/v2"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
 )
 
+// maxConfigPatchSize is the size of the largest configuration patch accepted, in bytes.
+const maxConfigPatchSize = 64 * 1024
+
 // V2CommonController controller for V2 REST APIs
 type V2CommonController struct {
-	dic *di.Container
+	dic        *di.Container
+	serviceKey string
+	// patching serializes the patches of the writable configuration
+	patching sync.Mutex
 }
 
-// NewV2CommonController creates and initializes an V2CommonController
-func NewV2CommonController(dic *di.Container) *V2CommonController {
+// ConfigPatchResponse is the response to a patch of the writable configuration
+type ConfigPatchResponse struct {
+	common.BaseResponse `json:",inline"`
+	Changes             []writable.Change `json:"changes"`
+	// Persisted tells whether the changes were stored by the configuration provider, or only applied to the service
+	// running without one
+	Persisted bool `json:"persisted"`
+}
+
+// NewV2CommonController creates and initializes an V2CommonController for the service of serviceKey
+func NewV2CommonController(dic *di.Container, serviceKey string) *V2CommonController {
 	return &V2CommonController{
-		dic: dic,
+		dic:        dic,
+		serviceKey: serviceKey,
 	}
 }
 
@@ -51,10 +76,101 @@ func (c *V2CommonController) Version(writer http.ResponseWriter, request *http.R
 // Config handles the request to /config endpoint. Is used to request the service's configuration
 // It returns a response as specified by the V2 API swagger in openapi/v2
 func (c *V2CommonController) Config(writer http.ResponseWriter, request *http.Request) {
-	response := common.NewConfigResponse(container.ConfigurationFrom(c.dic.Get))
+	// the values resolved from secret references are served as their reference
+	config, err := secretref.ReferencesFrom(c.dic.Get).Redact(container.ConfigurationFrom(c.dic.Get))
+	if err != nil {
+		c.sendError(writer, request, errors.KindServerError, "failed to redact the secrets of the configuration", err, contractsV2.ApiConfigRoute, "")
+		return
+	}
+	response := common.NewConfigResponse(config)
 	c.sendResponse(writer, request, contractsV2.ApiVersionRoute, response, http.StatusOK)
 }
 
+// PatchConfig handles the PATCH request to /config endpoint. Is used to change the service's writable configuration at
+// runtime. The patch is refused unless the requests are authorized, by RBAC or the policy agent, as anyone could
+// otherwise change the configuration. The changes are stored by the configuration provider when the service uses one,
+// all at once so that they survive a restart, and applied to the running service. Each change is logged along with the
+// correlation id of the request.
+func (c *V2CommonController) PatchConfig(writer http.ResponseWriter, request *http.Request) {
+	lc := container.LoggingClientFrom(c.dic.Get)
+	correlationID := request.Header.Get(clients.CorrelationHeader)
+
+	if !authz.GuardFrom(c.dic.Get).Enabled() {
+		lc.Error("configuration patch refused, neither RBAC nor the policy agent is enabled", clients.CorrelationHeader, correlationID)
+		response := common.NewBaseResponse("", "the configuration can't be patched unless the requests are authorized", http.StatusForbidden)
+		c.sendResponse(writer, request, contractsV2.ApiConfigRoute, response, http.StatusForbidden)
+		return
+	}
+
+	if request.ContentLength > maxConfigPatchSize {
+		c.sendError(writer, request, errors.KindLimitExceeded, fmt.Sprintf("the configuration patch exceeds %d bytes", maxConfigPatchSize), nil, contractsV2.ApiConfigRoute, "")
+		return
+	}
+	patch, err := ioutil.ReadAll(http.MaxBytesReader(writer, request.Body, maxConfigPatchSize))
+	if err != nil {
+		c.sendError(writer, request, errors.KindContractInvalid, "failed to read the configuration patch", err, contractsV2.ApiConfigRoute, "")
+		return
+	}
+
+	c.patching.Lock()
+	defer c.patching.Unlock()
+
+	config := container.ConfigurationFrom(c.dic.Get)
+	// The patch is applied to the configuration with its secret references rather than the secrets they were resolved
+	// to, so that the changes don't disclose them and the references left unchanged are resolved again.
+	redacted, err := secretref.ReferencesFrom(c.dic.Get).Redact(config)
+	if err != nil {
+		c.sendError(writer, request, errors.KindServerError, "failed to redact the secrets of the configuration", err, contractsV2.ApiConfigRoute, "")
+		return
+	}
+	updated, changes, err := writable.Patch(redacted.(interfaces.Configuration), patch)
+	if err != nil {
+		c.sendError(writer, request, errors.KindContractInvalid, "invalid configuration patch", err, contractsV2.ApiConfigRoute, "")
+		return
+	}
+	if len(changes) > writable.MaxChanges {
+		err = fmt.Errorf("the patch changes %d settings, at most %d can be changed at once", len(changes), writable.MaxChanges)
+		c.sendError(writer, request, errors.KindContractInvalid, "invalid configuration patch", err, contractsV2.ApiConfigRoute, "")
+		return
+	}
+
+	persisted := container.RegistryFrom(c.dic.Get) != nil
+	if persisted && len(changes) > 0 {
+		registryInfo := config.GetRegistryInfo()
+		err = writable.Store(
+			types.ServiceConfig{
+				Host:     registryInfo.Host,
+				Port:     registryInfo.Port,
+				Type:     registryInfo.Type,
+				BasePath: internal.ConfigStemCore + internal.ConfigMajorVersion + c.serviceKey,
+			},
+			changes)
+		if err != nil {
+			c.sendError(writer, request, errors.KindServerError, "failed to store the configuration patch", err, contractsV2.ApiConfigRoute, "")
+			return
+		}
+	}
+
+	previousLevel := config.GetLogLevel()
+	config.UpdateWritableFromRaw(updated)
+	if level := config.GetLogLevel(); level != previousLevel {
+		if err = lc.SetLogLevel(level); err != nil {
+			lc.Error(fmt.Sprintf("failed to set the log level to %s: %v", level, err), clients.CorrelationHeader, correlationID)
+		}
+	}
+	for _, change := range changes {
+		lc.Info(fmt.Sprintf("configuration %s changed from %v to %v", change.Key, change.Old, change.New),
+			clients.CorrelationHeader, correlationID)
+	}
+
+	response := ConfigPatchResponse{
+		BaseResponse: common.NewBaseResponse("", "", http.StatusOK),
+		Changes:      changes,
+		Persisted:    persisted,
+	}
+	c.sendResponse(writer, request, contractsV2.ApiConfigRoute, response, http.StatusOK)
+}
+
 // Metrics handles the request to the /metrics endpoint, memory and cpu utilization stats
 // It returns a response as specified by the V2 API swagger in openapi/v2
 func (c *V2CommonController) Metrics(writer http.ResponseWriter, request *http.Request) {
@@ -11,6 +11,7 @@ import (
 
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
 	redisClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/redis"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
 	"github.com/edgexfoundry/go-mod-core-contracts/errors"
@@ -438,6 +439,104 @@ func (c *Client) AllDevices(offset int, limit int, labels []string) ([]model.Dev
 	return devices, nil
 }
 
+// DevicesNear query the devices located within the radius (in meters) of the specified coordinates
+func (c *Client) DevicesNear(offset int, limit int, latitude float64, longitude float64, radius float64) ([]model.Device, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	devices, edgeXerr := devicesNear(conn, offset, limit, latitude, longitude, radius)
+	if edgeXerr != nil {
+		return devices, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query devices near latitude %v, longitude %v within radius %v", latitude, longitude, radius), edgeXerr)
+	}
+	return devices, nil
+}
+
+// DevicesByZone query the devices with offset, limit, and the zone of their location
+func (c *Client) DevicesByZone(offset int, limit int, zone string) ([]model.Device, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	devices, edgeXerr := devicesByZone(conn, offset, limit, zone)
+	if edgeXerr != nil {
+		return devices, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query devices by offset %d, limit %d and zone %s", offset, limit, zone), edgeXerr)
+	}
+	return devices, nil
+}
+
+// SetDeviceAliases replaces the aliases of the device
+func (c *Client) SetDeviceAliases(name string, aliases []string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := setDeviceAliases(conn, name, aliases)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to set the aliases of device %s", name), edgeXerr)
+	}
+	return nil
+}
+
+// DeviceAliases query the aliases of the device
+func (c *Client) DeviceAliases(name string) ([]string, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	aliases, edgeXerr := deviceAliases(conn, name)
+	if edgeXerr != nil {
+		return aliases, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query the aliases of device %s", name), edgeXerr)
+	}
+	return aliases, nil
+}
+
+// DeviceByAlias query the device by one of its aliases
+func (c *Client) DeviceByAlias(alias string) (device model.Device, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	device, edgeXerr = deviceByAlias(conn, alias)
+	if edgeXerr != nil {
+		return device, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query device by alias %s", alias), edgeXerr)
+	}
+	return device, nil
+}
+
+// DeleteDeviceAliases deletes all the aliases of the device
+func (c *Client) DeleteDeviceAliases(name string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := deleteDeviceAliases(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the aliases of device %s", name), edgeXerr)
+	}
+	return nil
+}
+
+// AddDecommissionCertificate stores the certificate of a device decommissioning, replacing the previous one
+func (c *Client) AddDecommissionCertificate(certificate pkgModels.DecommissionCertificate) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := addDecommissionCertificate(conn, certificate)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to store the decommission certificate of device %s", certificate.DeviceName), edgeXerr)
+	}
+	return nil
+}
+
+// DecommissionCertificate query the latest decommission certificate of the device
+func (c *Client) DecommissionCertificate(name string) (certificate pkgModels.DecommissionCertificate, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	certificate, edgeXerr = decommissionCertificate(conn, name)
+	if edgeXerr != nil {
+		return certificate, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query the decommission certificate of device %s", name), edgeXerr)
+	}
+	return certificate, nil
+}
+
 // EventsByDeviceName query events by offset, limit and device name
 func (c *Client) EventsByDeviceName(offset int, limit int, name string) (events []model.Event, edgeXerr errors.EdgeX) {
 	conn := c.Pool.Get()
@@ -476,3 +575,16 @@ func (c *Client) ReadingTotalCount() (uint32, errors.EdgeX) {
 
 	return count, nil
 }
+
+// MetadataChangesByTimeRange query the metadata changes recorded within the time range, in the order they were made
+func (c *Client) MetadataChangesByTimeRange(start int, end int) (changes []pkgModels.MetadataChange, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	changes, edgeXerr = metadataChangesByTimeRange(conn, start, end)
+	if edgeXerr != nil {
+		return changes, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query metadata changes by time range %v ~ %v", start, end), edgeXerr)
+	}
+	return changes, nil
+}
@@ -19,6 +19,7 @@ const (
 	HDEL             = "HDEL"
 	SADD             = "SADD"
 	SREM             = "SREM"
+	SMEMBERS         = "SMEMBERS"
 	ZADD             = "ZADD"
 	ZREM             = "ZREM"
 	EXEC             = "EXEC"
@@ -31,6 +32,10 @@ const (
 	ZRANGEBYSCORE    = "ZRANGEBYSCORE"
 	ZREVRANGEBYSCORE = "ZREVRANGEBYSCORE"
 	LIMIT            = "LIMIT"
+	GEOADD           = "GEOADD"
+	GEORADIUS        = "GEORADIUS"
+	COUNT            = "COUNT"
+	INCR             = "INCR"
 )
 
 const (
@@ -10,6 +10,7 @@ import (
 	"fmt"
 
 	"github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2"
@@ -18,13 +19,23 @@ import (
 	"github.com/gomodule/redigo/redis"
 )
 
+// The name, label and service name indexes of the devices are sharded, see shard.go
 const (
 	DeviceCollection            = "md|dv"
 	DeviceCollectionName        = DeviceCollection + DBKeySeparator + v2.Name
 	DeviceCollectionLabel       = DeviceCollection + DBKeySeparator + v2.Label
 	DeviceCollectionServiceName = DeviceCollection + DBKeySeparator + v2.Service + DBKeySeparator + v2.Name
+	DeviceCollectionGeo         = DeviceCollection + DBKeySeparator + "geo"
+	DeviceCollectionZone        = DeviceCollection + DBKeySeparator + "zone"
 )
 
+// geoRadiusUnit is the distance unit used for the radius of the device geo queries
+const geoRadiusUnit = "m"
+
+// maxDevicesNear caps the number of devices GEORADIUS returns, nearest first, so that a large radius can't load the
+// whole geo index at once
+const maxDevicesNear = 10000
+
 // deviceStoredKey return the device's stored key which combines the collection name and object id
 func deviceStoredKey(id string) string {
 	return CreateKey(DeviceCollection, id)
@@ -32,7 +43,7 @@ func deviceStoredKey(id string) string {
 
 // deviceNameExists whether the device exists by name
 func deviceNameExists(conn redis.Conn, name string) (bool, errors.EdgeX) {
-	exists, err := objectNameExists(conn, DeviceCollectionName, name)
+	exists, err := shardedNameExists(conn, DeviceCollectionName, name)
 	if err != nil {
 		return false, errors.NewCommonEdgeX(errors.KindDatabaseError, "device existence check by name failed", err)
 	}
@@ -64,6 +75,11 @@ func addDevice(conn redis.Conn, d models.Device) (models.Device, errors.EdgeX) {
 		return d, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("device name %s already exists", d.Name), edgeXerr)
 	}
 
+	location, hasLocation, err := pkgModels.ParseDeviceLocation(d.Location)
+	if err != nil {
+		return d, errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid device location", err)
+	}
+
 	ts := common.MakeTimestamp()
 	if d.Created == 0 {
 		d.Created = ts
@@ -75,15 +91,29 @@ func addDevice(conn redis.Conn, d models.Device) (models.Device, errors.EdgeX) {
 		return d, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal device for Redis persistence", err)
 	}
 
+	change, edgeXerr := newMetadataChange(conn, pkgModels.DeviceObject, d.Id, d.Name, nil, d)
+	if edgeXerr != nil {
+		return d, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
 	storedKey := deviceStoredKey(d.Id)
 	_ = conn.Send(MULTI)
 	_ = conn.Send(SET, storedKey, dsJSONBytes)
 	_ = conn.Send(ZADD, DeviceCollection, 0, storedKey)
-	_ = conn.Send(HSET, DeviceCollectionName, d.Name, storedKey)
-	_ = conn.Send(ZADD, CreateKey(DeviceCollectionServiceName, d.ServiceName), d.Modified, storedKey)
+	sendShardedNameAdd(conn, DeviceCollectionName, d.Name, storedKey)
+	sendShardedMemberAdd(conn, CreateKey(DeviceCollectionServiceName, d.ServiceName), d.Modified, storedKey)
 	for _, label := range d.Labels {
-		_ = conn.Send(ZADD, CreateKey(DeviceCollectionLabel, label), d.Modified, storedKey)
+		sendShardedMemberAdd(conn, CreateKey(DeviceCollectionLabel, label), d.Modified, storedKey)
+	}
+	if hasLocation {
+		if location.HasCoordinates() {
+			_ = conn.Send(GEOADD, DeviceCollectionGeo, *location.Longitude, *location.Latitude, storedKey)
+		}
+		if location.Zone != "" {
+			_ = conn.Send(ZADD, CreateKey(DeviceCollectionZone, location.Zone), d.Modified, storedKey)
+		}
 	}
+	sendMetadataChange(conn, change)
 	_, err = conn.Do(EXEC)
 	if err != nil {
 		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "device creation failed", err)
@@ -103,7 +133,7 @@ func deviceById(conn redis.Conn, id string) (device models.Device, edgeXerr erro
 
 // deviceByName query device by name from DB
 func deviceByName(conn redis.Conn, name string) (device models.Device, edgeXerr errors.EdgeX) {
-	edgeXerr = getObjectByHash(conn, DeviceCollectionName, name, &device)
+	edgeXerr = getObjectByShardedHash(conn, DeviceCollectionName, name, &device)
 	if edgeXerr != nil {
 		return device, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
@@ -138,15 +168,26 @@ func deleteDeviceByName(conn redis.Conn, name string) errors.EdgeX {
 
 // deleteDevice deletes a device
 func deleteDevice(conn redis.Conn, device models.Device) errors.EdgeX {
+	change, edgeXerr := newMetadataChange(conn, pkgModels.DeviceObject, device.Id, device.Name, device, nil)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
 	storedKey := deviceStoredKey(device.Id)
 	_ = conn.Send(MULTI)
 	_ = conn.Send(DEL, storedKey)
 	_ = conn.Send(ZREM, DeviceCollection, storedKey)
-	_ = conn.Send(HDEL, DeviceCollectionName, device.Name)
-	_ = conn.Send(ZREM, CreateKey(DeviceCollectionServiceName, device.ServiceName), storedKey)
+	sendShardedNameRemove(conn, DeviceCollectionName, device.Name)
+	sendShardedMemberRemove(conn, CreateKey(DeviceCollectionServiceName, device.ServiceName), storedKey)
 	for _, label := range device.Labels {
-		_ = conn.Send(ZREM, CreateKey(DeviceCollectionLabel, label), storedKey)
+		sendShardedMemberRemove(conn, CreateKey(DeviceCollectionLabel, label), storedKey)
 	}
+	// the GEO index is a sorted set, so the member is removed with ZREM as well
+	_ = conn.Send(ZREM, DeviceCollectionGeo, storedKey)
+	if location, ok, _ := pkgModels.ParseDeviceLocation(device.Location); ok && location.Zone != "" {
+		_ = conn.Send(ZREM, CreateKey(DeviceCollectionZone, location.Zone), storedKey)
+	}
+	sendMetadataChange(conn, change)
 	_, err := conn.Do(EXEC)
 	if err != nil {
 		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device deletion failed", err)
@@ -160,7 +201,7 @@ func devicesByServiceName(conn redis.Conn, offset int, limit int, name string) (
 	if limit == -1 { //-1 limit means that clients want to retrieve all remaining records after offset from DB, so specifying -1 for end
 		end = limit
 	}
-	objects, err := getObjectsByRevRange(conn, CreateKey(DeviceCollectionServiceName, name), offset, end)
+	objects, err := getObjectsByShardedRevRange(conn, CreateKey(DeviceCollectionServiceName, name), offset, end)
 	if err != nil {
 		return devices, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -183,7 +224,7 @@ func devicesByLabels(conn redis.Conn, offset int, limit int, labels []string) (d
 	if limit == -1 { //-1 limit means that clients want to retrieve all remaining records after offset from DB, so specifying -1 for end
 		end = limit
 	}
-	objects, edgeXerr := getObjectsByLabelsAndSomeRange(conn, ZREVRANGE, DeviceCollection, labels, offset, end)
+	objects, edgeXerr := getObjectsByShardedLabelsAndRevRange(conn, DeviceCollection, labels, offset, end)
 	if edgeXerr != nil {
 		return devices, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
@@ -199,3 +240,55 @@ func devicesByLabels(conn redis.Conn, offset int, limit int, labels []string) (d
 	}
 	return devices, nil
 }
+
+// devicesNear query devices located within the radius (in meters) of the specified coordinates, nearest first, among
+// the maxDevicesNear nearest ones
+func devicesNear(conn redis.Conn, offset int, limit int, latitude float64, longitude float64, radius float64) (devices []models.Device, edgeXerr errors.EdgeX) {
+	count := offset + limit
+	if limit == -1 || count > maxDevicesNear {
+		count = maxDevicesNear
+	}
+	storedKeys, err := redis.Strings(conn.Do(GEORADIUS, DeviceCollectionGeo, longitude, latitude, radius, geoRadiusUnit, COUNT, count, "ASC"))
+	if err != nil {
+		return devices, errors.NewCommonEdgeX(errors.KindDatabaseError, "query device ids by geo radius from database failed", err)
+	}
+	if offset > len(storedKeys) {
+		return devices, errors.NewCommonEdgeX(errors.KindRangeNotSatisfiable, fmt.Sprintf("query objects bounds out of range. length:%v", len(storedKeys)), nil)
+	}
+	end := offset + limit
+	if limit == -1 || end > len(storedKeys) {
+		end = len(storedKeys)
+	}
+
+	objects, edgeXerr := getObjectsByIds(conn, common.ConvertStringsToInterfaces(storedKeys[offset:end]))
+	if edgeXerr != nil {
+		return devices, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return convertObjectsToDevices(objects)
+}
+
+// devicesByZone query devices by offset, limit and the zone of their location
+func devicesByZone(conn redis.Conn, offset int, limit int, zone string) (devices []models.Device, edgeXerr errors.EdgeX) {
+	end := offset + limit - 1
+	if limit == -1 { //-1 limit means that clients want to retrieve all remaining records after offset from DB, so specifying -1 for end
+		end = limit
+	}
+	objects, edgeXerr := getObjectsByRevRange(conn, CreateKey(DeviceCollectionZone, zone), offset, end)
+	if edgeXerr != nil {
+		return devices, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return convertObjectsToDevices(objects)
+}
+
+func convertObjectsToDevices(objects [][]byte) (devices []models.Device, edgeXerr errors.EdgeX) {
+	devices = make([]models.Device, len(objects))
+	for i, in := range objects {
+		d := models.Device{}
+		err := json.Unmarshal(in, &d)
+		if err != nil {
+			return []models.Device{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "device format parsing failed from the database", err)
+		}
+		devices[i] = d
+	}
+	return devices, nil
+}
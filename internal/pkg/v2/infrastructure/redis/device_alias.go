@@ -0,0 +1,116 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// The aliases of the devices are kept apart from the devices, keyed by device name, so that they survive the device
+// being replaced on update: DeviceCollectionAlias maps every alias to the name of its device and the aliases of each
+// device are listed in a set.
+const DeviceCollectionAlias = DeviceCollection + DBKeySeparator + "alias"
+
+// deviceAliasesKey return the key of the set listing the aliases of the device
+func deviceAliasesKey(name string) string {
+	return CreateKey(DeviceCollectionAlias, v2.Name, name)
+}
+
+// setDeviceAliases replaces the aliases of the device, failing if any of them is an alias of another device
+func setDeviceAliases(conn redis.Conn, name string, aliases []string) errors.EdgeX {
+	exists, edgeXerr := deviceNameExists(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if !exists {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("device %s does not exist", name), nil)
+	}
+
+	for _, alias := range aliases {
+		owner, err := redis.String(conn.Do(HGET, DeviceCollectionAlias, alias))
+		if err == redis.ErrNil {
+			continue
+		} else if err != nil {
+			return errors.NewCommonEdgeX(errors.KindDatabaseError, "device alias existence check failed", err)
+		}
+		if owner != name {
+			return errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("device alias %s is already used by device %s", alias, owner), nil)
+		}
+	}
+
+	previous, err := redis.Strings(conn.Do(SMEMBERS, deviceAliasesKey(name)))
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "query device aliases from the database failed", err)
+	}
+
+	_ = conn.Send(MULTI)
+	for _, alias := range previous {
+		_ = conn.Send(HDEL, DeviceCollectionAlias, alias)
+	}
+	_ = conn.Send(DEL, deviceAliasesKey(name))
+	for _, alias := range aliases {
+		_ = conn.Send(HSET, DeviceCollectionAlias, alias, name)
+		_ = conn.Send(SADD, deviceAliasesKey(name), alias)
+	}
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device aliases update failed", err)
+	}
+	return nil
+}
+
+// deviceAliases query the aliases of the device, sorted
+func deviceAliases(conn redis.Conn, name string) (aliases []string, edgeXerr errors.EdgeX) {
+	exists, edgeXerr := deviceNameExists(conn, name)
+	if edgeXerr != nil {
+		return aliases, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if !exists {
+		return aliases, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("device %s does not exist", name), nil)
+	}
+
+	aliases, err := redis.Strings(conn.Do(SMEMBERS, deviceAliasesKey(name)))
+	if err != nil {
+		return aliases, errors.NewCommonEdgeX(errors.KindDatabaseError, "query device aliases from the database failed", err)
+	}
+	sort.Strings(aliases)
+	return aliases, nil
+}
+
+// deviceByAlias query the device by one of its aliases
+func deviceByAlias(conn redis.Conn, alias string) (device models.Device, edgeXerr errors.EdgeX) {
+	name, err := redis.String(conn.Do(HGET, DeviceCollectionAlias, alias))
+	if err == redis.ErrNil {
+		return device, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("device alias %s does not exist", alias), err)
+	} else if err != nil {
+		return device, errors.NewCommonEdgeX(errors.KindDatabaseError, "query device alias from the database failed", err)
+	}
+	return deviceByName(conn, name)
+}
+
+// deleteDeviceAliases deletes all the aliases of the device
+func deleteDeviceAliases(conn redis.Conn, name string) errors.EdgeX {
+	aliases, err := redis.Strings(conn.Do(SMEMBERS, deviceAliasesKey(name)))
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "query device aliases from the database failed", err)
+	}
+
+	_ = conn.Send(MULTI)
+	for _, alias := range aliases {
+		_ = conn.Send(HDEL, DeviceCollectionAlias, alias)
+	}
+	_ = conn.Send(DEL, deviceAliasesKey(name))
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device aliases deletion failed", err)
+	}
+	return nil
+}
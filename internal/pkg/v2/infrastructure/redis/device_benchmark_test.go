@@ -0,0 +1,141 @@
+// +build redisIntegration
+
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// These benchmarks are only executed with the redisIntegration tag, against the Redis server at REDIS_SERVER_TEST
+// (redis://localhost:6379 by default) whose database is populated with REDIS_BENCHMARK_DEVICES devices (100000 by
+// default), e.g.
+// go test -tags redisIntegration -run xxx -bench Device ./internal/pkg/v2/infrastructure/redis
+
+package redis
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	redisURLEnvName         = "REDIS_SERVER_TEST"
+	defaultRedisURL         = "redis://localhost:6379"
+	benchmarkDevicesEnvName = "REDIS_BENCHMARK_DEVICES"
+	defaultBenchmarkDevices = 100000
+
+	benchmarkServiceCount = 10
+	benchmarkLabelCount   = 100
+)
+
+func benchmarkDeviceName(i int) string {
+	return fmt.Sprintf("benchmark-device-%d", i)
+}
+
+// newBenchmarkClient connects to the Redis server and populates it with the benchmark devices, unless they exist.
+func newBenchmarkClient(b *testing.B) (*Client, int) {
+	redisURL, err := url.Parse(os.Getenv(redisURLEnvName))
+	if err != nil || redisURL.Host == "" {
+		redisURL, _ = url.Parse(defaultRedisURL)
+	}
+	port, err := strconv.Atoi(redisURL.Port())
+	require.NoError(b, err)
+
+	count := defaultBenchmarkDevices
+	if value := os.Getenv(benchmarkDevicesEnvName); value != "" {
+		count, err = strconv.Atoi(value)
+		require.NoError(b, err)
+	}
+
+	client, edgeXerr := NewClient(db.Configuration{Host: redisURL.Hostname(), Port: port}, logger.NewMockClient())
+	require.NoError(b, edgeXerr)
+
+	exists, edgeXerr := client.DeviceNameExists(benchmarkDeviceName(count - 1))
+	require.NoError(b, edgeXerr)
+	if !exists {
+		for i := 0; i < count; i++ {
+			name := benchmarkDeviceName(i)
+			if exists, _ := client.DeviceNameExists(name); exists {
+				continue
+			}
+			_, edgeXerr = client.AddDevice(models.Device{
+				Name:        name,
+				ServiceName: fmt.Sprintf("benchmark-service-%d", i%benchmarkServiceCount),
+				ProfileName: "benchmark-profile",
+				Labels:      []string{"benchmark", fmt.Sprintf("benchmark-label-%d", i%benchmarkLabelCount)},
+			})
+			require.NoError(b, edgeXerr)
+		}
+	}
+	return client, count
+}
+
+func BenchmarkDeviceByName(b *testing.B) {
+	client, count := newBenchmarkClient(b)
+	defer client.CloseSession()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := client.DeviceByName(benchmarkDeviceName(i % count)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDeviceNameExists(b *testing.B) {
+	client, count := newBenchmarkClient(b)
+	defer client.CloseSession()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := client.DeviceNameExists(benchmarkDeviceName(i % count)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDevicesByServiceName(b *testing.B) {
+	client, _ := newBenchmarkClient(b)
+	defer client.CloseSession()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := client.DevicesByServiceName(0, 20, fmt.Sprintf("benchmark-service-%d", i%benchmarkServiceCount)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkAllDevicesByLabel(b *testing.B) {
+	client, _ := newBenchmarkClient(b)
+	defer client.CloseSession()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := client.AllDevices(0, 20, []string{fmt.Sprintf("benchmark-label-%d", i%benchmarkLabelCount)}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkAllDevicesByLabels(b *testing.B) {
+	client, _ := newBenchmarkClient(b)
+	defer client.CloseSession()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		labels := []string{"benchmark", fmt.Sprintf("benchmark-label-%d", i%benchmarkLabelCount)}
+		if _, err := client.AllDevices(0, 20, labels); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// DeviceCollectionDecommission maps the name of every decommissioned device to its latest decommission certificate.
+// The certificates are kept apart from the devices so that they survive the device being deleted.
+const DeviceCollectionDecommission = DeviceCollection + DBKeySeparator + "decommission"
+
+// addDecommissionCertificate stores the certificate, replacing the previous certificate of the device
+func addDecommissionCertificate(conn redis.Conn, certificate pkgModels.DecommissionCertificate) errors.EdgeX {
+	m, err := json.Marshal(certificate)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal decommission certificate for Redis persistence", err)
+	}
+	_, err = conn.Do(HSET, DeviceCollectionDecommission, certificate.DeviceName, m)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "decommission certificate creation failed", err)
+	}
+	return nil
+}
+
+// decommissionCertificate query the latest decommission certificate of the device
+func decommissionCertificate(conn redis.Conn, name string) (certificate pkgModels.DecommissionCertificate, edgeXerr errors.EdgeX) {
+	m, err := redis.Bytes(conn.Do(HGET, DeviceCollectionDecommission, name))
+	if err == redis.ErrNil {
+		return certificate, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("device %s was never decommissioned", name), err)
+	} else if err != nil {
+		return certificate, errors.NewCommonEdgeX(errors.KindDatabaseError, "query decommission certificate from the database failed", err)
+	}
+	if err = json.Unmarshal(m, &certificate); err != nil {
+		return certificate, errors.NewCommonEdgeX(errors.KindContractInvalid, "decommission certificate parsing failed", err)
+	}
+	return certificate, nil
+}
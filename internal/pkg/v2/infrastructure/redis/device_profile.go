@@ -10,6 +10,7 @@ import (
 	"fmt"
 
 	"github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2"
@@ -79,6 +80,11 @@ func addDeviceProfile(conn redis.Conn, dp models.DeviceProfile) (addedDeviceProf
 		return addedDeviceProfile, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal device profile for Redis persistence", err)
 	}
 
+	change, edgeXerr := newMetadataChange(conn, pkgModels.DeviceProfileObject, dp.Id, dp.Name, nil, dp)
+	if edgeXerr != nil {
+		return addedDeviceProfile, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
 	storedKey := deviceProfileStoredKey(dp.Id)
 	_ = conn.Send(MULTI)
 	_ = conn.Send(SET, storedKey, m)
@@ -89,6 +95,7 @@ func addDeviceProfile(conn redis.Conn, dp models.DeviceProfile) (addedDeviceProf
 	for _, label := range dp.Labels {
 		_ = conn.Send(ZADD, CreateKey(DeviceProfileCollectionLabel, label), dp.Modified, storedKey)
 	}
+	sendMetadataChange(conn, change)
 
 	_, err = conn.Do(EXEC)
 	if err != nil {
@@ -117,6 +124,11 @@ func deviceProfileByName(conn redis.Conn, name string) (deviceProfile models.Dev
 }
 
 func deleteDeviceProfile(conn redis.Conn, dp models.DeviceProfile) errors.EdgeX {
+	change, edgeXerr := newMetadataChange(conn, pkgModels.DeviceProfileObject, dp.Id, dp.Name, dp, nil)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
 	storedKey := deviceProfileStoredKey(dp.Id)
 	_ = conn.Send(MULTI)
 	_ = conn.Send(DEL, storedKey)
@@ -127,6 +139,7 @@ func deleteDeviceProfile(conn redis.Conn, dp models.DeviceProfile) errors.EdgeX
 	for _, label := range dp.Labels {
 		_ = conn.Send(ZREM, CreateKey(DeviceProfileCollectionLabel, label), storedKey)
 	}
+	sendMetadataChange(conn, change)
 
 	_, err := conn.Do(EXEC)
 	if err != nil {
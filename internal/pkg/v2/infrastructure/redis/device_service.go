@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2"
@@ -72,6 +73,11 @@ func addDeviceService(conn redis.Conn, ds models.DeviceService) (addedDeviceServ
 	// redisKey represents the key stored in the redis, use the format of #{DeviceServiceCollection}:#{ds.Id}
 	// as the redisKey to avoid data being accidentally deleted when other objects, e.g. device profiles, also
 	// coincidentally have the same Id.
+	change, edgeXerr := newMetadataChange(conn, pkgModels.DeviceServiceObject, ds.Id, ds.Name, nil, ds)
+	if edgeXerr != nil {
+		return addedDeviceService, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
 	redisKey := deviceServiceStoredKey(ds.Id)
 	_ = conn.Send(MULTI)
 	// Set the redisKey to associate with object byte array for later retrieval
@@ -83,6 +89,7 @@ func addDeviceService(conn redis.Conn, ds models.DeviceService) (addedDeviceServ
 	for _, label := range ds.Labels { // Store the redisKey into Sorted Set of labels with Modified as the score for order
 		_ = conn.Send(ZADD, CreateKey(DeviceServiceCollectionLabel, label), ds.Modified, redisKey)
 	}
+	sendMetadataChange(conn, change)
 	_, err = conn.Do(EXEC)
 	if err != nil {
 		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "device service creation failed", err)
@@ -110,6 +117,11 @@ func deviceServiceByName(conn redis.Conn, name string) (deviceService models.Dev
 }
 
 func deleteDeviceService(conn redis.Conn, deviceService models.DeviceService) errors.EdgeX {
+	change, edgeXerr := newMetadataChange(conn, pkgModels.DeviceServiceObject, deviceService.Id, deviceService.Name, deviceService, nil)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
 	storedKey := deviceServiceStoredKey(deviceService.Id)
 	_ = conn.Send(MULTI)
 	_ = conn.Send(DEL, storedKey)
@@ -118,6 +130,7 @@ func deleteDeviceService(conn redis.Conn, deviceService models.DeviceService) er
 	for _, label := range deviceService.Labels {
 		_ = conn.Send(ZREM, CreateKey(DeviceServiceCollectionLabel, label), storedKey)
 	}
+	sendMetadataChange(conn, change)
 
 	_, err := conn.Do(EXEC)
 	if err != nil {
@@ -0,0 +1,79 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const (
+	MetadataChangeCollection         = "md|chg"
+	MetadataChangeCollectionSequence = MetadataChangeCollection + DBKeySeparator + "sequence"
+)
+
+// metadataChange is a metadata change ready to be stored within the transaction of the write it records
+type metadataChange struct {
+	storedKey string
+	timestamp int64
+	bytes     []byte
+}
+
+// newMetadataChange prepares the record of a write of a metadata object; it has to be prepared before the transaction
+// of the write starts, since the sequence is allocated from the DB.
+func newMetadataChange(conn redis.Conn, objectType string, id string, name string, before interface{}, after interface{}) (mc metadataChange, edgeXerr errors.EdgeX) {
+	sequence, err := redis.Int64(conn.Do(INCR, MetadataChangeCollectionSequence))
+	if err != nil {
+		return mc, errors.NewCommonEdgeX(errors.KindDatabaseError, "metadata change sequence allocation failed", err)
+	}
+
+	change, err := pkgModels.NewMetadataChange(objectType, id, name, common.MakeTimestamp(), before, after)
+	if err != nil {
+		return mc, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal metadata object for change record", err)
+	}
+	change.Sequence = sequence
+	mc.bytes, err = json.Marshal(change)
+	if err != nil {
+		return mc, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal metadata change for Redis persistence", err)
+	}
+	mc.storedKey = CreateKey(MetadataChangeCollection, strconv.FormatInt(sequence, 10))
+	mc.timestamp = change.Timestamp
+	return mc, nil
+}
+
+// sendMetadataChange queues the commands storing the change; it must be called within a MULTI transaction
+func sendMetadataChange(conn redis.Conn, mc metadataChange) {
+	_ = conn.Send(SET, mc.storedKey, mc.bytes)
+	_ = conn.Send(ZADD, MetadataChangeCollection, mc.timestamp, mc.storedKey)
+}
+
+// metadataChangesByTimeRange query the metadata changes recorded within the time range, in the order they were made
+func metadataChangesByTimeRange(conn redis.Conn, start int, end int) (changes []pkgModels.MetadataChange, edgeXerr errors.EdgeX) {
+	changeIds, err := redis.Values(conn.Do(ZRANGEBYSCORE, MetadataChangeCollection, start, end))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "query metadata change ids from database failed", err)
+	}
+	objects, edgeXerr := getObjectsByIds(conn, changeIds)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	changes = make([]pkgModels.MetadataChange, len(objects))
+	for i, in := range objects {
+		err := json.Unmarshal(in, &changes[i])
+		if err != nil {
+			return []pkgModels.MetadataChange{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "metadata change format parsing failed from the database", err)
+		}
+	}
+	return changes, nil
+}
@@ -0,0 +1,195 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// migrationBatchSize is the number of objects read at once by the migration dry run
+const migrationBatchSize = 100
+
+// migrationSource describes a v1 metadata collection, whose objects are stored under their id and listed in the sorted
+// set named after the collection, and the v2 collection its objects are migrated to.
+type migrationSource struct {
+	collection string
+	target     string
+	nameExists func(conn redis.Conn, name string) (bool, errors.EdgeX)
+}
+
+// metadataMigrationSources lists the v1 metadata collections in the order of their migration, the referenced objects
+// before the objects referencing them.
+var metadataMigrationSources = []migrationSource{
+	{collection: db.DeviceService, target: DeviceServiceCollection, nameExists: deviceServiceNameExist},
+	{collection: db.DeviceProfile, target: DeviceProfileCollection, nameExists: deviceProfileNameExists},
+	{collection: db.Device, target: DeviceCollection, nameExists: deviceNameExists},
+}
+
+// v1MetadataObject holds the fields of the v1 metadata objects checked by the migration dry run.
+type v1MetadataObject struct {
+	Id          string
+	Name        string
+	Addressable string
+	Service     string
+	Profile     string
+}
+
+// MetadataMigrationDryRun scans the v1 metadata for their migration to the v2 keys, without modifying anything, and
+// reports the objects to migrate along with those which can't be migrated as is.
+func (c *Client) MetadataMigrationDryRun() (pkgModels.MigrationReport, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	return metadataMigrationDryRun(conn)
+}
+
+func metadataMigrationDryRun(conn redis.Conn) (pkgModels.MigrationReport, errors.EdgeX) {
+	started := time.Now()
+	report := pkgModels.MigrationReport{Source: "v1 metadata", Target: "v2 metadata", Started: common.MakeTimestamp()}
+
+	// the ids of the objects scanned, by collection, to check the references of the objects scanned after them
+	scanned := make(map[string]map[string]bool, len(metadataMigrationSources))
+	for _, source := range metadataMigrationSources {
+		collection, ids, edgeXerr := scanMigrationSource(conn, source, scanned, &report)
+		if edgeXerr != nil {
+			return report, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		report.Collections = append(report.Collections, collection)
+		scanned[source.collection] = ids
+	}
+
+	report.Complete(time.Since(started))
+	return report, nil
+}
+
+// scanMigrationSource scans the objects of the source collection, records those which can't be migrated as is in the
+// report and returns the ids of the objects found.
+func scanMigrationSource(
+	conn redis.Conn,
+	source migrationSource,
+	scanned map[string]map[string]bool,
+	report *pkgModels.MigrationReport) (pkgModels.MigrationCollection, map[string]bool, errors.EdgeX) {
+
+	collection := pkgModels.MigrationCollection{Name: source.collection, Source: source.collection, Target: source.target}
+	members, err := redis.Strings(conn.Do(ZRANGE, source.collection, 0, -1))
+	if err != nil {
+		return collection, nil, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("listing the %s objects failed", source.collection), err)
+	}
+
+	ids := make(map[string]bool, len(members))
+	names := make(map[string]string, len(members))
+	for start := 0; start < len(members); start += migrationBatchSize {
+		end := start + migrationBatchSize
+		if end > len(members) {
+			end = len(members)
+		}
+		batch := members[start:end]
+
+		args := make([]interface{}, len(batch))
+		for i, id := range batch {
+			args[i] = id
+		}
+		objects, err := redis.ByteSlices(conn.Do(MGET, args...))
+		if err != nil {
+			return collection, nil, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("reading the %s objects failed", source.collection), err)
+		}
+
+		for i, object := range objects {
+			id := batch[i]
+			if object == nil {
+				report.AddIncompatibility(source.collection, id, "", "listed in the collection but not stored")
+				continue
+			}
+			var o v1MetadataObject
+			if err := json.Unmarshal(object, &o); err != nil {
+				report.AddIncompatibility(source.collection, id, "", "not decodable: "+err.Error())
+				continue
+			}
+
+			collection.Objects++
+			collection.Bytes += int64(len(object))
+			ids[id] = true
+
+			reasons, edgeXerr := checkMigrationObject(conn, source, id, o, names, scanned)
+			if edgeXerr != nil {
+				return collection, nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+			}
+			for _, reason := range reasons {
+				report.AddIncompatibility(source.collection, id, o.Name, reason)
+			}
+		}
+	}
+
+	return collection, ids, nil
+}
+
+// checkMigrationObject returns the reasons why the object, stored under id, can't be migrated as is.
+func checkMigrationObject(
+	conn redis.Conn,
+	source migrationSource,
+	id string,
+	o v1MetadataObject,
+	names map[string]string,
+	scanned map[string]map[string]bool) ([]string, errors.EdgeX) {
+
+	var reasons []string
+	if o.Name == "" {
+		reasons = append(reasons, "has no name, by which the v2 objects are identified")
+	} else if other, ok := names[o.Name]; ok {
+		reasons = append(reasons, fmt.Sprintf("has the same name as %s", other))
+	} else {
+		names[o.Name] = id
+	}
+
+	exists, edgeXerr := objectIdExists(conn, CreateKey(source.target, id))
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		reasons = append(reasons, "a v2 object with the same id already exists")
+	}
+	if o.Name != "" {
+		exists, edgeXerr = source.nameExists(conn, o.Name)
+		if edgeXerr != nil {
+			return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+		} else if exists {
+			reasons = append(reasons, "a v2 object with the same name already exists")
+		}
+	}
+
+	switch source.collection {
+	case db.DeviceService:
+		if o.Addressable == "" {
+			reasons = append(reasons, "has no addressable to derive the v2 base address from")
+			break
+		}
+		exists, edgeXerr = objectIdExists(conn, o.Addressable)
+		if edgeXerr != nil {
+			return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+		} else if !exists {
+			reasons = append(reasons, fmt.Sprintf("references the missing addressable %s", o.Addressable))
+		}
+	case db.Device:
+		// the v2 devices reference their service and profile by name, which has to be resolved from the id
+		if !scanned[db.DeviceService][o.Service] {
+			reasons = append(reasons, fmt.Sprintf("references the missing device service %s", o.Service))
+		}
+		if !scanned[db.DeviceProfile][o.Profile] {
+			reasons = append(reasons, fmt.Sprintf("references the missing device profile %s", o.Profile))
+		}
+	}
+
+	return reasons, nil
+}
@@ -0,0 +1,207 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/common"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// With a large number of objects, a single name hash or label sorted set becomes a hot key, so these indexes are split
+// into shards selected by the last hexadecimal digit of the hash of the indexed value. Lookups by name only touch the
+// shard of the name, whereas range queries fan out to all the shards and merge the results.
+//
+// Indexes written before sharding was introduced are kept under the unsharded key, which is still read from and
+// cleaned up, so that existing data remains reachable.
+const indexShardCount = 16
+
+// WITHSCORES is the option of the Redis range commands returning the scores along with the members
+const WITHSCORES = "WITHSCORES"
+
+// shardOf returns the shard of the value, i.e. the last hexadecimal digit of its FNV-1a hash, whose low bits are
+// evenly spread even for names differing only by a suffix.
+func shardOf(value string) string {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(value))
+	return strconv.FormatUint(uint64(h.Sum32()%indexShardCount), 16)
+}
+
+// shardedKey returns the key of the shard of the index holding the value.
+func shardedKey(index string, value string) string {
+	return CreateKey(index, shardOf(value))
+}
+
+// indexShardKeys returns the keys of all the shards of the index, followed by the unsharded key.
+func indexShardKeys(index string) []string {
+	keys := make([]string, 0, indexShardCount+1)
+	for i := 0; i < indexShardCount; i++ {
+		keys = append(keys, CreateKey(index, strconv.FormatUint(uint64(i), 16)))
+	}
+	return append(keys, index)
+}
+
+// sendShardedNameAdd queues the command storing the name in the sharded name index of the hash.
+func sendShardedNameAdd(conn redis.Conn, hash string, name string, storedKey string) {
+	_ = conn.Send(HSET, shardedKey(hash, name), name, storedKey)
+}
+
+// sendShardedNameRemove queues the commands removing the name from the sharded name index of the hash.
+func sendShardedNameRemove(conn redis.Conn, hash string, name string) {
+	_ = conn.Send(HDEL, shardedKey(hash, name), name)
+	_ = conn.Send(HDEL, hash, name)
+}
+
+// sendShardedMemberAdd queues the command adding the member to its shard of the sorted set.
+func sendShardedMemberAdd(conn redis.Conn, key string, score interface{}, member string) {
+	_ = conn.Send(ZADD, shardedKey(key, member), score, member)
+}
+
+// sendShardedMemberRemove queues the commands removing the member from its shard of the sorted set.
+func sendShardedMemberRemove(conn redis.Conn, key string, member string) {
+	_ = conn.Send(ZREM, shardedKey(key, member), member)
+	_ = conn.Send(ZREM, key, member)
+}
+
+// shardedNameExists checks whether the name exists in the sharded name index of the hash.
+func shardedNameExists(conn redis.Conn, hash string, name string) (bool, errors.EdgeX) {
+	exists, edgeXerr := objectNameExists(conn, shardedKey(hash, name), name)
+	if edgeXerr != nil || exists {
+		return exists, edgeXerr
+	}
+	return objectNameExists(conn, hash, name)
+}
+
+// getObjectByShardedHash retrieves the id associated with the name from the sharded name index of the hash and then
+// retrieves the object by id
+func getObjectByShardedHash(conn redis.Conn, hash string, name string, out interface{}) errors.EdgeX {
+	id, err := redis.String(conn.Do(HGET, shardedKey(hash, name), name))
+	if err == redis.ErrNil {
+		id, err = redis.String(conn.Do(HGET, hash, name))
+	}
+	if err == redis.ErrNil {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("fail to query object %T, because %s: %s doesn't exist in the database", out, name, hash), err)
+	} else if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("query %s from the database failed", name), err)
+	}
+
+	return getObjectById(conn, id, out)
+}
+
+// scoredMember is a member of a sorted set along with its score
+type scoredMember struct {
+	member string
+	score  float64
+}
+
+// shardedRevRangeIds retrieves the members of the sharded sorted set in the reverse sorted set order, from the
+// highest score down to the member at the stop index, or down to the last member when stop is -1. The shards are
+// queried in a single pipeline.
+func shardedRevRangeIds(conn redis.Conn, key string, stop int) ([]string, errors.EdgeX) {
+	keys := indexShardKeys(key)
+	for _, k := range keys {
+		_ = conn.Send(ZREVRANGE, k, 0, stop, WITHSCORES)
+	}
+	if err := conn.Flush(); err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "query object ids from database failed", err)
+	}
+
+	var members []scoredMember
+	for range keys {
+		values, err := redis.Strings(conn.Receive())
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "query object ids from database failed", err)
+		}
+		for i := 0; i+1 < len(values); i += 2 {
+			score, err := strconv.ParseFloat(values[i+1], 64)
+			if err != nil {
+				return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "object score parsing failed from the database", err)
+			}
+			members = append(members, scoredMember{member: values[i], score: score})
+		}
+	}
+
+	return mergeRevRange(members, stop), nil
+}
+
+// mergeRevRange orders the members gathered from the shards like ZREVRANGE does, i.e. by descending score and then
+// by descending member, drops the members also present in the unsharded key and keeps them down to the stop index.
+func mergeRevRange(members []scoredMember, stop int) []string {
+	sort.SliceStable(members, func(i, j int) bool {
+		if members[i].score != members[j].score {
+			return members[i].score > members[j].score
+		}
+		return members[i].member > members[j].member
+	})
+
+	ids := make([]string, 0, len(members))
+	seen := make(map[string]bool, len(members))
+	for _, m := range members {
+		if seen[m.member] {
+			continue
+		}
+		seen[m.member] = true
+		ids = append(ids, m.member)
+	}
+	if stop >= 0 && stop+1 < len(ids) {
+		ids = ids[:stop+1]
+	}
+	return ids
+}
+
+// getObjectsByShardedRevRange retrieves the entries for keys enumerated in a sharded sorted set, in the reverse
+// sorted set order.
+func getObjectsByShardedRevRange(conn redis.Conn, key string, start int, end int) ([][]byte, errors.EdgeX) {
+	ids, edgeXerr := shardedRevRangeIds(conn, key, end)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if len(ids) == 0 { // return nil slice when there is no records in the DB
+		return nil, nil
+	} else if start > len(ids) {
+		return nil, errors.NewCommonEdgeX(errors.KindRangeNotSatisfiable, fmt.Sprintf("query objects bounds out of range. length:%v", len(ids)), nil)
+	}
+
+	return getObjectsByIds(conn, common.ConvertStringsToInterfaces(ids[start:]))
+}
+
+// getObjectsByShardedLabelsAndRevRange retrieves the entries carrying all the labels, whose label sorted sets are
+// sharded, in the reverse sorted set order. Without labels, the entries of the unsharded key are retrieved.
+func getObjectsByShardedLabelsAndRevRange(conn redis.Conn, key string, labels []string, start int, end int) ([][]byte, errors.EdgeX) {
+	if len(labels) == 0 {
+		return getObjectsBySomeRange(conn, ZREVRANGE, key, start, end)
+	}
+
+	idsSlice := make([][]string, len(labels))
+	for i, label := range labels {
+		ids, edgeXerr := shardedRevRangeIds(conn, CreateKey(key, v2.Label, label), -1)
+		if edgeXerr != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("query object ids by label %s from database failed", label), edgeXerr)
+		}
+		idsSlice[i] = ids
+	}
+
+	//find common Ids among two-dimension Ids slice associated with labels
+	commonIds := common.FindCommonStrings(idsSlice...)
+	if start > len(commonIds) {
+		return nil, errors.NewCommonEdgeX(errors.KindRangeNotSatisfiable, fmt.Sprintf("query objects bounds out of range. length:%v", len(commonIds)), nil)
+	}
+	if end < 0 || end >= len(commonIds) {
+		commonIds = commonIds[start:]
+	} else { // as end index in golang re-slice is exclusive, increment the end index to ensure the end could be inclusive
+		commonIds = commonIds[start : end+1]
+	}
+
+	return getObjectsByIds(conn, common.ConvertStringsToInterfaces(commonIds))
+}
@@ -0,0 +1,58 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShardOf(t *testing.T) {
+	keys := indexShardKeys(DeviceCollectionName)
+	require.Len(t, keys, indexShardCount+1)
+	assert.Equal(t, DeviceCollectionName, keys[indexShardCount], "the unsharded key should be the last one")
+
+	used := make(map[string]bool)
+	for i := 0; i < 1000; i++ {
+		name := fmt.Sprintf("Device-%d", i)
+		key := shardedKey(DeviceCollectionName, name)
+		assert.Equal(t, key, shardedKey(DeviceCollectionName, name), "the shard of a name should be stable")
+		assert.Contains(t, keys[:indexShardCount], key)
+		used[key] = true
+	}
+	assert.Len(t, used, indexShardCount, "names should be spread over all the shards")
+}
+
+func TestMergeRevRange(t *testing.T) {
+	members := []scoredMember{
+		{member: "md|dv:a", score: 100},
+		{member: "md|dv:b", score: 300},
+		{member: "md|dv:c", score: 200},
+		{member: "md|dv:d", score: 200},
+		// the same member found in the unsharded key
+		{member: "md|dv:b", score: 50},
+	}
+
+	tests := []struct {
+		name     string
+		stop     int
+		expected []string
+	}{
+		{"all", -1, []string{"md|dv:b", "md|dv:d", "md|dv:c", "md|dv:a"}},
+		{"down to stop", 1, []string{"md|dv:b", "md|dv:d"}},
+		{"stop beyond the members", 10, []string{"md|dv:b", "md|dv:d", "md|dv:c", "md|dv:a"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := make([]scoredMember, len(members))
+			copy(input, members)
+			assert.Equal(t, tt.expected, mergeRevRange(input, tt.stop))
+		})
+	}
+}
@@ -0,0 +1,37 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MaxDeviceAliasLength is the maximum length of a device alias
+const MaxDeviceAliasLength = 256
+
+// ValidateDeviceAliases checks the alternate names of a device, e.g. its asset tag or a legacy SCADA tag. The aliases
+// must be non-blank, distinct, and different from the name of the device itself; their uniqueness across the devices
+// is enforced by the database.
+func ValidateDeviceAliases(name string, aliases []string) error {
+	seen := make(map[string]bool, len(aliases))
+	for _, alias := range aliases {
+		if strings.TrimSpace(alias) == "" {
+			return fmt.Errorf("device alias is blank")
+		}
+		if len(alias) > MaxDeviceAliasLength {
+			return fmt.Errorf("device alias %s is longer than %d characters", alias, MaxDeviceAliasLength)
+		}
+		if alias == name {
+			return fmt.Errorf("device alias %s is the name of the device itself", alias)
+		}
+		if seen[alias] {
+			return fmt.Errorf("device alias %s is duplicated", alias)
+		}
+		seen[alias] = true
+	}
+	return nil
+}
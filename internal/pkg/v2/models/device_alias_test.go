@@ -0,0 +1,38 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateDeviceAliases(t *testing.T) {
+	tests := []struct {
+		name        string
+		aliases     []string
+		expectedErr bool
+	}{
+		{"no alias", nil, false},
+		{"asset tag and scada tag", []string{"ASSET-0042", "PLC1.TT101"}, false},
+		{"blank alias", []string{"ASSET-0042", " "}, true},
+		{"duplicated alias", []string{"ASSET-0042", "ASSET-0042"}, true},
+		{"device name", []string{"boiler"}, true},
+		{"too long", []string{strings.Repeat("a", MaxDeviceAliasLength+1)}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateDeviceAliases("boiler", tt.aliases)
+			if tt.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
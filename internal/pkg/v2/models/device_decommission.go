@@ -0,0 +1,106 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+)
+
+// Policies deciding what happens to the events of a decommissioned device
+const (
+	DataPolicyRetain           = "retain"
+	DataPolicyArchive          = "archive"
+	DataPolicyDelete           = "delete"
+	DataPolicyArchiveAndDelete = "archiveAndDelete"
+)
+
+// Steps of a device decommissioning, in the order they are taken
+const (
+	DecommissionStepLock                = "lock"
+	DecommissionStepRemoveAutoEvents    = "removeAutoEvents"
+	DecommissionStepArchiveData         = "archiveData"
+	DecommissionStepDeleteData          = "deleteData"
+	DecommissionStepRemoveSubscriptions = "removeSubscriptions"
+)
+
+// Statuses of a decommissioning and of its steps
+const (
+	DecommissionCompleted = "completed"
+	DecommissionSkipped   = "skipped"
+	DecommissionFailed    = "failed"
+)
+
+// ValidateDataPolicy checks the data policy of a device decommissioning, retain when empty.
+func ValidateDataPolicy(policy string) error {
+	switch policy {
+	case "", DataPolicyRetain, DataPolicyArchive, DataPolicyDelete, DataPolicyArchiveAndDelete:
+		return nil
+	}
+	return fmt.Errorf("unknown data policy %s, expected %s, %s, %s or %s",
+		policy, DataPolicyRetain, DataPolicyArchive, DataPolicyDelete, DataPolicyArchiveAndDelete)
+}
+
+// ArchivesData tells whether the events of the device are archived under the data policy.
+func ArchivesData(policy string) bool {
+	return policy == DataPolicyArchive || policy == DataPolicyArchiveAndDelete
+}
+
+// DeletesData tells whether the events of the device are deleted under the data policy.
+func DeletesData(policy string) bool {
+	return policy == DataPolicyDelete || policy == DataPolicyArchiveAndDelete
+}
+
+// DecommissionStep records the outcome of a step of a device decommissioning. Completed is in epoch milliseconds.
+type DecommissionStep struct {
+	Name      string `json:"name"`
+	Status    string `json:"status"`
+	Detail    string `json:"detail,omitempty"`
+	Completed int64  `json:"completed,omitempty"`
+}
+
+// DataArchive describes the archive of the events of a decommissioned device, written by core data.
+type DataArchive struct {
+	File   string `json:"file"`
+	Events int    `json:"events"`
+	Sha256 string `json:"sha256"`
+}
+
+// DecommissionCertificate records the decommissioning of a device: who requested it and why, what became of its data,
+// autoevents and subscriptions, and the outcome of every step. Times are epoch milliseconds. Digest is the SHA-256 of
+// the certificate without its digest, so that an altered certificate can be told apart.
+type DecommissionCertificate struct {
+	DeviceId             string             `json:"deviceId"`
+	DeviceName           string             `json:"deviceName"`
+	ProfileName          string             `json:"profileName"`
+	ServiceName          string             `json:"serviceName"`
+	DataPolicy           string             `json:"dataPolicy"`
+	Reason               string             `json:"reason,omitempty"`
+	RequestedBy          string             `json:"requestedBy,omitempty"`
+	Started              int64              `json:"started"`
+	Completed            int64              `json:"completed"`
+	Status               string             `json:"status"`
+	Steps                []DecommissionStep `json:"steps"`
+	RemovedAutoEvents    []string           `json:"removedAutoEvents,omitempty"`
+	Archive              *DataArchive       `json:"archive,omitempty"`
+	DeletedEvents        int                `json:"deletedEvents"`
+	DeletedSubscriptions []string           `json:"deletedSubscriptions,omitempty"`
+	UpdatedSubscriptions []string           `json:"updatedSubscriptions,omitempty"`
+	Digest               string             `json:"digest"`
+}
+
+// ComputeDigest returns the SHA-256 of the certificate without its digest.
+func (c DecommissionCertificate) ComputeDigest() (string, error) {
+	c.Digest = ""
+	data, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	digest := sha256.Sum256(data)
+	return hex.EncodeToString(digest[:]), nil
+}
@@ -0,0 +1,49 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateDataPolicy(t *testing.T) {
+	for _, policy := range []string{"", DataPolicyRetain, DataPolicyArchive, DataPolicyDelete, DataPolicyArchiveAndDelete} {
+		assert.NoError(t, ValidateDataPolicy(policy), policy)
+	}
+	assert.Error(t, ValidateDataPolicy("shred"))
+
+	assert.True(t, ArchivesData(DataPolicyArchiveAndDelete))
+	assert.True(t, DeletesData(DataPolicyArchiveAndDelete))
+	assert.False(t, ArchivesData(DataPolicyDelete))
+	assert.False(t, DeletesData(DataPolicyArchive))
+	assert.False(t, ArchivesData(""))
+	assert.False(t, DeletesData(""))
+}
+
+func TestDecommissionCertificateDigest(t *testing.T) {
+	certificate := DecommissionCertificate{
+		DeviceName: "thermostat",
+		DataPolicy: DataPolicyDelete,
+		Status:     DecommissionCompleted,
+		Steps:      []DecommissionStep{{Name: DecommissionStepLock, Status: DecommissionCompleted}},
+	}
+	digest, err := certificate.ComputeDigest()
+	require.NoError(t, err)
+	assert.Len(t, digest, 64)
+
+	certificate.Digest = digest
+	again, err := certificate.ComputeDigest()
+	require.NoError(t, err)
+	assert.Equal(t, digest, again, "the digest doesn't cover itself")
+
+	certificate.DeletedEvents = 1
+	altered, err := certificate.ComputeDigest()
+	require.NoError(t, err)
+	assert.NotEqual(t, digest, altered)
+}
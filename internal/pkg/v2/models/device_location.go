@@ -0,0 +1,76 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	MinLatitude  = -85.05112878 // Redis GEO commands cannot index positions closer to the poles than this
+	MaxLatitude  = 85.05112878
+	MinLongitude = -180.0
+	MaxLongitude = 180.0
+)
+
+// DeviceLocation is the structured form of the free-form Location field of a device. A device location may carry
+// coordinates (latitude/longitude and an optional altitude in meters), a logical site/zone, or both.
+type DeviceLocation struct {
+	Latitude  *float64 `json:"latitude,omitempty"`
+	Longitude *float64 `json:"longitude,omitempty"`
+	Altitude  *float64 `json:"altitude,omitempty"`
+	Site      string   `json:"site,omitempty"`
+	Zone      string   `json:"zone,omitempty"`
+}
+
+// HasCoordinates reports whether the location can be indexed geographically.
+func (l DeviceLocation) HasCoordinates() bool {
+	return l.Latitude != nil && l.Longitude != nil
+}
+
+// Validate checks that the coordinates, when present, are complete and within the range supported by the database.
+func (l DeviceLocation) Validate() error {
+	if (l.Latitude == nil) != (l.Longitude == nil) {
+		return fmt.Errorf("device location requires both latitude and longitude")
+	}
+	if !l.HasCoordinates() {
+		return nil
+	}
+	if *l.Latitude < MinLatitude || *l.Latitude > MaxLatitude {
+		return fmt.Errorf("device location latitude %v is out of range %v ~ %v", *l.Latitude, MinLatitude, MaxLatitude)
+	}
+	if *l.Longitude < MinLongitude || *l.Longitude > MaxLongitude {
+		return fmt.Errorf("device location longitude %v is out of range %v ~ %v", *l.Longitude, MinLongitude, MaxLongitude)
+	}
+	return nil
+}
+
+// ParseDeviceLocation extracts the structured location from the Location field of a device. Locations which are not
+// JSON objects (e.g. legacy free-form strings) are not an error; ok is false for them as well as for a nil location.
+func ParseDeviceLocation(location interface{}) (l DeviceLocation, ok bool, err error) {
+	if location == nil {
+		return l, false, nil
+	}
+	if _, isObject := location.(map[string]interface{}); !isObject {
+		if _, isTyped := location.(DeviceLocation); !isTyped {
+			return l, false, nil
+		}
+	}
+
+	bytes, err := json.Marshal(location)
+	if err != nil {
+		return l, false, err
+	}
+	err = json.Unmarshal(bytes, &l)
+	if err != nil {
+		return l, false, fmt.Errorf("device location format is invalid: %v", err)
+	}
+	if err = l.Validate(); err != nil {
+		return l, false, err
+	}
+	return l, l.HasCoordinates() || l.Zone != "" || l.Site != "", nil
+}
@@ -0,0 +1,54 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDeviceLocation(t *testing.T) {
+	tests := []struct {
+		name        string
+		location    interface{}
+		expectedOk  bool
+		expectedErr bool
+	}{
+		{"nil location", nil, false, false},
+		{"legacy string location", "{40lat;45long}", false, false},
+		{"coordinates", map[string]interface{}{"latitude": 25.03, "longitude": 121.56, "altitude": 10.0}, true, false},
+		{"zone only", map[string]interface{}{"site": "taipei", "zone": "building-7"}, true, false},
+		{"empty object", map[string]interface{}{}, false, false},
+		{"latitude without longitude", map[string]interface{}{"latitude": 25.03}, false, true},
+		{"latitude out of range", map[string]interface{}{"latitude": 89.0, "longitude": 121.56}, false, true},
+		{"longitude out of range", map[string]interface{}{"latitude": 25.03, "longitude": 181.0}, false, true},
+		{"invalid field type", map[string]interface{}{"latitude": "north", "longitude": 121.56}, false, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, ok, err := ParseDeviceLocation(testCase.location)
+			if testCase.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.expectedOk, ok)
+		})
+	}
+}
+
+func TestParseDeviceLocationValues(t *testing.T) {
+	l, ok, err := ParseDeviceLocation(map[string]interface{}{"latitude": 25.03, "longitude": 121.56, "zone": "z1"})
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.True(t, l.HasCoordinates())
+	assert.Equal(t, 25.03, *l.Latitude)
+	assert.Equal(t, 121.56, *l.Longitude)
+	assert.Nil(t, l.Altitude)
+	assert.Equal(t, "z1", l.Zone)
+}
@@ -0,0 +1,200 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+)
+
+// Types of the metadata objects whose changes are recorded
+const (
+	DeviceServiceObject = "DeviceService"
+	DeviceProfileObject = "DeviceProfile"
+	DeviceObject        = "Device"
+)
+
+// Actions of a consolidated metadata change
+const (
+	ChangeAdded    = "ADDED"
+	ChangeModified = "MODIFIED"
+	ChangeDeleted  = "DELETED"
+)
+
+// ignoredChangeFields are the fields maintained by the database, which change whenever an object is stored again.
+var ignoredChangeFields = map[string]bool{"created": true, "modified": true}
+
+// MetadataChange records a single write of a metadata object. Before is empty when the object was created and After
+// is empty when it was deleted. Sequence orders the changes recorded within the same millisecond.
+type MetadataChange struct {
+	Sequence   int64           `json:"sequence"`
+	Timestamp  int64           `json:"timestamp"`
+	ObjectType string          `json:"objectType"`
+	ObjectId   string          `json:"objectId"`
+	ObjectName string          `json:"objectName"`
+	Before     json.RawMessage `json:"before,omitempty"`
+	After      json.RawMessage `json:"after,omitempty"`
+}
+
+// FieldDiff is the difference of a single field between two states of an object. Nested fields are named with their
+// dot-separated path.
+type FieldDiff struct {
+	Field  string      `json:"field"`
+	Before interface{} `json:"before,omitempty"`
+	After  interface{} `json:"after,omitempty"`
+}
+
+// ObjectDiff is the consolidated difference of a metadata object between two points in time.
+type ObjectDiff struct {
+	ObjectType string      `json:"objectType"`
+	ObjectName string      `json:"objectName"`
+	Action     string      `json:"action"`
+	Changes    int         `json:"changes"`
+	Fields     []FieldDiff `json:"fields,omitempty"`
+}
+
+// NewMetadataChange creates the record of a write of a metadata object, a nil before or after marking a creation or a
+// deletion.
+func NewMetadataChange(objectType string, id string, name string, timestamp int64, before interface{}, after interface{}) (MetadataChange, error) {
+	change := MetadataChange{Timestamp: timestamp, ObjectType: objectType, ObjectId: id, ObjectName: name}
+	var err error
+	if before != nil {
+		if change.Before, err = json.Marshal(before); err != nil {
+			return change, err
+		}
+	}
+	if after != nil {
+		if change.After, err = json.Marshal(after); err != nil {
+			return change, err
+		}
+	}
+	return change, nil
+}
+
+// ConsolidateChanges folds the changes of every object into a single difference between the state preceding its first
+// change and the state following its last one. Objects which end up unchanged, e.g. created and deleted again, are
+// left out. The result is sorted by object type and name.
+func ConsolidateChanges(changes []MetadataChange) ([]ObjectDiff, error) {
+	sorted := make([]MetadataChange, len(changes))
+	copy(sorted, changes)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Sequence < sorted[j].Sequence })
+
+	type objectKey struct{ objectType, name string }
+	type history struct {
+		before  json.RawMessage
+		after   json.RawMessage
+		changes int
+	}
+	histories := make(map[objectKey]*history)
+	for _, c := range sorted {
+		k := objectKey{c.ObjectType, c.ObjectName}
+		h, ok := histories[k]
+		if !ok {
+			h = &history{before: c.Before}
+			histories[k] = h
+		}
+		h.after = c.After
+		h.changes++
+	}
+
+	diffs := make([]ObjectDiff, 0, len(histories))
+	for k, h := range histories {
+		diff := ObjectDiff{ObjectType: k.objectType, ObjectName: k.name, Changes: h.changes}
+		switch {
+		case len(h.before) == 0 && len(h.after) == 0:
+			continue
+		case len(h.before) == 0:
+			diff.Action = ChangeAdded
+		case len(h.after) == 0:
+			diff.Action = ChangeDeleted
+		default:
+			diff.Action = ChangeModified
+		}
+
+		fields, err := diffFields(h.before, h.after)
+		if err != nil {
+			return nil, err
+		}
+		if diff.Action == ChangeModified && len(fields) == 0 {
+			continue
+		}
+		diff.Fields = fields
+		diffs = append(diffs, diff)
+	}
+
+	sort.Slice(diffs, func(i, j int) bool {
+		if diffs[i].ObjectType != diffs[j].ObjectType {
+			return diffs[i].ObjectType < diffs[j].ObjectType
+		}
+		return diffs[i].ObjectName < diffs[j].ObjectName
+	})
+	return diffs, nil
+}
+
+// diffFields returns the fields which differ between two JSON states of an object, either of which may be empty.
+func diffFields(before json.RawMessage, after json.RawMessage) ([]FieldDiff, error) {
+	beforeFields, err := flattenJSON(before)
+	if err != nil {
+		return nil, err
+	}
+	afterFields, err := flattenJSON(after)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(beforeFields)+len(afterFields))
+	for name := range beforeFields {
+		names = append(names, name)
+	}
+	for name := range afterFields {
+		if _, ok := beforeFields[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var diffs []FieldDiff
+	for _, name := range names {
+		if ignoredChangeFields[name] {
+			continue
+		}
+		b, a := beforeFields[name], afterFields[name]
+		if !reflect.DeepEqual(b, a) {
+			diffs = append(diffs, FieldDiff{Field: name, Before: b, After: a})
+		}
+	}
+	return diffs, nil
+}
+
+// flattenJSON returns the leaf values of a JSON object keyed by their dot-separated path. Arrays are leaves.
+func flattenJSON(data json.RawMessage) (map[string]interface{}, error) {
+	fields := make(map[string]interface{})
+	if len(data) == 0 {
+		return fields, nil
+	}
+
+	var object map[string]interface{}
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, err
+	}
+	flatten("", object, fields)
+	return fields, nil
+}
+
+func flatten(prefix string, object map[string]interface{}, fields map[string]interface{}) {
+	for name, value := range object {
+		path := name
+		if prefix != "" {
+			path = prefix + "." + name
+		}
+		if nested, ok := value.(map[string]interface{}); ok && len(nested) > 0 {
+			flatten(path, nested, fields)
+			continue
+		}
+		fields[path] = value
+	}
+}
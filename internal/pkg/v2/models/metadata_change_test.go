@@ -0,0 +1,72 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestChange(t *testing.T, sequence int64, objectType string, name string, before interface{}, after interface{}) MetadataChange {
+	c, err := NewMetadataChange(objectType, name+"-id", name, sequence, before, after)
+	require.NoError(t, err)
+	c.Sequence = sequence
+	return c
+}
+
+func TestConsolidateChanges(t *testing.T) {
+	original := map[string]interface{}{
+		"name":       "thermostat",
+		"adminState": "UNLOCKED",
+		"protocols":  map[string]interface{}{"modbus": map[string]interface{}{"Address": "10.0.0.1", "Port": "502"}},
+		"modified":   1,
+	}
+	patched := map[string]interface{}{
+		"name":       "thermostat",
+		"adminState": "LOCKED",
+		"protocols":  map[string]interface{}{"modbus": map[string]interface{}{"Address": "10.0.0.2", "Port": "502"}},
+		"modified":   2,
+	}
+	touched := map[string]interface{}{"name": "untouched", "modified": 1}
+	touchedAgain := map[string]interface{}{"name": "untouched", "modified": 2}
+
+	changes := []MetadataChange{
+		// listed out of order on purpose, the sequence decides
+		newTestChange(t, 2, DeviceObject, "thermostat", nil, patched),
+		newTestChange(t, 1, DeviceObject, "thermostat", original, nil),
+		newTestChange(t, 3, DeviceServiceObject, "modbus", nil, map[string]interface{}{"name": "modbus"}),
+		newTestChange(t, 4, DeviceProfileObject, "temporary", nil, map[string]interface{}{"name": "temporary"}),
+		newTestChange(t, 5, DeviceProfileObject, "temporary", map[string]interface{}{"name": "temporary"}, nil),
+		newTestChange(t, 6, DeviceProfileObject, "obsolete", map[string]interface{}{"name": "obsolete"}, nil),
+		newTestChange(t, 7, DeviceObject, "untouched", touched, nil),
+		newTestChange(t, 8, DeviceObject, "untouched", nil, touchedAgain),
+	}
+
+	diffs, err := ConsolidateChanges(changes)
+	require.NoError(t, err)
+	require.Len(t, diffs, 3, "created then deleted objects and objects stored again unchanged should be left out")
+
+	assert.Equal(t, ObjectDiff{
+		ObjectType: DeviceObject,
+		ObjectName: "thermostat",
+		Action:     ChangeModified,
+		Changes:    2,
+		Fields: []FieldDiff{
+			{Field: "adminState", Before: "UNLOCKED", After: "LOCKED"},
+			{Field: "protocols.modbus.Address", Before: "10.0.0.1", After: "10.0.0.2"},
+		},
+	}, diffs[0])
+
+	assert.Equal(t, DeviceProfileObject, diffs[1].ObjectType)
+	assert.Equal(t, "obsolete", diffs[1].ObjectName)
+	assert.Equal(t, ChangeDeleted, diffs[1].Action)
+
+	assert.Equal(t, DeviceServiceObject, diffs[2].ObjectType)
+	assert.Equal(t, ChangeAdded, diffs[2].Action)
+	assert.Equal(t, []FieldDiff{{Field: "name", After: "modbus"}}, diffs[2].Fields)
+}
@@ -0,0 +1,80 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"time"
+)
+
+const (
+	// MigrationCostFactor approximates how many times longer migrating an object takes than reading it during the dry
+	// run, the migration reading the object once and then writing it along with its indexes.
+	MigrationCostFactor = 4
+	// MigrationIndexOverhead approximates the bytes taken, per object, by the entries of its indexes.
+	MigrationIndexOverhead = 256
+)
+
+// MigrationCollection describes the objects of a collection to be migrated from their source keys to the target ones.
+type MigrationCollection struct {
+	Name    string `json:"name"`
+	Source  string `json:"source"`
+	Target  string `json:"target"`
+	Objects int    `json:"objects"`
+	Bytes   int64  `json:"bytes"`
+}
+
+// MigrationIncompatibility describes an object which can't be migrated as is, and why.
+type MigrationIncompatibility struct {
+	Collection string `json:"collection"`
+	Id         string `json:"id"`
+	Name       string `json:"name,omitempty"`
+	Reason     string `json:"reason"`
+}
+
+// MigrationReport is the outcome of a migration dry run, i.e. a scan of the existing data without modifying it, for the
+// operators to schedule the maintenance window of the migration.
+type MigrationReport struct {
+	Source            string                     `json:"source"`
+	Target            string                     `json:"target"`
+	Started           int64                      `json:"started"`
+	ScanDuration      string                     `json:"scanDuration"`
+	Objects           int                        `json:"objects"`
+	Bytes             int64                      `json:"bytes"`
+	EstimatedDuration string                     `json:"estimatedDuration"`
+	EstimatedBytes    int64                      `json:"estimatedBytes"`
+	Compatible        bool                       `json:"compatible"`
+	Collections       []MigrationCollection      `json:"collections"`
+	Incompatibilities []MigrationIncompatibility `json:"incompatibilities"`
+}
+
+// AddIncompatibility records that the object of the collection can't be migrated as is.
+func (r *MigrationReport) AddIncompatibility(collection string, id string, name string, reason string) {
+	r.Incompatibilities = append(r.Incompatibilities, MigrationIncompatibility{
+		Collection: collection,
+		Id:         id,
+		Name:       name,
+		Reason:     reason,
+	})
+}
+
+// Complete totals the collections scanned in scanDuration and estimates the duration of the migration and the
+// additional space it takes, the migrated objects coexisting with the source ones until these are deleted.
+func (r *MigrationReport) Complete(scanDuration time.Duration) {
+	r.Objects = 0
+	r.Bytes = 0
+	for _, c := range r.Collections {
+		r.Objects += c.Objects
+		r.Bytes += c.Bytes
+	}
+
+	r.ScanDuration = scanDuration.Round(time.Millisecond).String()
+	r.EstimatedDuration = (scanDuration * MigrationCostFactor).Round(time.Millisecond).String()
+	r.EstimatedBytes = r.Bytes + int64(r.Objects)*MigrationIndexOverhead
+	r.Compatible = len(r.Incompatibilities) == 0
+	if r.Incompatibilities == nil {
+		r.Incompatibilities = []MigrationIncompatibility{}
+	}
+}
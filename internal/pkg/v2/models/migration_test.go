@@ -0,0 +1,36 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMigrationReportComplete(t *testing.T) {
+	report := MigrationReport{
+		Collections: []MigrationCollection{
+			{Name: "deviceService", Objects: 2, Bytes: 600},
+			{Name: "device", Objects: 3, Bytes: 1400},
+		},
+	}
+
+	report.Complete(1500 * time.Millisecond)
+	assert.Equal(t, 5, report.Objects)
+	assert.Equal(t, int64(2000), report.Bytes)
+	assert.Equal(t, "1.5s", report.ScanDuration)
+	assert.Equal(t, "6s", report.EstimatedDuration)
+	assert.Equal(t, int64(2000+5*MigrationIndexOverhead), report.EstimatedBytes)
+	assert.True(t, report.Compatible)
+	assert.NotNil(t, report.Incompatibilities, "the incompatibilities should be encoded as an empty list")
+
+	report.AddIncompatibility("device", "d1", "boiler", "references the missing device service s1")
+	report.Complete(1500 * time.Millisecond)
+	assert.False(t, report.Compatible)
+	assert.Equal(t, 5, report.Objects, "completing again should not count the collections twice")
+}
@@ -64,6 +64,23 @@ func ParseQueryStringToInt(r *http.Request, queryStringKey string, defaultValue
 	return result, nil
 }
 
+// Parse the specified query string key to a float64.  The query string is required, EdgeX error will be returned if
+// it is missing, fails to be parsed, or is out of the min ~ max range.
+func ParseQueryStringToFloat(r *http.Request, queryStringKey string, min float64, max float64) (float64, errors.EdgeX) {
+	values, ok := r.URL.Query()[queryStringKey]
+	if !ok || len(values) == 0 || len(strings.TrimSpace(values[0])) == 0 {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("querystring %s is required", queryStringKey), nil)
+	}
+	result, parsingErr := strconv.ParseFloat(strings.TrimSpace(values[0]), 64)
+	if parsingErr != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse querystring %s's value %s into float. Error:%s", queryStringKey, values[0], parsingErr.Error()), nil)
+	}
+	if result < min || result > max {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("querystring %s's value %v is out of min %v ~ max %v range.", queryStringKey, result, min, max), nil)
+	}
+	return result, nil
+}
+
 // Parse the specified query string key to an array of string.  If specified query string key is found more than once in
 // the http request, only the first specified query string will be parsed and converted to an array of string.  The
 // value of query string will be split into an array of string by the passing separator.  If separator is passed in as
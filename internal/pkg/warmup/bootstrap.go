@@ -0,0 +1,63 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package warmup
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+const defaultTimeout = 30 * time.Second
+
+// Bootstrap contains references to dependencies required by the BootstrapHandler.
+type Bootstrap struct {
+	info    *WarmupInfo
+	loaders func(dic *di.Container) []Loader
+}
+
+// NewBootstrap is a factory method that returns an initialized Bootstrap receiver struct; loaders returns the loaders
+// of the caches of the service once its dependencies are in the DIC.
+func NewBootstrap(info *WarmupInfo, loaders func(dic *di.Container) []Loader) *Bootstrap {
+	return &Bootstrap{
+		info:    info,
+		loaders: loaders,
+	}
+}
+
+// BootstrapHandler fulfills the BootstrapHandler contract. When the warmup is enabled, it preloads the caches of the
+// service, so it must run after the handlers adding the dependencies of the loaders to the DIC and before the service
+// reports ready. A cache failing to load is logged and left to be filled by the requests, as it would be without the
+// warmup.
+func (b *Bootstrap) BootstrapHandler(ctx context.Context, _ *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
+	if !b.info.Enabled {
+		return true
+	}
+
+	lc := container.LoggingClientFrom(dic.Get)
+	timeout := defaultTimeout
+	if b.info.Timeout != "" {
+		var err error
+		if timeout, err = time.ParseDuration(b.info.Timeout); err != nil || timeout <= 0 {
+			lc.Error(fmt.Sprintf("invalid Warmup Timeout '%s'", b.info.Timeout))
+			return false
+		}
+	}
+
+	for _, result := range Run(ctx, b.loaders(dic), timeout) {
+		if result.Err != nil {
+			lc.Warn(fmt.Sprintf("failed to preload %s in %s: %s", result.Name, result.Duration, result.Err.Error()))
+			continue
+		}
+		lc.Info(fmt.Sprintf("Preloaded %d %s in %s", result.Loaded, result.Name, result.Duration))
+	}
+	return true
+}
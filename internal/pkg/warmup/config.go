@@ -0,0 +1,14 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package warmup
+
+// WarmupInfo provides properties related to preloading the caches of the service at startup, before it reports ready
+type WarmupInfo struct {
+	// Enabled indicates whether the caches are preloaded at startup
+	Enabled bool
+	// Timeout bounds the preloading, after which the service starts with the caches loaded so far, e.g. '30s'
+	Timeout string
+}
@@ -0,0 +1,58 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package warmup preloads the caches of a service at startup, so that the first requests after a restart don't all
+// miss them.
+package warmup
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Loader preloads a cache of the service, returning how many entries were loaded.
+type Loader struct {
+	Name string
+	Load func(ctx context.Context) (int, error)
+}
+
+// Result is the outcome of a Loader.
+type Result struct {
+	Name     string
+	Loaded   int
+	Duration time.Duration
+	Err      error
+}
+
+// Run runs the loaders concurrently, bounded by timeout, and returns their results in the order of the loaders.
+func Run(ctx context.Context, loaders []Loader, timeout time.Duration) []Result {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	results := make([]Result, len(loaders))
+	wg := sync.WaitGroup{}
+	for i, loader := range loaders {
+		wg.Add(1)
+		go func(i int, loader Loader) {
+			defer wg.Done()
+
+			done := make(chan Result, 1)
+			start := time.Now()
+			go func() {
+				loaded, err := loader.Load(ctx)
+				done <- Result{Name: loader.Name, Loaded: loaded, Duration: time.Since(start), Err: err}
+			}()
+			// a loader unaware of ctx doesn't hold up the startup past the timeout
+			select {
+			case results[i] = <-done:
+			case <-ctx.Done():
+				results[i] = Result{Name: loader.Name, Duration: time.Since(start), Err: ctx.Err()}
+			}
+		}(i, loader)
+	}
+	wg.Wait()
+	return results
+}
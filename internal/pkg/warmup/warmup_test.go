@@ -0,0 +1,46 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package warmup
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun(t *testing.T) {
+	blocked := make(chan struct{})
+	defer close(blocked)
+
+	results := Run(context.Background(), []Loader{
+		{Name: "devices", Load: func(ctx context.Context) (int, error) { return 3, nil }},
+		{Name: "profiles", Load: func(ctx context.Context) (int, error) { return 0, errors.New("connection refused") }},
+		{Name: "services", Load: func(ctx context.Context) (int, error) {
+			<-blocked
+			return 1, nil
+		}},
+	}, 50*time.Millisecond)
+	require.Len(t, results, 3)
+
+	assert.Equal(t, "devices", results[0].Name)
+	assert.Equal(t, 3, results[0].Loaded)
+	assert.NoError(t, results[0].Err)
+
+	assert.Equal(t, "profiles", results[1].Name)
+	assert.EqualError(t, results[1].Err, "connection refused")
+
+	assert.Equal(t, "services", results[2].Name)
+	assert.Equal(t, context.DeadlineExceeded, results[2].Err, "a loader shouldn't hold up the startup past the timeout")
+	assert.GreaterOrEqual(t, int64(results[2].Duration), int64(50*time.Millisecond))
+}
+
+func TestRunNoLoaders(t *testing.T) {
+	assert.Empty(t, Run(context.Background(), nil, time.Second))
+}
@@ -0,0 +1,63 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package writable
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-configuration/pkg/types"
+
+	consulapi "github.com/hashicorp/consul/api"
+)
+
+// MaxChanges is the number of settings a patch can change, as Consul limits the operations of a transaction to 64.
+const MaxChanges = 64
+
+// Store stores the changes in the configuration provider of serviceConfig, under its BasePath, in a single
+// transaction: either every change is stored or none is, so a failure never leaves the stored configuration half
+// patched.
+func Store(serviceConfig types.ServiceConfig, changes []Change) error {
+	if len(changes) > MaxChanges {
+		return fmt.Errorf("the patch changes %d settings, at most %d can be changed at once", len(changes), MaxChanges)
+	}
+
+	consulConfig := consulapi.DefaultConfig()
+	consulConfig.Address = serviceConfig.GetUrl()
+	client, err := consulapi.NewClient(consulConfig)
+	if err != nil {
+		return fmt.Errorf("unable to create the Consul client for %s: %v", consulConfig.Address, err)
+	}
+
+	basePath := strings.TrimSuffix(serviceConfig.BasePath, "/")
+	ops := make(consulapi.TxnOps, 0, len(changes))
+	for _, change := range changes {
+		ops = append(ops, &consulapi.TxnOp{
+			KV: &consulapi.KVTxnOp{
+				Verb:  consulapi.KVSet,
+				Key:   basePath + "/" + change.Key,
+				Value: []byte(Value(change.New)),
+			},
+		})
+	}
+
+	ok, response, _, err := client.Txn().Txn(ops, nil)
+	if err != nil {
+		return fmt.Errorf("unable to store the changes in Consul: %v", err)
+	}
+	if !ok {
+		var reasons []string
+		for _, txnErr := range response.Errors {
+			if txnErr.OpIndex >= 0 && txnErr.OpIndex < len(changes) {
+				reasons = append(reasons, fmt.Sprintf("%s: %s", changes[txnErr.OpIndex].Key, txnErr.What))
+				continue
+			}
+			reasons = append(reasons, txnErr.What)
+		}
+		return fmt.Errorf("Consul rolled back the changes: %s", strings.Join(reasons, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package writable
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-configuration/pkg/types"
+
+	consulapi "github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestConsul returns a fake Consul answering the transactions with ok, and the operations it received.
+func newTestConsul(t *testing.T, ok bool) (types.ServiceConfig, *consulapi.TxnOps) {
+	var ops consulapi.TxnOps
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/txn", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&ops))
+		if !ok {
+			w.WriteHeader(http.StatusConflict)
+			_ = json.NewEncoder(w).Encode(consulapi.TxnResponse{
+				Errors: consulapi.TxnErrors{{OpIndex: 1, What: "permission denied"}},
+			})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(consulapi.TxnResponse{})
+	}))
+	t.Cleanup(server.Close)
+
+	serverUrl, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(serverUrl.Port())
+	require.NoError(t, err)
+	return types.ServiceConfig{
+		Host:     serverUrl.Hostname(),
+		Port:     port,
+		Type:     "consul",
+		BasePath: "edgex/core/1.0/edgex-core-data/",
+	}, &ops
+}
+
+func TestStore(t *testing.T) {
+	serviceConfig, ops := newTestConsul(t, true)
+	changes := []Change{
+		{Key: "Writable/LogLevel", Old: "INFO", New: "DEBUG"},
+		{Key: "Writable/Retry/Limit", Old: json.Number("3"), New: json.Number("1000000")},
+	}
+
+	require.NoError(t, Store(serviceConfig, changes))
+	require.Len(t, *ops, 2, "the changes should be stored in a single transaction")
+	assert.Equal(t, &consulapi.KVTxnOp{
+		Verb:  consulapi.KVSet,
+		Key:   "edgex/core/1.0/edgex-core-data/Writable/LogLevel",
+		Value: []byte("DEBUG"),
+	}, (*ops)[0].KV)
+	assert.Equal(t, &consulapi.KVTxnOp{
+		Verb:  consulapi.KVSet,
+		Key:   "edgex/core/1.0/edgex-core-data/Writable/Retry/Limit",
+		Value: []byte("1000000"),
+	}, (*ops)[1].KV)
+}
+
+func TestStoreRolledBack(t *testing.T) {
+	serviceConfig, _ := newTestConsul(t, false)
+	err := Store(serviceConfig, []Change{
+		{Key: "Writable/LogLevel", Old: "INFO", New: "DEBUG"},
+		{Key: "Writable/Retry/Limit", Old: json.Number("3"), New: json.Number("5")},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Writable/Retry/Limit: permission denied")
+}
+
+func TestStoreTooManyChanges(t *testing.T) {
+	serviceConfig, ops := newTestConsul(t, true)
+	changes := make([]Change, MaxChanges+1)
+	for i := range changes {
+		changes[i] = Change{Key: fmt.Sprintf("Writable/Setting%d", i), New: "on"}
+	}
+	assert.Error(t, Store(serviceConfig, changes))
+	assert.Empty(t, *ops)
+}
@@ -0,0 +1,151 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package writable changes the writable configuration of a service at runtime, from a patch of its settings validated
+// against the configuration before it's applied.
+package writable
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/interfaces"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+)
+
+// Section is the section of the configuration changed at runtime
+const Section = "Writable"
+
+// Change is a setting of the writable configuration changed by a patch.
+type Change struct {
+	// Key is the path of the setting in the configuration, e.g. 'Writable/LogLevel'
+	Key string      `json:"key"`
+	Old interface{} `json:"old"`
+	New interface{} `json:"new"`
+}
+
+// Patch returns the writable configuration of configuration with the patch applied, and the settings it changes. The
+// patch is a JSON object of the settings to change, nested as in the writable configuration; it can't add settings
+// nor change their type, and only changes the settings holding a single value.
+func Patch(configuration interfaces.Configuration, patch []byte) (interface{}, []Change, error) {
+	current := reflect.Indirect(reflect.ValueOf(configuration)).FieldByName(Section)
+	if !current.IsValid() {
+		return nil, nil, fmt.Errorf("the configuration has no %s section", Section)
+	}
+
+	var settings map[string]interface{}
+	if err := decode(patch, &settings); err != nil {
+		return nil, nil, fmt.Errorf("the patch isn't a JSON object: %v", err)
+	}
+	if len(settings) == 0 {
+		return nil, nil, errors.New("the patch changes no setting")
+	}
+	if err := checkValues(settings, Section); err != nil {
+		return nil, nil, err
+	}
+
+	// the patch is applied to a copy of the writable configuration, left intact until the patch is validated
+	before, err := json.Marshal(current.Interface())
+	if err != nil {
+		return nil, nil, err
+	}
+	updated := configuration.EmptyWritablePtr()
+	if err = json.Unmarshal(before, updated); err != nil {
+		return nil, nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(patch))
+	decoder.DisallowUnknownFields()
+	if err = decoder.Decode(updated); err != nil {
+		return nil, nil, fmt.Errorf("invalid patch: %v", err)
+	}
+	if level := reflect.Indirect(reflect.ValueOf(updated)).FieldByName("LogLevel"); level.IsValid() &&
+		level.Kind() == reflect.String && !logger.IsValidLogLevel(level.String()) {
+		return nil, nil, fmt.Errorf("invalid LogLevel '%s'", level.String())
+	}
+
+	after, err := json.Marshal(updated)
+	if err != nil {
+		return nil, nil, err
+	}
+	return updated, diff(before, after), nil
+}
+
+// Value formats the value of a changed setting as it's stored by the configuration provider. The numbers are
+// json.Number, written as they were encoded rather than in the exponent notation of a float64, e.g. 1000000 rather
+// than 1e+06.
+func Value(value interface{}) string {
+	if number, ok := value.(json.Number); ok {
+		return number.String()
+	}
+	return fmt.Sprint(value)
+}
+
+// checkValues checks that the settings of the patch under path hold single values.
+func checkValues(settings map[string]interface{}, path string) error {
+	for name, value := range settings {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			if err := checkValues(v, path+"/"+name); err != nil {
+				return err
+			}
+		case []interface{}, nil:
+			return fmt.Errorf("%s/%s can't be patched, only the settings holding a single value can", path, name)
+		}
+	}
+	return nil
+}
+
+// diff returns the settings of the JSON encoded writable configurations before and after which differ, by key.
+func diff(before []byte, after []byte) []Change {
+	old := flatten(before)
+	var changes []Change
+	for key, value := range flatten(after) {
+		if !reflect.DeepEqual(old[key], value) {
+			changes = append(changes, Change{Key: key, Old: old[key], New: value})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Key < changes[j].Key })
+	return changes
+}
+
+// flatten returns the settings of the JSON encoded writable configuration, keyed by their path in the configuration.
+func flatten(encoded []byte) map[string]interface{} {
+	var object map[string]interface{}
+	_ = decode(encoded, &object)
+	values := make(map[string]interface{})
+	addValues(object, Section, values)
+	return values
+}
+
+// addValues adds the settings of object under path to values, keyed by their path.
+func addValues(object map[string]interface{}, path string, values map[string]interface{}) {
+	for name, value := range object {
+		if nested, ok := value.(map[string]interface{}); ok {
+			addValues(nested, path+"/"+name, values)
+			continue
+		}
+		values[path+"/"+name] = value
+	}
+}
+
+// decode decodes the JSON encoded settings into v, their numbers as json.Number, failing as json.Unmarshal does when
+// they're followed by anything else.
+func decode(encoded []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+	if err := decoder.Decode(v); err != nil {
+		return err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return errors.New("invalid data after the settings")
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package writable
+
+import (
+	"encoding/json"
+	"testing"
+
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type retryInfo struct {
+	Limit    int
+	Interval string
+}
+
+type writableInfo struct {
+	LogLevel    string
+	PersistData bool
+	Retry       retryInfo
+	Topics      []string
+}
+
+type testConfiguration struct {
+	Writable writableInfo
+}
+
+func (c *testConfiguration) UpdateFromRaw(interface{}) bool {
+	return false
+}
+
+func (c *testConfiguration) EmptyWritablePtr() interface{} {
+	return &writableInfo{}
+}
+
+func (c *testConfiguration) UpdateWritableFromRaw(rawWritable interface{}) bool {
+	writable, ok := rawWritable.(*writableInfo)
+	if ok {
+		c.Writable = *writable
+	}
+	return ok
+}
+
+func (c *testConfiguration) GetBootstrap() bootstrapConfig.BootstrapConfiguration {
+	return bootstrapConfig.BootstrapConfiguration{}
+}
+
+func (c *testConfiguration) GetLogLevel() string {
+	return c.Writable.LogLevel
+}
+
+func (c *testConfiguration) GetRegistryInfo() bootstrapConfig.RegistryInfo {
+	return bootstrapConfig.RegistryInfo{}
+}
+
+func newTestConfiguration() *testConfiguration {
+	return &testConfiguration{
+		Writable: writableInfo{
+			LogLevel:    "INFO",
+			PersistData: true,
+			Retry:       retryInfo{Limit: 3, Interval: "1s"},
+			Topics:      []string{"events"},
+		},
+	}
+}
+
+func TestPatch(t *testing.T) {
+	configuration := newTestConfiguration()
+
+	updated, changes, err := Patch(configuration, []byte(`{"loglevel":"DEBUG","PersistData":true,"Retry":{"Limit":5}}`))
+	require.NoError(t, err)
+
+	assert.Equal(t, &writableInfo{
+		LogLevel:    "DEBUG",
+		PersistData: true,
+		Retry:       retryInfo{Limit: 5, Interval: "1s"},
+		Topics:      []string{"events"},
+	}, updated)
+	assert.Equal(t, []Change{
+		{Key: "Writable/LogLevel", Old: "INFO", New: "DEBUG"},
+		{Key: "Writable/Retry/Limit", Old: json.Number("3"), New: json.Number("5")},
+	}, changes, "only the settings whose value changes should be reported")
+	assert.Equal(t, "INFO", configuration.Writable.LogLevel, "the configuration should be left intact")
+	assert.Equal(t, "5", Value(changes[1].New))
+
+	_, changes, err = Patch(configuration, []byte(`{"Retry":{"Limit":1000000}}`))
+	require.NoError(t, err)
+	assert.Equal(t, "1000000", Value(changes[0].New), "the numbers should be stored as they were encoded")
+}
+
+func TestPatchValidation(t *testing.T) {
+	tests := []struct {
+		name  string
+		patch string
+	}{
+		{"not an object", `["LogLevel"]`},
+		{"empty", `{}`},
+		{"unknown setting", `{"Title":"edge"}`},
+		{"wrong type", `{"PersistData":"yes"}`},
+		{"list", `{"Topics":["events","readings"]}`},
+		{"null", `{"LogLevel":null}`},
+		{"nested unknown setting", `{"Retry":{"Backoff":2}}`},
+		{"invalid log level", `{"LogLevel":"VERBOSE"}`},
+		{"trailing data", `{"LogLevel":"DEBUG"} {"LogLevel":"TRACE"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configuration := newTestConfiguration()
+			_, _, err := Patch(configuration, []byte(tt.patch))
+			assert.Error(t, err)
+			assert.Equal(t, newTestConfiguration(), configuration)
+		})
+	}
+}
@@ -26,6 +26,7 @@ type ConfigurationStruct struct {
 	Writable          WritableInfo
 	SecretService     secretstoreclient.SecretServiceInfo
 	TokenFileProvider TokenFileProviderInfo
+	TokenServer       TokenServerInfo
 }
 
 type WritableInfo struct {
@@ -44,6 +45,28 @@ type TokenFileProviderInfo struct {
 	OutputFilename string
 }
 
+// TokenServerInfo configures the issuing of tokens on demand, to the custom services declared with the selectors
+// their processes must match, when the token provider runs with the --serve flag
+type TokenServerInfo struct {
+	// Path of the unix socket the tokens are requested on, e.g. '/run/edgex/secrets/token-provider.sock'
+	Path string
+	// Mode is the octal file mode of the socket, e.g. '0666'
+	Mode string
+	// Services are the selectors of the custom services, by service name
+	Services map[string]ServiceSelectors
+}
+
+// ServiceSelectors identify the processes of a custom service, as the unix workload attestor of SPIRE does; a process
+// must match all of them to be issued the token of the service
+type ServiceSelectors struct {
+	// Uid is the user the processes of the service run as
+	Uid int
+	// Gids are the groups the processes may run as; any group matches when empty
+	Gids []int
+	// Path is the absolute path of the executable of the processes; any executable matches when empty
+	Path string
+}
+
 // UpdateFromRaw converts configuration received from the registry to a service-specific configuration struct which is
 // then used to overwrite the service's existing configuration struct.
 func (c *ConfigurationStruct) UpdateFromRaw(rawConfig interface{}) bool {
@@ -36,11 +36,14 @@ import (
 
 type Bootstrap struct {
 	exitCode int
+	serve    bool
 }
 
-func NewBootstrap() *Bootstrap {
+// NewBootstrap creates the Bootstrap generating the token files, or issuing tokens on demand when serve is set.
+func NewBootstrap(serve bool) *Bootstrap {
 	return &Bootstrap{
 		exitCode: 0,
+		serve:    serve,
 	}
 }
 
@@ -50,7 +53,7 @@ func (b *Bootstrap) ExitCode() int {
 }
 
 // BootstrapHandler fulfills the BootstrapHandler contract and performs initialization needed by the data service.
-func (b *Bootstrap) BootstrapHandler(_ context.Context, _ *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
+func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
 	cfg := container.ConfigurationFrom(dic.Get)
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 
@@ -77,6 +80,17 @@ func (b *Bootstrap) BootstrapHandler(_ context.Context, _ *sync.WaitGroup, _ sta
 	fileProvider := NewTokenProvider(lc, fileOpener, tokenProvider, vaultClient)
 
 	fileProvider.SetConfiguration(cfg.SecretService, cfg.TokenFileProvider)
+
+	if b.serve {
+		if err := NewTokenServer(lc, fileProvider, cfg.TokenServer).Start(ctx, wg); err != nil {
+			lc.Error(fmt.Sprintf("unable to serve tokens on unix socket %s: %s", cfg.TokenServer.Path, err.Error()))
+			b.exitCode = 1
+			return false
+		}
+		lc.Info("Issuing tokens on demand on unix socket " + cfg.TokenServer.Path)
+		return true
+	}
+
 	err := fileProvider.Run()
 
 	if err != nil {
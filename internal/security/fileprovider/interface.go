@@ -28,4 +28,6 @@ type TokenProvider interface {
 	SetConfiguration(secretConfig secretstoreclient.SecretServiceInfo, tokenConfig config.TokenFileProviderInfo)
 	// Generate tokens
 	Run() error
+	// Issue the token of a service asking for one on demand
+	IssueToken(serviceName string) (interface{}, error)
 }
@@ -45,7 +45,9 @@ func Main(ctx context.Context, cancel context.CancelFunc, _ *mux.Router, _ chan<
 	//      flags.Parse(os.Args[1:])
 	//
 
+	var serve bool
 	f := flags.New()
+	f.FlagSet.BoolVar(&serve, ServeFlag, false, ServeUsage)
 	f.Parse(os.Args[1:])
 
 	configuration := &config.ConfigurationStruct{}
@@ -55,7 +57,7 @@ func Main(ctx context.Context, cancel context.CancelFunc, _ *mux.Router, _ chan<
 		},
 	})
 
-	bootStrapper := NewBootstrap()
+	bootStrapper := NewBootstrap(serve)
 
 	bootstrap.Run(
 		ctx,
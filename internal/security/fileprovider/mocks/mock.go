@@ -38,3 +38,10 @@ func (p *MockTokenProvider) SetConfiguration(secretConfig secretstoreclient.Secr
 	// Boilerplate that returns whatever Mock.On().Returns() is configured for
 	p.Called(secretConfig, tokenConfig)
 }
+
+// IssueToken see interface.go
+func (p *MockTokenProvider) IssueToken(serviceName string) (interface{}, error) {
+	// Boilerplate that returns whatever Mock.On().Returns() is configured for
+	arguments := p.Called(serviceName)
+	return arguments.Get(0), arguments.Error(1)
+}
@@ -98,56 +98,8 @@ func (p *fileTokenProvider) Run() error {
 	for serviceName, serviceConfig := range tokenConf {
 		p.logger.Info(fmt.Sprintf("generating policy/token defaults for service %s", serviceName))
 
-		servicePolicy := make(map[string]interface{})
-		createTokenParameters := make(map[string]interface{})
-
-		if serviceConfig.UseDefaults {
-			p.logger.Info(fmt.Sprintf("using policy/token defaults for service %s", serviceName))
-			servicePolicy = makeDefaultTokenPolicy(serviceName)
-			createTokenParameters = makeDefaultTokenParameters(serviceName)
-		}
-
-		if serviceConfig.CustomPolicy != nil {
-			customPolicy := serviceConfig.CustomPolicy
-			if customPolicy["path"] != nil {
-				customPaths := customPolicy["path"].(map[string]interface{})
-				if servicePolicy["path"] == nil {
-					servicePolicy["path"] = make(map[string]interface{})
-				}
-				for k, v := range customPaths {
-					(servicePolicy["path"]).(map[string]interface{})[k] = v
-				}
-			}
-		}
-
-		if serviceConfig.CustomTokenParameters != nil {
-			// Custom token parameters override the defaults
-			createTokenParameters = mergeMaps(createTokenParameters, serviceConfig.CustomTokenParameters)
-		}
-
-		// Set a meta property that consuming serices can use to automatically scope secret queries
-		createTokenParameters["meta"] = map[string]interface{}{
-			"edgex-service-name": serviceName,
-		}
-
-		// Always create a policy with this name
-		policyName := "edgex-service-" + serviceName
-
-		policyBytes, err := json.Marshal(servicePolicy)
+		createTokenResponse, err := p.createToken(privilegedToken, serviceName, serviceConfig)
 		if err != nil {
-			p.logger.Error(fmt.Sprintf("failed encode service policy for %s: %s", serviceName, err.Error()))
-			return err
-		}
-
-		if _, err := p.vaultClient.InstallPolicy(privilegedToken, policyName, string(policyBytes)); err != nil {
-			p.logger.Error(fmt.Sprintf("failed to install policy %s: %s", policyName, err.Error()))
-			return err
-		}
-
-		var createTokenResponse interface{}
-
-		if _, err = p.vaultClient.CreateToken(privilegedToken, createTokenParameters, &createTokenResponse); err != nil {
-			p.logger.Error(fmt.Sprintf("failed to create vault token for service %s: %s", serviceName, err.Error()))
 			return err
 		}
 
@@ -216,3 +168,84 @@ func (p *fileTokenProvider) Run() error {
 
 	return nil
 }
+
+// IssueToken installs the policy of the service and creates its token, for a service asking for one on demand. The
+// entry of the service in the token configuration file is used when there is one, the defaults otherwise.
+func (p *fileTokenProvider) IssueToken(serviceName string) (interface{}, error) {
+	privilegedToken, err := p.tokenProvider.Load(p.tokenConfig.PrivilegedTokenPath)
+	if err != nil {
+		p.logger.Error(fmt.Sprintf("failed to read privileged access token: %s", err.Error()))
+		return nil, err
+	}
+
+	var tokenConf TokenConfFile
+	if err := LoadTokenConfig(p.fileOpener, p.tokenConfig.ConfigFile, &tokenConf); err != nil {
+		p.logger.Error(fmt.Sprintf("failed to read token configuration file %s: %s", p.tokenConfig.ConfigFile, err.Error()))
+		return nil, err
+	}
+
+	serviceConfig, ok := tokenConf[serviceName]
+	if !ok {
+		serviceConfig = ServiceKey{UseDefaults: true}
+	}
+	p.logger.Info(fmt.Sprintf("issuing token on demand to service %s", serviceName))
+	return p.createToken(privilegedToken, serviceName, serviceConfig)
+}
+
+// createToken installs the policy of the service and creates its token, returning the response of the secret store.
+func (p *fileTokenProvider) createToken(privilegedToken string, serviceName string, serviceConfig ServiceKey) (interface{}, error) {
+	servicePolicy := make(map[string]interface{})
+	createTokenParameters := make(map[string]interface{})
+
+	if serviceConfig.UseDefaults {
+		p.logger.Info(fmt.Sprintf("using policy/token defaults for service %s", serviceName))
+		servicePolicy = makeDefaultTokenPolicy(serviceName)
+		createTokenParameters = makeDefaultTokenParameters(serviceName)
+	}
+
+	if serviceConfig.CustomPolicy != nil {
+		customPolicy := serviceConfig.CustomPolicy
+		if customPolicy["path"] != nil {
+			customPaths := customPolicy["path"].(map[string]interface{})
+			if servicePolicy["path"] == nil {
+				servicePolicy["path"] = make(map[string]interface{})
+			}
+			for k, v := range customPaths {
+				(servicePolicy["path"]).(map[string]interface{})[k] = v
+			}
+		}
+	}
+
+	if serviceConfig.CustomTokenParameters != nil {
+		// Custom token parameters override the defaults
+		createTokenParameters = mergeMaps(createTokenParameters, serviceConfig.CustomTokenParameters)
+	}
+
+	// Set a meta property that consuming serices can use to automatically scope secret queries
+	createTokenParameters["meta"] = map[string]interface{}{
+		"edgex-service-name": serviceName,
+	}
+
+	// Always create a policy with this name
+	policyName := "edgex-service-" + serviceName
+
+	policyBytes, err := json.Marshal(servicePolicy)
+	if err != nil {
+		p.logger.Error(fmt.Sprintf("failed encode service policy for %s: %s", serviceName, err.Error()))
+		return nil, err
+	}
+
+	if _, err := p.vaultClient.InstallPolicy(privilegedToken, policyName, string(policyBytes)); err != nil {
+		p.logger.Error(fmt.Sprintf("failed to install policy %s: %s", policyName, err.Error()))
+		return nil, err
+	}
+
+	var createTokenResponse interface{}
+
+	if _, err = p.vaultClient.CreateToken(privilegedToken, createTokenParameters, &createTokenResponse); err != nil {
+		p.logger.Error(fmt.Sprintf("failed to create vault token for service %s: %s", serviceName, err.Error()))
+		return nil, err
+	}
+
+	return createTokenResponse, nil
+}
@@ -280,6 +280,48 @@ func TestTokenUsingDefaults(t *testing.T) {
 	require.Error(t, err, "expect error due to invalid servcie name from the list in env")
 }
 
+// TestIssueToken
+func TestIssueToken(t *testing.T) {
+	// Arrange
+	mockLogger := logger.MockLogger{}
+
+	mockFileIoPerformer := &MockFileIoPerformer{}
+	mockFileIoPerformer.On("OpenFileReader", configFile, os.O_RDONLY, os.FileMode(0400)).
+		Return(strings.NewReader(`{"device-camera":{"custom_token_parameters":{"ttl":"1h"}}}`), nil)
+
+	mockAuthTokenLoader := &MockAuthTokenLoader{}
+	mockAuthTokenLoader.On("Load", privilegedTokenPath).Return("fake-priv-token", nil)
+
+	expectedCameraParameters := makeMetaServiceName("device-camera")
+	expectedCameraParameters["ttl"] = "1h"
+	mockSecretStoreClient := &MockSecretStoreClient{}
+	mockSecretStoreClient.On("InstallPolicy", "fake-priv-token", "edgex-service-device-camera", "{}").Return(http.StatusNoContent, nil)
+	mockSecretStoreClient.On("CreateToken", "fake-priv-token", expectedCameraParameters, mock.Anything).
+		Run(func(args mock.Arguments) {
+			setCreateTokenResponse(args.Get(2).(*interface{}))
+		}).
+		Return(http.StatusOK, nil)
+
+	p := NewTokenProvider(mockLogger, mockFileIoPerformer, mockAuthTokenLoader, mockSecretStoreClient)
+	p.SetConfiguration(secretstoreclient.SecretServiceInfo{}, config.TokenFileProviderInfo{
+		PrivilegedTokenPath: privilegedTokenPath,
+		ConfigFile:          configFile,
+	})
+
+	// Act
+	token, err := p.IssueToken("device-camera")
+
+	// Assert
+	// - the entry of the service in the configuration file is used, and no token file is written
+	require.NoError(t, err)
+	encoded, err := json.Marshal(token)
+	require.NoError(t, err)
+	assert.Equal(t, strings.TrimSpace(string(expectedTokenFile("device-camera"))), string(encoded))
+	mockFileIoPerformer.AssertExpectations(t)
+	mockAuthTokenLoader.AssertExpectations(t)
+	mockSecretStoreClient.AssertExpectations(t)
+}
+
 // TestTokenFilePermissions
 func TestTokenFilePermissions(t *testing.T) {
 	// Arrange
@@ -0,0 +1,138 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fileprovider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/unixsocket"
+	"github.com/edgexfoundry/edgex-go/internal/security/fileprovider/config"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/gorilla/mux"
+)
+
+const (
+	// ServeFlag is the command-line flag running the token provider as a server issuing tokens on demand.
+	ServeFlag = "serve"
+	// ServeUsage describes the ServeFlag.
+	ServeUsage = "Issue tokens on demand to the custom services declared in the configuration instead of generating the token files"
+
+	serviceNameKey = "service"
+)
+
+// ApiTokenRoute issues the token of the service named in the path to the process requesting it on the socket.
+const ApiTokenRoute = clients.ApiBase + "/token/{" + serviceNameKey + "}"
+
+// TokenServer issues secret store tokens on demand, on a unix socket, to the processes of the custom services declared
+// in the configuration. A process is identified by the credentials the kernel records for its connection, which are
+// matched against the selectors of the service it asks the token of.
+type TokenServer struct {
+	lc         logger.LoggingClient
+	provider   TokenProvider
+	info       config.TokenServerInfo
+	executable func(pid int) (string, error)
+}
+
+// NewTokenServer creates a TokenServer issuing the tokens of the services of info with the provider.
+func NewTokenServer(lc logger.LoggingClient, provider TokenProvider, info config.TokenServerInfo) *TokenServer {
+	return &TokenServer{
+		lc:         lc,
+		provider:   provider,
+		info:       info,
+		executable: executablePath,
+	}
+}
+
+// Handler returns the handler of the token requests.
+func (s *TokenServer) Handler() http.Handler {
+	r := mux.NewRouter()
+	r.HandleFunc(ApiTokenRoute, s.issueToken).Methods(http.MethodPost)
+	return r
+}
+
+// Start serves the token requests on the socket until ctx is done. Only the users of the declared services are
+// allowed to connect.
+func (s *TokenServer) Start(ctx context.Context, wg *sync.WaitGroup) error {
+	if len(s.info.Services) == 0 {
+		return errors.New("no custom service is declared to issue tokens to")
+	}
+
+	info := unixsocket.UnixSocketInfo{
+		Enabled:     true,
+		Path:        s.info.Path,
+		Mode:        s.info.Mode,
+		TcpDisabled: true,
+	}
+	for _, selectors := range s.info.Services {
+		info.AllowedUids = append(info.AllowedUids, selectors.Uid)
+	}
+	return unixsocket.NewServer(info, s.Handler(), s.lc).Start(ctx, wg)
+}
+
+func (s *TokenServer) issueToken(w http.ResponseWriter, r *http.Request) {
+	serviceName := mux.Vars(r)[serviceNameKey]
+	credentials, known := unixsocket.PeerCredentials(r)
+	selectors, declared := s.info.Services[serviceName]
+	if !known || !declared || !s.matches(selectors, credentials) {
+		s.lc.Warn(fmt.Sprintf(
+			"token of service %s denied to pid %d, uid %d, gid %d",
+			serviceName,
+			credentials.Pid,
+			credentials.Uid,
+			credentials.Gid))
+		http.Error(w, "forbidden", http.StatusForbidden)
+		return
+	}
+
+	token, err := s.provider.IssueToken(serviceName)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(token)
+}
+
+// matches reports whether the process with the credentials matches all the selectors of a service.
+func (s *TokenServer) matches(selectors config.ServiceSelectors, credentials unixsocket.Credentials) bool {
+	if credentials.Uid != selectors.Uid {
+		return false
+	}
+	if len(selectors.Gids) > 0 && !containsInt(selectors.Gids, credentials.Gid) {
+		return false
+	}
+	if selectors.Path != "" {
+		path, err := s.executable(credentials.Pid)
+		if err != nil || path != selectors.Path {
+			return false
+		}
+	}
+	return true
+}
+
+// executablePath returns the path of the executable of the process, as linked from /proc.
+func executablePath(pid int) (string, error) {
+	return os.Readlink(fmt.Sprintf("/proc/%d/exe", pid))
+}
+
+func containsInt(values []int, value int) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
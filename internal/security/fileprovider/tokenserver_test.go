@@ -0,0 +1,111 @@
+// +build linux
+
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fileprovider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/unixsocket"
+	"github.com/edgexfoundry/edgex-go/internal/security/fileprovider/config"
+	"github.com/edgexfoundry/edgex-go/internal/security/fileprovider/mocks"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenServer(t *testing.T) {
+	executable, err := os.Executable()
+	require.NoError(t, err)
+	executable, err = filepath.EvalSymlinks(executable)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "token-provider.sock")
+	info := config.TokenServerInfo{
+		Path: path,
+		Services: map[string]config.ServiceSelectors{
+			"device-camera": {Uid: os.Getuid(), Gids: []int{os.Getgid()}, Path: executable},
+			"other-group":   {Uid: os.Getuid(), Gids: []int{os.Getgid() + 1}},
+			"other-program": {Uid: os.Getuid(), Path: "/usr/bin/other"},
+		},
+	}
+	provider := &mocks.MockTokenProvider{}
+	provider.On("IssueToken", "device-camera").Return(map[string]interface{}{"auth": "token"}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	require.NoError(t, NewTokenServer(logger.NewMockClient(), provider, info).Start(ctx, wg))
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	client := http.Client{Transport: &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, "unix", path)
+		},
+	}}
+
+	tests := []struct {
+		name           string
+		service        string
+		expectedStatus int
+	}{
+		{"Created", "device-camera", http.StatusCreated},
+		{"Forbidden undeclared service", "device-virtual", http.StatusForbidden},
+		{"Forbidden group", "other-group", http.StatusForbidden},
+		{"Forbidden executable", "other-program", http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := client.Post("http://unix/api/v1/token/"+tt.service, "", nil)
+			require.NoError(t, err)
+			defer func() { _ = resp.Body.Close() }()
+			require.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			if tt.expectedStatus == http.StatusCreated {
+				var token map[string]interface{}
+				require.NoError(t, json.NewDecoder(resp.Body).Decode(&token))
+				assert.Equal(t, "token", token["auth"])
+			}
+		})
+	}
+	provider.AssertNumberOfCalls(t, "IssueToken", 1)
+}
+
+func TestTokenServerNoService(t *testing.T) {
+	info := config.TokenServerInfo{Path: filepath.Join(t.TempDir(), "token-provider.sock")}
+	server := NewTokenServer(logger.NewMockClient(), &mocks.MockTokenProvider{}, info)
+	assert.Error(t, server.Start(context.Background(), &sync.WaitGroup{}))
+}
+
+func TestTokenServerMatches(t *testing.T) {
+	server := NewTokenServer(logger.NewMockClient(), &mocks.MockTokenProvider{}, config.TokenServerInfo{})
+	server.executable = func(pid int) (string, error) {
+		if pid == 1 {
+			return "/device-camera", nil
+		}
+		return "", errors.New("no such process")
+	}
+	selectors := config.ServiceSelectors{Uid: 2002, Gids: []int{2002, 2003}, Path: "/device-camera"}
+
+	assert.True(t, server.matches(selectors, unixsocket.Credentials{Pid: 1, Uid: 2002, Gid: 2003}))
+	assert.False(t, server.matches(selectors, unixsocket.Credentials{Pid: 1, Uid: 0, Gid: 2003}), "uid")
+	assert.False(t, server.matches(selectors, unixsocket.Credentials{Pid: 1, Uid: 2002, Gid: 0}), "gid")
+	assert.False(t, server.matches(selectors, unixsocket.Credentials{Pid: 2, Uid: 2002, Gid: 2002}), "executable")
+	assert.True(t, server.matches(config.ServiceSelectors{Uid: 2002}, unixsocket.Credentials{Pid: 2, Uid: 2002}))
+}
@@ -24,13 +24,15 @@ import (
 )
 
 type ConfigurationStruct struct {
-	Writable      WritableInfo
-	KongURL       KongUrlInfo
-	KongAuth      KongAuthInfo
-	KongACL       KongAclInfo
-	SecretStore   bootstrapConfig.SecretStoreInfo
-	SecretService SecretServiceInfo
-	Clients       map[string]bootstrapConfig.ClientInfo
+	Writable       WritableInfo
+	KongURL        KongUrlInfo
+	KongAuth       KongAuthInfo
+	KongACL        KongAclInfo
+	SecretStore    bootstrapConfig.SecretStoreInfo
+	SecretService  SecretServiceInfo
+	Clients        map[string]bootstrapConfig.ClientInfo
+	RouteManifests RouteManifestsInfo
+	RateLimits     map[string]RateLimitInfo
 }
 
 type WritableInfo struct {
@@ -67,6 +69,27 @@ type KongAuthInfo struct {
 	TokenTTL   int
 	Resource   string
 	OutputPath string
+	// RevocationPath is the file recording the revoked JWT credentials, deleted again from the proxy when restored
+	RevocationPath string
+	// OIDC configures the identity provider the tokens are validated against when Name is 'oidc'
+	OIDC OIDCInfo
+}
+
+// OIDCInfo describes the external OpenID Connect identity provider issuing the tokens accepted by the gateway, in
+// place of the locally-issued JWTs.
+type OIDCInfo struct {
+	// Issuer identifies the identity provider, required in the iss claim of the tokens, e.g.
+	// 'https://idp.example.com/realms/edgex'
+	Issuer string
+	// JWKSURL is where the signing keys of the identity provider are published; it's discovered from the Issuer
+	// when empty
+	JWKSURL string
+	// Audience is required in the aud claim of the tokens, usually the client ID of EdgeX at the identity provider
+	Audience string
+	// Group is the ACL group of the holders of the tokens, one of the KongACL WhiteList
+	Group string
+	// CACertPath is the CA certificate the identity provider is trusted with, along with the system's
+	CACertPath string
 }
 
 type KongAclInfo struct {
@@ -89,6 +112,32 @@ func (s SecretServiceInfo) GetSecretSvcBaseURL() string {
 	return fmt.Sprintf("%s://%s:%d", s.Protocol, s.Server, s.Port)
 }
 
+// RouteManifestsInfo locates the route manifests published by the services, from which their routes are set up along
+// with the ones of the Clients.
+type RouteManifestsInfo struct {
+	// Directory is where the services publish their manifests; none are read when empty
+	Directory string
+}
+
+// RateLimitInfo limits the rate of the requests proxied to a service, or to a path of a service, from a consumer or
+// from any consumer.
+type RateLimitInfo struct {
+	// Service is the name of the proxied service limited, one of the Clients or of the route manifests; every service
+	// when empty
+	Service string
+	// Path restricts the limit to the requests to the path of the Service and below, e.g. '/api/v1/event'
+	Path string
+	// Consumer is the username of the consumer limited; every consumer when empty
+	Consumer string
+	// Second, Minute, Hour and Day are the numbers of requests allowed per period, not limited when 0
+	Second int
+	Minute int
+	Hour   int
+	Day    int
+	// LimitBy is what the requests are counted per: 'consumer', 'credential' or 'ip'; 'consumer' when empty
+	LimitBy string
+}
+
 // UpdateFromRaw converts configuration received from the registry to a service-specific configuration struct which is
 // then used to overwrite the service's existing configuration struct.
 func (c *ConfigurationStruct) UpdateFromRaw(rawConfig interface{}) bool {
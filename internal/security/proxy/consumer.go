@@ -132,6 +132,10 @@ func (c *Consumer) CreateToken() (string, error) {
 	case "oauth2":
 		c.loggingClient.Info("authenticate the user with oauth2 authentication.")
 		return c.createOAuth2Token()
+	case "oidc":
+		e := "the tokens are issued by the identity provider with oidc authentication"
+		c.loggingClient.Error(e)
+		return "", errors.New(e)
 	default:
 		e := fmt.Sprintf("unknown authentication method provided: %s", c.configuration.KongAuth.Name)
 		c.loggingClient.Error(e)
@@ -0,0 +1,238 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package proxy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+// KongConsumer is a gateway user as listed by Kong.
+type KongConsumer struct {
+	ID        string `json:"id,omitempty"`
+	Username  string `json:"username,omitempty"`
+	CreatedAt int64  `json:"created_at,omitempty"`
+}
+
+// kongPage is a page of the lists of Kong, followed by the path of the next page when there is one.
+type kongPage struct {
+	Data json.RawMessage `json:"data"`
+	Next *string         `json:"next"`
+}
+
+// ListConsumers returns the users of the gateway.
+func ListConsumers(client internal.HttpCaller, kongProxyBaseUrl string, lc logger.LoggingClient) ([]KongConsumer, error) {
+	var consumers []KongConsumer
+	err := listPages(client, kongProxyBaseUrl, ConsumersPath, lc, func(data json.RawMessage) error {
+		var page []KongConsumer
+		if err := json.Unmarshal(data, &page); err != nil {
+			return err
+		}
+		consumers = append(consumers, page...)
+		return nil
+	})
+	return consumers, err
+}
+
+// JWTCredentials returns the JWT credentials of the consumer, one for every token created for it.
+func (c *Consumer) JWTCredentials() ([]JWTCred, error) {
+	var credentials []JWTCred
+	path := strings.Join([]string{ConsumersPath, c.name, "jwt"}, "/")
+	err := listPages(c.client, c.configuration.KongURL.GetProxyBaseURL(), path, c.loggingClient, func(data json.RawMessage) error {
+		var page []JWTCred
+		if err := json.Unmarshal(data, &page); err != nil {
+			return err
+		}
+		credentials = append(credentials, page...)
+		return nil
+	})
+	return credentials, err
+}
+
+// RevokeTokens revokes every token of the consumer by deleting all its JWT credentials, recorded in revoked.
+func (c *Consumer) RevokeTokens(revoked *RevocationList) error {
+	if err := c.checkJWTAuth(); err != nil {
+		return err
+	}
+	credentials, err := c.JWTCredentials()
+	if err != nil {
+		return err
+	}
+	return c.revokeCredentials(credentials, revoked)
+}
+
+// RevokeToken revokes token, issued to the consumer, by deleting the JWT credential it was signed with, recorded in
+// revoked. The other tokens of the consumer remain valid.
+func (c *Consumer) RevokeToken(token string, revoked *RevocationList) error {
+	if err := c.checkJWTAuth(); err != nil {
+		return err
+	}
+	key, err := jwtKey(token)
+	if err != nil {
+		return err
+	}
+	credentials, err := c.JWTCredentials()
+	if err != nil {
+		return err
+	}
+	for _, credential := range credentials {
+		if credential.Key == key {
+			return c.revokeCredentials([]JWTCred{credential}, revoked)
+		}
+	}
+	if revoked.Contains(key) {
+		c.loggingClient.Info(fmt.Sprintf("the token of consumer %s has already been revoked", c.name))
+		return nil
+	}
+	e := fmt.Sprintf("no credential of consumer %s signed the token", c.name)
+	c.loggingClient.Error(e)
+	return errors.New(e)
+}
+
+// RotateToken creates a new token for the consumer, then revokes all its previous tokens, recorded in revoked.
+func (c *Consumer) RotateToken(revoked *RevocationList) (string, error) {
+	if err := c.checkJWTAuth(); err != nil {
+		return "", err
+	}
+	previous, err := c.JWTCredentials()
+	if err != nil {
+		return "", err
+	}
+	t, err := c.createJWTToken()
+	if err != nil {
+		return "", err
+	}
+	if err := c.revokeCredentials(previous, revoked); err != nil {
+		return "", err
+	}
+	return t, nil
+}
+
+// EnforceRevocations deletes the revoked JWT credentials of the consumer still known to the proxy.
+func (c *Consumer) EnforceRevocations(revoked *RevocationList) error {
+	credentials, err := c.JWTCredentials()
+	if err != nil {
+		return err
+	}
+	var restored []JWTCred
+	for _, credential := range credentials {
+		if revoked.Contains(credential.Key) {
+			restored = append(restored, credential)
+		}
+	}
+	if len(restored) > 0 {
+		c.loggingClient.Warn(fmt.Sprintf("%d revoked credentials of consumer %s found in the proxy", len(restored), c.name))
+	}
+	return c.revokeCredentials(restored, revoked)
+}
+
+// TokenUser returns the consumer token was issued to, without verifying its signature.
+func TokenUser(token string) (string, error) {
+	claims, err := parseJWTClaims(token)
+	if err != nil {
+		return "", err
+	}
+	if claims.Acct == "" {
+		return "", errors.New("the token names no consumer")
+	}
+	return claims.Acct, nil
+}
+
+func (c *Consumer) revokeCredentials(credentials []JWTCred, revoked *RevocationList) error {
+	for _, credential := range credentials {
+		path := strings.Join([]string{ConsumersPath, c.name, "jwt"}, "/")
+		resource := NewResource(credential.ID, c.client, c.configuration.KongURL.GetProxyBaseURL(), c.loggingClient)
+		if err := resource.Remove(path); err != nil {
+			return err
+		}
+		revoked.Add(c.name, credential.Key)
+		c.loggingClient.Info(fmt.Sprintf("revoked the credential %s of consumer %s", credential.Key, c.name))
+	}
+	return nil
+}
+
+func (c *Consumer) checkJWTAuth() error {
+	if c.configuration.KongAuth.Name != "jwt" {
+		e := fmt.Sprintf("tokens can only be revoked with jwt authentication, not %s", c.configuration.KongAuth.Name)
+		c.loggingClient.Error(e)
+		return errors.New(e)
+	}
+	return nil
+}
+
+// jwtKey returns the key of the JWT credential token was signed with, which Kong reads from its iss claim.
+func jwtKey(token string) (string, error) {
+	claims, err := parseJWTClaims(token)
+	if err != nil {
+		return "", err
+	}
+	if claims.ISS == "" {
+		return "", errors.New("the token names no credential")
+	}
+	return claims.ISS, nil
+}
+
+func parseJWTClaims(token string) (*KongJWTClaims, error) {
+	claims := &KongJWTClaims{}
+	if _, _, err := new(jwt.Parser).ParseUnverified(strings.TrimSpace(token), claims); err != nil {
+		return nil, fmt.Errorf("failed to parse the token: %s", err.Error())
+	}
+	return claims, nil
+}
+
+// listPages calls collect with the data of every page of the list of Kong at path.
+func listPages(
+	client internal.HttpCaller,
+	kongProxyBaseUrl string,
+	path string,
+	lc logger.LoggingClient,
+	collect func(data json.RawMessage) error) error {
+
+	next := "/" + path
+	for next != "" {
+		req, err := http.NewRequest(http.MethodGet, kongProxyBaseUrl+next, nil)
+		if err != nil {
+			return fmt.Errorf("failed to list %s with error %s", path, err.Error())
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return fmt.Errorf("failed to list %s with error %s", path, err.Error())
+		}
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return fmt.Errorf("failed to list %s with error %s", path, err.Error())
+		}
+		if resp.StatusCode != http.StatusOK {
+			e := fmt.Sprintf("failed to list %s with error %s,%s", path, resp.Status, string(body))
+			lc.Error(e)
+			return errors.New(e)
+		}
+
+		page := kongPage{}
+		if err := json.Unmarshal(body, &page); err != nil {
+			return fmt.Errorf("failed to parse the list of %s: %s", path, err.Error())
+		}
+		if err := collect(page.Data); err != nil {
+			return fmt.Errorf("failed to parse the list of %s: %s", path, err.Error())
+		}
+		next = ""
+		if page.Next != nil {
+			next = *page.Next
+		}
+	}
+	return nil
+}
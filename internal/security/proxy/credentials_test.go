@@ -0,0 +1,201 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package proxy
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/security/proxy/config"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeKong serves the JWT credentials of the consumers as the admin API of Kong does, one credential per page.
+type fakeKong struct {
+	mutex       sync.Mutex
+	created     int
+	credentials map[string][]JWTCred
+}
+
+func (k *fakeKong) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	k.mutex.Lock()
+	defer k.mutex.Unlock()
+
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	switch {
+	case r.Method == http.MethodGet && len(segments) == 1 && segments[0] == ConsumersPath:
+		var consumers []KongConsumer
+		for name := range k.credentials {
+			consumers = append(consumers, KongConsumer{ID: name, Username: name})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": consumers, "next": nil})
+	case r.Method == http.MethodGet && len(segments) == 3:
+		credentials := k.credentials[segments[1]]
+		page := map[string]interface{}{"data": []JWTCred{}, "next": nil}
+		offset := 0
+		fmt.Sscanf(r.URL.Query().Get("offset"), "%d", &offset)
+		if offset < len(credentials) {
+			page["data"] = credentials[offset : offset+1]
+		}
+		if offset+1 < len(credentials) {
+			page["next"] = fmt.Sprintf("/%s?offset=%d", r.URL.Path[1:], offset+1)
+		}
+		_ = json.NewEncoder(w).Encode(page)
+	case r.Method == http.MethodPost && len(segments) == 3:
+		k.created++
+		credential := JWTCred{ID: fmt.Sprintf("id-%d", k.created), Key: fmt.Sprintf("key-%d", k.created), Secret: "secret"}
+		k.credentials[segments[1]] = append(k.credentials[segments[1]], credential)
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(credential)
+	case r.Method == http.MethodDelete && len(segments) == 4:
+		credentials := k.credentials[segments[1]]
+		for i, credential := range credentials {
+			if credential.ID == segments[3] {
+				k.credentials[segments[1]] = append(credentials[:i], credentials[i+1:]...)
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (k *fakeKong) keys(name string) []string {
+	k.mutex.Lock()
+	defer k.mutex.Unlock()
+	var keys []string
+	for _, credential := range k.credentials[name] {
+		keys = append(keys, credential.Key)
+	}
+	return keys
+}
+
+func newFakeKong(t *testing.T) (*fakeKong, *config.ConfigurationStruct, func()) {
+	kong := &fakeKong{credentials: map[string][]JWTCred{"testuser": nil}}
+	ts := httptest.NewServer(kong)
+	host, port, err := parseHostAndPort(ts, t)
+	require.NoError(t, err)
+
+	configuration := &config.ConfigurationStruct{}
+	configuration.KongURL = config.KongUrlInfo{Server: host, AdminPort: port}
+	configuration.KongAuth = config.KongAuthInfo{Name: "jwt"}
+	return kong, configuration, ts.Close
+}
+
+func newRevocationList(t *testing.T) *RevocationList {
+	revoked, err := LoadRevocationList(filepath.Join(t.TempDir(), "revokedTokens.json"))
+	require.NoError(t, err)
+	return revoked
+}
+
+func TestRevokeToken(t *testing.T) {
+	kong, configuration, closeKong := newFakeKong(t)
+	defer closeKong()
+
+	co := NewConsumer("testuser", &http.Client{}, logger.MockLogger{}, configuration)
+	first, err := co.CreateToken()
+	require.NoError(t, err)
+	_, err = co.CreateToken()
+	require.NoError(t, err)
+
+	user, err := TokenUser(first)
+	require.NoError(t, err)
+	assert.Equal(t, "testuser", user)
+
+	revoked := newRevocationList(t)
+	require.NoError(t, co.RevokeToken(first, revoked))
+	assert.Equal(t, []string{"key-2"}, kong.keys("testuser"), "the other token should remain valid")
+	assert.True(t, revoked.Contains("key-1"))
+
+	assert.NoError(t, co.RevokeToken(first, revoked), "revoking a token twice should succeed")
+	assert.Error(t, co.RevokeToken("not a token", revoked))
+}
+
+func TestRevokeTokens(t *testing.T) {
+	kong, configuration, closeKong := newFakeKong(t)
+	defer closeKong()
+
+	co := NewConsumer("testuser", &http.Client{}, logger.MockLogger{}, configuration)
+	for i := 0; i < 3; i++ {
+		_, err := co.CreateToken()
+		require.NoError(t, err)
+	}
+
+	revoked := newRevocationList(t)
+	require.NoError(t, co.RevokeTokens(revoked))
+	assert.Empty(t, kong.keys("testuser"))
+	assert.Len(t, revoked.Credentials, 3, "the credentials of all the pages should be revoked")
+}
+
+func TestRotateToken(t *testing.T) {
+	kong, configuration, closeKong := newFakeKong(t)
+	defer closeKong()
+
+	co := NewConsumer("testuser", &http.Client{}, logger.MockLogger{}, configuration)
+	_, err := co.CreateToken()
+	require.NoError(t, err)
+
+	revoked := newRevocationList(t)
+	token, err := co.RotateToken(revoked)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"key-2"}, kong.keys("testuser"))
+	assert.True(t, revoked.Contains("key-1"))
+
+	claims := &KongJWTClaims{}
+	_, err = jwt.ParseWithClaims(token, claims, func(*jwt.Token) (interface{}, error) { return []byte("secret"), nil })
+	require.NoError(t, err)
+	assert.Equal(t, "key-2", claims.ISS)
+}
+
+func TestEnforceRevocations(t *testing.T) {
+	kong, configuration, closeKong := newFakeKong(t)
+	defer closeKong()
+
+	co := NewConsumer("testuser", &http.Client{}, logger.MockLogger{}, configuration)
+	for i := 0; i < 2; i++ {
+		_, err := co.CreateToken()
+		require.NoError(t, err)
+	}
+
+	revoked := newRevocationList(t)
+	revoked.Add("testuser", "key-1")
+	require.NoError(t, co.EnforceRevocations(revoked))
+	assert.Equal(t, []string{"key-2"}, kong.keys("testuser"))
+}
+
+func TestRevocationRequiresJWT(t *testing.T) {
+	_, configuration, closeKong := newFakeKong(t)
+	defer closeKong()
+	configuration.KongAuth.Name = "oauth2"
+
+	co := NewConsumer("testuser", &http.Client{}, logger.MockLogger{}, configuration)
+	assert.Error(t, co.RevokeTokens(newRevocationList(t)))
+	_, err := co.RotateToken(newRevocationList(t))
+	assert.Error(t, err)
+}
+
+func TestListConsumers(t *testing.T) {
+	_, configuration, closeKong := newFakeKong(t)
+	defer closeKong()
+
+	consumers, err := ListConsumers(&http.Client{}, configuration.KongURL.GetProxyBaseURL(), logger.MockLogger{})
+	require.NoError(t, err)
+	require.Len(t, consumers, 1)
+	assert.Equal(t, "testuser", consumers[0].Username)
+}
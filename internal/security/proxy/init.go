@@ -20,9 +20,11 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 	"sync"
 
 	"github.com/edgexfoundry/edgex-go/internal"
+	"github.com/edgexfoundry/edgex-go/internal/security/proxy/config"
 	"github.com/edgexfoundry/edgex-go/internal/security/proxy/container"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
@@ -39,6 +41,10 @@ type Bootstrap struct {
 	userTobeCreated    string
 	userOfGroup        string
 	userToBeDeleted    string
+	userList           bool
+	userToBeRevoked    string
+	tokenToBeRevoked   string
+	userToBeRotated    string
 }
 
 func NewBootstrap(
@@ -47,7 +53,11 @@ func NewBootstrap(
 	resetNeeded bool,
 	userTobeCreated string,
 	userOfGroup string,
-	userToBeDeleted string) *Bootstrap {
+	userToBeDeleted string,
+	userList bool,
+	userToBeRevoked string,
+	tokenToBeRevoked string,
+	userToBeRotated string) *Bootstrap {
 
 	return &Bootstrap{
 		insecureSkipVerify: insecureSkipVerify,
@@ -56,6 +66,10 @@ func NewBootstrap(
 		userTobeCreated:    userTobeCreated,
 		userOfGroup:        userOfGroup,
 		userToBeDeleted:    userToBeDeleted,
+		userList:           userList,
+		userToBeRevoked:    userToBeRevoked,
+		tokenToBeRevoked:   tokenToBeRevoked,
+		userToBeRotated:    userToBeRotated,
 	}
 }
 
@@ -112,6 +126,7 @@ func (b *Bootstrap) BootstrapHandler(_ context.Context, _ *sync.WaitGroup, _ sta
 		c := NewConsumer(b.userTobeCreated, req, lc, configuration)
 		b.haltIfError(lc, c.Create(EdgeXKong))
 		b.haltIfError(lc, c.AssociateWithGroup(b.userOfGroup))
+		b.haltIfError(lc, s.InitConsumerRateLimits(b.userTobeCreated))
 
 		t, err := c.CreateToken()
 		if err != nil {
@@ -132,5 +147,95 @@ func (b *Bootstrap) BootstrapHandler(_ context.Context, _ *sync.WaitGroup, _ sta
 		b.haltIfError(lc, t.Delete())
 	}
 
+	if configuration.KongAuth.Name == "jwt" && configuration.KongAuth.RevocationPath != "" {
+		b.manageTokens(lc, req, configuration)
+	} else if b.userToBeRevoked != "" || b.tokenToBeRevoked != "" || b.userToBeRotated != "" {
+		b.errorAndHalt(lc, "tokens can only be revoked and rotated with jwt authentication and a RevocationPath")
+	}
+
+	if b.userList {
+		b.listUsers(lc, req, configuration)
+	}
+
 	return false
 }
+
+// manageTokens revokes and rotates the tokens requested, after deleting again from the proxy the credentials revoked
+// beforehand which it still knows of.
+func (b *Bootstrap) manageTokens(lc logger.LoggingClient, req internal.HttpCaller, configuration *config.ConfigurationStruct) {
+	revoked, err := LoadRevocationList(configuration.KongAuth.RevocationPath)
+	b.haltIfError(lc, err)
+
+	if len(revoked.Credentials) > 0 {
+		consumers, err := ListConsumers(req, configuration.KongURL.GetProxyBaseURL(), lc)
+		b.haltIfError(lc, err)
+		existing := make(map[string]bool, len(consumers))
+		for _, consumer := range consumers {
+			existing[consumer.Username] = true
+		}
+		for _, user := range revoked.Users() {
+			if existing[user] {
+				c := NewConsumer(user, req, lc, configuration)
+				b.haltIfError(lc, c.EnforceRevocations(revoked))
+			}
+		}
+	}
+
+	if b.tokenToBeRevoked != "" {
+		user, err := TokenUser(b.tokenToBeRevoked)
+		b.haltIfError(lc, err)
+		c := NewConsumer(user, req, lc, configuration)
+		err = c.RevokeToken(b.tokenToBeRevoked, revoked)
+		b.haltIfError(lc, revoked.Save())
+		b.haltIfError(lc, err)
+		fmt.Println(fmt.Sprintf("the token of user %s has been revoked", user))
+	}
+
+	if b.userToBeRevoked != "" {
+		c := NewConsumer(b.userToBeRevoked, req, lc, configuration)
+		err := c.RevokeTokens(revoked)
+		b.haltIfError(lc, revoked.Save())
+		b.haltIfError(lc, err)
+		fmt.Println(fmt.Sprintf("all the tokens of user %s have been revoked", b.userToBeRevoked))
+	}
+
+	if b.userToBeRotated != "" {
+		c := NewConsumer(b.userToBeRotated, req, lc, configuration)
+		t, err := c.RotateToken(revoked)
+		b.haltIfError(lc, revoked.Save())
+		if err != nil {
+			b.errorAndHalt(lc, fmt.Sprintf("failed to rotate the access token of user %s due to error %s", b.userToBeRotated, err.Error()))
+		}
+
+		fmt.Println(fmt.Sprintf("the new access token for user %s is: %s. Its previous tokens have been revoked", b.userToBeRotated, t))
+
+		file, err := os.Create(configuration.KongAuth.OutputPath)
+		b.haltIfError(lc, err)
+
+		utp := &UserTokenPair{User: b.userToBeRotated, Token: t}
+		b.haltIfError(lc, utp.Save(file))
+	}
+
+	b.haltIfError(lc, revoked.Save())
+}
+
+// listUsers prints the users of the gateway, along with the keys of their valid JWT credentials.
+func (b *Bootstrap) listUsers(lc logger.LoggingClient, req internal.HttpCaller, configuration *config.ConfigurationStruct) {
+	consumers, err := ListConsumers(req, configuration.KongURL.GetProxyBaseURL(), lc)
+	b.haltIfError(lc, err)
+
+	for _, consumer := range consumers {
+		if configuration.KongAuth.Name != "jwt" {
+			fmt.Println(consumer.Username)
+			continue
+		}
+		c := NewConsumer(consumer.Username, req, lc, configuration)
+		credentials, err := c.JWTCredentials()
+		b.haltIfError(lc, err)
+		keys := make([]string, len(credentials))
+		for i, credential := range credentials {
+			keys[i] = credential.Key
+		}
+		fmt.Println(fmt.Sprintf("%s: %d valid token credentials [%s]", consumer.Username, len(keys), strings.Join(keys, ", ")))
+	}
+}
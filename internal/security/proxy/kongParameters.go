@@ -22,6 +22,7 @@ type KongService struct {
 	Host     string `url:"host,omitempty"`
 	Port     int    `url:"port,omitempty"`
 	Protocol string `url:"protocol,omitempty"`
+	Path     string `url:"path,omitempty"`
 }
 
 // KongServiceResponse is the response from Kong when creating a service
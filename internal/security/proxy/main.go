@@ -45,6 +45,10 @@ func Main(ctx context.Context, cancel context.CancelFunc, _ *mux.Router, _ chan<
 	var userTobeCreated string
 	var userOfGroup string
 	var userToBeDeleted string
+	var userList bool
+	var userToBeRevoked string
+	var tokenToBeRevoked string
+	var userToBeRotated string
 
 	// All common command-line flags have been moved to bootstrap. Service specific flags are added below.
 	f := flags.NewWithUsage(
@@ -53,7 +57,11 @@ func Main(ctx context.Context, cancel context.CancelFunc, _ *mux.Router, _ chan<
 			"    --reset=true/false              Indicate if security service should be reset to initialization status\n" +
 			"    --useradd=<username>            Create an account and return JWT\n" +
 			"    --group=<groupname>             Group name the user belongs to\n" +
-			"    --userdel=<username>            Delete an account",
+			"    --userdel=<username>            Delete an account\n" +
+			"    --userlist=true/false           List the accounts and the keys of their valid tokens\n" +
+			"    --userrevoke=<username>         Revoke all the JWTs of an account\n" +
+			"    --tokenrevoke=<token>           Revoke a single JWT\n" +
+			"    --userrotate=<username>         Create a new JWT for an account and revoke its previous ones",
 	)
 
 	if len(os.Args) < 2 {
@@ -66,6 +74,10 @@ func Main(ctx context.Context, cancel context.CancelFunc, _ *mux.Router, _ chan<
 	f.FlagSet.StringVar(&userTobeCreated, "useradd", "", "")
 	f.FlagSet.StringVar(&userOfGroup, "group", "user", "")
 	f.FlagSet.StringVar(&userToBeDeleted, "userdel", "", "")
+	f.FlagSet.BoolVar(&userList, "userlist", false, "")
+	f.FlagSet.StringVar(&userToBeRevoked, "userrevoke", "", "")
+	f.FlagSet.StringVar(&tokenToBeRevoked, "tokenrevoke", "", "")
+	f.FlagSet.StringVar(&userToBeRotated, "userrotate", "", "")
 	f.Parse(os.Args[1:])
 
 	configuration := &config.ConfigurationStruct{}
@@ -92,7 +104,11 @@ func Main(ctx context.Context, cancel context.CancelFunc, _ *mux.Router, _ chan<
 				resetNeeded,
 				userTobeCreated,
 				userOfGroup,
-				userToBeDeleted).BootstrapHandler,
+				userToBeDeleted,
+				userList,
+				userToBeRevoked,
+				tokenToBeRevoked,
+				userToBeRotated).BootstrapHandler,
 		},
 	)
 }
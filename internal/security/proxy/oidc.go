@@ -0,0 +1,300 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package proxy
+
+import (
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+)
+
+const (
+	// OIDCConsumer is the consumer holding the signing keys of the identity provider, the tokens it issued are
+	// authenticated as
+	OIDCConsumer = "edgex-oidc"
+	// OIDCDiscoveryPath is appended to the issuer to discover the configuration of the identity provider
+	OIDCDiscoveryPath = "/.well-known/openid-configuration"
+)
+
+// oidcClaimsCheck is run by the pre-function plugin ahead of the jwt plugin, which verifies the signature and the
+// expiration of the tokens but neither their issuer nor their audience. The tokens are only accepted as bearer tokens
+// so that none skips the check.
+const oidcClaimsCheck = `
+local cjson = require "cjson.safe"
+local issuer, audience = "%s", "%s"
+local function reject()
+  return kong.response.exit(401, { message = "Unauthorized" })
+end
+local token = (kong.request.get_header("authorization") or ""):match("^[Bb]earer%%s+([^%%s]+)$")
+local payload = token and token:match("^[^.]+%%.([^.]+)%%.[^.]*$")
+if not payload then
+  return reject()
+end
+payload = payload:gsub("%%-", "+"):gsub("_", "/")
+payload = payload .. string.rep("=", (4 - #payload %% 4) %% 4)
+local claims = cjson.decode(ngx.decode_base64(payload) or "")
+if type(claims) ~= "table" or claims.iss ~= issuer then
+  return reject()
+end
+local aud = claims.aud
+if type(aud) == "table" then
+  for _, a in ipairs(aud) do
+    if a == audience then
+      return
+    end
+  end
+  return reject()
+end
+if aud ~= audience then
+  return reject()
+end
+`
+
+// jsonWebKey is a key of a JWK set, of which only the RSA signing keys are used.
+type jsonWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// signingKey is a public key of the identity provider, PEM encoded as Kong expects it.
+type signingKey struct {
+	Kid string
+	PEM string
+}
+
+// initOIDC provisions the gateway to accept the tokens issued by the identity provider: the jwt plugin verifies their
+// signature with the keys of the provider, held by the OIDCConsumer, and the pre-function plugin their issuer and
+// audience.
+func (s *Service) initOIDC() error {
+	info := s.configuration.KongAuth.OIDC
+	if info.Issuer == "" || info.Audience == "" {
+		return errors.New("the Issuer and the Audience of the OIDC identity provider are required")
+	}
+	if !isLuaSafe(info.Issuer) || !isLuaSafe(info.Audience) {
+		return errors.New("the Issuer and the Audience of the OIDC identity provider can't contain quotes, backslashes or control characters")
+	}
+	group := info.Group
+	if group == "" {
+		group = strings.TrimSpace(strings.Split(s.configuration.KongACL.WhiteList, ",")[0])
+	}
+
+	idp, err := newIdPClient(info.CACertPath, s.configuration.Writable.RequestTimeout)
+	if err != nil {
+		return err
+	}
+	jwksURL := info.JWKSURL
+	if jwksURL == "" {
+		if jwksURL, err = discoverJWKSURL(idp, info.Issuer); err != nil {
+			return err
+		}
+	}
+	keys, err := fetchSigningKeys(idp, jwksURL)
+	if err != nil {
+		return err
+	}
+
+	err = s.postForm(PluginsPath, url.Values{
+		"name":                    {"jwt"},
+		"config.key_claim_name":   {"kid"},
+		"config.claims_to_verify": {"exp"},
+	}, "oidc token validation")
+	if err != nil {
+		return err
+	}
+	err = s.postForm(PluginsPath, url.Values{
+		"name":             {"pre-function"},
+		"config.functions": {fmt.Sprintf(oidcClaimsCheck, info.Issuer, info.Audience)},
+	}, "oidc claims check")
+	if err != nil {
+		return err
+	}
+
+	c := NewConsumer(OIDCConsumer, s.client, s.loggingClient, s.configuration)
+	if err = c.Create(EdgeXKong); err != nil {
+		return err
+	}
+	if err = c.AssociateWithGroup(group); err != nil {
+		return err
+	}
+	for _, key := range keys {
+		err = s.postForm(strings.Join([]string{ConsumersPath, OIDCConsumer, "jwt"}, "/"), url.Values{
+			"key":            {key.Kid},
+			"algorithm":      {"RS256"},
+			"rsa_public_key": {key.PEM},
+		}, fmt.Sprintf("oidc signing key %s", key.Kid))
+		if err != nil {
+			return err
+		}
+	}
+
+	s.loggingClient.Info(fmt.Sprintf("successful to set up oidc authentication with the %d signing keys of %s", len(keys), info.Issuer))
+	return nil
+}
+
+// postForm posts formVals to the admin API of Kong at path, what being set up.
+func (s *Service) postForm(path string, formVals url.Values, what string) error {
+	tokens := []string{s.configuration.KongURL.GetProxyBaseURL(), path}
+	req, err := http.NewRequest(http.MethodPost, strings.Join(tokens, "/"), strings.NewReader(formVals.Encode()))
+	if err != nil {
+		e := fmt.Sprintf("failed to create %s request -- %s", what, err.Error())
+		s.loggingClient.Error(e)
+		return errors.New(e)
+	}
+	req.Header.Add(clients.ContentType, "application/x-www-form-urlencoded")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		e := fmt.Sprintf("failed to set up %s -- %s", what, err.Error())
+		s.loggingClient.Error(e)
+		return errors.New(e)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusCreated, http.StatusConflict:
+		s.loggingClient.Info(fmt.Sprintf("successful to set up %s", what))
+		return nil
+	default:
+		b, _ := ioutil.ReadAll(resp.Body)
+		e := fmt.Sprintf("failed to set up %s with error %s,%s", what, resp.Status, string(b))
+		s.loggingClient.Error(e)
+		return errors.New(e)
+	}
+}
+
+// newIdPClient returns the client of the identity provider, trusting the system's CA certificates and the one at
+// caCertPath.
+func newIdPClient(caCertPath string, timeoutInSecond int) (internal.HttpCaller, error) {
+	transport := &http.Transport{TLSHandshakeTimeout: 10 * time.Second}
+	if caCertPath != "" {
+		caCertPool, err := x509.SystemCertPool()
+		if err != nil || caCertPool == nil {
+			caCertPool = x509.NewCertPool()
+		}
+		caCert, err := ioutil.ReadFile(caCertPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the CA certificate of the identity provider: %s", err.Error())
+		}
+		if !caCertPool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("no CA certificate found in %s", caCertPath)
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: caCertPool}
+	}
+	return &http.Client{Timeout: time.Duration(timeoutInSecond) * time.Second, Transport: transport}, nil
+}
+
+// discoverJWKSURL reads the URL of the signing keys from the configuration published by the issuer.
+func discoverJWKSURL(client internal.HttpCaller, issuer string) (string, error) {
+	var discovery struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}
+	if err := getJSON(client, strings.TrimSuffix(issuer, "/")+OIDCDiscoveryPath, &discovery); err != nil {
+		return "", fmt.Errorf("failed to discover the identity provider %s: %s", issuer, err.Error())
+	}
+	if discovery.Issuer != issuer {
+		return "", fmt.Errorf("the identity provider at %s identifies itself as %s", issuer, discovery.Issuer)
+	}
+	if discovery.JWKSURI == "" {
+		return "", fmt.Errorf("the identity provider %s publishes no jwks_uri", issuer)
+	}
+	return discovery.JWKSURI, nil
+}
+
+// fetchSigningKeys returns the RSA signing keys of the JWK set at jwksURL.
+func fetchSigningKeys(client internal.HttpCaller, jwksURL string) ([]signingKey, error) {
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := getJSON(client, jwksURL, &jwks); err != nil {
+		return nil, fmt.Errorf("failed to fetch the signing keys of the identity provider: %s", err.Error())
+	}
+
+	var keys []signingKey
+	for _, jwk := range jwks.Keys {
+		if jwk.Kty != "RSA" || (jwk.Use != "" && jwk.Use != "sig") || (jwk.Alg != "" && jwk.Alg != "RS256") {
+			continue
+		}
+		if jwk.Kid == "" {
+			return nil, errors.New("a signing key of the identity provider has no kid")
+		}
+		encoded, err := rsaPublicKeyPEM(jwk)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signing key %s of the identity provider: %s", jwk.Kid, err.Error())
+		}
+		keys = append(keys, signingKey{Kid: jwk.Kid, PEM: encoded})
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no RS256 signing key published at %s", jwksURL)
+	}
+	return keys, nil
+}
+
+// rsaPublicKeyPEM encodes the RSA public key of jwk in PEM.
+func rsaPublicKeyPEM(jwk jsonWebKey) (string, error) {
+	n, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(jwk.N, "="))
+	if err != nil {
+		return "", err
+	}
+	e, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(jwk.E, "="))
+	if err != nil {
+		return "", err
+	}
+	exponent := new(big.Int).SetBytes(e)
+	if len(n) == 0 || !exponent.IsInt64() || exponent.Int64() < 3 || exponent.Int64() > 1<<31-1 {
+		return "", errors.New("invalid modulus or exponent")
+	}
+	der, err := x509.MarshalPKIXPublicKey(&rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())})
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})), nil
+}
+
+func getJSON(client internal.HttpCaller, url string, v interface{}) error {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s answered %s", url, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// isLuaSafe tells whether s can be embedded as is in a Lua string literal.
+func isLuaSafe(s string) bool {
+	for _, c := range s {
+		if c < ' ' || c > '~' || c == '"' || c == '\\' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,152 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package proxy
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/security/proxy/config"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newIdP serves the discovery document and the JWK set of an identity provider signing with key.
+func newIdP(t *testing.T, key *rsa.PublicKey) *httptest.Server {
+	var idp *httptest.Server
+	idp = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/realms/edgex" + OIDCDiscoveryPath:
+			_ = json.NewEncoder(w).Encode(map[string]string{
+				"issuer":   idp.URL + "/realms/edgex",
+				"jwks_uri": idp.URL + "/realms/edgex/certs",
+			})
+		case "/realms/edgex/certs":
+			_ = json.NewEncoder(w).Encode(map[string][]jsonWebKey{"keys": {
+				{Kid: "encryption", Kty: "RSA", Use: "enc", N: "AQAB", E: "AQAB"},
+				{Kid: "signing", Kty: "RSA", Use: "sig", Alg: "RS256",
+					N: base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+					E: base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes())},
+			}})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return idp
+}
+
+// adminRecorder records the forms posted to the admin API of Kong.
+type adminRecorder struct {
+	mutex sync.Mutex
+	forms map[string][]map[string]string
+}
+
+func (a *adminRecorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	_ = r.ParseForm()
+	form := make(map[string]string)
+	for k := range r.PostForm {
+		form[k] = r.PostForm.Get(k)
+	}
+	a.forms[r.URL.Path] = append(a.forms[r.URL.Path], form)
+	w.WriteHeader(http.StatusCreated)
+}
+
+func TestInitOIDC(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	idp := newIdP(t, &key.PublicKey)
+	defer idp.Close()
+
+	admin := &adminRecorder{forms: make(map[string][]map[string]string)}
+	ts := httptest.NewServer(admin)
+	defer ts.Close()
+	host, port, err := parseHostAndPort(ts, t)
+	require.NoError(t, err)
+
+	cfg := config.ConfigurationStruct{}
+	cfg.KongURL = config.KongUrlInfo{Server: host, AdminPort: port}
+	cfg.KongACL = config.KongAclInfo{Name: "acl", WhiteList: "admin"}
+	cfg.KongAuth = config.KongAuthInfo{Name: "oidc", OIDC: config.OIDCInfo{
+		Issuer:   idp.URL + "/realms/edgex",
+		Audience: "edgex",
+	}}
+
+	svc := NewService(&http.Client{}, logger.MockLogger{}, &cfg)
+	require.NoError(t, svc.initAuthMethod(cfg.KongAuth.Name, cfg.KongAuth.TokenTTL))
+
+	plugins := admin.forms["/"+PluginsPath]
+	require.Len(t, plugins, 2)
+	assert.Equal(t, "jwt", plugins[0]["name"])
+	assert.Equal(t, "kid", plugins[0]["config.key_claim_name"])
+	assert.Equal(t, "pre-function", plugins[1]["name"])
+	assert.Contains(t, plugins[1]["config.functions"], `"`+idp.URL+`/realms/edgex", "edgex"`)
+
+	assert.Equal(t, "admin", admin.forms["/consumers/"+OIDCConsumer+"/acls"][0]["group"])
+	credentials := admin.forms["/consumers/"+OIDCConsumer+"/jwt"]
+	require.Len(t, credentials, 1, "only the signing keys should be provisioned")
+	assert.Equal(t, "signing", credentials[0]["key"])
+	assert.Equal(t, "RS256", credentials[0]["algorithm"])
+
+	block, _ := pem.Decode([]byte(credentials[0]["rsa_public_key"]))
+	require.NotNil(t, block)
+	parsed, err := x509.ParsePKIXPublicKey(block.Bytes)
+	require.NoError(t, err)
+	assert.Equal(t, &key.PublicKey, parsed)
+}
+
+func TestInitOIDCErrors(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	idp := newIdP(t, &key.PublicKey)
+	defer idp.Close()
+
+	tests := []struct {
+		name string
+		info config.OIDCInfo
+	}{
+		{"No issuer", config.OIDCInfo{Audience: "edgex"}},
+		{"No audience", config.OIDCInfo{Issuer: idp.URL + "/realms/edgex"}},
+		{"Unsafe audience", config.OIDCInfo{Issuer: idp.URL + "/realms/edgex", Audience: `edgex", "other`}},
+		{"Issuer mismatch", config.OIDCInfo{Issuer: idp.URL + "/realms/edgex/", Audience: "edgex"}},
+		{"Unknown issuer", config.OIDCInfo{Issuer: idp.URL + "/realms/other", Audience: "edgex"}},
+		{"No signing key", config.OIDCInfo{Issuer: idp.URL + "/realms/edgex", JWKSURL: idp.URL + "/realms/other/certs", Audience: "edgex"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.ConfigurationStruct{}
+			cfg.KongAuth = config.KongAuthInfo{Name: "oidc", OIDC: tt.info}
+			svc := NewService(&http.Client{}, logger.MockLogger{}, &cfg)
+			err := svc.initOIDC()
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestRSAPublicKeyPEMInvalid(t *testing.T) {
+	for _, jwk := range []jsonWebKey{
+		{N: "", E: "AQAB"},
+		{N: "AQAB", E: "!"},
+		{N: "AQAB", E: "AQ"},
+	} {
+		_, err := rsaPublicKeyPEM(jwk)
+		assert.Error(t, err, jwk)
+	}
+	assert.False(t, isLuaSafe("edgex\n"))
+}
@@ -0,0 +1,240 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package proxy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal/security/proxy/config"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+)
+
+const (
+	// RateLimitPlugin is the plugin of the proxy limiting the rate of the requests
+	RateLimitPlugin = "rate-limiting"
+	// rateLimitPrefix prefixes the names of the services and routes of the proxy dedicated to a rate limited path
+	rateLimitPrefix = "ratelimit-"
+)
+
+// initRateLimits sets up the RateLimits of the configuration, apart from the ones of consumers not added yet, which
+// are set up by InitConsumerRateLimits once the consumers are added.
+func (s *Service) initRateLimits() error {
+	for _, name := range s.rateLimitNames("") {
+		limit := s.configuration.RateLimits[name]
+		var consumerID string
+		if limit.Consumer != "" {
+			id, found, err := s.getConsumerID(limit.Consumer)
+			if err != nil {
+				return err
+			}
+			if !found {
+				s.loggingClient.Info(fmt.Sprintf(
+					"consumer %s of rate limit %s doesn't exist yet, the limit is set up when it's added",
+					limit.Consumer, name))
+				continue
+			}
+			consumerID = id
+		}
+
+		if err := s.initRateLimit(name, limit, consumerID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// InitConsumerRateLimits sets up the RateLimits of the configuration applying to the consumer, once it's added.
+func (s *Service) InitConsumerRateLimits(consumer string) error {
+	names := s.rateLimitNames(consumer)
+	if len(names) == 0 {
+		return nil
+	}
+
+	id, found, err := s.getConsumerID(consumer)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("failed to set up the rate limits of consumer %s, which doesn't exist", consumer)
+	}
+	for _, name := range names {
+		if err := s.initRateLimit(name, s.configuration.RateLimits[name], id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rateLimitNames returns the names of the RateLimits in order, either of all of them when consumer is empty or of
+// the ones applying to the consumer.
+func (s *Service) rateLimitNames(consumer string) []string {
+	var names []string
+	for name, limit := range s.configuration.RateLimits {
+		if consumer == "" || limit.Consumer == consumer {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// initRateLimit sets up the rate limiting plugin of the limit, for the consumer of consumerID or any consumer when
+// empty, on the route dedicated to its path, on the routes of its service, or globally.
+func (s *Service) initRateLimit(name string, limit config.RateLimitInfo, consumerID string) error {
+	if limit.Second <= 0 && limit.Minute <= 0 && limit.Hour <= 0 && limit.Day <= 0 {
+		return fmt.Errorf("rate limit %s allows no number of requests in any period", name)
+	}
+
+	tokens := []string{s.configuration.KongURL.GetProxyBaseURL(), PluginsPath}
+	service := strings.ToLower(limit.Service)
+	switch {
+	case limit.Path != "":
+		if service == "" {
+			return fmt.Errorf("rate limit %s has a path but no service", name)
+		}
+		route, err := s.initRateLimitRoute(strings.ToLower(name), service, limit.Path)
+		if err != nil {
+			return err
+		}
+		tokens = []string{s.configuration.KongURL.GetProxyBaseURL(), RoutesPath, route, PluginsPath}
+	case service != "":
+		tokens = []string{s.configuration.KongURL.GetProxyBaseURL(), ServicesPath, service, PluginsPath}
+	}
+
+	formVals := url.Values{
+		"name":          {RateLimitPlugin},
+		"config.policy": {"local"},
+	}
+	for field, value := range map[string]int{
+		"config.second": limit.Second,
+		"config.minute": limit.Minute,
+		"config.hour":   limit.Hour,
+		"config.day":    limit.Day,
+	} {
+		if value > 0 {
+			formVals.Set(field, strconv.Itoa(value))
+		}
+	}
+	if limit.LimitBy != "" {
+		formVals.Set("config.limit_by", limit.LimitBy)
+	}
+	if consumerID != "" {
+		formVals.Set("consumer.id", consumerID)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, strings.Join(tokens, "/"), strings.NewReader(formVals.Encode()))
+	if err != nil {
+		return fmt.Errorf("failed to create rate limit %s request -- %s", name, err.Error())
+	}
+	req.Header.Add(clients.ContentType, "application/x-www-form-urlencoded")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		e := fmt.Sprintf("failed to set up rate limit %s -- %s", name, err.Error())
+		s.loggingClient.Error(e)
+		return errors.New(e)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusCreated, http.StatusConflict:
+		s.loggingClient.Info(fmt.Sprintf("successful to set up rate limit %s", name))
+	default:
+		e := fmt.Sprintf("failed to set up rate limit %s with errorcode %d", name, resp.StatusCode)
+		s.loggingClient.Error(e)
+		return errors.New(e)
+	}
+	return nil
+}
+
+// initRateLimitRoute sets up the service and the route of the proxy dedicated to the path of a proxied service, so
+// that the requests to the path are limited apart from the other requests to the service, and returns the name of the
+// route. The dedicated service forwards the requests to the same upstream as the proxied service.
+func (s *Service) initRateLimitRoute(name string, service string, limitPath string) (string, error) {
+	proxied, err := s.getKongService(service)
+	if err != nil {
+		return "", err
+	}
+
+	limitPath = path.Clean("/" + limitPath)
+	dedicated := rateLimitPrefix + name
+	err = s.initKongService(&KongService{
+		Name:     dedicated,
+		Host:     proxied.Host,
+		Port:     int(proxied.Port),
+		Protocol: proxied.Protocol,
+		Path:     path.Join("/", proxied.Path, limitPath),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// the route being more specific than the one of the proxied service, it takes precedence for the path
+	err = s.initKongRoutes(&KongRoute{Paths: []string{"/" + service + limitPath}, Name: dedicated}, dedicated)
+	if err != nil {
+		return "", err
+	}
+	return dedicated, nil
+}
+
+// getKongService returns the service of the proxy of the given name.
+func (s *Service) getKongService(name string) (KongServiceResponse, error) {
+	var service KongServiceResponse
+	found, err := s.getKongEntity(ServicesPath, name, &service)
+	if err != nil {
+		return service, err
+	}
+	if !found {
+		return service, fmt.Errorf("proxy service %s isn't set up", name)
+	}
+	return service, nil
+}
+
+// getConsumerID returns the id of the consumer of the given username, and whether it exists.
+func (s *Service) getConsumerID(username string) (string, bool, error) {
+	var consumer Item
+	found, err := s.getKongEntity(ConsumersPath, username, &consumer)
+	return consumer.ID, found, err
+}
+
+// getKongEntity reads the entity of the proxy at entityPath and name into entity, and reports whether it exists.
+func (s *Service) getKongEntity(entityPath string, name string, entity interface{}) (bool, error) {
+	tokens := []string{s.configuration.KongURL.GetProxyBaseURL(), entityPath, url.PathEscape(name)}
+	req, err := http.NewRequest(http.MethodGet, strings.Join(tokens, "/"), nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to create %s request for %s -- %s", entityPath, name, err.Error())
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		e := fmt.Sprintf("failed to get %s %s with error %s", entityPath, name, err.Error())
+		s.loggingClient.Error(e)
+		return false, errors.New(e)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		if err = json.NewDecoder(resp.Body).Decode(entity); err != nil {
+			return false, err
+		}
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		e := fmt.Sprintf("failed to get %s %s with HTTP error code %d", entityPath, name, resp.StatusCode)
+		s.loggingClient.Error(e)
+		return false, errors.New(e)
+	}
+}
@@ -0,0 +1,123 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package proxy
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/security/proxy/config"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRateLimitKong answers the requests of the rate limits, recording the ones setting something up.
+type fakeRateLimitKong struct {
+	mutex     sync.Mutex
+	consumers map[string]string
+	posted    map[string][]string
+}
+
+func (k *fakeRateLimitKong) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	k.mutex.Lock()
+	defer k.mutex.Unlock()
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/services/coredata":
+		w.Write([]byte(`{"name":"coredata","protocol":"http","host":"edgex-core-data","port":48080}`))
+	case r.Method == http.MethodGet && len(r.URL.Path) > len("/consumers/"):
+		id, ok := k.consumers[r.URL.Path[len("/consumers/"):]]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"id":"` + id + `"}`))
+	case r.Method == http.MethodPost:
+		body, _ := ioutil.ReadAll(r.Body)
+		k.posted[r.URL.Path] = append(k.posted[r.URL.Path], string(body))
+		w.WriteHeader(http.StatusCreated)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (k *fakeRateLimitKong) postedTo(path string) []string {
+	k.mutex.Lock()
+	defer k.mutex.Unlock()
+	return k.posted[path]
+}
+
+func newRateLimitTestService(t *testing.T, kong *fakeRateLimitKong, limits map[string]config.RateLimitInfo) (Service, func()) {
+	ts := httptest.NewServer(kong)
+	host, port, err := parseHostAndPort(ts, t)
+	require.NoError(t, err)
+
+	cfg := config.ConfigurationStruct{
+		KongURL:    config.KongUrlInfo{Server: host, AdminPort: port},
+		RateLimits: limits,
+	}
+	return NewService(&http.Client{}, logger.MockLogger{}, &cfg), ts.Close
+}
+
+func TestInitRateLimits(t *testing.T) {
+	kong := &fakeRateLimitKong{consumers: map[string]string{"partner": "partner-id"}, posted: map[string][]string{}}
+	svc, closeKong := newRateLimitTestService(t, kong, map[string]config.RateLimitInfo{
+		"all":      {Second: 50},
+		"metadata": {Service: "Metadata", Hour: 1000, LimitBy: "ip"},
+		"events":   {Service: "CoreData", Path: "api/v1/event/", Consumer: "partner", Minute: 100},
+		"external": {Consumer: "external", Day: 10000},
+	})
+	defer closeKong()
+
+	require.NoError(t, svc.initRateLimits())
+
+	assert.Equal(t, []string{"config.policy=local&config.second=50&name=rate-limiting"}, kong.postedTo("/plugins"))
+	assert.Equal(t, []string{"config.hour=1000&config.limit_by=ip&config.policy=local&name=rate-limiting"},
+		kong.postedTo("/services/metadata/plugins"))
+	assert.Equal(t, []string{"host=edgex-core-data&name=ratelimit-events&path=%2Fapi%2Fv1%2Fevent&port=48080&protocol=http"},
+		kong.postedTo("/services"), "a service should be dedicated to the limited path")
+	assert.Equal(t, []string{`{"paths":["/coredata/api/v1/event"],"name":"ratelimit-events"}`},
+		kong.postedTo("/services/ratelimit-events/routes"))
+	assert.Equal(t, []string{"config.minute=100&config.policy=local&consumer.id=partner-id&name=rate-limiting"},
+		kong.postedTo("/routes/ratelimit-events/plugins"))
+}
+
+func TestInitConsumerRateLimits(t *testing.T) {
+	kong := &fakeRateLimitKong{consumers: map[string]string{}, posted: map[string][]string{}}
+	svc, closeKong := newRateLimitTestService(t, kong, map[string]config.RateLimitInfo{
+		"external": {Consumer: "external", Day: 10000},
+	})
+	defer closeKong()
+
+	require.NoError(t, svc.initRateLimits())
+	assert.Empty(t, kong.postedTo("/plugins"), "the limit of a consumer not added yet should be left for later")
+
+	require.NoError(t, svc.InitConsumerRateLimits("other"))
+	assert.Error(t, svc.InitConsumerRateLimits("external"))
+
+	kong.mutex.Lock()
+	kong.consumers["external"] = "external-id"
+	kong.mutex.Unlock()
+	require.NoError(t, svc.InitConsumerRateLimits("external"))
+	assert.Equal(t, []string{"config.day=10000&config.policy=local&consumer.id=external-id&name=rate-limiting"},
+		kong.postedTo("/plugins"))
+}
+
+func TestInitRateLimitValidation(t *testing.T) {
+	kong := &fakeRateLimitKong{consumers: map[string]string{}, posted: map[string][]string{}}
+	svc, closeKong := newRateLimitTestService(t, kong, nil)
+	defer closeKong()
+
+	assert.Error(t, svc.initRateLimit("none", config.RateLimitInfo{Service: "coredata"}, ""))
+	assert.Error(t, svc.initRateLimit("no service", config.RateLimitInfo{Path: "/api/v1/event", Minute: 1}, ""))
+	assert.Error(t, svc.initRateLimit("unknown service", config.RateLimitInfo{Service: "rules", Path: "/rules", Minute: 1}, ""))
+}
@@ -0,0 +1,106 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package proxy
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// RevokedCredential is a JWT credential of a gateway user deleted from the proxy, along with the validity of every
+// token signed with it.
+type RevokedCredential struct {
+	User string
+	Key  string
+	// Revoked is when the credential was revoked, in milliseconds since the epoch
+	Revoked int64
+}
+
+// RevocationList records the revoked credentials in a file, so that they are deleted again from the proxy when they
+// are restored, e.g. along with a backup of its database, on every run of the setup.
+type RevocationList struct {
+	path        string
+	Credentials []RevokedCredential
+}
+
+// LoadRevocationList reads the revocation list stored at path, empty when the file doesn't exist yet.
+func LoadRevocationList(path string) (*RevocationList, error) {
+	l := &RevocationList{path: path}
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return l, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the revocation list %s: %s", path, err.Error())
+	}
+	if err := json.Unmarshal(data, &l.Credentials); err != nil {
+		return nil, fmt.Errorf("failed to parse the revocation list %s: %s", path, err.Error())
+	}
+	return l, nil
+}
+
+// Add records the revocation of the credential of user with key.
+func (l *RevocationList) Add(user string, key string) {
+	if l.Contains(key) {
+		return
+	}
+	l.Credentials = append(l.Credentials, RevokedCredential{
+		User:    user,
+		Key:     key,
+		Revoked: time.Now().UnixNano() / int64(time.Millisecond),
+	})
+}
+
+// Contains tells whether the credential with key has been revoked.
+func (l *RevocationList) Contains(key string) bool {
+	for _, c := range l.Credentials {
+		if c.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// Users returns the users who had credentials revoked.
+func (l *RevocationList) Users() []string {
+	var users []string
+	seen := make(map[string]bool)
+	for _, c := range l.Credentials {
+		if !seen[c.User] {
+			seen[c.User] = true
+			users = append(users, c.User)
+		}
+	}
+	return users
+}
+
+// Save writes the revocation list to its file, replacing the previous one at once so that it's never left truncated.
+func (l *RevocationList) Save() error {
+	data, err := json.MarshalIndent(l.Credentials, "", " ")
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(l.path), filepath.Base(l.path)+".*")
+	if err != nil {
+		return fmt.Errorf("failed to save the revocation list %s: %s", l.path, err.Error())
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to save the revocation list %s: %s", l.path, err.Error())
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to save the revocation list %s: %s", l.path, err.Error())
+	}
+	if err := os.Rename(tmp.Name(), l.path); err != nil {
+		return fmt.Errorf("failed to save the revocation list %s: %s", l.path, err.Error())
+	}
+	return nil
+}
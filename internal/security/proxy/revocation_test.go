@@ -0,0 +1,44 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package proxy
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevocationListPersistence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "revokedTokens.json")
+
+	revoked, err := LoadRevocationList(path)
+	require.NoError(t, err, "a missing revocation list should be empty")
+	assert.Empty(t, revoked.Credentials)
+
+	revoked.Add("alice", "key-1")
+	revoked.Add("bob", "key-2")
+	revoked.Add("alice", "key-3")
+	revoked.Add("alice", "key-1")
+	require.NoError(t, revoked.Save())
+
+	loaded, err := LoadRevocationList(path)
+	require.NoError(t, err)
+	assert.Len(t, loaded.Credentials, 3)
+	assert.True(t, loaded.Contains("key-2"))
+	assert.False(t, loaded.Contains("key-4"))
+	assert.Equal(t, []string{"alice", "bob"}, loaded.Users())
+}
+
+func TestLoadInvalidRevocationList(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "revokedTokens.json")
+	require.NoError(t, ioutil.WriteFile(path, []byte("not json"), 0600))
+
+	_, err := LoadRevocationList(path)
+	assert.Error(t, err)
+}
@@ -28,6 +28,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/edgexfoundry/edgex-go/internal/pkg/gateway"
 	"github.com/edgexfoundry/edgex-go/internal/security/proxy/config"
 
 	"github.com/edgexfoundry/edgex-go/internal"
@@ -166,6 +167,14 @@ func (s *Service) Init() error {
 		}
 	}
 
+	if err := s.initManifestRoutes(mergedClients); err != nil {
+		return err
+	}
+
+	if err := s.initRateLimits(); err != nil {
+		return err
+	}
+
 	err := s.initAuthMethod(s.configuration.KongAuth.Name, s.configuration.KongAuth.TokenTTL)
 	if err != nil {
 		return err
@@ -278,6 +287,52 @@ func (s *Service) mergeRoutesWith(additional map[string]bootstrapConfig.ClientIn
 	return merged
 }
 
+// initManifestRoutes sets up the routes described by the manifests the services published to the RouteManifests
+// Directory, ignoring the services whose routes are already set up from the clients.
+func (s *Service) initManifestRoutes(clients map[string]bootstrapConfig.ClientInfo) error {
+	dir := s.configuration.RouteManifests.Directory
+	if dir == "" {
+		return nil
+	}
+
+	manifests, err := gateway.ReadManifests(dir)
+	if err != nil {
+		s.loggingClient.Error(fmt.Sprintf("failed to read the route manifests from %s: %s", dir, err.Error()))
+		return nil
+	}
+
+	existing := make(map[string]bool, len(clients))
+	for clientName := range clients {
+		existing[strings.ToLower(clientName)] = true
+	}
+	for _, manifest := range manifests {
+		name := strings.ToLower(manifest.Name)
+		if existing[name] {
+			s.loggingClient.Warn(fmt.Sprintf(
+				"route manifest of %s names service %s that already exists in the config. Ignoring manifest",
+				manifest.Service, name))
+			continue
+		}
+
+		err = s.initKongService(&KongService{
+			Name:     name,
+			Host:     manifest.Host,
+			Port:     manifest.Port,
+			Protocol: manifest.Protocol,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = s.initKongRoutes(&KongRoute{Paths: manifest.Paths, Name: name}, name)
+		if err != nil {
+			return err
+		}
+		existing[name] = true
+	}
+	return nil
+}
+
 func (s *Service) postCert(cp bootstrapConfig.CertKeyPair) *CertError {
 	body := &CertInfo{
 		Cert: cp.Cert,
@@ -333,6 +388,9 @@ func (s *Service) initKongService(service *KongService) error {
 		"port":     {strconv.Itoa(service.Port)},
 		"protocol": {service.Protocol},
 	}
+	if service.Path != "" {
+		formVals.Set("path", service.Path)
+	}
 	tokens := []string{s.configuration.KongURL.GetProxyBaseURL(), ServicesPath}
 
 	req, err := http.NewRequest(http.MethodPost, strings.Join(tokens, "/"), strings.NewReader(formVals.Encode()))
@@ -448,6 +506,8 @@ func (s *Service) initAuthMethod(name string, ttl int) error {
 		return s.initJWTAuth()
 	case "oauth2":
 		return s.initOAuth2(ttl)
+	case "oidc":
+		return s.initOIDC()
 	default:
 		return fmt.Errorf("unsupported authetication method: %s", name)
 	}
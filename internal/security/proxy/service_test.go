@@ -23,8 +23,10 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 
+	"github.com/edgexfoundry/edgex-go/internal/pkg/gateway"
 	"github.com/edgexfoundry/edgex-go/internal/security/proxy/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -325,7 +327,7 @@ func TestInitKongService(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			tk := &KongService{tt.serviceId, "test", 80, "http"}
+			tk := &KongService{Name: tt.serviceId, Host: "test", Port: 80, Protocol: "http"}
 			svc := NewService(&http.Client{}, logger.MockLogger{}, &tt.config)
 			err = svc.initKongService(tk)
 
@@ -396,6 +398,45 @@ func TestInitKongRoutes(t *testing.T) {
 	}
 }
 
+func TestInitManifestRoutes(t *testing.T) {
+	var mutex sync.Mutex
+	var posted []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		posted = append(posted, r.URL.EscapedPath())
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer ts.Close()
+
+	host, port, err := parseHostAndPort(ts, t)
+	require.NoError(t, err)
+
+	dir, err := ioutil.TempDir("", "routes")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	require.NoError(t, gateway.WriteManifest(dir, gateway.Manifest{Service: "edgex-core-data", Name: "CoreData",
+		Protocol: "http", Host: "edgex-core-data", Port: 48080, Paths: []string{"/coredata"}}))
+	require.NoError(t, gateway.WriteManifest(dir, gateway.Manifest{Service: "edgex-rules-engine", Name: "rules",
+		Protocol: "http", Host: "edgex-rules-engine", Port: 48075, Paths: []string{"/rules", "/rules-engine"}}))
+
+	cfg := config.ConfigurationStruct{
+		KongURL:        config.KongUrlInfo{Server: host, AdminPort: port},
+		RouteManifests: config.RouteManifestsInfo{Directory: dir},
+	}
+	clients := map[string]bootstrapConfig.ClientInfo{"CoreData": {Protocol: "http", Host: "localhost", Port: 48080}}
+	svc := NewService(&http.Client{}, logger.MockLogger{}, &cfg)
+
+	require.NoError(t, svc.initManifestRoutes(clients))
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	assert.Equal(t, []string{"/" + ServicesPath, "/" + ServicesPath + "/rules/routes"}, posted,
+		"only the routes of the services missing from the clients should be set up")
+	require.Contains(t, svc.routes, "rules")
+	assert.Equal(t, []string{"/rules", "/rules-engine"}, svc.routes["rules"].Paths)
+}
+
 func TestInitACL(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPost {
@@ -25,6 +25,8 @@ type ConfigurationStruct struct {
 	Writable      WritableInfo
 	SecretService secretstoreclient.SecretServiceInfo
 	Databases     map[string]Database
+	Rotation      RotationInfo
+	Secrets       map[string]SecretInfo
 }
 
 type WritableInfo struct {
@@ -37,6 +39,73 @@ type Database struct {
 	Service  string
 }
 
+// RotationInfo configures the periodic rotation of the credentials issued to the services. When enabled, the service
+// keeps running once the secret store is set up, with the master key shares in memory to regenerate a transient root
+// token on every rotation.
+type RotationInfo struct {
+	Enabled bool
+	// Interval is how often the credentials are rotated, e.g. '720h'
+	Interval string
+	// AgentUrl is the base URL of the system management agent restarting the services to load their new credentials
+	AgentUrl         string
+	RedisPassword    RedisRotationInfo
+	ServiceTokens    TokenRotationInfo
+	ProxyCertificate CertificateRotationInfo
+}
+
+// RedisRotationInfo configures the rotation of the shared Redis password, which is changed in Redis before being
+// written to the secret store.
+type RedisRotationInfo struct {
+	Enabled bool
+	Host    string
+	Port    int
+	// Restart lists the services restarted once the password is rotated
+	Restart []string
+}
+
+// TokenRotationInfo configures the rotation of the secret store tokens of the services, which are revoked and issued
+// again by the token provider.
+type TokenRotationInfo struct {
+	Enabled bool
+	// Restart lists the services restarted once their tokens are issued again
+	Restart []string
+}
+
+// CertificateRotationInfo configures the rotation of the TLS certificate of the API gateway, issued by the PKI secrets
+// engine of the secret store and written to the CertPath of the SecretService.
+type CertificateRotationInfo struct {
+	Enabled bool
+	// PKIPath is the mount path of the PKI secrets engine issuing the certificate, e.g. 'pki'
+	PKIPath string
+	// Role is the role of the PKI the certificate is issued with
+	Role       string
+	CommonName string
+	AltNames   []string
+	// TTL is the validity of the issued certificate, e.g. '2160h'; the one of the role when empty
+	TTL string
+	// Restart lists the services restarted once the certificate is rotated
+	Restart []string
+}
+
+// SecretInfo declares a secret of a service provisioned into the secret store, such as the credentials of an external
+// broker, stored as a username and password under the path of the service. A secret without any password is left to
+// be provisioned by hand.
+type SecretInfo struct {
+	// Service is the service reading the secret, e.g. 'notifications'
+	Service string
+	// Path is the path of the secret under the one of the service, e.g. 'smtp' or 'slack/alerts'
+	Path     string
+	Username string
+	// Password is the password, or the token, of the secret
+	Password string
+	// PasswordFile is the file the password is read from instead, e.g. a mounted secret
+	PasswordFile string
+	// Generate indicates whether a random password is generated when none is given
+	Generate bool
+	// Overwrite indicates whether the secret replaces the one already in the secret store, which is kept otherwise
+	Overwrite bool
+}
+
 // UpdateFromRaw converts configuration received from the registry to a service-specific configuration struct which is
 // then used to overwrite the service's existing configuration struct.
 func (c *ConfigurationStruct) UpdateFromRaw(rawConfig interface{}) bool {
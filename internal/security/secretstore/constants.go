@@ -20,6 +20,8 @@ package secretstore
 const (
 	VaultToken             = "X-Vault-Token"
 	TokenCreatorPolicyName = "privileged-token-creator"
+	// ServicePolicyPrefix prefixes the name of the policy of a service, held by the tokens the token provider issues
+	ServicePolicyPrefix = "edgex-service-"
 
 	// This is an admin token policy that allow for creation of
 	// per-service tokens and policies
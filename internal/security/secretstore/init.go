@@ -56,7 +56,7 @@ func NewBootstrap(insecureSkipVerify bool, vaultInterval int) *Bootstrap {
 }
 
 // BootstrapHandler fulfills the BootstrapHandler contract and performs initialization needed by the data service.
-func (b *Bootstrap) BootstrapHandler(ctx context.Context, _ *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
+func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
 	configuration := container.ConfigurationFrom(dic.Get)
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 
@@ -340,6 +340,12 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, _ *sync.WaitGroup, _ s
 		os.Exit(1)
 	}
 
+	// Provision the secrets the services read from the secret store, such as the credentials of external brokers
+	if err = seedSecrets(ctx, lc, cred, configuration.Secrets); err != nil {
+		lc.Error(err.Error())
+		os.Exit(1)
+	}
+
 	cert := NewCerts(req, configuration.SecretService.CertPath, rootToken, configuration.SecretService.GetSecretSvcBaseURL(), lc)
 	existing, err := cert.AlreadyinStore()
 	if err != nil {
@@ -349,27 +355,52 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, _ *sync.WaitGroup, _ s
 
 	if existing == true {
 		lc.Info("proxy certificate pair are in the secret store already, skip uploading")
-		return false
-	}
+	} else {
+		lc.Info("proxy certificate pair are not in the secret store yet, uploading them")
+		cp, err := cert.ReadFrom(configuration.SecretService.CertFilePath, configuration.SecretService.KeyFilePath)
+		if err != nil {
+			lc.Error("failed to get certificate pair from volume")
+			os.Exit(1)
+		}
 
-	lc.Info("proxy certificate pair are not in the secret store yet, uploading them")
-	cp, err := cert.ReadFrom(configuration.SecretService.CertFilePath, configuration.SecretService.KeyFilePath)
-	if err != nil {
-		lc.Error("failed to get certificate pair from volume")
-		os.Exit(1)
+		lc.Info("proxy certificate pair are loaded from volume successfully, will upload to secret store")
+
+		err = cert.UploadToStore(cp)
+		if err != nil {
+			lc.Error("failed to upload the proxy cert pair into the secret store")
+			lc.Error(err.Error())
+			os.Exit(1)
+		}
+
+		lc.Info("proxy certificate pair are uploaded to secret store successfully, Vault init done successfully")
 	}
 
-	lc.Info("proxy certificate pair are loaded from volume successfully, will upload to secret store")
+	if !configuration.Rotation.Enabled {
+		return false
+	}
 
-	err = cert.UploadToStore(cp)
+	// Keep running to rotate the credentials, from the key shares loaded again for each rotation
+	loadKeyShares := func(initResponse *secretstoreclient.InitResponse) error {
+		if err := loadInitResponse(lc, fileOpener, configuration.SecretService, initResponse); err != nil {
+			return err
+		}
+		if len(hook) == 0 {
+			return nil
+		}
+		if err := vmkEncryption.LoadIKM(hook); err != nil {
+			return err
+		}
+		defer vmkEncryption.WipeIKM()
+		return vmkEncryption.DecryptInitResponse(initResponse)
+	}
+	rotator, err := NewRotator(configuration, req, vc, loadKeyShares, tokenMaintenance, tokenProvider, fileOpener, lc)
 	if err != nil {
-		lc.Error("failed to upload the proxy cert pair into the secret store")
-		lc.Error(err.Error())
+		lc.Error(fmt.Sprintf("failed to configure credential rotation: %s", err.Error()))
 		os.Exit(1)
 	}
-
-	lc.Info("proxy certificate pair are uploaded to secret store successfully, Vault init done successfully")
-	return false
+	rotator.Start(ctx, wg)
+	lc.Info(fmt.Sprintf("credentials will be rotated every %s", configuration.Rotation.Interval))
+	return true
 }
 
 // XXX Collapse addServiceCredential and addDBCredential together by passing in the path or using
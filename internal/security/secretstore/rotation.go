@@ -0,0 +1,430 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package secretstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal"
+	"github.com/edgexfoundry/edgex-go/internal/security/secretstore/config"
+	"github.com/edgexfoundry/edgex-go/internal/security/secretstoreclient"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/edgexfoundry/go-mod-secrets/pkg/token/fileioperformer"
+
+	redigo "github.com/gomodule/redigo/redis"
+)
+
+const (
+	// bootstrapRedisPath is the path security-bootstrap-redis reads the Redis password from
+	bootstrapRedisPath = "/v1/secret/edgex/bootstrap-redis/redisdb"
+	// serviceRedisPath is the path a service reads the Redis password from
+	serviceRedisPath = "/v1/secret/edgex/%s/redisdb"
+
+	restartAction  = "restart"
+	restartTimeout = 5 * time.Minute
+)
+
+// certificateRequest is the request of a certificate to the PKI secrets engine of the secret store.
+type certificateRequest struct {
+	CommonName string `json:"common_name"`
+	AltNames   string `json:"alt_names,omitempty"`
+	IPSans     string `json:"ip_sans,omitempty"`
+	TTL        string `json:"ttl,omitempty"`
+}
+
+// certificateResponse is the certificate issued by the PKI secrets engine of the secret store.
+type certificateResponse struct {
+	Data struct {
+		Certificate string `json:"certificate"`
+		PrivateKey  string `json:"private_key"`
+		IssuingCA   string `json:"issuing_ca"`
+	} `json:"data"`
+}
+
+// KeySharesLoader loads the key shares of the secret store, decrypted, into initResponse.
+type KeySharesLoader func(initResponse *secretstoreclient.InitResponse) error
+
+// Rotator periodically issues the credentials of the services again, writes them to the secret store, and has the
+// system management agent restart the services for them to load their new credentials.
+type Rotator struct {
+	configuration    *config.ConfigurationStruct
+	interval         time.Duration
+	caller           internal.HttpCaller
+	agent            internal.HttpCaller
+	vc               secretstoreclient.SecretStoreClient
+	loadKeyShares    KeySharesLoader
+	generator        CredentialGenerator
+	tokenMaintenance *TokenMaintenance
+	tokenProvider    *TokenProvider
+	fileOpener       fileioperformer.FileIoPerformer
+	setRedisPassword func(current string, password string) error
+	lc               logger.LoggingClient
+}
+
+// NewRotator validates the Rotation configuration and returns a Rotator regenerating its transient root tokens from
+// the key shares loaded by loadKeyShares, which are only kept in memory for the time of the regeneration.
+func NewRotator(
+	configuration *config.ConfigurationStruct,
+	caller internal.HttpCaller,
+	vc secretstoreclient.SecretStoreClient,
+	loadKeyShares KeySharesLoader,
+	tokenMaintenance *TokenMaintenance,
+	tokenProvider *TokenProvider,
+	fileOpener fileioperformer.FileIoPerformer,
+	lc logger.LoggingClient) (*Rotator, error) {
+
+	info := configuration.Rotation
+	interval, err := time.ParseDuration(info.Interval)
+	if err != nil || interval <= 0 {
+		return nil, fmt.Errorf("invalid Rotation Interval '%s'", info.Interval)
+	}
+	if info.RedisPassword.Enabled && info.RedisPassword.Host == "" {
+		return nil, errors.New("no Rotation RedisPassword Host configured")
+	}
+	if info.ServiceTokens.Enabled &&
+		(configuration.SecretService.TokenProvider == "" || configuration.SecretService.TokenProviderAdminTokenPath == "") {
+		return nil, errors.New("the ServiceTokens rotation requires the TokenProvider and its TokenProviderAdminTokenPath")
+	}
+	if info.ProxyCertificate.Enabled &&
+		(info.ProxyCertificate.PKIPath == "" || info.ProxyCertificate.Role == "" || info.ProxyCertificate.CommonName == "") {
+		return nil, errors.New("no Rotation ProxyCertificate PKIPath, Role and CommonName configured")
+	}
+
+	address := net.JoinHostPort(info.RedisPassword.Host, strconv.Itoa(info.RedisPassword.Port))
+	return &Rotator{
+		configuration:    configuration,
+		interval:         interval,
+		caller:           caller,
+		agent:            &http.Client{Timeout: restartTimeout},
+		vc:               vc,
+		loadKeyShares:    loadKeyShares,
+		generator:        NewPasswordGenerator(lc, configuration.SecretService.PasswordProvider, configuration.SecretService.PasswordProviderArgs),
+		tokenMaintenance: tokenMaintenance,
+		tokenProvider:    tokenProvider,
+		fileOpener:       fileOpener,
+		setRedisPassword: func(current string, password string) error {
+			return changeRedisPassword(address, current, password)
+		},
+		lc: lc,
+	}, nil
+}
+
+// Start rotates the credentials every interval until ctx is done. A failed rotation is logged and attempted again on
+// the next interval.
+func (r *Rotator) Start(ctx context.Context, wg *sync.WaitGroup) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := r.Rotate(ctx); err != nil {
+					r.lc.Error(fmt.Sprintf("failed to rotate the credentials: %s", err.Error()))
+					continue
+				}
+				r.lc.Info(fmt.Sprintf("rotated the credentials, next rotation in %s", r.interval))
+			}
+		}
+	}()
+}
+
+// Rotate issues the enabled credentials again with a transient root token, then restarts the services of the ones
+// rotated successfully. The credentials failing to rotate are left unchanged.
+func (r *Rotator) Rotate(ctx context.Context) error {
+	var initResponse secretstoreclient.InitResponse
+	if err := r.loadKeyShares(&initResponse); err != nil {
+		return fmt.Errorf("could not load the key shares: %s", err.Error())
+	}
+	var rootToken string
+	err := r.vc.RegenRootToken(&initResponse, &rootToken)
+	// the key shares are dropped as soon as the root token is regenerated
+	initResponse = secretstoreclient.InitResponse{}
+	if err != nil {
+		return fmt.Errorf("could not regenerate root token: %s", err.Error())
+	}
+	defer func() {
+		if _, err := r.vc.RevokeSelf(rootToken); err != nil {
+			r.lc.Error(fmt.Sprintf("could not revoke temporary root token %s", err.Error()))
+		}
+	}()
+
+	info := r.configuration.Rotation
+	var failures []string
+	var restart []string
+	rotate := func(name string, enabled bool, services []string, rotation func() error) {
+		if !enabled {
+			return
+		}
+		if err := rotation(); err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %s", name, err.Error()))
+			return
+		}
+		r.lc.Info(fmt.Sprintf("rotated the %s", name))
+		restart = append(restart, services...)
+	}
+
+	rotate("Redis password", info.RedisPassword.Enabled, info.RedisPassword.Restart, func() error {
+		return r.rotateRedisPassword(ctx, NewCred(r.caller, rootToken, r.generator, r.baseURL(), r.lc))
+	})
+	rotate("service tokens", info.ServiceTokens.Enabled, info.ServiceTokens.Restart, func() error {
+		return r.rotateServiceTokens(rootToken)
+	})
+	rotate("proxy certificate", info.ProxyCertificate.Enabled, info.ProxyCertificate.Restart, func() error {
+		return r.rotateProxyCertificate(rootToken)
+	})
+
+	if err := r.restart(restart); err != nil {
+		failures = append(failures, err.Error())
+	}
+	if len(failures) > 0 {
+		return errors.New(strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+func (r *Rotator) baseURL() string {
+	return r.configuration.SecretService.GetSecretSvcBaseURL()
+}
+
+// redisPaths returns the paths of the Redis password, the one of security-bootstrap-redis first.
+func (r *Rotator) redisPaths() []string {
+	var services []string
+	for _, database := range r.configuration.Databases {
+		if database.Service != "" {
+			services = append(services, database.Service)
+		}
+	}
+	sort.Strings(services)
+
+	paths := []string{bootstrapRedisPath}
+	for i, service := range services {
+		if i == 0 || service != services[i-1] {
+			paths = append(paths, fmt.Sprintf(serviceRedisPath, service))
+		}
+	}
+	return paths
+}
+
+// rotateRedisPassword changes the password of Redis before writing it to the secret store, the services already
+// connected staying authenticated until they're restarted. The current password is restored when it can't be written.
+func (r *Rotator) rotateRedisPassword(ctx context.Context, cred Cred) error {
+	current, err := cred.retrieve(bootstrapRedisPath)
+	if err != nil {
+		return fmt.Errorf("failed to read the current password: %s", err.Error())
+	}
+	password, err := cred.GeneratePassword(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to generate the password: %s", err.Error())
+	}
+	if err = r.setRedisPassword(current.Password, password); err != nil {
+		return fmt.Errorf("failed to set the password: %s", err.Error())
+	}
+
+	pair := UserPasswordPair{User: current.User, Password: password}
+	paths := r.redisPaths()
+	for i, path := range paths {
+		if err = cred.UploadToStore(&pair, path); err == nil {
+			continue
+		}
+		for _, written := range paths[:i] {
+			if restoreErr := cred.UploadToStore(current, written); restoreErr != nil {
+				r.lc.Error(fmt.Sprintf("failed to restore the Redis password on path %s", written))
+			}
+		}
+		if restoreErr := r.setRedisPassword(password, current.Password); restoreErr != nil {
+			r.lc.Error(fmt.Sprintf("failed to restore the Redis password: %s", restoreErr.Error()))
+		}
+		return fmt.Errorf("failed to write the password on path %s: %s", path, err.Error())
+	}
+	return nil
+}
+
+// changeRedisPassword authenticates to the Redis at address with the current password, if any, and sets the new one.
+func changeRedisPassword(address string, current string, password string) error {
+	conn, err := redigo.Dial("tcp", address, redigo.DialConnectTimeout(30*time.Second), redigo.DialPassword(current))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	_, err = conn.Do("CONFIG", "SET", "REQUIREPASS", password)
+	return err
+}
+
+// rotateServiceTokens has the token provider issue new tokens to the services, as on setup, then revokes the tokens
+// they held before. The previous tokens of a service are only revoked once a new one is issued to it, so that a
+// service the token provider failed to issue a token to keeps working.
+func (r *Rotator) rotateServiceTokens(rootToken string) error {
+	previous, err := r.tokenMaintenance.ServiceTokenAccessors(rootToken)
+	if err != nil {
+		return fmt.Errorf("failed to list the current tokens: %s", err.Error())
+	}
+	revokeIssuingToken, err := makeTokenIssuingToken(r.lc, r.configuration, r.tokenMaintenance, r.fileOpener, rootToken)
+	if err != nil {
+		return err
+	}
+	if r.configuration.SecretService.TokenProviderType == OneShotProvider {
+		defer revokeIssuingToken()
+	}
+	if err = r.tokenProvider.Launch(); err != nil {
+		return fmt.Errorf("failed to issue the new tokens, the current ones are kept: %s", err.Error())
+	}
+
+	current, err := r.tokenMaintenance.ServiceTokenAccessors(rootToken)
+	if err != nil {
+		return fmt.Errorf("failed to list the new tokens, the previous ones are kept: %s", err.Error())
+	}
+	stale, kept := staleServiceTokens(previous, current)
+	if len(kept) > 0 {
+		r.lc.Warn(fmt.Sprintf("no new token was issued for %s, the previous tokens are kept", strings.Join(kept, ", ")))
+	}
+	if err = r.tokenMaintenance.RevokeAccessors(rootToken, stale); err != nil {
+		return fmt.Errorf("failed to revoke the previous tokens: %s", err.Error())
+	}
+	return nil
+}
+
+// staleServiceTokens returns the accessors of the previous tokens of the policies a new token holds, and the policies
+// no new token holds.
+func staleServiceTokens(previous map[string][]string, current map[string][]string) ([]string, []string) {
+	var stale, kept []string
+	for policy, accessors := range previous {
+		existing := make(map[string]bool)
+		for _, accessor := range accessors {
+			existing[accessor] = true
+		}
+		issued := false
+		for _, accessor := range current[policy] {
+			if !existing[accessor] {
+				issued = true
+				break
+			}
+		}
+		if !issued {
+			kept = append(kept, policy)
+			continue
+		}
+		stale = append(stale, accessors...)
+	}
+	sort.Strings(stale)
+	sort.Strings(kept)
+	return stale, kept
+}
+
+// rotateProxyCertificate issues the certificate of the API gateway from the PKI secrets engine, and writes it along
+// with its issuing certificate authority to the CertPath of the SecretService.
+func (r *Rotator) rotateProxyCertificate(rootToken string) error {
+	info := r.configuration.Rotation.ProxyCertificate
+	request := certificateRequest{CommonName: info.CommonName, TTL: info.TTL}
+	var altNames, ipSans []string
+	for _, name := range info.AltNames {
+		if net.ParseIP(name) != nil {
+			ipSans = append(ipSans, name)
+		} else {
+			altNames = append(altNames, name)
+		}
+	}
+	request.AltNames = strings.Join(altNames, ",")
+	request.IPSans = strings.Join(ipSans, ",")
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+	url := fmt.Sprintf("%sv1/%s/issue/%s", r.baseURL(), strings.Trim(info.PKIPath, "/"), info.Role)
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set(VaultToken, rootToken)
+	req.Header.Set(clients.ContentType, clients.ContentTypeJSON)
+
+	resp, err := r.caller.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to issue the certificate: %s", err.Error())
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to issue the certificate, status code %d", resp.StatusCode)
+	}
+
+	var issued certificateResponse
+	if err = json.NewDecoder(resp.Body).Decode(&issued); err != nil {
+		return fmt.Errorf("failed to decode the certificate issued: %s", err.Error())
+	}
+	if issued.Data.Certificate == "" || issued.Data.PrivateKey == "" {
+		return errors.New("no certificate issued")
+	}
+
+	pair := CertPair{
+		Cert: strings.TrimSpace(issued.Data.Certificate) + "\n" + issued.Data.IssuingCA,
+		Key:  issued.Data.PrivateKey,
+	}
+	certs := NewCerts(r.caller, r.configuration.SecretService.CertPath, rootToken, r.baseURL(), r.lc)
+	return certs.UploadToStore(&pair)
+}
+
+// restart has the system management agent restart the services, each one once and in the order given.
+func (r *Rotator) restart(services []string) error {
+	var unique []string
+	restarted := make(map[string]bool)
+	for _, service := range services {
+		if !restarted[service] {
+			restarted[service] = true
+			unique = append(unique, service)
+		}
+	}
+	if len(unique) == 0 {
+		return nil
+	}
+	if r.configuration.Rotation.AgentUrl == "" {
+		r.lc.Warn(fmt.Sprintf("no Rotation AgentUrl configured, restart %s to load their new credentials",
+			strings.Join(unique, ", ")))
+		return nil
+	}
+
+	body, err := json.Marshal(models.Operation{Services: unique, Action: restartAction})
+	if err != nil {
+		return err
+	}
+	url := strings.TrimRight(r.configuration.Rotation.AgentUrl, "/") + "/api/v1/operation"
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set(clients.ContentType, clients.ContentTypeJSON)
+
+	resp, err := r.agent.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to restart %s: %s", strings.Join(unique, ", "), err.Error())
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to restart %s, status code %d", strings.Join(unique, ", "), resp.StatusCode)
+	}
+	r.lc.Info(fmt.Sprintf("restarted %s to load their new credentials", strings.Join(unique, ", ")))
+	return nil
+}
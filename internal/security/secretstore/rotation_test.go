@@ -0,0 +1,280 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package secretstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/security/secretstore/config"
+	"github.com/edgexfoundry/edgex-go/internal/security/secretstoreclient"
+	"github.com/edgexfoundry/edgex-go/internal/security/secretstoreclient/mocks"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const transientRootToken = "s.transient"
+
+// fakeVault stores the secrets written to it, and issues the certificates of the PKI secrets engine mounted on pki.
+type fakeVault struct {
+	mutex   sync.Mutex
+	secrets map[string]json.RawMessage
+	failing string
+}
+
+func (v *fakeVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	if r.Header.Get(VaultToken) != transientRootToken {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	switch {
+	case r.URL.Path == "/v1/pki/issue/edgex-kong":
+		_, _ = w.Write([]byte(`{"data":{"certificate":"kong-cert","private_key":"kong-key","issuing_ca":"edgex-ca"}}`))
+	case r.Method == http.MethodGet:
+		secret, ok := v.secrets[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":` + string(secret) + `}`))
+	case r.URL.Path == v.failing:
+		w.WriteHeader(http.StatusInternalServerError)
+	default:
+		body, _ := ioutil.ReadAll(r.Body)
+		v.secrets[r.URL.Path] = body
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (v *fakeVault) pair(t *testing.T, path string) UserPasswordPair {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	var pair UserPasswordPair
+	require.NoError(t, json.Unmarshal(v.secrets[path], &pair))
+	return pair
+}
+
+func newRotationConfiguration(t *testing.T, vault *httptest.Server, agent *httptest.Server) *config.ConfigurationStruct {
+	vaultURL, err := url.Parse(vault.URL)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(vaultURL.Port())
+	require.NoError(t, err)
+
+	return &config.ConfigurationStruct{
+		SecretService: secretstoreclient.SecretServiceInfo{
+			Protocol: "http",
+			Server:   vaultURL.Hostname(),
+			Port:     port,
+			CertPath: "v1/secret/edgex/edgex-security-proxy-setup/kong-tls",
+		},
+		Databases: map[string]config.Database{
+			"admin":    {Username: "admin"},
+			"coredata": {Username: "core", Service: "coredata"},
+			"metadata": {Username: "meta", Service: "metadata"},
+		},
+		Rotation: config.RotationInfo{
+			Enabled:  true,
+			Interval: "720h",
+			AgentUrl: agent.URL + "/",
+			RedisPassword: config.RedisRotationInfo{
+				Enabled: true,
+				Host:    "edgex-redis",
+				Port:    6379,
+				Restart: []string{"edgex-core-data", "edgex-core-metadata"},
+			},
+			ProxyCertificate: config.CertificateRotationInfo{
+				PKIPath:    "/pki/",
+				Role:       "edgex-kong",
+				CommonName: "edgex-kong",
+				AltNames:   []string{"localhost", "127.0.0.1"},
+				Restart:    []string{"edgex-proxy", "edgex-core-data"},
+			},
+		},
+	}
+}
+
+func newMockRotationClient() *mocks.MockSecretStoreClient {
+	vc := &mocks.MockSecretStoreClient{}
+	vc.On("RegenRootToken", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		*args.Get(1).(*string) = transientRootToken
+	}).Return(nil)
+	vc.On("RevokeSelf", transientRootToken).Return(http.StatusNoContent, nil)
+	return vc
+}
+
+func TestNewRotatorValidation(t *testing.T) {
+	tests := []struct {
+		name     string
+		rotation config.RotationInfo
+	}{
+		{"no interval", config.RotationInfo{Enabled: true}},
+		{"invalid interval", config.RotationInfo{Enabled: true, Interval: "monthly"}},
+		{"no Redis host", config.RotationInfo{Enabled: true, Interval: "720h",
+			RedisPassword: config.RedisRotationInfo{Enabled: true}}},
+		{"no token provider", config.RotationInfo{Enabled: true, Interval: "720h",
+			ServiceTokens: config.TokenRotationInfo{Enabled: true}}},
+		{"no PKI role", config.RotationInfo{Enabled: true, Interval: "720h",
+			ProxyCertificate: config.CertificateRotationInfo{Enabled: true, PKIPath: "pki", CommonName: "edgex-kong"}}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			configuration := &config.ConfigurationStruct{Rotation: testCase.rotation}
+			_, err := NewRotator(configuration, http.DefaultClient, nil, nil, nil, nil, nil, logger.MockLogger{})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestRotate(t *testing.T) {
+	vault := &fakeVault{secrets: map[string]json.RawMessage{
+		bootstrapRedisPath: json.RawMessage(`{"username":"redis5","password":"current"}`),
+	}}
+	vaultServer := httptest.NewServer(vault)
+	defer vaultServer.Close()
+
+	var operation models.Operation
+	agentServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/operation" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&operation)
+	}))
+	defer agentServer.Close()
+
+	configuration := newRotationConfiguration(t, vaultServer, agentServer)
+	configuration.Rotation.ProxyCertificate.Enabled = true
+	vc := newMockRotationClient()
+	rotator, err := NewRotator(
+		configuration,
+		http.DefaultClient,
+		vc,
+		func(*secretstoreclient.InitResponse) error { return nil },
+		nil,
+		nil,
+		nil,
+		logger.MockLogger{})
+	require.NoError(t, err)
+
+	var changes [][2]string
+	rotator.setRedisPassword = func(current string, password string) error {
+		changes = append(changes, [2]string{current, password})
+		return nil
+	}
+
+	require.NoError(t, rotator.Rotate(context.Background()))
+	vc.AssertExpectations(t)
+
+	require.Len(t, changes, 1)
+	assert.Equal(t, "current", changes[0][0])
+	password := changes[0][1]
+	assert.NotEqual(t, "current", password)
+	for _, path := range []string{bootstrapRedisPath, "/v1/secret/edgex/coredata/redisdb", "/v1/secret/edgex/metadata/redisdb"} {
+		assert.Equal(t, UserPasswordPair{User: "redis5", Password: password}, vault.pair(t, path), path)
+	}
+
+	var cert CertPair
+	require.NoError(t, json.Unmarshal(vault.secrets["/v1/secret/edgex/edgex-security-proxy-setup/kong-tls"], &cert))
+	assert.Equal(t, CertPair{Cert: "kong-cert\nedgex-ca", Key: "kong-key"}, cert)
+
+	assert.Equal(t, restartAction, operation.Action)
+	assert.Equal(t, []string{"edgex-core-data", "edgex-core-metadata", "edgex-proxy"}, operation.Services,
+		"each service should be restarted once")
+}
+
+func TestRotateRedisPasswordRestored(t *testing.T) {
+	vault := &fakeVault{
+		secrets: map[string]json.RawMessage{
+			bootstrapRedisPath: json.RawMessage(`{"username":"redis5","password":"current"}`),
+		},
+		failing: "/v1/secret/edgex/metadata/redisdb",
+	}
+	vaultServer := httptest.NewServer(vault)
+	defer vaultServer.Close()
+
+	restarted := false
+	agentServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		restarted = true
+	}))
+	defer agentServer.Close()
+
+	rotator, err := NewRotator(
+		newRotationConfiguration(t, vaultServer, agentServer),
+		http.DefaultClient,
+		newMockRotationClient(),
+		func(*secretstoreclient.InitResponse) error { return nil },
+		nil,
+		nil,
+		nil,
+		logger.MockLogger{})
+	require.NoError(t, err)
+
+	var changes [][2]string
+	rotator.setRedisPassword = func(current string, password string) error {
+		changes = append(changes, [2]string{current, password})
+		return nil
+	}
+
+	assert.Error(t, rotator.Rotate(context.Background()))
+	require.Len(t, changes, 2)
+	assert.Equal(t, [2]string{changes[0][1], "current"}, changes[1], "the current password should be set again")
+	for _, path := range []string{bootstrapRedisPath, "/v1/secret/edgex/coredata/redisdb"} {
+		assert.Equal(t, UserPasswordPair{User: "redis5", Password: "current"}, vault.pair(t, path), path)
+	}
+	assert.False(t, restarted, "no service should be restarted when no credential is rotated")
+}
+
+func TestRotateKeySharesNotLoaded(t *testing.T) {
+	vc := &mocks.MockSecretStoreClient{}
+	rotator, err := NewRotator(
+		&config.ConfigurationStruct{Rotation: config.RotationInfo{Enabled: true, Interval: "720h"}},
+		http.DefaultClient,
+		vc,
+		func(*secretstoreclient.InitResponse) error { return errors.New("no init response") },
+		nil,
+		nil,
+		nil,
+		logger.MockLogger{})
+	require.NoError(t, err)
+
+	assert.Error(t, rotator.Rotate(context.Background()))
+	vc.AssertNotCalled(t, "RegenRootToken", mock.Anything, mock.Anything)
+}
+
+func TestStaleServiceTokens(t *testing.T) {
+	previous := map[string][]string{
+		"edgex-service-edgex-core-data":     {"data-1", "data-2"},
+		"edgex-service-edgex-core-metadata": {"metadata-1"},
+		"edgex-service-device-camera":       {"camera-1"},
+	}
+	current := map[string][]string{
+		"edgex-service-edgex-core-data":     {"data-1", "data-2", "data-3"},
+		"edgex-service-edgex-core-metadata": {"metadata-1"},
+		"edgex-service-device-camera":       {"camera-1"},
+		"edgex-service-support-scheduler":   {"scheduler-1"},
+	}
+
+	stale, kept := staleServiceTokens(previous, current)
+	assert.Equal(t, []string{"data-1", "data-2"}, stale)
+	assert.Equal(t, []string{"edgex-service-device-camera", "edgex-service-edgex-core-metadata"}, kept)
+}
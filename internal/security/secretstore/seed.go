@@ -0,0 +1,92 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package secretstore
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal/security/secretstore/config"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+)
+
+// serviceSecretPath is the path a service reads its secrets from, along with the path of the secret
+const serviceSecretPath = "/v1/secret/edgex/%s/%s"
+
+// seedSecrets provisions the secrets declared in the configuration into the secret store, keeping the ones already
+// there unless they are to be overwritten.
+func seedSecrets(ctx context.Context, lc logger.LoggingClient, cred Cred, secrets map[string]config.SecretInfo) error {
+	names := make([]string, 0, len(secrets))
+	for name := range secrets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if err := seedSecret(ctx, lc, cred, name, secrets[name]); err != nil {
+			return fmt.Errorf("failed to seed secret %s: %s", name, err.Error())
+		}
+	}
+	return nil
+}
+
+func seedSecret(ctx context.Context, lc logger.LoggingClient, cred Cred, name string, info config.SecretInfo) error {
+	secretPath := strings.Trim(path.Clean("/"+info.Path), "/")
+	if info.Service == "" || info.Service == ".." || strings.Contains(info.Service, "/") || secretPath == "" {
+		return fmt.Errorf("a service and a path are required, got service '%s' and path '%s'", info.Service, info.Path)
+	}
+	fullPath := fmt.Sprintf(serviceSecretPath, info.Service, secretPath)
+
+	password, err := seedPassword(ctx, cred, info)
+	if err != nil {
+		return err
+	}
+	if password == "" {
+		lc.Info(fmt.Sprintf("no password given for secret %s, skip seeding %s", name, fullPath))
+		return nil
+	}
+
+	if !info.Overwrite {
+		existing, err := cred.retrieve(fullPath)
+		if err != nil && err != errNotFound {
+			return err
+		}
+		if existing != nil && existing.Password != "" {
+			lc.Info(fmt.Sprintf("secret %s already present at path %s", name, fullPath))
+			return nil
+		}
+	}
+
+	if err = cred.UploadToStore(&UserPasswordPair{User: info.Username, Password: password}, fullPath); err != nil {
+		return err
+	}
+	lc.Info(fmt.Sprintf("seeded secret %s at path %s", name, fullPath))
+	return nil
+}
+
+// seedPassword returns the password of the secret read from its file, given or generated, in that order of
+// precedence, or an empty string when there is none.
+func seedPassword(ctx context.Context, cred Cred, info config.SecretInfo) (string, error) {
+	switch {
+	case info.PasswordFile != "":
+		contents, err := ioutil.ReadFile(info.PasswordFile)
+		if err != nil {
+			return "", fmt.Errorf("failed to read the password file: %s", err.Error())
+		}
+		return strings.TrimRight(string(contents), "\r\n"), nil
+	case info.Password != "":
+		return info.Password, nil
+	case info.Generate:
+		return cred.GeneratePassword(ctx)
+	default:
+		return "", nil
+	}
+}
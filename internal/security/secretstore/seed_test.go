@@ -0,0 +1,84 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package secretstore
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/security/secretstore/config"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeedSecrets(t *testing.T) {
+	vault := &fakeVault{secrets: map[string]json.RawMessage{
+		"/v1/secret/edgex/notifications/slack/alerts": json.RawMessage(`{"password":"https://hooks.slack.com/old"}`),
+		"/v1/secret/edgex/scheduler/mqtt":             json.RawMessage(`{"username":"scheduler","password":"old"}`),
+	}}
+	vaultServer := httptest.NewServer(vault)
+	defer vaultServer.Close()
+
+	dir, err := ioutil.TempDir("", "seed")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	passwordFile := filepath.Join(dir, "smtp-password")
+	require.NoError(t, ioutil.WriteFile(passwordFile, []byte("from-file\n"), 0600))
+
+	cred := NewCred(http.DefaultClient, transientRootToken, NewDefaultCredentialGenerator(), vaultServer.URL, logger.MockLogger{})
+	err = seedSecrets(context.Background(), logger.MockLogger{}, cred, map[string]config.SecretInfo{
+		"smtp":         {Service: "notifications", Path: "smtp", Username: "edgex@example.com", PasswordFile: passwordFile},
+		"slack-alerts": {Service: "notifications", Path: "slack/alerts", Password: "https://hooks.slack.com/new"},
+		"mqtt":         {Service: "scheduler", Path: "/mqtt/", Username: "scheduler", Password: "new", Overwrite: true},
+		"export":       {Service: "scheduler", Path: "scheduler/export", Generate: true},
+		"teams":        {Service: "notifications", Path: "teams/alerts"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, UserPasswordPair{User: "edgex@example.com", Password: "from-file"},
+		vault.pair(t, "/v1/secret/edgex/notifications/smtp"))
+	assert.Equal(t, "https://hooks.slack.com/old", vault.pair(t, "/v1/secret/edgex/notifications/slack/alerts").Password,
+		"a secret already in the store should be kept")
+	assert.Equal(t, UserPasswordPair{User: "scheduler", Password: "new"}, vault.pair(t, "/v1/secret/edgex/scheduler/mqtt"))
+	assert.NotEmpty(t, vault.pair(t, "/v1/secret/edgex/scheduler/scheduler/export").Password)
+	vault.mutex.Lock()
+	defer vault.mutex.Unlock()
+	assert.NotContains(t, vault.secrets, "/v1/secret/edgex/notifications/teams/alerts",
+		"a secret without password should be left to be provisioned by hand")
+}
+
+func TestSeedSecretsValidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		secret config.SecretInfo
+	}{
+		{"no service", config.SecretInfo{Path: "smtp", Password: "password"}},
+		{"no path", config.SecretInfo{Service: "notifications", Password: "password"}},
+		{"path out of the service", config.SecretInfo{Service: "..", Path: "smtp", Password: "password"}},
+		{"missing password file", config.SecretInfo{Service: "notifications", Path: "smtp", PasswordFile: "/nonexistent"}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			vaultServer := httptest.NewServer(&fakeVault{secrets: map[string]json.RawMessage{}})
+			defer vaultServer.Close()
+
+			cred := NewCred(http.DefaultClient, transientRootToken, nil, vaultServer.URL, logger.MockLogger{})
+			err := seedSecrets(context.Background(), logger.MockLogger{}, cred, map[string]config.SecretInfo{
+				"secret": testCase.secret,
+			})
+			assert.Error(t, err)
+		})
+	}
+}
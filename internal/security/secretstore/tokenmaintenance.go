@@ -37,6 +37,7 @@ For Fuji.DOT/Geneva, all root tokens will be revoked.
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/edgexfoundry/edgex-go/internal/security/secretstoreclient"
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
@@ -187,3 +188,41 @@ func (tm *TokenMaintenance) RevokeRootTokens(privilegedToken string) error {
 	}
 	return nil
 }
+
+// ServiceTokenAccessors returns the accessors of the tokens issued to the services by the token provider, which hold
+// the policy of their service, keyed by that policy. Should be called with a high-privileged token.
+func (tm *TokenMaintenance) ServiceTokenAccessors(privilegedToken string) (map[string][]string, error) {
+	allAccessors := make([]string, 0)
+	_, err := tm.secretClient.ListAccessors(privilegedToken, &allAccessors)
+	if err != nil {
+		return nil, err // secretclient already logged failure
+	}
+
+	serviceAccessors := make(map[string][]string)
+	for _, accessor := range allAccessors {
+		tokenMetadata := secretstoreclient.TokenMetadata{}
+		_, err := tm.secretClient.LookupAccessor(privilegedToken, accessor, &tokenMetadata)
+		if err != nil {
+			return nil, err // secretclient already logged failure
+		}
+		for _, policy := range tokenMetadata.Policies {
+			if strings.HasPrefix(policy, ServicePolicyPrefix) {
+				serviceAccessors[policy] = append(serviceAccessors[policy], accessor)
+				break
+			}
+		}
+	}
+	return serviceAccessors, nil
+}
+
+// RevokeAccessors revokes the tokens of the accessors, as many as it can despite errors.
+// Should be called with a high-privileged token.
+func (tm *TokenMaintenance) RevokeAccessors(privilegedToken string, accessors []string) error {
+	var lastErr error
+	for _, accessor := range accessors {
+		if _, err := tm.secretClient.RevokeAccessor(privilegedToken, accessor); err != nil {
+			lastErr = err
+		}
+	}
+	return lastErr // return error if any revoke errored
+}
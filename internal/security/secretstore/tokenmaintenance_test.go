@@ -154,3 +154,47 @@ func TestRevokeRootTokens(t *testing.T) {
 	assert.Nil(t, err)
 	secretClient.AssertExpectations(t)
 }
+
+func TestServiceTokenAccessors(t *testing.T) {
+	// Arrange
+	logging := logger.MockLogger{}
+	secretClient := &MockSecretStoreClient{}
+	tm := NewTokenMaintenance(logging, secretClient)
+
+	policies := map[string][]string{
+		"rootaccessor":     {"root"},
+		"creatoraccessor":  {TokenCreatorPolicyName},
+		"coredataaccessor": {"default", "edgex-service-edgex-core-data"},
+		"metadataaccessor": {"edgex-service-edgex-core-metadata"},
+		"coredataprevious": {"edgex-service-edgex-core-data"},
+	}
+	secretClient.On("ListAccessors", "priv-token", mock.Anything).
+		Run(func(args mock.Arguments) {
+			*(args.Get(1)).(*[]string) = []string{
+				"rootaccessor", "creatoraccessor", "coredataaccessor", "metadataaccessor", "coredataprevious",
+			}
+		}).
+		Return(http.StatusOK, nil)
+	for accessor, policies := range policies {
+		accessor, policies := accessor, policies
+		secretClient.On("LookupAccessor", "priv-token", accessor, mock.Anything).
+			Run(func(args mock.Arguments) {
+				*(args.Get(2)).(*secretstoreclient.TokenMetadata) = secretstoreclient.TokenMetadata{
+					Accessor: accessor,
+					Policies: policies,
+				}
+			}).
+			Return(http.StatusOK, nil)
+	}
+
+	// Act
+	accessors, err := tm.ServiceTokenAccessors("priv-token")
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, map[string][]string{
+		"edgex-service-edgex-core-data":     {"coredataaccessor", "coredataprevious"},
+		"edgex-service-edgex-core-metadata": {"metadataaccessor"},
+	}, accessors)
+	secretClient.AssertExpectations(t)
+}
@@ -16,28 +16,239 @@
 package config
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/gateway"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/mtls"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/readygate"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/selftest"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/sysevent"
+
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
 )
 
 type ConfigurationStruct struct {
-	Writable    WritableInfo
-	Clients     map[string]bootstrapConfig.ClientInfo
-	Databases   map[string]bootstrapConfig.Database
-	Registry    bootstrapConfig.RegistryInfo
-	Service     bootstrapConfig.ServiceInfo
-	Smtp        SmtpInfo
-	SecretStore bootstrapConfig.SecretStoreInfo
+	Writable         WritableInfo
+	Clients          map[string]bootstrapConfig.ClientInfo
+	Databases        map[string]bootstrapConfig.Database
+	DatabaseDecoding db.DecodingInfo
+	Registry         bootstrapConfig.RegistryInfo
+	Service          bootstrapConfig.ServiceInfo
+	Smtp             SmtpInfo
+	SecretStore      bootstrapConfig.SecretStoreInfo
+	MutualTLS        mtls.MutualTLSInfo
+	RateMonitor      RateMonitorInfo
+	Slack            WebhookInfo
+	Teams            WebhookInfo
+	Sms              SmsInfo
+	Escalation       EscalationInfo
+	Suppression      SuppressionInfo
+	Retry            RetryInfo
+	Records          RecordsInfo
+	Retention        RetentionInfo
+	Receipts         ReceiptInfo
+	Signing          SigningInfo
+	MessageQueue     MessageQueueInfo
+	Gateway          gateway.GatewayInfo
+	SelfTest         selftest.SelfTestInfo
+	SystemEvents     sysevent.SystemEventsInfo
+	ReadyGate        readygate.ReadyGateInfo
+
+	// resolveWritable resolves the secret references of the writable configuration received from the registry
+	resolveWritable func(writable interface{}) bool
 }
 
 type WritableInfo struct {
+	LogLevel string
+	// ResendLimit overrides Retry.MaxAttempts at runtime when positive.
 	ResendLimit int
-	LogLevel    string
+	// ResendInterval overrides Retry.InitialInterval at runtime when set, e.g. '30s'.
+	ResendInterval string
+}
+
+// RateMonitorInfo configures the detection of notification storms and of alert sources gone silent.
+type RateMonitorInfo struct {
+	Enabled bool
+	// Window is the period over which notifications are counted, e.g. '1m'.
+	Window string
+	// SmoothingFactor weighs the latest window in the baseline, between 0 and 1.
+	SmoothingFactor float64
+	// StormFactor is how many times the baseline a window has to reach to be reported as a storm.
+	StormFactor float64
+	// MinBaseline is the baseline below which a category is too quiet to report anomalies for.
+	MinBaseline float64
+	// WarmupWindows is the number of windows used to establish the baseline before anomalies are reported.
+	WarmupWindows int
+}
+
+// WebhookInfo configures the sender posting notifications to the incoming webhooks of a chat service. A channel selects
+// a webhook by name through its url, e.g. slack://alerts.
+type WebhookInfo struct {
+	// SecretPath is the secret store path under which the url of each webhook, which embeds its token, is stored as the
+	// password of the secret named after the webhook.
+	SecretPath string
+	// Webhooks holds the urls of the webhooks by name, used instead of the secret store when security is disabled.
+	Webhooks map[string]string
+	// Timeout of a request to a webhook, e.g. '10s'.
+	Timeout string
+}
+
+// SmsInfo configures the sender texting notifications to the phone numbers of SMS channels, e.g.
+// sms:+15551234567,+15557654321, through the Twilio API or an SMPP server.
+type SmsInfo struct {
+	// Provider is either 'twilio' or 'smpp'.
+	Provider string
+	// Sender is the phone number, or alphanumeric id, the messages are sent from.
+	Sender string
+	// SecretPath is the secret store path of the credentials, stored as username and password: the account SID and
+	// auth token for Twilio, the system id and password for SMPP.
+	SecretPath string
+	// Username and Password are used instead of the secret store when security is disabled.
+	Username string
+	Password string
+	// TwilioUrl is the base url of the Twilio API.
+	TwilioUrl string
+	// Host and Port of the SMPP server.
+	Host string
+	Port int
+	// SystemType identifies the type of system binding to the SMPP server, if the server requires it.
+	SystemType string
+	// MaxLength is the number of characters the messages are truncated to, 0 for no limit.
+	MaxLength int
+	// Timeout of the delivery of a message to the provider, e.g. '10s'.
+	Timeout string
+}
+
+// EscalationInfo configures the escalation of the unacknowledged critical notifications along the escalation policies
+// of their subscriptions.
+type EscalationInfo struct {
+	// CheckInterval is how often the escalations due are looked for, e.g. '10s'.
+	CheckInterval string
+}
+
+// SuppressionInfo configures the collapsing of the identical notifications, of the same category, labels and content,
+// arriving within a window into the first one, followed by a summary counting the occurrences.
+type SuppressionInfo struct {
+	Enabled bool
+	// Window is how long the duplicates of a notification are collapsed after it, e.g. '5m'.
+	Window string
+}
+
+// RetryInfo configures how the failed transmissions are resent for the subscriptions without a retry policy of their
+// own, in which case only the transmissions of the critical notifications are resent.
+type RetryInfo struct {
+	// MaxAttempts is the number of resends of a failed transmission before giving up, or escalating it when critical.
+	MaxAttempts int
+	// InitialInterval is the delay before the first resend, e.g. '5s'.
+	InitialInterval string
+	// BackoffMultiplier multiplies the delay before every following resend.
+	BackoffMultiplier float64
+	// MaxInterval caps the delay between resends, e.g. '5m'.
+	MaxInterval string
+	// Jitter is the fraction, between 0 and 1, by which each delay is randomly shortened or lengthened.
+	Jitter float64
+}
+
+// RecordsInfo configures the pruning of the records of the transmissions resent over and over, which are capped to the
+// first record, a record counting the pruned ones by status and the latest records.
+type RecordsInfo struct {
+	// KeepLatest is the number of the latest records kept in a transmission, 0 for no pruning.
+	KeepLatest int
+	// History indicates whether every record is also stored aside, in the full history of its transmission.
+	History bool
+}
+
+// RetentionInfo configures the purge of the processed notifications, along with their transmissions and escalations,
+// older than the age configured for their severity.
+type RetentionInfo struct {
+	Enabled bool
+	// Interval is how often the expired notifications are purged, e.g. '1h'.
+	Interval string
+	// MaxAge holds, by severity (NORMAL or CRITICAL), the age since its last modification after which a processed
+	// notification is purged, e.g. '720h'. The notifications of a severity without age are kept.
+	MaxAge map[string]string
+	// TransmissionMaxAge is the age after which any transmission is purged, even when its notification is kept, e.g.
+	// '2160h'. When empty, the transmissions are only purged along with their notifications.
+	TransmissionMaxAge string
+}
+
+// ReceiptInfo configures the verification of the delivery receipts signed by the targets of REST channels.
+type ReceiptInfo struct {
+	// Keys holds the base64 encoded Ed25519 public keys of the targets by the key id they sign their receipts with.
+	Keys map[string]string
+}
+
+// SigningInfo configures the HMAC-SHA256 signing of the payloads posted to the REST channels of the subscriptions, so
+// that their targets can authenticate them.
+type SigningInfo struct {
+	// Subscriptions is the comma separated list of the slugs of the subscriptions whose payloads are signed.
+	Subscriptions string
+	// SecretPath is the secret store path under which the secret of each of these subscriptions is stored as the
+	// password of the secret named after its slug.
+	SecretPath string
+	// Secrets holds the secrets by subscription slug, used instead of the secret store when security is disabled.
+	Secrets map[string]string
+}
+
+// Signs reports whether the payloads of the subscription are signed.
+func (s SigningInfo) Signs(subscription string) bool {
+	for _, slug := range strings.Split(s.Subscriptions, ",") {
+		if strings.TrimSpace(slug) == subscription {
+			return true
+		}
+	}
+	return false
+}
+
+// MessageQueueInfo provides parameters related to accepting notifications over a message bus.
+type MessageQueueInfo struct {
+	// Enabled indicates whether notifications are accepted over the message bus.
+	Enabled bool
+	// Host is the hostname or IP address of the broker, if applicable.
+	Host string
+	// Port defines the port on which to access the message queue.
+	Port int
+	// Protocol indicates the protocol to use when accessing the message queue.
+	Protocol string
+	// Indicates the message queue platform being used.
+	Type string
+	// SecretPath is the secret store path of the credentials of the broker, stored as username and password, which
+	// are used instead of the Username and Password of Optional when set and security is enabled.
+	SecretPath string
+	// SubscribeTopics is the comma separated list of topics on which notifications are received, e.g.
+	// 'edgex/notifications/#'.
+	SubscribeTopics string
+	// Provides additional configuration properties which do not fit within the existing field.
+	// Typically the key is the name of the configuration property and the value is a string representation of the
+	// desired value for the configuration property.
+	Optional map[string]string
+}
+
+// URL constructs a URL from the protocol, host and port and returns that as a string.
+func (m MessageQueueInfo) URL() string {
+	return fmt.Sprintf("%s://%s:%v", m.Protocol, m.Host, m.Port)
+}
+
+// Topics returns the topics on which notifications are received.
+func (m MessageQueueInfo) Topics() []string {
+	var topics []string
+	for _, topic := range strings.Split(m.SubscribeTopics, ",") {
+		if topic = strings.TrimSpace(topic); topic != "" {
+			topics = append(topics, topic)
+		}
+	}
+	return topics
 }
 
 type SmtpInfo struct {
-	Host                 string
-	Username             string
-	Password             string
+	Host     string
+	Username string
+	Password string
+	// SecretPath is the secret store path of the credentials, stored as username and password, which are used instead
+	// of Username and Password when set and security is enabled.
+	SecretPath           string
 	Port                 int
 	Sender               string
 	EnableSelfSignedCert bool
@@ -75,15 +286,22 @@ func (c *ConfigurationStruct) EmptyWritablePtr() interface{} {
 }
 
 // UpdateWritableFromRaw converts configuration received from the registry to a service-specific WritableInfo struct
-// which is then used to overwrite the service's existing configuration's WritableInfo struct.
+// which is then used to overwrite the service's existing configuration's WritableInfo struct, once its secret
+// references are resolved.
 func (c *ConfigurationStruct) UpdateWritableFromRaw(rawWritable interface{}) bool {
 	writable, ok := rawWritable.(*WritableInfo)
-	if ok {
+	if ok && (c.resolveWritable == nil || c.resolveWritable(writable)) {
 		c.Writable = *writable
 	}
 	return ok
 }
 
+// SetWritableResolver sets the function resolving the secret references of the writable configuration received from
+// the registry, which is only applied when they are.
+func (c *ConfigurationStruct) SetWritableResolver(resolve func(writable interface{}) bool) {
+	c.resolveWritable = resolve
+}
+
 // GetBootstrap returns the configuration elements required by the bootstrap.  Currently, a copy of the configuration
 // data is returned.  This is intended to be temporary -- since ConfigurationStruct drives the configuration.toml's
 // structure -- until we can make backwards-breaking configuration.toml changes (which would consolidate these fields
@@ -112,3 +330,8 @@ func (c *ConfigurationStruct) GetRegistryInfo() bootstrapConfig.RegistryInfo {
 func (c *ConfigurationStruct) GetDatabaseInfo() map[string]bootstrapConfig.Database {
 	return c.Databases
 }
+
+// GetDatabaseDecodePolicy returns the decode policy of the objects read from the database.
+func (c *ConfigurationStruct) GetDatabaseDecodePolicy() string {
+	return c.DatabaseDecoding.Policy
+}
@@ -21,6 +21,7 @@ var (
 	ESCALATIONSUBSCRIPTIONSLUG = "ESCALATION"
 	ESCALATIONPREFIX           = "escalated-"
 	ESCALATEDCONTENTNOTICE     = "This notification is escalated by the transmission"
+	ESCALATEDLEVELNOTICE       = "This notification was not acknowledged and is escalated to level"
 
 	/* ---------------- URL PARAM NAMES -----------------------*/
 	START        = "start"
@@ -40,6 +41,27 @@ var (
 	NEW          = "new"
 	ESCALATED    = "escalated"
 	ACKNOWLEDGED = "acknowledged"
+	ACKNOWLEDGE  = "acknowledge"
 	FAILED       = "failed"
 	SENT         = "sent"
+	STATUS       = "status"
+
+	SEVERITYMAPPING  = "severitymapping"
+	NAME             = "name"
+	SEVERITYSCHEME   = "severityscheme"
+	EXTERNALSEVERITY = "externalseverity"
+	FILTER           = "filter"
+	TEMPLATE         = "template"
+	ESCALATION       = "escalation"
+	EXPORT           = "export"
+	IMPORT           = "import"
+	STATISTICS       = "statistics"
+	SUPPRESSION      = "suppression"
+	RETRY            = "retry"
+	ROUTING          = "routing"
+	RECEIPT          = "receipt"
+	VERIFY           = "verify"
+	HISTORY          = "history"
+	RESEND           = "resend"
+	EFFECTIVE        = "effective"
 )
@@ -0,0 +1,19 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// DBClientFrom helper function queries the DIC and returns the database client as the interfaces.DBClient of the
+// service, whose methods the shared database interface leaves out.
+func DBClientFrom(get di.Get) interfaces.DBClient {
+	return get(container.DBClientInterfaceName).(interfaces.DBClient)
+}
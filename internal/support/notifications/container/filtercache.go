@@ -0,0 +1,24 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/filtercache"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// FilterCacheName contains the name of the filtercache.Cache instance in the DIC.
+var FilterCacheName = di.TypeInstanceToName(filtercache.Cache{})
+
+// FilterCacheFrom helper function queries the DIC and returns the filtercache.Cache instance, or nil when it isn't
+// bootstrapped.
+func FilterCacheFrom(get di.Get) *filtercache.Cache {
+	if c, ok := get(FilterCacheName).(*filtercache.Cache); ok {
+		return c
+	}
+	return nil
+}
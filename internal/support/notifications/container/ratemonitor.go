@@ -0,0 +1,24 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/ratemonitor"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// RateMonitorName contains the name of the ratemonitor.Monitor instance in the DIC.
+var RateMonitorName = di.TypeInstanceToName(ratemonitor.Monitor{})
+
+// RateMonitorFrom helper function queries the DIC and returns the ratemonitor.Monitor instance, or nil when the rate
+// monitoring is disabled.
+func RateMonitorFrom(get di.Get) *ratemonitor.Monitor {
+	if m, ok := get(RateMonitorName).(*ratemonitor.Monitor); ok {
+		return m
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/sender"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// ChannelSendersName contains the name of the sender.Registry instance in the DIC.
+var ChannelSendersName = di.TypeInstanceToName(sender.Registry{})
+
+// ChannelSendersFrom helper function queries the DIC and returns the sender.Registry instance.
+func ChannelSendersFrom(get di.Get) *sender.Registry {
+	return get(ChannelSendersName).(*sender.Registry)
+}
@@ -0,0 +1,24 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/suppressor"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// SuppressorName contains the name of the suppressor.Suppressor instance in the DIC.
+var SuppressorName = di.TypeInstanceToName(suppressor.Suppressor{})
+
+// SuppressorFrom helper function queries the DIC and returns the suppressor.Suppressor instance, or nil when the
+// suppression of duplicate notifications is disabled.
+func SuppressorFrom(get di.Get) *suppressor.Suppressor {
+	if s, ok := get(SuppressorName).(*suppressor.Suppressor); ok {
+		return s
+	}
+	return nil
+}
@@ -16,8 +16,13 @@
 package notifications
 
 import (
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
 	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/filtercache"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/sender"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
 	"github.com/edgexfoundry/go-mod-core-contracts/models"
@@ -27,6 +32,8 @@ func distribute(
 	n models.Notification,
 	lc logger.LoggingClient,
 	dbClient interfaces.DBClient,
+	senders *sender.Registry,
+	filters *filtercache.Cache,
 	config notificationsConfig.ConfigurationStruct) error {
 
 	lc.Debug("DistributionCoordinator start distributing notification: " + n.Slug)
@@ -38,19 +45,59 @@ func distribute(
 		return err
 	}
 	for _, sub := range subs {
-		send(n, sub, lc, dbClient, config)
+		matched, err := filters.Matches(n, sub.Slug, dbClient, time.Now())
+		if err != nil {
+			lc.Error("Unable to evaluate filter of subscription " + sub.Slug + " for notification " + n.Slug + ": " + err.Error())
+			continue
+		}
+		if !matched {
+			lc.Debug("Notification " + n.Slug + " does not match the filter of subscription " + sub.Slug)
+			continue
+		}
+		admitted, reason, err := subscriptionRoutingAdmits(n, sub, time.Now(), dbClient)
+		if err != nil {
+			lc.Error("Unable to evaluate routing policy of subscription " + sub.Slug + " for notification " + n.Slug + ": " + err.Error())
+			continue
+		}
+		if !admitted {
+			lc.Debug("Notification " + n.Slug + " is not routed to subscription " + sub.Slug + ": " + reason)
+			continue
+		}
+		send(n, sub, lc, dbClient, senders, config)
 	}
 	return nil
 }
 
+// resend resends the failed transmission whose resend was scheduled at the given time, unless the pending resends of
+// the subscription were cancelled since. The retry policy the subscription has by now applies, so that the changes
+// made to it since the transmission failed are picked up.
 func resend(
 	t models.Transmission,
+	subscription string,
+	scheduled int64,
 	lc logger.LoggingClient,
 	dbClient interfaces.DBClient,
+	senders *sender.Registry,
 	config notificationsConfig.ConfigurationStruct) {
 
+	cancelled, err := dbClient.GetResendCancellation(subscription)
+	if err != nil && err != db.ErrUnsupportedDatabase {
+		lc.Error("Unable to get the resend cancellation of subscription " + subscription + ": " + err.Error())
+	}
+	if cancelled >= scheduled {
+		lc.Info("Resend of transmission " + t.ID + " for: " + t.Notification.Slug + " was cancelled")
+		return
+	}
+
+	policy := retryPolicy(subscription, lc, dbClient, config)
+	if t.ResendCount >= policy.MaxAttempts || !policy.Retries(t.Notification) {
+		// the policy was changed since the resend was scheduled
+		handleFailedTransmission(t, subscription, policy, lc, dbClient, senders, config)
+		return
+	}
+
 	lc.Debug("Resending transmission: " + t.ID + " for: " + t.Notification.Slug)
-	resendViaChannel(t, lc, dbClient, config)
+	resendViaChannel(t, subscription, policy, lc, dbClient, senders, config)
 }
 
 func send(
@@ -58,19 +105,17 @@ func send(
 	s models.Subscription,
 	lc logger.LoggingClient,
 	dbClient interfaces.DBClient,
+	senders *sender.Registry,
 	config notificationsConfig.ConfigurationStruct) {
 
+	render := renderer(s, lc, dbClient)
+	policy := retryPolicy(s.Slug, lc, dbClient, config)
+	var transmissions []string
 	for _, ch := range s.Channels {
-		sendViaChannel(n, ch, s.Receiver, lc, dbClient, config)
+		t, err := sendViaChannel(render(n, ch), ch, s.Receiver, s.Slug, policy, lc, dbClient, senders, config)
+		if err == nil {
+			transmissions = append(transmissions, t.ID)
+		}
 	}
-}
-
-func criticalSeverityResend(
-	t models.Transmission,
-	lc logger.LoggingClient,
-	dbClient interfaces.DBClient,
-	config notificationsConfig.ConfigurationStruct) {
-
-	lc.Info("Critical severity resend scheduler is triggered.")
-	resend(t, lc, dbClient, config)
+	startEscalation(n, s, transmissions, lc, dbClient)
 }
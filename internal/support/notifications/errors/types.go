@@ -57,3 +57,137 @@ func NewErrInvalidEmailAddresses(addresses []string, description string) error {
 	return ErrInvalidEmailAddresses{description: description,
 		addresses: addresses}
 }
+
+type ErrInvalidPhoneNumbers struct {
+	description string
+}
+
+func (e ErrInvalidPhoneNumbers) Error() string {
+	return fmt.Sprintf("Invalid SMS channel, Reason: %s", e.description)
+}
+
+func NewErrInvalidPhoneNumbers(description string) error {
+	return ErrInvalidPhoneNumbers{description: description}
+}
+
+type ErrSeverityMappingNotFound struct {
+	name string
+}
+
+func (e ErrSeverityMappingNotFound) Error() string {
+	return fmt.Sprintf("Severity mapping '%s' not found", e.name)
+}
+
+func NewErrSeverityMappingNotFound(name string) error {
+	return ErrSeverityMappingNotFound{name: name}
+}
+
+type ErrUnmappedSeverity struct {
+	mapping  string
+	external string
+}
+
+func (e ErrUnmappedSeverity) Error() string {
+	return fmt.Sprintf("Severity '%s' is not mapped by severity mapping '%s' and no default severity is defined",
+		e.external, e.mapping)
+}
+
+func NewErrUnmappedSeverity(mapping string, external string) error {
+	return ErrUnmappedSeverity{mapping: mapping, external: external}
+}
+
+type ErrSubscriptionFilterNotFound struct {
+	slug string
+}
+
+func (e ErrSubscriptionFilterNotFound) Error() string {
+	return fmt.Sprintf("Filter of subscription '%s' not found", e.slug)
+}
+
+func NewErrSubscriptionFilterNotFound(slug string) error {
+	return ErrSubscriptionFilterNotFound{slug: slug}
+}
+
+type ErrEscalationPolicyNotFound struct {
+	slug string
+}
+
+func (e ErrEscalationPolicyNotFound) Error() string {
+	return fmt.Sprintf("Escalation policy of subscription '%s' not found", e.slug)
+}
+
+func NewErrEscalationPolicyNotFound(slug string) error {
+	return ErrEscalationPolicyNotFound{slug: slug}
+}
+
+type ErrRetryPolicyNotFound struct {
+	slug string
+}
+
+func (e ErrRetryPolicyNotFound) Error() string {
+	return fmt.Sprintf("Retry policy of subscription '%s' not found", e.slug)
+}
+
+func NewErrRetryPolicyNotFound(slug string) error {
+	return ErrRetryPolicyNotFound{slug: slug}
+}
+
+type ErrRoutingPolicyNotFound struct {
+	slug string
+}
+
+func (e ErrRoutingPolicyNotFound) Error() string {
+	return fmt.Sprintf("Routing policy of subscription '%s' not found", e.slug)
+}
+
+func NewErrRoutingPolicyNotFound(slug string) error {
+	return ErrRoutingPolicyNotFound{slug: slug}
+}
+
+type ErrDeliveryReceiptNotFound struct {
+	id string
+}
+
+func (e ErrDeliveryReceiptNotFound) Error() string {
+	return fmt.Sprintf("Delivery receipt of transmission '%s' not found", e.id)
+}
+
+func NewErrDeliveryReceiptNotFound(id string) error {
+	return ErrDeliveryReceiptNotFound{id: id}
+}
+
+type ErrTransmissionNotFound struct {
+	id string
+}
+
+func (e ErrTransmissionNotFound) Error() string {
+	return fmt.Sprintf("Transmission '%s' not found", e.id)
+}
+
+func NewErrTransmissionNotFound(id string) error {
+	return ErrTransmissionNotFound{id: id}
+}
+
+type ErrTemplateNotFound struct {
+	name string
+}
+
+func (e ErrTemplateNotFound) Error() string {
+	return fmt.Sprintf("Template '%s' not found", e.name)
+}
+
+func NewErrTemplateNotFound(name string) error {
+	return ErrTemplateNotFound{name: name}
+}
+
+type ErrSubscriptionTemplateNotFound struct {
+	slug string
+}
+
+func (e ErrSubscriptionTemplateNotFound) Error() string {
+	return fmt.Sprintf("Subscription '%s' references no template", e.slug)
+}
+
+func NewErrSubscriptionTemplateNotFound(slug string) error {
+	return ErrSubscriptionTemplateNotFound{slug: slug}
+}
@@ -18,6 +18,7 @@ package notifications
 import (
 	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/sender"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
 	"github.com/edgexfoundry/go-mod-core-contracts/models"
@@ -27,6 +28,7 @@ func escalate(
 	t models.Transmission,
 	lc logger.LoggingClient,
 	dbClient interfaces.DBClient,
+	senders *sender.Registry,
 	config notificationsConfig.ConfigurationStruct) {
 
 	lc.Warn("Escalating transmission: " + t.ID + ", for: " + t.Notification.Slug)
@@ -44,7 +46,7 @@ func escalate(
 		return
 	}
 
-	send(n, s, lc, dbClient, config)
+	send(n, s, lc, dbClient, senders, config)
 }
 
 func createEscalatedNotification(
@@ -0,0 +1,230 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	notificationsContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/sender"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// startEscalation records the escalation of a critical notification sent to a subscription with an escalation policy,
+// so that the first level of the policy is contacted unless one of the transmissions is acknowledged in time.
+func startEscalation(
+	n models.Notification,
+	s models.Subscription,
+	transmissions []string,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if n.Severity != models.Critical || n.Status == models.Escalated || len(transmissions) == 0 {
+		return
+	}
+
+	p, err := dbClient.GetEscalationPolicyBySlug(s.Slug)
+	if err != nil {
+		if err != db.ErrNotFound {
+			lc.Error("Unable to get the escalation policy of subscription " + s.Slug + ": " + err.Error())
+		}
+		return
+	}
+
+	e := notificationsModels.Escalation{
+		Notification:  n.Slug,
+		Subscription:  s.Slug,
+		Transmissions: transmissions,
+		Status:        notificationsModels.EscalationActive,
+		Deadline:      p.Deadline(0, time.Now()),
+	}
+	if _, err = dbClient.AddEscalation(e); err != nil {
+		lc.Error("Unable to start the escalation of notification " + n.Slug + " for subscription " + s.Slug + ": " + err.Error())
+	}
+}
+
+// expediteEscalation makes the escalation of a transmission due immediately once all of its transmissions failed,
+// since none of them can be acknowledged anymore.
+func expediteEscalation(t models.Transmission, lc logger.LoggingClient, dbClient interfaces.DBClient) {
+	e, err := dbClient.GetEscalationByTransmission(t.ID)
+	if err != nil || e.Status != notificationsModels.EscalationActive {
+		return
+	}
+
+	for _, id := range e.Transmissions {
+		other, err := dbClient.GetTransmissionById(id)
+		if err != nil {
+			return
+		}
+		if other.Status != models.Failed && other.Status != models.Trxescalated {
+			return
+		}
+	}
+
+	lc.Info("All transmissions of notification " + e.Notification + " to subscription " + e.Subscription + " failed, escalating it now")
+	e.Deadline = db.MakeTimestamp()
+	if err = dbClient.UpdateEscalation(e); err != nil {
+		lc.Error("Unable to expedite the escalation of notification " + e.Notification + ": " + err.Error())
+	}
+}
+
+// monitorEscalations advances the escalations due every interval until ctx is done.
+func monitorEscalations(ctx context.Context, interval time.Duration, dic *di.Container) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			escalateDue(
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get),
+				notificationsContainer.ChannelSendersFrom(dic.Get),
+				*notificationsContainer.ConfigurationFrom(dic.Get))
+		}
+	}
+}
+
+// escalateDue advances the escalations whose deadline has passed.
+func escalateDue(
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	senders *sender.Registry,
+	config notificationsConfig.ConfigurationStruct) {
+
+	escalations, err := dbClient.GetDueEscalations(db.MakeTimestamp())
+	if err != nil {
+		lc.Error("Unable to get the escalations due: " + err.Error())
+		return
+	}
+	for _, e := range escalations {
+		advanceEscalation(e, lc, dbClient, senders, config)
+	}
+}
+
+// advanceEscalation stops the escalation if one of its transmissions was acknowledged, and otherwise resends its
+// notification to the contacts of the next level of the policy.
+func advanceEscalation(
+	e notificationsModels.Escalation,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	senders *sender.Registry,
+	config notificationsConfig.ConfigurationStruct) {
+
+	if escalationAcknowledged(e, dbClient) {
+		lc.Info("Escalation of notification " + e.Notification + " to subscription " + e.Subscription + " was acknowledged")
+		e.Status = notificationsModels.EscalationAcknowledged
+		updateEscalation(e, lc, dbClient)
+		return
+	}
+
+	p, err := dbClient.GetEscalationPolicyBySlug(e.Subscription)
+	if err != nil && err != db.ErrNotFound {
+		lc.Error("Unable to get the escalation policy of subscription " + e.Subscription + ": " + err.Error())
+		return
+	}
+	n, err := dbClient.GetNotificationBySlug(e.Notification)
+	if err != nil && err != db.ErrNotFound {
+		lc.Error("Unable to get notification " + e.Notification + " to escalate: " + err.Error())
+		return
+	}
+	if err == db.ErrNotFound || e.Level >= len(p.Levels) {
+		lc.Warn("Escalation of notification " + e.Notification + " to subscription " + e.Subscription + " has no level left to contact")
+		e.Status = notificationsModels.EscalationExhausted
+		updateEscalation(e, lc, dbClient)
+		return
+	}
+
+	level := p.Levels[e.Level]
+	lc.Warn(fmt.Sprintf("Escalating notification %s of subscription %s to level %d", e.Notification, e.Subscription, e.Level+1))
+	escalated := createLevelNotification(n, e, lc, dbClient)
+	policy := retryPolicy(e.Subscription, lc, dbClient, config)
+	for _, ch := range level.Channels {
+		t, err := sendViaChannel(escalated, ch, level.Receiver, e.Subscription, policy, lc, dbClient, senders, config)
+		if err == nil {
+			e.Transmissions = append(e.Transmissions, t.ID)
+		}
+	}
+
+	e.Level++
+	if e.Level < len(p.Levels) {
+		e.Deadline = p.Deadline(e.Level, time.Now())
+	} else {
+		e.Status = notificationsModels.EscalationExhausted
+	}
+	updateEscalation(e, lc, dbClient)
+}
+
+// escalationAcknowledged reports whether any transmission of the escalation has been acknowledged.
+func escalationAcknowledged(e notificationsModels.Escalation, dbClient interfaces.DBClient) bool {
+	for _, id := range e.Transmissions {
+		t, err := dbClient.GetTransmissionById(id)
+		if err == nil && t.Status == models.Acknowledged {
+			return true
+		}
+	}
+	return false
+}
+
+// createLevelNotification stores the copy of the notification sent to the next level of the escalation. Its escalated
+// status prevents it from being escalated in turn.
+func createLevelNotification(
+	old models.Notification,
+	e notificationsModels.Escalation,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) models.Notification {
+
+	n := models.Notification{Category: old.Category, Severity: old.Severity, Description: old.Description, Labels: old.Labels, ContentType: "text/plain"}
+	n.Slug = fmt.Sprintf("%s%s-%d-%s", ESCALATIONPREFIX, e.Subscription, e.Level+1, old.Slug)
+	n.Sender = ESCALATIONPREFIX + old.Sender
+	n.Content = fmt.Sprintf("%s %d of subscription %s: %s", ESCALATEDLEVELNOTICE, e.Level+1, e.Subscription, old.Content)
+	n.Status = models.Escalated
+	if _, err := dbClient.AddNotification(n); err != nil {
+		// the contacts are notified all the same
+		lc.Error("Unable to store escalated notification " + n.Slug + ": " + err.Error())
+	}
+	return n
+}
+
+func updateEscalation(e notificationsModels.Escalation, lc logger.LoggingClient, dbClient interfaces.DBClient) {
+	if err := dbClient.UpdateEscalation(e); err != nil {
+		lc.Error("Unable to update the escalation of notification " + e.Notification + ": " + err.Error())
+	}
+}
+
+// withEscalations attaches to the transmissions the escalation they are part of, if any.
+func withEscalations(
+	transmissions []models.Transmission,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) []notificationsModels.Transmission {
+
+	result := make([]notificationsModels.Transmission, len(transmissions))
+	for i, t := range transmissions {
+		result[i].Transmission = t
+		e, err := dbClient.GetEscalationByTransmission(t.ID)
+		if err != nil {
+			if err != db.ErrNotFound {
+				lc.Error("Unable to get the escalation of transmission " + t.ID + ": " + err.Error())
+			}
+			continue
+		}
+		result[i].Escalation = &e
+	}
+	return result
+}
@@ -0,0 +1,106 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package filtercache keeps the compiled filters of the subscriptions, so that distributing a notification evaluates
+// the filter of each subscription without reading it from the database and compiling it again.
+package filtercache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// DefaultTTL bounds how long a filter changed through another instance of the service may still be applied by this
+// one; the changes made through this instance invalidate its filters right away.
+const DefaultTTL = time.Minute
+
+// FilterReader reads the filter of a subscription from the database.
+type FilterReader interface {
+	GetSubscriptionFilterBySlug(slug string) (models.SubscriptionFilter, error)
+}
+
+type entry struct {
+	// filter is nil when the subscription has no filter
+	filter  *models.CompiledFilter
+	expires time.Time
+}
+
+// Cache holds the compiled filters by subscription slug, along with the subscriptions known to have none.
+type Cache struct {
+	ttl     time.Duration
+	mutex   sync.RWMutex
+	entries map[string]entry
+}
+
+// NewCache creates an empty Cache keeping the filters for ttl after they are read.
+func NewCache(ttl time.Duration) *Cache {
+	return &Cache{ttl: ttl, entries: make(map[string]entry)}
+}
+
+// Matches reports whether the notification matches the filter of the subscription, which it does when the subscription
+// has no filter. The filter is read from reader and compiled when it isn't cached or has expired. A nil Cache reads
+// and compiles the filter every time.
+func (c *Cache) Matches(n contract.Notification, slug string, reader FilterReader, now time.Time) (bool, error) {
+	filter, err := c.filter(slug, reader, now)
+	if err != nil || filter == nil {
+		return err == nil, err
+	}
+	return filter.Matches(n)
+}
+
+func (c *Cache) filter(slug string, reader FilterReader, now time.Time) (*models.CompiledFilter, error) {
+	if c != nil {
+		c.mutex.RLock()
+		e, found := c.entries[slug]
+		c.mutex.RUnlock()
+		if found && now.Before(e.expires) {
+			return e.filter, nil
+		}
+	}
+
+	var compiled *models.CompiledFilter
+	f, err := reader.GetSubscriptionFilterBySlug(slug)
+	switch {
+	case err == db.ErrNotFound:
+	case err != nil:
+		return nil, err
+	default:
+		if compiled, err = f.Compile(); err != nil {
+			return nil, err
+		}
+	}
+
+	if c != nil {
+		c.mutex.Lock()
+		c.entries[slug] = entry{filter: compiled, expires: now.Add(c.ttl)}
+		c.mutex.Unlock()
+	}
+	return compiled, nil
+}
+
+// Invalidate drops the filter of the subscription, so that its next evaluation reads it again. It is nil-safe.
+func (c *Cache) Invalidate(slug string) {
+	if c == nil {
+		return
+	}
+	c.mutex.Lock()
+	delete(c.entries, slug)
+	c.mutex.Unlock()
+}
+
+// Reset drops every filter, for the changes whose subscription slugs aren't known. It is nil-safe.
+func (c *Cache) Reset() {
+	if c == nil {
+		return
+	}
+	c.mutex.Lock()
+	c.entries = make(map[string]entry)
+	c.mutex.Unlock()
+}
@@ -0,0 +1,130 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package filtercache
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	hot  = contract.Notification{Slug: "hot", Content: `{"temperature":85}`}
+	cold = contract.Notification{Slug: "cold", Content: `{"temperature":20}`}
+
+	testFilter = models.SubscriptionFilter{
+		Subscription: "hot-rooms",
+		Conditions:   []models.FilterCondition{{Path: "$.content.temperature", Operator: models.OperatorGreater, Value: 80}},
+	}
+)
+
+// testReader serves the filters it holds and counts the reads.
+type testReader struct {
+	filters map[string]models.SubscriptionFilter
+	err     error
+	reads   int
+}
+
+func (r *testReader) GetSubscriptionFilterBySlug(slug string) (models.SubscriptionFilter, error) {
+	r.reads++
+	if r.err != nil {
+		return models.SubscriptionFilter{}, r.err
+	}
+	f, ok := r.filters[slug]
+	if !ok {
+		return f, db.ErrNotFound
+	}
+	return f, nil
+}
+
+func TestMatches(t *testing.T) {
+	reader := &testReader{filters: map[string]models.SubscriptionFilter{testFilter.Subscription: testFilter}}
+	c := NewCache(time.Minute)
+	now := time.Now()
+
+	tests := []struct {
+		name         string
+		notification contract.Notification
+		slug         string
+		expected     bool
+	}{
+		{"matching content", hot, testFilter.Subscription, true},
+		{"non-matching content", cold, testFilter.Subscription, false},
+		{"no filter", cold, "all-rooms", true},
+		{"no filter, cached", hot, "all-rooms", true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			matched, err := c.Matches(testCase.notification, testCase.slug, reader, now)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, matched)
+		})
+	}
+	assert.Equal(t, 2, reader.reads, "each filter should be read once")
+}
+
+func TestMatchesReadsAgain(t *testing.T) {
+	reader := &testReader{filters: map[string]models.SubscriptionFilter{testFilter.Subscription: testFilter}}
+	c := NewCache(time.Minute)
+	now := time.Now()
+
+	matched, err := c.Matches(cold, testFilter.Subscription, reader, now)
+	require.NoError(t, err)
+	assert.False(t, matched)
+
+	delete(reader.filters, testFilter.Subscription)
+	matched, _ = c.Matches(cold, testFilter.Subscription, reader, now)
+	assert.False(t, matched, "the cached filter should apply until it is invalidated or expires")
+
+	c.Invalidate(testFilter.Subscription)
+	matched, _ = c.Matches(cold, testFilter.Subscription, reader, now)
+	assert.True(t, matched, "the invalidated filter should be read again")
+
+	reader.filters[testFilter.Subscription] = testFilter
+	c.Reset()
+	matched, _ = c.Matches(cold, testFilter.Subscription, reader, now)
+	assert.False(t, matched, "the filters should be read again after a reset")
+
+	delete(reader.filters, testFilter.Subscription)
+	matched, _ = c.Matches(cold, testFilter.Subscription, reader, now.Add(time.Minute))
+	assert.True(t, matched, "the expired filter should be read again")
+	assert.Equal(t, 4, reader.reads)
+}
+
+func TestMatchesReadFailure(t *testing.T) {
+	reader := &testReader{err: errors.New("connection refused")}
+	c := NewCache(time.Minute)
+
+	_, err := c.Matches(hot, testFilter.Subscription, reader, time.Now())
+	assert.Error(t, err)
+	_, err = c.Matches(hot, testFilter.Subscription, reader, time.Now())
+	assert.Error(t, err)
+	assert.Equal(t, 2, reader.reads, "failed reads should not be cached")
+}
+
+func TestNilCacheReadsEveryTime(t *testing.T) {
+	reader := &testReader{filters: map[string]models.SubscriptionFilter{testFilter.Subscription: testFilter}}
+	var c *Cache
+
+	for i := 0; i < 2; i++ {
+		matched, err := c.Matches(hot, testFilter.Subscription, reader, time.Now())
+		require.NoError(t, err)
+		assert.True(t, matched)
+	}
+	assert.Equal(t, 2, reader.reads)
+	assert.NotPanics(t, func() {
+		c.Invalidate(testFilter.Subscription)
+		c.Reset()
+	})
+}
@@ -16,10 +16,21 @@ package notifications
 
 import (
 	"context"
+	"fmt"
 	"sync"
+	"time"
 
+	"github.com/edgexfoundry/edgex-go/internal/pkg/mtls"
+	notificationsContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/filtercache"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/ratemonitor"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/sender"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/suppressor"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
 	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
 
 	"github.com/gorilla/mux"
 )
@@ -37,7 +48,115 @@ func NewBootstrap(router *mux.Router) *Bootstrap {
 }
 
 // BootstrapHandler fulfills the BootstrapHandler contract and performs initialization for the notifications service.
-func (b *Bootstrap) BootstrapHandler(_ context.Context, _ *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
+func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, startupTimer startup.Timer, dic *di.Container) bool {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	configuration := notificationsContainer.ConfigurationFrom(dic.Get)
+
+	credentials := bootstrapContainer.CredentialsProviderFrom(dic.Get)
+	senders := sender.NewRegistry()
+	senders.Register(models.Email, sender.NewEmailSender(configuration, credentials, lc))
+	senders.Register(models.Rest, sender.NewRESTSender(
+		sender.NewSigningSecrets(configuration, credentials),
+		mtls.CertificatesFrom(dic.Get).Client(0),
+		lc))
+	senders.Register(sender.Slack, sender.NewSlackSender(configuration, credentials, lc))
+	senders.Register(sender.Teams, sender.NewTeamsSender(configuration, credentials, lc))
+	senders.Register(sender.Sms, sender.NewSmsSender(configuration, credentials, lc))
+	dic.Update(di.ServiceConstructorMap{
+		notificationsContainer.ChannelSendersName: func(get di.Get) interface{} {
+			return senders
+		},
+	})
+
+	filters := filtercache.NewCache(filtercache.DefaultTTL)
+	dic.Update(di.ServiceConstructorMap{
+		notificationsContainer.FilterCacheName: func(get di.Get) interface{} {
+			return filters
+		},
+	})
+
+	if configuration.RateMonitor.Enabled {
+		window, err := time.ParseDuration(configuration.RateMonitor.Window)
+		if err != nil || window <= 0 {
+			lc.Error(fmt.Sprintf("invalid rate monitor window '%s'", configuration.RateMonitor.Window))
+			return false
+		}
+
+		monitor := ratemonitor.NewMonitor(ratemonitor.Config{
+			Window:          window,
+			SmoothingFactor: configuration.RateMonitor.SmoothingFactor,
+			StormFactor:     configuration.RateMonitor.StormFactor,
+			MinBaseline:     configuration.RateMonitor.MinBaseline,
+			WarmupWindows:   configuration.RateMonitor.WarmupWindows,
+		})
+		dic.Update(di.ServiceConstructorMap{
+			notificationsContainer.RateMonitorName: func(get di.Get) interface{} {
+				return monitor
+			},
+		})
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			monitorRates(ctx, monitor, dic)
+		}()
+	}
+
+	if configuration.Suppression.Enabled {
+		window, err := time.ParseDuration(configuration.Suppression.Window)
+		if err != nil || window <= 0 {
+			lc.Error(fmt.Sprintf("invalid suppression window '%s'", configuration.Suppression.Window))
+			return false
+		}
+
+		s := suppressor.NewSuppressor(window)
+		dic.Update(di.ServiceConstructorMap{
+			notificationsContainer.SuppressorName: func(get di.Get) interface{} {
+				return s
+			},
+		})
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			monitorSuppression(ctx, s, dic)
+		}()
+	}
+
+	if configuration.Retention.Enabled {
+		interval, err := time.ParseDuration(configuration.Retention.Interval)
+		if err != nil || interval <= 0 {
+			lc.Error(fmt.Sprintf("invalid retention interval '%s'", configuration.Retention.Interval))
+			return false
+		}
+		policy, err := newRetentionPolicy(configuration.Retention)
+		if err != nil {
+			lc.Error(err.Error())
+			return false
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			monitorRetention(ctx, interval, policy, dic)
+		}()
+	}
+
+	interval, err := time.ParseDuration(configuration.Escalation.CheckInterval)
+	if err != nil || interval <= 0 {
+		lc.Error(fmt.Sprintf("invalid escalation check interval '%s'", configuration.Escalation.CheckInterval))
+		return false
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		monitorEscalations(ctx, interval, dic)
+	}()
+
+	if configuration.MessageQueue.Enabled && !connectMessageBus(ctx, wg, startupTimer, dic) {
+		return false
+	}
+
 	loadRestRoutes(b.router, dic)
 	return true
 }
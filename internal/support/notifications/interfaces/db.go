@@ -17,6 +17,9 @@ package interfaces
 
 import (
 	"errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
 	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
 )
 
@@ -67,13 +70,76 @@ type DBClient interface {
 	GetTransmissionsByStart(start int64, limit int) ([]contract.Transmission, error)
 	GetTransmissionsByEnd(end int64, limit int) ([]contract.Transmission, error)
 	GetTransmissionsByStatus(limit int, status contract.TransmissionStatus) ([]contract.Transmission, error)
+	GetTransmissionsByStatusAndTimeRange(status contract.TransmissionStatus, start int64, end int64, limit int) ([]contract.Transmission, error)
 	AddTransmission(t contract.Transmission) (string, error)
 	UpdateTransmission(t contract.Transmission) error
 	DeleteTransmission(age int64, status contract.TransmissionStatus) error
+	GetNotificationBacklog() (models.NotificationBacklog, error)
+
+	// Severity Mappings
+	GetSeverityMappings() ([]models.SeverityMapping, error)
+	GetSeverityMappingByName(name string) (models.SeverityMapping, error)
+	AddSeverityMapping(m models.SeverityMapping) (string, error)
+	UpdateSeverityMapping(m models.SeverityMapping) error
+	DeleteSeverityMappingByName(name string) error
+
+	// Subscription Filters
+	GetSubscriptionFilterBySlug(slug string) (models.SubscriptionFilter, error)
+	AddSubscriptionFilter(f models.SubscriptionFilter) (string, error)
+	UpdateSubscriptionFilter(f models.SubscriptionFilter) error
+	DeleteSubscriptionFilterBySlug(slug string) error
+
+	// Templates
+	GetTemplates() ([]models.Template, error)
+	GetTemplateByName(name string) (models.Template, error)
+	AddTemplate(t models.Template) (string, error)
+	UpdateTemplate(t models.Template) error
+	DeleteTemplateByName(name string) error
+	GetSubscriptionTemplate(slug string) (string, error)
+	SetSubscriptionTemplate(slug string, template string) error
+	DeleteSubscriptionTemplate(slug string) error
+
+	// Escalations
+	GetEscalationPolicyBySlug(slug string) (models.EscalationPolicy, error)
+	AddEscalationPolicy(p models.EscalationPolicy) (string, error)
+	UpdateEscalationPolicy(p models.EscalationPolicy) error
+	DeleteEscalationPolicyBySlug(slug string) error
+	AddEscalation(e models.Escalation) (string, error)
+	UpdateEscalation(e models.Escalation) error
+	GetEscalationByTransmission(id string) (models.Escalation, error)
+	GetDueEscalations(deadline int64) ([]models.Escalation, error)
+
+	// Retry Policies
+	GetRetryPolicyBySlug(slug string) (models.RetryPolicy, error)
+	AddRetryPolicy(p models.RetryPolicy) (string, error)
+	UpdateRetryPolicy(p models.RetryPolicy) error
+	DeleteRetryPolicyBySlug(slug string) error
+
+	// Routing Policies
+	GetRoutingPolicyBySlug(slug string) (models.RoutingPolicy, error)
+	AddRoutingPolicy(p models.RoutingPolicy) (string, error)
+	UpdateRoutingPolicy(p models.RoutingPolicy) error
+	DeleteRoutingPolicyBySlug(slug string) error
+
+	// Delivery Receipts
+	AddDeliveryReceipt(r models.DeliveryReceipt) (string, error)
+	GetDeliveryReceiptByTransmission(id string) (models.DeliveryReceipt, error)
+
+	// Transmission Histories
+	AddTransmissionHistoryRecord(id string, r contract.TransmissionRecord) error
+	GetTransmissionHistory(id string) ([]contract.TransmissionRecord, error)
+
+	// Resend Cancellations
+	SetResendCancellation(slug string, cancelled int64) error
+	GetResendCancellation(slug string) (int64, error)
 
 	// General Cleanup
 	Cleanup() error
 	CleanupOld(age int) error
+
+	// Retention
+	DeleteProcessedNotificationsByModifiedRange(severity contract.NotificationsSeverity, start int64, end int64) (int, error)
+	DeleteTransmissionsByModifiedRange(start int64, end int64) (int, error)
 }
 
 type DBConfiguration struct {
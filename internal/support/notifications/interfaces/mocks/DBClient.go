@@ -4,12 +4,76 @@ package mocks
 
 import mock "github.com/stretchr/testify/mock"
 import models "github.com/edgexfoundry/go-mod-core-contracts/models"
+import notificationsmodels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
 
 // DBClient is an autogenerated mock type for the DBClient type
 type DBClient struct {
 	mock.Mock
 }
 
+// AddDeliveryReceipt provides a mock function with given fields: r
+func (_m *DBClient) AddDeliveryReceipt(r notificationsmodels.DeliveryReceipt) (string, error) {
+	ret := _m.Called(r)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(notificationsmodels.DeliveryReceipt) string); ok {
+		r0 = rf(r)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(notificationsmodels.DeliveryReceipt) error); ok {
+		r1 = rf(r)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddEscalation provides a mock function with given fields: e
+func (_m *DBClient) AddEscalation(e notificationsmodels.Escalation) (string, error) {
+	ret := _m.Called(e)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(notificationsmodels.Escalation) string); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(notificationsmodels.Escalation) error); ok {
+		r1 = rf(e)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddEscalationPolicy provides a mock function with given fields: p
+func (_m *DBClient) AddEscalationPolicy(p notificationsmodels.EscalationPolicy) (string, error) {
+	ret := _m.Called(p)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(notificationsmodels.EscalationPolicy) string); ok {
+		r0 = rf(p)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(notificationsmodels.EscalationPolicy) error); ok {
+		r1 = rf(p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AddNotification provides a mock function with given fields: n
 func (_m *DBClient) AddNotification(n models.Notification) (string, error) {
 	ret := _m.Called(n)
@@ -31,6 +95,69 @@ func (_m *DBClient) AddNotification(n models.Notification) (string, error) {
 	return r0, r1
 }
 
+// AddRetryPolicy provides a mock function with given fields: p
+func (_m *DBClient) AddRetryPolicy(p notificationsmodels.RetryPolicy) (string, error) {
+	ret := _m.Called(p)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(notificationsmodels.RetryPolicy) string); ok {
+		r0 = rf(p)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(notificationsmodels.RetryPolicy) error); ok {
+		r1 = rf(p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddRoutingPolicy provides a mock function with given fields: p
+func (_m *DBClient) AddRoutingPolicy(p notificationsmodels.RoutingPolicy) (string, error) {
+	ret := _m.Called(p)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(notificationsmodels.RoutingPolicy) string); ok {
+		r0 = rf(p)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(notificationsmodels.RoutingPolicy) error); ok {
+		r1 = rf(p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddSeverityMapping provides a mock function with given fields: m
+func (_m *DBClient) AddSeverityMapping(m notificationsmodels.SeverityMapping) (string, error) {
+	ret := _m.Called(m)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(notificationsmodels.SeverityMapping) string); ok {
+		r0 = rf(m)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(notificationsmodels.SeverityMapping) error); ok {
+		r1 = rf(m)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AddSubscription provides a mock function with given fields: s
 func (_m *DBClient) AddSubscription(s models.Subscription) (string, error) {
 	ret := _m.Called(s)
@@ -52,6 +179,48 @@ func (_m *DBClient) AddSubscription(s models.Subscription) (string, error) {
 	return r0, r1
 }
 
+// AddSubscriptionFilter provides a mock function with given fields: f
+func (_m *DBClient) AddSubscriptionFilter(f notificationsmodels.SubscriptionFilter) (string, error) {
+	ret := _m.Called(f)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(notificationsmodels.SubscriptionFilter) string); ok {
+		r0 = rf(f)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(notificationsmodels.SubscriptionFilter) error); ok {
+		r1 = rf(f)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddTemplate provides a mock function with given fields: t
+func (_m *DBClient) AddTemplate(t notificationsmodels.Template) (string, error) {
+	ret := _m.Called(t)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(notificationsmodels.Template) string); ok {
+		r0 = rf(t)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(notificationsmodels.Template) error); ok {
+		r1 = rf(t)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AddTransmission provides a mock function with given fields: t
 func (_m *DBClient) AddTransmission(t models.Transmission) (string, error) {
 	ret := _m.Called(t)
@@ -73,6 +242,20 @@ func (_m *DBClient) AddTransmission(t models.Transmission) (string, error) {
 	return r0, r1
 }
 
+// AddTransmissionHistoryRecord provides a mock function with given fields: id, r
+func (_m *DBClient) AddTransmissionHistoryRecord(id string, r models.TransmissionRecord) error {
+	ret := _m.Called(id, r)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, models.TransmissionRecord) error); ok {
+		r0 = rf(id, r)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Cleanup provides a mock function with given fields:
 func (_m *DBClient) Cleanup() error {
 	ret := _m.Called()
@@ -106,6 +289,20 @@ func (_m *DBClient) CloseSession() {
 	_m.Called()
 }
 
+// DeleteEscalationPolicyBySlug provides a mock function with given fields: slug
+func (_m *DBClient) DeleteEscalationPolicyBySlug(slug string) error {
+	ret := _m.Called(slug)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(slug)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteNotificationById provides a mock function with given fields: id
 func (_m *DBClient) DeleteNotificationById(id string) error {
 	ret := _m.Called(id)
@@ -148,6 +345,69 @@ func (_m *DBClient) DeleteNotificationsOld(age int) error {
 	return r0
 }
 
+// DeleteProcessedNotificationsByModifiedRange provides a mock function with given fields: severity, start, end
+func (_m *DBClient) DeleteProcessedNotificationsByModifiedRange(severity models.NotificationsSeverity, start int64, end int64) (int, error) {
+	ret := _m.Called(severity, start, end)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(models.NotificationsSeverity, int64, int64) int); ok {
+		r0 = rf(severity, start, end)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(models.NotificationsSeverity, int64, int64) error); ok {
+		r1 = rf(severity, start, end)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteRetryPolicyBySlug provides a mock function with given fields: slug
+func (_m *DBClient) DeleteRetryPolicyBySlug(slug string) error {
+	ret := _m.Called(slug)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(slug)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteRoutingPolicyBySlug provides a mock function with given fields: slug
+func (_m *DBClient) DeleteRoutingPolicyBySlug(slug string) error {
+	ret := _m.Called(slug)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(slug)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteSeverityMappingByName provides a mock function with given fields: name
+func (_m *DBClient) DeleteSeverityMappingByName(name string) error {
+	ret := _m.Called(name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteSubscriptionById provides a mock function with given fields: id
 func (_m *DBClient) DeleteSubscriptionById(id string) error {
 	ret := _m.Called(id)
@@ -176,6 +436,48 @@ func (_m *DBClient) DeleteSubscriptionBySlug(id string) error {
 	return r0
 }
 
+// DeleteSubscriptionFilterBySlug provides a mock function with given fields: slug
+func (_m *DBClient) DeleteSubscriptionFilterBySlug(slug string) error {
+	ret := _m.Called(slug)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(slug)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteSubscriptionTemplate provides a mock function with given fields: slug
+func (_m *DBClient) DeleteSubscriptionTemplate(slug string) error {
+	ret := _m.Called(slug)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(slug)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteTemplateByName provides a mock function with given fields: name
+func (_m *DBClient) DeleteTemplateByName(name string) error {
+	ret := _m.Called(name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteTransmission provides a mock function with given fields: age, status
 func (_m *DBClient) DeleteTransmission(age int64, status models.TransmissionStatus) error {
 	ret := _m.Called(age, status)
@@ -190,6 +492,113 @@ func (_m *DBClient) DeleteTransmission(age int64, status models.TransmissionStat
 	return r0
 }
 
+// DeleteTransmissionsByModifiedRange provides a mock function with given fields: start, end
+func (_m *DBClient) DeleteTransmissionsByModifiedRange(start int64, end int64) (int, error) {
+	ret := _m.Called(start, end)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(int64, int64) int); ok {
+		r0 = rf(start, end)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = rf(start, end)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDeliveryReceiptByTransmission provides a mock function with given fields: id
+func (_m *DBClient) GetDeliveryReceiptByTransmission(id string) (notificationsmodels.DeliveryReceipt, error) {
+	ret := _m.Called(id)
+
+	var r0 notificationsmodels.DeliveryReceipt
+	if rf, ok := ret.Get(0).(func(string) notificationsmodels.DeliveryReceipt); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(notificationsmodels.DeliveryReceipt)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDueEscalations provides a mock function with given fields: deadline
+func (_m *DBClient) GetDueEscalations(deadline int64) ([]notificationsmodels.Escalation, error) {
+	ret := _m.Called(deadline)
+
+	var r0 []notificationsmodels.Escalation
+	if rf, ok := ret.Get(0).(func(int64) []notificationsmodels.Escalation); ok {
+		r0 = rf(deadline)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]notificationsmodels.Escalation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(deadline)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEscalationByTransmission provides a mock function with given fields: id
+func (_m *DBClient) GetEscalationByTransmission(id string) (notificationsmodels.Escalation, error) {
+	ret := _m.Called(id)
+
+	var r0 notificationsmodels.Escalation
+	if rf, ok := ret.Get(0).(func(string) notificationsmodels.Escalation); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(notificationsmodels.Escalation)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEscalationPolicyBySlug provides a mock function with given fields: slug
+func (_m *DBClient) GetEscalationPolicyBySlug(slug string) (notificationsmodels.EscalationPolicy, error) {
+	ret := _m.Called(slug)
+
+	var r0 notificationsmodels.EscalationPolicy
+	if rf, ok := ret.Get(0).(func(string) notificationsmodels.EscalationPolicy); ok {
+		r0 = rf(slug)
+	} else {
+		r0 = ret.Get(0).(notificationsmodels.EscalationPolicy)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetNewNormalNotifications provides a mock function with given fields: limit
 func (_m *DBClient) GetNewNormalNotifications(limit int) ([]models.Notification, error) {
 	ret := _m.Called(limit)
@@ -236,6 +645,27 @@ func (_m *DBClient) GetNewNotifications(limit int) ([]models.Notification, error
 	return r0, r1
 }
 
+// GetNotificationBacklog provides a mock function with given fields:
+func (_m *DBClient) GetNotificationBacklog() (notificationsmodels.NotificationBacklog, error) {
+	ret := _m.Called()
+
+	var r0 notificationsmodels.NotificationBacklog
+	if rf, ok := ret.Get(0).(func() notificationsmodels.NotificationBacklog); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(notificationsmodels.NotificationBacklog)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetNotificationById provides a mock function with given fields: id
 func (_m *DBClient) GetNotificationById(id string) (models.Notification, error) {
 	ret := _m.Called(id)
@@ -292,8 +722,123 @@ func (_m *DBClient) GetNotificationBySlug(slug string) (models.Notification, err
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(slug)
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetNotifications provides a mock function with given fields:
+func (_m *DBClient) GetNotifications() ([]models.Notification, error) {
+	ret := _m.Called()
+
+	var r0 []models.Notification
+	if rf, ok := ret.Get(0).(func() []models.Notification); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Notification)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetNotificationsByEnd provides a mock function with given fields: end, limit
+func (_m *DBClient) GetNotificationsByEnd(end int64, limit int) ([]models.Notification, error) {
+	ret := _m.Called(end, limit)
+
+	var r0 []models.Notification
+	if rf, ok := ret.Get(0).(func(int64, int) []models.Notification); ok {
+		r0 = rf(end, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Notification)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int) error); ok {
+		r1 = rf(end, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetNotificationsByLabels provides a mock function with given fields: labels, limit
+func (_m *DBClient) GetNotificationsByLabels(labels []string, limit int) ([]models.Notification, error) {
+	ret := _m.Called(labels, limit)
+
+	var r0 []models.Notification
+	if rf, ok := ret.Get(0).(func([]string, int) []models.Notification); ok {
+		r0 = rf(labels, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Notification)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]string, int) error); ok {
+		r1 = rf(labels, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetNotificationsByStart provides a mock function with given fields: start, limit
+func (_m *DBClient) GetNotificationsByStart(start int64, limit int) ([]models.Notification, error) {
+	ret := _m.Called(start, limit)
+
+	var r0 []models.Notification
+	if rf, ok := ret.Get(0).(func(int64, int) []models.Notification); ok {
+		r0 = rf(start, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Notification)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int) error); ok {
+		r1 = rf(start, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetNotificationsByStartEnd provides a mock function with given fields: start, end, limit
+func (_m *DBClient) GetNotificationsByStartEnd(start int64, end int64, limit int) ([]models.Notification, error) {
+	ret := _m.Called(start, end, limit)
+
+	var r0 []models.Notification
+	if rf, ok := ret.Get(0).(func(int64, int64, int) []models.Notification); ok {
+		r0 = rf(start, end, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Notification)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int64, int) error); ok {
+		r1 = rf(start, end, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -301,22 +846,20 @@ func (_m *DBClient) GetNotificationBySlug(slug string) (models.Notification, err
 	return r0, r1
 }
 
-// GetNotifications provides a mock function with given fields:
-func (_m *DBClient) GetNotifications() ([]models.Notification, error) {
-	ret := _m.Called()
+// GetResendCancellation provides a mock function with given fields: slug
+func (_m *DBClient) GetResendCancellation(slug string) (int64, error) {
+	ret := _m.Called(slug)
 
-	var r0 []models.Notification
-	if rf, ok := ret.Get(0).(func() []models.Notification); ok {
-		r0 = rf()
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = rf(slug)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Notification)
-		}
+		r0 = ret.Get(0).(int64)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(slug)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -324,22 +867,20 @@ func (_m *DBClient) GetNotifications() ([]models.Notification, error) {
 	return r0, r1
 }
 
-// GetNotificationsByEnd provides a mock function with given fields: end, limit
-func (_m *DBClient) GetNotificationsByEnd(end int64, limit int) ([]models.Notification, error) {
-	ret := _m.Called(end, limit)
+// GetRetryPolicyBySlug provides a mock function with given fields: slug
+func (_m *DBClient) GetRetryPolicyBySlug(slug string) (notificationsmodels.RetryPolicy, error) {
+	ret := _m.Called(slug)
 
-	var r0 []models.Notification
-	if rf, ok := ret.Get(0).(func(int64, int) []models.Notification); ok {
-		r0 = rf(end, limit)
+	var r0 notificationsmodels.RetryPolicy
+	if rf, ok := ret.Get(0).(func(string) notificationsmodels.RetryPolicy); ok {
+		r0 = rf(slug)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Notification)
-		}
+		r0 = ret.Get(0).(notificationsmodels.RetryPolicy)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int64, int) error); ok {
-		r1 = rf(end, limit)
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(slug)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -347,22 +888,20 @@ func (_m *DBClient) GetNotificationsByEnd(end int64, limit int) ([]models.Notifi
 	return r0, r1
 }
 
-// GetNotificationsByLabels provides a mock function with given fields: labels, limit
-func (_m *DBClient) GetNotificationsByLabels(labels []string, limit int) ([]models.Notification, error) {
-	ret := _m.Called(labels, limit)
+// GetRoutingPolicyBySlug provides a mock function with given fields: slug
+func (_m *DBClient) GetRoutingPolicyBySlug(slug string) (notificationsmodels.RoutingPolicy, error) {
+	ret := _m.Called(slug)
 
-	var r0 []models.Notification
-	if rf, ok := ret.Get(0).(func([]string, int) []models.Notification); ok {
-		r0 = rf(labels, limit)
+	var r0 notificationsmodels.RoutingPolicy
+	if rf, ok := ret.Get(0).(func(string) notificationsmodels.RoutingPolicy); ok {
+		r0 = rf(slug)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Notification)
-		}
+		r0 = ret.Get(0).(notificationsmodels.RoutingPolicy)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func([]string, int) error); ok {
-		r1 = rf(labels, limit)
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(slug)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -370,22 +909,20 @@ func (_m *DBClient) GetNotificationsByLabels(labels []string, limit int) ([]mode
 	return r0, r1
 }
 
-// GetNotificationsByStart provides a mock function with given fields: start, limit
-func (_m *DBClient) GetNotificationsByStart(start int64, limit int) ([]models.Notification, error) {
-	ret := _m.Called(start, limit)
+// GetSeverityMappingByName provides a mock function with given fields: name
+func (_m *DBClient) GetSeverityMappingByName(name string) (notificationsmodels.SeverityMapping, error) {
+	ret := _m.Called(name)
 
-	var r0 []models.Notification
-	if rf, ok := ret.Get(0).(func(int64, int) []models.Notification); ok {
-		r0 = rf(start, limit)
+	var r0 notificationsmodels.SeverityMapping
+	if rf, ok := ret.Get(0).(func(string) notificationsmodels.SeverityMapping); ok {
+		r0 = rf(name)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Notification)
-		}
+		r0 = ret.Get(0).(notificationsmodels.SeverityMapping)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int64, int) error); ok {
-		r1 = rf(start, limit)
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -393,22 +930,22 @@ func (_m *DBClient) GetNotificationsByStart(start int64, limit int) ([]models.No
 	return r0, r1
 }
 
-// GetNotificationsByStartEnd provides a mock function with given fields: start, end, limit
-func (_m *DBClient) GetNotificationsByStartEnd(start int64, end int64, limit int) ([]models.Notification, error) {
-	ret := _m.Called(start, end, limit)
+// GetSeverityMappings provides a mock function with given fields:
+func (_m *DBClient) GetSeverityMappings() ([]notificationsmodels.SeverityMapping, error) {
+	ret := _m.Called()
 
-	var r0 []models.Notification
-	if rf, ok := ret.Get(0).(func(int64, int64, int) []models.Notification); ok {
-		r0 = rf(start, end, limit)
+	var r0 []notificationsmodels.SeverityMapping
+	if rf, ok := ret.Get(0).(func() []notificationsmodels.SeverityMapping); ok {
+		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Notification)
+			r0 = ret.Get(0).([]notificationsmodels.SeverityMapping)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int64, int64, int) error); ok {
-		r1 = rf(start, end, limit)
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -550,6 +1087,48 @@ func (_m *DBClient) GetSubscriptionBySlug(slug string) (models.Subscription, err
 	return r0, r1
 }
 
+// GetSubscriptionFilterBySlug provides a mock function with given fields: slug
+func (_m *DBClient) GetSubscriptionFilterBySlug(slug string) (notificationsmodels.SubscriptionFilter, error) {
+	ret := _m.Called(slug)
+
+	var r0 notificationsmodels.SubscriptionFilter
+	if rf, ok := ret.Get(0).(func(string) notificationsmodels.SubscriptionFilter); ok {
+		r0 = rf(slug)
+	} else {
+		r0 = ret.Get(0).(notificationsmodels.SubscriptionFilter)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSubscriptionTemplate provides a mock function with given fields: slug
+func (_m *DBClient) GetSubscriptionTemplate(slug string) (string, error) {
+	ret := _m.Called(slug)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(slug)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetSubscriptions provides a mock function with given fields:
 func (_m *DBClient) GetSubscriptions() ([]models.Subscription, error) {
 	ret := _m.Called()
@@ -573,6 +1152,50 @@ func (_m *DBClient) GetSubscriptions() ([]models.Subscription, error) {
 	return r0, r1
 }
 
+// GetTemplateByName provides a mock function with given fields: name
+func (_m *DBClient) GetTemplateByName(name string) (notificationsmodels.Template, error) {
+	ret := _m.Called(name)
+
+	var r0 notificationsmodels.Template
+	if rf, ok := ret.Get(0).(func(string) notificationsmodels.Template); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(notificationsmodels.Template)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTemplates provides a mock function with given fields:
+func (_m *DBClient) GetTemplates() ([]notificationsmodels.Template, error) {
+	ret := _m.Called()
+
+	var r0 []notificationsmodels.Template
+	if rf, ok := ret.Get(0).(func() []notificationsmodels.Template); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]notificationsmodels.Template)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTransmissionById provides a mock function with given fields: id
 func (_m *DBClient) GetTransmissionById(id string) (models.Transmission, error) {
 	ret := _m.Called(id)
@@ -594,6 +1217,29 @@ func (_m *DBClient) GetTransmissionById(id string) (models.Transmission, error)
 	return r0, r1
 }
 
+// GetTransmissionHistory provides a mock function with given fields: id
+func (_m *DBClient) GetTransmissionHistory(id string) ([]models.TransmissionRecord, error) {
+	ret := _m.Called(id)
+
+	var r0 []models.TransmissionRecord
+	if rf, ok := ret.Get(0).(func(string) []models.TransmissionRecord); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.TransmissionRecord)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTransmissionsByEnd provides a mock function with given fields: end, limit
 func (_m *DBClient) GetTransmissionsByEnd(end int64, limit int) ([]models.Transmission, error) {
 	ret := _m.Called(end, limit)
@@ -732,6 +1378,29 @@ func (_m *DBClient) GetTransmissionsByStatus(limit int, status models.Transmissi
 	return r0, r1
 }
 
+// GetTransmissionsByStatusAndTimeRange provides a mock function with given fields: status, start, end, limit
+func (_m *DBClient) GetTransmissionsByStatusAndTimeRange(status models.TransmissionStatus, start int64, end int64, limit int) ([]models.Transmission, error) {
+	ret := _m.Called(status, start, end, limit)
+
+	var r0 []models.Transmission
+	if rf, ok := ret.Get(0).(func(models.TransmissionStatus, int64, int64, int) []models.Transmission); ok {
+		r0 = rf(status, start, end, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Transmission)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(models.TransmissionStatus, int64, int64, int) error); ok {
+		r1 = rf(status, start, end, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // MarkNotificationProcessed provides a mock function with given fields: n
 func (_m *DBClient) MarkNotificationProcessed(n models.Notification) error {
 	ret := _m.Called(n)
@@ -746,6 +1415,62 @@ func (_m *DBClient) MarkNotificationProcessed(n models.Notification) error {
 	return r0
 }
 
+// SetResendCancellation provides a mock function with given fields: slug, cancelled
+func (_m *DBClient) SetResendCancellation(slug string, cancelled int64) error {
+	ret := _m.Called(slug, cancelled)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64) error); ok {
+		r0 = rf(slug, cancelled)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetSubscriptionTemplate provides a mock function with given fields: slug, template
+func (_m *DBClient) SetSubscriptionTemplate(slug string, template string) error {
+	ret := _m.Called(slug, template)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(slug, template)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateEscalation provides a mock function with given fields: e
+func (_m *DBClient) UpdateEscalation(e notificationsmodels.Escalation) error {
+	ret := _m.Called(e)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(notificationsmodels.Escalation) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateEscalationPolicy provides a mock function with given fields: p
+func (_m *DBClient) UpdateEscalationPolicy(p notificationsmodels.EscalationPolicy) error {
+	ret := _m.Called(p)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(notificationsmodels.EscalationPolicy) error); ok {
+		r0 = rf(p)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateNotification provides a mock function with given fields: n
 func (_m *DBClient) UpdateNotification(n models.Notification) error {
 	ret := _m.Called(n)
@@ -760,6 +1485,48 @@ func (_m *DBClient) UpdateNotification(n models.Notification) error {
 	return r0
 }
 
+// UpdateRetryPolicy provides a mock function with given fields: p
+func (_m *DBClient) UpdateRetryPolicy(p notificationsmodels.RetryPolicy) error {
+	ret := _m.Called(p)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(notificationsmodels.RetryPolicy) error); ok {
+		r0 = rf(p)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateRoutingPolicy provides a mock function with given fields: p
+func (_m *DBClient) UpdateRoutingPolicy(p notificationsmodels.RoutingPolicy) error {
+	ret := _m.Called(p)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(notificationsmodels.RoutingPolicy) error); ok {
+		r0 = rf(p)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateSeverityMapping provides a mock function with given fields: m
+func (_m *DBClient) UpdateSeverityMapping(m notificationsmodels.SeverityMapping) error {
+	ret := _m.Called(m)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(notificationsmodels.SeverityMapping) error); ok {
+		r0 = rf(m)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateSubscription provides a mock function with given fields: s
 func (_m *DBClient) UpdateSubscription(s models.Subscription) error {
 	ret := _m.Called(s)
@@ -774,6 +1541,34 @@ func (_m *DBClient) UpdateSubscription(s models.Subscription) error {
 	return r0
 }
 
+// UpdateSubscriptionFilter provides a mock function with given fields: f
+func (_m *DBClient) UpdateSubscriptionFilter(f notificationsmodels.SubscriptionFilter) error {
+	ret := _m.Called(f)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(notificationsmodels.SubscriptionFilter) error); ok {
+		r0 = rf(f)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateTemplate provides a mock function with given fields: t
+func (_m *DBClient) UpdateTemplate(t notificationsmodels.Template) error {
+	ret := _m.Called(t)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(notificationsmodels.Template) error); ok {
+		r0 = rf(t)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateTransmission provides a mock function with given fields: t
 func (_m *DBClient) UpdateTransmission(t models.Transmission) error {
 	ret := _m.Called(t)
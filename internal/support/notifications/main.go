@@ -27,6 +27,13 @@ import (
 	"github.com/edgexfoundry/edgex-go"
 	"github.com/edgexfoundry/edgex-go/internal"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers/database"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers/secret"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/gateway"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/mtls"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/readygate"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/secretref"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/selftest"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/sysevent"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/telemetry"
 	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
@@ -35,7 +42,6 @@ import (
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/flags"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/handlers/httpserver"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/handlers/message"
-	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/handlers/secret"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/handlers/testing"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/interfaces"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
@@ -67,6 +73,7 @@ func Main(ctx context.Context, cancel context.CancelFunc, router *mux.Router, re
 	})
 
 	httpServer := httpserver.NewBootstrap(router, true)
+	readyGate := readygate.NewBootstrap(clients.SupportNotificationsServiceKey, &configuration.ReadyGate)
 
 	bootstrap.Run(
 		ctx,
@@ -79,10 +86,17 @@ func Main(ctx context.Context, cancel context.CancelFunc, router *mux.Router, re
 		dic,
 		[]interfaces.BootstrapHandler{
 			secret.NewSecret().BootstrapHandler,
+			secretref.NewBootstrap(configuration).BootstrapHandler,
+			readyGate.WaitHandler,
+			mtls.NewBootstrap(&configuration.Service, &configuration.SecretStore, &configuration.MutualTLS).BootstrapHandler,
 			database.NewDatabase(httpServer, configuration).BootstrapHandler,
 			NewBootstrap(router).BootstrapHandler,
+			gateway.NewBootstrap(clients.SupportNotificationsServiceKey, configuration, &configuration.Gateway).BootstrapHandler,
 			telemetry.BootstrapHandler,
+			selftest.NewBootstrap(router, &configuration.SelfTest).BootstrapHandler,
+			sysevent.NewBootstrap(router, clients.SupportNotificationsServiceKey, &configuration.Writable, &configuration.SystemEvents).BootstrapHandler,
 			httpServer.BootstrapHandler,
+			readyGate.AnnounceHandler,
 			message.NewBootstrap(clients.SupportNotificationsServiceKey, edgex.Version).BootstrapHandler,
 			testing.NewBootstrap(httpServer, readyStream).BootstrapHandler,
 		})
@@ -0,0 +1,229 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/messaging"
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	notificationsContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/filtercache"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/ratemonitor"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/sender"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/suppressor"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	msgTypes "github.com/edgexfoundry/go-mod-messaging/pkg/types"
+)
+
+// connectMessageBus connects to the message bus configured for notifications and starts listening for them on every
+// topic configured.
+func connectMessageBus(ctx context.Context, wg *sync.WaitGroup, startupTimer startup.Timer, dic *di.Container) bool {
+	configuration := notificationsContainer.ConfigurationFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	messageQueue := configuration.MessageQueue
+
+	topics := messageQueue.Topics()
+	if len(topics) == 0 {
+		lc.Error("no topic configured to receive notifications from the message bus")
+		return false
+	}
+
+	// For Redis Streams MessageBus, we reuse the Redis instance running for the DB, which may have a password,
+	// so we need to get and use the DB credentials for the MessageBus connection.
+	if messageQueue.Type == "redisstreams" {
+		credentials, err := bootstrapContainer.CredentialsProviderFrom(dic.Get).GetDatabaseCredentials(configuration.Databases["Primary"])
+		if err != nil {
+			lc.Error(fmt.Sprintf("Error getting DB creds for RedisStreams: %s", err.Error()))
+			return false
+		}
+
+		if messageQueue.Optional == nil {
+			messageQueue.Optional = make(map[string]string)
+		}
+		messageQueue.Optional["Password"] = credentials.Password
+	} else if messageQueue.SecretPath != "" {
+		// The credentials provider returns the secret stored under the path given as database type when security is
+		// enabled and the username and password passed along, taken from the configuration, otherwise.
+		credentials, err := bootstrapContainer.CredentialsProviderFrom(dic.Get).GetDatabaseCredentials(bootstrapConfig.Database{
+			Type:     messageQueue.SecretPath,
+			Username: messageQueue.Optional["Username"],
+			Password: messageQueue.Optional["Password"],
+		})
+		if err != nil {
+			lc.Error(fmt.Sprintf("unable to retrieve the message bus credentials: %s", err.Error()))
+			return false
+		}
+
+		if messageQueue.Optional == nil {
+			messageQueue.Optional = make(map[string]string)
+		}
+		messageQueue.Optional["Username"] = credentials.Username
+		messageQueue.Optional["Password"] = credentials.Password
+	}
+
+	msgClient, err := messaging.NewMessageClient(
+		msgTypes.MessageBusConfig{
+			SubscribeHost: msgTypes.HostInfo{
+				Host:     messageQueue.Host,
+				Port:     messageQueue.Port,
+				Protocol: messageQueue.Protocol,
+			},
+			Type:     messageQueue.Type,
+			Optional: messageQueue.Optional,
+		})
+	if err != nil {
+		lc.Error(fmt.Sprintf("failed to create messaging client: %s", err.Error()))
+		return false
+	}
+
+	for startupTimer.HasNotElapsed() {
+		err = msgClient.Connect()
+		if err == nil {
+			break
+		}
+
+		lc.Warn(fmt.Sprintf("couldn't connect to message bus: %s", err.Error()))
+		startupTimer.SleepForInterval()
+	}
+
+	if err != nil {
+		lc.Error("failed to connect to message bus in allotted time")
+		return false
+	}
+
+	messages := make(chan msgTypes.MessageEnvelope)
+	messageErrors := make(chan error)
+	topicChannels := make([]msgTypes.TopicChannel, len(topics))
+	for i, topic := range topics {
+		topicChannels[i] = msgTypes.TopicChannel{Topic: topic, Messages: messages}
+	}
+	err = msgClient.Subscribe(topicChannels, messageErrors)
+	if err != nil {
+		lc.Error(fmt.Sprintf("failed to subscribe to topics '%s': %s", messageQueue.SubscribeTopics, err.Error()))
+		return false
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-ctx.Done():
+				if err := msgClient.Disconnect(); err != nil {
+					lc.Error("failed to disconnect from the Message Bus")
+					return
+				}
+				lc.Info("Message Bus disconnected")
+				return
+
+			case err := <-messageErrors:
+				lc.Error(fmt.Sprintf("failed to receive notification: %s", err.Error()))
+
+			case envelope := <-messages:
+				_ = handleNotificationMessage(
+					envelope,
+					lc,
+					notificationsContainer.DBClientFrom(dic.Get),
+					notificationsContainer.ChannelSendersFrom(dic.Get),
+					notificationsContainer.FilterCacheFrom(dic.Get),
+					*notificationsContainer.ConfigurationFrom(dic.Get),
+					notificationsContainer.RateMonitorFrom(dic.Get),
+					notificationsContainer.SuppressorFrom(dic.Get))
+			}
+		}
+	}()
+
+	lc.Info(fmt.Sprintf(
+		"Connected to %s Message Bus @ %s listening for notifications on '%s' topics",
+		messageQueue.Type,
+		messageQueue.URL(),
+		strings.Join(topics, "', '")))
+
+	return true
+}
+
+// severityMappingRequest is the severity mapping a notification published on the message bus may ask for, along with
+// the notification, the same way the REST API takes it in its query parameters.
+type severityMappingRequest struct {
+	Scheme   string `json:"severityscheme"`
+	External string `json:"externalseverity"`
+}
+
+// handleNotificationMessage stores the notification carried by the envelope, in the same JSON representation as the
+// REST API accepts, and distributes it the same way, unless it duplicates one distributed within the suppression
+// window. The severity and category of the notification are translated first when the payload names a severity
+// mapping and the external severity to translate.
+func handleNotificationMessage(
+	envelope msgTypes.MessageEnvelope,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	senders *sender.Registry,
+	filters *filtercache.Cache,
+	config notificationsConfig.ConfigurationStruct,
+	monitor *ratemonitor.Monitor,
+	suppressor *suppressor.Suppressor) error {
+
+	var n models.Notification
+	if err := json.Unmarshal(envelope.Payload, &n); err != nil {
+		lc.Error(fmt.Sprintf("failed to decode notification: %s", err.Error()), clients.CorrelationHeader, envelope.CorrelationID)
+		return err
+	}
+
+	var mapping severityMappingRequest
+	if err := json.Unmarshal(envelope.Payload, &mapping); err != nil {
+		lc.Error(fmt.Sprintf("failed to decode notification: %s", err.Error()), clients.CorrelationHeader, envelope.CorrelationID)
+		return err
+	}
+	if mapping.Scheme != "" {
+		if err := applySeverityMapping(&n, mapping.Scheme, mapping.External, dbClient); err != nil {
+			lc.Error(err.Error(), clients.CorrelationHeader, envelope.CorrelationID)
+			return err
+		}
+	}
+
+	lc.Info("Posting Notification: "+n.String(), clients.CorrelationHeader, envelope.CorrelationID)
+	n.Status = models.NotificationsStatus(models.New)
+	id, err := dbClient.AddNotification(n)
+	if err != nil {
+		lc.Error(err.Error(), clients.CorrelationHeader, envelope.CorrelationID)
+		return err
+	}
+	n.ID = id
+	monitor.Record(n)
+
+	n, err = dbClient.GetNotificationById(id)
+	if err != nil {
+		lc.Error(err.Error(), clients.CorrelationHeader, envelope.CorrelationID)
+		return err
+	}
+
+	if suppressor.Admit(n, db.MakeTimestamp()) {
+		return distributeAndMark(n, lc, dbClient, senders, filters, config)
+	}
+
+	lc.Info("Suppressing duplicate notification: "+n.Slug, clients.CorrelationHeader, envelope.CorrelationID)
+	err = dbClient.MarkNotificationProcessed(n)
+	if err != nil {
+		lc.Error(err.Error(), clients.CorrelationHeader, envelope.CorrelationID)
+	}
+	return err
+}
@@ -0,0 +1,195 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/suppressor"
+
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	msgTypes "github.com/edgexfoundry/go-mod-messaging/pkg/types"
+)
+
+func TestHandleNotificationMessage(t *testing.T) {
+	notification := createNotificationBySeverityLevel(contract.Normal)
+	payload, _ := json.Marshal(notification)
+	categories := []string{string(notification.Category)}
+	labels := []string{"first-label", "second-label"}
+
+	duplicates := suppressor.NewSuppressor(time.Hour)
+	duplicates.Admit(validateNotification(&notification), time.Now().UnixNano()/int64(time.Millisecond))
+
+	tests := []struct {
+		name        string
+		payload     []byte
+		dbMock      interfaces.DBClient
+		suppressor  *suppressor.Suppressor
+		expectError bool
+	}{
+		{
+			"ok",
+			payload,
+			createMockWithOutlines([]mockOutline{
+				{"AddNotification", []interface{}{validateNotification(&notification)}, []interface{}{notificationId, nil}},
+				{"GetNotificationById", []interface{}{notificationId}, []interface{}{notification, nil}},
+				{"GetSubscriptionByCategoriesLabels", []interface{}{categories, labels}, []interface{}{[]contract.Subscription{}, nil}},
+				{"MarkNotificationProcessed", []interface{}{validateNotification(&notification)}, []interface{}{nil}},
+			}),
+			nil,
+			false,
+		},
+		{
+			"suppressed duplicate",
+			payload,
+			createMockWithOutlines([]mockOutline{
+				{"AddNotification", []interface{}{validateNotification(&notification)}, []interface{}{notificationId, nil}},
+				{"GetNotificationById", []interface{}{notificationId}, []interface{}{notification, nil}},
+				{"MarkNotificationProcessed", []interface{}{validateNotification(&notification)}, []interface{}{nil}},
+			}),
+			duplicates,
+			false,
+		},
+		{
+			"invalid payload",
+			[]byte("{"),
+			createMockWithOutlines(nil),
+			nil,
+			true,
+		},
+		{
+			"add notification error",
+			payload,
+			createMockWithOutlines([]mockOutline{
+				{"AddNotification", []interface{}{validateNotification(&notification)}, []interface{}{"", testError}},
+			}),
+			nil,
+			true,
+		},
+		{
+			"get notification error",
+			payload,
+			createMockWithOutlines([]mockOutline{
+				{"AddNotification", []interface{}{validateNotification(&notification)}, []interface{}{notificationId, nil}},
+				{"GetNotificationById", []interface{}{notificationId}, []interface{}{contract.Notification{}, testError}},
+			}),
+			nil,
+			true,
+		},
+		{
+			"mark notification processed error",
+			payload,
+			createMockWithOutlines([]mockOutline{
+				{"AddNotification", []interface{}{validateNotification(&notification)}, []interface{}{notificationId, nil}},
+				{"GetNotificationById", []interface{}{notificationId}, []interface{}{notification, nil}},
+				{"GetSubscriptionByCategoriesLabels", []interface{}{categories, labels}, []interface{}{[]contract.Subscription{}, nil}},
+				{"MarkNotificationProcessed", []interface{}{validateNotification(&notification)}, []interface{}{testError}},
+			}),
+			nil,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := handleNotificationMessage(
+				msgTypes.NewMessageEnvelope(tt.payload, context.Background()),
+				logger.NewMockClient(),
+				tt.dbMock,
+				nil,
+				nil,
+				notificationsConfig.ConfigurationStruct{Service: bootstrapConfig.ServiceInfo{MaxResultCount: 5}},
+				nil,
+				tt.suppressor)
+			if tt.expectError && err == nil {
+				t.Error("expected an error")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("unexpected error: %s", err.Error())
+			}
+		})
+	}
+}
+
+func TestHandleNotificationMessageSeverityMapping(t *testing.T) {
+	notification := createNotificationBySeverityLevel(contract.Normal)
+	var fields map[string]interface{}
+	b, _ := json.Marshal(notification)
+	_ = json.Unmarshal(b, &fields)
+	fields[SEVERITYSCHEME] = "syslog"
+	fields[EXTERNALSEVERITY] = "emerg"
+	payload, _ := json.Marshal(fields)
+
+	mapped := notification
+	mapped.Severity = contract.Critical
+	mapped.Category = contract.Hwhealth
+	mapping := notificationsModels.SeverityMapping{
+		Name: "syslog",
+		Rules: []notificationsModels.SeverityRule{
+			{External: "EMERG", Severity: contract.Critical, Category: contract.Hwhealth},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		dbMock      interfaces.DBClient
+		expectError bool
+	}{
+		{
+			"mapped",
+			createMockWithOutlines([]mockOutline{
+				{"GetSeverityMappingByName", []interface{}{"syslog"}, []interface{}{mapping, nil}},
+				{"AddNotification", []interface{}{validateNotification(&mapped)}, []interface{}{notificationId, nil}},
+				{"GetNotificationById", []interface{}{notificationId}, []interface{}{mapped, nil}},
+				{"GetSubscriptionByCategoriesLabels",
+					[]interface{}{[]string{contract.Hwhealth}, mapped.Labels},
+					[]interface{}{[]contract.Subscription{}, nil}},
+				{"MarkNotificationProcessed", []interface{}{validateNotification(&mapped)}, []interface{}{nil}},
+			}),
+			false,
+		},
+		{
+			"unknown mapping",
+			createMockWithOutlines([]mockOutline{
+				{"GetSeverityMappingByName",
+					[]interface{}{"syslog"},
+					[]interface{}{notificationsModels.SeverityMapping{}, db.ErrNotFound}},
+			}),
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := handleNotificationMessage(
+				msgTypes.NewMessageEnvelope(payload, context.Background()),
+				logger.NewMockClient(),
+				tt.dbMock,
+				nil,
+				nil,
+				notificationsConfig.ConfigurationStruct{Service: bootstrapConfig.ServiceInfo{MaxResultCount: 5}},
+				nil,
+				nil)
+			if tt.expectError && err == nil {
+				t.Error("expected an error")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("unexpected error: %s", err.Error())
+			}
+		})
+	}
+}
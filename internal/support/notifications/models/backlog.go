@@ -0,0 +1,58 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// BacklogStatuses are the statuses the notifications are counted by in a backlog.
+var BacklogStatuses = []contract.NotificationsStatus{contract.New, contract.Processed, contract.Escalated}
+
+// NotificationBacklog describes the work left to the distribution of the notifications, so that monitoring can detect
+// a stuck pipeline: the notifications counted by status, the NEW ones being yet to be distributed, and the failed
+// transmissions, pending a resend, counted by channel type.
+type NotificationBacklog struct {
+	Notifications        map[contract.NotificationsStatus]int `json:"notifications"`
+	PendingTransmissions map[contract.ChannelType]int         `json:"pendingTransmissions"`
+	// OldestPending is when the oldest NEW notification or pending transmission was created, 0 when there is none.
+	OldestPending int64 `json:"oldestPending"`
+	// OldestPendingAge is how old, in milliseconds, the oldest pending notification or transmission is.
+	OldestPendingAge int64 `json:"oldestPendingAge"`
+}
+
+// NewNotificationBacklog returns an empty backlog, every status being counted.
+func NewNotificationBacklog() NotificationBacklog {
+	b := NotificationBacklog{
+		Notifications:        map[contract.NotificationsStatus]int{},
+		PendingTransmissions: map[contract.ChannelType]int{},
+	}
+	for _, status := range BacklogStatuses {
+		b.Notifications[status] = 0
+	}
+	return b
+}
+
+// AddPending accounts for a notification or transmission pending since created.
+func (b *NotificationBacklog) AddPending(created int64) {
+	if b.OldestPending == 0 || created < b.OldestPending {
+		b.OldestPending = created
+	}
+}
+
+// AddPendingTransmission counts the transmission pending a resend.
+func (b *NotificationBacklog) AddPendingTransmission(t contract.Transmission) {
+	b.PendingTransmissions[t.Channel.Type]++
+	b.AddPending(t.Created)
+}
+
+// Age sets the age of the oldest pending notification or transmission at now, in milliseconds.
+func (b *NotificationBacklog) Age(now int64) {
+	b.OldestPendingAge = 0
+	if b.OldestPending != 0 && now > b.OldestPending {
+		b.OldestPendingAge = now - b.OldestPending
+	}
+}
@@ -0,0 +1,32 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"testing"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationBacklog(t *testing.T) {
+	b := NewNotificationBacklog()
+	assert.Equal(t, map[contract.NotificationsStatus]int{contract.New: 0, contract.Processed: 0, contract.Escalated: 0}, b.Notifications)
+
+	b.Age(1000)
+	assert.Equal(t, int64(0), b.OldestPendingAge, "nothing is pending")
+
+	b.AddPending(500)
+	b.AddPendingTransmission(contract.Transmission{Timestamps: contract.Timestamps{Created: 300}, Channel: contract.Channel{Type: contract.Email}})
+	b.AddPendingTransmission(contract.Transmission{Timestamps: contract.Timestamps{Created: 700}, Channel: contract.Channel{Type: contract.Email}})
+	b.AddPendingTransmission(contract.Transmission{Timestamps: contract.Timestamps{Created: 600}, Channel: contract.Channel{Type: contract.Rest}})
+
+	assert.Equal(t, map[contract.ChannelType]int{contract.Email: 2, contract.Rest: 1}, b.PendingTransmissions)
+	assert.Equal(t, int64(300), b.OldestPending)
+	b.Age(1000)
+	assert.Equal(t, int64(700), b.OldestPendingAge)
+}
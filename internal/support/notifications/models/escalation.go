@@ -0,0 +1,108 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+const (
+	// EscalationActive is the status of an escalation waiting for an acknowledgement before contacting its next level.
+	EscalationActive = "ACTIVE"
+	// EscalationAcknowledged is the status of an escalation stopped by the acknowledgement of one of its transmissions.
+	EscalationAcknowledged = "ACKNOWLEDGED"
+	// EscalationExhausted is the status of an escalation which contacted all the levels of its policy.
+	EscalationExhausted = "EXHAUSTED"
+)
+
+// EscalationLevel lists the contacts a critical notification is resent to when it hasn't been acknowledged within
+// Window of the previous level, or of the subscription itself for the first level.
+type EscalationLevel struct {
+	Window   string             `json:"window"`
+	Receiver string             `json:"receiver"`
+	Channels []contract.Channel `json:"channels"`
+}
+
+// EscalationPolicy defines the successive levels of contacts the critical notifications delivered to a subscription
+// are escalated to, as long as none of their transmissions is acknowledged.
+type EscalationPolicy struct {
+	ID           string            `json:"id,omitempty"`
+	Subscription string            `json:"subscription"`
+	Levels       []EscalationLevel `json:"levels"`
+	Created      int64             `json:"created,omitempty"`
+	Modified     int64             `json:"modified,omitempty"`
+}
+
+// Validate checks that every level has a positive window and at least one channel to contact.
+func (p EscalationPolicy) Validate() error {
+	if p.Subscription == "" {
+		return fmt.Errorf("escalation policy requires a subscription slug")
+	}
+	if len(p.Levels) == 0 {
+		return fmt.Errorf("escalation policy of %s requires at least one level", p.Subscription)
+	}
+	for i, level := range p.Levels {
+		window, err := time.ParseDuration(level.Window)
+		if err != nil || window <= 0 {
+			return fmt.Errorf("level %d of escalation policy of %s has invalid window '%s'", i+1, p.Subscription, level.Window)
+		}
+		if strings.TrimSpace(level.Receiver) == "" {
+			return fmt.Errorf("level %d of escalation policy of %s requires a receiver", i+1, p.Subscription)
+		}
+		if len(level.Channels) == 0 {
+			return fmt.Errorf("level %d of escalation policy of %s requires at least one channel", i+1, p.Subscription)
+		}
+	}
+	return nil
+}
+
+// Deadline returns when the escalation to the given level is due, in milliseconds, counting from now.
+func (p EscalationPolicy) Deadline(level int, now time.Time) int64 {
+	window, _ := time.ParseDuration(p.Levels[level].Window)
+	return now.Add(window).UnixNano() / int64(time.Millisecond)
+}
+
+// Escalation is the state of the escalation of a critical notification delivered to a subscription. Level is the
+// number of levels of the policy already contacted, and Deadline when the next one is contacted unless one of the
+// Transmissions, sent to the subscription or to the levels contacted so far, is acknowledged first.
+type Escalation struct {
+	ID            string   `json:"id,omitempty"`
+	Notification  string   `json:"notification"`
+	Subscription  string   `json:"subscription"`
+	Transmissions []string `json:"transmissions"`
+	Level         int      `json:"level"`
+	Status        string   `json:"status"`
+	Deadline      int64    `json:"deadline,omitempty"`
+	Created       int64    `json:"created,omitempty"`
+	Modified      int64    `json:"modified,omitempty"`
+}
+
+// Transmission is the representation of a transmission returned by the API, along with the state of the escalation
+// of its notification when it is part of one.
+type Transmission struct {
+	contract.Transmission
+	Escalation *Escalation
+}
+
+// MarshalJSON adds the escalation to the JSON representation of the transmission.
+func (t Transmission) MarshalJSON() ([]byte, error) {
+	b, err := json.Marshal(t.Transmission)
+	if err != nil || t.Escalation == nil {
+		return b, err
+	}
+
+	var fields map[string]interface{}
+	if err = json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+	fields["escalation"] = t.Escalation
+	return json.Marshal(fields)
+}
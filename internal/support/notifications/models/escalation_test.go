@@ -0,0 +1,103 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+var escalationLevel = EscalationLevel{
+	Window:   "5m",
+	Receiver: "On-call engineer",
+	Channels: []contract.Channel{{Type: contract.ChannelType(contract.Email), MailAddresses: []string{"oncall@example.com"}}},
+}
+
+func TestEscalationPolicyValidate(t *testing.T) {
+	noWindow := escalationLevel
+	noWindow.Window = ""
+	negativeWindow := escalationLevel
+	negativeWindow.Window = "-1m"
+	noReceiver := escalationLevel
+	noReceiver.Receiver = " "
+	noChannels := escalationLevel
+	noChannels.Channels = nil
+
+	tests := []struct {
+		name        string
+		policy      EscalationPolicy
+		expectError bool
+	}{
+		{"valid", EscalationPolicy{Subscription: "ops", Levels: []EscalationLevel{escalationLevel, escalationLevel}}, false},
+		{"no subscription", EscalationPolicy{Levels: []EscalationLevel{escalationLevel}}, true},
+		{"no levels", EscalationPolicy{Subscription: "ops"}, true},
+		{"no window", EscalationPolicy{Subscription: "ops", Levels: []EscalationLevel{escalationLevel, noWindow}}, true},
+		{"negative window", EscalationPolicy{Subscription: "ops", Levels: []EscalationLevel{negativeWindow}}, true},
+		{"no receiver", EscalationPolicy{Subscription: "ops", Levels: []EscalationLevel{noReceiver}}, true},
+		{"no channels", EscalationPolicy{Subscription: "ops", Levels: []EscalationLevel{noChannels}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.Validate()
+			if tt.expectError && err == nil {
+				t.Error("expected an error")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("unexpected error %v", err)
+			}
+		})
+	}
+}
+
+func TestEscalationPolicyDeadline(t *testing.T) {
+	second := escalationLevel
+	second.Window = "1h"
+	policy := EscalationPolicy{Subscription: "ops", Levels: []EscalationLevel{escalationLevel, second}}
+	now := time.Unix(1600000000, 0)
+
+	if deadline := policy.Deadline(0, now); deadline != 1600000300000 {
+		t.Errorf("deadline of first level mismatch -- expected 1600000300000 got %d", deadline)
+	}
+	if deadline := policy.Deadline(1, now); deadline != 1600003600000 {
+		t.Errorf("deadline of second level mismatch -- expected 1600003600000 got %d", deadline)
+	}
+}
+
+func TestTransmissionMarshalJSON(t *testing.T) {
+	trx := contract.Transmission{ID: "trx", Receiver: "System Admin", Status: contract.Sent}
+
+	b, err := json.Marshal(Transmission{Transmission: trx})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	var fields map[string]interface{}
+	_ = json.Unmarshal(b, &fields)
+	if _, ok := fields["escalation"]; ok {
+		t.Error("expected no escalation for a transmission not escalated")
+	}
+
+	escalation := Escalation{ID: "esc", Notification: "hot", Subscription: "ops", Transmissions: []string{"trx"}, Level: 1, Status: EscalationActive}
+	b, err = json.Marshal(Transmission{Transmission: trx, Escalation: &escalation})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	var decoded struct {
+		ID         string     `json:"id"`
+		Receiver   string     `json:"receiver"`
+		Escalation Escalation `json:"escalation"`
+	}
+	_ = json.Unmarshal(b, &decoded)
+	if decoded.ID != trx.ID || decoded.Receiver != trx.Receiver {
+		t.Errorf("transmission fields mismatch -- got %s", string(b))
+	}
+	if decoded.Escalation.ID != escalation.ID || decoded.Escalation.Level != 1 || decoded.Escalation.Status != EscalationActive {
+		t.Errorf("escalation mismatch -- got %s", string(b))
+	}
+}
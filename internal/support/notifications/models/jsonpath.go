@@ -0,0 +1,90 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// pathSegment is either a field name or, when isIndex is set, an array index.
+type pathSegment struct {
+	field   string
+	index   int
+	isIndex bool
+}
+
+// parsePath parses the subset of JSONPath needed to address a single value of a document: a root "$" followed by
+// any combination of ".field", "['field']" and "[index]" segments, e.g. $.content.sensors[0].name
+func parsePath(path string) ([]pathSegment, error) {
+	path = strings.TrimSpace(path)
+	if !strings.HasPrefix(path, "$") {
+		return nil, fmt.Errorf("path '%s' must start with '$'", path)
+	}
+
+	var segments []pathSegment
+	rest := path[1:]
+	for len(rest) > 0 {
+		switch rest[0] {
+		case '.':
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end == -1 {
+				end = len(rest)
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("path '%s' has an empty field name", path)
+			}
+			segments = append(segments, pathSegment{field: rest[:end]})
+			rest = rest[end:]
+		case '[':
+			end := strings.Index(rest, "]")
+			if end == -1 {
+				return nil, fmt.Errorf("path '%s' has an unterminated '['", path)
+			}
+			token := strings.TrimSpace(rest[1:end])
+			rest = rest[end+1:]
+			if len(token) >= 2 && (token[0] == '\'' || token[0] == '"') && token[len(token)-1] == token[0] {
+				segments = append(segments, pathSegment{field: token[1 : len(token)-1]})
+				continue
+			}
+			index, err := strconv.Atoi(token)
+			if err != nil || index < 0 {
+				return nil, fmt.Errorf("path '%s' has an invalid index '%s'", path, token)
+			}
+			segments = append(segments, pathSegment{index: index, isIndex: true})
+		default:
+			return nil, fmt.Errorf("path '%s' has an unexpected character '%c'", path, rest[0])
+		}
+	}
+	return segments, nil
+}
+
+// evaluatePath resolves the path against a document decoded from JSON. found is false when any segment of the path
+// does not exist in the document.
+func evaluatePath(document interface{}, segments []pathSegment) (value interface{}, found bool) {
+	value = document
+	for _, segment := range segments {
+		if segment.isIndex {
+			array, ok := value.([]interface{})
+			if !ok || segment.index >= len(array) {
+				return nil, false
+			}
+			value = array[segment.index]
+			continue
+		}
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		value, ok = object[segment.field]
+		if !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
@@ -0,0 +1,75 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// DeliveryReceipt is the acknowledgement, signed by a REST channel target, that it received and accepted the content of
+// a notification. The target signs the slug of the notification, the SHA-256 hash of the content it received and the
+// time it accepted it at, so that the receipt proves the delivery of that exact content.
+type DeliveryReceipt struct {
+	ID           string `json:"id,omitempty"`
+	Transmission string `json:"transmission"`
+	Notification string `json:"notification"`
+	ContentHash  string `json:"contentHash"`
+	KeyId        string `json:"keyId"`
+	Timestamp    int64  `json:"timestamp"`
+	Signature    string `json:"signature"`
+	Created      int64  `json:"created,omitempty"`
+}
+
+// ContentHash returns the hex encoded SHA-256 hash of the content of a notification, as signed in the receipts.
+func ContentHash(content string) string {
+	hash := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(hash[:])
+}
+
+// SignedPayload returns the bytes signed by the target: the notification slug, the content hash and the timestamp,
+// separated by newlines.
+func (r DeliveryReceipt) SignedPayload() []byte {
+	return []byte(r.Notification + "\n" + r.ContentHash + "\n" + strconv.FormatInt(r.Timestamp, 10))
+}
+
+// Verify checks that the receipt acknowledges the content of the transmission and is signed with the Ed25519 public
+// key of its key id, keys holding the base64 encoded public keys by key id. Any alteration of the stored receipt or of
+// the transmission content fails the verification.
+func (r DeliveryReceipt) Verify(t contract.Transmission, keys map[string]string) error {
+	if r.Transmission != t.ID {
+		return fmt.Errorf("receipt is for transmission %s, not %s", r.Transmission, t.ID)
+	}
+	if r.Notification != t.Notification.Slug {
+		return fmt.Errorf("receipt is for notification %s, not %s", r.Notification, t.Notification.Slug)
+	}
+	if r.ContentHash != ContentHash(t.Notification.Content) {
+		return fmt.Errorf("receipt doesn't match the content of notification %s", t.Notification.Slug)
+	}
+
+	encodedKey, ok := keys[r.KeyId]
+	if !ok {
+		return fmt.Errorf("no receipt key configured for key id '%s'", r.KeyId)
+	}
+	key, err := base64.StdEncoding.DecodeString(encodedKey)
+	if err != nil || len(key) != ed25519.PublicKeySize {
+		return fmt.Errorf("receipt key '%s' isn't a base64 encoded Ed25519 public key", r.KeyId)
+	}
+	signature, err := base64.StdEncoding.DecodeString(r.Signature)
+	if err != nil {
+		return fmt.Errorf("receipt signature isn't base64 encoded: %v", err)
+	}
+	if !ed25519.Verify(key, r.SignedPayload(), signature) {
+		return fmt.Errorf("invalid receipt signature for key '%s'", r.KeyId)
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeliveryReceiptVerify(t *testing.T) {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	keys := map[string]string{"target": base64.StdEncoding.EncodeToString(public)}
+
+	transmission := contract.Transmission{ID: "trx", Notification: contract.Notification{Slug: "overheat", Content: "too hot"}}
+	receipt := DeliveryReceipt{
+		Transmission: "trx",
+		Notification: "overheat",
+		ContentHash:  ContentHash("too hot"),
+		KeyId:        "target",
+		Timestamp:    1600000000000,
+	}
+	receipt.Signature = base64.StdEncoding.EncodeToString(ed25519.Sign(private, receipt.SignedPayload()))
+
+	assert.NoError(t, receipt.Verify(transmission, keys))
+
+	tampered := receipt
+	tampered.Timestamp++
+	assert.Error(t, tampered.Verify(transmission, keys), "altered receipt")
+
+	altered := transmission
+	altered.Notification.Content = "cool"
+	assert.Error(t, receipt.Verify(altered, keys), "altered content")
+
+	assert.Error(t, receipt.Verify(transmission, map[string]string{}), "unknown key id")
+}
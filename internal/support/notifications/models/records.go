@@ -0,0 +1,98 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// prunedRecordsPrefix starts the response of the record standing for the pruned records of a transmission.
+const prunedRecordsPrefix = "pruned records:"
+
+// PruneRecords caps the records of a transmission to its first record, a record counting the pruned ones by status, and
+// the latest keepLatest records. The records are kept whole when keepLatest is 0 or when there are few enough of them.
+// Pruning the records again updates the counts of the pruned ones.
+func PruneRecords(records []contract.TransmissionRecord, keepLatest int) []contract.TransmissionRecord {
+	if keepLatest <= 0 {
+		return records
+	}
+
+	// pruning fewer records than the one counting them saves nothing
+	if len(records) <= 2+keepLatest {
+		return records
+	}
+
+	head := 1
+	counts := map[string]int{}
+	if isPrunedRecords(records[1]) {
+		head = 2
+		counts = prunedCounts(records[1].Response)
+	}
+
+	latest := len(records) - keepLatest
+	for _, r := range records[head:latest] {
+		counts[string(r.Status)]++
+	}
+	summary := contract.TransmissionRecord{
+		Status:   records[latest-1].Status,
+		Response: prunedResponse(counts),
+		Sent:     records[latest-1].Sent,
+	}
+
+	pruned := make([]contract.TransmissionRecord, 0, 2+keepLatest)
+	pruned = append(pruned, records[0], summary)
+	return append(pruned, records[latest:]...)
+}
+
+// PrunedRecordsCount returns the number of records of the transmission which were pruned.
+func PrunedRecordsCount(records []contract.TransmissionRecord) int {
+	if len(records) < 2 || !isPrunedRecords(records[1]) {
+		return 0
+	}
+	total := 0
+	for _, count := range prunedCounts(records[1].Response) {
+		total += count
+	}
+	return total
+}
+
+func isPrunedRecords(r contract.TransmissionRecord) bool {
+	return strings.HasPrefix(r.Response, prunedRecordsPrefix)
+}
+
+// prunedResponse formats the counts of the pruned records by status, e.g. 'pruned records: FAILED=11 SENT=1'.
+func prunedResponse(counts map[string]int) string {
+	statuses := make([]string, 0, len(counts))
+	for status := range counts {
+		statuses = append(statuses, status)
+	}
+	sort.Strings(statuses)
+
+	response := prunedRecordsPrefix
+	for _, status := range statuses {
+		response += fmt.Sprintf(" %s=%d", status, counts[status])
+	}
+	return response
+}
+
+func prunedCounts(response string) map[string]int {
+	counts := map[string]int{}
+	for _, field := range strings.Fields(strings.TrimPrefix(response, prunedRecordsPrefix)) {
+		parts := strings.SplitN(field, "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		if count, err := strconv.Atoi(parts[1]); err == nil {
+			counts[parts[0]] += count
+		}
+	}
+	return counts
+}
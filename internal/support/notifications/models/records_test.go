@@ -0,0 +1,48 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"testing"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testRecords(statuses ...contract.TransmissionStatus) []contract.TransmissionRecord {
+	var records []contract.TransmissionRecord
+	for i, status := range statuses {
+		records = append(records, contract.TransmissionRecord{Status: status, Sent: int64(i + 1)})
+	}
+	return records
+}
+
+func TestPruneRecords(t *testing.T) {
+	records := testRecords(contract.Failed, contract.Failed, contract.Sent, contract.Failed, contract.Failed, contract.Failed)
+
+	assert.Equal(t, records, PruneRecords(records, 0), "no limit")
+	assert.Equal(t, records, PruneRecords(records, 4), "nothing to save")
+
+	pruned := PruneRecords(records, 2)
+	require.Len(t, pruned, 4)
+	assert.Equal(t, records[0], pruned[0])
+	assert.Equal(t, "pruned records: FAILED=2 SENT=1", pruned[1].Response)
+	assert.Equal(t, int64(4), pruned[1].Sent)
+	assert.Equal(t, records[4:], pruned[2:])
+	assert.Equal(t, 3, PrunedRecordsCount(pruned))
+
+	// the records added since are pruned along with the ones already counted
+	pruned = append(pruned, contract.TransmissionRecord{Status: contract.Failed, Sent: 7})
+	pruned = PruneRecords(pruned, 2)
+	require.Len(t, pruned, 4)
+	assert.Equal(t, "pruned records: FAILED=3 SENT=1", pruned[1].Response)
+	assert.Equal(t, int64(7), pruned[3].Sent)
+	assert.Equal(t, 4, PrunedRecordsCount(pruned))
+
+	assert.Equal(t, 0, PrunedRecordsCount(records))
+}
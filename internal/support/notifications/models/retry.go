@@ -0,0 +1,91 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// RetryPolicy defines how the failed transmissions of the notifications delivered to a subscription are resent. The
+// first resend is delayed by InitialInterval, and every following one by BackoffMultiplier times the previous delay, up
+// to MaxInterval, each delay being randomly shortened or lengthened by up to the Jitter fraction of it so that the
+// resends of a flood of failures are spread out.
+type RetryPolicy struct {
+	ID                string  `json:"id,omitempty"`
+	Subscription      string  `json:"subscription"`
+	MaxAttempts       int     `json:"maxAttempts"`
+	InitialInterval   string  `json:"initialInterval"`
+	BackoffMultiplier float64 `json:"backoffMultiplier"`
+	MaxInterval       string  `json:"maxInterval,omitempty"`
+	Jitter            float64 `json:"jitter"`
+	Created           int64   `json:"created,omitempty"`
+	Modified          int64   `json:"modified,omitempty"`
+}
+
+// Validate checks that the intervals are positive durations, that the delays never shrink and that the jitter is a
+// fraction.
+func (p RetryPolicy) Validate() error {
+	if p.MaxAttempts < 0 {
+		return fmt.Errorf("retry policy of %s has negative maxAttempts %d", p.Subscription, p.MaxAttempts)
+	}
+	initial, err := time.ParseDuration(p.InitialInterval)
+	if err != nil || initial <= 0 {
+		return fmt.Errorf("retry policy of %s has invalid initialInterval '%s'", p.Subscription, p.InitialInterval)
+	}
+	if p.MaxInterval != "" {
+		max, err := time.ParseDuration(p.MaxInterval)
+		if err != nil || max < initial {
+			return fmt.Errorf("retry policy of %s has invalid maxInterval '%s'", p.Subscription, p.MaxInterval)
+		}
+	}
+	if p.BackoffMultiplier < 1 {
+		return fmt.Errorf("retry policy of %s has backoffMultiplier %v lower than 1", p.Subscription, p.BackoffMultiplier)
+	}
+	if p.Jitter < 0 || p.Jitter > 1 {
+		return fmt.Errorf("retry policy of %s has jitter %v outside of [0, 1]", p.Subscription, p.Jitter)
+	}
+	return nil
+}
+
+// Retries reports whether the failed transmissions of the notification are resent. The default policy, which has no
+// subscription, only resends those of the critical notifications, while a policy of a subscription resends them all.
+func (p RetryPolicy) Retries(n contract.Notification) bool {
+	return p.Subscription != "" || n.Severity == contract.Critical
+}
+
+// Delay returns how long to wait before the resend following the given number of resends already made, where r, in
+// [0, 1), picks the jitter applied.
+func (p RetryPolicy) Delay(resends int, r float64) time.Duration {
+	initial, _ := time.ParseDuration(p.InitialInterval)
+	delay := float64(initial) * math.Pow(math.Max(p.BackoffMultiplier, 1), float64(resends))
+	if max, err := time.ParseDuration(p.MaxInterval); err == nil && max > 0 {
+		delay = math.Min(delay, float64(max))
+	}
+	delay *= 1 + p.Jitter*(2*r-1)
+	return time.Duration(delay)
+}
+
+// ResendOverride overrides the number of resends and the delay before the first resend of a retry policy, e.g. on a
+// live subscription during an incident, the fields left out being kept as they are.
+type ResendOverride struct {
+	ResendLimit    *int    `json:"resendLimit,omitempty"`
+	ResendInterval *string `json:"resendInterval,omitempty"`
+}
+
+// Apply returns the policy with the overrides.
+func (o ResendOverride) Apply(p RetryPolicy) RetryPolicy {
+	if o.ResendLimit != nil {
+		p.MaxAttempts = *o.ResendLimit
+	}
+	if o.ResendInterval != nil {
+		p.InitialInterval = *o.ResendInterval
+	}
+	return p
+}
@@ -0,0 +1,89 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"testing"
+	"time"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testRetryPolicy = RetryPolicy{
+	Subscription:      "sub1",
+	MaxAttempts:       5,
+	InitialInterval:   "1s",
+	BackoffMultiplier: 2,
+	MaxInterval:       "5s",
+	Jitter:            0.5,
+}
+
+func TestRetryPolicyValidate(t *testing.T) {
+	assert.NoError(t, testRetryPolicy.Validate())
+
+	tests := []struct {
+		name   string
+		modify func(p *RetryPolicy)
+	}{
+		{"negative attempts", func(p *RetryPolicy) { p.MaxAttempts = -1 }},
+		{"invalid initial interval", func(p *RetryPolicy) { p.InitialInterval = "soon" }},
+		{"max interval below initial", func(p *RetryPolicy) { p.MaxInterval = "500ms" }},
+		{"shrinking backoff", func(p *RetryPolicy) { p.BackoffMultiplier = 0.5 }},
+		{"jitter above 1", func(p *RetryPolicy) { p.Jitter = 1.5 }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := testRetryPolicy
+			tt.modify(&p)
+			assert.Error(t, p.Validate())
+		})
+	}
+}
+
+func TestRetryPolicyDelay(t *testing.T) {
+	// r = 0.5 applies no jitter
+	assert.Equal(t, time.Second, testRetryPolicy.Delay(0, 0.5))
+	assert.Equal(t, 2*time.Second, testRetryPolicy.Delay(1, 0.5))
+	assert.Equal(t, 4*time.Second, testRetryPolicy.Delay(2, 0.5))
+	assert.Equal(t, 5*time.Second, testRetryPolicy.Delay(3, 0.5), "delays are capped by the max interval")
+
+	assert.Equal(t, 1*time.Second, testRetryPolicy.Delay(1, 0), "jitter shortens the delay by up to half")
+	assert.Equal(t, 3*time.Second, testRetryPolicy.Delay(1, 1), "jitter lengthens the delay by up to half")
+
+	unbounded := testRetryPolicy
+	unbounded.MaxInterval = ""
+	assert.Equal(t, 16*time.Second, unbounded.Delay(4, 0.5))
+}
+
+func TestRetryPolicyRetries(t *testing.T) {
+	critical := contract.Notification{Severity: contract.Critical}
+	normal := contract.Notification{Severity: contract.Normal}
+
+	defaultPolicy := testRetryPolicy
+	defaultPolicy.Subscription = ""
+	assert.True(t, defaultPolicy.Retries(critical))
+	assert.False(t, defaultPolicy.Retries(normal), "the default policy only resends critical notifications")
+
+	assert.True(t, testRetryPolicy.Retries(normal))
+}
+
+func TestResendOverrideApply(t *testing.T) {
+	limit := 10
+	interval := "3s"
+
+	assert.Equal(t, testRetryPolicy, ResendOverride{}.Apply(testRetryPolicy), "nothing is overridden")
+
+	p := ResendOverride{ResendLimit: &limit}.Apply(testRetryPolicy)
+	assert.Equal(t, 10, p.MaxAttempts)
+	assert.Equal(t, testRetryPolicy.InitialInterval, p.InitialInterval)
+
+	p = ResendOverride{ResendLimit: &limit, ResendInterval: &interval}.Apply(testRetryPolicy)
+	assert.Equal(t, 10, p.MaxAttempts)
+	assert.Equal(t, "3s", p.InitialInterval)
+	assert.Equal(t, testRetryPolicy.BackoffMultiplier, p.BackoffMultiplier)
+}
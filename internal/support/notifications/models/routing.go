@@ -0,0 +1,187 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// QuietWindow is a daily period, from Start to End in the format 15:04, during which only the notifications severe
+// enough are delivered. A window ending before it starts spans midnight.
+type QuietWindow struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+	// Days are the weekdays the window starts on, e.g. Saturday; every day when empty.
+	Days []string `json:"days,omitempty"`
+}
+
+// QuietHours are the windows, in a time zone, during which the notifications below MinSeverity aren't delivered.
+type QuietHours struct {
+	// Timezone is the IANA name of the time zone of the windows, e.g. Europe/Paris; UTC when empty.
+	Timezone string        `json:"timezone,omitempty"`
+	Windows  []QuietWindow `json:"windows"`
+	// MinSeverity is the lowest severity delivered during the windows; CRITICAL when empty.
+	MinSeverity contract.NotificationsSeverity `json:"minSeverity,omitempty"`
+}
+
+// RoutingPolicy restricts the notifications delivered to a subscription by severity, at any time or during its quiet
+// hours, so that e.g. the NORMAL notifications don't page people at night while the CRITICAL ones still go through.
+type RoutingPolicy struct {
+	ID           string `json:"id,omitempty"`
+	Subscription string `json:"subscription"`
+	// MinSeverity is the lowest severity ever delivered; every severity when empty.
+	MinSeverity contract.NotificationsSeverity `json:"minSeverity,omitempty"`
+	QuietHours  *QuietHours                    `json:"quietHours,omitempty"`
+	Created     int64                          `json:"created,omitempty"`
+	Modified    int64                          `json:"modified,omitempty"`
+}
+
+// severityRanks orders the severities from the least to the most severe.
+var severityRanks = map[contract.NotificationsSeverity]int{
+	contract.Normal:   0,
+	contract.Critical: 1,
+}
+
+// SeverityAtLeast reports whether the severity is at least as severe as min. Unknown severities are ranked as NORMAL.
+func SeverityAtLeast(severity contract.NotificationsSeverity, min contract.NotificationsSeverity) bool {
+	rank, ok := severityRanks[severity]
+	if !ok {
+		rank = severityRanks[contract.Normal]
+	}
+	return rank >= severityRanks[min]
+}
+
+// Validate checks the severities, the time zone and the windows of the policy.
+func (p RoutingPolicy) Validate() error {
+	if p.Subscription == "" {
+		return fmt.Errorf("routing policy requires a subscription slug")
+	}
+	if _, ok := severityRanks[p.MinSeverity]; p.MinSeverity != "" && !ok {
+		return fmt.Errorf("routing policy of %s has invalid minimum severity '%s'", p.Subscription, p.MinSeverity)
+	}
+	if p.QuietHours == nil {
+		if p.MinSeverity == "" {
+			return fmt.Errorf("routing policy of %s requires a minimum severity or quiet hours", p.Subscription)
+		}
+		return nil
+	}
+
+	q := p.QuietHours
+	if _, ok := severityRanks[q.MinSeverity]; q.MinSeverity != "" && !ok {
+		return fmt.Errorf("quiet hours of %s have invalid minimum severity '%s'", p.Subscription, q.MinSeverity)
+	}
+	if _, err := time.LoadLocation(q.Timezone); err != nil {
+		return fmt.Errorf("quiet hours of %s have invalid time zone '%s'", p.Subscription, q.Timezone)
+	}
+	if len(q.Windows) == 0 {
+		return fmt.Errorf("quiet hours of %s require at least one window", p.Subscription)
+	}
+	for i, w := range q.Windows {
+		start, err := minuteOfDay(w.Start)
+		if err != nil {
+			return fmt.Errorf("window %d of quiet hours of %s has invalid start '%s'", i+1, p.Subscription, w.Start)
+		}
+		end, err := minuteOfDay(w.End)
+		if err != nil {
+			return fmt.Errorf("window %d of quiet hours of %s has invalid end '%s'", i+1, p.Subscription, w.End)
+		}
+		if start == end {
+			return fmt.Errorf("window %d of quiet hours of %s is empty", i+1, p.Subscription)
+		}
+		for _, day := range w.Days {
+			if _, ok := parseWeekday(day); !ok {
+				return fmt.Errorf("window %d of quiet hours of %s has invalid day '%s'", i+1, p.Subscription, day)
+			}
+		}
+	}
+	return nil
+}
+
+// Admits reports whether the notification, distributed at now, is delivered to the subscription. Otherwise the reason
+// it isn't is returned.
+func (p RoutingPolicy) Admits(n contract.Notification, now time.Time) (bool, string) {
+	if p.MinSeverity != "" && !SeverityAtLeast(n.Severity, p.MinSeverity) {
+		return false, fmt.Sprintf("severity %s is below %s", n.Severity, p.MinSeverity)
+	}
+	if p.QuietHours == nil || !p.QuietHours.Active(now) {
+		return true, ""
+	}
+	min := p.QuietHours.MinSeverity
+	if min == "" {
+		min = contract.Critical
+	}
+	if !SeverityAtLeast(n.Severity, min) {
+		return false, fmt.Sprintf("severity %s is below %s during quiet hours", n.Severity, min)
+	}
+	return true, ""
+}
+
+// Active reports whether now falls within one of the windows.
+func (q QuietHours) Active(now time.Time) bool {
+	location, err := time.LoadLocation(q.Timezone)
+	if err != nil {
+		location = time.UTC
+	}
+	now = now.In(location)
+	minute := now.Hour()*60 + now.Minute()
+	yesterday := now.AddDate(0, 0, -1).Weekday()
+
+	for _, w := range q.Windows {
+		start, err := minuteOfDay(w.Start)
+		if err != nil {
+			continue
+		}
+		end, err := minuteOfDay(w.End)
+		if err != nil {
+			continue
+		}
+		if start < end {
+			if minute >= start && minute < end && w.startsOn(now.Weekday()) {
+				return true
+			}
+			continue
+		}
+		// the window spans midnight, it is active late on its days and early on the following ones
+		if (minute >= start && w.startsOn(now.Weekday())) || (minute < end && w.startsOn(yesterday)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (w QuietWindow) startsOn(day time.Weekday) bool {
+	if len(w.Days) == 0 {
+		return true
+	}
+	for _, d := range w.Days {
+		if weekday, ok := parseWeekday(d); ok && weekday == day {
+			return true
+		}
+	}
+	return false
+}
+
+func minuteOfDay(clock string) (int, error) {
+	t, err := time.Parse("15:04", clock)
+	if err != nil {
+		return 0, err
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+func parseWeekday(day string) (time.Weekday, bool) {
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		name := weekday.String()
+		if strings.EqualFold(day, name) || strings.EqualFold(day, name[:3]) {
+			return weekday, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,93 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"testing"
+	"time"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoutingPolicyValidate(t *testing.T) {
+	window := QuietWindow{Start: "22:00", End: "07:00"}
+	tests := []struct {
+		name   string
+		policy RoutingPolicy
+		valid  bool
+	}{
+		{"minimum severity", RoutingPolicy{Subscription: "s", MinSeverity: contract.Normal}, true},
+		{"quiet hours", RoutingPolicy{Subscription: "s", QuietHours: &QuietHours{Timezone: "Europe/Paris", Windows: []QuietWindow{window}}}, true},
+		{"nothing to route", RoutingPolicy{Subscription: "s"}, false},
+		{"invalid severity", RoutingPolicy{Subscription: "s", MinSeverity: "URGENT"}, false},
+		{"invalid time zone", RoutingPolicy{Subscription: "s", QuietHours: &QuietHours{Timezone: "Mars/Olympus", Windows: []QuietWindow{window}}}, false},
+		{"no window", RoutingPolicy{Subscription: "s", QuietHours: &QuietHours{}}, false},
+		{"invalid start", RoutingPolicy{Subscription: "s", QuietHours: &QuietHours{Windows: []QuietWindow{{Start: "10pm", End: "07:00"}}}}, false},
+		{"empty window", RoutingPolicy{Subscription: "s", QuietHours: &QuietHours{Windows: []QuietWindow{{Start: "07:00", End: "07:00"}}}}, false},
+		{"invalid day", RoutingPolicy{Subscription: "s", QuietHours: &QuietHours{Windows: []QuietWindow{{Start: "22:00", End: "07:00", Days: []string{"Caturday"}}}}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.Validate()
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestRoutingPolicyAdmits(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+	policy := RoutingPolicy{
+		Subscription: "on-call",
+		MinSeverity:  contract.Normal,
+		QuietHours: &QuietHours{
+			Timezone: "Europe/Paris",
+			Windows:  []QuietWindow{{Start: "22:00", End: "07:00", Days: []string{"Fri", "Saturday"}}},
+		},
+	}
+	normal := contract.Notification{Severity: contract.Normal}
+	critical := contract.Notification{Severity: contract.Critical}
+
+	// Friday, October 2nd 2020
+	fridayNoon := time.Date(2020, 10, 2, 12, 0, 0, 0, paris)
+	fridayNight := time.Date(2020, 10, 2, 23, 0, 0, 0, paris)
+	saturdayMorning := time.Date(2020, 10, 3, 6, 59, 0, 0, paris)
+	sundayMorning := time.Date(2020, 10, 4, 6, 0, 0, 0, paris)
+	mondayMorning := time.Date(2020, 10, 5, 6, 0, 0, 0, paris)
+
+	tests := []struct {
+		name     string
+		n        contract.Notification
+		now      time.Time
+		expected bool
+	}{
+		{"normal outside quiet hours", normal, fridayNoon, true},
+		{"normal during quiet hours", normal, fridayNight, false},
+		{"normal after midnight", normal, saturdayMorning, false},
+		{"normal early on the day after the last window", normal, sundayMorning, false},
+		{"normal early on a day without window the night before", normal, mondayMorning, true},
+		{"critical during quiet hours", critical, fridayNight, true},
+		{"quiet hours in UTC", normal, fridayNight.UTC(), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			admitted, reason := policy.Admits(tt.n, tt.now)
+			assert.Equal(t, tt.expected, admitted)
+			assert.Equal(t, tt.expected, reason == "")
+		})
+	}
+
+	criticalOnly := RoutingPolicy{Subscription: "on-call", MinSeverity: contract.Critical}
+	admitted, _ := criticalOnly.Admits(normal, fridayNoon)
+	assert.False(t, admitted, "the notifications below the minimum severity should never be delivered")
+}
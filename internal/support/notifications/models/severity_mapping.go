@@ -0,0 +1,111 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"fmt"
+	"strings"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// SeverityRule maps a single value of an external severity scheme onto an EdgeX severity and, optionally, category.
+type SeverityRule struct {
+	External string                         `json:"external"`
+	Severity contract.NotificationsSeverity `json:"severity"`
+	Category contract.NotificationsCategory `json:"category,omitempty"`
+}
+
+// SeverityMapping translates the severities of an external system (syslog levels, SNMP trap severities, custom
+// integers, ...) into EdgeX notification severities and categories. The mapping is referenced by its Name.
+type SeverityMapping struct {
+	ID              string                         `json:"id,omitempty"`
+	Name            string                         `json:"name"`
+	Description     string                         `json:"description,omitempty"`
+	DefaultSeverity contract.NotificationsSeverity `json:"defaultSeverity,omitempty"`
+	DefaultCategory contract.NotificationsCategory `json:"defaultCategory,omitempty"`
+	Rules           []SeverityRule                 `json:"rules"`
+	Created         int64                          `json:"created,omitempty"`
+	Modified        int64                          `json:"modified,omitempty"`
+}
+
+// Validate ensures the mapping only references known EdgeX severities and categories and that each external
+// value is mapped at most once.
+func (m SeverityMapping) Validate() error {
+	if strings.TrimSpace(m.Name) == "" {
+		return fmt.Errorf("severity mapping name is required")
+	}
+	if len(m.Rules) == 0 {
+		return fmt.Errorf("severity mapping '%s' has no rules", m.Name)
+	}
+	if m.DefaultSeverity != "" && !validSeverity(m.DefaultSeverity) {
+		return fmt.Errorf("severity mapping '%s' has invalid default severity '%s'", m.Name, m.DefaultSeverity)
+	}
+	if m.DefaultCategory != "" && !validCategory(m.DefaultCategory) {
+		return fmt.Errorf("severity mapping '%s' has invalid default category '%s'", m.Name, m.DefaultCategory)
+	}
+
+	seen := make(map[string]bool, len(m.Rules))
+	for _, rule := range m.Rules {
+		external := normalizeExternal(rule.External)
+		if external == "" {
+			return fmt.Errorf("severity mapping '%s' has a rule without an external value", m.Name)
+		}
+		if seen[external] {
+			return fmt.Errorf("severity mapping '%s' maps external value '%s' more than once", m.Name, rule.External)
+		}
+		seen[external] = true
+
+		if !validSeverity(rule.Severity) {
+			return fmt.Errorf("severity mapping '%s' has invalid severity '%s' for '%s'", m.Name, rule.Severity, rule.External)
+		}
+		if rule.Category != "" && !validCategory(rule.Category) {
+			return fmt.Errorf("severity mapping '%s' has invalid category '%s' for '%s'", m.Name, rule.Category, rule.External)
+		}
+	}
+	return nil
+}
+
+// Translate looks up the EdgeX severity and category for the given external value. External values are matched
+// case-insensitively. When no rule matches, the mapping defaults are returned and ok reports whether a default
+// severity was available. An empty category means the caller should keep the category it already has.
+func (m SeverityMapping) Translate(external string) (
+	severity contract.NotificationsSeverity,
+	category contract.NotificationsCategory,
+	ok bool) {
+
+	key := normalizeExternal(external)
+	for _, rule := range m.Rules {
+		if normalizeExternal(rule.External) == key {
+			category = rule.Category
+			if category == "" {
+				category = m.DefaultCategory
+			}
+			return rule.Severity, category, true
+		}
+	}
+	return m.DefaultSeverity, m.DefaultCategory, m.DefaultSeverity != ""
+}
+
+func normalizeExternal(value string) string {
+	return strings.ToLower(strings.TrimSpace(value))
+}
+
+func validSeverity(s contract.NotificationsSeverity) bool {
+	switch s {
+	case contract.Critical, contract.Normal:
+		return true
+	}
+	return false
+}
+
+func validCategory(c contract.NotificationsCategory) bool {
+	switch c {
+	case contract.Security, contract.Hwhealth, contract.Swhealth:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,101 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"testing"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func syslogMapping() SeverityMapping {
+	return SeverityMapping{
+		Name:            "syslog",
+		DefaultSeverity: contract.Normal,
+		DefaultCategory: contract.Swhealth,
+		Rules: []SeverityRule{
+			{External: "emerg", Severity: contract.Critical, Category: contract.Hwhealth},
+			{External: "crit", Severity: contract.Critical},
+			{External: "info", Severity: contract.Normal},
+		},
+	}
+}
+
+func TestSeverityMappingValidate(t *testing.T) {
+	valid := syslogMapping()
+
+	noName := syslogMapping()
+	noName.Name = " "
+
+	noRules := syslogMapping()
+	noRules.Rules = nil
+
+	duplicate := syslogMapping()
+	duplicate.Rules = append(duplicate.Rules, SeverityRule{External: "CRIT ", Severity: contract.Normal})
+
+	badSeverity := syslogMapping()
+	badSeverity.Rules[0].Severity = "LOUD"
+
+	badCategory := syslogMapping()
+	badCategory.Rules[0].Category = "UNKNOWN"
+
+	badDefault := syslogMapping()
+	badDefault.DefaultSeverity = "LOUD"
+
+	emptyExternal := syslogMapping()
+	emptyExternal.Rules[1].External = ""
+
+	tests := []struct {
+		name    string
+		mapping SeverityMapping
+		wantErr bool
+	}{
+		{"valid", valid, false},
+		{"missing name", noName, true},
+		{"missing rules", noRules, true},
+		{"duplicate external", duplicate, true},
+		{"invalid severity", badSeverity, true},
+		{"invalid category", badCategory, true},
+		{"invalid default severity", badDefault, true},
+		{"empty external", emptyExternal, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.mapping.Validate()
+			assert.Equal(t, tt.wantErr, err != nil, "unexpected validation result: %v", err)
+		})
+	}
+}
+
+func TestSeverityMappingTranslate(t *testing.T) {
+	mapping := syslogMapping()
+
+	tests := []struct {
+		name         string
+		external     string
+		wantSeverity contract.NotificationsSeverity
+		wantCategory contract.NotificationsCategory
+		wantOk       bool
+	}{
+		{"rule with category", "emerg", contract.Critical, contract.Hwhealth, true},
+		{"rule without category uses default", "crit", contract.Critical, contract.Swhealth, true},
+		{"case insensitive", " INFO ", contract.Normal, contract.Swhealth, true},
+		{"unmatched uses defaults", "debug", contract.Normal, contract.Swhealth, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			severity, category, ok := mapping.Translate(tt.external)
+			assert.Equal(t, tt.wantSeverity, severity)
+			assert.Equal(t, tt.wantCategory, category)
+			assert.Equal(t, tt.wantOk, ok)
+		})
+	}
+
+	mapping.DefaultSeverity = ""
+	_, _, ok := mapping.Translate("debug")
+	assert.False(t, ok, "unmatched value without a default severity should not translate")
+}
@@ -0,0 +1,281 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+const (
+	OperatorEqual          = "=="
+	OperatorNotEqual       = "!="
+	OperatorGreater        = ">"
+	OperatorGreaterOrEqual = ">="
+	OperatorLess           = "<"
+	OperatorLessOrEqual    = "<="
+	OperatorContains       = "contains"
+	OperatorMatches        = "matches"
+	OperatorExists         = "exists"
+)
+
+// FilterCondition compares the value found at a JSONPath of the notification with a constant. Paths are evaluated
+// against the JSON representation of the notification, e.g. $.severity or $.labels[0]; when the content of the
+// notification is itself a JSON document its fields can be addressed too, e.g. $.content.temperature
+type FilterCondition struct {
+	Path     string      `json:"path"`
+	Operator string      `json:"operator"`
+	Value    interface{} `json:"value,omitempty"`
+}
+
+// SubscriptionFilter narrows the notifications delivered to a subscription beyond its labels and categories. By
+// default all conditions must match; when MatchAny is set a single matching condition is enough.
+type SubscriptionFilter struct {
+	ID           string            `json:"id,omitempty"`
+	Subscription string            `json:"subscription"`
+	MatchAny     bool              `json:"matchAny,omitempty"`
+	Conditions   []FilterCondition `json:"conditions"`
+	Created      int64             `json:"created,omitempty"`
+	Modified     int64             `json:"modified,omitempty"`
+}
+
+// Validate checks that every condition has a well-formed path, a known operator and a value usable by that operator.
+func (f SubscriptionFilter) Validate() error {
+	if f.Subscription == "" {
+		return fmt.Errorf("subscription filter requires a subscription slug")
+	}
+	if len(f.Conditions) == 0 {
+		return fmt.Errorf("subscription filter for %s requires at least one condition", f.Subscription)
+	}
+	for _, c := range f.Conditions {
+		if _, err := parsePath(c.Path); err != nil {
+			return err
+		}
+		switch c.Operator {
+		case OperatorExists:
+		case OperatorEqual, OperatorNotEqual, OperatorContains:
+			if c.Value == nil {
+				return fmt.Errorf("operator '%s' of path '%s' requires a value", c.Operator, c.Path)
+			}
+		case OperatorGreater, OperatorGreaterOrEqual, OperatorLess, OperatorLessOrEqual:
+			if _, isNumber := toFloat(c.Value); !isNumber {
+				if _, isString := c.Value.(string); !isString {
+					return fmt.Errorf("operator '%s' of path '%s' requires a number or string value", c.Operator, c.Path)
+				}
+			}
+		case OperatorMatches:
+			pattern, ok := c.Value.(string)
+			if !ok {
+				return fmt.Errorf("operator '%s' of path '%s' requires a regular expression", c.Operator, c.Path)
+			}
+			if _, err := regexp.Compile(pattern); err != nil {
+				return fmt.Errorf("invalid regular expression for path '%s': %v", c.Path, err)
+			}
+		default:
+			return fmt.Errorf("unknown operator '%s' for path '%s'", c.Operator, c.Path)
+		}
+	}
+	return nil
+}
+
+// Matches evaluates the conditions of the filter against the notification. The filters evaluated against many
+// notifications are compiled once instead.
+func (f SubscriptionFilter) Matches(n contract.Notification) (bool, error) {
+	compiled, err := f.Compile()
+	if err != nil {
+		return false, err
+	}
+	return compiled.Matches(n)
+}
+
+// CompiledFilter is a SubscriptionFilter whose paths are parsed and regular expressions compiled.
+type CompiledFilter struct {
+	matchAny   bool
+	conditions []compiledCondition
+}
+
+type compiledCondition struct {
+	FilterCondition
+	segments []pathSegment
+	pattern  *regexp.Regexp
+}
+
+// Compile parses the paths and compiles the regular expressions of the conditions of the filter.
+func (f SubscriptionFilter) Compile() (*CompiledFilter, error) {
+	compiled := &CompiledFilter{matchAny: f.MatchAny, conditions: make([]compiledCondition, len(f.Conditions))}
+	for i, c := range f.Conditions {
+		segments, err := parsePath(c.Path)
+		if err != nil {
+			return nil, err
+		}
+		compiled.conditions[i] = compiledCondition{FilterCondition: c, segments: segments}
+		if c.Operator == OperatorMatches {
+			pattern, _ := c.Value.(string)
+			if compiled.conditions[i].pattern, err = regexp.Compile(pattern); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return compiled, nil
+}
+
+// Matches evaluates the conditions of the filter against the notification.
+func (f *CompiledFilter) Matches(n contract.Notification) (bool, error) {
+	document, err := notificationDocument(n)
+	if err != nil {
+		return false, err
+	}
+
+	for _, c := range f.conditions {
+		matched, err := c.evaluate(document)
+		if err != nil {
+			return false, err
+		}
+		if matched && f.matchAny {
+			return true, nil
+		}
+		if !matched && !f.matchAny {
+			return false, nil
+		}
+	}
+	return !f.matchAny, nil
+}
+
+// notificationDocument converts the notification to a generic JSON document, expanding the content when it holds JSON.
+func notificationDocument(n contract.Notification) (map[string]interface{}, error) {
+	bytes, err := json.Marshal(n)
+	if err != nil {
+		return nil, err
+	}
+	var document map[string]interface{}
+	if err = json.Unmarshal(bytes, &document); err != nil {
+		return nil, err
+	}
+
+	content := strings.TrimSpace(n.Content)
+	if strings.HasPrefix(content, "{") || strings.HasPrefix(content, "[") {
+		var parsed interface{}
+		if json.Unmarshal([]byte(content), &parsed) == nil {
+			document["content"] = parsed
+		}
+	}
+	return document, nil
+}
+
+func (c compiledCondition) evaluate(document interface{}) (bool, error) {
+	actual, found := evaluatePath(document, c.segments)
+	if c.Operator == OperatorExists {
+		return found, nil
+	}
+	if !found {
+		// comparing a missing field never matches, except for inequality
+		return c.Operator == OperatorNotEqual, nil
+	}
+
+	switch c.Operator {
+	case OperatorEqual:
+		return valuesEqual(actual, c.Value), nil
+	case OperatorNotEqual:
+		return !valuesEqual(actual, c.Value), nil
+	case OperatorContains:
+		return contains(actual, c.Value), nil
+	case OperatorMatches:
+		return c.pattern.MatchString(fmt.Sprint(actual)), nil
+	default:
+		result, comparable := compare(actual, c.Value)
+		if !comparable {
+			return false, nil
+		}
+		switch c.Operator {
+		case OperatorGreater:
+			return result > 0, nil
+		case OperatorGreaterOrEqual:
+			return result >= 0, nil
+		case OperatorLess:
+			return result < 0, nil
+		case OperatorLessOrEqual:
+			return result <= 0, nil
+		}
+	}
+	return false, fmt.Errorf("unknown operator '%s' for path '%s'", c.Operator, c.Path)
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+func valuesEqual(actual interface{}, expected interface{}) bool {
+	if a, ok := toFloat(actual); ok {
+		if e, ok := toFloat(expected); ok {
+			return a == e
+		}
+	}
+	if a, ok := actual.(string); ok {
+		if e, ok := expected.(string); ok {
+			return a == e
+		}
+	}
+	return reflect.DeepEqual(actual, expected)
+}
+
+// compare orders numbers numerically and strings lexically; other combinations are not comparable.
+func compare(actual interface{}, expected interface{}) (int, bool) {
+	if a, ok := toFloat(actual); ok {
+		e, ok := toFloat(expected)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case a < e:
+			return -1, true
+		case a > e:
+			return 1, true
+		}
+		return 0, true
+	}
+	a, ok := actual.(string)
+	if !ok {
+		return 0, false
+	}
+	e, ok := expected.(string)
+	if !ok {
+		return 0, false
+	}
+	return strings.Compare(a, e), true
+}
+
+func contains(actual interface{}, expected interface{}) bool {
+	switch a := actual.(type) {
+	case string:
+		e, ok := expected.(string)
+		return ok && strings.Contains(a, e)
+	case []interface{}:
+		for _, element := range a {
+			if valuesEqual(element, expected) {
+				return true
+			}
+		}
+	}
+	return false
+}
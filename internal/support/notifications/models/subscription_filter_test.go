@@ -0,0 +1,122 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"testing"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testFilterNotification() contract.Notification {
+	return contract.Notification{
+		Slug:     "temperature-alert",
+		Sender:   "rules-engine",
+		Category: contract.Hwhealth,
+		Severity: contract.Critical,
+		Content:  `{"building":"B7","temperature":81.5,"sensors":[{"name":"t1"},{"name":"t2"}]}`,
+		Labels:   []string{"temperature", "floor-3"},
+	}
+}
+
+func TestSubscriptionFilterValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		filter      SubscriptionFilter
+		expectedErr bool
+	}{
+		{"valid", SubscriptionFilter{Subscription: "s1", Conditions: []FilterCondition{{Path: "$.content.temperature", Operator: OperatorGreater, Value: 80.0}}}, false},
+		{"exists without value", SubscriptionFilter{Subscription: "s1", Conditions: []FilterCondition{{Path: "$.content.building", Operator: OperatorExists}}}, false},
+		{"missing subscription", SubscriptionFilter{Conditions: []FilterCondition{{Path: "$.severity", Operator: OperatorExists}}}, true},
+		{"no conditions", SubscriptionFilter{Subscription: "s1"}, true},
+		{"invalid path", SubscriptionFilter{Subscription: "s1", Conditions: []FilterCondition{{Path: "content.building", Operator: OperatorExists}}}, true},
+		{"unknown operator", SubscriptionFilter{Subscription: "s1", Conditions: []FilterCondition{{Path: "$.severity", Operator: "~", Value: "x"}}}, true},
+		{"missing value", SubscriptionFilter{Subscription: "s1", Conditions: []FilterCondition{{Path: "$.severity", Operator: OperatorEqual}}}, true},
+		{"invalid regular expression", SubscriptionFilter{Subscription: "s1", Conditions: []FilterCondition{{Path: "$.sender", Operator: OperatorMatches, Value: "("}}}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := testCase.filter.Validate()
+			if testCase.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSubscriptionFilterMatches(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition FilterCondition
+		expected  bool
+	}{
+		{"severity equal", FilterCondition{Path: "$.severity", Operator: OperatorEqual, Value: "CRITICAL"}, true},
+		{"severity not equal", FilterCondition{Path: "$.severity", Operator: OperatorNotEqual, Value: "CRITICAL"}, false},
+		{"content number greater", FilterCondition{Path: "$.content.temperature", Operator: OperatorGreater, Value: 80}, true},
+		{"content number less or equal", FilterCondition{Path: "$.content.temperature", Operator: OperatorLessOrEqual, Value: 80.0}, false},
+		{"content string equal", FilterCondition{Path: "$.content.building", Operator: OperatorEqual, Value: "B7"}, true},
+		{"nested array field", FilterCondition{Path: "$.content.sensors[1].name", Operator: OperatorEqual, Value: "t2"}, true},
+		{"bracket field", FilterCondition{Path: "$['content']['building']", Operator: OperatorEqual, Value: "B7"}, true},
+		{"label index", FilterCondition{Path: "$.labels[1]", Operator: OperatorEqual, Value: "floor-3"}, true},
+		{"labels contain", FilterCondition{Path: "$.labels", Operator: OperatorContains, Value: "temperature"}, true},
+		{"sender matches", FilterCondition{Path: "$.sender", Operator: OperatorMatches, Value: "^rules-"}, true},
+		{"missing field exists", FilterCondition{Path: "$.content.humidity", Operator: OperatorExists}, false},
+		{"missing field compared", FilterCondition{Path: "$.content.humidity", Operator: OperatorGreater, Value: 10}, false},
+		{"type mismatch compared", FilterCondition{Path: "$.content.building", Operator: OperatorGreater, Value: 10}, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			f := SubscriptionFilter{Subscription: "s1", Conditions: []FilterCondition{testCase.condition}}
+			require.NoError(t, f.Validate())
+			matched, err := f.Matches(testFilterNotification())
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, matched)
+		})
+	}
+}
+
+func TestSubscriptionFilterMatchAny(t *testing.T) {
+	conditions := []FilterCondition{
+		{Path: "$.content.building", Operator: OperatorEqual, Value: "B1"},
+		{Path: "$.content.temperature", Operator: OperatorGreaterOrEqual, Value: 81.5},
+	}
+
+	all := SubscriptionFilter{Subscription: "s1", Conditions: conditions}
+	matched, err := all.Matches(testFilterNotification())
+	require.NoError(t, err)
+	assert.False(t, matched)
+
+	matchAny := SubscriptionFilter{Subscription: "s1", MatchAny: true, Conditions: conditions}
+	matched, err = matchAny.Matches(testFilterNotification())
+	require.NoError(t, err)
+	assert.True(t, matched)
+}
+
+func TestSubscriptionFilterCompile(t *testing.T) {
+	compiled, err := SubscriptionFilter{Subscription: "s1", Conditions: []FilterCondition{
+		{Path: "$.sender", Operator: OperatorMatches, Value: "^rules-"},
+	}}.Compile()
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		matched, err := compiled.Matches(testFilterNotification())
+		require.NoError(t, err)
+		assert.True(t, matched)
+	}
+
+	_, err = SubscriptionFilter{Subscription: "s1", Conditions: []FilterCondition{
+		{Path: "$.sender", Operator: OperatorMatches, Value: "("},
+	}}.Compile()
+	assert.Error(t, err)
+	_, err = SubscriptionFilter{Subscription: "s1", Conditions: []FilterCondition{
+		{Path: "sender", Operator: OperatorExists},
+	}}.Compile()
+	assert.Error(t, err)
+}
@@ -0,0 +1,105 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// DefaultTemplateBody is the key of the body rendering the notifications sent through the channels of a type the
+// template has no body for.
+const DefaultTemplateBody = "DEFAULT"
+
+// templateFuncs are the functions available to the template bodies in addition to the text/template builtins.
+var templateFuncs = template.FuncMap{
+	"join":  strings.Join,
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	// json quotes a value so that it can be embedded in a JSON payload, e.g. {"text": {{json .Content}}}
+	"json": func(v interface{}) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+}
+
+// Template renders the notifications sent to the subscriptions referencing it by Name. Its bodies are Go text/template
+// templates, keyed by channel type such as EMAIL, REST or SLACK, executed with the notification as data, e.g.
+// "{{.Severity}} alert from {{.Sender}}: {{.Content}}".
+type Template struct {
+	ID          string            `json:"id,omitempty"`
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Bodies      map[string]string `json:"bodies"`
+	Created     int64             `json:"created,omitempty"`
+	Modified    int64             `json:"modified,omitempty"`
+}
+
+// Validate ensures the template is named and that all its bodies parse.
+func (t Template) Validate() error {
+	if strings.TrimSpace(t.Name) == "" {
+		return fmt.Errorf("template name is required")
+	}
+	if len(t.Bodies) == 0 {
+		return fmt.Errorf("template '%s' has no bodies", t.Name)
+	}
+	for channelType, body := range t.Bodies {
+		if strings.TrimSpace(channelType) == "" {
+			return fmt.Errorf("template '%s' has a body without a channel type", t.Name)
+		}
+		if _, err := parseBody(t.Name, channelType, body); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Render executes the body of the template for the channel type, or else its default body, with the notification as
+// data. ok is false when the template has no body for the channel type and no default body.
+func (t Template) Render(n contract.Notification, channelType string) (content string, ok bool, err error) {
+	channelType, body, ok := t.body(channelType)
+	if !ok {
+		return "", false, nil
+	}
+
+	tmpl, err := parseBody(t.Name, channelType, body)
+	if err != nil {
+		return "", true, err
+	}
+	var rendered bytes.Buffer
+	if err = tmpl.Execute(&rendered, n); err != nil {
+		return "", true, fmt.Errorf("template '%s' failed to render the %s body: %v", t.Name, channelType, err)
+	}
+	return rendered.String(), true, nil
+}
+
+// body returns the body for the channel type, matched case-insensitively, falling back on the default body.
+func (t Template) body(channelType string) (string, string, bool) {
+	var fallback string
+	hasFallback := false
+	for key, body := range t.Bodies {
+		switch strings.ToUpper(key) {
+		case strings.ToUpper(channelType):
+			return key, body, true
+		case DefaultTemplateBody:
+			fallback, hasFallback = body, true
+		}
+	}
+	return DefaultTemplateBody, fallback, hasFallback
+}
+
+func parseBody(name string, channelType string, body string) (*template.Template, error) {
+	tmpl, err := template.New(name + "/" + channelType).Funcs(templateFuncs).Option("missingkey=error").Parse(body)
+	if err != nil {
+		return nil, fmt.Errorf("template '%s' has an invalid %s body: %v", name, channelType, err)
+	}
+	return tmpl, nil
+}
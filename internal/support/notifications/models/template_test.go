@@ -0,0 +1,91 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"testing"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+var templateNotification = contract.Notification{
+	Slug:     "door-opened",
+	Sender:   "door-sensor",
+	Category: contract.Security,
+	Severity: contract.Critical,
+	Content:  `The "server room" door is open`,
+	Labels:   []string{"door", "building-a"},
+}
+
+func TestTemplateValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		template    Template
+		expectError bool
+	}{
+		{"valid", Template{Name: "alert", Bodies: map[string]string{"EMAIL": "{{.Content}}"}}, false},
+		{"no name", Template{Bodies: map[string]string{"EMAIL": "{{.Content}}"}}, true},
+		{"no bodies", Template{Name: "alert"}, true},
+		{"no channel type", Template{Name: "alert", Bodies: map[string]string{" ": "{{.Content}}"}}, true},
+		{"invalid body", Template{Name: "alert", Bodies: map[string]string{"EMAIL": "{{.Content"}}, true},
+		{"unknown function", Template{Name: "alert", Bodies: map[string]string{"EMAIL": "{{shout .Content}}"}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.template.Validate()
+			if tt.expectError && err == nil {
+				t.Error("expected an error")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("unexpected error %v", err)
+			}
+		})
+	}
+}
+
+func TestTemplateRender(t *testing.T) {
+	template := Template{
+		Name: "alert",
+		Bodies: map[string]string{
+			"rest":              `{"severity":"{{.Severity}}","text":{{json .Content}},"labels":"{{join .Labels ","}}"}`,
+			DefaultTemplateBody: `{{.Severity}} {{lower (print .Category)}} alert from {{.Sender}}: {{.Content}}`,
+			"SMS":               `{{.Missing}}`,
+		},
+	}
+
+	tests := []struct {
+		name        string
+		channelType string
+		expected    string
+		expectOk    bool
+		expectError bool
+	}{
+		{"channel type body", "REST", `{"severity":"CRITICAL","text":"The \"server room\" door is open","labels":"door,building-a"}`, true, false},
+		{"default body", "EMAIL", `CRITICAL security alert from door-sensor: The "server room" door is open`, true, false},
+		{"rendering failure", "SMS", "", true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, ok, err := template.Render(templateNotification, tt.channelType)
+			if tt.expectError != (err != nil) {
+				t.Fatalf("error mismatch -- expected error %v got %v", tt.expectError, err)
+			}
+			if ok != tt.expectOk {
+				t.Errorf("ok mismatch -- expected %v got %v", tt.expectOk, ok)
+			}
+			if content != tt.expected {
+				t.Errorf("content mismatch -- expected %s got %s", tt.expected, content)
+			}
+		})
+	}
+
+	_, ok, err := Template{Name: "email only", Bodies: map[string]string{"EMAIL": "{{.Content}}"}}.Render(templateNotification, "SLACK")
+	if ok || err != nil {
+		t.Errorf("expected no body for SLACK channels, got ok %v and error %v", ok, err)
+	}
+}
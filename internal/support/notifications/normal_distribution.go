@@ -17,7 +17,9 @@ package notifications
 
 import (
 	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/filtercache"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/sender"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
 	"github.com/edgexfoundry/go-mod-core-contracts/models"
@@ -27,9 +29,11 @@ func distributeAndMark(
 	n models.Notification,
 	lc logger.LoggingClient,
 	dbClient interfaces.DBClient,
+	senders *sender.Registry,
+	filters *filtercache.Cache,
 	config notificationsConfig.ConfigurationStruct) error {
 
-	go distribute(n, lc, dbClient, config)
+	go distribute(n, lc, dbClient, senders, filters, config)
 
 	err := dbClient.MarkNotificationProcessed(n)
 	if err != nil {
@@ -0,0 +1,85 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	notificationsContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/filtercache"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/ratemonitor"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/sender"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// monitorRates closes the windows of the monitor until ctx is done and posts a notification for each anomaly found.
+func monitorRates(ctx context.Context, monitor *ratemonitor.Monitor, dic *di.Container) {
+	ticker := time.NewTicker(monitor.Window())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+			for _, n := range monitor.CloseWindow() {
+				lc.Warn(n.Description)
+				postNotification(
+					n,
+					lc,
+					notificationsContainer.DBClientFrom(dic.Get),
+					notificationsContainer.ChannelSendersFrom(dic.Get),
+					notificationsContainer.FilterCacheFrom(dic.Get),
+					*notificationsContainer.ConfigurationFrom(dic.Get))
+			}
+		}
+	}
+}
+
+// postNotification stores a notification raised by the service itself, such as a rate anomaly, and distributes it to
+// its subscribers.
+func postNotification(
+	n models.Notification,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	senders *sender.Registry,
+	filters *filtercache.Cache,
+	config notificationsConfig.ConfigurationStruct) {
+
+	n.Status = models.NotificationsStatus(models.New)
+	id, err := dbClient.AddNotification(n)
+	if err != nil {
+		lc.Error("Unable to add notification " + n.Slug + ": " + err.Error())
+		return
+	}
+
+	n, err = dbClient.GetNotificationById(id)
+	if err != nil {
+		lc.Error(err.Error())
+		return
+	}
+
+	_ = distributeAndMark(n, lc, dbClient, senders, filters, config)
+}
+
+func restGetNotificationStatistics(w http.ResponseWriter, lc logger.LoggingClient, monitor *ratemonitor.Monitor) {
+	if monitor == nil {
+		http.Error(w, "notification rate monitoring is disabled", http.StatusServiceUnavailable)
+		return
+	}
+	pkg.Encode(monitor.Statistics(), w, lc)
+}
@@ -0,0 +1,189 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ratemonitor tracks how many notifications are created per category and severity and detects when the rate
+// of a category deviates sharply from its baseline, which points at alarm storms or alert sources gone silent.
+package ratemonitor
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+const (
+	// Storm is reported when a category produces far more notifications than its baseline.
+	Storm = "STORM"
+	// Silence is reported when a category which usually produces notifications produces none.
+	Silence = "SILENCE"
+
+	// AnomalyLabel labels the notifications raised by the monitor; they are never counted themselves.
+	AnomalyLabel = "notification-rate-anomaly"
+	// AnomalySender is the sender of the notifications raised by the monitor.
+	AnomalySender = "support-notifications"
+)
+
+// Config holds the parameters of the anomaly detection.
+type Config struct {
+	// Window is the length of the period over which notifications are counted.
+	Window time.Duration
+	// SmoothingFactor weighs the latest window in the exponentially weighted baseline, between 0 and 1.
+	SmoothingFactor float64
+	// StormFactor is how many times the baseline a window has to reach to be considered a storm.
+	StormFactor float64
+	// MinBaseline is the baseline below which a category is considered too quiet to detect anomalies.
+	MinBaseline float64
+	// WarmupWindows is the number of windows used to establish the baseline before anomalies are reported.
+	WarmupWindows int
+}
+
+// Statistic describes the notification rate of a category and severity.
+type Statistic struct {
+	Category  string  `json:"category"`
+	Severity  string  `json:"severity"`
+	Count     int     `json:"count"`
+	LastCount int     `json:"lastCount"`
+	Baseline  float64 `json:"baseline"`
+	Windows   int     `json:"windows"`
+	Anomaly   string  `json:"anomaly,omitempty"`
+}
+
+type key struct {
+	category string
+	severity string
+}
+
+// Monitor counts the notifications created per category and severity over consecutive windows.
+type Monitor struct {
+	config     Config
+	mutex      sync.Mutex
+	statistics map[key]*Statistic
+}
+
+// NewMonitor creates a Monitor detecting anomalies according to config.
+func NewMonitor(config Config) *Monitor {
+	return &Monitor{
+		config:     config,
+		statistics: make(map[key]*Statistic),
+	}
+}
+
+// Window returns the length of the counting window.
+func (m *Monitor) Window() time.Duration {
+	return m.config.Window
+}
+
+// Record counts the notification in the current window. A nil Monitor records nothing.
+func (m *Monitor) Record(n models.Notification) {
+	if m == nil || isAnomalyNotification(n) {
+		return
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	k := key{category: string(n.Category), severity: string(n.Severity)}
+	s, ok := m.statistics[k]
+	if !ok {
+		s = &Statistic{Category: k.category, Severity: k.severity}
+		m.statistics[k] = s
+	}
+	s.Count++
+}
+
+// Statistics returns the statistics of all the categories and severities seen so far.
+func (m *Monitor) Statistics() []Statistic {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	result := make([]Statistic, 0, len(m.statistics))
+	for _, s := range m.statistics {
+		result = append(result, *s)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Category != result[j].Category {
+			return result[i].Category < result[j].Category
+		}
+		return result[i].Severity < result[j].Severity
+	})
+	return result
+}
+
+// CloseWindow ends the current window, updates the baselines and returns a notification for every category and
+// severity which entered an anomaly during the window. An anomaly is only reported once until the rate recovers.
+func (m *Monitor) CloseWindow() []models.Notification {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	var alerts []models.Notification
+	for _, s := range m.statistics {
+		anomaly := m.detect(*s)
+		if anomaly != "" && anomaly != s.Anomaly {
+			alerts = append(alerts, m.newAlert(*s, anomaly))
+		}
+		s.Anomaly = anomaly
+
+		if s.Windows == 0 {
+			s.Baseline = float64(s.Count)
+		} else {
+			s.Baseline = m.config.SmoothingFactor*float64(s.Count) + (1-m.config.SmoothingFactor)*s.Baseline
+		}
+		s.Windows++
+		s.LastCount = s.Count
+		s.Count = 0
+	}
+	return alerts
+}
+
+// detect returns the anomaly of the window described by s, if any.
+func (m *Monitor) detect(s Statistic) string {
+	if s.Windows < m.config.WarmupWindows || s.Baseline < m.config.MinBaseline || s.Baseline <= 0 {
+		return ""
+	}
+	switch {
+	case float64(s.Count) >= s.Baseline*m.config.StormFactor:
+		return Storm
+	case s.Count == 0:
+		return Silence
+	}
+	return ""
+}
+
+func (m *Monitor) newAlert(s Statistic, anomaly string) models.Notification {
+	now := db.MakeTimestamp()
+	var description string
+	// a storm floods the subscribers and hides the notifications that matter, so it is raised as critical
+	severity := models.NotificationsSeverity(models.Normal)
+	if anomaly == Storm {
+		severity = models.Critical
+		description = fmt.Sprintf("Notification storm: %d %s notifications of category %s within %s, baseline is %.1f",
+			s.Count, s.Severity, s.Category, m.config.Window, s.Baseline)
+	} else {
+		description = fmt.Sprintf("Notification source silent: no %s notifications of category %s within %s, baseline is %.1f",
+			s.Severity, s.Category, m.config.Window, s.Baseline)
+	}
+
+	return models.Notification{
+		Slug:        fmt.Sprintf("%s-%s-%s-%d", AnomalyLabel, s.Category, s.Severity, now),
+		Sender:      AnomalySender,
+		Category:    models.Swhealth,
+		Severity:    severity,
+		Content:     description,
+		Description: description,
+		Labels:      []string{AnomalyLabel, anomaly},
+	}
+}
+
+func isAnomalyNotification(n models.Notification) bool {
+	for _, label := range n.Labels {
+		if label == AnomalyLabel {
+			return true
+		}
+	}
+	return false
+}
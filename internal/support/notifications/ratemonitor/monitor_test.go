@@ -0,0 +1,88 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ratemonitor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testConfig = Config{
+	Window:          time.Minute,
+	SmoothingFactor: 0.5,
+	StormFactor:     3,
+	MinBaseline:     1,
+	WarmupWindows:   2,
+}
+
+var testNotification = models.Notification{Category: models.Hwhealth, Severity: models.Critical}
+
+func record(m *Monitor, n models.Notification, count int) {
+	for i := 0; i < count; i++ {
+		m.Record(n)
+	}
+}
+
+func TestMonitorDetectsStorm(t *testing.T) {
+	m := NewMonitor(testConfig)
+	for i := 0; i < testConfig.WarmupWindows; i++ {
+		record(m, testNotification, 2)
+		assert.Empty(t, m.CloseWindow())
+	}
+
+	record(m, testNotification, 10)
+	alerts := m.CloseWindow()
+	require.Len(t, alerts, 1)
+	assert.Contains(t, alerts[0].Labels, Storm)
+	assert.Equal(t, models.NotificationsCategory(models.Swhealth), alerts[0].Category)
+	assert.Equal(t, models.NotificationsSeverity(models.Critical), alerts[0].Severity)
+
+	record(m, testNotification, 20)
+	assert.Empty(t, m.CloseWindow(), "an ongoing storm should only be reported once")
+
+	stats := m.Statistics()
+	require.Len(t, stats, 1)
+	assert.Equal(t, Storm, stats[0].Anomaly)
+	assert.Equal(t, 20, stats[0].LastCount)
+}
+
+func TestMonitorDetectsSilence(t *testing.T) {
+	m := NewMonitor(testConfig)
+	for i := 0; i < testConfig.WarmupWindows; i++ {
+		record(m, testNotification, 4)
+		m.CloseWindow()
+	}
+
+	alerts := m.CloseWindow()
+	require.Len(t, alerts, 1)
+	assert.Contains(t, alerts[0].Labels, Silence)
+	assert.Equal(t, models.NotificationsSeverity(models.Normal), alerts[0].Severity)
+}
+
+func TestMonitorIgnoresQuietCategoriesAndOwnAlerts(t *testing.T) {
+	config := testConfig
+	config.MinBaseline = 5
+	m := NewMonitor(config)
+	for i := 0; i < config.WarmupWindows; i++ {
+		record(m, testNotification, 1)
+		m.CloseWindow()
+	}
+	record(m, testNotification, 10)
+	assert.Empty(t, m.CloseWindow(), "categories below the minimum baseline should not raise alerts")
+
+	m.Record(models.Notification{Category: models.Swhealth, Severity: models.Normal, Labels: []string{AnomalyLabel}})
+	assert.Len(t, m.Statistics(), 1)
+}
+
+func TestNilMonitorRecordsNothing(t *testing.T) {
+	var m *Monitor
+	assert.NotPanics(t, func() { m.Record(testNotification) })
+}
@@ -0,0 +1,55 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/utils"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+)
+
+// notificationBacklogResponse is the response of the notification backlog API.
+type notificationBacklogResponse struct {
+	commonDTO.BaseResponse                  `json:",inline"`
+	notificationsModels.NotificationBacklog `json:",inline"`
+}
+
+// restGetNotificationBacklog returns the notifications counted by status, the transmissions pending a resend counted by
+// channel type and the age of the oldest pending one, so that monitoring can detect a stuck distribution pipeline.
+// api/v2/notification/backlog
+func restGetNotificationBacklog(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	b, err := dbClient.GetNotificationBacklog()
+	if err != nil {
+		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		utils.WriteHttpHeader(w, ctx, http.StatusInternalServerError)
+		pkg.Encode(commonDTO.NewBaseResponse("", err.Error(), http.StatusInternalServerError), w, lc)
+		return
+	}
+	b.Age(db.MakeTimestamp())
+
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(notificationBacklogResponse{
+		BaseResponse:        commonDTO.NewBaseResponse("", "", http.StatusOK),
+		NotificationBacklog: b,
+	}, w, lc)
+}
@@ -0,0 +1,64 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces/mocks"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetNotificationBacklog(t *testing.T) {
+	backlog := notificationsModels.NewNotificationBacklog()
+	backlog.Notifications[contract.New] = 3
+	backlog.Notifications[contract.Processed] = 40
+	backlog.AddPendingTransmission(contract.Transmission{
+		Timestamps: contract.Timestamps{Created: db.MakeTimestamp() - 60000},
+		Channel:    contract.Channel{Type: contract.Rest},
+	})
+
+	tests := []struct {
+		name           string
+		err            error
+		expectedStatus int
+	}{
+		{"OK", nil, http.StatusOK},
+		{"Database error", errors.New("test error"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dbMock := &mocks.DBClient{}
+			dbMock.On("GetNotificationBacklog").Return(backlog, tt.err)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v2/notification/backlog", nil)
+			rr := httptest.NewRecorder()
+			restGetNotificationBacklog(rr, req, logger.NewMockClient(), dbMock)
+
+			require.Equal(t, tt.expectedStatus, rr.Code)
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+			var response notificationBacklogResponse
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+			assert.Equal(t, backlog.Notifications, response.Notifications)
+			assert.Equal(t, map[contract.ChannelType]int{contract.Rest: 1}, response.PendingTransmissions)
+			assert.GreaterOrEqual(t, response.OldestPendingAge, int64(60000))
+		})
+	}
+}
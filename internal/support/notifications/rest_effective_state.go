@@ -0,0 +1,157 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// effectiveState is the declarative state of the service, in the canonical form emitted by the effective state
+// endpoint: no identifier nor timestamp specific to the site, and every list sorted, so that GitOps pipelines can diff
+// it against the state kept under version control to detect drift.
+type effectiveState struct {
+	Subscriptions    []effectiveSubscription               `json:"subscriptions"`
+	Templates        []notificationsModels.Template        `json:"templates"`
+	SeverityMappings []notificationsModels.SeverityMapping `json:"severityMappings"`
+}
+
+// effectiveSubscription groups a subscription with the filter, template and policies attached to it.
+type effectiveSubscription struct {
+	Subscription     models.Subscription                     `json:"subscription"`
+	Template         string                                  `json:"template,omitempty"`
+	Filter           *notificationsModels.SubscriptionFilter `json:"filter,omitempty"`
+	EscalationPolicy *notificationsModels.EscalationPolicy   `json:"escalationPolicy,omitempty"`
+	RetryPolicy      *notificationsModels.RetryPolicy        `json:"retryPolicy,omitempty"`
+	RoutingPolicy    *notificationsModels.RoutingPolicy      `json:"routingPolicy,omitempty"`
+}
+
+func restGetEffectiveState(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	state, err := readEffectiveState(dbClient)
+	if err != nil {
+		lc.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	pkg.EncodeCanonical(state, w, r, lc)
+}
+
+// readEffectiveState reads the subscriptions, along with what is attached to them, the templates and the severity
+// mappings in their canonical form. What the database doesn't support can't be configured and is left empty.
+func readEffectiveState(dbClient interfaces.DBClient) (effectiveState, error) {
+	subscriptions, err := dbClient.GetSubscriptions()
+	if err != nil {
+		return effectiveState{}, err
+	}
+	templates, err := dbClient.GetTemplates()
+	if err != nil && !isAbsent(err) {
+		return effectiveState{}, err
+	}
+	mappings, err := dbClient.GetSeverityMappings()
+	if err != nil && !isAbsent(err) {
+		return effectiveState{}, err
+	}
+
+	state := effectiveState{
+		Subscriptions:    make([]effectiveSubscription, len(subscriptions)),
+		Templates:        make([]notificationsModels.Template, len(templates)),
+		SeverityMappings: make([]notificationsModels.SeverityMapping, len(mappings)),
+	}
+	for i, s := range subscriptions {
+		if state.Subscriptions[i], err = readEffectiveSubscription(s, dbClient); err != nil {
+			return effectiveState{}, err
+		}
+	}
+	sort.Slice(state.Subscriptions, func(i, j int) bool {
+		return state.Subscriptions[i].Subscription.Slug < state.Subscriptions[j].Subscription.Slug
+	})
+
+	for i, t := range templates {
+		t.ID, t.Created, t.Modified = "", 0, 0
+		state.Templates[i] = t
+	}
+	sort.Slice(state.Templates, func(i, j int) bool { return state.Templates[i].Name < state.Templates[j].Name })
+
+	for i, m := range mappings {
+		m.ID, m.Created, m.Modified = "", 0, 0
+		state.SeverityMappings[i] = m
+	}
+	sort.Slice(state.SeverityMappings, func(i, j int) bool {
+		return state.SeverityMappings[i].Name < state.SeverityMappings[j].Name
+	})
+	return state, nil
+}
+
+// readEffectiveSubscription reads what is attached to the subscription, the categories and labels it subscribes to
+// being sorted as their order doesn't matter.
+func readEffectiveSubscription(s models.Subscription, dbClient interfaces.DBClient) (effectiveSubscription, error) {
+	s.ID = ""
+	s.Timestamps = models.Timestamps{}
+	s.SubscribedCategories = append([]models.NotificationsCategory(nil), s.SubscribedCategories...)
+	sort.Slice(s.SubscribedCategories, func(i, j int) bool {
+		return s.SubscribedCategories[i] < s.SubscribedCategories[j]
+	})
+	s.SubscribedLabels = append([]string(nil), s.SubscribedLabels...)
+	sort.Strings(s.SubscribedLabels)
+	effective := effectiveSubscription{Subscription: s}
+
+	template, err := dbClient.GetSubscriptionTemplate(s.Slug)
+	if err != nil && !isAbsent(err) {
+		return effectiveSubscription{}, err
+	}
+	effective.Template = template
+
+	if f, err := dbClient.GetSubscriptionFilterBySlug(s.Slug); err == nil {
+		f.ID, f.Created, f.Modified = "", 0, 0
+		effective.Filter = &f
+	} else if !isAbsent(err) {
+		return effectiveSubscription{}, err
+	}
+
+	if p, err := dbClient.GetEscalationPolicyBySlug(s.Slug); err == nil {
+		p.ID, p.Created, p.Modified = "", 0, 0
+		effective.EscalationPolicy = &p
+	} else if !isAbsent(err) {
+		return effectiveSubscription{}, err
+	}
+
+	if p, err := dbClient.GetRetryPolicyBySlug(s.Slug); err == nil {
+		p.ID, p.Created, p.Modified = "", 0, 0
+		effective.RetryPolicy = &p
+	} else if !isAbsent(err) {
+		return effectiveSubscription{}, err
+	}
+
+	if p, err := dbClient.GetRoutingPolicyBySlug(s.Slug); err == nil {
+		p.ID, p.Created, p.Modified = "", 0, 0
+		effective.RoutingPolicy = &p
+	} else if !isAbsent(err) {
+		return effectiveSubscription{}, err
+	}
+	return effective, nil
+}
+
+// isAbsent tells whether err reports that there is nothing stored, or that the database doesn't support storing it.
+func isAbsent(err error) bool {
+	return err == db.ErrNotFound || err == db.ErrUnsupportedDatabase
+}
@@ -0,0 +1,115 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces/mocks"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockEffectiveStateLoader(subscriptionsErr error) *mocks.DBClient {
+	alerts := contract.Subscription{
+		ID:                   TestId,
+		Slug:                 "alerts",
+		Receiver:             "Operators",
+		SubscribedCategories: []contract.NotificationsCategory{"SW_HEALTH", "HW_HEALTH"},
+		SubscribedLabels:     []string{"temperature", "pressure"},
+		Timestamps:           contract.Timestamps{Created: 1600000000000, Modified: 1600000000000},
+	}
+	audit := contract.Subscription{ID: "audit-id", Slug: "audit", Receiver: "Auditors"}
+
+	dbMock := &mocks.DBClient{}
+	dbMock.On("GetSubscriptions").Return([]contract.Subscription{audit, alerts}, subscriptionsErr)
+	dbMock.On("GetTemplates").Return(nil, db.ErrUnsupportedDatabase)
+	dbMock.On("GetSeverityMappings").Return([]notificationsModels.SeverityMapping{
+		{ID: "zabbix-id", Name: "zabbix", Created: 1600000000000},
+		{ID: "nagios-id", Name: "nagios"},
+	}, nil)
+	dbMock.On("GetSubscriptionTemplate", alerts.Slug).Return("alert", nil)
+	dbMock.On("GetSubscriptionTemplate", audit.Slug).Return("", db.ErrNotFound)
+	dbMock.On("GetSubscriptionFilterBySlug", alerts.Slug).Return(notificationsModels.SubscriptionFilter{
+		ID:           "filter-id",
+		Subscription: alerts.Slug,
+		Conditions:   []notificationsModels.FilterCondition{{Path: "$.severity", Operator: "eq", Value: "CRITICAL"}},
+	}, nil)
+	dbMock.On("GetSubscriptionFilterBySlug", audit.Slug).Return(notificationsModels.SubscriptionFilter{}, db.ErrNotFound)
+	dbMock.On("GetEscalationPolicyBySlug", mock.Anything).Return(notificationsModels.EscalationPolicy{}, db.ErrNotFound)
+	dbMock.On("GetRetryPolicyBySlug", alerts.Slug).Return(notificationsModels.RetryPolicy{
+		ID:              "retry-id",
+		Subscription:    alerts.Slug,
+		MaxAttempts:     3,
+		InitialInterval: "10s",
+		Modified:        1600000000000,
+	}, nil)
+	dbMock.On("GetRetryPolicyBySlug", audit.Slug).Return(notificationsModels.RetryPolicy{}, db.ErrNotFound)
+	dbMock.On("GetRoutingPolicyBySlug", mock.Anything).Return(notificationsModels.RoutingPolicy{}, db.ErrNotFound)
+	return dbMock
+}
+
+func TestGetEffectiveState(t *testing.T) {
+	uri := clients.ApiConfigRoute + "/" + EFFECTIVE
+	rr := httptest.NewRecorder()
+	restGetEffectiveState(rr, httptest.NewRequest(http.MethodGet, uri, nil), logger.NewMockClient(), createMockEffectiveStateLoader(nil))
+	require.Equal(t, http.StatusOK, rr.Code)
+	etag := rr.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+
+	var state effectiveState
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &state))
+	require.Len(t, state.Subscriptions, 2)
+	alerts := state.Subscriptions[0]
+	assert.Equal(t, "alerts", alerts.Subscription.Slug, "the subscriptions should be sorted by slug")
+	assert.Empty(t, alerts.Subscription.ID, "site specific ids should not be emitted")
+	assert.Zero(t, alerts.Subscription.Created)
+	assert.Equal(t, []contract.NotificationsCategory{"HW_HEALTH", "SW_HEALTH"}, alerts.Subscription.SubscribedCategories)
+	assert.Equal(t, []string{"pressure", "temperature"}, alerts.Subscription.SubscribedLabels)
+	assert.Equal(t, "alert", alerts.Template)
+	require.NotNil(t, alerts.Filter)
+	assert.Empty(t, alerts.Filter.ID)
+	require.NotNil(t, alerts.RetryPolicy)
+	assert.Equal(t, 3, alerts.RetryPolicy.MaxAttempts)
+	assert.Zero(t, alerts.RetryPolicy.Modified)
+	assert.Nil(t, alerts.EscalationPolicy)
+
+	audit := state.Subscriptions[1]
+	assert.Empty(t, audit.Template)
+	assert.Nil(t, audit.Filter)
+	assert.Nil(t, audit.RetryPolicy)
+
+	assert.Empty(t, state.Templates, "the templates unsupported by the database should be left empty")
+	require.Len(t, state.SeverityMappings, 2)
+	assert.Equal(t, "nagios", state.SeverityMappings[0].Name)
+	assert.Empty(t, state.SeverityMappings[1].ID)
+
+	rr = httptest.NewRecorder()
+	restGetEffectiveState(rr, httptest.NewRequest(http.MethodGet, uri, nil), logger.NewMockClient(), createMockEffectiveStateLoader(nil))
+	assert.Equal(t, etag, rr.Header().Get("ETag"), "the same state should be emitted identically")
+
+	req := httptest.NewRequest(http.MethodGet, uri, nil)
+	req.Header.Set("If-None-Match", etag)
+	rr = httptest.NewRecorder()
+	restGetEffectiveState(rr, req, logger.NewMockClient(), createMockEffectiveStateLoader(nil))
+	assert.Equal(t, http.StatusNotModified, rr.Code)
+	assert.Empty(t, rr.Body.Bytes())
+
+	rr = httptest.NewRecorder()
+	restGetEffectiveState(rr, httptest.NewRequest(http.MethodGet, uri, nil), logger.NewMockClient(), createMockEffectiveStateLoader(testError))
+	assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+}
@@ -0,0 +1,243 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/errors"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/gorilla/mux"
+)
+
+func restGetEscalationPolicy(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	slug := mux.Vars(r)[SLUG]
+	p, err := dbClient.GetEscalationPolicyBySlug(slug)
+	if err != nil {
+		if err == db.ErrNotFound {
+			err = errors.NewErrEscalationPolicyNotFound(slug)
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return
+	}
+
+	pkg.Encode(p, w, lc)
+}
+
+func restAddEscalationPolicy(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	p, ok := decodeEscalationPolicy(w, r, lc, dbClient)
+	if !ok {
+		return
+	}
+
+	lc.Info("Posting escalation policy of subscription: " + p.Subscription)
+	id, err := dbClient.AddEscalationPolicy(p)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		lc.Error(err.Error())
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	w.Write([]byte(id))
+}
+
+func restUpdateEscalationPolicy(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	p, ok := decodeEscalationPolicy(w, r, lc, dbClient)
+	if !ok {
+		return
+	}
+
+	lc.Info("Updating escalation policy of subscription: " + p.Subscription)
+	if err := dbClient.UpdateEscalationPolicy(p); err != nil {
+		if err == db.ErrNotFound {
+			err = errors.NewErrEscalationPolicyNotFound(p.Subscription)
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return
+	}
+
+	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("true"))
+}
+
+func restDeleteEscalationPolicy(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	slug := mux.Vars(r)[SLUG]
+	lc.Info("Deleting escalation policy of subscription: " + slug)
+
+	if err := dbClient.DeleteEscalationPolicyBySlug(slug); err != nil {
+		if err == db.ErrNotFound {
+			err = errors.NewErrEscalationPolicyNotFound(slug)
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return
+	}
+
+	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("true"))
+}
+
+// restAcknowledgeTransmission marks the transmission as acknowledged by its receiver, which stops the escalation of
+// its notification.
+func restAcknowledgeTransmission(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	id := mux.Vars(r)[ID]
+	t, err := dbClient.GetTransmissionById(id)
+	if err != nil {
+		if err == db.ErrNotFound {
+			err = errors.NewErrTransmissionNotFound(id)
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return
+	}
+
+	lc.Info("Acknowledging transmission: " + id)
+	t.Status = models.Acknowledged
+	if err = dbClient.UpdateTransmission(t); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		lc.Error(err.Error())
+		return
+	}
+
+	e, err := dbClient.GetEscalationByTransmission(id)
+	if err == nil && e.Status != notificationsModels.EscalationAcknowledged {
+		e.Status = notificationsModels.EscalationAcknowledged
+		if err = dbClient.UpdateEscalation(e); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			lc.Error(err.Error())
+			return
+		}
+	} else if err != nil && err != db.ErrNotFound {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		lc.Error(err.Error())
+		return
+	}
+
+	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("true"))
+}
+
+// decodeEscalationPolicy reads and validates the escalation policy of the subscription addressed by the request path,
+// writing the error response itself when the policy cannot be accepted.
+func decodeEscalationPolicy(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) (notificationsModels.EscalationPolicy, bool) {
+
+	var p notificationsModels.EscalationPolicy
+	err := json.NewDecoder(r.Body).Decode(&p)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error("Error decoding escalation policy: " + err.Error())
+		return p, false
+	}
+
+	slug := mux.Vars(r)[SLUG]
+	if p.Subscription != "" && p.Subscription != slug {
+		http.Error(w, "Subscription of escalation policy does not match the request path", http.StatusBadRequest)
+		lc.Error("Subscription of escalation policy " + p.Subscription + " does not match " + slug)
+		return p, false
+	}
+	p.Subscription = slug
+
+	if err = p.Validate(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error(err.Error())
+		return p, false
+	}
+	for _, level := range p.Levels {
+		contacts := models.Subscription{Channels: level.Channels}
+		if err = validateEmailAddresses(contacts); err == nil {
+			err = validatePhoneNumbers(contacts)
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			lc.Error(err.Error())
+			return p, false
+		}
+	}
+
+	if _, err = dbClient.GetSubscriptionBySlug(slug); err != nil {
+		if err == db.ErrNotFound {
+			err = errors.NewErrSubscriptionNotFound(slug)
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return p, false
+	}
+	return p, true
+}
@@ -0,0 +1,194 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces/mocks"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/sender"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/mock"
+)
+
+var escalationPolicyForAdd = notificationsModels.EscalationPolicy{
+	Subscription: subscriptionForAdd.Slug,
+	Levels: []notificationsModels.EscalationLevel{
+		{
+			Window:   "5m",
+			Receiver: "On-call engineer",
+			Channels: []contract.Channel{{Type: contract.ChannelType(contract.Email), MailAddresses: []string{"oncall@example.com"}}},
+		},
+		{
+			Window:   "15m",
+			Receiver: "Operations manager",
+			Channels: []contract.Channel{{Type: contract.ChannelType(contract.Email), MailAddresses: []string{"manager@example.com"}}},
+		},
+	},
+}
+
+var criticalNotification = contract.Notification{
+	Slug:     "overheating",
+	Sender:   "thermostat",
+	Category: contract.Hwhealth,
+	Severity: contract.Critical,
+	Content:  "temperature is 95",
+}
+
+type recordingSender struct {
+	sent []contract.Notification
+}
+
+func (s *recordingSender) Send(n contract.Notification, _ contract.Channel) contract.TransmissionRecord {
+	s.sent = append(s.sent, n)
+	return contract.TransmissionRecord{Status: contract.Sent}
+}
+
+func TestAcknowledgeTransmission(t *testing.T) {
+	escalation := notificationsModels.Escalation{ID: "esc", Transmissions: []string{"trx"}, Status: notificationsModels.EscalationActive}
+
+	tests := []struct {
+		name           string
+		dbMock         interfaces.DBClient
+		expectedStatus int
+	}{
+		{"OK", createMockAcknowledgeLoader(nil, escalation, nil), http.StatusOK},
+		{"OK without escalation", createMockAcknowledgeLoader(nil, notificationsModels.Escalation{}, db.ErrNotFound), http.StatusOK},
+		{"Transmission not found", createMockAcknowledgeLoader(db.ErrNotFound, escalation, nil), http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := mux.SetURLVars(httptest.NewRequest(http.MethodPut, TestURI, nil), map[string]string{ID: "trx"})
+			rr := httptest.NewRecorder()
+			restAcknowledgeTransmission(rr, req, logger.NewMockClient(), tt.dbMock)
+			response := rr.Result()
+			if response.StatusCode != tt.expectedStatus {
+				t.Errorf("status code mismatch -- expected %v got %v", tt.expectedStatus, response.StatusCode)
+			}
+			if tt.expectedStatus == http.StatusOK {
+				tt.dbMock.(*mocks.DBClient).AssertCalled(t, "UpdateTransmission", mock.MatchedBy(func(t contract.Transmission) bool {
+					return t.Status == contract.Acknowledged
+				}))
+			}
+		})
+	}
+}
+
+func TestStartEscalation(t *testing.T) {
+	normal := criticalNotification
+	normal.Severity = contract.Normal
+
+	withPolicy := &mocks.DBClient{}
+	withPolicy.On("GetEscalationPolicyBySlug", subscriptionForAdd.Slug).Return(escalationPolicyForAdd, nil)
+	withPolicy.On("AddEscalation", mock.Anything).Return("esc", nil)
+	withoutPolicy := &mocks.DBClient{}
+	withoutPolicy.On("GetEscalationPolicyBySlug", subscriptionForAdd.Slug).Return(notificationsModels.EscalationPolicy{}, db.ErrNotFound)
+
+	tests := []struct {
+		name         string
+		notification contract.Notification
+		dbMock       *mocks.DBClient
+		expectAdded  bool
+	}{
+		{"critical notification", criticalNotification, withPolicy, true},
+		{"normal notification", normal, withPolicy, false},
+		{"no escalation policy", criticalNotification, withoutPolicy, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.dbMock.Calls = nil
+			startEscalation(tt.notification, subscriptionForAdd, []string{"trx"}, logger.NewMockClient(), tt.dbMock)
+			if tt.expectAdded {
+				tt.dbMock.AssertCalled(t, "AddEscalation", mock.MatchedBy(func(e notificationsModels.Escalation) bool {
+					return e.Notification == criticalNotification.Slug && e.Level == 0 &&
+						e.Status == notificationsModels.EscalationActive && e.Deadline > db.MakeTimestamp()
+				}))
+			} else {
+				tt.dbMock.AssertNotCalled(t, "AddEscalation", mock.Anything)
+			}
+		})
+	}
+}
+
+func TestAdvanceEscalation(t *testing.T) {
+	active := notificationsModels.Escalation{
+		ID:            "esc",
+		Notification:  criticalNotification.Slug,
+		Subscription:  subscriptionForAdd.Slug,
+		Transmissions: []string{"trx"},
+		Status:        notificationsModels.EscalationActive,
+	}
+	lastLevel := active
+	lastLevel.Level = 1
+
+	tests := []struct {
+		name           string
+		escalation     notificationsModels.Escalation
+		acknowledged   bool
+		expectSent     bool
+		expectedLevel  int
+		expectedStatus string
+	}{
+		{"first level", active, false, true, 1, notificationsModels.EscalationActive},
+		{"last level", lastLevel, false, true, 2, notificationsModels.EscalationExhausted},
+		{"acknowledged", active, true, false, 0, notificationsModels.EscalationAcknowledged},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := contract.TransmissionStatus(contract.Sent)
+			if tt.acknowledged {
+				status = contract.Acknowledged
+			}
+			dbMock := &mocks.DBClient{}
+			dbMock.On("GetTransmissionById", "trx").Return(contract.Transmission{ID: "trx", Status: status}, nil)
+			dbMock.On("GetEscalationPolicyBySlug", subscriptionForAdd.Slug).Return(escalationPolicyForAdd, nil)
+			dbMock.On("GetNotificationBySlug", criticalNotification.Slug).Return(criticalNotification, nil)
+			dbMock.On("GetRetryPolicyBySlug", subscriptionForAdd.Slug).Return(notificationsModels.RetryPolicy{}, db.ErrNotFound)
+			dbMock.On("AddNotification", mock.Anything).Return("escalated", nil)
+			dbMock.On("AddTransmission", mock.Anything).Return("escalated-trx", nil)
+			dbMock.On("GetTransmissionById", "escalated-trx").Return(contract.Transmission{ID: "escalated-trx", Status: contract.Sent}, nil)
+			dbMock.On("UpdateEscalation", mock.Anything).Return(nil)
+
+			email := &recordingSender{}
+			senders := sender.NewRegistry()
+			senders.Register(contract.Email, email)
+
+			advanceEscalation(tt.escalation, logger.NewMockClient(), dbMock, senders, notificationsConfig.ConfigurationStruct{})
+
+			if tt.expectSent != (len(email.sent) == 1) {
+				t.Fatalf("expected the next level to be contacted %v, sent %d notifications", tt.expectSent, len(email.sent))
+			}
+			if tt.expectSent && email.sent[0].Status != contract.Escalated {
+				t.Errorf("expected an escalated notification, got status %s", email.sent[0].Status)
+			}
+			dbMock.AssertCalled(t, "UpdateEscalation", mock.MatchedBy(func(e notificationsModels.Escalation) bool {
+				return e.Level == tt.expectedLevel && e.Status == tt.expectedStatus
+			}))
+		})
+	}
+}
+
+func createMockAcknowledgeLoader(getErr error, e notificationsModels.Escalation, getEscalationErr error) interfaces.DBClient {
+	myMock := mocks.DBClient{}
+	myMock.On("GetTransmissionById", "trx").Return(contract.Transmission{ID: "trx", Status: contract.Sent}, getErr)
+	myMock.On("UpdateTransmission", mock.Anything).Return(nil)
+	myMock.On("GetEscalationByTransmission", "trx").Return(e, getEscalationErr)
+	myMock.On("UpdateEscalation", mock.Anything).Return(nil)
+	return &myMock
+}
@@ -24,8 +24,12 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
 	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/errors"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/filtercache"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/operators/notification"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/ratemonitor"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/sender"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/suppressor"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/clients"
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
@@ -39,7 +43,11 @@ func notificationHandler(
 	r *http.Request,
 	lc logger.LoggingClient,
 	dbClient interfaces.DBClient,
-	config notificationsConfig.ConfigurationStruct) {
+	senders *sender.Registry,
+	filters *filtercache.Cache,
+	config notificationsConfig.ConfigurationStruct,
+	monitor *ratemonitor.Monitor,
+	suppressor *suppressor.Suppressor) {
 
 	if r.Body != nil {
 		defer r.Body.Close()
@@ -55,6 +63,20 @@ func notificationHandler(
 		return
 	}
 
+	if scheme := r.URL.Query().Get(SEVERITYSCHEME); scheme != "" {
+		err = applySeverityMapping(&n, scheme, r.URL.Query().Get(EXTERNALSEVERITY), dbClient)
+		if err != nil {
+			switch err.(type) {
+			case errors.ErrSeverityMappingNotFound, errors.ErrUnmappedSeverity:
+				http.Error(w, err.Error(), http.StatusBadRequest)
+			default:
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+			lc.Error(err.Error())
+			return
+		}
+	}
+
 	lc.Info("Posting Notification: " + n.String())
 	n.Status = models.NotificationsStatus(models.New)
 	n.ID, err = dbClient.AddNotification(n)
@@ -63,6 +85,7 @@ func notificationHandler(
 		lc.Error(err.Error())
 		return
 	}
+	monitor.Record(n)
 
 	lc.Debug("The scheduler is triggered for: " + n.Slug)
 	n, err = dbClient.GetNotificationById(n.ID)
@@ -72,9 +95,19 @@ func notificationHandler(
 		return
 	}
 
-	err = distributeAndMark(n, lc, dbClient, config)
-	if err != nil {
-		return
+	if suppressor.Admit(n, db.MakeTimestamp()) {
+		err = distributeAndMark(n, lc, dbClient, senders, filters, config)
+		if err != nil {
+			return
+		}
+	} else {
+		lc.Info("Suppressing duplicate notification: " + n.Slug)
+		err = dbClient.MarkNotificationProcessed(n)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			lc.Error(err.Error())
+			return
+		}
 	}
 	lc.Debug("The scheduler has completed for: " + n.Slug)
 
@@ -1005,7 +1005,11 @@ func TestNotificationHandler(t *testing.T) {
 				tt.request,
 				logger.NewMockClient(),
 				tt.dbMock,
-				notificationsConfig.ConfigurationStruct{Service: bootstrapConfig.ServiceInfo{MaxResultCount: 5}})
+				nil,
+				nil,
+				notificationsConfig.ConfigurationStruct{Service: bootstrapConfig.ServiceInfo{MaxResultCount: 5}},
+				nil,
+				nil)
 			response := rr.Result()
 			if response.StatusCode != tt.expectedStatus {
 				t.Errorf("status code mismatch -- expected %v got %v", tt.expectedStatus, response.StatusCode)
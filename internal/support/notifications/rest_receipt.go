@@ -0,0 +1,107 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/errors"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/gorilla/mux"
+)
+
+// ReceiptVerification is the outcome of the verification of the delivery receipt of a transmission.
+type ReceiptVerification struct {
+	Verified bool                                `json:"verified"`
+	Reason   string                              `json:"reason,omitempty"`
+	Receipt  notificationsModels.DeliveryReceipt `json:"receipt"`
+}
+
+func restGetDeliveryReceipt(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	id := mux.Vars(r)[ID]
+	receipt, ok := getDeliveryReceipt(w, id, lc, dbClient)
+	if !ok {
+		return
+	}
+
+	pkg.Encode(receipt, w, lc)
+}
+
+func restVerifyDeliveryReceipt(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	config notificationsConfig.ConfigurationStruct) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	id := mux.Vars(r)[ID]
+	t, err := dbClient.GetTransmissionById(id)
+	if err != nil {
+		if err == db.ErrNotFound {
+			err = errors.NewErrTransmissionNotFound(id)
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return
+	}
+	receipt, ok := getDeliveryReceipt(w, id, lc, dbClient)
+	if !ok {
+		return
+	}
+
+	verification := ReceiptVerification{Verified: true, Receipt: receipt}
+	if err = receipt.Verify(t, config.Receipts.Keys); err != nil {
+		verification.Verified = false
+		verification.Reason = err.Error()
+		lc.Warn("Delivery receipt of transmission " + id + " failed verification: " + err.Error())
+	}
+
+	pkg.Encode(verification, w, lc)
+}
+
+// getDeliveryReceipt returns the delivery receipt of the transmission, answering the request with an error when there
+// is none.
+func getDeliveryReceipt(
+	w http.ResponseWriter,
+	id string,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) (notificationsModels.DeliveryReceipt, bool) {
+
+	receipt, err := dbClient.GetDeliveryReceiptByTransmission(id)
+	if err != nil {
+		if err == db.ErrNotFound {
+			err = errors.NewErrDeliveryReceiptNotFound(id)
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return receipt, false
+	}
+	return receipt, true
+}
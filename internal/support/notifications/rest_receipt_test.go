@@ -0,0 +1,82 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces/mocks"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyDeliveryReceipt(t *testing.T) {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	config := notificationsConfig.ConfigurationStruct{
+		Receipts: notificationsConfig.ReceiptInfo{Keys: map[string]string{"target": base64.StdEncoding.EncodeToString(public)}},
+	}
+
+	transmission := contract.Transmission{ID: "trx", Notification: contract.Notification{Slug: "overheat", Content: "too hot"}}
+	signed := notificationsModels.DeliveryReceipt{
+		Transmission: "trx",
+		Notification: "overheat",
+		ContentHash:  notificationsModels.ContentHash("too hot"),
+		KeyId:        "target",
+		Timestamp:    1600000000000,
+	}
+	signed.Signature = base64.StdEncoding.EncodeToString(ed25519.Sign(private, signed.SignedPayload()))
+	tampered := signed
+	tampered.ContentHash = notificationsModels.ContentHash("cool")
+
+	tests := []struct {
+		name             string
+		receipt          notificationsModels.DeliveryReceipt
+		receiptErr       error
+		expectedStatus   int
+		expectedVerified bool
+	}{
+		{"Verified", signed, nil, http.StatusOK, true},
+		{"Tampered", tampered, nil, http.StatusOK, false},
+		{"No receipt", notificationsModels.DeliveryReceipt{}, db.ErrNotFound, http.StatusNotFound, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dbMock := &mocks.DBClient{}
+			dbMock.On("GetTransmissionById", "trx").Return(transmission, nil)
+			dbMock.On("GetDeliveryReceiptByTransmission", "trx").Return(tt.receipt, tt.receiptErr)
+
+			req := httptest.NewRequest(http.MethodGet, TestURI, nil)
+			req = mux.SetURLVars(req, map[string]string{ID: "trx"})
+			rr := httptest.NewRecorder()
+			restVerifyDeliveryReceipt(rr, req, logger.NewMockClient(), dbMock, config)
+
+			require.Equal(t, tt.expectedStatus, rr.Code)
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+			var verification ReceiptVerification
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&verification))
+			assert.Equal(t, tt.expectedVerified, verification.Verified)
+			assert.Equal(t, tt.expectedVerified, verification.Reason == "")
+		})
+	}
+}
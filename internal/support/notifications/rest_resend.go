@@ -0,0 +1,132 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/errors"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/gorilla/mux"
+)
+
+// restUpdateResendPolicy overrides the resend limit and interval of a live subscription, starting from the default
+// retry policy when the subscription has none of its own yet. The resends already scheduled pick up the change.
+func restUpdateResendPolicy(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	config notificationsConfig.ConfigurationStruct) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	var o notificationsModels.ResendOverride
+	err := json.NewDecoder(r.Body).Decode(&o)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error("Error decoding resend override: " + err.Error())
+		return
+	}
+
+	slug := mux.Vars(r)[SLUG]
+	if !subscriptionExists(w, slug, lc, dbClient) {
+		return
+	}
+
+	existing, err := dbClient.GetRetryPolicyBySlug(slug)
+	if err != nil && err != db.ErrNotFound {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		lc.Error(err.Error())
+		return
+	}
+	base := existing
+	if err == db.ErrNotFound {
+		base = defaultRetryPolicy(lc, config)
+		base.Subscription = slug
+	}
+
+	p := o.Apply(base)
+	if err = p.Validate(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error(err.Error())
+		return
+	}
+
+	lc.Info("Overriding resends of subscription: " + slug)
+	if existing.ID == "" {
+		p.ID, err = dbClient.AddRetryPolicy(p)
+	} else {
+		err = dbClient.UpdateRetryPolicy(p)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		lc.Error(err.Error())
+		return
+	}
+
+	pkg.Encode(p, w, lc)
+}
+
+// restCancelResends cancels the resends scheduled for the failed transmissions of a subscription, e.g. while its
+// receiver is down during an incident. The transmissions failing afterwards are resent as usual.
+func restCancelResends(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	slug := mux.Vars(r)[SLUG]
+	if !subscriptionExists(w, slug, lc, dbClient) {
+		return
+	}
+
+	lc.Info("Cancelling pending resends of subscription: " + slug)
+	if err := dbClient.SetResendCancellation(slug, db.MakeTimestamp()); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		lc.Error(err.Error())
+		return
+	}
+
+	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("true"))
+}
+
+// subscriptionExists checks that the subscription exists, writing the error response itself when it doesn't.
+func subscriptionExists(
+	w http.ResponseWriter,
+	slug string,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) bool {
+
+	if _, err := dbClient.GetSubscriptionBySlug(slug); err != nil {
+		if err == db.ErrNotFound {
+			err = errors.NewErrSubscriptionNotFound(slug)
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return false
+	}
+	return true
+}
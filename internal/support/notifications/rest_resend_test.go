@@ -0,0 +1,196 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces/mocks"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUpdateResendPolicy(t *testing.T) {
+	existing := retryPolicyForAdd
+	existing.ID = "id"
+	existing.Subscription = subscriptionForAdd.Slug
+
+	config := notificationsConfig.ConfigurationStruct{}
+	config.Retry.MaxAttempts = 2
+	config.Retry.InitialInterval = "5s"
+	config.Retry.BackoffMultiplier = 2
+	config.Writable.ResendInterval = "10s"
+
+	tests := []struct {
+		name            string
+		body            string
+		subscriptionErr error
+		existing        notificationsModels.RetryPolicy
+		existingErr     error
+		expectedStatus  int
+		expectedPolicy  notificationsModels.RetryPolicy
+	}{
+		{
+			"Override existing policy",
+			`{"resendLimit": 8}`,
+			nil,
+			existing,
+			nil,
+			http.StatusOK,
+			notificationsModels.RetryPolicy{
+				ID:                "id",
+				Subscription:      subscriptionForAdd.Slug,
+				MaxAttempts:       8,
+				InitialInterval:   retryPolicyForAdd.InitialInterval,
+				BackoffMultiplier: retryPolicyForAdd.BackoffMultiplier,
+				MaxInterval:       retryPolicyForAdd.MaxInterval,
+				Jitter:            retryPolicyForAdd.Jitter,
+			},
+		},
+		{
+			"Override default policy",
+			`{"resendLimit": 0}`,
+			nil,
+			notificationsModels.RetryPolicy{},
+			db.ErrNotFound,
+			http.StatusOK,
+			notificationsModels.RetryPolicy{
+				ID:                "new",
+				Subscription:      subscriptionForAdd.Slug,
+				MaxAttempts:       0,
+				InitialInterval:   "10s",
+				BackoffMultiplier: 2,
+			},
+		},
+		{"Invalid interval", `{"resendInterval": "soon"}`, nil, existing, nil, http.StatusBadRequest, notificationsModels.RetryPolicy{}},
+		{"Invalid body", `{"resendLimit": "many"}`, nil, existing, nil, http.StatusBadRequest, notificationsModels.RetryPolicy{}},
+		{"Subscription not found", `{"resendLimit": 8}`, db.ErrNotFound, existing, nil, http.StatusNotFound, notificationsModels.RetryPolicy{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dbMock := &mocks.DBClient{}
+			dbMock.On("GetSubscriptionBySlug", subscriptionForAdd.Slug).Return(subscriptionForAdd, tt.subscriptionErr)
+			dbMock.On("GetRetryPolicyBySlug", subscriptionForAdd.Slug).Return(tt.existing, tt.existingErr)
+			dbMock.On("AddRetryPolicy", mock.Anything).Return("new", nil)
+			dbMock.On("UpdateRetryPolicy", mock.Anything).Return(nil)
+
+			req := httptest.NewRequest(http.MethodPut, TestURI, bytes.NewBufferString(tt.body))
+			req = mux.SetURLVars(req, map[string]string{SLUG: subscriptionForAdd.Slug})
+			rr := httptest.NewRecorder()
+			restUpdateResendPolicy(rr, req, logger.NewMockClient(), dbMock, config)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			if tt.expectedStatus != http.StatusOK {
+				dbMock.AssertNotCalled(t, "AddRetryPolicy", mock.Anything)
+				dbMock.AssertNotCalled(t, "UpdateRetryPolicy", mock.Anything)
+				return
+			}
+			if tt.existingErr == nil {
+				dbMock.AssertCalled(t, "UpdateRetryPolicy", tt.expectedPolicy)
+			} else {
+				expectedAdded := tt.expectedPolicy
+				expectedAdded.ID = ""
+				dbMock.AssertCalled(t, "AddRetryPolicy", expectedAdded)
+			}
+		})
+	}
+}
+
+func TestCancelResends(t *testing.T) {
+	tests := []struct {
+		name            string
+		subscriptionErr error
+		expectedStatus  int
+	}{
+		{"OK", nil, http.StatusOK},
+		{"Subscription not found", db.ErrNotFound, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dbMock := &mocks.DBClient{}
+			dbMock.On("GetSubscriptionBySlug", subscriptionForAdd.Slug).Return(subscriptionForAdd, tt.subscriptionErr)
+			dbMock.On("SetResendCancellation", subscriptionForAdd.Slug, mock.Anything).Return(nil)
+
+			req := httptest.NewRequest(http.MethodDelete, TestURI, nil)
+			req = mux.SetURLVars(req, map[string]string{SLUG: subscriptionForAdd.Slug})
+			rr := httptest.NewRecorder()
+			restCancelResends(rr, req, logger.NewMockClient(), dbMock)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			if tt.expectedStatus == http.StatusOK {
+				dbMock.AssertCalled(t, "SetResendCancellation", subscriptionForAdd.Slug, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestResendCancelled(t *testing.T) {
+	failed := contract.Transmission{
+		ID:           "trx",
+		Notification: contract.Notification{Slug: "notice", Severity: contract.Normal},
+		Status:       contract.Failed,
+		ResendCount:  1,
+	}
+
+	dbMock := &mocks.DBClient{}
+	dbMock.On("GetResendCancellation", subscriptionForAdd.Slug).Return(int64(200), nil)
+	resend(failed, subscriptionForAdd.Slug, 100, logger.NewMockClient(), dbMock, nil, notificationsConfig.ConfigurationStruct{})
+
+	dbMock.AssertNotCalled(t, "GetRetryPolicyBySlug", mock.Anything)
+	dbMock.AssertNotCalled(t, "UpdateTransmission", mock.Anything)
+}
+
+func TestResendLimitLowered(t *testing.T) {
+	failed := contract.Transmission{
+		ID:           "trx",
+		Notification: contract.Notification{Slug: "notice", Severity: contract.Normal},
+		Status:       contract.Failed,
+		ResendCount:  3,
+	}
+	lowered := retryPolicyForAdd
+	lowered.Subscription = subscriptionForAdd.Slug
+	lowered.MaxAttempts = 3
+
+	dbMock := &mocks.DBClient{}
+	dbMock.On("GetResendCancellation", subscriptionForAdd.Slug).Return(int64(0), nil)
+	dbMock.On("GetRetryPolicyBySlug", subscriptionForAdd.Slug).Return(lowered, nil)
+	resend(failed, subscriptionForAdd.Slug, 100, logger.NewMockClient(), dbMock, nil, notificationsConfig.ConfigurationStruct{})
+
+	dbMock.AssertNotCalled(t, "UpdateTransmission", mock.Anything)
+}
+
+func TestDefaultRetryPolicyWritableOverrides(t *testing.T) {
+	config := notificationsConfig.ConfigurationStruct{}
+	config.Retry.MaxAttempts = 2
+	config.Retry.InitialInterval = "5s"
+
+	p := defaultRetryPolicy(logger.NewMockClient(), config)
+	assert.Equal(t, 2, p.MaxAttempts)
+	assert.Equal(t, "5s", p.InitialInterval)
+
+	config.Writable.ResendLimit = 4
+	config.Writable.ResendInterval = "1m"
+	p = defaultRetryPolicy(logger.NewMockClient(), config)
+	assert.Equal(t, 4, p.MaxAttempts)
+	assert.Equal(t, "1m", p.InitialInterval)
+
+	config.Writable.ResendInterval = "soon"
+	p = defaultRetryPolicy(logger.NewMockClient(), config)
+	assert.Equal(t, "5s", p.InitialInterval, "an invalid interval is ignored")
+}
@@ -0,0 +1,179 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/errors"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/gorilla/mux"
+)
+
+func restGetRetryPolicy(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	slug := mux.Vars(r)[SLUG]
+	p, err := dbClient.GetRetryPolicyBySlug(slug)
+	if err != nil {
+		if err == db.ErrNotFound {
+			err = errors.NewErrRetryPolicyNotFound(slug)
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return
+	}
+
+	pkg.Encode(p, w, lc)
+}
+
+func restAddRetryPolicy(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	p, ok := decodeRetryPolicy(w, r, lc, dbClient)
+	if !ok {
+		return
+	}
+
+	lc.Info("Posting retry policy of subscription: " + p.Subscription)
+	id, err := dbClient.AddRetryPolicy(p)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		lc.Error(err.Error())
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	w.Write([]byte(id))
+}
+
+func restUpdateRetryPolicy(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	p, ok := decodeRetryPolicy(w, r, lc, dbClient)
+	if !ok {
+		return
+	}
+
+	lc.Info("Updating retry policy of subscription: " + p.Subscription)
+	if err := dbClient.UpdateRetryPolicy(p); err != nil {
+		if err == db.ErrNotFound {
+			err = errors.NewErrRetryPolicyNotFound(p.Subscription)
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return
+	}
+
+	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("true"))
+}
+
+func restDeleteRetryPolicy(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	slug := mux.Vars(r)[SLUG]
+	lc.Info("Deleting retry policy of subscription: " + slug)
+
+	if err := dbClient.DeleteRetryPolicyBySlug(slug); err != nil {
+		if err == db.ErrNotFound {
+			err = errors.NewErrRetryPolicyNotFound(slug)
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return
+	}
+
+	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("true"))
+}
+
+// decodeRetryPolicy reads and validates the retry policy of the subscription addressed by the request path, writing
+// the error response itself when the policy cannot be accepted.
+func decodeRetryPolicy(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) (notificationsModels.RetryPolicy, bool) {
+
+	var p notificationsModels.RetryPolicy
+	err := json.NewDecoder(r.Body).Decode(&p)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error("Error decoding retry policy: " + err.Error())
+		return p, false
+	}
+
+	slug := mux.Vars(r)[SLUG]
+	if p.Subscription != "" && p.Subscription != slug {
+		http.Error(w, "Subscription of retry policy does not match the request path", http.StatusBadRequest)
+		lc.Error("Subscription of retry policy " + p.Subscription + " does not match " + slug)
+		return p, false
+	}
+	p.Subscription = slug
+
+	if err = p.Validate(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error(err.Error())
+		return p, false
+	}
+
+	if _, err = dbClient.GetSubscriptionBySlug(slug); err != nil {
+		if err == db.ErrNotFound {
+			err = errors.NewErrSubscriptionNotFound(slug)
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return p, false
+	}
+	return p, true
+}
@@ -0,0 +1,92 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces/mocks"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/mock"
+)
+
+var retryPolicyForAdd = notificationsModels.RetryPolicy{
+	MaxAttempts:       5,
+	InitialInterval:   "1s",
+	BackoffMultiplier: 2,
+	MaxInterval:       "1m",
+	Jitter:            0.1,
+}
+
+func TestAddRetryPolicy(t *testing.T) {
+	invalid := retryPolicyForAdd
+	invalid.BackoffMultiplier = 0.5
+	mismatched := retryPolicyForAdd
+	mismatched.Subscription = "other"
+
+	tests := []struct {
+		name            string
+		policy          notificationsModels.RetryPolicy
+		subscriptionErr error
+		expectedStatus  int
+	}{
+		{"OK", retryPolicyForAdd, nil, http.StatusCreated},
+		{"Invalid policy", invalid, nil, http.StatusBadRequest},
+		{"Mismatched subscription", mismatched, nil, http.StatusBadRequest},
+		{"Subscription not found", retryPolicyForAdd, db.ErrNotFound, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dbMock := &mocks.DBClient{}
+			dbMock.On("GetSubscriptionBySlug", subscriptionForAdd.Slug).Return(subscriptionForAdd, tt.subscriptionErr)
+			dbMock.On("AddRetryPolicy", mock.Anything).Return("id", nil)
+
+			body, _ := json.Marshal(tt.policy)
+			req := httptest.NewRequest(http.MethodPost, TestURI, bytes.NewReader(body))
+			req = mux.SetURLVars(req, map[string]string{SLUG: subscriptionForAdd.Slug})
+			rr := httptest.NewRecorder()
+			restAddRetryPolicy(rr, req, logger.NewMockClient(), dbMock)
+
+			response := rr.Result()
+			if response.StatusCode != tt.expectedStatus {
+				t.Errorf("status code mismatch -- expected %v got %v", tt.expectedStatus, response.StatusCode)
+			}
+			if tt.expectedStatus == http.StatusCreated {
+				dbMock.AssertCalled(t, "AddRetryPolicy", mock.MatchedBy(func(p notificationsModels.RetryPolicy) bool {
+					return p.Subscription == subscriptionForAdd.Slug
+				}))
+			}
+		})
+	}
+}
+
+func TestHandleFailedTransmissionGivesUp(t *testing.T) {
+	failed := contract.Transmission{
+		ID:           "trx",
+		Notification: contract.Notification{Slug: "notice", Severity: contract.Normal},
+		Status:       contract.Failed,
+		ResendCount:  5,
+	}
+	policy := retryPolicyForAdd
+	policy.Subscription = subscriptionForAdd.Slug
+
+	dbMock := &mocks.DBClient{}
+	handleFailedTransmission(failed, policy.Subscription, policy, logger.NewMockClient(), dbMock, nil, notificationsConfig.ConfigurationStruct{})
+
+	dbMock.AssertNotCalled(t, "UpdateTransmission", mock.Anything)
+}
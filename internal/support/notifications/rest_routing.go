@@ -0,0 +1,201 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/errors"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/gorilla/mux"
+)
+
+func restGetRoutingPolicy(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	slug := mux.Vars(r)[SLUG]
+	p, err := dbClient.GetRoutingPolicyBySlug(slug)
+	if err != nil {
+		if err == db.ErrNotFound {
+			err = errors.NewErrRoutingPolicyNotFound(slug)
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return
+	}
+
+	pkg.Encode(p, w, lc)
+}
+
+func restAddRoutingPolicy(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	p, ok := decodeRoutingPolicy(w, r, lc, dbClient)
+	if !ok {
+		return
+	}
+
+	lc.Info("Posting routing policy of subscription: " + p.Subscription)
+	id, err := dbClient.AddRoutingPolicy(p)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		lc.Error(err.Error())
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	w.Write([]byte(id))
+}
+
+func restUpdateRoutingPolicy(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	p, ok := decodeRoutingPolicy(w, r, lc, dbClient)
+	if !ok {
+		return
+	}
+
+	lc.Info("Updating routing policy of subscription: " + p.Subscription)
+	if err := dbClient.UpdateRoutingPolicy(p); err != nil {
+		if err == db.ErrNotFound {
+			err = errors.NewErrRoutingPolicyNotFound(p.Subscription)
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return
+	}
+
+	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("true"))
+}
+
+func restDeleteRoutingPolicy(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	slug := mux.Vars(r)[SLUG]
+	lc.Info("Deleting routing policy of subscription: " + slug)
+
+	if err := dbClient.DeleteRoutingPolicyBySlug(slug); err != nil {
+		if err == db.ErrNotFound {
+			err = errors.NewErrRoutingPolicyNotFound(slug)
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return
+	}
+
+	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("true"))
+}
+
+// decodeRoutingPolicy reads and validates the routing policy of the subscription addressed by the request path, writing
+// the error response itself when the policy cannot be accepted.
+func decodeRoutingPolicy(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) (notificationsModels.RoutingPolicy, bool) {
+
+	var p notificationsModels.RoutingPolicy
+	err := json.NewDecoder(r.Body).Decode(&p)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error("Error decoding routing policy: " + err.Error())
+		return p, false
+	}
+
+	slug := mux.Vars(r)[SLUG]
+	if p.Subscription != "" && p.Subscription != slug {
+		http.Error(w, "Subscription of routing policy does not match the request path", http.StatusBadRequest)
+		lc.Error("Subscription of routing policy " + p.Subscription + " does not match " + slug)
+		return p, false
+	}
+	p.Subscription = slug
+
+	if err = p.Validate(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error(err.Error())
+		return p, false
+	}
+
+	if _, err = dbClient.GetSubscriptionBySlug(slug); err != nil {
+		if err == db.ErrNotFound {
+			err = errors.NewErrSubscriptionNotFound(slug)
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return p, false
+	}
+	return p, true
+}
+
+// subscriptionRoutingAdmits reports whether the notification, distributed at now, is delivered to the subscription
+// according to its routing policy, along with the reason it isn't. Subscriptions without a routing policy receive the
+// notifications of every severity at any time.
+func subscriptionRoutingAdmits(
+	n models.Notification,
+	s models.Subscription,
+	now time.Time,
+	dbClient interfaces.DBClient) (bool, string, error) {
+
+	p, err := dbClient.GetRoutingPolicyBySlug(s.Slug)
+	if err != nil {
+		if err == db.ErrNotFound {
+			return true, "", nil
+		}
+		return false, "", err
+	}
+	admitted, reason := p.Admits(n, now)
+	return admitted, reason, nil
+}
@@ -0,0 +1,111 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces/mocks"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/mock"
+)
+
+var routingPolicyForAdd = notificationsModels.RoutingPolicy{
+	MinSeverity: contract.Normal,
+	QuietHours: &notificationsModels.QuietHours{
+		Timezone: "UTC",
+		Windows:  []notificationsModels.QuietWindow{{Start: "22:00", End: "07:00"}},
+	},
+}
+
+func TestAddRoutingPolicy(t *testing.T) {
+	invalid := routingPolicyForAdd
+	invalid.MinSeverity = "URGENT"
+
+	tests := []struct {
+		name            string
+		policy          notificationsModels.RoutingPolicy
+		subscriptionErr error
+		expectedStatus  int
+	}{
+		{"OK", routingPolicyForAdd, nil, http.StatusCreated},
+		{"Invalid policy", invalid, nil, http.StatusBadRequest},
+		{"Subscription not found", routingPolicyForAdd, db.ErrNotFound, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dbMock := &mocks.DBClient{}
+			dbMock.On("GetSubscriptionBySlug", subscriptionForAdd.Slug).Return(subscriptionForAdd, tt.subscriptionErr)
+			dbMock.On("AddRoutingPolicy", mock.Anything).Return("id", nil)
+
+			body, _ := json.Marshal(tt.policy)
+			req := httptest.NewRequest(http.MethodPost, TestURI, bytes.NewReader(body))
+			req = mux.SetURLVars(req, map[string]string{SLUG: subscriptionForAdd.Slug})
+			rr := httptest.NewRecorder()
+			restAddRoutingPolicy(rr, req, logger.NewMockClient(), dbMock)
+
+			response := rr.Result()
+			if response.StatusCode != tt.expectedStatus {
+				t.Errorf("status code mismatch -- expected %v got %v", tt.expectedStatus, response.StatusCode)
+			}
+		})
+	}
+}
+
+func TestSubscriptionRoutingAdmitsNotification(t *testing.T) {
+	normal := contract.Notification{Slug: "normal", Severity: contract.Normal}
+	critical := contract.Notification{Slug: "critical", Severity: contract.Critical}
+	day := time.Date(2020, 10, 2, 12, 0, 0, 0, time.UTC)
+	night := time.Date(2020, 10, 2, 23, 0, 0, 0, time.UTC)
+
+	routed := &mocks.DBClient{}
+	routed.On("GetRoutingPolicyBySlug", subscriptionForAdd.Slug).Return(routingPolicyForAdd, nil)
+	criticalOnly := &mocks.DBClient{}
+	criticalOnly.On("GetRoutingPolicyBySlug", subscriptionForAdd.Slug).Return(
+		notificationsModels.RoutingPolicy{MinSeverity: contract.Critical}, nil)
+	unrouted := &mocks.DBClient{}
+	unrouted.On("GetRoutingPolicyBySlug", subscriptionForAdd.Slug).Return(notificationsModels.RoutingPolicy{}, db.ErrNotFound)
+
+	tests := []struct {
+		name         string
+		notification contract.Notification
+		now          time.Time
+		dbMock       interfaces.DBClient
+		expected     bool
+	}{
+		{"below minimum severity", normal, day, criticalOnly, false},
+		{"outside quiet hours", normal, day, routed, true},
+		{"during quiet hours", normal, night, routed, false},
+		{"critical during quiet hours", critical, night, routed, true},
+		{"no routing policy", normal, night, unrouted, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			admitted, _, err := subscriptionRoutingAdmits(tt.notification, subscriptionForAdd, tt.now, tt.dbMock)
+			if err != nil {
+				t.Errorf("unexpected error %v", err)
+				return
+			}
+			if admitted != tt.expected {
+				t.Errorf("admission mismatch -- expected %v got %v", tt.expected, admitted)
+			}
+		})
+	}
+}
@@ -0,0 +1,207 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"encoding/json"
+	goErrors "errors"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/errors"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/gorilla/mux"
+)
+
+func restGetSeverityMappings(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	mappings, err := dbClient.GetSeverityMappings()
+	if err != nil {
+		lc.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	pkg.Encode(mappings, w, lc)
+}
+
+func restAddSeverityMapping(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	var m notificationsModels.SeverityMapping
+	err := json.NewDecoder(r.Body).Decode(&m)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error("Error decoding severity mapping: " + err.Error())
+		return
+	}
+
+	if err = m.Validate(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error(err.Error())
+		return
+	}
+
+	lc.Info("Posting severity mapping: " + m.Name)
+	id, err := dbClient.AddSeverityMapping(m)
+	if err != nil {
+		if goErrors.Is(err, db.ErrNotUnique) {
+			http.Error(w, err.Error(), http.StatusConflict)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	w.Write([]byte(id))
+}
+
+func restUpdateSeverityMapping(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	var m notificationsModels.SeverityMapping
+	err := json.NewDecoder(r.Body).Decode(&m)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error("Error decoding severity mapping: " + err.Error())
+		return
+	}
+
+	if err = m.Validate(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error(err.Error())
+		return
+	}
+
+	lc.Info("Updating severity mapping: " + m.Name)
+	if err = dbClient.UpdateSeverityMapping(m); err != nil {
+		if err == db.ErrNotFound {
+			err = errors.NewErrSeverityMappingNotFound(m.Name)
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return
+	}
+
+	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("true"))
+}
+
+func restGetSeverityMappingByName(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	name := mux.Vars(r)[NAME]
+	m, err := dbClient.GetSeverityMappingByName(name)
+	if err != nil {
+		if err == db.ErrNotFound {
+			err = errors.NewErrSeverityMappingNotFound(name)
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return
+	}
+
+	pkg.Encode(m, w, lc)
+}
+
+func restDeleteSeverityMappingByName(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	name := mux.Vars(r)[NAME]
+	lc.Info("Deleting severity mapping: " + name)
+
+	if err := dbClient.DeleteSeverityMappingByName(name); err != nil {
+		if err == db.ErrNotFound {
+			err = errors.NewErrSeverityMappingNotFound(name)
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return
+	}
+
+	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("true"))
+}
+
+// applySeverityMapping rewrites the severity, and optionally the category, of the notification using the named
+// severity mapping. It is shared by every ingestion path so integrations never hard-code their own translations.
+func applySeverityMapping(
+	n *models.Notification,
+	mappingName string,
+	external string,
+	dbClient interfaces.DBClient) error {
+
+	mapping, err := dbClient.GetSeverityMappingByName(mappingName)
+	if err != nil {
+		if err == db.ErrNotFound {
+			return errors.NewErrSeverityMappingNotFound(mappingName)
+		}
+		return err
+	}
+
+	severity, category, ok := mapping.Translate(external)
+	if !ok {
+		return errors.NewErrUnmappedSeverity(mappingName, external)
+	}
+
+	n.Severity = severity
+	if category != "" {
+		n.Category = category
+	}
+	return nil
+}
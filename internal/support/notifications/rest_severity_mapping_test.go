@@ -0,0 +1,57 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"bytes"
+	"encoding/json"
+	goErrors "errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces/mocks"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddSeverityMapping(t *testing.T) {
+	mapping := notificationsModels.SeverityMapping{
+		Name:  "plc",
+		Rules: []notificationsModels.SeverityRule{{External: "ALARM", Severity: contract.Critical}},
+	}
+
+	tests := []struct {
+		name           string
+		addErr         error
+		expectedStatus int
+	}{
+		{"created", nil, http.StatusCreated},
+		{"duplicate", fmt.Errorf("%w, name=%v", db.ErrNotUnique, mapping.Name), http.StatusConflict},
+		{"database failure", goErrors.New("connection refused"), http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dbMock := &mocks.DBClient{}
+			dbMock.On("AddSeverityMapping", mock.Anything).Return("1", tt.addErr)
+			body, err := json.Marshal(mapping)
+			require.NoError(t, err)
+			request := httptest.NewRequest(http.MethodPost, "/api/v1/severitymapping", bytes.NewReader(body))
+
+			rr := httptest.NewRecorder()
+			restAddSeverityMapping(rr, request, logger.NewMockClient(), dbMock)
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+		})
+	}
+}
@@ -23,8 +23,10 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/errors"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/filtercache"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/operators/subscription"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/sender"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/clients"
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
@@ -80,6 +82,13 @@ func restAddSubscription(
 		return
 	}
 
+	err = validatePhoneNumbers(s)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error(err.Error())
+		return
+	}
+
 	lc.Info("Posting Subscription: " + s.String())
 	op := subscription.NewAddExecutor(dbClient, s)
 	err = op.Execute()
@@ -118,6 +127,13 @@ func restUpdateSubscription(
 		return
 	}
 
+	err = validatePhoneNumbers(s)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error(err.Error())
+		return
+	}
+
 	// Check if the subscription exists
 	s2, err := dbClient.GetSubscriptionBySlug(s.Slug)
 	if err != nil {
@@ -175,7 +191,8 @@ func restDeleteSubscriptionByID(
 	w http.ResponseWriter,
 	r *http.Request,
 	lc logger.LoggingClient,
-	dbClient interfaces.DBClient) {
+	dbClient interfaces.DBClient,
+	filters *filtercache.Cache) {
 
 	if r.Body != nil {
 		defer r.Body.Close()
@@ -196,6 +213,8 @@ func restDeleteSubscriptionByID(
 		}
 		return
 	}
+	// the filter was deleted along with the subscription, whose slug isn't known here
+	filters.Reset()
 	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
 }
@@ -234,7 +253,8 @@ func restDeleteSubscriptionBySlug(
 	w http.ResponseWriter,
 	r *http.Request,
 	lc logger.LoggingClient,
-	dbClient interfaces.DBClient) {
+	dbClient interfaces.DBClient,
+	filters *filtercache.Cache) {
 
 	if r.Body != nil {
 		defer r.Body.Close()
@@ -257,6 +277,7 @@ func restDeleteSubscriptionBySlug(
 		lc.Error(err.Error())
 		return
 	}
+	filters.Invalidate(slug)
 	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
 	w.Write([]byte("true"))
@@ -370,6 +391,19 @@ func validateEmailAddresses(s models.Subscription) error {
 	return nil
 }
 
+// validatePhoneNumbers checks the SMS channels of the subscription list valid phone numbers to text to.
+func validatePhoneNumbers(s models.Subscription) error {
+	for _, c := range s.Channels {
+		if sender.ChannelType(c) != sender.Sms {
+			continue
+		}
+		if _, err := sender.PhoneNumbers(c); err != nil {
+			return errors.NewErrInvalidPhoneNumbers(err.Error())
+		}
+	}
+	return nil
+}
+
 func subscriptionsByReceiverHandler(
 	w http.ResponseWriter,
 	r *http.Request,
@@ -0,0 +1,186 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/errors"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/filtercache"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/gorilla/mux"
+)
+
+func restGetSubscriptionFilter(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	slug := mux.Vars(r)[SLUG]
+	f, err := dbClient.GetSubscriptionFilterBySlug(slug)
+	if err != nil {
+		if err == db.ErrNotFound {
+			err = errors.NewErrSubscriptionFilterNotFound(slug)
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return
+	}
+
+	pkg.Encode(f, w, lc)
+}
+
+func restAddSubscriptionFilter(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	filters *filtercache.Cache) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	f, ok := decodeSubscriptionFilter(w, r, lc, dbClient)
+	if !ok {
+		return
+	}
+
+	lc.Info("Posting filter of subscription: " + f.Subscription)
+	id, err := dbClient.AddSubscriptionFilter(f)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		lc.Error(err.Error())
+		return
+	}
+	filters.Invalidate(f.Subscription)
+
+	w.WriteHeader(http.StatusCreated)
+	w.Write([]byte(id))
+}
+
+func restUpdateSubscriptionFilter(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	filters *filtercache.Cache) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	f, ok := decodeSubscriptionFilter(w, r, lc, dbClient)
+	if !ok {
+		return
+	}
+
+	lc.Info("Updating filter of subscription: " + f.Subscription)
+	if err := dbClient.UpdateSubscriptionFilter(f); err != nil {
+		if err == db.ErrNotFound {
+			err = errors.NewErrSubscriptionFilterNotFound(f.Subscription)
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return
+	}
+	filters.Invalidate(f.Subscription)
+
+	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("true"))
+}
+
+func restDeleteSubscriptionFilter(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	filters *filtercache.Cache) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	slug := mux.Vars(r)[SLUG]
+	lc.Info("Deleting filter of subscription: " + slug)
+
+	if err := dbClient.DeleteSubscriptionFilterBySlug(slug); err != nil {
+		if err == db.ErrNotFound {
+			err = errors.NewErrSubscriptionFilterNotFound(slug)
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return
+	}
+	filters.Invalidate(slug)
+
+	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("true"))
+}
+
+// decodeSubscriptionFilter reads and validates the filter of the subscription addressed by the request path, writing
+// the error response itself when the filter cannot be accepted.
+func decodeSubscriptionFilter(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) (notificationsModels.SubscriptionFilter, bool) {
+
+	var f notificationsModels.SubscriptionFilter
+	err := json.NewDecoder(r.Body).Decode(&f)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error("Error decoding subscription filter: " + err.Error())
+		return f, false
+	}
+
+	slug := mux.Vars(r)[SLUG]
+	if f.Subscription != "" && f.Subscription != slug {
+		http.Error(w, "Subscription of filter does not match the request path", http.StatusBadRequest)
+		lc.Error("Subscription of filter " + f.Subscription + " does not match " + slug)
+		return f, false
+	}
+	f.Subscription = slug
+
+	if err = f.Validate(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error(err.Error())
+		return f, false
+	}
+
+	if _, err = dbClient.GetSubscriptionBySlug(slug); err != nil {
+		if err == db.ErrNotFound {
+			err = errors.NewErrSubscriptionNotFound(slug)
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return f, false
+	}
+	return f, true
+}
@@ -0,0 +1,101 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces/mocks"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/mock"
+)
+
+var subscriptionFilterForAdd = notificationsModels.SubscriptionFilter{
+	Subscription: subscriptionForAdd.Slug,
+	Conditions: []notificationsModels.FilterCondition{
+		{Path: "$.content.temperature", Operator: notificationsModels.OperatorGreater, Value: 80.0},
+	},
+}
+
+func TestAddSubscriptionFilter(t *testing.T) {
+	invalidFilter := subscriptionFilterForAdd
+	invalidFilter.Conditions = nil
+	mismatchedFilter := subscriptionFilterForAdd
+	mismatchedFilter.Subscription = "another-subscription"
+
+	tests := []struct {
+		name           string
+		request        *http.Request
+		dbMock         interfaces.DBClient
+		expectedStatus int
+	}{
+		{
+			name:           "OK",
+			request:        createRequestSubscriptionFilter(http.MethodPost, subscriptionForAdd.Slug, subscriptionFilterForAdd),
+			dbMock:         createMockSubscriptionFilterLoader(nil, nil),
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "Invalid filter",
+			request:        createRequestSubscriptionFilter(http.MethodPost, subscriptionForAdd.Slug, invalidFilter),
+			dbMock:         createMockSubscriptionFilterLoader(nil, nil),
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Mismatched subscription",
+			request:        createRequestSubscriptionFilter(http.MethodPost, subscriptionForAdd.Slug, mismatchedFilter),
+			dbMock:         createMockSubscriptionFilterLoader(nil, nil),
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Subscription not found",
+			request:        createRequestSubscriptionFilter(http.MethodPost, subscriptionForAdd.Slug, subscriptionFilterForAdd),
+			dbMock:         createMockSubscriptionFilterLoader(db.ErrNotFound, nil),
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Duplicate filter",
+			request:        createRequestSubscriptionFilter(http.MethodPost, subscriptionForAdd.Slug, subscriptionFilterForAdd),
+			dbMock:         createMockSubscriptionFilterLoader(nil, db.ErrNotUnique),
+			expectedStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			restAddSubscriptionFilter(rr, tt.request, logger.NewMockClient(), tt.dbMock, nil)
+			response := rr.Result()
+			if response.StatusCode != tt.expectedStatus {
+				t.Errorf("status code mismatch -- expected %v got %v", tt.expectedStatus, response.StatusCode)
+				return
+			}
+		})
+	}
+}
+
+func createRequestSubscriptionFilter(method string, slug string, f notificationsModels.SubscriptionFilter) *http.Request {
+	b, _ := json.Marshal(f)
+	req := httptest.NewRequest(method, TestURI, bytes.NewBuffer(b))
+	return mux.SetURLVars(req, map[string]string{SLUG: slug})
+}
+
+func createMockSubscriptionFilterLoader(getSubscriptionErr error, addErr error) interfaces.DBClient {
+	myMock := mocks.DBClient{}
+	myMock.On("GetSubscriptionBySlug", subscriptionForAdd.Slug).Return(subscriptionForAdd, getSubscriptionErr)
+	myMock.On("AddSubscriptionFilter", mock.Anything).Return("", addErr)
+	return &myMock
+}
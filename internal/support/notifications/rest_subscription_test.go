@@ -287,7 +287,7 @@ func TestDeleteSubscriptionById(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			rr := httptest.NewRecorder()
-			restDeleteSubscriptionByID(rr, tt.request, logger.NewMockClient(), tt.dbMock)
+			restDeleteSubscriptionByID(rr, tt.request, logger.NewMockClient(), tt.dbMock, nil)
 			response := rr.Result()
 			if response.StatusCode != tt.expectedStatus {
 				t.Errorf("status code mismatch -- expected %v got %v", tt.expectedStatus, response.StatusCode)
@@ -368,7 +368,7 @@ func TestDeleteSubscriptionBySlug(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			rr := httptest.NewRecorder()
-			restDeleteSubscriptionBySlug(rr, tt.request, logger.NewMockClient(), tt.dbMock)
+			restDeleteSubscriptionBySlug(rr, tt.request, logger.NewMockClient(), tt.dbMock, nil)
 			response := rr.Result()
 			if response.StatusCode != tt.expectedStatus {
 				t.Errorf("status code mismatch -- expected %v got %v", tt.expectedStatus, response.StatusCode)
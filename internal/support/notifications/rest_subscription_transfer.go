@@ -0,0 +1,168 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/transfer"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/filtercache"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// subscriptionConfiguration is the document exchanged by the subscription export and import endpoints, used to
+// replicate the alerting configuration of one site to others.
+type subscriptionConfiguration struct {
+	Subscriptions []models.Subscription                    `json:"subscriptions"`
+	Filters       []notificationsModels.SubscriptionFilter `json:"filters,omitempty"`
+}
+
+func restExportSubscriptions(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	subscriptions, err := dbClient.GetSubscriptions()
+	if err != nil {
+		lc.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	export := subscriptionConfiguration{Subscriptions: make([]models.Subscription, len(subscriptions))}
+	for i, s := range subscriptions {
+		f, err := dbClient.GetSubscriptionFilterBySlug(s.Slug)
+		if err == nil {
+			f.ID = ""
+			export.Filters = append(export.Filters, f)
+		} else if err != db.ErrNotFound {
+			lc.Error(err.Error())
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+
+		// identifiers and timestamps are specific to this site and are assigned again on import
+		s.ID = ""
+		s.Timestamps = models.Timestamps{}
+		export.Subscriptions[i] = s
+	}
+	pkg.Encode(export, w, lc)
+}
+
+func restImportSubscriptions(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	filters *filtercache.Cache) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	var document subscriptionConfiguration
+	err := json.NewDecoder(r.Body).Decode(&document)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error("Error decoding subscription configuration: " + err.Error())
+		return
+	}
+
+	lc.Info(fmt.Sprintf("Importing %d subscriptions and %d subscription filters",
+		len(document.Subscriptions), len(document.Filters)))
+	result := transfer.NewImportResult()
+	for _, s := range document.Subscriptions {
+		importSubscription(s, result, dbClient)
+	}
+	for _, f := range document.Filters {
+		importSubscriptionFilter(f, result, dbClient)
+		filters.Invalidate(f.Subscription)
+	}
+
+	result.Write(w, lc)
+}
+
+// importSubscription adds the subscription, or replaces the existing subscription with the same slug.
+func importSubscription(s models.Subscription, result *transfer.ImportResult, dbClient interfaces.DBClient) {
+	key := SUBSCRIPTION + "/" + s.Slug
+	if s.Slug == "" {
+		result.Fail(key, fmt.Errorf("subscription slug is required"))
+		return
+	}
+	if err := validateEmailAddresses(s); err != nil {
+		result.Fail(key, err)
+		return
+	}
+
+	existing, err := dbClient.GetSubscriptionBySlug(s.Slug)
+	switch {
+	case err == db.ErrNotFound:
+		s.ID = ""
+		if _, err = dbClient.AddSubscription(s); err != nil {
+			result.Fail(key, err)
+			return
+		}
+		result.Create(key)
+	case err != nil:
+		result.Fail(key, err)
+	default:
+		s.ID = existing.ID
+		if err = dbClient.UpdateSubscription(s); err != nil {
+			result.Fail(key, err)
+			return
+		}
+		result.Update(key)
+	}
+}
+
+// importSubscriptionFilter adds the filter, or replaces the existing filter of the same subscription.
+func importSubscriptionFilter(
+	f notificationsModels.SubscriptionFilter,
+	result *transfer.ImportResult,
+	dbClient interfaces.DBClient) {
+
+	key := FILTER + "/" + f.Subscription
+	if err := f.Validate(); err != nil {
+		result.Fail(key, err)
+		return
+	}
+	if _, err := dbClient.GetSubscriptionBySlug(f.Subscription); err != nil {
+		result.Fail(key, err)
+		return
+	}
+
+	_, err := dbClient.GetSubscriptionFilterBySlug(f.Subscription)
+	switch {
+	case err == db.ErrNotFound:
+		f.ID = ""
+		if _, err = dbClient.AddSubscriptionFilter(f); err != nil {
+			result.Fail(key, err)
+			return
+		}
+		result.Create(key)
+	case err != nil:
+		result.Fail(key, err)
+	default:
+		if err = dbClient.UpdateSubscriptionFilter(f); err != nil {
+			result.Fail(key, err)
+			return
+		}
+		result.Update(key)
+	}
+}
@@ -0,0 +1,336 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/errors"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/sender"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/gorilla/mux"
+)
+
+// subscriptionTemplate is the reference of a subscription to the template rendering its notifications.
+type subscriptionTemplate struct {
+	Subscription string `json:"subscription"`
+	Template     string `json:"template"`
+}
+
+func restGetTemplates(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	templates, err := dbClient.GetTemplates()
+	if err != nil {
+		lc.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	pkg.Encode(templates, w, lc)
+}
+
+func restAddTemplate(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	t, ok := decodeTemplate(w, r, lc)
+	if !ok {
+		return
+	}
+
+	lc.Info("Posting template: " + t.Name)
+	id, err := dbClient.AddTemplate(t)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		lc.Error(err.Error())
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	w.Write([]byte(id))
+}
+
+func restUpdateTemplate(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	t, ok := decodeTemplate(w, r, lc)
+	if !ok {
+		return
+	}
+
+	lc.Info("Updating template: " + t.Name)
+	if err := dbClient.UpdateTemplate(t); err != nil {
+		if err == db.ErrNotFound {
+			err = errors.NewErrTemplateNotFound(t.Name)
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return
+	}
+
+	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("true"))
+}
+
+func restGetTemplateByName(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	name := mux.Vars(r)[NAME]
+	t, err := dbClient.GetTemplateByName(name)
+	if err != nil {
+		if err == db.ErrNotFound {
+			err = errors.NewErrTemplateNotFound(name)
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return
+	}
+
+	pkg.Encode(t, w, lc)
+}
+
+func restDeleteTemplateByName(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	name := mux.Vars(r)[NAME]
+	lc.Info("Deleting template: " + name)
+
+	if err := dbClient.DeleteTemplateByName(name); err != nil {
+		switch err {
+		case db.ErrNotFound:
+			err = errors.NewErrTemplateNotFound(name)
+			http.Error(w, err.Error(), http.StatusNotFound)
+		case db.ErrTemplateStillInUse:
+			http.Error(w, err.Error(), http.StatusConflict)
+		default:
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return
+	}
+
+	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("true"))
+}
+
+func restGetSubscriptionTemplate(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	slug := mux.Vars(r)[SLUG]
+	name, err := dbClient.GetSubscriptionTemplate(slug)
+	if err != nil {
+		if err == db.ErrNotFound {
+			err = errors.NewErrSubscriptionTemplateNotFound(slug)
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return
+	}
+
+	pkg.Encode(subscriptionTemplate{Subscription: slug, Template: name}, w, lc)
+}
+
+// restSetSubscriptionTemplate makes the subscription reference a template, replacing the template it referenced.
+func restSetSubscriptionTemplate(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	var reference subscriptionTemplate
+	err := json.NewDecoder(r.Body).Decode(&reference)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error("Error decoding subscription template: " + err.Error())
+		return
+	}
+	if reference.Template == "" {
+		http.Error(w, "Template of subscription is required", http.StatusBadRequest)
+		lc.Error("Template of subscription is required")
+		return
+	}
+
+	slug := mux.Vars(r)[SLUG]
+	if _, err = dbClient.GetSubscriptionBySlug(slug); err != nil {
+		if err == db.ErrNotFound {
+			err = errors.NewErrSubscriptionNotFound(slug)
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return
+	}
+	if _, err = dbClient.GetTemplateByName(reference.Template); err != nil {
+		if err == db.ErrNotFound {
+			err = errors.NewErrTemplateNotFound(reference.Template)
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return
+	}
+
+	lc.Info("Setting template of subscription " + slug + ": " + reference.Template)
+	if err = dbClient.SetSubscriptionTemplate(slug, reference.Template); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		lc.Error(err.Error())
+		return
+	}
+
+	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("true"))
+}
+
+func restDeleteSubscriptionTemplate(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	slug := mux.Vars(r)[SLUG]
+	lc.Info("Deleting template of subscription: " + slug)
+
+	if err := dbClient.DeleteSubscriptionTemplate(slug); err != nil {
+		if err == db.ErrNotFound {
+			err = errors.NewErrSubscriptionTemplateNotFound(slug)
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return
+	}
+
+	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("true"))
+}
+
+func decodeTemplate(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient) (notificationsModels.Template, bool) {
+
+	var t notificationsModels.Template
+	err := json.NewDecoder(r.Body).Decode(&t)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error("Error decoding template: " + err.Error())
+		return t, false
+	}
+
+	if err = t.Validate(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error(err.Error())
+		return t, false
+	}
+	return t, true
+}
+
+// renderer returns the function rendering the notification sent through a channel of the subscription with the
+// template it references. Subscriptions without a template, and channel types the template has no body for, are sent
+// the notification content as is.
+func renderer(
+	s models.Subscription,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) func(n models.Notification, c models.Channel) models.Notification {
+
+	unchanged := func(n models.Notification, _ models.Channel) models.Notification { return n }
+
+	name, err := dbClient.GetSubscriptionTemplate(s.Slug)
+	if err != nil {
+		if err != db.ErrNotFound {
+			lc.Error("Unable to get the template of subscription " + s.Slug + ": " + err.Error())
+		}
+		return unchanged
+	}
+	t, err := dbClient.GetTemplateByName(name)
+	if err != nil {
+		lc.Error("Unable to get template " + name + " of subscription " + s.Slug + ": " + err.Error())
+		return unchanged
+	}
+
+	return func(n models.Notification, c models.Channel) models.Notification {
+		content, ok, err := t.Render(n, sender.ChannelType(c))
+		if err != nil {
+			lc.Error("Unable to render notification " + n.Slug + ": " + err.Error())
+			return n
+		}
+		if ok {
+			n.Content = content
+		}
+		return n
+	}
+}
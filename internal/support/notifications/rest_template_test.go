@@ -0,0 +1,124 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces/mocks"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/gorilla/mux"
+)
+
+var templateForAdd = notificationsModels.Template{
+	Name: "alert",
+	Bodies: map[string]string{
+		"EMAIL": "{{.Severity}}: {{.Content}}",
+	},
+}
+
+func TestSetSubscriptionTemplate(t *testing.T) {
+	tests := []struct {
+		name           string
+		request        *http.Request
+		dbMock         interfaces.DBClient
+		expectedStatus int
+	}{
+		{
+			name:           "OK",
+			request:        createRequestSubscriptionTemplate(subscriptionForAdd.Slug, templateForAdd.Name),
+			dbMock:         createMockSubscriptionTemplateLoader(nil, nil),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "No template",
+			request:        createRequestSubscriptionTemplate(subscriptionForAdd.Slug, ""),
+			dbMock:         createMockSubscriptionTemplateLoader(nil, nil),
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Subscription not found",
+			request:        createRequestSubscriptionTemplate(subscriptionForAdd.Slug, templateForAdd.Name),
+			dbMock:         createMockSubscriptionTemplateLoader(db.ErrNotFound, nil),
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Template not found",
+			request:        createRequestSubscriptionTemplate(subscriptionForAdd.Slug, templateForAdd.Name),
+			dbMock:         createMockSubscriptionTemplateLoader(nil, db.ErrNotFound),
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			restSetSubscriptionTemplate(rr, tt.request, logger.NewMockClient(), tt.dbMock)
+			response := rr.Result()
+			if response.StatusCode != tt.expectedStatus {
+				t.Errorf("status code mismatch -- expected %v got %v", tt.expectedStatus, response.StatusCode)
+				return
+			}
+		})
+	}
+}
+
+func TestRendererAppliesSubscriptionTemplate(t *testing.T) {
+	n := contract.Notification{Slug: "hot", Severity: contract.Critical, Content: "temperature is 85"}
+	email := contract.Channel{Type: contract.ChannelType(contract.Email), MailAddresses: []string{"ops@example.com"}}
+	rest := contract.Channel{Type: contract.ChannelType(contract.Rest), Url: "http://localhost:8080/alerts"}
+
+	templated := &mocks.DBClient{}
+	templated.On("GetSubscriptionTemplate", subscriptionForAdd.Slug).Return(templateForAdd.Name, nil)
+	templated.On("GetTemplateByName", templateForAdd.Name).Return(templateForAdd, nil)
+	untemplated := &mocks.DBClient{}
+	untemplated.On("GetSubscriptionTemplate", subscriptionForAdd.Slug).Return("", db.ErrNotFound)
+
+	tests := []struct {
+		name     string
+		channel  contract.Channel
+		dbMock   interfaces.DBClient
+		expected string
+	}{
+		{"template body of channel type", email, templated, "CRITICAL: temperature is 85"},
+		{"no template body for channel type", rest, templated, n.Content},
+		{"no template", email, untemplated, n.Content},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			render := renderer(subscriptionForAdd, logger.NewMockClient(), tt.dbMock)
+			rendered := render(n, tt.channel)
+			if rendered.Content != tt.expected {
+				t.Errorf("content mismatch -- expected %s got %s", tt.expected, rendered.Content)
+			}
+		})
+	}
+}
+
+func createRequestSubscriptionTemplate(slug string, template string) *http.Request {
+	b, _ := json.Marshal(subscriptionTemplate{Template: template})
+	req := httptest.NewRequest(http.MethodPut, TestURI, bytes.NewBuffer(b))
+	return mux.SetURLVars(req, map[string]string{SLUG: slug})
+}
+
+func createMockSubscriptionTemplateLoader(getSubscriptionErr error, getTemplateErr error) interfaces.DBClient {
+	myMock := mocks.DBClient{}
+	myMock.On("GetSubscriptionBySlug", subscriptionForAdd.Slug).Return(subscriptionForAdd, getSubscriptionErr)
+	myMock.On("GetTemplateByName", templateForAdd.Name).Return(templateForAdd, getTemplateErr)
+	myMock.On("SetSubscriptionTemplate", subscriptionForAdd.Slug, templateForAdd.Name).Return(nil)
+	return &myMock
+}
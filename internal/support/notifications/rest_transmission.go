@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
@@ -95,7 +96,7 @@ func transmissionBySlugHandler(
 		return
 	}
 
-	pkg.Encode(t, w, lc)
+	pkg.Encode(withEscalations(t, lc, dbClient), w, lc)
 
 }
 
@@ -141,7 +142,7 @@ func transmissionBySlugAndStartEndHandler(
 		return
 	}
 
-	pkg.Encode(t, w, lc)
+	pkg.Encode(withEscalations(t, lc, dbClient), w, lc)
 
 }
 
@@ -187,7 +188,7 @@ func transmissionByStartEndHandler(
 		return
 	}
 
-	pkg.Encode(t, w, lc)
+	pkg.Encode(withEscalations(t, lc, dbClient), w, lc)
 
 }
 
@@ -226,7 +227,7 @@ func transmissionByStartHandler(
 		return
 	}
 
-	pkg.Encode(t, w, lc)
+	pkg.Encode(withEscalations(t, lc, dbClient), w, lc)
 
 }
 
@@ -266,7 +267,7 @@ func transmissionByEndHandler(
 		return
 	}
 
-	pkg.Encode(t, w, lc)
+	pkg.Encode(withEscalations(t, lc, dbClient), w, lc)
 
 }
 
@@ -321,10 +322,62 @@ func transmissionByStatusHandler(
 			return
 		}
 
-		pkg.Encode(t, w, lc)
+		pkg.Encode(withEscalations(t, lc, dbClient), w, lc)
 	}
 }
 
+func transmissionByStatusAndStartEndHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	vars := mux.Vars(r)
+	status := models.TransmissionStatus(strings.ToUpper(vars[STATUS]))
+	switch status {
+	case models.Sent, models.Acknowledged, models.Failed, models.Trxescalated:
+	default:
+		http.Error(w, fmt.Sprintf("Invalid transmission status %s", vars[STATUS]), http.StatusBadRequest)
+		lc.Error("Invalid transmission status " + vars[STATUS])
+		return
+	}
+	start, err := strconv.ParseInt(vars["start"], 10, 64)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error("Error converting the start to an integer")
+		return
+	}
+	end, err := strconv.ParseInt(vars["end"], 10, 64)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error("Error converting the end to an integer")
+		return
+	}
+	limitNum, err := strconv.Atoi(vars["limit"])
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error("Error converting limit to integer: " + err.Error())
+		return
+	}
+
+	t, err := dbClient.GetTransmissionsByStatusAndTimeRange(status, start, end, limitNum)
+	if err != nil {
+		if err == db.ErrNotFound {
+			http.Error(w, "Transmission not found", http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return
+	}
+
+	pkg.Encode(withEscalations(t, lc, dbClient), w, lc)
+}
+
 func transmissionByAgeSentHandler(
 	w http.ResponseWriter,
 	r *http.Request,
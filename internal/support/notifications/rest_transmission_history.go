@@ -0,0 +1,69 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/errors"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/gorilla/mux"
+)
+
+// TransmissionHistory holds the records of a transmission: its full history when it was stored, its own records
+// otherwise, of which Pruned were pruned.
+type TransmissionHistory struct {
+	Transmission string                        `json:"transmission"`
+	Pruned       int                           `json:"pruned"`
+	Records      []contract.TransmissionRecord `json:"records"`
+}
+
+func restGetTransmissionHistory(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	id := mux.Vars(r)[ID]
+	t, err := dbClient.GetTransmissionById(id)
+	if err != nil {
+		if err == db.ErrNotFound {
+			err = errors.NewErrTransmissionNotFound(id)
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return
+	}
+
+	records, err := dbClient.GetTransmissionHistory(id)
+	if err != nil && err != db.ErrUnsupportedDatabase {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		lc.Error(err.Error())
+		return
+	}
+
+	history := TransmissionHistory{Transmission: id, Records: records}
+	if len(records) == 0 {
+		// no history was stored, the histories being disabled or the transmission older than them
+		history.Pruned = notificationsModels.PrunedRecordsCount(t.Records)
+		history.Records = t.Records
+	}
+
+	pkg.Encode(history, w, lc)
+}
@@ -0,0 +1,70 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces/mocks"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTransmissionHistory(t *testing.T) {
+	var records []contract.TransmissionRecord
+	for i := 1; i <= 5; i++ {
+		records = append(records, contract.TransmissionRecord{Status: contract.Failed, Sent: int64(i)})
+	}
+	transmission := contract.Transmission{ID: "trx", Records: notificationsModels.PruneRecords(records, 2)}
+
+	tests := []struct {
+		name            string
+		transmissionErr error
+		history         []contract.TransmissionRecord
+		historyErr      error
+		expectedStatus  int
+		expectedPruned  int
+		expectedRecords []contract.TransmissionRecord
+	}{
+		{"Full history", nil, records, nil, http.StatusOK, 0, records},
+		{"No history", nil, []contract.TransmissionRecord{}, nil, http.StatusOK, 2, transmission.Records},
+		{"Unsupported database", nil, nil, db.ErrUnsupportedDatabase, http.StatusOK, 2, transmission.Records},
+		{"History error", nil, nil, db.ErrInvalidObjectId, http.StatusInternalServerError, 0, nil},
+		{"No transmission", db.ErrNotFound, nil, nil, http.StatusNotFound, 0, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dbMock := &mocks.DBClient{}
+			dbMock.On("GetTransmissionById", "trx").Return(transmission, tt.transmissionErr)
+			dbMock.On("GetTransmissionHistory", "trx").Return(tt.history, tt.historyErr)
+
+			req := httptest.NewRequest(http.MethodGet, TestURI, nil)
+			req = mux.SetURLVars(req, map[string]string{ID: "trx"})
+			rr := httptest.NewRecorder()
+			restGetTransmissionHistory(rr, req, logger.NewMockClient(), dbMock)
+
+			require.Equal(t, tt.expectedStatus, rr.Code)
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+			var history TransmissionHistory
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&history))
+			assert.Equal(t, "trx", history.Transmission)
+			assert.Equal(t, tt.expectedPruned, history.Pruned)
+			assert.Equal(t, tt.expectedRecords, history.Records)
+		})
+	}
+}
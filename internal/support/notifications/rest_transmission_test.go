@@ -0,0 +1,58 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces/mocks"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTransmissionByStatusAndStartEnd(t *testing.T) {
+	failed := contract.TransmissionStatus(contract.Failed)
+	transmissions := []contract.Transmission{{ID: "trx", Status: failed, Timestamps: contract.Timestamps{Created: 1500}}}
+
+	tests := []struct {
+		name           string
+		status         string
+		start          string
+		dbErr          error
+		expectedStatus int
+	}{
+		{"OK", "failed", "1000", nil, http.StatusOK},
+		{"Invalid status", "lost", "1000", nil, http.StatusBadRequest},
+		{"Invalid start", "FAILED", "soon", nil, http.StatusBadRequest},
+		{"Not found", "FAILED", "1000", db.ErrNotFound, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dbMock := &mocks.DBClient{}
+			dbMock.On("GetTransmissionsByStatusAndTimeRange", failed, int64(1000), int64(2000), 10).Return(transmissions, tt.dbErr)
+			dbMock.On("GetEscalationByTransmission", mock.Anything).Return(notificationsModels.Escalation{}, db.ErrNotFound)
+
+			req := httptest.NewRequest(http.MethodGet, TestURI, nil)
+			req = mux.SetURLVars(req, map[string]string{STATUS: tt.status, START: tt.start, END: "2000", LIMIT: "10"})
+			rr := httptest.NewRecorder()
+			transmissionByStatusAndStartEndHandler(rr, req, logger.NewMockClient(), dbMock)
+
+			response := rr.Result()
+			if response.StatusCode != tt.expectedStatus {
+				t.Errorf("status code mismatch -- expected %v got %v", tt.expectedStatus, response.StatusCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	notificationsContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// retentionPolicy holds how long the processed notifications of each severity, and the transmissions, are kept.
+type retentionPolicy struct {
+	maxAges            map[models.NotificationsSeverity]time.Duration
+	transmissionMaxAge time.Duration
+}
+
+// newRetentionPolicy parses the retention configuration.
+func newRetentionPolicy(info notificationsConfig.RetentionInfo) (retentionPolicy, error) {
+	p := retentionPolicy{maxAges: make(map[models.NotificationsSeverity]time.Duration)}
+	for severity, age := range info.MaxAge {
+		s := models.NotificationsSeverity(strings.ToUpper(severity))
+		if s != models.Normal && s != models.Critical {
+			return p, fmt.Errorf("unknown notification severity '%s' in retention max ages", severity)
+		}
+		d, err := time.ParseDuration(age)
+		if err != nil || d <= 0 {
+			return p, fmt.Errorf("invalid retention max age '%s' of %s notifications", age, s)
+		}
+		p.maxAges[s] = d
+	}
+	if info.TransmissionMaxAge != "" {
+		d, err := time.ParseDuration(info.TransmissionMaxAge)
+		if err != nil || d <= 0 {
+			return p, fmt.Errorf("invalid retention transmission max age '%s'", info.TransmissionMaxAge)
+		}
+		p.transmissionMaxAge = d
+	}
+	return p, nil
+}
+
+// monitorRetention purges the expired notifications and transmissions every interval until ctx is done.
+func monitorRetention(ctx context.Context, interval time.Duration, p retentionPolicy, dic *di.Container) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			purgeExpired(now, p, bootstrapContainer.LoggingClientFrom(dic.Get), notificationsContainer.DBClientFrom(dic.Get))
+		}
+	}
+}
+
+// purgeExpired deletes the processed notifications older, at now, than the max age of their severity, along with
+// their transmissions, and the transmissions older than the transmission max age.
+func purgeExpired(now time.Time, p retentionPolicy, lc logger.LoggingClient, dbClient interfaces.DBClient) {
+	for severity, age := range p.maxAges {
+		end := now.Add(-age).UnixNano() / int64(time.Millisecond)
+		count, err := dbClient.DeleteProcessedNotificationsByModifiedRange(severity, 0, end)
+		if err != nil {
+			lc.Error(fmt.Sprintf("unable to purge the %s notifications older than %v: %s", severity, age, err.Error()))
+		}
+		if count > 0 {
+			lc.Info(fmt.Sprintf("Purged %d processed %s notifications older than %v", count, severity, age))
+		}
+	}
+
+	if p.transmissionMaxAge > 0 {
+		end := now.Add(-p.transmissionMaxAge).UnixNano() / int64(time.Millisecond)
+		count, err := dbClient.DeleteTransmissionsByModifiedRange(0, end)
+		if err != nil {
+			lc.Error(fmt.Sprintf("unable to purge the transmissions older than %v: %s", p.transmissionMaxAge, err.Error()))
+		}
+		if count > 0 {
+			lc.Info(fmt.Sprintf("Purged %d transmissions older than %v", count, p.transmissionMaxAge))
+		}
+	}
+}
@@ -0,0 +1,63 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"testing"
+	"time"
+
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces/mocks"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRetentionPolicy(t *testing.T) {
+	tests := []struct {
+		name        string
+		info        notificationsConfig.RetentionInfo
+		expectedErr bool
+	}{
+		{"valid", notificationsConfig.RetentionInfo{MaxAge: map[string]string{"normal": "24h", "CRITICAL": "720h"}, TransmissionMaxAge: "48h"}, false},
+		{"unknown severity", notificationsConfig.RetentionInfo{MaxAge: map[string]string{"URGENT": "24h"}}, true},
+		{"invalid age", notificationsConfig.RetentionInfo{MaxAge: map[string]string{"NORMAL": "a day"}}, true},
+		{"negative transmission age", notificationsConfig.RetentionInfo{TransmissionMaxAge: "-1h"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newRetentionPolicy(tt.info)
+			if tt.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPurgeExpired(t *testing.T) {
+	p, err := newRetentionPolicy(notificationsConfig.RetentionInfo{
+		MaxAge:             map[string]string{"NORMAL": "1h", "CRITICAL": "24h"},
+		TransmissionMaxAge: "48h",
+	})
+	require.NoError(t, err)
+
+	now := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+	millis := func(t time.Time) int64 { return t.UnixNano() / int64(time.Millisecond) }
+
+	dbMock := &mocks.DBClient{}
+	dbMock.On("DeleteProcessedNotificationsByModifiedRange", contract.NotificationsSeverity(contract.Normal), int64(0), millis(now.Add(-time.Hour))).Return(3, nil)
+	dbMock.On("DeleteProcessedNotificationsByModifiedRange", contract.NotificationsSeverity(contract.Critical), int64(0), millis(now.Add(-24*time.Hour))).Return(0, nil)
+	dbMock.On("DeleteTransmissionsByModifiedRange", int64(0), millis(now.Add(-48*time.Hour))).Return(1, nil)
+
+	purgeExpired(now, p, logger.NewMockClient(), dbMock)
+
+	dbMock.AssertExpectations(t)
+}
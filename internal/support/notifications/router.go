@@ -19,9 +19,10 @@ import (
 	"net/http"
 
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
-	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/secretref"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/telemetry"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/constant"
 	notificationsContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
@@ -45,7 +46,7 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 	r.HandleFunc(
 		clients.ApiConfigRoute,
 		func(w http.ResponseWriter, _ *http.Request) {
-			pkg.Encode(*notificationsContainer.ConfigurationFrom(dic.Get), w, bootstrapContainer.LoggingClientFrom(dic.Get))
+			secretref.EncodeConfiguration(notificationsContainer.ConfigurationFrom(dic.Get), w, dic)
 		}).Methods(http.MethodGet)
 
 	// Metrics
@@ -58,6 +59,28 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 	// Version
 	r.HandleFunc(clients.ApiVersionRoute, pkg.VersionHandler).Methods(http.MethodGet)
 
+	// Effective state, in canonical form for the detection of drift
+	r.HandleFunc(
+		clients.ApiConfigRoute+"/"+EFFECTIVE,
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetEffectiveState(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
+
+	// Notification backlog
+	r.HandleFunc(
+		constant.ApiNotificationBacklogRoute,
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetNotificationBacklog(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
+
 	b := r.PathPrefix(clients.ApiBase).Subrouter()
 
 	// Notifications
@@ -68,9 +91,29 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get),
-				*notificationsContainer.ConfigurationFrom(dic.Get))
+				notificationsContainer.DBClientFrom(dic.Get),
+				notificationsContainer.ChannelSendersFrom(dic.Get),
+				notificationsContainer.FilterCacheFrom(dic.Get),
+				*notificationsContainer.ConfigurationFrom(dic.Get),
+				notificationsContainer.RateMonitorFrom(dic.Get),
+				notificationsContainer.SuppressorFrom(dic.Get))
 		}).Methods(http.MethodPost)
+	b.HandleFunc(
+		"/"+NOTIFICATION+"/"+STATISTICS,
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetNotificationStatistics(
+				w,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.RateMonitorFrom(dic.Get))
+		}).Methods(http.MethodGet)
+	b.HandleFunc(
+		"/"+NOTIFICATION+"/"+SUPPRESSION,
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetSuppressedNotifications(
+				w,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.SuppressorFrom(dic.Get))
+		}).Methods(http.MethodGet)
 	b.HandleFunc(
 		"/"+NOTIFICATION+"/{"+ID+"}",
 		func(w http.ResponseWriter, r *http.Request) {
@@ -78,7 +121,7 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get))
+				notificationsContainer.DBClientFrom(dic.Get))
 		}).Methods(http.MethodGet)
 	b.HandleFunc(
 		"/"+NOTIFICATION+"/{"+ID+"}",
@@ -87,7 +130,7 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get))
+				notificationsContainer.DBClientFrom(dic.Get))
 		}).Methods(http.MethodDelete)
 	b.HandleFunc(
 		"/"+NOTIFICATION+"/"+SLUG+"/{"+SLUG+"}",
@@ -96,7 +139,7 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get))
+				notificationsContainer.DBClientFrom(dic.Get))
 		}).Methods(http.MethodGet)
 	b.HandleFunc(
 		"/"+NOTIFICATION+"/"+SLUG+"/{"+SLUG+"}",
@@ -105,7 +148,7 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get))
+				notificationsContainer.DBClientFrom(dic.Get))
 		}).Methods(http.MethodDelete)
 	b.HandleFunc(
 		"/"+NOTIFICATION+"/"+AGE+"/{"+AGE+":[0-9]+}",
@@ -114,7 +157,7 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get))
+				notificationsContainer.DBClientFrom(dic.Get))
 		}).Methods(http.MethodDelete)
 	b.HandleFunc(
 		"/"+NOTIFICATION+"/"+SENDER+"/{"+SENDER+"}/{"+LIMIT+":[0-9]+}",
@@ -123,7 +166,7 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get),
 				*notificationsContainer.ConfigurationFrom(dic.Get))
 		}).Methods(http.MethodGet)
 	b.HandleFunc(
@@ -133,7 +176,7 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get),
 				*notificationsContainer.ConfigurationFrom(dic.Get))
 		}).Methods(http.MethodGet)
 	b.HandleFunc(
@@ -143,7 +186,7 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get),
 				*notificationsContainer.ConfigurationFrom(dic.Get))
 		}).Methods(http.MethodGet)
 	b.HandleFunc(
@@ -153,7 +196,7 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get),
 				*notificationsContainer.ConfigurationFrom(dic.Get))
 		}).Methods(http.MethodGet)
 	b.HandleFunc(
@@ -163,7 +206,7 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get),
 				*notificationsContainer.ConfigurationFrom(dic.Get))
 		}).Methods(http.MethodGet)
 	b.HandleFunc(
@@ -173,7 +216,7 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get),
 				*notificationsContainer.ConfigurationFrom(dic.Get))
 		}).Methods(http.MethodGet)
 
@@ -185,7 +228,7 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get))
+				notificationsContainer.DBClientFrom(dic.Get))
 		}).Methods(http.MethodGet)
 	b.HandleFunc(
 		"/"+SUBSCRIPTION,
@@ -194,7 +237,7 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get))
+				notificationsContainer.DBClientFrom(dic.Get))
 		}).Methods(http.MethodPost)
 	b.HandleFunc(
 		"/"+SUBSCRIPTION,
@@ -203,8 +246,28 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get))
+				notificationsContainer.DBClientFrom(dic.Get))
 		}).Methods(http.MethodPut)
+	// Export/Import must be registered ahead of the subscription by id routes
+	b.HandleFunc(
+		"/"+SUBSCRIPTION+"/"+EXPORT,
+		func(w http.ResponseWriter, r *http.Request) {
+			restExportSubscriptions(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
+	b.HandleFunc(
+		"/"+SUBSCRIPTION+"/"+IMPORT,
+		func(w http.ResponseWriter, r *http.Request) {
+			restImportSubscriptions(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get),
+				notificationsContainer.FilterCacheFrom(dic.Get))
+		}).Methods(http.MethodPost)
 	b.HandleFunc(
 		"/"+SUBSCRIPTION+"/{"+ID+"}",
 		func(w http.ResponseWriter, r *http.Request) {
@@ -212,7 +275,7 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get))
+				notificationsContainer.DBClientFrom(dic.Get))
 		}).Methods(http.MethodGet)
 	b.HandleFunc(
 		"/"+SUBSCRIPTION+"/{"+ID+"}",
@@ -221,7 +284,8 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get))
+				notificationsContainer.DBClientFrom(dic.Get),
+				notificationsContainer.FilterCacheFrom(dic.Get))
 		}).Methods(http.MethodDelete)
 	b.HandleFunc(
 		"/"+SUBSCRIPTION+"/"+SLUG+"/{"+SLUG+"}",
@@ -230,7 +294,7 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get))
+				notificationsContainer.DBClientFrom(dic.Get))
 		}).Methods(http.MethodGet)
 	b.HandleFunc(
 		"/"+SUBSCRIPTION+"/"+SLUG+"/{"+SLUG+"}",
@@ -239,7 +303,8 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get))
+				notificationsContainer.DBClientFrom(dic.Get),
+				notificationsContainer.FilterCacheFrom(dic.Get))
 		}).Methods(http.MethodDelete)
 	b.HandleFunc(
 		"/"+SUBSCRIPTION+"/"+CATEGORIES+"/{"+CATEGORIES+"}/"+LABELS+"/{"+LABELS+"}",
@@ -248,7 +313,7 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get))
+				notificationsContainer.DBClientFrom(dic.Get))
 		}).Methods(http.MethodGet)
 	b.HandleFunc(
 		"/"+SUBSCRIPTION+"/"+CATEGORIES+"/{"+CATEGORIES+"}",
@@ -257,7 +322,7 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get))
+				notificationsContainer.DBClientFrom(dic.Get))
 		}).Methods(http.MethodGet)
 	b.HandleFunc(
 		"/"+SUBSCRIPTION+"/"+LABELS+"/{"+LABELS+"}",
@@ -266,7 +331,7 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get))
+				notificationsContainer.DBClientFrom(dic.Get))
 		}).Methods(http.MethodGet)
 	b.HandleFunc(
 		"/"+SUBSCRIPTION+"/"+RECEIVER+"/{"+RECEIVER+"}",
@@ -275,7 +340,7 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get))
+				notificationsContainer.DBClientFrom(dic.Get))
 		}).Methods(http.MethodGet)
 
 	// Transmissions
@@ -286,7 +351,7 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get))
+				notificationsContainer.DBClientFrom(dic.Get))
 		}).Methods(http.MethodPost)
 	b.HandleFunc(
 		"/"+TRANSMISSION+"/"+SLUG+"/{"+SLUG+"}/{"+LIMIT+":[0-9]+}",
@@ -295,7 +360,7 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get))
+				notificationsContainer.DBClientFrom(dic.Get))
 		}).Methods(http.MethodGet)
 	b.HandleFunc(
 		"/"+TRANSMISSION+"/"+SLUG+"/{"+SLUG+"}/"+START+"/{"+START+"}/"+END+"/{"+END+"}/{"+LIMIT+":[0-9]+}",
@@ -304,7 +369,16 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get))
+				notificationsContainer.DBClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
+	b.HandleFunc(
+		"/"+TRANSMISSION+"/"+STATUS+"/{"+STATUS+"}/"+START+"/{"+START+"}/"+END+"/{"+END+"}/{"+LIMIT+":[0-9]+}",
+		func(w http.ResponseWriter, r *http.Request) {
+			transmissionByStatusAndStartEndHandler(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get))
 		}).Methods(http.MethodGet)
 	b.HandleFunc(
 		"/"+TRANSMISSION+"/"+START+"/{"+START+"}/"+END+"/{"+END+"}/{"+LIMIT+":[0-9]+}",
@@ -313,7 +387,7 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get))
+				notificationsContainer.DBClientFrom(dic.Get))
 		}).Methods(http.MethodGet)
 	b.HandleFunc(
 		"/"+TRANSMISSION+"/"+START+"/{"+START+"}/{"+LIMIT+":[0-9]+}",
@@ -322,7 +396,7 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get))
+				notificationsContainer.DBClientFrom(dic.Get))
 		}).Methods(http.MethodGet)
 	b.HandleFunc(
 		"/"+TRANSMISSION+"/"+END+"/{"+END+"}/{"+LIMIT+":[0-9]+}",
@@ -331,7 +405,7 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get))
+				notificationsContainer.DBClientFrom(dic.Get))
 		}).Methods(http.MethodGet)
 	b.HandleFunc(
 		"/"+TRANSMISSION+"/"+ESCALATED+"/{"+LIMIT+":[0-9]+}",
@@ -340,7 +414,7 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get))
+				notificationsContainer.DBClientFrom(dic.Get))
 		}).Methods(http.MethodGet)
 	b.HandleFunc(
 		"/"+TRANSMISSION+"/"+FAILED+"/{"+LIMIT+":[0-9]+}",
@@ -349,7 +423,7 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get))
+				notificationsContainer.DBClientFrom(dic.Get))
 		}).Methods(http.MethodGet)
 	b.HandleFunc(
 		"/"+TRANSMISSION+"/"+SENT+"/"+AGE+"/{"+AGE+":[0-9]+}",
@@ -358,7 +432,7 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get))
+				notificationsContainer.DBClientFrom(dic.Get))
 		}).Methods(http.MethodDelete)
 	b.HandleFunc(
 		"/"+TRANSMISSION+"/"+ESCALATED+"/"+AGE+"/{"+AGE+":[0-9]+}",
@@ -367,7 +441,7 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get))
+				notificationsContainer.DBClientFrom(dic.Get))
 		}).Methods(http.MethodDelete)
 	b.HandleFunc(
 		"/"+TRANSMISSION+"/"+ACKNOWLEDGED+"/"+AGE+"/{"+AGE+":[0-9]+}",
@@ -376,7 +450,7 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get))
+				notificationsContainer.DBClientFrom(dic.Get))
 		}).Methods(http.MethodDelete)
 	b.HandleFunc(
 		"/"+TRANSMISSION+"/"+FAILED+"/"+AGE+"/{"+AGE+":[0-9]+}",
@@ -385,9 +459,347 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get))
+				notificationsContainer.DBClientFrom(dic.Get))
+		}).Methods(http.MethodDelete)
+	b.HandleFunc(
+		"/"+TRANSMISSION+"/"+ID+"/{"+ID+"}/"+ACKNOWLEDGE,
+		func(w http.ResponseWriter, r *http.Request) {
+			restAcknowledgeTransmission(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get))
+		}).Methods(http.MethodPut)
+
+	// Severity Mappings
+	b.HandleFunc(
+		"/"+SEVERITYMAPPING,
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetSeverityMappings(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
+	b.HandleFunc(
+		"/"+SEVERITYMAPPING,
+		func(w http.ResponseWriter, r *http.Request) {
+			restAddSeverityMapping(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get))
+		}).Methods(http.MethodPost)
+	b.HandleFunc(
+		"/"+SEVERITYMAPPING,
+		func(w http.ResponseWriter, r *http.Request) {
+			restUpdateSeverityMapping(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get))
+		}).Methods(http.MethodPut)
+	b.HandleFunc(
+		"/"+SEVERITYMAPPING+"/"+NAME+"/{"+NAME+"}",
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetSeverityMappingByName(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
+	b.HandleFunc(
+		"/"+SEVERITYMAPPING+"/"+NAME+"/{"+NAME+"}",
+		func(w http.ResponseWriter, r *http.Request) {
+			restDeleteSeverityMappingByName(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get))
+		}).Methods(http.MethodDelete)
+
+	// Templates
+	b.HandleFunc(
+		"/"+TEMPLATE,
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetTemplates(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
+	b.HandleFunc(
+		"/"+TEMPLATE,
+		func(w http.ResponseWriter, r *http.Request) {
+			restAddTemplate(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get))
+		}).Methods(http.MethodPost)
+	b.HandleFunc(
+		"/"+TEMPLATE,
+		func(w http.ResponseWriter, r *http.Request) {
+			restUpdateTemplate(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get))
+		}).Methods(http.MethodPut)
+	b.HandleFunc(
+		"/"+TEMPLATE+"/"+NAME+"/{"+NAME+"}",
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetTemplateByName(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
+	b.HandleFunc(
+		"/"+TEMPLATE+"/"+NAME+"/{"+NAME+"}",
+		func(w http.ResponseWriter, r *http.Request) {
+			restDeleteTemplateByName(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get))
+		}).Methods(http.MethodDelete)
+	b.HandleFunc(
+		"/"+SUBSCRIPTION+"/"+SLUG+"/{"+SLUG+"}/"+TEMPLATE,
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetSubscriptionTemplate(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
+	b.HandleFunc(
+		"/"+SUBSCRIPTION+"/"+SLUG+"/{"+SLUG+"}/"+TEMPLATE,
+		func(w http.ResponseWriter, r *http.Request) {
+			restSetSubscriptionTemplate(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get))
+		}).Methods(http.MethodPut)
+	b.HandleFunc(
+		"/"+SUBSCRIPTION+"/"+SLUG+"/{"+SLUG+"}/"+TEMPLATE,
+		func(w http.ResponseWriter, r *http.Request) {
+			restDeleteSubscriptionTemplate(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get))
+		}).Methods(http.MethodDelete)
+
+	// Subscription Filters
+	b.HandleFunc(
+		"/"+SUBSCRIPTION+"/"+SLUG+"/{"+SLUG+"}/"+FILTER,
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetSubscriptionFilter(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
+	b.HandleFunc(
+		"/"+SUBSCRIPTION+"/"+SLUG+"/{"+SLUG+"}/"+FILTER,
+		func(w http.ResponseWriter, r *http.Request) {
+			restAddSubscriptionFilter(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get),
+				notificationsContainer.FilterCacheFrom(dic.Get))
+		}).Methods(http.MethodPost)
+	b.HandleFunc(
+		"/"+SUBSCRIPTION+"/"+SLUG+"/{"+SLUG+"}/"+FILTER,
+		func(w http.ResponseWriter, r *http.Request) {
+			restUpdateSubscriptionFilter(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get),
+				notificationsContainer.FilterCacheFrom(dic.Get))
+		}).Methods(http.MethodPut)
+	b.HandleFunc(
+		"/"+SUBSCRIPTION+"/"+SLUG+"/{"+SLUG+"}/"+FILTER,
+		func(w http.ResponseWriter, r *http.Request) {
+			restDeleteSubscriptionFilter(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get),
+				notificationsContainer.FilterCacheFrom(dic.Get))
+		}).Methods(http.MethodDelete)
+
+	// Escalation Policies
+	b.HandleFunc(
+		"/"+SUBSCRIPTION+"/"+SLUG+"/{"+SLUG+"}/"+ESCALATION,
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetEscalationPolicy(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
+	b.HandleFunc(
+		"/"+SUBSCRIPTION+"/"+SLUG+"/{"+SLUG+"}/"+ESCALATION,
+		func(w http.ResponseWriter, r *http.Request) {
+			restAddEscalationPolicy(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get))
+		}).Methods(http.MethodPost)
+	b.HandleFunc(
+		"/"+SUBSCRIPTION+"/"+SLUG+"/{"+SLUG+"}/"+ESCALATION,
+		func(w http.ResponseWriter, r *http.Request) {
+			restUpdateEscalationPolicy(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get))
+		}).Methods(http.MethodPut)
+	b.HandleFunc(
+		"/"+SUBSCRIPTION+"/"+SLUG+"/{"+SLUG+"}/"+ESCALATION,
+		func(w http.ResponseWriter, r *http.Request) {
+			restDeleteEscalationPolicy(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get))
+		}).Methods(http.MethodDelete)
+
+	// Retry Policies
+	b.HandleFunc(
+		"/"+SUBSCRIPTION+"/"+SLUG+"/{"+SLUG+"}/"+RETRY,
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetRetryPolicy(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
+	b.HandleFunc(
+		"/"+SUBSCRIPTION+"/"+SLUG+"/{"+SLUG+"}/"+RETRY,
+		func(w http.ResponseWriter, r *http.Request) {
+			restAddRetryPolicy(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get))
+		}).Methods(http.MethodPost)
+	b.HandleFunc(
+		"/"+SUBSCRIPTION+"/"+SLUG+"/{"+SLUG+"}/"+RETRY,
+		func(w http.ResponseWriter, r *http.Request) {
+			restUpdateRetryPolicy(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get))
+		}).Methods(http.MethodPut)
+	b.HandleFunc(
+		"/"+SUBSCRIPTION+"/"+SLUG+"/{"+SLUG+"}/"+RETRY,
+		func(w http.ResponseWriter, r *http.Request) {
+			restDeleteRetryPolicy(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get))
+		}).Methods(http.MethodDelete)
+
+	// Resends
+	b.HandleFunc(
+		"/"+SUBSCRIPTION+"/"+SLUG+"/{"+SLUG+"}/"+RESEND,
+		func(w http.ResponseWriter, r *http.Request) {
+			restUpdateResendPolicy(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get),
+				*notificationsContainer.ConfigurationFrom(dic.Get))
+		}).Methods(http.MethodPut)
+	b.HandleFunc(
+		"/"+SUBSCRIPTION+"/"+SLUG+"/{"+SLUG+"}/"+RESEND,
+		func(w http.ResponseWriter, r *http.Request) {
+			restCancelResends(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get))
+		}).Methods(http.MethodDelete)
+
+	// Routing Policies
+	b.HandleFunc(
+		"/"+SUBSCRIPTION+"/"+SLUG+"/{"+SLUG+"}/"+ROUTING,
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetRoutingPolicy(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
+	b.HandleFunc(
+		"/"+SUBSCRIPTION+"/"+SLUG+"/{"+SLUG+"}/"+ROUTING,
+		func(w http.ResponseWriter, r *http.Request) {
+			restAddRoutingPolicy(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get))
+		}).Methods(http.MethodPost)
+	b.HandleFunc(
+		"/"+SUBSCRIPTION+"/"+SLUG+"/{"+SLUG+"}/"+ROUTING,
+		func(w http.ResponseWriter, r *http.Request) {
+			restUpdateRoutingPolicy(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get))
+		}).Methods(http.MethodPut)
+	b.HandleFunc(
+		"/"+SUBSCRIPTION+"/"+SLUG+"/{"+SLUG+"}/"+ROUTING,
+		func(w http.ResponseWriter, r *http.Request) {
+			restDeleteRoutingPolicy(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get))
 		}).Methods(http.MethodDelete)
 
+	// Delivery Receipts
+	b.HandleFunc(
+		"/"+TRANSMISSION+"/"+ID+"/{"+ID+"}/"+RECEIPT,
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetDeliveryReceipt(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
+	b.HandleFunc(
+		"/"+TRANSMISSION+"/"+ID+"/{"+ID+"}/"+RECEIPT+"/"+VERIFY,
+		func(w http.ResponseWriter, r *http.Request) {
+			restVerifyDeliveryReceipt(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get),
+				*notificationsContainer.ConfigurationFrom(dic.Get))
+		}).Methods(http.MethodGet)
+
+	// Transmission Histories
+	b.HandleFunc(
+		"/"+TRANSMISSION+"/"+ID+"/{"+ID+"}/"+HISTORY,
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetTransmissionHistory(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				notificationsContainer.DBClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
+
 	// Cleanup
 	b.HandleFunc(
 		"/"+CLEANUP,
@@ -396,7 +808,7 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get))
+				notificationsContainer.DBClientFrom(dic.Get))
 		}).Methods(http.MethodDelete)
 	b.HandleFunc(
 		"/"+CLEANUP+"/"+AGE+"/{"+AGE+":[0-9]+}",
@@ -405,7 +817,7 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				w,
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
-				container.DBClientFrom(dic.Get))
+				notificationsContainer.DBClientFrom(dic.Get))
 		}).Methods(http.MethodDelete)
 
 	r.Use(correlation.ManageHeader)
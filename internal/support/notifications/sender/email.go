@@ -0,0 +1,198 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sender
+
+import (
+	"bytes"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	mail "net/smtp"
+	"strconv"
+	"strings"
+
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+type emailSender struct {
+	configuration *notificationsConfig.ConfigurationStruct
+	credentials   CredentialsProvider
+	lc            logger.LoggingClient
+}
+
+// NewEmailSender creates the sender mailing the notifications to the addresses of EMAIL channels through the SMTP
+// server of the configuration, authenticating with the credentials stored under its SecretPath when set.
+func NewEmailSender(
+	configuration *notificationsConfig.ConfigurationStruct,
+	credentials CredentialsProvider,
+	lc logger.LoggingClient) ChannelSender {
+
+	return emailSender{configuration: configuration, credentials: credentials, lc: lc}
+}
+
+func (s emailSender) Send(n models.Notification, c models.Channel) models.TransmissionRecord {
+	smtp := s.configuration.Smtp
+	if smtp.SecretPath != "" {
+		// The credentials provider returns the secret stored under the path given as database type when security is
+		// enabled and the username and password passed along, taken from the configuration, otherwise.
+		credentials, err := s.credentials.GetDatabaseCredentials(bootstrapConfig.Database{
+			Type:     smtp.SecretPath,
+			Username: smtp.Username,
+			Password: smtp.Password,
+		})
+		if err != nil {
+			s.lc.Error("Problems retrieving the SMTP credentials, issue: " + err.Error())
+			return newTransmissionRecord(fmt.Sprintf("unable to retrieve the SMTP credentials: %v", err), models.Failed)
+		}
+		smtp.Username = credentials.Username
+		smtp.Password = credentials.Password
+	}
+	return sendMail(n.Content, c.MailAddresses, n.ContentType, s.lc, smtp)
+}
+
+func sendMail(
+	message string,
+	addressees []string,
+	contentType string,
+	lc logger.LoggingClient,
+	smtp notificationsConfig.SmtpInfo) models.TransmissionRecord {
+
+	tr := newTransmissionRecord("SMTP server received", models.Sent)
+
+	smtpMessage := buildSmtpMessage(smtp.Sender, smtp.Subject, addressees, contentType, message)
+
+	err := smtpSend(addressees, smtpMessage, smtp)
+	if err != nil {
+		lc.Error("Problems sending message to: " + strings.Join(addressees, ",") + ", issue: " + err.Error())
+		tr.Status = models.Failed
+		tr.Response = err.Error()
+		return tr
+	}
+	return tr
+}
+
+func buildSmtpMessage(sender string, subject string, toAddresses []string, contentType string, message string) []byte {
+	smtpNewline := "\r\n"
+
+	// required CRLF at ends of lines and CRLF between header and body for SMTP RFC 822 style email
+	buf := bytes.NewBufferString("Subject: " + subject + smtpNewline)
+
+	buf.WriteString("From: " + sender + smtpNewline)
+
+	buf.WriteString("To: " + strings.Join(toAddresses, ",") + smtpNewline)
+
+	// only add MIME header if notification content type was set
+	// maybe provide charset overrides as well?
+	if contentType != "" {
+		buf.WriteString(fmt.Sprintf("MIME-version: 1.0;\r\nContent-Type: %s; charset=\"UTF-8\";\r\n", contentType))
+	}
+
+	buf.WriteString(smtpNewline)
+
+	//maximum line size is 1000
+	//split on newline first then break further as needed
+	for _, line := range strings.Split(message, smtpNewline) {
+		ln := 998
+		idx := 0
+		for len(line) > idx+ln {
+			buf.WriteString(line[idx:idx+ln] + smtpNewline)
+			idx += ln
+		}
+		buf.WriteString(line[idx:] + smtpNewline)
+	}
+
+	return []byte(buf.String())
+}
+
+func deduceAuth(s notificationsConfig.SmtpInfo) (mail.Auth, error) {
+	if s.CheckUsername() == "" && s.Password == "" {
+		return nil, errors.New("Notifications: Expecting username")
+	}
+	if s.CheckUsername() != "" && s.Password == "" {
+		return nil, nil
+	}
+	if s.CheckUsername() == "" && s.Password != "" {
+		return nil, errors.New("Notifications: Expecting username")
+	}
+	return mail.PlainAuth("", s.CheckUsername(), s.Password, s.Host), nil
+}
+
+// The function smtpSend replicates the functionality provided by the SendMail function
+// from smtp package. A rivision of standard function was needed because smtp.SendMail
+// does not allow for set-reset of InsecureSkipVerify flag of tls.Config structure. This
+// flag is needed to be manipulated for allowing the self-signed certificates.
+//
+// As it is replicating the functionality from smtp.SendMail, it borrows heavily from the
+// original function in its design and implementation. This version adds new functionality
+// for handling the SmtpInfo configuration and authentication management, along with the
+// requirement of ability to set-reset the InsecureSkipVerify flag.
+//
+// This is using a lot of unexported methods and types from smtp package through exported
+// interfaces, which makes it a little bit trickier to modify. Since, the intention for
+// this function is to use it as a support function for handling the low level SMTP
+// protocol mechanism, it is not exported.
+func smtpSend(to []string, msg []byte, s notificationsConfig.SmtpInfo) error {
+	addr := s.Host + ":" + strconv.Itoa(s.Port)
+	auth, err := deduceAuth(s)
+	if err != nil {
+		return err
+	}
+	c, err := mail.Dial(addr)
+	if err != nil {
+		return errors.New("Notifications: Error dialing address")
+	}
+	defer c.Close()
+	serverName, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return err
+	}
+	if err = c.Hello(addr); err != nil {
+		return err
+	}
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		config := &tls.Config{ServerName: serverName}
+		config.InsecureSkipVerify = s.EnableSelfSignedCert
+		if err = c.StartTLS(config); err != nil {
+			return err
+		}
+	}
+	if auth != nil {
+		if ok, _ := c.Extension("AUTH"); !ok {
+			return errors.New("Notifications: server doesn't support AUTH")
+		}
+		err = c.Auth(auth)
+		if err != nil {
+			return err
+		}
+	}
+	if err = c.Mail(s.Sender); err != nil {
+		return err
+	}
+	for _, addr := range to {
+		if err = c.Rcpt(addr); err != nil {
+			return err
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(msg)
+	if err != nil {
+		return err
+	}
+	err = w.Close()
+	if err != nil {
+		return err
+	}
+	return c.Quit()
+}
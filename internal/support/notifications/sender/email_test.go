@@ -12,14 +12,20 @@
  * the License.
  *
  *******************************************************************************/
-package notifications
+package sender
 
 import (
 	"fmt"
+	"testing"
+
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	"testing"
 )
 
 func TestBuildSmtpMessageNoContentType(t *testing.T) {
@@ -134,3 +140,15 @@ func TestBuildSmtpMessageLongMessageIsPartlyChunked(t *testing.T) {
 	expected := fmt.Sprintf("Subject: %s\r\nFrom: %s\r\nTo: %s\r\n\r\n%s%s\r\n%s\r\n", subject, from, to, goodLine, longLine[0:998], longLine[998:])
 	assert.Equal(t, expected, stringResult)
 }
+
+func TestEmailSenderWithoutStoredCredentials(t *testing.T) {
+	configuration := &notificationsConfig.ConfigurationStruct{
+		Smtp: notificationsConfig.SmtpInfo{Host: "localhost", Port: 25, SecretPath: "smtp"},
+	}
+	s := NewEmailSender(configuration, secretStore{}, logger.NewMockClient())
+
+	record := s.Send(testNotification, models.Channel{Type: models.Email, MailAddresses: []string{"jdoe@example.com"}})
+
+	assert.Equal(t, models.TransmissionStatus(models.Failed), record.Status)
+	assert.Contains(t, record.Response, "SMTP credentials")
+}
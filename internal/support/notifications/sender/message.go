@@ -0,0 +1,99 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sender
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+const (
+	criticalColor = "D00000"
+	normalColor   = "439FE0"
+)
+
+type slackMessage struct {
+	Text        string            `json:"text"`
+	Attachments []slackAttachment `json:"attachments,omitempty"`
+}
+
+type slackAttachment struct {
+	Color  string `json:"color"`
+	Text   string `json:"text"`
+	Footer string `json:"footer"`
+}
+
+type teamsMessageCard struct {
+	Type       string         `json:"@type"`
+	Context    string         `json:"@context"`
+	ThemeColor string         `json:"themeColor"`
+	Summary    string         `json:"summary"`
+	Title      string         `json:"title"`
+	Text       string         `json:"text"`
+	Sections   []teamsSection `json:"sections,omitempty"`
+}
+
+type teamsSection struct {
+	Facts []teamsFact `json:"facts"`
+}
+
+type teamsFact struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+var slackEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// formatSlackMessage formats the notification as a Slack message, its title in bold followed by its content in an
+// attachment colored by severity.
+func formatSlackMessage(n models.Notification) ([]byte, error) {
+	return json.Marshal(slackMessage{
+		Text: "*" + slackEscaper.Replace(title(n)) + "*",
+		Attachments: []slackAttachment{{
+			Color:  "#" + severityColor(n),
+			Text:   slackEscaper.Replace(n.Content),
+			Footer: slackEscaper.Replace(fmt.Sprintf("Notification %s from %s", n.Slug, n.Sender)),
+		}},
+	})
+}
+
+// formatTeamsMessage formats the notification as a Microsoft Teams message card colored by severity, listing the
+// sender, slug and labels of the notification as facts.
+func formatTeamsMessage(n models.Notification) ([]byte, error) {
+	facts := []teamsFact{{Name: "Sender", Value: n.Sender}, {Name: "Slug", Value: n.Slug}}
+	if len(n.Labels) > 0 {
+		facts = append(facts, teamsFact{Name: "Labels", Value: strings.Join(n.Labels, ", ")})
+	}
+
+	return json.Marshal(teamsMessageCard{
+		Type:       "MessageCard",
+		Context:    "https://schema.org/extensions",
+		ThemeColor: severityColor(n),
+		Summary:    title(n),
+		Title:      title(n),
+		Text:       n.Content,
+		Sections:   []teamsSection{{Facts: facts}},
+	})
+}
+
+// title summarizes the notification, e.g. [CRITICAL] SECURITY: Door opened.
+func title(n models.Notification) string {
+	summary := n.Description
+	if summary == "" {
+		summary = n.Slug
+	}
+	return fmt.Sprintf("[%s] %s: %s", n.Severity, n.Category, summary)
+}
+
+func severityColor(n models.Notification) string {
+	if n.Severity == models.Critical {
+		return criticalColor
+	}
+	return normalColor
+}
@@ -0,0 +1,122 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sender
+
+import (
+	"bytes"
+	"net/http"
+	"strconv"
+	"time"
+
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// Headers of the delivery receipt protocol. The slug of the notification is posted along with its content, and a
+// target acknowledges the receipt by answering with its key id, the time it accepted the content at, in milliseconds,
+// and its signature of the receipt.
+const (
+	NotificationSlugHeader = "X-Notification-Slug"
+	ReceiptKeyIdHeader     = "X-Receipt-Key-Id"
+	ReceiptTimestampHeader = "X-Receipt-Timestamp"
+	ReceiptSignatureHeader = "X-Receipt-Signature"
+)
+
+type restSender struct {
+	secrets SigningSecrets
+	client  *http.Client
+	lc      logger.LoggingClient
+}
+
+// NewRESTSender creates the sender posting the content of the notifications to the url of REST channels with the
+// client, signed with the secret of their subscription when it has one. The secrets may be nil, in which case nothing
+// is signed.
+func NewRESTSender(secrets SigningSecrets, client *http.Client, lc logger.LoggingClient) ChannelSender {
+	return restSender{secrets: secrets, client: client, lc: lc}
+}
+
+func (s restSender) Send(n models.Notification, c models.Channel) models.TransmissionRecord {
+	tr, _ := s.SendWithReceipt(n, c, "")
+	return tr
+}
+
+func (s restSender) SendWithReceipt(
+	n models.Notification,
+	c models.Channel,
+	subscription string) (models.TransmissionRecord, *notificationsModels.DeliveryReceipt) {
+
+	var secret string
+	if s.secrets != nil {
+		var err error
+		if secret, err = s.secrets.Secret(subscription); err != nil {
+			// The payload isn't sent unsigned, its target would reject it, but failed so that it gets resent.
+			s.lc.Error("Problems signing message to: " + c.Url + ", issue: " + err.Error())
+			return newTransmissionRecord(err.Error(), models.Failed), nil
+		}
+	}
+	return restSend(n.Slug, n.Content, c.Url, n.ContentType, secret, s.client, s.lc)
+}
+
+func restSend(
+	slug string,
+	message string,
+	url string,
+	contentType string,
+	secret string,
+	client *http.Client,
+	lc logger.LoggingClient) (models.TransmissionRecord, *notificationsModels.DeliveryReceipt) {
+
+	tr := newTransmissionRecord("", models.Sent)
+
+	if contentType == "" {
+		contentType = "text/plain"
+	}
+
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer([]byte(message)))
+	if err == nil {
+		req.Header.Set("Content-Type", contentType)
+		req.Header.Set(NotificationSlugHeader, slug)
+		if secret != "" {
+			timestamp := time.Now().Unix()
+			req.Header.Set(SignatureTimestampHeader, strconv.FormatInt(timestamp, 10))
+			req.Header.Set(SignatureHeader, Sign(secret, timestamp, []byte(message)))
+		}
+		var rs *http.Response
+		if rs, err = client.Do(req); err == nil {
+			defer rs.Body.Close()
+			tr.Response = "Got response status code: " + rs.Status
+			return tr, receiptFrom(rs, slug, message)
+		}
+	}
+
+	lc.Error("Problems sending message to: " + url)
+	lc.Error("Error indication was:  " + err.Error())
+	tr.Status = models.Failed
+	tr.Response = err.Error()
+	return tr, nil
+}
+
+// receiptFrom returns the receipt of the content signed in the response of a target which accepted it, or nil when the
+// response carries no receipt.
+func receiptFrom(rs *http.Response, slug string, message string) *notificationsModels.DeliveryReceipt {
+	if rs.StatusCode < 200 || rs.StatusCode >= 300 {
+		return nil
+	}
+	signature := rs.Header.Get(ReceiptSignatureHeader)
+	if signature == "" {
+		return nil
+	}
+	timestamp, _ := strconv.ParseInt(rs.Header.Get(ReceiptTimestampHeader), 10, 64)
+	return &notificationsModels.DeliveryReceipt{
+		Notification: slug,
+		ContentHash:  notificationsModels.ContentHash(message),
+		KeyId:        rs.Header.Get(ReceiptKeyIdHeader),
+		Timestamp:    timestamp,
+		Signature:    signature,
+	}
+}
@@ -0,0 +1,52 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sender
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRESTSendWithReceipt(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) == "signed" {
+			w.Header().Set(ReceiptKeyIdHeader, "target")
+			w.Header().Set(ReceiptTimestampHeader, "1600000000000")
+			w.Header().Set(ReceiptSignatureHeader, "c2lnbmF0dXJl")
+		}
+		assert.Equal(t, "overheat", r.Header.Get(NotificationSlugHeader))
+	}))
+	defer server.Close()
+
+	s := NewRESTSender(nil, http.DefaultClient, logger.NewMockClient()).(ReceiptSender)
+	channel := models.Channel{Type: models.Rest, Url: server.URL}
+
+	tr, receipt := s.SendWithReceipt(models.Notification{Slug: "overheat", Content: "signed"}, channel, "")
+	assert.Equal(t, models.TransmissionStatus(models.Sent), tr.Status)
+	require.NotNil(t, receipt)
+	assert.Equal(t, notificationsModels.DeliveryReceipt{
+		Notification: "overheat",
+		ContentHash:  notificationsModels.ContentHash("signed"),
+		KeyId:        "target",
+		Timestamp:    1600000000000,
+		Signature:    "c2lnbmF0dXJl",
+	}, *receipt)
+
+	tr, receipt = s.SendWithReceipt(models.Notification{Slug: "overheat", Content: "unsigned"}, channel, "")
+	assert.Equal(t, models.TransmissionStatus(models.Sent), tr.Status)
+	assert.Nil(t, receipt)
+}
@@ -0,0 +1,122 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package sender provides the senders transmitting notifications through the channels of the subscriptions, registered
+// by channel type.
+package sender
+
+import (
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// Channel types of the built-in chat senders. The contracts only know the EMAIL and REST channel types, so a REST
+// channel selects another type through the scheme of its url, e.g. slack://alerts is a SLACK channel.
+const (
+	Slack = "SLACK"
+	Teams = "TEAMS"
+)
+
+// ChannelSender transmits notifications through the channels of the type it is registered for.
+type ChannelSender interface {
+	// Send transmits the notification through the channel and returns the record of the attempt.
+	Send(n models.Notification, c models.Channel) models.TransmissionRecord
+}
+
+// ReceiptSender is a ChannelSender whose targets may acknowledge the notifications with a signed delivery receipt.
+type ReceiptSender interface {
+	ChannelSender
+	// SendWithReceipt transmits the notification through the channel of the subscription and returns the record of the
+	// attempt, along with the receipt returned by the target, if any.
+	SendWithReceipt(
+		n models.Notification,
+		c models.Channel,
+		subscription string) (models.TransmissionRecord, *notificationsModels.DeliveryReceipt)
+}
+
+// Registry holds the channel senders by channel type.
+type Registry struct {
+	mutex   sync.RWMutex
+	senders map[string]ChannelSender
+}
+
+// NewRegistry creates an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{senders: make(map[string]ChannelSender)}
+}
+
+// Register makes the sender transmit the notifications through the channels of the given type, replacing the sender
+// previously registered for it.
+func (r *Registry) Register(channelType string, s ChannelSender) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.senders[strings.ToUpper(channelType)] = s
+}
+
+// Send transmits the notification through the channel with the sender registered for its type. The attempt fails when
+// no sender is registered for the type.
+func (r *Registry) Send(n models.Notification, c models.Channel) models.TransmissionRecord {
+	channelType := ChannelType(c)
+
+	var s ChannelSender
+	if r != nil {
+		r.mutex.RLock()
+		s = r.senders[channelType]
+		r.mutex.RUnlock()
+	}
+	if s == nil {
+		return newTransmissionRecord("no sender registered for channel type "+channelType, models.Failed)
+	}
+	return s.Send(n, c)
+}
+
+// SendWithReceipt transmits the notification through the channel of the subscription as Send does, and returns the
+// delivery receipt of the target when the sender of the channel type collects them.
+func (r *Registry) SendWithReceipt(
+	n models.Notification,
+	c models.Channel,
+	subscription string) (models.TransmissionRecord, *notificationsModels.DeliveryReceipt) {
+
+	var s ChannelSender
+	if r != nil {
+		r.mutex.RLock()
+		s = r.senders[ChannelType(c)]
+		r.mutex.RUnlock()
+	}
+	if rs, ok := s.(ReceiptSender); ok {
+		return rs.SendWithReceipt(n, c, subscription)
+	}
+	return r.Send(n, c), nil
+}
+
+// ChannelType returns the type of the channel, which for a REST channel is the scheme of its url unless it is http or
+// https.
+func ChannelType(c models.Channel) string {
+	if c.Type == models.ChannelType(models.Rest) {
+		if u, err := url.Parse(c.Url); err == nil {
+			switch scheme := strings.ToUpper(u.Scheme); scheme {
+			case "", "HTTP", "HTTPS":
+			default:
+				return scheme
+			}
+		}
+	}
+	return strings.ToUpper(string(c.Type))
+}
+
+func newTransmissionRecord(msg string, st models.TransmissionStatus) models.TransmissionRecord {
+	tr := models.TransmissionRecord{}
+	tr.Sent = db.MakeTimestamp()
+	tr.Status = st
+	tr.Response = msg
+	return tr
+}
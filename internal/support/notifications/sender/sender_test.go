@@ -0,0 +1,65 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sender
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingSender struct {
+	sent []models.Channel
+}
+
+func (s *recordingSender) Send(_ models.Notification, c models.Channel) models.TransmissionRecord {
+	s.sent = append(s.sent, c)
+	return newTransmissionRecord("", models.Sent)
+}
+
+func TestChannelType(t *testing.T) {
+	tests := []struct {
+		name     string
+		channel  models.Channel
+		expected string
+	}{
+		{"email", models.Channel{Type: models.Email, MailAddresses: []string{"jdoe@example.com"}}, models.Email},
+		{"rest http", models.Channel{Type: models.Rest, Url: "http://localhost:8080/alerts"}, models.Rest},
+		{"rest https", models.Channel{Type: models.Rest, Url: "HTTPS://localhost/alerts"}, models.Rest},
+		{"slack", models.Channel{Type: models.Rest, Url: "slack://alerts"}, Slack},
+		{"teams", models.Channel{Type: models.Rest, Url: "Teams://operations"}, Teams},
+		{"unparsable url", models.Channel{Type: models.Rest, Url: "%zz"}, models.Rest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ChannelType(tt.channel))
+		})
+	}
+}
+
+func TestRegistrySend(t *testing.T) {
+	rest := &recordingSender{}
+	slack := &recordingSender{}
+	registry := NewRegistry()
+	registry.Register(models.Rest, rest)
+	registry.Register("slack", slack)
+
+	restChannel := models.Channel{Type: models.Rest, Url: "http://localhost/alerts"}
+	slackChannel := models.Channel{Type: models.Rest, Url: "slack://alerts"}
+	assert.Equal(t, models.TransmissionStatus(models.Sent), registry.Send(models.Notification{}, restChannel).Status)
+	assert.Equal(t, models.TransmissionStatus(models.Sent), registry.Send(models.Notification{}, slackChannel).Status)
+	assert.Equal(t, []models.Channel{restChannel}, rest.sent)
+	assert.Equal(t, []models.Channel{slackChannel}, slack.sent)
+
+	tr := registry.Send(models.Notification{}, models.Channel{Type: models.Rest, Url: "teams://operations"})
+	assert.Equal(t, models.TransmissionStatus(models.Failed), tr.Status)
+	assert.Contains(t, tr.Response, Teams)
+
+	var unset *Registry
+	assert.Equal(t, models.TransmissionStatus(models.Failed), unset.Send(models.Notification{}, restChannel).Status)
+}
@@ -0,0 +1,92 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sender
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+)
+
+// Headers of the signed payloads. The signature is the HMAC-SHA256, keyed with the secret of the subscription, of the
+// timestamp, in seconds since the epoch, followed by a dot and the payload. It is sent hex encoded and prefixed by
+// sha256=, so that the receivers can check both the origin of the payload and its freshness.
+const (
+	SignatureHeader          = "X-Notification-Signature"
+	SignatureTimestampHeader = "X-Notification-Timestamp"
+
+	signaturePrefix = "sha256="
+)
+
+// SigningSecrets retrieves the secrets the payloads posted to the REST channels of the subscriptions are signed with.
+type SigningSecrets interface {
+	// Secret returns the secret of the subscription, or an empty string when its payloads aren't signed.
+	Secret(subscription string) (string, error)
+}
+
+type signingSecrets struct {
+	info        func() notificationsConfig.SigningInfo
+	credentials CredentialsProvider
+}
+
+// NewSigningSecrets creates the SigningSecrets reading the secret of each subscription signing its payloads from the
+// secret store, where it is stored as the password of the secret named after the slug of the subscription.
+func NewSigningSecrets(
+	configuration *notificationsConfig.ConfigurationStruct,
+	credentials CredentialsProvider) SigningSecrets {
+
+	return signingSecrets{
+		info:        func() notificationsConfig.SigningInfo { return configuration.Signing },
+		credentials: credentials,
+	}
+}
+
+func (s signingSecrets) Secret(subscription string) (string, error) {
+	info := s.info()
+	if subscription == "" || !info.Signs(subscription) {
+		return "", nil
+	}
+
+	// When security is disabled the credentials provider returns the password passed along, taken from the
+	// configuration.
+	credentials, err := s.credentials.GetDatabaseCredentials(bootstrapConfig.Database{
+		Type:     path.Join(info.SecretPath, subscription),
+		Password: info.Secrets[subscription],
+	})
+	if err != nil {
+		return "", fmt.Errorf("unable to retrieve the signing secret of subscription '%s': %v", subscription, err)
+	}
+	if credentials.Password == "" {
+		return "", fmt.Errorf("no signing secret stored for subscription '%s'", subscription)
+	}
+	return credentials.Password, nil
+}
+
+// Sign returns the value of the signature header of the payload sent at the timestamp, in seconds since the epoch.
+func Sign(secret string, timestamp int64, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature reports whether the value of the signature header is the signature of the payload sent at the
+// timestamp, as a receiver checks it. The comparison takes a constant time.
+func VerifySignature(secret string, timestamp int64, payload []byte, signature string) bool {
+	if !strings.HasPrefix(signature, signaturePrefix) {
+		return false
+	}
+	return hmac.Equal([]byte(Sign(secret, timestamp, payload)), []byte(signature))
+}
@@ -0,0 +1,86 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sender
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSign(t *testing.T) {
+	// Reference value computed with: printf '1600000000.payload' | openssl dgst -sha256 -hmac secret
+	signature := Sign("secret", 1600000000, []byte("payload"))
+	assert.Equal(t, "sha256=36955cad05cf254b22576d6b463a5be66c54d719d909f1b39acdf57e6f6f2e10", signature)
+
+	assert.True(t, VerifySignature("secret", 1600000000, []byte("payload"), signature))
+	assert.False(t, VerifySignature("other", 1600000000, []byte("payload"), signature))
+	assert.False(t, VerifySignature("secret", 1600000001, []byte("payload"), signature))
+	assert.False(t, VerifySignature("secret", 1600000000, []byte("tampered"), signature))
+	assert.False(t, VerifySignature("secret", 1600000000, []byte("payload"), signature[len(signaturePrefix):]))
+}
+
+func TestRESTSendSigned(t *testing.T) {
+	var received http.Header
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header
+		body, _ = ioutil.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	configuration := &notificationsConfig.ConfigurationStruct{
+		Signing: notificationsConfig.SigningInfo{Subscriptions: "alerts, missing", SecretPath: "signing"},
+	}
+	secrets := NewSigningSecrets(configuration, secretStore{"signing/alerts": "alerts-secret"})
+	s := NewRESTSender(secrets, http.DefaultClient, logger.NewMockClient()).(ReceiptSender)
+	channel := models.Channel{Type: models.Rest, Url: server.URL}
+
+	tr, _ := s.SendWithReceipt(testNotification, channel, "alerts")
+	require.Equal(t, models.TransmissionStatus(models.Sent), tr.Status)
+	timestamp, err := strconv.ParseInt(received.Get(SignatureTimestampHeader), 10, 64)
+	require.NoError(t, err)
+	assert.True(t, VerifySignature("alerts-secret", timestamp, body, received.Get(SignatureHeader)))
+	assert.Equal(t, testNotification.Content, string(body))
+
+	tr, _ = s.SendWithReceipt(testNotification, channel, "unsigned")
+	require.Equal(t, models.TransmissionStatus(models.Sent), tr.Status)
+	assert.Empty(t, received.Get(SignatureHeader))
+	assert.Empty(t, received.Get(SignatureTimestampHeader))
+
+	received = nil
+	tr, _ = s.SendWithReceipt(testNotification, channel, "missing")
+	assert.Equal(t, models.TransmissionStatus(models.Failed), tr.Status)
+	assert.Nil(t, received, "a payload whose secret is missing should not be sent unsigned")
+}
+
+func TestSigningSecretsInsecure(t *testing.T) {
+	configuration := &notificationsConfig.ConfigurationStruct{
+		Signing: notificationsConfig.SigningInfo{
+			Subscriptions: "alerts",
+			Secrets:       map[string]string{"alerts": "alerts-secret", "other": "other-secret"},
+		},
+	}
+	secrets := NewSigningSecrets(configuration, insecureStore{})
+
+	secret, err := secrets.Secret("alerts")
+	require.NoError(t, err)
+	assert.Equal(t, "alerts-secret", secret)
+
+	secret, err = secrets.Secret("other")
+	require.NoError(t, err)
+	assert.Empty(t, secret, "only the subscriptions listed should be signed")
+}
@@ -0,0 +1,209 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sender
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+)
+
+// SMPP 3.4 command ids of the operations of a transmitter.
+const (
+	smppGenericNack         uint32 = 0x80000000
+	smppBindTransmitter     uint32 = 0x00000002
+	smppBindTransmitterResp uint32 = 0x80000002
+	smppSubmitSm            uint32 = 0x00000004
+	smppSubmitSmResp        uint32 = 0x80000004
+	smppUnbind              uint32 = 0x00000006
+	smppUnbindResp          uint32 = 0x80000006
+)
+
+const (
+	smppHeaderLength     = 16
+	smppMaxPduLength     = 64 * 1024
+	smppInterfaceVersion = 0x34
+	// smppMaxMessageLength is the maximum length of the short_message field of submit_sm.
+	smppMaxMessageLength = 254
+)
+
+// Type of number and numbering plan indicator of the addresses.
+const (
+	smppTonUnknown       = 0x00
+	smppTonInternational = 0x01
+	smppTonAlphanumeric  = 0x05
+	smppNpiUnknown       = 0x00
+	smppNpiIsdn          = 0x01
+)
+
+// smppProvider delivers the messages through a session bound as transmitter to an SMPP server.
+type smppProvider struct {
+	address    string
+	sender     string
+	systemId   string
+	password   string
+	systemType string
+	timeout    time.Duration
+}
+
+func newSmppProvider(
+	info notificationsConfig.SmsInfo,
+	credentials bootstrapConfig.Credentials,
+	timeout time.Duration) smsProvider {
+
+	return smppProvider{
+		address:    net.JoinHostPort(info.Host, strconv.Itoa(info.Port)),
+		sender:     info.Sender,
+		systemId:   credentials.Username,
+		password:   credentials.Password,
+		systemType: info.SystemType,
+		timeout:    timeout,
+	}
+}
+
+// deliver binds a session for the message, submits it once per recipient and unbinds.
+func (p smppProvider) deliver(recipients []string, message string) error {
+	if len(message) > smppMaxMessageLength {
+		return fmt.Errorf("message of %d bytes exceeds the %d bytes of an SMPP short message",
+			len(message), smppMaxMessageLength)
+	}
+
+	conn, err := net.DialTimeout("tcp", p.address, p.timeout)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+	if err = conn.SetDeadline(time.Now().Add(p.timeout)); err != nil {
+		return err
+	}
+
+	s := smppSession{conn: conn}
+	var bind bytes.Buffer
+	writeCString(&bind, p.systemId)
+	writeCString(&bind, p.password)
+	writeCString(&bind, p.systemType)
+	bind.WriteByte(smppInterfaceVersion)
+	bind.WriteByte(smppTonUnknown)
+	bind.WriteByte(smppNpiUnknown)
+	writeCString(&bind, "")
+	if err = s.call(smppBindTransmitter, smppBindTransmitterResp, bind.Bytes()); err != nil {
+		return fmt.Errorf("SMPP bind failed: %v", err)
+	}
+
+	var failures []string
+	for _, recipient := range recipients {
+		if err = s.call(smppSubmitSm, smppSubmitSmResp, p.submitSm(recipient, message)); err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", recipient, err))
+		}
+	}
+
+	// The messages are accepted whether or not the server acknowledges the unbind.
+	_ = s.call(smppUnbind, smppUnbindResp, nil)
+
+	if len(failures) > 0 {
+		return fmt.Errorf("SMPP server failed to accept the message for %s", strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+func (p smppProvider) submitSm(recipient string, message string) []byte {
+	var body bytes.Buffer
+	writeCString(&body, "") // service_type
+	sourceTon, sourceNpi := addressType(p.sender)
+	body.WriteByte(sourceTon)
+	body.WriteByte(sourceNpi)
+	writeCString(&body, strings.TrimPrefix(p.sender, "+"))
+	body.WriteByte(smppTonInternational)
+	body.WriteByte(smppNpiIsdn)
+	writeCString(&body, strings.TrimPrefix(recipient, "+"))
+	body.WriteByte(0)                  // esm_class
+	body.WriteByte(0)                  // protocol_id
+	body.WriteByte(0)                  // priority_flag
+	writeCString(&body, "")            // schedule_delivery_time
+	writeCString(&body, "")            // validity_period
+	body.WriteByte(0)                  // registered_delivery
+	body.WriteByte(0)                  // replace_if_present_flag
+	body.WriteByte(0)                  // data_coding, the default alphabet of the server
+	body.WriteByte(0)                  // sm_default_msg_id
+	body.WriteByte(byte(len(message))) // sm_length
+	body.WriteString(message)
+	return body.Bytes()
+}
+
+// addressType returns the type of number and numbering plan indicator of a source address: an international number
+// when it starts with +, an alphanumeric id when it holds anything but digits.
+func addressType(address string) (ton byte, npi byte) {
+	if strings.HasPrefix(address, "+") {
+		return smppTonInternational, smppNpiIsdn
+	}
+	if _, err := strconv.ParseUint(address, 10, 64); address != "" && err != nil {
+		return smppTonAlphanumeric, smppNpiUnknown
+	}
+	return smppTonUnknown, smppNpiUnknown
+}
+
+func writeCString(b *bytes.Buffer, s string) {
+	b.WriteString(s)
+	b.WriteByte(0)
+}
+
+// smppSession exchanges the PDUs of a session, one request awaiting its response at a time.
+type smppSession struct {
+	conn     io.ReadWriter
+	sequence uint32
+}
+
+// call sends a request and reads its response, failing unless the server answers with a zero command status.
+func (s *smppSession) call(command uint32, response uint32, body []byte) error {
+	s.sequence++
+	pdu := make([]byte, smppHeaderLength, smppHeaderLength+len(body))
+	binary.BigEndian.PutUint32(pdu[0:], uint32(smppHeaderLength+len(body)))
+	binary.BigEndian.PutUint32(pdu[4:], command)
+	binary.BigEndian.PutUint32(pdu[8:], 0)
+	binary.BigEndian.PutUint32(pdu[12:], s.sequence)
+	if _, err := s.conn.Write(append(pdu, body...)); err != nil {
+		return err
+	}
+
+	header := make([]byte, smppHeaderLength)
+	if _, err := io.ReadFull(s.conn, header); err != nil {
+		return err
+	}
+	length := binary.BigEndian.Uint32(header[0:])
+	id := binary.BigEndian.Uint32(header[4:])
+	status := binary.BigEndian.Uint32(header[8:])
+	if length < smppHeaderLength || length > smppMaxPduLength {
+		return fmt.Errorf("invalid PDU length %d", length)
+	}
+	// The body of the response, e.g. the message id of submit_sm_resp, is not needed.
+	if _, err := io.CopyN(ioutil.Discard, s.conn, int64(length-smppHeaderLength)); err != nil {
+		return err
+	}
+
+	switch {
+	case id == smppGenericNack:
+		return fmt.Errorf("request rejected with status 0x%08X", status)
+	case id != response:
+		return fmt.Errorf("unexpected response 0x%08X", id)
+	case status != 0:
+		return fmt.Errorf("command status 0x%08X", status)
+	}
+	if binary.BigEndian.Uint32(header[12:]) != s.sequence {
+		return errors.New("response sequence number does not match the request")
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sender
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// Sms is the channel type of the channels texting notifications to phone numbers, e.g. sms:+15551234567,+15557654321.
+const Sms = "SMS"
+
+// SMS providers the sender delivers the messages through.
+const (
+	TwilioProvider = "twilio"
+	SmppProvider   = "smpp"
+)
+
+const (
+	defaultSmsTimeout = 10 * time.Second
+	truncationMark    = "..."
+)
+
+var phoneNumberPattern = regexp.MustCompile(`^\+?[0-9]{3,15}$`)
+
+// smsProvider delivers a text message to a phone number.
+type smsProvider interface {
+	deliver(recipients []string, message string) error
+}
+
+type smsSender struct {
+	configuration *notificationsConfig.ConfigurationStruct
+	credentials   CredentialsProvider
+	lc            logger.LoggingClient
+}
+
+// NewSmsSender creates the sender texting the notifications to the phone numbers of SMS channels through the provider
+// of the configuration.
+func NewSmsSender(
+	configuration *notificationsConfig.ConfigurationStruct,
+	credentials CredentialsProvider,
+	lc logger.LoggingClient) ChannelSender {
+
+	return smsSender{configuration: configuration, credentials: credentials, lc: lc}
+}
+
+func (s smsSender) Send(n models.Notification, c models.Channel) models.TransmissionRecord {
+	info := s.configuration.Sms
+
+	recipients, err := PhoneNumbers(c)
+	if err != nil {
+		return s.failed(err)
+	}
+
+	provider, err := s.provider(info)
+	if err != nil {
+		return s.failed(err)
+	}
+
+	if err = provider.deliver(recipients, smsMessage(n, info.MaxLength)); err != nil {
+		return s.failed(err)
+	}
+	return newTransmissionRecord(fmt.Sprintf("%s provider accepted the message", info.Provider), models.Sent)
+}
+
+func (s smsSender) failed(err error) models.TransmissionRecord {
+	s.lc.Error("Problems sending SMS message, issue: " + err.Error())
+	return newTransmissionRecord(err.Error(), models.Failed)
+}
+
+func (s smsSender) provider(info notificationsConfig.SmsInfo) (smsProvider, error) {
+	timeout := defaultSmsTimeout
+	if info.Timeout != "" {
+		var err error
+		if timeout, err = time.ParseDuration(info.Timeout); err != nil {
+			return nil, fmt.Errorf("invalid SMS timeout '%s'", info.Timeout)
+		}
+	}
+
+	// The credentials provider returns the secret stored under the path given as database type when security is
+	// enabled and the username and password passed along, taken from the configuration, otherwise.
+	credentials, err := s.credentials.GetDatabaseCredentials(bootstrapConfig.Database{
+		Type:     info.SecretPath,
+		Username: info.Username,
+		Password: info.Password,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve the SMS provider credentials: %v", err)
+	}
+
+	switch strings.ToLower(info.Provider) {
+	case TwilioProvider:
+		return newTwilioProvider(info, credentials, timeout), nil
+	case SmppProvider:
+		return newSmppProvider(info, credentials, timeout), nil
+	default:
+		return nil, fmt.Errorf("unknown SMS provider '%s'", info.Provider)
+	}
+}
+
+// PhoneNumbers returns the phone numbers an SMS channel texts to, listed after the sms: scheme of its url.
+func PhoneNumbers(c models.Channel) ([]string, error) {
+	u, err := url.Parse(c.Url)
+	if err != nil {
+		return nil, err
+	}
+	list := u.Opaque
+	if list == "" {
+		list = u.Host + u.Path
+	}
+
+	var numbers, invalid []string
+	for _, number := range strings.Split(list, ",") {
+		number = strings.TrimSpace(number)
+		if number == "" {
+			continue
+		}
+		if !phoneNumberPattern.MatchString(number) {
+			invalid = append(invalid, number)
+			continue
+		}
+		numbers = append(numbers, number)
+	}
+	if len(invalid) > 0 {
+		return nil, fmt.Errorf("invalid phone numbers %s", strings.Join(invalid, ", "))
+	}
+	if len(numbers) == 0 {
+		return nil, errors.New("no phone number to text to")
+	}
+	return numbers, nil
+}
+
+// smsMessage formats the notification as a text message, truncated to maxLength characters unless it is 0.
+func smsMessage(n models.Notification, maxLength int) string {
+	message := []rune(title(n) + "\n" + n.Content)
+	if maxLength > len(truncationMark) && len(message) > maxLength {
+		return string(message[:maxLength-len(truncationMark)]) + truncationMark
+	}
+	return string(message)
+}
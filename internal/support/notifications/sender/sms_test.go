@@ -0,0 +1,182 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sender
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPhoneNumbers(t *testing.T) {
+	tests := []struct {
+		name        string
+		url         string
+		expected    []string
+		expectError bool
+	}{
+		{"single number", "sms:+15551234567", []string{"+15551234567"}, false},
+		{"several numbers", "sms:+15551234567,5557654321", []string{"+15551234567", "5557654321"}, false},
+		{"authority form", "sms://15551234567", []string{"15551234567"}, false},
+		{"invalid number", "sms:+1555abc", nil, true},
+		{"no number", "sms:", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			numbers, err := PhoneNumbers(models.Channel{Type: models.ChannelType(models.Rest), Url: tt.url})
+			if tt.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, numbers)
+		})
+	}
+}
+
+func TestSmsMessage(t *testing.T) {
+	assert.Equal(t, "[CRITICAL] SECURITY: Door <1> opened\nThe door of the server room is open",
+		smsMessage(testNotification, 0))
+	assert.Equal(t, "[CRITICAL] SECURITY: D...", smsMessage(testNotification, 25))
+}
+
+func TestSmsSenderTwilio(t *testing.T) {
+	var received []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/2010-04-01/Accounts/AC123/Messages.json", r.URL.Path)
+		sid, token, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "AC123", sid)
+		assert.Equal(t, "secret", token)
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "+15550000000", r.PostForm.Get("From"))
+		received = append(received, r.PostForm.Get("To"))
+		if r.PostForm.Get("To") == "+15559999999" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"code": 21211, "message": "Invalid 'To' Phone Number"}`))
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	configuration := &notificationsConfig.ConfigurationStruct{Sms: notificationsConfig.SmsInfo{
+		Provider:  TwilioProvider,
+		Sender:    "+15550000000",
+		Username:  "AC123",
+		Password:  "secret",
+		TwilioUrl: server.URL,
+	}}
+	s := NewSmsSender(configuration, insecureStore{}, logger.NewMockClient())
+
+	record := s.Send(testNotification, models.Channel{Url: "sms:+15551234567,+15557654321"})
+	assert.Equal(t, models.TransmissionStatus(models.Sent), record.Status)
+	assert.Equal(t, []string{"+15551234567", "+15557654321"}, received)
+
+	record = s.Send(testNotification, models.Channel{Url: "sms:+15559999999"})
+	assert.Equal(t, models.TransmissionStatus(models.Failed), record.Status)
+	assert.Contains(t, record.Response, "Invalid 'To' Phone Number")
+}
+
+// fakeSmscServer accepts a single SMPP session, records the command ids it receives and answers each with the
+// status returned by respond.
+func fakeSmscServer(t *testing.T, respond func(command uint32) uint32) (net.Listener, <-chan []uint32) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	commands := make(chan []uint32, 1)
+	go func() {
+		var received []uint32
+		defer func() { commands <- received }()
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			header := make([]byte, smppHeaderLength)
+			if _, err := io.ReadFull(conn, header); err != nil {
+				return
+			}
+			body := make([]byte, binary.BigEndian.Uint32(header[0:])-smppHeaderLength)
+			if _, err := io.ReadFull(conn, body); err != nil {
+				return
+			}
+			command := binary.BigEndian.Uint32(header[4:])
+			received = append(received, command)
+
+			resp := make([]byte, smppHeaderLength)
+			binary.BigEndian.PutUint32(resp[0:], smppHeaderLength)
+			binary.BigEndian.PutUint32(resp[4:], command|smppGenericNack)
+			binary.BigEndian.PutUint32(resp[8:], respond(command))
+			copy(resp[12:], header[12:])
+			if _, err := conn.Write(resp); err != nil {
+				return
+			}
+			if command == smppUnbind {
+				return
+			}
+		}
+	}()
+	return listener, commands
+}
+
+func smppConfiguration(t *testing.T, listener net.Listener) *notificationsConfig.ConfigurationStruct {
+	host, port, err := net.SplitHostPort(listener.Addr().String())
+	require.NoError(t, err)
+	portNumber, err := strconv.Atoi(port)
+	require.NoError(t, err)
+	return &notificationsConfig.ConfigurationStruct{Sms: notificationsConfig.SmsInfo{
+		Provider:  SmppProvider,
+		Sender:    "EdgeX",
+		Username:  "edgex",
+		Password:  "secret",
+		Host:      host,
+		Port:      portNumber,
+		MaxLength: 160,
+		Timeout:   "5s",
+	}}
+}
+
+func TestSmsSenderSmpp(t *testing.T) {
+	listener, commands := fakeSmscServer(t, func(uint32) uint32 { return 0 })
+	defer listener.Close()
+
+	s := NewSmsSender(smppConfiguration(t, listener), insecureStore{}, logger.NewMockClient())
+	record := s.Send(testNotification, models.Channel{Url: "sms:+15551234567,+15557654321"})
+
+	assert.Equal(t, models.TransmissionStatus(models.Sent), record.Status)
+	assert.Equal(t, []uint32{smppBindTransmitter, smppSubmitSm, smppSubmitSm, smppUnbind}, <-commands)
+}
+
+func TestSmsSenderSmppBindRejected(t *testing.T) {
+	listener, commands := fakeSmscServer(t, func(command uint32) uint32 {
+		if command == smppBindTransmitter {
+			return 0x0000000E // ESME_RINVPASWD
+		}
+		return 0
+	})
+	defer listener.Close()
+
+	s := NewSmsSender(smppConfiguration(t, listener), insecureStore{}, logger.NewMockClient())
+	record := s.Send(testNotification, models.Channel{Url: "sms:+15551234567"})
+
+	assert.Equal(t, models.TransmissionStatus(models.Failed), record.Status)
+	assert.Contains(t, record.Response, "SMPP bind failed")
+	assert.Equal(t, []uint32{smppBindTransmitter}, <-commands)
+}
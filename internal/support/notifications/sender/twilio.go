@@ -0,0 +1,97 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sender
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+)
+
+const defaultTwilioUrl = "https://api.twilio.com"
+
+// twilioProvider delivers the messages through the Messages resource of the Twilio REST API, authenticated with the
+// account SID and auth token.
+type twilioProvider struct {
+	baseUrl    string
+	sender     string
+	accountSid string
+	authToken  string
+	client     http.Client
+}
+
+func newTwilioProvider(
+	info notificationsConfig.SmsInfo,
+	credentials bootstrapConfig.Credentials,
+	timeout time.Duration) smsProvider {
+
+	baseUrl := info.TwilioUrl
+	if baseUrl == "" {
+		baseUrl = defaultTwilioUrl
+	}
+	return twilioProvider{
+		baseUrl:    strings.TrimSuffix(baseUrl, "/"),
+		sender:     info.Sender,
+		accountSid: credentials.Username,
+		authToken:  credentials.Password,
+		client:     http.Client{Timeout: timeout},
+	}
+}
+
+// deliver sends the message to each recipient in turn, as the Messages resource accepts a single recipient.
+func (p twilioProvider) deliver(recipients []string, message string) error {
+	endpoint := fmt.Sprintf("%s/2010-04-01/Accounts/%s/Messages.json", p.baseUrl, url.PathEscape(p.accountSid))
+
+	var failures []string
+	for _, recipient := range recipients {
+		if err := p.deliverTo(endpoint, recipient, message); err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", recipient, err))
+		}
+	}
+	if len(failures) > 0 {
+		return fmt.Errorf("twilio failed to deliver the message to %s", strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+func (p twilioProvider) deliverTo(endpoint string, recipient string, message string) error {
+	form := url.Values{"To": {recipient}, "From": {p.sender}, "Body": {message}}
+	req, err := http.NewRequest(http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(p.accountSid, p.authToken)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		return nil
+	}
+
+	// Twilio describes the errors with a JSON object holding a code and a message.
+	var twilioErr struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	if json.Unmarshal(body, &twilioErr) == nil && twilioErr.Message != "" {
+		return fmt.Errorf("%s (error %d)", twilioErr.Message, twilioErr.Code)
+	}
+	return fmt.Errorf("got response status code: %s", resp.Status)
+}
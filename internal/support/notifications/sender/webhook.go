@@ -0,0 +1,148 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sender
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+const defaultWebhookTimeout = 10 * time.Second
+
+// CredentialsProvider retrieves the username and password stored under a path of the secret store, as the bootstrap
+// credentials provider does for the databases.
+type CredentialsProvider interface {
+	GetDatabaseCredentials(database bootstrapConfig.Database) (bootstrapConfig.Credentials, error)
+}
+
+// webhookSender posts the notifications to the incoming webhooks of a chat service. The url of a webhook embeds its
+// token, hence it is read from the secret store rather than from the subscription.
+type webhookSender struct {
+	channelType string
+	info        func() notificationsConfig.WebhookInfo
+	credentials CredentialsProvider
+	format      func(n models.Notification) ([]byte, error)
+	lc          logger.LoggingClient
+}
+
+// NewSlackSender creates the sender posting the notifications to the Slack incoming webhooks named by SLACK channels.
+func NewSlackSender(
+	configuration *notificationsConfig.ConfigurationStruct,
+	credentials CredentialsProvider,
+	lc logger.LoggingClient) ChannelSender {
+
+	return webhookSender{
+		channelType: Slack,
+		info:        func() notificationsConfig.WebhookInfo { return configuration.Slack },
+		credentials: credentials,
+		format:      formatSlackMessage,
+		lc:          lc,
+	}
+}
+
+// NewTeamsSender creates the sender posting the notifications to the Microsoft Teams incoming webhooks named by TEAMS
+// channels.
+func NewTeamsSender(
+	configuration *notificationsConfig.ConfigurationStruct,
+	credentials CredentialsProvider,
+	lc logger.LoggingClient) ChannelSender {
+
+	return webhookSender{
+		channelType: Teams,
+		info:        func() notificationsConfig.WebhookInfo { return configuration.Teams },
+		credentials: credentials,
+		format:      formatTeamsMessage,
+		lc:          lc,
+	}
+}
+
+func (s webhookSender) Send(n models.Notification, c models.Channel) models.TransmissionRecord {
+	info := s.info()
+	name := webhookName(c.Url)
+
+	webhookUrl, err := s.webhookUrl(name, info)
+	if err != nil {
+		return s.failed(name, err)
+	}
+
+	body, err := s.format(n)
+	if err != nil {
+		return s.failed(name, err)
+	}
+
+	timeout := defaultWebhookTimeout
+	if info.Timeout != "" {
+		if timeout, err = time.ParseDuration(info.Timeout); err != nil {
+			return s.failed(name, fmt.Errorf("invalid timeout '%s'", info.Timeout))
+		}
+	}
+
+	client := http.Client{Timeout: timeout}
+	resp, err := client.Post(webhookUrl, clients.ContentTypeJSON, bytes.NewReader(body))
+	if err != nil {
+		// The url of the webhook holds its token, so it must not end up in the logs or in the transmission record.
+		if urlErr, ok := err.(*url.Error); ok {
+			err = urlErr.Err
+		}
+		return s.failed(name, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	response := "Got response status code: " + resp.Status
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return s.failed(name, errors.New(response))
+	}
+	return newTransmissionRecord(response, models.Sent)
+}
+
+func (s webhookSender) failed(name string, err error) models.TransmissionRecord {
+	s.lc.Error(fmt.Sprintf("Problems sending message to %s webhook '%s', issue: %s", s.channelType, name, err.Error()))
+	return newTransmissionRecord(err.Error(), models.Failed)
+}
+
+// webhookUrl retrieves the url of the named webhook, stored as the password of the secret named after the webhook.
+// When security is disabled the credentials provider returns the password passed along, taken from the configuration.
+func (s webhookSender) webhookUrl(name string, info notificationsConfig.WebhookInfo) (string, error) {
+	if name == "" {
+		return "", errors.New("channel url doesn't name a webhook")
+	}
+
+	credentials, err := s.credentials.GetDatabaseCredentials(bootstrapConfig.Database{
+		Type:     path.Join(info.SecretPath, name),
+		Password: info.Webhooks[name],
+	})
+	if err != nil {
+		return "", fmt.Errorf("unable to retrieve the url of webhook '%s': %v", name, err)
+	}
+	if credentials.Password == "" {
+		return "", fmt.Errorf("no url stored for webhook '%s'", name)
+	}
+	return credentials.Password, nil
+}
+
+// webhookName returns the name of the webhook a channel url such as slack://alerts refers to.
+func webhookName(channelUrl string) string {
+	u, err := url.Parse(channelUrl)
+	if err != nil {
+		return ""
+	}
+	if u.Host != "" {
+		return u.Host
+	}
+	return u.Opaque
+}
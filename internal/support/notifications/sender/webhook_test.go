@@ -0,0 +1,124 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sender
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// secretStore mimics the bootstrap credentials provider with security enabled.
+type secretStore map[string]string
+
+func (s secretStore) GetDatabaseCredentials(database bootstrapConfig.Database) (bootstrapConfig.Credentials, error) {
+	password, ok := s[database.Type]
+	if !ok {
+		return bootstrapConfig.Credentials{}, errors.New("secret not found")
+	}
+	return bootstrapConfig.Credentials{Username: "webhook", Password: password}, nil
+}
+
+// insecureStore mimics the bootstrap credentials provider with security disabled.
+type insecureStore struct{}
+
+func (insecureStore) GetDatabaseCredentials(database bootstrapConfig.Database) (bootstrapConfig.Credentials, error) {
+	return bootstrapConfig.Credentials{Username: database.Username, Password: database.Password}, nil
+}
+
+var testNotification = models.Notification{
+	Slug:        "door-opened",
+	Sender:      "door-sensor",
+	Category:    models.Security,
+	Severity:    models.Critical,
+	Description: "Door <1> opened",
+	Content:     "The door of the server room is open",
+	Labels:      []string{"door", "building-a"},
+}
+
+func newWebhookServer(t *testing.T, status int, received *map[string]interface{}) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.NoError(t, json.Unmarshal(body, received))
+		w.WriteHeader(status)
+	}))
+}
+
+func TestSlackSender(t *testing.T) {
+	var received map[string]interface{}
+	server := newWebhookServer(t, http.StatusOK, &received)
+	defer server.Close()
+
+	configuration := &notificationsConfig.ConfigurationStruct{Slack: notificationsConfig.WebhookInfo{SecretPath: "slack"}}
+	s := NewSlackSender(configuration, secretStore{"slack/alerts": server.URL}, logger.NewMockClient())
+
+	tr := s.Send(testNotification, models.Channel{Type: models.Rest, Url: "slack://alerts"})
+	assert.Equal(t, models.TransmissionStatus(models.Sent), tr.Status)
+	assert.Equal(t, "*[CRITICAL] SECURITY: Door &lt;1&gt; opened*", received["text"])
+	attachment := received["attachments"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "#"+criticalColor, attachment["color"])
+	assert.Equal(t, testNotification.Content, attachment["text"])
+}
+
+func TestTeamsSender(t *testing.T) {
+	var received map[string]interface{}
+	server := newWebhookServer(t, http.StatusOK, &received)
+	defer server.Close()
+
+	configuration := &notificationsConfig.ConfigurationStruct{
+		Teams: notificationsConfig.WebhookInfo{SecretPath: "teams", Webhooks: map[string]string{"operations": server.URL}},
+	}
+	s := NewTeamsSender(configuration, insecureStore{}, logger.NewMockClient())
+
+	tr := s.Send(testNotification, models.Channel{Type: models.Rest, Url: "teams://operations"})
+	assert.Equal(t, models.TransmissionStatus(models.Sent), tr.Status)
+	assert.Equal(t, "MessageCard", received["@type"])
+	assert.Equal(t, criticalColor, received["themeColor"])
+	assert.Equal(t, "[CRITICAL] SECURITY: Door <1> opened", received["title"])
+	assert.Equal(t, testNotification.Content, received["text"])
+}
+
+func TestWebhookSenderFailures(t *testing.T) {
+	var received map[string]interface{}
+	server := newWebhookServer(t, http.StatusForbidden, &received)
+	defer server.Close()
+
+	configuration := &notificationsConfig.ConfigurationStruct{Slack: notificationsConfig.WebhookInfo{SecretPath: "slack"}}
+	s := NewSlackSender(configuration, secretStore{"slack/alerts": server.URL}, logger.NewMockClient())
+
+	tests := []struct {
+		name    string
+		url     string
+		timeout string
+	}{
+		{"rejected by webhook", "slack://alerts", ""},
+		{"unknown webhook", "slack://unknown", ""},
+		{"no webhook name", "slack://", ""},
+		{"invalid timeout", "slack://alerts", "soon"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configuration.Slack.Timeout = tt.timeout
+			tr := s.Send(testNotification, models.Channel{Type: models.Rest, Url: tt.url})
+			assert.Equal(t, models.TransmissionStatus(models.Failed), tr.Status)
+			assert.NotContains(t, tr.Response, server.URL)
+		})
+	}
+}
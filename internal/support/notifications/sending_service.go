@@ -16,20 +16,16 @@
 package notifications
 
 import (
-	"bytes"
-	"crypto/tls"
-	"errors"
-	"fmt"
-	"net"
-	"net/http"
-	mail "net/smtp"
+	"math/rand"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+
 	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/sender"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
 	"github.com/edgexfoundry/go-mod-core-contracts/models"
@@ -39,50 +35,77 @@ func sendViaChannel(
 	n models.Notification,
 	c models.Channel,
 	receiver string,
+	subscription string,
+	policy notificationsModels.RetryPolicy,
 	lc logger.LoggingClient,
 	dbClient interfaces.DBClient,
-	config notificationsConfig.ConfigurationStruct) {
+	senders *sender.Registry,
+	config notificationsConfig.ConfigurationStruct) (models.Transmission, error) {
 
 	lc.Debug("Sending notification: " + n.Slug + ", via channel: " + c.String())
-	var tr models.TransmissionRecord
-	if c.Type == models.ChannelType(models.Email) {
-		tr = sendMail(n.Content, c.MailAddresses, n.ContentType, lc, config.Smtp)
-	} else {
-		tr = restSend(n.Content, c.Url, n.ContentType, lc)
-	}
+	tr, receipt := senders.SendWithReceipt(n, c, subscription)
 	t, err := persistTransmission(tr, n, c, receiver, lc, dbClient)
 	if err == nil {
-		handleFailedTransmission(t, lc, dbClient, config)
+		persistHistoryRecord(t, tr, lc, dbClient, config)
+		persistReceipt(t, receipt, lc, dbClient)
+		handleFailedTransmission(t, subscription, policy, lc, dbClient, senders, config)
 	}
+	return t, err
 }
 
 func resendViaChannel(
 	t models.Transmission,
+	subscription string,
+	policy notificationsModels.RetryPolicy,
 	lc logger.LoggingClient,
 	dbClient interfaces.DBClient,
+	senders *sender.Registry,
 	config notificationsConfig.ConfigurationStruct) {
 
-	var tr models.TransmissionRecord
-	if t.Channel.Type == models.ChannelType(models.Email) {
-		tr = sendMail(t.Notification.Content, t.Channel.MailAddresses, t.Notification.ContentType, lc, config.Smtp)
-	} else {
-		tr = restSend(t.Notification.Content, t.Channel.Url, t.Notification.ContentType, lc)
-	}
+	tr, receipt := senders.SendWithReceipt(t.Notification, t.Channel, subscription)
 	t.ResendCount = t.ResendCount + 1
 	t.Status = tr.Status
-	t.Records = append(t.Records, tr)
+	t.Records = notificationsModels.PruneRecords(append(t.Records, tr), config.Records.KeepLatest)
 	err := dbClient.UpdateTransmission(t)
 	if err == nil {
-		handleFailedTransmission(t, lc, dbClient, config)
+		persistHistoryRecord(t, tr, lc, dbClient, config)
+		persistReceipt(t, receipt, lc, dbClient)
+		handleFailedTransmission(t, subscription, policy, lc, dbClient, senders, config)
 	}
 }
 
-func getTransmissionRecord(msg string, st models.TransmissionStatus) models.TransmissionRecord {
-	tr := models.TransmissionRecord{}
-	tr.Sent = db.MakeTimestamp()
-	tr.Status = st
-	tr.Response = msg
-	return tr
+// persistHistoryRecord appends the record to the full history of the transmission, kept aside from its pruned records,
+// when the histories are enabled.
+func persistHistoryRecord(
+	t models.Transmission,
+	tr models.TransmissionRecord,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	config notificationsConfig.ConfigurationStruct) {
+
+	if !config.Records.History {
+		return
+	}
+	if err := dbClient.AddTransmissionHistoryRecord(t.ID, tr); err != nil {
+		lc.Error("History record of transmission " + t.ID + " cannot be persisted: " + err.Error())
+	}
+}
+
+// persistReceipt stores the delivery receipt returned by the target of the transmission, if any, with the
+// transmission. The receipt is stored as received, its signature being checked when it is verified.
+func persistReceipt(
+	t models.Transmission,
+	receipt *notificationsModels.DeliveryReceipt,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if receipt == nil {
+		return
+	}
+	receipt.Transmission = t.ID
+	if _, err := dbClient.AddDeliveryReceipt(*receipt); err != nil {
+		lc.Error("Delivery receipt of transmission " + t.ID + " cannot be persisted: " + err.Error())
+	}
 }
 
 func persistTransmission(
@@ -110,185 +133,76 @@ func persistTransmission(
 	return trx, nil
 }
 
-func sendMail(
-	message string,
-	addressees []string,
-	contentType string,
-	lc logger.LoggingClient,
-	smtp notificationsConfig.SmtpInfo) models.TransmissionRecord {
-
-	tr := getTransmissionRecord("SMTP server received", models.Sent)
-
-	smtpMessage := buildSmtpMessage(smtp.Sender, smtp.Subject, addressees, contentType, message)
-
-	err := smtpSend(addressees, smtpMessage, smtp)
-	if err != nil {
-		lc.Error("Problems sending message to: " + strings.Join(addressees, ",") + ", issue: " + err.Error())
-		tr.Status = models.Failed
-		tr.Response = err.Error()
-		return tr
-	}
-	return tr
-}
-
-func buildSmtpMessage(sender string, subject string, toAddresses []string, contentType string, message string) []byte {
-	smtpNewline := "\r\n"
-
-	// required CRLF at ends of lines and CRLF between header and body for SMTP RFC 822 style email
-	buf := bytes.NewBufferString("Subject: " + subject + smtpNewline)
-
-	buf.WriteString("From: " + sender + smtpNewline)
-
-	buf.WriteString("To: " + strings.Join(toAddresses, ",") + smtpNewline)
-
-	// only add MIME header if notification content type was set
-	// maybe provide charset overrides as well?
-	if contentType != "" {
-		buf.WriteString(fmt.Sprintf("MIME-version: 1.0;\r\nContent-Type: %s; charset=\"UTF-8\";\r\n", contentType))
-	}
-
-	buf.WriteString(smtpNewline)
-
-	//maximum line size is 1000
-	//split on newline first then break further as needed
-	for _, line := range strings.Split(message, smtpNewline) {
-		ln := 998
-		idx := 0
-		for len(line) > idx+ln {
-			buf.WriteString(line[idx:idx+ln] + smtpNewline)
-			idx += ln
-		}
-		buf.WriteString(line[idx:] + smtpNewline)
-	}
-
-	return []byte(buf.String())
-}
-
-func restSend(message string, url string, contentType string, lc logger.LoggingClient) models.TransmissionRecord {
-	tr := getTransmissionRecord("", models.Sent)
-
-	if contentType == "" {
-		contentType = "text/plain"
-	}
-
-	rs, err := http.Post(url, contentType, bytes.NewBuffer([]byte(message)))
-	if err != nil {
-		lc.Error("Problems sending message to: " + url)
-		lc.Error("Error indication was:  " + err.Error())
-		tr.Status = models.Failed
-		tr.Response = err.Error()
-		return tr
-	}
-	tr.Response = "Got response status code: " + rs.Status
-	return tr
-}
-
 func handleFailedTransmission(
 	t models.Transmission,
+	subscription string,
+	policy notificationsModels.RetryPolicy,
 	lc logger.LoggingClient,
 	dbClient interfaces.DBClient,
+	senders *sender.Registry,
 	config notificationsConfig.ConfigurationStruct) {
 
 	n := t.Notification
-	if t.ResendCount >= config.Writable.ResendLimit {
-		lc.Error("Too many transmission resend attempts!  Giving up on transmission: " + t.ID + ", for notification: " + n.Slug)
+	if t.Status != models.Failed || n.Status == models.Escalated || !policy.Retries(n) {
+		return
 	}
-	if t.Status == models.Failed && n.Status != models.Escalated {
-		lc.Debug("Handling failed transmission for: " + t.ID + " for notification: " + t.Notification.Slug + ", resends so far: " + strconv.Itoa(t.ResendCount))
-		if n.Severity == models.Critical {
-			if t.ResendCount < config.Writable.ResendLimit {
-				time.AfterFunc(time.Second*5, func() {
-					criticalSeverityResend(t, lc, dbClient, config)
-				})
-			} else {
-				escalate(t, lc, dbClient, config)
-				t.Status = models.Trxescalated
-				dbClient.UpdateTransmission(t)
-			}
-		}
+	lc.Debug("Handling failed transmission for: " + t.ID + " for notification: " + n.Slug + ", resends so far: " + strconv.Itoa(t.ResendCount))
+	if t.ResendCount < policy.MaxAttempts {
+		scheduled := db.MakeTimestamp()
+		time.AfterFunc(policy.Delay(t.ResendCount, rand.Float64()), func() {
+			resend(t, subscription, scheduled, lc, dbClient, senders, config)
+		})
+		return
 	}
-}
 
-func deduceAuth(s notificationsConfig.SmtpInfo) (mail.Auth, error) {
-	if s.CheckUsername() == "" && s.Password == "" {
-		return nil, errors.New("Notifications: Expecting username")
-	}
-	if s.CheckUsername() != "" && s.Password == "" {
-		return nil, nil
-	}
-	if s.CheckUsername() == "" && s.Password != "" {
-		return nil, errors.New("Notifications: Expecting username")
+	lc.Error("Too many transmission resend attempts!  Giving up on transmission: " + t.ID + ", for notification: " + n.Slug)
+	if n.Severity == models.Critical {
+		escalate(t, lc, dbClient, senders, config)
+		t.Status = models.Trxescalated
+		dbClient.UpdateTransmission(t)
+		expediteEscalation(t, lc, dbClient)
 	}
-	return mail.PlainAuth("", s.CheckUsername(), s.Password, s.Host), nil
 }
 
-// The function smtpSend replicates the functionality provided by the SendMail function
-// from smtp package. A rivision of standard function was needed because smtp.SendMail
-// does not allow for set-reset of InsecureSkipVerify flag of tls.Config structure. This
-// flag is needed to be manipulated for allowing the self-signed certificates.
-//
-// As it is replicating the functionality from smtp.SendMail, it borrows heavily from the
-// original function in its design and implementation. This version adds new functionality
-// for handling the SmtpInfo configuration and authentication management, along with the
-// requirement of ability to set-reset the InsecureSkipVerify flag.
-//
-// This is using a lot of unexported methods and types from smtp package through exported
-// interfaces, which makes it a little bit trickier to modify. Since, the intention for
-// this function is to use it as a support function for handling the low level SMTP
-// protocol mechanism, it is not exported.
-func smtpSend(to []string, msg []byte, s notificationsConfig.SmtpInfo) error {
-	addr := s.Host + ":" + strconv.Itoa(s.Port)
-	auth, err := deduceAuth(s)
-	if err != nil {
-		return err
-	}
-	c, err := mail.Dial(addr)
-	if err != nil {
-		return errors.New("Notifications: Error dialing address")
-	}
-	defer c.Close()
-	serverName, _, err := net.SplitHostPort(addr)
-	if err != nil {
-		return err
-	}
-	if err = c.Hello(addr); err != nil {
-		return err
-	}
-	if ok, _ := c.Extension("STARTTLS"); ok {
-		config := &tls.Config{ServerName: serverName}
-		config.InsecureSkipVerify = s.EnableSelfSignedCert
-		if err = c.StartTLS(config); err != nil {
-			return err
-		}
-	}
-	if auth != nil {
-		if ok, _ := c.Extension("AUTH"); !ok {
-			return errors.New("Notifications: server doesn't support AUTH")
-		}
-		err = c.Auth(auth)
-		if err != nil {
-			return err
-		}
+// retryPolicy returns the retry policy of the subscription, or the default one configured when it has none.
+func retryPolicy(
+	slug string,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	config notificationsConfig.ConfigurationStruct) notificationsModels.RetryPolicy {
+
+	p, err := dbClient.GetRetryPolicyBySlug(slug)
+	if err == nil {
+		return p
 	}
-	if err = c.Mail(s.Sender); err != nil {
-		return err
+	if err != db.ErrNotFound {
+		lc.Error("Unable to get the retry policy of subscription " + slug + ", using the default one: " + err.Error())
 	}
-	for _, addr := range to {
-		if err = c.Rcpt(addr); err != nil {
-			return err
+	return defaultRetryPolicy(lc, config)
+}
+
+// defaultRetryPolicy returns the retry policy configured for the subscriptions without one of their own, with the
+// resend limit and interval of the writable configuration, which may be changed at runtime, when they are set.
+func defaultRetryPolicy(
+	lc logger.LoggingClient,
+	config notificationsConfig.ConfigurationStruct) notificationsModels.RetryPolicy {
+
+	p := notificationsModels.RetryPolicy{
+		MaxAttempts:       config.Retry.MaxAttempts,
+		InitialInterval:   config.Retry.InitialInterval,
+		BackoffMultiplier: config.Retry.BackoffMultiplier,
+		MaxInterval:       config.Retry.MaxInterval,
+		Jitter:            config.Retry.Jitter,
+	}
+	if config.Writable.ResendLimit > 0 {
+		p.MaxAttempts = config.Writable.ResendLimit
+	}
+	if config.Writable.ResendInterval != "" {
+		if interval, err := time.ParseDuration(config.Writable.ResendInterval); err != nil || interval <= 0 {
+			lc.Error("Invalid resend interval '" + config.Writable.ResendInterval + "', using the retry initial interval")
+		} else {
+			p.InitialInterval = config.Writable.ResendInterval
 		}
 	}
-	w, err := c.Data()
-	if err != nil {
-		return err
-	}
-	_, err = w.Write(msg)
-	if err != nil {
-		return err
-	}
-	err = w.Close()
-	if err != nil {
-		return err
-	}
-	return c.Quit()
+	return p
 }
@@ -0,0 +1,57 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	notificationsContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/suppressor"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+)
+
+// suppressionCheckInterval is how often the elapsed suppression windows are looked for.
+const suppressionCheckInterval = time.Second
+
+// monitorSuppression ends the elapsed windows of the suppressor until ctx is done and posts the summary of the
+// duplicates collapsed during each of them.
+func monitorSuppression(ctx context.Context, s *suppressor.Suppressor, dic *di.Container) {
+	ticker := time.NewTicker(suppressionCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, n := range s.Expire(db.MakeTimestamp()) {
+				postNotification(
+					n,
+					bootstrapContainer.LoggingClientFrom(dic.Get),
+					notificationsContainer.DBClientFrom(dic.Get),
+					notificationsContainer.ChannelSendersFrom(dic.Get),
+					notificationsContainer.FilterCacheFrom(dic.Get),
+					*notificationsContainer.ConfigurationFrom(dic.Get))
+			}
+		}
+	}
+}
+
+func restGetSuppressedNotifications(w http.ResponseWriter, lc logger.LoggingClient, s *suppressor.Suppressor) {
+	if s == nil {
+		http.Error(w, "notification suppression is disabled", http.StatusServiceUnavailable)
+		return
+	}
+	pkg.Encode(s.Active(), w, lc)
+}
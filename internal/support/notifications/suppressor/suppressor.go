@@ -0,0 +1,153 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package suppressor collapses the identical notifications, of the same category, labels and content, arriving within
+// a window, so that an event storm reaches the channels as the first notification followed by a single summary
+// counting the occurrences, instead of one send per occurrence.
+package suppressor
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// SummaryPrefix prefixes the slug of the summary notifications.
+const SummaryPrefix = "repeated-"
+
+// Occurrences describes the notifications collapsed into the first one of their kind during its window.
+type Occurrences struct {
+	Slug     string                       `json:"slug"`
+	Category models.NotificationsCategory `json:"category"`
+	Labels   []string                     `json:"labels,omitempty"`
+	Hash     string                       `json:"hash"`
+	Count    int                          `json:"count"`
+	First    int64                        `json:"first"`
+	Last     int64                        `json:"last"`
+
+	notification models.Notification
+}
+
+// Suppressor tracks the notifications admitted for distribution and the duplicates collapsed into them.
+type Suppressor struct {
+	window      time.Duration
+	mutex       sync.Mutex
+	occurrences map[string]*Occurrences
+}
+
+// NewSuppressor creates a Suppressor collapsing the duplicates arriving within window of the first notification of
+// their kind.
+func NewSuppressor(window time.Duration) *Suppressor {
+	return &Suppressor{window: window, occurrences: make(map[string]*Occurrences)}
+}
+
+// Window returns how long the duplicates of a notification are collapsed after it.
+func (s *Suppressor) Window() time.Duration {
+	return s.window
+}
+
+// Hash identifies the notifications of the same category, labels and content, regardless of the order of the labels.
+func Hash(n models.Notification) string {
+	labels := append([]string(nil), n.Labels...)
+	sort.Strings(labels)
+
+	h := sha256.New()
+	h.Write([]byte(n.Category))
+	for _, label := range labels {
+		h.Write([]byte{0})
+		h.Write([]byte(label))
+	}
+	h.Write([]byte{0, 0})
+	h.Write([]byte(n.Content))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Admit reports whether the notification, received at now in milliseconds, is to be distributed, i.e. it is the first
+// of its kind within the window. Otherwise it is counted as an occurrence of the first one. A nil Suppressor admits
+// every notification.
+func (s *Suppressor) Admit(n models.Notification, now int64) bool {
+	if s == nil {
+		return true
+	}
+
+	hash := Hash(n)
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	o, ok := s.occurrences[hash]
+	if ok && now < o.First+s.window.Milliseconds() {
+		o.Count++
+		o.Last = now
+		return false
+	}
+	s.occurrences[hash] = &Occurrences{
+		Slug:         n.Slug,
+		Category:     n.Category,
+		Labels:       n.Labels,
+		Hash:         hash,
+		Count:        1,
+		First:        now,
+		Last:         now,
+		notification: n,
+	}
+	return true
+}
+
+// Expire ends the windows elapsed at now, in milliseconds, and returns a summary notification for each of them which
+// collapsed duplicates.
+func (s *Suppressor) Expire(now int64) []models.Notification {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var summaries []models.Notification
+	for hash, o := range s.occurrences {
+		if now < o.First+s.window.Milliseconds() {
+			continue
+		}
+		delete(s.occurrences, hash)
+		if o.Count > 1 {
+			summaries = append(summaries, s.newSummary(*o, now))
+		}
+	}
+	return summaries
+}
+
+// Active returns the windows in progress, sorted by start.
+func (s *Suppressor) Active() []Occurrences {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	result := make([]Occurrences, 0, len(s.occurrences))
+	for _, o := range s.occurrences {
+		result = append(result, *o)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].First != result[j].First {
+			return result[i].First < result[j].First
+		}
+		return result[i].Slug < result[j].Slug
+	})
+	return result
+}
+
+func (s *Suppressor) newSummary(o Occurrences, now int64) models.Notification {
+	n := o.notification
+	content := fmt.Sprintf("Notification %s occurred %d times within %s: %s", o.Slug, o.Count, s.window, n.Content)
+	return models.Notification{
+		Slug:        fmt.Sprintf("%s%s-%d", SummaryPrefix, o.Slug, now),
+		Sender:      n.Sender,
+		Category:    n.Category,
+		Severity:    n.Severity,
+		Content:     content,
+		Description: fmt.Sprintf("%d occurrences of notification %s collapsed", o.Count, o.Slug),
+		Labels:      n.Labels,
+		ContentType: "text/plain",
+	}
+}
@@ -0,0 +1,87 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package suppressor
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testNotification = models.Notification{
+	Slug:     "disk-full",
+	Sender:   "system-management",
+	Category: models.Hwhealth,
+	Severity: models.Critical,
+	Content:  "disk /dev/sda1 is full",
+	Labels:   []string{"disk", "gateway-1"},
+}
+
+func TestAdmitCollapsesDuplicatesWithinWindow(t *testing.T) {
+	s := NewSuppressor(time.Minute)
+	assert.True(t, s.Admit(testNotification, 0))
+
+	duplicate := testNotification
+	duplicate.Slug = "disk-full-2"
+	assert.False(t, s.Admit(duplicate, 1000))
+	assert.False(t, s.Admit(duplicate, 2000))
+
+	other := testNotification
+	other.Content = "disk /dev/sdb1 is full"
+	assert.True(t, s.Admit(other, 3000), "notifications of other content are distributed")
+
+	active := s.Active()
+	require.Len(t, active, 2)
+	assert.Equal(t, "disk-full", active[0].Slug)
+	assert.Equal(t, 3, active[0].Count)
+	assert.Equal(t, int64(2000), active[0].Last)
+
+	assert.True(t, s.Admit(testNotification, time.Minute.Milliseconds()), "duplicates after the window are distributed")
+}
+
+func TestExpireSummarizesDuplicates(t *testing.T) {
+	s := NewSuppressor(time.Minute)
+	s.Admit(testNotification, 0)
+	s.Admit(testNotification, 1000)
+	s.Admit(testNotification, 2000)
+
+	single := testNotification
+	single.Content = "disk /dev/sdb1 is full"
+	s.Admit(single, 0)
+
+	assert.Empty(t, s.Expire(time.Minute.Milliseconds()-1), "windows in progress aren't expired")
+
+	summaries := s.Expire(time.Minute.Milliseconds())
+	require.Len(t, summaries, 1, "windows without duplicates aren't summarized")
+	summary := summaries[0]
+	assert.True(t, strings.HasPrefix(summary.Slug, SummaryPrefix+"disk-full-"))
+	assert.Contains(t, summary.Content, "3 times")
+	assert.Equal(t, testNotification.Category, summary.Category)
+	assert.Equal(t, testNotification.Severity, summary.Severity)
+	assert.Equal(t, testNotification.Labels, summary.Labels)
+	assert.Empty(t, s.Active())
+}
+
+func TestHashIgnoresLabelOrder(t *testing.T) {
+	reordered := testNotification
+	reordered.Labels = []string{"gateway-1", "disk"}
+	assert.Equal(t, Hash(testNotification), Hash(reordered))
+
+	relabeled := testNotification
+	relabeled.Labels = []string{"disk"}
+	assert.NotEqual(t, Hash(testNotification), Hash(relabeled))
+}
+
+func TestNilSuppressorAdmitsEveryNotification(t *testing.T) {
+	var s *Suppressor
+	assert.True(t, s.Admit(testNotification, 0))
+	assert.True(t, s.Admit(testNotification, 0))
+}
@@ -0,0 +1,68 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+)
+
+// CredentialsProvider retrieves the username and password stored under a path of the secret store, as the bootstrap
+// credentials provider does for the databases.
+type CredentialsProvider interface {
+	GetDatabaseCredentials(database bootstrapConfig.Database) (bootstrapConfig.Credentials, error)
+}
+
+// HeaderSecrets injects the headers of the REST interval actions backed by the secrets of the secret store.
+type HeaderSecrets struct {
+	info        func() config.AuthHeadersInfo
+	credentials CredentialsProvider
+}
+
+// NewHeaderSecrets returns the HeaderSecrets reading the secrets from the secret store, or from the configuration when
+// security is disabled.
+func NewHeaderSecrets(configuration *config.ConfigurationStruct, credentials CredentialsProvider) *HeaderSecrets {
+	return &HeaderSecrets{
+		info:        func() config.AuthHeadersInfo { return configuration.AuthHeaders },
+		credentials: credentials,
+	}
+}
+
+// Inject sets the headers on the request, with the values built from their secrets. The request isn't sent when one of
+// them can't be read.
+func (s *HeaderSecrets) Inject(req *http.Request, headers []schedulerModels.AuthHeader) error {
+	if len(headers) == 0 {
+		return nil
+	}
+	if s == nil {
+		return fmt.Errorf("no secret store to read the headers %s from", headers[0].Name())
+	}
+
+	secrets := s.info().Secrets
+	for _, h := range headers {
+		// When security is disabled the credentials provider returns the credentials passed along, taken from the
+		// configuration.
+		fallback := secrets[h.SecretPath]
+		credentials, err := s.credentials.GetDatabaseCredentials(bootstrapConfig.Database{
+			Type:     h.SecretPath,
+			Username: fallback.Username,
+			Password: fallback.Password,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to retrieve the secret '%s' of the header %s: %v", h.SecretPath, h.Name(), err)
+		}
+		if credentials.Password == "" {
+			return fmt.Errorf("no secret stored under '%s' for the header %s", h.SecretPath, h.Name())
+		}
+		req.Header.Set(h.Name(), h.Value(credentials.Username, credentials.Password))
+	}
+	return nil
+}
@@ -17,19 +17,38 @@ package config
 import (
 	"fmt"
 
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/gateway"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/readygate"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/selftest"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/sysevent"
+
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
 )
 
 // Configuration V2 for the Support Scheduler Service
 type ConfigurationStruct struct {
-	Writable        WritableInfo
-	Clients         map[string]bootstrapConfig.ClientInfo
-	Databases       map[string]bootstrapConfig.Database
-	Registry        bootstrapConfig.RegistryInfo
-	Service         bootstrapConfig.ServiceInfo
-	Intervals       map[string]IntervalInfo
-	IntervalActions map[string]IntervalActionInfo
-	SecretStore     bootstrapConfig.SecretStoreInfo
+	Writable         WritableInfo
+	Clients          map[string]bootstrapConfig.ClientInfo
+	Databases        map[string]bootstrapConfig.Database
+	DatabaseDecoding db.DecodingInfo
+	Registry         bootstrapConfig.RegistryInfo
+	Service          bootstrapConfig.ServiceInfo
+	Intervals        map[string]IntervalInfo
+	IntervalActions  map[string]IntervalActionInfo
+	ExecutionHistory ExecutionHistoryInfo
+	Misfire          MisfireInfo
+	LeaderElection   LeaderElectionInfo
+	AuthHeaders      AuthHeadersInfo
+	MessageQueue     MessageQueueInfo
+	SecretStore      bootstrapConfig.SecretStoreInfo
+	Gateway          gateway.GatewayInfo
+	SelfTest         selftest.SelfTestInfo
+	SystemEvents     sysevent.SystemEventsInfo
+	ReadyGate        readygate.ReadyGateInfo
+
+	// resolveWritable resolves the secret references of the writable configuration received from the registry
+	resolveWritable func(writable interface{}) bool
 }
 
 type WritableInfo struct {
@@ -46,11 +65,21 @@ type IntervalInfo struct {
 	End string
 	// Periodicity of the schedule
 	Frequency string
-	// Cron style regular expression indicating how often the action under schedule should occur.
+	// Cron expression indicating when the action under schedule should occur, either of 5 fields, from the minute to
+	// the day of the week, or of 6 fields starting with the second. It takes precedence over the frequency.
 	// Use either runOnce, frequency or cron and not all.
 	Cron string
+	// IANA name of the timezone in which the start, the end and the cron expression are evaluated, e.g. 'Europe/Paris',
+	// UTC when empty. Frequencies of whole days keep their time of day across daylight saving time changes.
+	Timezone string
+	// MisfirePolicy decides what becomes of the runs missed while the scheduler was down: skip them, the default,
+	// runOnceNow to run the interval once on startup or runAllMissed to run it as many times as runs were missed.
+	MisfirePolicy string
 	// Boolean indicating that this schedules runs one time - at the time indicated by the start
 	RunOnce bool
+	// MaxIterations is the number of times the interval runs before it is disabled, 0 for no limit. The runs left are
+	// persisted so that restarting the scheduler doesn't reset the count.
+	MaxIterations int64
 }
 
 type IntervalActionInfo struct {
@@ -58,8 +87,11 @@ type IntervalActionInfo struct {
 	Host string
 	// Port defines the port on which to access a given service
 	Port int
-	// Protocol indicates the protocol to use when accessing a given service
+	// Protocol indicates the protocol to use when accessing a given service, or MESSAGEBUS for an action publishing its
+	// parameters to the topic on the message bus
 	Protocol string
+	// Topic the parameters of a MESSAGEBUS action are published to
+	Topic string
 	// Action name
 	Name string
 	// Action http method *const prob*
@@ -72,6 +104,94 @@ type IntervalActionInfo struct {
 	Path string
 	// Associated Schedule for the Event
 	Interval string
+	// Timeout of every request of the action, e.g. '30s', the service timeout applying when empty
+	Timeout string
+	// Retries is the number of times a failed execution of the action is retried
+	Retries int
+	// RetryBackoff is the delay before the first retry, doubled for every following one, e.g. '10s'
+	RetryBackoff string
+	// Jitter is the duration up to which the execution of the action is randomly delayed, e.g. '1m'
+	Jitter string
+	// RunAfter names the actions of the same interval after which the action is executed within a run of the interval,
+	// the action being skipped when one of them fails
+	RunAfter []string
+	// AuthHeaders are injected from the secret store into the requests of the action
+	AuthHeaders []AuthHeaderInfo
+}
+
+// AuthHeaderInfo references the secret injected as a header of the requests of a REST interval action.
+type AuthHeaderInfo struct {
+	// SecretPath is the path of the secret in the secret store
+	SecretPath string
+	// Header is the name of the header, 'Authorization' when empty
+	Header string
+	// Scheme prefixes the password of the secret, e.g. 'Bearer', while 'Basic' sends its username and password
+	Scheme string
+}
+
+// ExecutionHistoryInfo configures the history of the executions of the interval actions.
+type ExecutionHistoryInfo struct {
+	Enabled bool
+	// MaxAge is how long the executions of an interval action are kept, e.g. '168h'. They are kept until the interval
+	// action is deleted when empty.
+	MaxAge string
+	// MaxPerAction is the number of the latest executions kept per interval action, all of them when 0.
+	MaxPerAction int
+}
+
+// MisfireInfo configures the catch-up of the runs of the intervals missed while the scheduler was down.
+type MisfireInfo struct {
+	// MaxCatchUpRuns is the number of missed runs of an interval caught up on startup, at most.
+	MaxCatchUpRuns int64
+}
+
+// LeaderElectionInfo configures the election of the leader among the scheduler instances sharing the database, for
+// high availability. Only the leader executes the interval actions, a standby taking over once the lease of the leader
+// expires.
+type LeaderElectionInfo struct {
+	// Enabled indicates whether the instance executes the interval actions only while it is the leader.
+	Enabled bool
+	// InstanceId identifies the instance among the scheduler instances, a unique id being generated on startup when
+	// empty.
+	InstanceId string
+	// LeaseTTL is how long the lease of the leader lasts unless renewed, e.g. '15s'.
+	LeaseTTL string
+	// RenewInterval is how often the leader renews its lease and the standbys try to acquire it, e.g. '5s'. It must be
+	// shorter than the lease TTL.
+	RenewInterval string
+}
+
+// AuthHeadersInfo configures the secrets injected as headers of the requests of the REST interval actions.
+type AuthHeadersInfo struct {
+	// Secrets holds the username and password of the secrets by secret path, used instead of the secret store when
+	// security is disabled.
+	Secrets map[string]bootstrapConfig.Credentials
+}
+
+// MessageQueueInfo provides parameters related to publishing the payloads of the MESSAGEBUS interval actions.
+type MessageQueueInfo struct {
+	// Enabled indicates whether the scheduler connects to the message bus.
+	Enabled bool
+	// Host is the hostname or IP address of the broker, if applicable.
+	Host string
+	// Port defines the port on which to access the message queue.
+	Port int
+	// Protocol indicates the protocol to use when accessing the message queue.
+	Protocol string
+	// Indicates the message queue platform being used.
+	Type string
+	// SecretPath is the secret store path of the credentials of the broker, stored as username and password, which
+	// are used instead of the Username and Password of Optional when set and security is enabled.
+	SecretPath string
+	// Provides additional configuration properties which do not fit within the existing field.
+	// Typically the key is the name of the configuration property and the value is a string representation of the
+	// desired value for the configuration property.
+	Optional map[string]string
+}
+
+// URL constructs a URL from the protocol, host and port and returns that as a string.
+func (m MessageQueueInfo) URL() string {
+	return fmt.Sprintf("%s://%s:%v", m.Protocol, m.Host, m.Port)
 }
 
 // URI constructs a URI from the protocol, host and port and returns that as a string.
@@ -100,15 +220,22 @@ func (c *ConfigurationStruct) EmptyWritablePtr() interface{} {
 }
 
 // UpdateWritableFromRaw converts configuration received from the registry to a service-specific WritableInfo struct
-// which is then used to overwrite the service's existing configuration's WritableInfo struct.
+// which is then used to overwrite the service's existing configuration's WritableInfo struct, once its secret
+// references are resolved.
 func (c *ConfigurationStruct) UpdateWritableFromRaw(rawWritable interface{}) bool {
 	writable, ok := rawWritable.(*WritableInfo)
-	if ok {
+	if ok && (c.resolveWritable == nil || c.resolveWritable(writable)) {
 		c.Writable = *writable
 	}
 	return ok
 }
 
+// SetWritableResolver sets the function resolving the secret references of the writable configuration received from
+// the registry, which is only applied when they are.
+func (c *ConfigurationStruct) SetWritableResolver(resolve func(writable interface{}) bool) {
+	c.resolveWritable = resolve
+}
+
 // GetBootstrap returns the configuration elements required by the bootstrap.  Currently, a copy of the configuration
 // data is returned.  This is intended to be temporary -- since ConfigurationStruct drives the configuration.toml's
 // structure -- until we can make backwards-breaking configuration.toml changes (which would consolidate these fields
@@ -137,3 +264,8 @@ func (c *ConfigurationStruct) GetRegistryInfo() bootstrapConfig.RegistryInfo {
 func (c *ConfigurationStruct) GetDatabaseInfo() map[string]bootstrapConfig.Database {
 	return c.Databases
 }
+
+// GetDatabaseDecodePolicy returns the decode policy of the objects read from the database.
+func (c *ConfigurationStruct) GetDatabaseDecodePolicy() string {
+	return c.DatabaseDecoding.Policy
+}
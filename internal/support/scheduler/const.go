@@ -31,6 +31,10 @@ const (
 	TIMELAYOUT     = "20060102T150405"
 	SCRUB          = "scrub"
 	TARGET         = "target"
+	EXPORT         = "export"
+	IMPORT         = "import"
+	STATUS         = "status"
+	EFFECTIVE      = "effective"
 
 	/* ---------------- URL PARAM NAMES -----------------------*/
 	ContentTypeKey       = "Content-Type"
@@ -80,6 +80,18 @@ func NewErrIntervalActionTargetNameRequired(id string) error {
 	return ErrIntervalActionTargetNameRequired{id: id}
 }
 
+type ErrIntervalActionTopicRequired struct {
+	name string
+}
+
+func (e ErrIntervalActionTopicRequired) Error() string {
+	return fmt.Sprintf("intervalAction %s publishing to the message bus requires a topic none provided", e.name)
+}
+
+func NewErrIntervalActionTopicRequired(name string) error {
+	return ErrIntervalActionTopicRequired{name: name}
+}
+
 type ErrIntervalActionNameInUse struct {
 	name string
 }
@@ -117,15 +129,110 @@ func NewErrInvalidFrequencyFormat(frequency string) error {
 }
 
 type ErrInvalidCronFormat struct {
-	cron string
+	cron   string
+	reason string
 }
 
 func (e ErrInvalidCronFormat) Error() string {
-	return fmt.Sprintf("invalid cron format for value: %s", e.cron)
+	if e.reason == "" {
+		return fmt.Sprintf("invalid cron format for value: %s", e.cron)
+	}
+	return fmt.Sprintf("invalid cron format for value: %s: %s", e.cron, e.reason)
+}
+
+// NewErrInvalidCronFormat creates the error of an invalid cron expression, along with the reason it was rejected for,
+// if known.
+func NewErrInvalidCronFormat(cron string, reason error) error {
+	e := ErrInvalidCronFormat{cron: cron}
+	if reason != nil {
+		e.reason = reason.Error()
+	}
+	return e
+}
+
+type ErrInvalidTimezone struct {
+	timezone string
+	reason   string
+}
+
+func (e ErrInvalidTimezone) Error() string {
+	return fmt.Sprintf("invalid timezone %s: %s", e.timezone, e.reason)
+}
+
+// NewErrInvalidTimezone creates the error of a timezone which isn't a known IANA zone name.
+func NewErrInvalidTimezone(timezone string, reason error) error {
+	return ErrInvalidTimezone{timezone: timezone, reason: reason.Error()}
+}
+
+type ErrInvalidMisfirePolicy struct {
+	policy string
+}
+
+func (e ErrInvalidMisfirePolicy) Error() string {
+	return fmt.Sprintf("invalid misfire policy %s, expected skip, runOnceNow or runAllMissed", e.policy)
+}
+
+// NewErrInvalidMisfirePolicy creates the error of an unknown misfire policy.
+func NewErrInvalidMisfirePolicy(policy string) error {
+	return ErrInvalidMisfirePolicy{policy: policy}
+}
+
+type ErrInvalidMaxIterations struct {
+	maxIterations int64
+}
+
+func (e ErrInvalidMaxIterations) Error() string {
+	return fmt.Sprintf("invalid max iterations %d, expected 0 for no limit or a positive number of runs", e.maxIterations)
+}
+
+// NewErrInvalidMaxIterations creates the error of a negative maximum number of runs of an interval.
+func NewErrInvalidMaxIterations(maxIterations int64) error {
+	return ErrInvalidMaxIterations{maxIterations: maxIterations}
+}
+
+type ErrInvalidExecutionPolicy struct {
+	name   string
+	reason string
+}
+
+func (e ErrInvalidExecutionPolicy) Error() string {
+	return fmt.Sprintf("invalid execution policy of intervalAction %s: %s", e.name, e.reason)
+}
+
+// NewErrInvalidExecutionPolicy creates the error of an invalid timeout, retries, retry backoff or jitter of an interval
+// action.
+func NewErrInvalidExecutionPolicy(name string, reason string) error {
+	return ErrInvalidExecutionPolicy{name: name, reason: reason}
+}
+
+type ErrInvalidIntervalActionDependencies struct {
+	name   string
+	reason string
+}
+
+func (e ErrInvalidIntervalActionDependencies) Error() string {
+	return fmt.Sprintf("invalid runAfter dependencies of intervalAction %s: %s", e.name, e.reason)
+}
+
+// NewErrInvalidIntervalActionDependencies creates the error of an interval action running after an action which isn't
+// another action of its interval, or after itself through the dependencies of its interval's actions.
+func NewErrInvalidIntervalActionDependencies(name string, reason string) error {
+	return ErrInvalidIntervalActionDependencies{name: name, reason: reason}
+}
+
+type ErrInvalidAuthHeaders struct {
+	name   string
+	reason string
+}
+
+func (e ErrInvalidAuthHeaders) Error() string {
+	return fmt.Sprintf("invalid auth headers of intervalAction %s: %s", e.name, e.reason)
 }
 
-func NewErrInvalidCronFormat(cron string) error {
-	return ErrInvalidCronFormat{cron: cron}
+// NewErrInvalidAuthHeaders creates the error of a header of an interval action referencing no secret, of an invalid
+// header name or scheme, or of a header injected twice.
+func NewErrInvalidAuthHeaders(name string, reason string) error {
+	return ErrInvalidAuthHeaders{name: name, reason: reason}
 }
 
 type ErrDbNotFound struct {
@@ -0,0 +1,61 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/interfaces"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+)
+
+// ExecutionRecorder persists the executions of the interval actions, dropping them once older than the max age or
+// beyond the max number per interval action. A nil recorder records nothing.
+type ExecutionRecorder struct {
+	dbClient     interfaces.DBClient
+	maxAge       time.Duration
+	maxPerAction int
+}
+
+// NewExecutionRecorder returns the recorder configured by the execution history, nil when it is disabled.
+func NewExecutionRecorder(dbClient interfaces.DBClient, info config.ExecutionHistoryInfo) (*ExecutionRecorder, error) {
+	if !info.Enabled {
+		return nil, nil
+	}
+
+	recorder := &ExecutionRecorder{dbClient: dbClient, maxPerAction: info.MaxPerAction}
+	if info.MaxAge != "" {
+		maxAge, err := time.ParseDuration(info.MaxAge)
+		if err != nil || maxAge <= 0 {
+			return nil, fmt.Errorf("invalid execution history max age '%s'", info.MaxAge)
+		}
+		recorder.maxAge = maxAge
+	}
+	if info.MaxPerAction < 0 {
+		return nil, fmt.Errorf("invalid execution history max per action %d", info.MaxPerAction)
+	}
+	return recorder, nil
+}
+
+// Record persists the execution of an interval action. A failure is only logged so that it doesn't affect the
+// schedule.
+func (r *ExecutionRecorder) Record(execution schedulerModels.IntervalActionExecution, lc logger.LoggingClient) {
+	if r == nil {
+		return
+	}
+
+	var expired int64
+	if r.maxAge > 0 {
+		expired = execution.Started - r.maxAge.Milliseconds()
+	}
+	if _, err := r.dbClient.AddIntervalActionExecution(execution, expired, r.maxPerAction); err != nil {
+		lc.Error(fmt.Sprintf("failed to record the execution of the interval action %s: %s", execution.IntervalAction, err.Error()))
+	}
+}
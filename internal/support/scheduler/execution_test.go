@@ -0,0 +1,83 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler
+
+import (
+	goErrors "errors"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/interfaces/mocks"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewExecutionRecorder(t *testing.T) {
+	tests := []struct {
+		name        string
+		info        config.ExecutionHistoryInfo
+		expectNil   bool
+		expectError bool
+	}{
+		{"disabled", config.ExecutionHistoryInfo{MaxAge: "1h"}, true, false},
+		{"enabled", config.ExecutionHistoryInfo{Enabled: true, MaxAge: "168h", MaxPerAction: 1000}, false, false},
+		{"enabled without max age", config.ExecutionHistoryInfo{Enabled: true}, false, false},
+		{"invalid max age", config.ExecutionHistoryInfo{Enabled: true, MaxAge: "a week"}, true, true},
+		{"negative max age", config.ExecutionHistoryInfo{Enabled: true, MaxAge: "-1h"}, true, true},
+		{"negative max per action", config.ExecutionHistoryInfo{Enabled: true, MaxPerAction: -1}, true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder, err := NewExecutionRecorder(&mocks.DBClient{}, tt.info)
+			assert.Equal(t, tt.expectError, err != nil, err)
+			assert.Equal(t, tt.expectNil, recorder == nil)
+		})
+	}
+}
+
+func TestExecutionRecorderRecord(t *testing.T) {
+	execution := schedulerModels.IntervalActionExecution{
+		IntervalAction: "scrub-pushed-events",
+		Interval:       "midnight",
+		Started:        1000000,
+		StatusCode:     202,
+	}
+
+	dbMock := &mocks.DBClient{}
+	dbMock.On("AddIntervalActionExecution", execution, int64(1000000-3600000), 10).Return("id", nil)
+	recorder, err := NewExecutionRecorder(dbMock, config.ExecutionHistoryInfo{Enabled: true, MaxAge: "1h", MaxPerAction: 10})
+	require.NoError(t, err)
+	recorder.Record(execution, logger.NewMockClient())
+	dbMock.AssertExpectations(t)
+
+	// a failure to record is only logged
+	dbMock = &mocks.DBClient{}
+	dbMock.On("AddIntervalActionExecution", execution, int64(0), 0).Return("", goErrors.New("test error"))
+	recorder, err = NewExecutionRecorder(dbMock, config.ExecutionHistoryInfo{Enabled: true})
+	require.NoError(t, err)
+	recorder.Record(execution, logger.NewMockClient())
+	dbMock.AssertExpectations(t)
+
+	// a disabled history records nothing
+	var disabled *ExecutionRecorder
+	disabled.Record(execution, logger.NewMockClient())
+}
+
+func TestResponseSnippet(t *testing.T) {
+	assert.Equal(t, "ok", schedulerModels.ResponseSnippet([]byte("ok")))
+
+	long := strings.Repeat("a", schedulerModels.MaxResponseSnippetLength+10)
+	assert.Len(t, schedulerModels.ResponseSnippet([]byte(long)), schedulerModels.MaxResponseSnippetLength)
+
+	// a multi-byte character across the limit isn't split
+	split := strings.Repeat("a", schedulerModels.MaxResponseSnippetLength-1) + "é"
+	assert.Equal(t, strings.Repeat("a", schedulerModels.MaxResponseSnippetLength-1), schedulerModels.ResponseSnippet([]byte(split)))
+}
@@ -0,0 +1,308 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler
+
+import (
+	"fmt"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/messaging"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// executeIntervalAction executes the interval action of the interval according to its execution policy: after a random
+// delay up to the jitter, the action is attempted until it succeeds or its retries are exhausted, every REST request
+// being bounded by the timeout. The execution recorded is the one of the last attempt, along with the number of
+// attempts, which is returned.
+func executeIntervalAction(
+	intervalName string,
+	intervalAction contract.IntervalAction,
+	policy schedulerModels.ExecutionPolicy,
+	lc logger.LoggingClient,
+	msgClient messaging.MessageClient,
+	recorder *ExecutionRecorder,
+	secrets *HeaderSecrets,
+	configuration *config.ConfigurationStruct) (execution schedulerModels.IntervalActionExecution) {
+
+	defer func() {
+		if err := recover(); err != nil {
+			lc.Error(fmt.Sprintf("interval action %s execution error : %v", intervalAction.Name, err))
+			execution.Error = fmt.Sprintf("execution error : %v", err)
+		}
+	}()
+
+	if delay := policy.JitterDelay(rand.Float64()); delay > 0 {
+		lc.Debug(fmt.Sprintf("the interval action %s is delayed by %s", intervalAction.Name, delay))
+		time.Sleep(delay)
+	}
+
+	timeout := policy.TimeoutDuration(time.Duration(configuration.Service.Timeout) * time.Millisecond)
+	for attempt := 1; ; attempt++ {
+		var retriable bool
+		execution, retriable = attemptIntervalAction(intervalName, intervalAction, timeout, lc, msgClient, secrets)
+		execution.Attempts = attempt
+		if execution.Succeeded() || !retriable || attempt > policy.Retries {
+			recorder.Record(execution, lc)
+			return execution
+		}
+
+		delay := policy.RetryDelay(attempt)
+		lc.Warn(fmt.Sprintf(
+			"the interval action %s failed, retry %d of %d in %s",
+			intervalAction.Name,
+			attempt,
+			policy.Retries,
+			delay))
+		time.Sleep(delay)
+	}
+}
+
+// intervalActionChain holds the interval actions of a run of an interval chained by their runAfter dependencies, by name,
+// in the order they are executed.
+type intervalActionChain struct {
+	order    []string
+	runAfter map[string][]string
+	actions  map[string]contract.IntervalAction
+	policies map[string]schedulerModels.ExecutionPolicy
+}
+
+// newIntervalActionChain returns the chain of the interval actions, by id, which run after other actions of them, and of
+// the actions they run after. The dependencies on actions which aren't part of the interval anymore are ignored. The
+// chain is empty when the dependencies form a cycle, which their validation prevents, the actions being executed apart
+// then.
+func newIntervalActionChain(
+	intervalActions map[string]contract.IntervalAction,
+	runAfterById map[string][]string,
+	policiesById map[string]schedulerModels.ExecutionPolicy) intervalActionChain {
+
+	byName := make(map[string]string, len(intervalActions))
+	for id, intervalAction := range intervalActions {
+		byName[intervalAction.Name] = id
+	}
+
+	chain := intervalActionChain{
+		runAfter: make(map[string][]string),
+		actions:  make(map[string]contract.IntervalAction),
+		policies: make(map[string]schedulerModels.ExecutionPolicy),
+	}
+	for id, intervalAction := range intervalActions {
+		for _, upstream := range runAfterById[id] {
+			upstreamId, exists := byName[upstream]
+			if !exists {
+				continue
+			}
+			chain.runAfter[intervalAction.Name] = append(chain.runAfter[intervalAction.Name], upstream)
+			chain.actions[intervalAction.Name] = intervalAction
+			chain.actions[upstream] = intervalActions[upstreamId]
+			chain.policies[intervalAction.Name] = policiesById[id]
+			chain.policies[upstream] = policiesById[upstreamId]
+		}
+	}
+	for name := range chain.actions {
+		if _, exists := chain.runAfter[name]; !exists {
+			chain.runAfter[name] = nil
+		}
+	}
+
+	order, err := schedulerModels.OrderChain(chain.runAfter)
+	if err != nil {
+		return intervalActionChain{}
+	}
+	chain.order = order
+	return chain
+}
+
+// includes tells whether the interval action by name is executed as part of the chain.
+func (chain intervalActionChain) includes(name string) bool {
+	_, exists := chain.actions[name]
+	return exists
+}
+
+// failedUpstream returns the name of an interval action the action by name runs after which didn't succeed according
+// to the outcomes of the steps executed so far, empty when they all succeeded.
+func (chain intervalActionChain) failedUpstream(name string, steps map[string]string) string {
+	for _, upstream := range chain.runAfter[name] {
+		if steps[upstream] != schedulerModels.ChainStepSucceeded {
+			return upstream
+		}
+	}
+	return ""
+}
+
+// executeChain executes the chained interval actions of a run of the interval one after the other, each according to
+// its execution policy. An action is skipped, and its execution recorded as such, when an action it runs after didn't
+// succeed. The outcome of the chain is kept as the last one of the interval.
+func executeChain(
+	context *IntervalContext,
+	chain intervalActionChain,
+	lc logger.LoggingClient,
+	msgClient messaging.MessageClient,
+	recorder *ExecutionRecorder,
+	secrets *HeaderSecrets,
+	configuration *config.ConfigurationStruct) {
+
+	intervalName := context.Interval.Name
+	started := time.Now()
+	outcome := schedulerModels.ChainOutcome{
+		Started:   toMillis(started),
+		Succeeded: true,
+		Steps:     make(map[string]string, len(chain.order)),
+	}
+
+	for _, name := range chain.order {
+		intervalAction := chain.actions[name]
+		if upstream := chain.failedUpstream(name, outcome.Steps); upstream != "" {
+			lc.Warn(fmt.Sprintf(
+				"the interval action %s is skipped, the interval action %s it runs after didn't succeed",
+				name,
+				upstream))
+			outcome.Steps[name] = schedulerModels.ChainStepSkipped
+			outcome.Succeeded = false
+			recorder.Record(skippedExecution(intervalName, intervalAction, upstream), lc)
+			continue
+		}
+
+		execution := executeIntervalAction(
+			intervalName,
+			intervalAction,
+			chain.policies[name],
+			lc,
+			msgClient,
+			recorder,
+			secrets,
+			configuration)
+		if execution.Succeeded() {
+			outcome.Steps[name] = schedulerModels.ChainStepSucceeded
+		} else {
+			outcome.Steps[name] = schedulerModels.ChainStepFailed
+			outcome.Succeeded = false
+		}
+	}
+	outcome.Duration = time.Since(started).Milliseconds()
+
+	mutex.Lock()
+	context.LastChain = &outcome
+	mutex.Unlock()
+
+	if outcome.Succeeded {
+		lc.Info(fmt.Sprintf("the chain of %d interval actions of the interval %s succeeded", len(chain.order), intervalName))
+	} else {
+		lc.Warn(fmt.Sprintf("the chain of %d interval actions of the interval %s failed", len(chain.order), intervalName))
+	}
+}
+
+// skippedExecution returns the execution recorded for the interval action of the interval skipped because the action
+// it runs after by name didn't succeed, which has no attempt.
+func skippedExecution(
+	intervalName string,
+	intervalAction contract.IntervalAction,
+	upstream string) schedulerModels.IntervalActionExecution {
+
+	execution := schedulerModels.IntervalActionExecution{
+		IntervalAction: intervalAction.Name,
+		Interval:       intervalName,
+		Method:         intervalAction.HTTPMethod,
+		Started:        toMillis(time.Now()),
+		Error:          fmt.Sprintf("skipped, the interval action %s it runs after didn't succeed", upstream),
+	}
+	if schedulerModels.IsMessageBusAction(intervalAction) {
+		execution.Method = schedulerModels.IntervalActionMessageBus
+		execution.Topic = intervalAction.Topic
+	} else {
+		execution.Url = getUrlStr(intervalAction)
+	}
+	return execution
+}
+
+// attemptIntervalAction publishes the parameters of a MESSAGEBUS interval action, or sends the REST request of any other
+// one with the timeout. It returns the execution, and whether a failure may be retried: a publishing error, an error
+// sending the request or a 5xx status code.
+func attemptIntervalAction(
+	intervalName string,
+	intervalAction contract.IntervalAction,
+	timeout time.Duration,
+	lc logger.LoggingClient,
+	msgClient messaging.MessageClient,
+	secrets *HeaderSecrets) (schedulerModels.IntervalActionExecution, bool) {
+
+	started := time.Now()
+	if schedulerModels.IsMessageBusAction(intervalAction) {
+		lc.Debug("the interval action " + intervalAction.Name + " will publish to topic : " + intervalAction.Topic)
+		execution := schedulerModels.IntervalActionExecution{
+			IntervalAction: intervalAction.Name,
+			Interval:       intervalName,
+			Method:         schedulerModels.IntervalActionMessageBus,
+			Topic:          intervalAction.Topic,
+			Started:        started.UnixNano() / int64(time.Millisecond),
+		}
+		correlationId, err := publishIntervalAction(msgClient, intervalAction)
+		execution.Duration = time.Since(started).Milliseconds()
+		if err != nil {
+			lc.Error(fmt.Sprintf("failed to publish the interval action %s: %s", intervalAction.Name, err.Error()))
+			execution.Error = err.Error()
+			return execution, true
+		}
+		lc.Debug("published to topic : "+intervalAction.Topic, clients.CorrelationHeader, correlationId)
+		return execution, false
+	}
+
+	executingUrl := getUrlStr(intervalAction)
+	lc.Debug("the interval action " + intervalAction.Name + " will request url : " + executingUrl)
+
+	execution := schedulerModels.IntervalActionExecution{
+		IntervalAction: intervalAction.Name,
+		Interval:       intervalName,
+		Method:         intervalAction.HTTPMethod,
+		Url:            executingUrl,
+		Started:        started.UnixNano() / int64(time.Millisecond),
+	}
+
+	httpMethod := intervalAction.HTTPMethod
+	if !validMethod(httpMethod) {
+		lc.Error(fmt.Sprintf("net/http: invalid method %q", httpMethod))
+		execution.Error = fmt.Sprintf("invalid method %q", httpMethod)
+		return execution, false
+	}
+
+	req, err := getHttpRequest(httpMethod, executingUrl, intervalAction, lc)
+	if err != nil {
+		lc.Error("create new request occurs error : " + err.Error())
+		execution.Error = err.Error()
+		return execution, false
+	}
+
+	// the secrets are read at every execution, so that the rotated ones are picked up
+	if err = secrets.Inject(req, authHeadersOf(intervalAction.ID)); err != nil {
+		lc.Error(fmt.Sprintf("failed to inject the headers of the interval action %s: %s", intervalAction.Name, err.Error()))
+		execution.Error = err.Error()
+		return execution, true
+	}
+
+	client := &http.Client{
+		Timeout: timeout,
+	}
+	responseBytes, statusCode, err := sendRequestAndGetResponse(client, req)
+	responseStr := string(responseBytes)
+
+	lc.Debug(fmt.Sprintf("execution returns status code : %d", statusCode))
+	lc.Debug("execution returns response content : " + responseStr)
+
+	execution.Duration = time.Since(started).Milliseconds()
+	if err != nil {
+		execution.Error = err.Error()
+		return execution, true
+	}
+	execution.StatusCode = statusCode
+	execution.Response = schedulerModels.ResponseSnippet(responseBytes)
+	return execution, statusCode >= http.StatusInternalServerError
+}
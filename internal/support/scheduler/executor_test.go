@@ -0,0 +1,129 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	msgTypes "github.com/edgexfoundry/go-mod-messaging/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// failingPublisher fails to publish to one topic.
+type failingPublisher struct {
+	publisher
+	failing string
+}
+
+func (p *failingPublisher) Publish(message msgTypes.MessageEnvelope, topic string) error {
+	if topic == p.failing {
+		return errors.New("message bus unreachable")
+	}
+	return p.publisher.Publish(message, topic)
+}
+
+func chainedIntervalActions(names ...string) map[string]contract.IntervalAction {
+	actions := make(map[string]contract.IntervalAction, len(names))
+	for _, name := range names {
+		actions[name+"-id"] = contract.IntervalAction{
+			ID:       name + "-id",
+			Name:     name,
+			Interval: "midnight",
+			Target:   "app-service",
+			Protocol: schedulerModels.IntervalActionMessageBus,
+			Topic:    "edgex/" + name,
+		}
+	}
+	return actions
+}
+
+func TestNewIntervalActionChain(t *testing.T) {
+	actions := chainedIntervalActions("export", "purge", "notify", "standalone")
+	runAfter := map[string][]string{
+		"purge-id":  {"export"},
+		"notify-id": {"purge", "deleted"},
+	}
+
+	chain := newIntervalActionChain(actions, runAfter, map[string]schedulerModels.ExecutionPolicy{})
+	assert.Equal(t, []string{"export", "purge", "notify"}, chain.order)
+	assert.Equal(t, []string{"purge"}, chain.runAfter["notify"], "the dependency on an action deleted is ignored")
+	assert.False(t, chain.includes("standalone"))
+
+	runAfter["export-id"] = []string{"notify"}
+	chain = newIntervalActionChain(actions, runAfter, map[string]schedulerModels.ExecutionPolicy{})
+	assert.Empty(t, chain.order, "the actions of a cycle are executed apart")
+	assert.False(t, chain.includes("export"))
+}
+
+func TestExecuteChain(t *testing.T) {
+	actions := chainedIntervalActions("export", "purge", "notify")
+	runAfter := map[string][]string{
+		"purge-id":  {"export"},
+		"notify-id": {"purge"},
+	}
+	chain := newIntervalActionChain(actions, runAfter, map[string]schedulerModels.ExecutionPolicy{})
+
+	tests := []struct {
+		name              string
+		failing           string
+		expectedSteps     map[string]string
+		expectedPublished []string
+	}{
+		{
+			"Succeeded",
+			"",
+			map[string]string{
+				"export": schedulerModels.ChainStepSucceeded,
+				"purge":  schedulerModels.ChainStepSucceeded,
+				"notify": schedulerModels.ChainStepSucceeded,
+			},
+			[]string{"edgex/export", "edgex/purge", "edgex/notify"},
+		},
+		{
+			"Upstream failed",
+			"edgex/export",
+			map[string]string{
+				"export": schedulerModels.ChainStepFailed,
+				"purge":  schedulerModels.ChainStepSkipped,
+				"notify": schedulerModels.ChainStepSkipped,
+			},
+			nil,
+		},
+		{
+			"Last step failed",
+			"edgex/notify",
+			map[string]string{
+				"export": schedulerModels.ChainStepSucceeded,
+				"purge":  schedulerModels.ChainStepSucceeded,
+				"notify": schedulerModels.ChainStepFailed,
+			},
+			[]string{"edgex/export", "edgex/purge"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &failingPublisher{failing: tt.failing}
+			context := &IntervalContext{Interval: contract.Interval{Name: "midnight"}}
+
+			executeChain(context, chain, logger.NewMockClient(), p, nil, nil, &config.ConfigurationStruct{})
+
+			require.NotNil(t, context.LastChain)
+			assert.Equal(t, tt.expectedSteps, context.LastChain.Steps)
+			assert.Equal(t, tt.failing == "", context.LastChain.Succeeded)
+			assert.Equal(t, tt.expectedPublished, p.topics)
+		})
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/messaging"
 	schedulerContainer "github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
@@ -44,7 +45,7 @@ func NewBootstrap(router *mux.Router) *Bootstrap {
 }
 
 // BootstrapHandler fulfills the BootstrapHandler contract and performs initialization needed by the scheduler service.
-func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
+func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, startupTimer startup.Timer, dic *di.Container) bool {
 	loadRestRoutes(b.router, dic)
 
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
@@ -58,14 +59,39 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _
 		},
 	})
 
-	err := LoadScheduler(lc, container.DBClientFrom(dic.Get), scClient, configuration)
+	dbClient := container.DBClientFrom(dic.Get)
+	err := LoadScheduler(lc, dbClient, scClient, configuration)
 	if err != nil {
 		lc.Error(fmt.Sprintf("Failed to load schedules and events %s", err.Error()))
 		return false
 	}
 
+	recorder, err := NewExecutionRecorder(dbClient, configuration.ExecutionHistory)
+	if err != nil {
+		lc.Error(err.Error())
+		return false
+	}
+
+	// the MESSAGEBUS interval actions fail to publish unless the message bus is enabled
+	var msgClient messaging.MessageClient
+	if configuration.MessageQueue.Enabled {
+		msgClient = connectMessageBus(startupTimer, configuration, dic)
+		if msgClient == nil {
+			return false
+		}
+	}
+
+	secrets := NewHeaderSecrets(configuration, bootstrapContainer.CredentialsProviderFrom(dic.Get))
+
+	elector, err := NewLeaderElector(dbClient, configuration.LeaderElection, lc)
+	if err != nil {
+		lc.Error(err.Error())
+		return false
+	}
+	elector.Start(ctx, wg)
+
 	ticker := time.NewTicker(time.Duration(configuration.Writable.ScheduleIntervalTime) * time.Millisecond)
-	StartTicker(ticker, lc, configuration)
+	StartTicker(ticker, lc, dbClient, scClient, msgClient, recorder, secrets, elector, configuration)
 
 	wg.Add(1)
 	go func() {
@@ -73,6 +99,13 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _
 
 		<-ctx.Done()
 		StopTicker(ticker)
+		if msgClient != nil {
+			if err := msgClient.Disconnect(); err != nil {
+				lc.Error("failed to disconnect from the Message Bus")
+				return
+			}
+			lc.Info("Message Bus disconnected")
+		}
 	}()
 
 	return true
@@ -14,6 +14,10 @@
 package interfaces
 
 import (
+	"time"
+
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+
 	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
 )
 
@@ -43,6 +47,36 @@ type DBClient interface {
 	// Remove Interval by id
 	DeleteIntervalById(id string) error
 
+	// Return the timezone of the Interval(s) scheduled in a named timezone, by interval id
+	IntervalTimezones() (map[string]string, error)
+
+	// Set the timezone of an Interval by id, an empty timezone scheduling it in UTC
+	SetIntervalTimezone(id string, timezone string) error
+
+	// Return the misfire policy of the Interval(s) which don't skip their missed runs, by interval id
+	IntervalMisfirePolicies() (map[string]string, error)
+
+	// Set the misfire policy of an Interval by id, an empty policy skipping its missed runs
+	SetIntervalMisfirePolicy(id string, policy string) error
+
+	// Return the time of the last scheduled run of the Interval(s), in epoch milliseconds, by interval id
+	IntervalLastRuns() (map[string]int64, error)
+
+	// Set the time of the last scheduled run of an Interval by id, in epoch milliseconds
+	SetIntervalLastRun(id string, lastRun int64) error
+
+	// Return the maximum number of runs of the Interval(s) limited to a number of runs, by interval id
+	IntervalMaxIterations() (map[string]int64, error)
+
+	// Set the maximum number of runs of an Interval by id, 0 for no limit, which restarts the count of its runs
+	SetIntervalMaxIterations(id string, maxIterations int64) error
+
+	// Return the number of runs left to the Interval(s) limited to a number of runs, by interval id
+	IntervalRemainingIterations() (map[string]int64, error)
+
+	// Set the number of runs left to an Interval limited to a number of runs by id
+	SetIntervalRemainingIterations(id string, remaining int64) error
+
 	// ************************* INTERVAL ACTIONS *******************************
 
 	// Get all IntervalAction(s)
@@ -69,9 +103,47 @@ type DBClient interface {
 	// Update IntervalAction
 	UpdateIntervalAction(intervalAction contract.IntervalAction) error
 
-	// Remove IntervalAction by id
+	// Remove IntervalAction by id, along with its executions, its execution policy, its dependencies and its headers
 	DeleteIntervalActionById(id string) error
 
+	// Return the execution policy of the IntervalAction(s) which aren't executed with the default one, by action id
+	IntervalActionPolicies() (map[string]schedulerModels.ExecutionPolicy, error)
+
+	// Set the execution policy of an IntervalAction by id, the default policy removing it
+	SetIntervalActionPolicy(id string, policy schedulerModels.ExecutionPolicy) error
+
+	// Return the names of the IntervalAction(s) each IntervalAction with runAfter dependencies runs after, by action id
+	IntervalActionDependencies() (map[string][]string, error)
+
+	// Set the names of the IntervalAction(s) an IntervalAction runs after by id, no dependency removing them
+	SetIntervalActionDependencies(id string, runAfter []string) error
+
+	// Return the headers injected from the secret store into the requests of the IntervalAction(s) which have some, by
+	// action id
+	IntervalActionAuthHeaders() (map[string][]schedulerModels.AuthHeader, error)
+
+	// Set the headers injected from the secret store into the requests of an IntervalAction by id, no header removing
+	// them
+	SetIntervalActionAuthHeaders(id string, headers []schedulerModels.AuthHeader) error
+
+	// ********************* INTERVAL ACTION EXECUTIONS *************************
+
+	// Add the execution of an IntervalAction, dropping its executions started before expired, in epoch milliseconds,
+	// and the oldest ones beyond max when max is positive
+	AddIntervalActionExecution(e schedulerModels.IntervalActionExecution, expired int64, max int) (string, error)
+
+	// Get the executions of an IntervalAction by name, the latest first, up to the number specified
+	IntervalActionExecutionsByName(name string, limit int) ([]schedulerModels.IntervalActionExecution, error)
+
+	// ************************** LEADER ELECTION ******************************
+
+	// Acquire the lease of the leader of the scheduler instances for the instance, or renew it when the instance holds it
+	// already, for ttl, returning whether the instance holds it
+	AcquireSchedulerLease(instance string, ttl time.Duration) (bool, error)
+
+	// Release the lease of the leader when the instance holds it
+	ReleaseSchedulerLease(instance string) error
+
 	// ************************** UTILITY FUNCTION(S) ***************************
 
 	// Scrub all scheduler interval actions from the database data (only used in test)
@@ -4,12 +4,35 @@ package mocks
 
 import mock "github.com/stretchr/testify/mock"
 import models "github.com/edgexfoundry/go-mod-core-contracts/models"
+import schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+import time "time"
 
 // DBClient is an autogenerated mock type for the DBClient type
 type DBClient struct {
 	mock.Mock
 }
 
+// AcquireSchedulerLease provides a mock function with given fields: instance, ttl
+func (_m *DBClient) AcquireSchedulerLease(instance string, ttl time.Duration) (bool, error) {
+	ret := _m.Called(instance, ttl)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, time.Duration) bool); ok {
+		r0 = rf(instance, ttl)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, time.Duration) error); ok {
+		r1 = rf(instance, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AddInterval provides a mock function with given fields: interval
 func (_m *DBClient) AddInterval(interval models.Interval) (string, error) {
 	ret := _m.Called(interval)
@@ -52,6 +75,27 @@ func (_m *DBClient) AddIntervalAction(intervalAction models.IntervalAction) (str
 	return r0, r1
 }
 
+// AddIntervalActionExecution provides a mock function with given fields: e, expired, max
+func (_m *DBClient) AddIntervalActionExecution(e schedulerModels.IntervalActionExecution, expired int64, max int) (string, error) {
+	ret := _m.Called(e, expired, max)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(schedulerModels.IntervalActionExecution, int64, int) string); ok {
+		r0 = rf(e, expired, max)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(schedulerModels.IntervalActionExecution, int64, int) error); ok {
+		r1 = rf(e, expired, max)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CloseSession provides a mock function with given fields:
 func (_m *DBClient) CloseSession() {
 	_m.Called()
@@ -85,6 +129,29 @@ func (_m *DBClient) DeleteIntervalById(id string) error {
 	return r0
 }
 
+// IntervalActionAuthHeaders provides a mock function with given fields:
+func (_m *DBClient) IntervalActionAuthHeaders() (map[string][]schedulerModels.AuthHeader, error) {
+	ret := _m.Called()
+
+	var r0 map[string][]schedulerModels.AuthHeader
+	if rf, ok := ret.Get(0).(func() map[string][]schedulerModels.AuthHeader); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]schedulerModels.AuthHeader)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // IntervalActionById provides a mock function with given fields: id
 func (_m *DBClient) IntervalActionById(id string) (models.IntervalAction, error) {
 	ret := _m.Called(id)
@@ -127,6 +194,75 @@ func (_m *DBClient) IntervalActionByName(name string) (models.IntervalAction, er
 	return r0, r1
 }
 
+// IntervalActionDependencies provides a mock function with given fields:
+func (_m *DBClient) IntervalActionDependencies() (map[string][]string, error) {
+	ret := _m.Called()
+
+	var r0 map[string][]string
+	if rf, ok := ret.Get(0).(func() map[string][]string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IntervalActionExecutionsByName provides a mock function with given fields: name, limit
+func (_m *DBClient) IntervalActionExecutionsByName(name string, limit int) ([]schedulerModels.IntervalActionExecution, error) {
+	ret := _m.Called(name, limit)
+
+	var r0 []schedulerModels.IntervalActionExecution
+	if rf, ok := ret.Get(0).(func(string, int) []schedulerModels.IntervalActionExecution); ok {
+		r0 = rf(name, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]schedulerModels.IntervalActionExecution)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(name, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IntervalActionPolicies provides a mock function with given fields:
+func (_m *DBClient) IntervalActionPolicies() (map[string]schedulerModels.ExecutionPolicy, error) {
+	ret := _m.Called()
+
+	var r0 map[string]schedulerModels.ExecutionPolicy
+	if rf, ok := ret.Get(0).(func() map[string]schedulerModels.ExecutionPolicy); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]schedulerModels.ExecutionPolicy)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // IntervalActions provides a mock function with given fields:
 func (_m *DBClient) IntervalActions() ([]models.IntervalAction, error) {
 	ret := _m.Called()
@@ -261,6 +397,121 @@ func (_m *DBClient) IntervalByName(name string) (models.Interval, error) {
 	return r0, r1
 }
 
+// IntervalLastRuns provides a mock function with given fields:
+func (_m *DBClient) IntervalLastRuns() (map[string]int64, error) {
+	ret := _m.Called()
+
+	var r0 map[string]int64
+	if rf, ok := ret.Get(0).(func() map[string]int64); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int64)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IntervalMaxIterations provides a mock function with given fields:
+func (_m *DBClient) IntervalMaxIterations() (map[string]int64, error) {
+	ret := _m.Called()
+
+	var r0 map[string]int64
+	if rf, ok := ret.Get(0).(func() map[string]int64); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int64)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IntervalMisfirePolicies provides a mock function with given fields:
+func (_m *DBClient) IntervalMisfirePolicies() (map[string]string, error) {
+	ret := _m.Called()
+
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func() map[string]string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IntervalRemainingIterations provides a mock function with given fields:
+func (_m *DBClient) IntervalRemainingIterations() (map[string]int64, error) {
+	ret := _m.Called()
+
+	var r0 map[string]int64
+	if rf, ok := ret.Get(0).(func() map[string]int64); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int64)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IntervalTimezones provides a mock function with given fields:
+func (_m *DBClient) IntervalTimezones() (map[string]string, error) {
+	ret := _m.Called()
+
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func() map[string]string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Intervals provides a mock function with given fields:
 func (_m *DBClient) Intervals() ([]models.Interval, error) {
 	ret := _m.Called()
@@ -307,6 +558,20 @@ func (_m *DBClient) IntervalsWithLimit(limit int) ([]models.Interval, error) {
 	return r0, r1
 }
 
+// ReleaseSchedulerLease provides a mock function with given fields: instance
+func (_m *DBClient) ReleaseSchedulerLease(instance string) error {
+	ret := _m.Called(instance)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(instance)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ScrubAllIntervalActions provides a mock function with given fields:
 func (_m *DBClient) ScrubAllIntervalActions() (int, error) {
 	ret := _m.Called()
@@ -349,6 +614,118 @@ func (_m *DBClient) ScrubAllIntervals() (int, error) {
 	return r0, r1
 }
 
+// SetIntervalActionAuthHeaders provides a mock function with given fields: id, headers
+func (_m *DBClient) SetIntervalActionAuthHeaders(id string, headers []schedulerModels.AuthHeader) error {
+	ret := _m.Called(id, headers)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []schedulerModels.AuthHeader) error); ok {
+		r0 = rf(id, headers)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetIntervalActionDependencies provides a mock function with given fields: id, runAfter
+func (_m *DBClient) SetIntervalActionDependencies(id string, runAfter []string) error {
+	ret := _m.Called(id, runAfter)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []string) error); ok {
+		r0 = rf(id, runAfter)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetIntervalActionPolicy provides a mock function with given fields: id, policy
+func (_m *DBClient) SetIntervalActionPolicy(id string, policy schedulerModels.ExecutionPolicy) error {
+	ret := _m.Called(id, policy)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, schedulerModels.ExecutionPolicy) error); ok {
+		r0 = rf(id, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetIntervalLastRun provides a mock function with given fields: id, lastRun
+func (_m *DBClient) SetIntervalLastRun(id string, lastRun int64) error {
+	ret := _m.Called(id, lastRun)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64) error); ok {
+		r0 = rf(id, lastRun)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetIntervalMaxIterations provides a mock function with given fields: id, maxIterations
+func (_m *DBClient) SetIntervalMaxIterations(id string, maxIterations int64) error {
+	ret := _m.Called(id, maxIterations)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64) error); ok {
+		r0 = rf(id, maxIterations)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetIntervalMisfirePolicy provides a mock function with given fields: id, policy
+func (_m *DBClient) SetIntervalMisfirePolicy(id string, policy string) error {
+	ret := _m.Called(id, policy)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(id, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetIntervalRemainingIterations provides a mock function with given fields: id, remaining
+func (_m *DBClient) SetIntervalRemainingIterations(id string, remaining int64) error {
+	ret := _m.Called(id, remaining)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64) error); ok {
+		r0 = rf(id, remaining)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetIntervalTimezone provides a mock function with given fields: id, timezone
+func (_m *DBClient) SetIntervalTimezone(id string, timezone string) error {
+	ret := _m.Called(id, timezone)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(id, timezone)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateInterval provides a mock function with given fields: interval
 func (_m *DBClient) UpdateInterval(interval models.Interval) error {
 	ret := _m.Called(interval)
@@ -4,6 +4,8 @@ package mocks
 
 import mock "github.com/stretchr/testify/mock"
 import models "github.com/edgexfoundry/go-mod-core-contracts/models"
+import schedulermodels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+import time "time"
 
 // SchedulerQueueClient is an autogenerated mock type for the SchedulerQueueClient type
 type SchedulerQueueClient struct {
@@ -101,6 +103,22 @@ func (_m *SchedulerQueueClient) QueryIntervalActionByName(intervalActionName str
 	return r0, r1
 }
 
+// QueryIntervalActionDependencies provides a mock function with given fields: intervalName
+func (_m *SchedulerQueueClient) QueryIntervalActionDependencies(intervalName string) map[string][]string {
+	ret := _m.Called(intervalName)
+
+	var r0 map[string][]string
+	if rf, ok := ret.Get(0).(func(string) map[string][]string); ok {
+		r0 = rf(intervalName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]string)
+		}
+	}
+
+	return r0
+}
+
 // QueryIntervalByID provides a mock function with given fields: intervalId
 func (_m *SchedulerQueueClient) QueryIntervalByID(intervalId string) (models.Interval, error) {
 	ret := _m.Called(intervalId)
@@ -143,6 +161,43 @@ func (_m *SchedulerQueueClient) QueryIntervalByName(intervalName string) (models
 	return r0, r1
 }
 
+// QueryIntervalStatusByName provides a mock function with given fields: intervalName
+func (_m *SchedulerQueueClient) QueryIntervalStatusByName(intervalName string) (schedulermodels.IntervalStatus, error) {
+	ret := _m.Called(intervalName)
+
+	var r0 schedulermodels.IntervalStatus
+	if rf, ok := ret.Get(0).(func(string) schedulermodels.IntervalStatus); ok {
+		r0 = rf(intervalName)
+	} else {
+		r0 = ret.Get(0).(schedulermodels.IntervalStatus)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(intervalName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QueryIntervalStatuses provides a mock function with given fields:
+func (_m *SchedulerQueueClient) QueryIntervalStatuses() []schedulermodels.IntervalStatus {
+	ret := _m.Called()
+
+	var r0 []schedulermodels.IntervalStatus
+	if rf, ok := ret.Get(0).(func() []schedulermodels.IntervalStatus); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]schedulermodels.IntervalStatus)
+		}
+	}
+
+	return r0
+}
+
 // RemoveIntervalActionQueue provides a mock function with given fields: intervalActionId
 func (_m *SchedulerQueueClient) RemoveIntervalActionQueue(intervalActionId string) error {
 	ret := _m.Called(intervalActionId)
@@ -171,6 +226,45 @@ func (_m *SchedulerQueueClient) RemoveIntervalInQueue(intervalId string) error {
 	return r0
 }
 
+// SetIntervalActionAuthHeaders provides a mock function with given fields: intervalActionId, headers
+func (_m *SchedulerQueueClient) SetIntervalActionAuthHeaders(intervalActionId string, headers []schedulermodels.AuthHeader) {
+	_m.Called(intervalActionId, headers)
+}
+
+// SetIntervalActionDependencies provides a mock function with given fields: intervalActionId, runAfter
+func (_m *SchedulerQueueClient) SetIntervalActionDependencies(intervalActionId string, runAfter []string) {
+	_m.Called(intervalActionId, runAfter)
+}
+
+// SetIntervalActionPolicy provides a mock function with given fields: intervalActionId, policy
+func (_m *SchedulerQueueClient) SetIntervalActionPolicy(intervalActionId string, policy schedulermodels.ExecutionPolicy) {
+	_m.Called(intervalActionId, policy)
+}
+
+// SetIntervalIterations provides a mock function with given fields: intervalId, maxIterations, remaining
+func (_m *SchedulerQueueClient) SetIntervalIterations(intervalId string, maxIterations int64, remaining int64) {
+	_m.Called(intervalId, maxIterations, remaining)
+}
+
+// SetIntervalMissedRuns provides a mock function with given fields: intervalId, lastRun, policy, max
+func (_m *SchedulerQueueClient) SetIntervalMissedRuns(intervalId string, lastRun time.Time, policy string, max int64) int64 {
+	ret := _m.Called(intervalId, lastRun, policy, max)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(string, time.Time, string, int64) int64); ok {
+		r0 = rf(intervalId, lastRun, policy, max)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	return r0
+}
+
+// SetIntervalTimezone provides a mock function with given fields: intervalId, location
+func (_m *SchedulerQueueClient) SetIntervalTimezone(intervalId string, location *time.Location) {
+	_m.Called(intervalId, location)
+}
+
 // UpdateIntervalActionQueue provides a mock function with given fields: intervalAction
 func (_m *SchedulerQueueClient) UpdateIntervalActionQueue(intervalAction models.IntervalAction) error {
 	ret := _m.Called(intervalAction)
@@ -14,6 +14,10 @@
 package interfaces
 
 import (
+	"time"
+
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+
 	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
 )
 
@@ -36,6 +40,23 @@ type SchedulerQueueClient interface {
 	// Remote the Interval from the Scheduler Queue
 	RemoveIntervalInQueue(intervalId string) error
 
+	// Set the timezone the Interval is scheduled in, nil for UTC
+	SetIntervalTimezone(intervalId string, location *time.Location)
+
+	// Set the maximum number of runs of the Interval, 0 for no limit, and the number of runs it has left, the maximum
+	// when negative
+	SetIntervalIterations(intervalId string, maxIterations int64, remaining int64)
+
+	// Queue the runs of the Interval missed since its last run, at most max, according to the misfire policy, and
+	// return their number
+	SetIntervalMissedRuns(intervalId string, lastRun time.Time, policy string, max int64) int64
+
+	// Return the execution status of all the Intervals in the Scheduler Queue
+	QueryIntervalStatuses() []schedulerModels.IntervalStatus
+
+	// Return the execution status of the Interval by Name from the Scheduler Queue
+	QueryIntervalStatusByName(intervalName string) (schedulerModels.IntervalStatus, error)
+
 	// ************************* INTERVAL ACTIONS *******************************
 
 	// Return IntervalAction by ID from the Scheduler IntervalAction Context
@@ -53,6 +74,20 @@ type SchedulerQueueClient interface {
 	// Remove IntervalAction from the Scheduler Queue
 	RemoveIntervalActionQueue(intervalActionId string) error
 
+	// Set the policy the IntervalAction is executed with, the default policy executing it once, right away, with the
+	// service timeout
+	SetIntervalActionPolicy(intervalActionId string, policy schedulerModels.ExecutionPolicy)
+
+	// Return the names of the IntervalAction(s) each IntervalAction of the Interval runs after, by action name
+	QueryIntervalActionDependencies(intervalName string) map[string][]string
+
+	// Set the names of the IntervalAction(s) of its Interval the IntervalAction runs after within a run of the Interval,
+	// no dependency removing them
+	SetIntervalActionDependencies(intervalActionId string, runAfter []string)
+
+	// Set the headers injected from the secret store into the requests of the IntervalAction, no header removing them
+	SetIntervalActionAuthHeaders(intervalActionId string, headers []schedulerModels.AuthHeader)
+
 	// Check if we can connect to Scheduler Queue
 	Connect() (string, error)
 }
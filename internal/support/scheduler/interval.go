@@ -20,6 +20,7 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/errors"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/interfaces"
+	intervalOperator "github.com/edgexfoundry/edgex-go/internal/support/scheduler/operators/interval"
 )
 
 func getIntervals(limit int, dbClient interfaces.DBClient) ([]contract.Interval, error) {
@@ -66,6 +67,12 @@ func addNewInterval(
 			return "", errors.NewErrInvalidTimeFormat(end)
 		}
 	}
+	// Validate the Cron expression, stored normalized
+	if interval.Cron != "" {
+		if interval.Cron, err = intervalOperator.NormalizeCron(interval.Cron); err != nil {
+			return "", err
+		}
+	}
 	// Validate the Frequency
 	freq := interval.Frequency
 	if freq != "" {
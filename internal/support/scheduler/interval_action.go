@@ -21,6 +21,7 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/errors"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/interfaces"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
 )
 
 func addNewIntervalAction(
@@ -41,6 +42,9 @@ func addNewIntervalAction(
 	if target == "" {
 		return "", errors.NewErrIntervalActionTargetNameRequired(intervalAction.ID)
 	}
+	if schedulerModels.IsMessageBusAction(intervalAction) && intervalAction.Topic == "" {
+		return "", errors.NewErrIntervalActionTopicRequired(name)
+	}
 
 	// Validate the Interval
 	interval := intervalAction.Interval
@@ -172,6 +176,9 @@ func updateIntervalAction(
 	if params != to.Parameters {
 		to.Parameters = params
 	}
+	if schedulerModels.IsMessageBusAction(to) && to.Topic == "" {
+		return errors.NewErrIntervalActionTopicRequired(to.Name)
+	}
 
 	// Validate the IntervalAction does not exist in the scheduler queue
 	_, err = scClient.QueryIntervalActionByName(to.Name)
@@ -0,0 +1,181 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/google/uuid"
+)
+
+// leaseHolder acquires and releases the lease of the leader of the scheduler instances.
+type leaseHolder interface {
+	AcquireSchedulerLease(instance string, ttl time.Duration) (bool, error)
+	ReleaseSchedulerLease(instance string) error
+}
+
+// LeaderElector elects the leader executing the interval actions among the scheduler instances sharing the database
+// by means of a lease, which the leader renews and the standbys try to acquire every renew interval. The leader steps
+// down as soon as it fails to renew its lease, so that two instances don't execute the same actions for longer than a
+// renew interval. A nil elector is always the leader.
+type LeaderElector struct {
+	leases        leaseHolder
+	lc            logger.LoggingClient
+	instance      string
+	ttl           time.Duration
+	renewInterval time.Duration
+	mutex         sync.Mutex
+	leader        bool
+	campaigned    bool
+	tookOver      bool
+}
+
+// NewLeaderElector returns the elector configured by the leader election, nil when it is disabled.
+func NewLeaderElector(leases leaseHolder, info config.LeaderElectionInfo, lc logger.LoggingClient) (*LeaderElector, error) {
+	if !info.Enabled {
+		return nil, nil
+	}
+
+	ttl, err := parseLeaderElectionDuration("lease TTL", info.LeaseTTL, 15*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	renewInterval, err := parseLeaderElectionDuration("renew interval", info.RenewInterval, 5*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	if renewInterval >= ttl {
+		return nil, fmt.Errorf("leader election renew interval '%s' isn't shorter than the lease TTL '%s'", renewInterval, ttl)
+	}
+
+	instance := info.InstanceId
+	if instance == "" {
+		hostname, _ := os.Hostname()
+		instance = hostname + "-" + uuid.New().String()
+	}
+
+	return &LeaderElector{
+		leases:        leases,
+		lc:            lc,
+		instance:      instance,
+		ttl:           ttl,
+		renewInterval: renewInterval,
+	}, nil
+}
+
+// Start campaigns for the lease right away, so that a leader is elected before the interval actions are first
+// executed, then every renew interval until ctx is done, when the lease is released.
+func (e *LeaderElector) Start(ctx context.Context, wg *sync.WaitGroup) {
+	if e == nil {
+		return
+	}
+
+	e.campaign()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(e.renewInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				e.resign()
+				return
+			case <-ticker.C:
+				e.campaign()
+			}
+		}
+	}()
+}
+
+// IsLeader tells whether the instance is the leader, executing the interval actions.
+func (e *LeaderElector) IsLeader() bool {
+	if e == nil {
+		return true
+	}
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	return e.leader
+}
+
+// TookOver tells whether the instance became the leader after having been a standby since it was last asked. The
+// intervals of a standby are stale, the leader having executed and modified them meanwhile.
+func (e *LeaderElector) TookOver() bool {
+	if e == nil {
+		return false
+	}
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	tookOver := e.tookOver
+	e.tookOver = false
+	return tookOver
+}
+
+// campaign acquires or renews the lease, the instance stepping down when it fails to.
+func (e *LeaderElector) campaign() {
+	acquired, err := e.leases.AcquireSchedulerLease(e.instance, e.ttl)
+	if err != nil {
+		e.lc.Error(fmt.Sprintf("the scheduler instance %s failed to acquire the leader lease: %s", e.instance, err.Error()))
+		acquired = false
+	}
+
+	e.mutex.Lock()
+	wasLeader := e.leader
+	first := !e.campaigned
+	e.leader = acquired
+	e.campaigned = true
+	if acquired && !wasLeader && !first {
+		e.tookOver = true
+	}
+	e.mutex.Unlock()
+
+	switch {
+	case acquired && !wasLeader:
+		e.lc.Info(fmt.Sprintf("the scheduler instance %s is the leader, executing the interval actions", e.instance))
+	case !acquired && (wasLeader || first):
+		e.lc.Info(fmt.Sprintf("the scheduler instance %s is a standby, another instance executing the interval actions", e.instance))
+	}
+}
+
+// resign releases the lease held by the leader, so that a standby takes over without waiting for it to expire.
+func (e *LeaderElector) resign() {
+	e.mutex.Lock()
+	wasLeader := e.leader
+	e.leader = false
+	e.mutex.Unlock()
+
+	if !wasLeader {
+		return
+	}
+	if err := e.leases.ReleaseSchedulerLease(e.instance); err != nil {
+		e.lc.Error(fmt.Sprintf("the scheduler instance %s failed to release the leader lease: %s", e.instance, err.Error()))
+		return
+	}
+	e.lc.Info(fmt.Sprintf("the scheduler instance %s released the leader lease", e.instance))
+}
+
+func parseLeaderElectionDuration(name string, value string, defaultDuration time.Duration) (time.Duration, error) {
+	if value == "" {
+		return defaultDuration, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid leader election %s '%s'", name, value)
+	}
+	return d, nil
+}
@@ -0,0 +1,109 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler
+
+import (
+	"context"
+	goErrors "errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/interfaces/mocks"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLeaderElector(t *testing.T) {
+	tests := []struct {
+		name        string
+		info        config.LeaderElectionInfo
+		expectNil   bool
+		expectError bool
+	}{
+		{"disabled", config.LeaderElectionInfo{LeaseTTL: "invalid"}, true, false},
+		{"defaults", config.LeaderElectionInfo{Enabled: true}, false, false},
+		{"valid", config.LeaderElectionInfo{Enabled: true, LeaseTTL: "10s", RenewInterval: "2s"}, false, false},
+		{"invalid lease TTL", config.LeaderElectionInfo{Enabled: true, LeaseTTL: "invalid"}, true, true},
+		{"invalid renew interval", config.LeaderElectionInfo{Enabled: true, RenewInterval: "-1s"}, true, true},
+		{"renew interval not shorter", config.LeaderElectionInfo{Enabled: true, LeaseTTL: "5s", RenewInterval: "5s"}, true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			elector, err := NewLeaderElector(&mocks.DBClient{}, tt.info, logger.NewMockClient())
+			assert.Equal(t, tt.expectError, err != nil)
+			assert.Equal(t, tt.expectNil, elector == nil)
+		})
+	}
+
+	elector, err := NewLeaderElector(&mocks.DBClient{}, config.LeaderElectionInfo{Enabled: true}, logger.NewMockClient())
+	require.NoError(t, err)
+	assert.NotEmpty(t, elector.instance, "an instance id is generated when none is configured")
+	assert.Equal(t, 15*time.Second, elector.ttl)
+	assert.Equal(t, 5*time.Second, elector.renewInterval)
+}
+
+func TestLeaderElectorCampaign(t *testing.T) {
+	info := config.LeaderElectionInfo{Enabled: true, InstanceId: "scheduler-1"}
+	dbClient := &mocks.DBClient{}
+	dbClient.On("AcquireSchedulerLease", "scheduler-1", 15*time.Second).Return(false, nil).Once()
+	dbClient.On("AcquireSchedulerLease", "scheduler-1", 15*time.Second).Return(true, nil).Once()
+	dbClient.On("AcquireSchedulerLease", "scheduler-1", 15*time.Second).Return(true, nil).Once()
+	dbClient.On("AcquireSchedulerLease", "scheduler-1", 15*time.Second).Return(false, goErrors.New("unreachable")).Once()
+	elector, err := NewLeaderElector(dbClient, info, logger.NewMockClient())
+	require.NoError(t, err)
+
+	elector.campaign()
+	assert.False(t, elector.IsLeader(), "another instance holds the lease")
+	assert.False(t, elector.TookOver())
+
+	elector.campaign()
+	assert.True(t, elector.IsLeader(), "the lease of the former leader expired")
+	assert.True(t, elector.TookOver())
+	assert.False(t, elector.TookOver(), "the takeover is told once")
+
+	elector.campaign()
+	assert.True(t, elector.IsLeader(), "the leader renewed its lease")
+	assert.False(t, elector.TookOver())
+
+	elector.campaign()
+	assert.False(t, elector.IsLeader(), "the leader steps down when it fails to renew its lease")
+	dbClient.AssertExpectations(t)
+}
+
+func TestLeaderElectorFirstCampaign(t *testing.T) {
+	dbClient := &mocks.DBClient{}
+	dbClient.On("AcquireSchedulerLease", "scheduler-1", mock.Anything).Return(true, nil)
+	dbClient.On("ReleaseSchedulerLease", "scheduler-1").Return(nil)
+	elector, err := NewLeaderElector(
+		dbClient,
+		config.LeaderElectionInfo{Enabled: true, InstanceId: "scheduler-1"},
+		logger.NewMockClient())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	elector.Start(ctx, wg)
+	assert.True(t, elector.IsLeader(), "the leader is elected before the intervals are first triggered")
+	assert.False(t, elector.TookOver(), "the first leader has nothing to reload")
+
+	cancel()
+	wg.Wait()
+	assert.False(t, elector.IsLeader())
+	dbClient.AssertCalled(t, "ReleaseSchedulerLease", "scheduler-1")
+}
+
+func TestNilLeaderElector(t *testing.T) {
+	var elector *LeaderElector
+	elector.Start(context.Background(), &sync.WaitGroup{})
+	assert.True(t, elector.IsLeader(), "a single instance is always the leader")
+	assert.False(t, elector.TookOver())
+}
@@ -15,11 +15,19 @@
 package scheduler
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
 	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
 
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/errors"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/interfaces"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+	intervalOperator "github.com/edgexfoundry/edgex-go/internal/support/scheduler/operators/interval"
+	intervalActionOperator "github.com/edgexfoundry/edgex-go/internal/support/scheduler/operators/intervalaction"
 )
 
 // Utility function for adding configured locally intervals and scheduled events
@@ -43,6 +51,12 @@ func LoadScheduler(
 		return err
 	}
 
+	// catch up the runs missed while the scheduler was down
+	err = addMissedRuns(lc, dbClient, scClient, configuration.Misfire)
+	if err != nil {
+		return err
+	}
+
 	// load config intervals
 	errLCI := loadConfigIntervals(lc, dbClient, scClient, configuration)
 	if errLCI != nil {
@@ -115,6 +129,34 @@ func getSchedulerDBIntervalActions(
 	return intervalActions, nil
 }
 
+// Iterate over the timezones of the intervals and set them in the scheduler memory queue, ahead of the intervals
+func addReceivedIntervalTimezones(
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	scClient interfaces.SchedulerQueueClient) error {
+
+	timezones, err := dbClient.IntervalTimezones()
+	if err == db.ErrUnsupportedDatabase {
+		lc.Warn("the database doesn't support interval timezones, the intervals are scheduled in UTC")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for id, timezone := range timezones {
+		location, err := intervalOperator.LoadTimezone(timezone)
+		if err != nil {
+			// the timezone database of the host may differ from the one the timezone was validated against
+			lc.Error(fmt.Sprintf("interval with id %s is scheduled in UTC: %s", id, err.Error()))
+			continue
+		}
+		scClient.SetIntervalTimezone(id, location)
+		lc.Debug("found interval timezone", "id", id, "timezone", timezone)
+	}
+	return nil
+}
+
 // Iterate over the received intervals add them to scheduler memory queue
 func addReceivedIntervals(
 	intervals []contract.Interval,
@@ -132,6 +174,80 @@ func addReceivedIntervals(
 	return nil
 }
 
+// Iterate over the received intervals and set the maximum and the remaining number of runs of those limited to a number
+// of runs in the scheduler memory queue
+func addReceivedIntervalIterations(
+	intervals []contract.Interval,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	scClient interfaces.SchedulerQueueClient) error {
+
+	maxIterations, err := dbClient.IntervalMaxIterations()
+	if err == db.ErrUnsupportedDatabase {
+		lc.Warn("the database doesn't support interval iterations, the runs of the intervals are counted from startup")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	remainingIterations, err := dbClient.IntervalRemainingIterations()
+	if err != nil {
+		return err
+	}
+
+	for _, interval := range intervals {
+		max := maxIterations[interval.ID]
+		remaining, exists := remainingIterations[interval.ID]
+		if !exists {
+			if max == 0 {
+				continue
+			}
+			remaining = -1
+		}
+		scClient.SetIntervalIterations(interval.ID, max, remaining)
+		lc.Debug("found interval iterations", "id", interval.ID, "max", max, "remaining", remaining)
+	}
+	return nil
+}
+
+// Queue the runs of the intervals missed since their last run, according to their misfire policy
+func addMissedRuns(
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	scClient interfaces.SchedulerQueueClient,
+	misfire config.MisfireInfo) error {
+
+	policies, err := dbClient.IntervalMisfirePolicies()
+	if err == db.ErrUnsupportedDatabase {
+		lc.Warn("the database doesn't support interval misfire policies, the runs missed while the scheduler was down are skipped")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if len(policies) == 0 {
+		return nil
+	}
+
+	lastRuns, err := dbClient.IntervalLastRuns()
+	if err != nil {
+		return err
+	}
+
+	for id, policy := range policies {
+		lastRun, exists := lastRuns[id]
+		if !exists {
+			// the interval never ran
+			continue
+		}
+		missed := scClient.SetIntervalMissedRuns(id, time.Unix(0, lastRun*int64(time.Millisecond)), policy, misfire.MaxCatchUpRuns)
+		if missed > 0 {
+			lc.Info(fmt.Sprintf("catching up %d runs of the interval with id %s missed while the scheduler was down", missed, id))
+		}
+	}
+	return nil
+}
+
 // Iterate over the received interval action(s)
 func addReceivedIntervalActions(
 	intervalActions []contract.IntervalAction,
@@ -154,6 +270,73 @@ func addReceivedIntervalActions(
 	return nil
 }
 
+// Set the execution policy of the received interval action(s) which aren't executed with the default one in the
+// scheduler memory queue
+func addReceivedIntervalActionPolicies(
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	scClient interfaces.SchedulerQueueClient) error {
+
+	policies, err := dbClient.IntervalActionPolicies()
+	if err == db.ErrUnsupportedDatabase {
+		lc.Warn("the database doesn't support execution policies, the interval actions are executed with the default one")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for id, policy := range policies {
+		scClient.SetIntervalActionPolicy(id, policy)
+		lc.Debug("found interval action execution policy", "id", id)
+	}
+	return nil
+}
+
+// Set the runAfter dependencies of the received interval action(s) in the scheduler memory queue
+func addReceivedIntervalActionDependencies(
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	scClient interfaces.SchedulerQueueClient) error {
+
+	dependencies, err := dbClient.IntervalActionDependencies()
+	if err == db.ErrUnsupportedDatabase {
+		lc.Warn("the database doesn't support interval action dependencies, the interval actions aren't chained")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for id, runAfter := range dependencies {
+		scClient.SetIntervalActionDependencies(id, runAfter)
+		lc.Debug("found interval action dependencies", "id", id)
+	}
+	return nil
+}
+
+// Set the secret-backed headers of the received interval action(s) in the scheduler memory queue
+func addReceivedIntervalActionAuthHeaders(
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	scClient interfaces.SchedulerQueueClient) error {
+
+	headers, err := dbClient.IntervalActionAuthHeaders()
+	if err == db.ErrUnsupportedDatabase {
+		lc.Warn("the database doesn't support auth headers, no header is injected from the secret store")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for id, actionHeaders := range headers {
+		scClient.SetIntervalActionAuthHeaders(id, actionHeaders)
+		lc.Debug("found interval action auth headers", "id", id)
+	}
+	return nil
+}
+
 // Add interval to support-scheduler
 func addIntervalToSchedulerDB(
 	interval contract.Interval,
@@ -211,6 +394,22 @@ func loadConfigIntervals(
 			Cron:       intervals[i].Cron,
 			RunOnce:    intervals[i].RunOnce,
 		}
+		if interval.Cron != "" {
+			cron, err := intervalOperator.NormalizeCron(interval.Cron)
+			if err != nil {
+				return err
+			}
+			interval.Cron = cron
+		}
+		if _, err := intervalOperator.LoadTimezone(intervals[i].Timezone); err != nil {
+			return err
+		}
+		if err := intervalOperator.ValidateMisfirePolicy(intervals[i].MisfirePolicy); err != nil {
+			return err
+		}
+		if err := intervalOperator.ValidateMaxIterations(intervals[i].MaxIterations); err != nil {
+			return err
+		}
 
 		// query scheduler service for interval in memory queue
 		_, errExistingSchedule := scClient.QueryIntervalByName(interval.Name)
@@ -225,6 +424,30 @@ func loadConfigIntervals(
 			// add the support-scheduler scheduler.id
 			interval.ID = newIntervalID
 
+			if intervals[i].Timezone != "" {
+				op := intervalOperator.NewTimezoneExecutor(dbClient, scClient, interval.ID, intervals[i].Timezone)
+				if err := op.Execute(); err != nil {
+					return err
+				}
+			}
+
+			if intervals[i].MisfirePolicy != "" {
+				op := intervalOperator.NewMisfirePolicyExecutor(dbClient, interval.ID, intervals[i].MisfirePolicy)
+				if err := op.Execute(); err != nil && err != db.ErrUnsupportedDatabase {
+					return err
+				}
+			}
+
+			if intervals[i].MaxIterations != 0 {
+				op := intervalOperator.NewMaxIterationsExecutor(dbClient, scClient, interval.ID, intervals[i].MaxIterations)
+				if err := op.Execute(); err == db.ErrUnsupportedDatabase {
+					// the runs are counted from startup
+					scClient.SetIntervalIterations(interval.ID, intervals[i].MaxIterations, -1)
+				} else if err != nil {
+					return err
+				}
+			}
+
 			// add the interval to the scheduler
 			err := scClient.AddIntervalToQueue(interval)
 
@@ -249,6 +472,7 @@ func loadConfigIntervalActions(
 	configuration *config.ConfigurationStruct) error {
 
 	intervalActions := configuration.IntervalActions
+	chained := make(map[string]contract.IntervalAction)
 
 	for ia := range intervalActions {
 		intervalAction := contract.IntervalAction{
@@ -261,6 +485,30 @@ func loadConfigIntervalActions(
 			Protocol:   intervalActions[ia].Protocol,
 			HTTPMethod: intervalActions[ia].Method,
 			Address:    intervalActions[ia].Host,
+			Topic:      intervalActions[ia].Topic,
+		}
+		if schedulerModels.IsMessageBusAction(intervalAction) && intervalAction.Topic == "" {
+			return errors.NewErrIntervalActionTopicRequired(intervalAction.Name)
+		}
+		policy := schedulerModels.ExecutionPolicy{
+			Timeout:      intervalActions[ia].Timeout,
+			Retries:      intervalActions[ia].Retries,
+			RetryBackoff: intervalActions[ia].RetryBackoff,
+			Jitter:       intervalActions[ia].Jitter,
+		}
+		if err := intervalActionOperator.ValidateExecutionPolicy(intervalAction.Name, policy); err != nil {
+			return err
+		}
+		var authHeaders []schedulerModels.AuthHeader
+		for _, h := range intervalActions[ia].AuthHeaders {
+			authHeaders = append(authHeaders, schedulerModels.AuthHeader{
+				SecretPath: h.SecretPath,
+				Header:     h.Header,
+				Scheme:     h.Scheme,
+			})
+		}
+		if err := intervalActionOperator.ValidateAuthHeaders(intervalAction.Name, authHeaders); err != nil {
+			return err
 		}
 
 		// query scheduler in memory queue and determine of intervalAction exists
@@ -278,17 +526,57 @@ func loadConfigIntervalActions(
 			intervalAction.ID = newIntervalActionID
 			// TODO: Do we care about the Created,Modified, or Origin fields?
 
+			if !policy.IsDefault() {
+				op := intervalActionOperator.NewExecutionPolicyExecutor(dbClient, scClient, intervalAction.ID, intervalAction.Name, policy)
+				if err := op.Execute(); err == db.ErrUnsupportedDatabase {
+					// the policy applies until the scheduler restarts
+					scClient.SetIntervalActionPolicy(intervalAction.ID, policy)
+				} else if err != nil {
+					return err
+				}
+			}
+			if len(authHeaders) > 0 {
+				op := intervalActionOperator.NewAuthHeadersExecutor(dbClient, scClient, intervalAction.ID, intervalAction.Name, authHeaders)
+				if err := op.Execute(); err == db.ErrUnsupportedDatabase {
+					// the headers are injected until the scheduler restarts
+					scClient.SetIntervalActionAuthHeaders(intervalAction.ID, authHeaders)
+				} else if err != nil {
+					return err
+				}
+			}
+
 			errAddIntervalAction := scClient.AddIntervalActionToQueue(intervalAction)
 			if errAddIntervalAction != nil {
 				return errAddIntervalAction
 
 			}
+			if len(intervalActions[ia].RunAfter) > 0 {
+				chained[ia] = intervalAction
+			}
 		} else {
 			lc.Debug(
 				"did not load interval action as it exists in the scheduler database" +
 					":" + intervalAction.Name)
 		}
 	}
+
+	// the dependencies are set once all the actions are in the queue, whatever their order in the configuration
+	for ia, intervalAction := range chained {
+		runAfter := intervalActions[ia].RunAfter
+		op := intervalActionOperator.NewDependenciesExecutor(
+			dbClient,
+			scClient,
+			intervalAction.ID,
+			intervalAction.Name,
+			intervalAction.Interval,
+			runAfter)
+		if err := op.Execute(); err == db.ErrUnsupportedDatabase {
+			// the dependencies apply until the scheduler restarts
+			scClient.SetIntervalActionDependencies(intervalAction.ID, runAfter)
+		} else if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -303,11 +591,21 @@ func loadSupportSchedulerDBInformation(
 		return err
 	}
 
+	err = addReceivedIntervalTimezones(lc, dbClient, scClient)
+	if err != nil {
+		return err
+	}
+
 	err = addReceivedIntervals(receivedIntervals, lc, scClient)
 	if err != nil {
 		return err
 	}
 
+	err = addReceivedIntervalIterations(receivedIntervals, lc, dbClient, scClient)
+	if err != nil {
+		return err
+	}
+
 	intervalActions, err := getSchedulerDBIntervalActions(lc, dbClient)
 	if err != nil {
 		return err
@@ -318,5 +616,20 @@ func loadSupportSchedulerDBInformation(
 		return err
 	}
 
+	err = addReceivedIntervalActionPolicies(lc, dbClient, scClient)
+	if err != nil {
+		return err
+	}
+
+	err = addReceivedIntervalActionDependencies(lc, dbClient, scClient)
+	if err != nil {
+		return err
+	}
+
+	err = addReceivedIntervalActionAuthHeaders(lc, dbClient, scClient)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
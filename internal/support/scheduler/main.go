@@ -21,6 +21,12 @@ import (
 	"github.com/edgexfoundry/edgex-go"
 	"github.com/edgexfoundry/edgex-go/internal"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers/database"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers/secret"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/gateway"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/readygate"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/secretref"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/selftest"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/sysevent"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/telemetry"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
@@ -29,7 +35,6 @@ import (
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/flags"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/handlers/httpserver"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/handlers/message"
-	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/handlers/secret"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/handlers/testing"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/interfaces"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
@@ -50,7 +55,11 @@ func Main(ctx context.Context, cancel context.CancelFunc, router *mux.Router, re
 	//      ....
 	//      flags.Parse(os.Args[1:])
 	//
+	var repairDecoding string
+	var repairDecodingDryRun bool
 	f := flags.New()
+	f.FlagSet.StringVar(&repairDecoding, database.RepairDecodingFlag, "", database.RepairDecodingUsage)
+	f.FlagSet.BoolVar(&repairDecodingDryRun, database.RepairDecodingDryRunFlag, false, database.RepairDecodingDryRunUsage)
 	f.Parse(os.Args[1:])
 
 	configuration := &config.ConfigurationStruct{}
@@ -61,6 +70,31 @@ func Main(ctx context.Context, cancel context.CancelFunc, router *mux.Router, re
 	})
 
 	httpServer := httpserver.NewBootstrap(router, true)
+	readyGate := readygate.NewBootstrap(clients.SupportSchedulerServiceKey, &configuration.ReadyGate)
+
+	bootstrapHandlers := []interfaces.BootstrapHandler{
+		secret.NewSecret().BootstrapHandler,
+		secretref.NewBootstrap(configuration).BootstrapHandler,
+		readyGate.WaitHandler,
+		database.NewDatabase(httpServer, configuration).BootstrapHandler,
+		NewBootstrap(router).BootstrapHandler,
+		gateway.NewBootstrap(clients.SupportSchedulerServiceKey, configuration, &configuration.Gateway).BootstrapHandler,
+		telemetry.BootstrapHandler,
+		selftest.NewBootstrap(router, &configuration.SelfTest).BootstrapHandler,
+		sysevent.NewBootstrap(router, clients.SupportSchedulerServiceKey, &configuration.Writable, &configuration.SystemEvents).BootstrapHandler,
+		httpServer.BootstrapHandler,
+		readyGate.AnnounceHandler,
+		message.NewBootstrap(clients.SupportSchedulerServiceKey, edgex.Version).BootstrapHandler,
+		testing.NewBootstrap(httpServer, readyStream).BootstrapHandler,
+	}
+	if repairDecoding != "" {
+		// only connect to the database, to repair the stored objects and exit
+		bootstrapHandlers = []interfaces.BootstrapHandler{
+			secret.NewSecret().BootstrapHandler,
+			database.NewDatabase(httpServer, configuration).BootstrapHandler,
+			database.NewDecodingRepair(repairDecoding, repairDecodingDryRun).BootstrapHandler,
+		}
+	}
 
 	bootstrap.Run(
 		ctx,
@@ -71,13 +105,5 @@ func Main(ctx context.Context, cancel context.CancelFunc, router *mux.Router, re
 		configuration,
 		startupTimer,
 		dic,
-		[]interfaces.BootstrapHandler{
-			secret.NewSecret().BootstrapHandler,
-			database.NewDatabase(httpServer, configuration).BootstrapHandler,
-			NewBootstrap(router).BootstrapHandler,
-			telemetry.BootstrapHandler,
-			httpServer.BootstrapHandler,
-			message.NewBootstrap(clients.SupportSchedulerServiceKey, edgex.Version).BootstrapHandler,
-			testing.NewBootstrap(httpServer, readyStream).BootstrapHandler,
-		})
+		bootstrapHandlers)
 }
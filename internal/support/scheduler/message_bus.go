@@ -0,0 +1,118 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/messaging"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	msgTypes "github.com/edgexfoundry/go-mod-messaging/pkg/types"
+
+	"github.com/google/uuid"
+)
+
+// connectMessageBus connects to the message bus the MESSAGEBUS interval actions publish to. It returns nil when the
+// connection fails.
+func connectMessageBus(startupTimer startup.Timer, configuration *config.ConfigurationStruct, dic *di.Container) messaging.MessageClient {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	messageQueue := configuration.MessageQueue
+
+	// For Redis Streams MessageBus, we reuse the Redis instance running for the DB, which may have a password,
+	// so we need to get and use the DB credentials for the MessageBus connection.
+	if messageQueue.Type == "redisstreams" {
+		credentials, err := bootstrapContainer.CredentialsProviderFrom(dic.Get).GetDatabaseCredentials(configuration.Databases["Primary"])
+		if err != nil {
+			lc.Error(fmt.Sprintf("Error getting DB creds for RedisStreams: %s", err.Error()))
+			return nil
+		}
+
+		if messageQueue.Optional == nil {
+			messageQueue.Optional = make(map[string]string)
+		}
+		messageQueue.Optional["Password"] = credentials.Password
+	} else if messageQueue.SecretPath != "" {
+		// The credentials provider returns the secret stored under the path given as database type when security is
+		// enabled and the username and password passed along, taken from the configuration, otherwise.
+		credentials, err := bootstrapContainer.CredentialsProviderFrom(dic.Get).GetDatabaseCredentials(bootstrapConfig.Database{
+			Type:     messageQueue.SecretPath,
+			Username: messageQueue.Optional["Username"],
+			Password: messageQueue.Optional["Password"],
+		})
+		if err != nil {
+			lc.Error(fmt.Sprintf("unable to retrieve the message bus credentials: %s", err.Error()))
+			return nil
+		}
+
+		if messageQueue.Optional == nil {
+			messageQueue.Optional = make(map[string]string)
+		}
+		messageQueue.Optional["Username"] = credentials.Username
+		messageQueue.Optional["Password"] = credentials.Password
+	}
+
+	msgClient, err := messaging.NewMessageClient(
+		msgTypes.MessageBusConfig{
+			PublishHost: msgTypes.HostInfo{
+				Host:     messageQueue.Host,
+				Port:     messageQueue.Port,
+				Protocol: messageQueue.Protocol,
+			},
+			Type:     messageQueue.Type,
+			Optional: messageQueue.Optional,
+		})
+	if err != nil {
+		lc.Error(fmt.Sprintf("failed to create messaging client: %s", err.Error()))
+		return nil
+	}
+
+	for startupTimer.HasNotElapsed() {
+		err = msgClient.Connect()
+		if err == nil {
+			break
+		}
+
+		lc.Warn(fmt.Sprintf("couldn't connect to message bus: %s", err.Error()))
+		startupTimer.SleepForInterval()
+	}
+
+	if err != nil {
+		lc.Error("failed to connect to message bus in allotted time")
+		return nil
+	}
+
+	lc.Info(fmt.Sprintf(
+		"Connected to %s Message Bus @ %s to publish the MESSAGEBUS interval actions",
+		messageQueue.Type,
+		messageQueue.URL()))
+
+	return msgClient
+}
+
+// publishIntervalAction publishes the parameters of the MESSAGEBUS interval action to its topic as a JSON payload with
+// a new correlation id, which it returns. msgClient is nil when the message bus isn't enabled.
+func publishIntervalAction(msgClient messaging.MessageClient, intervalAction contract.IntervalAction) (string, error) {
+	if msgClient == nil {
+		return "", fmt.Errorf("the message bus isn't enabled to publish to topic %s", intervalAction.Topic)
+	}
+
+	correlationId := uuid.New().String()
+	ctx := context.WithValue(context.Background(), clients.CorrelationHeader, correlationId)
+	ctx = context.WithValue(ctx, clients.ContentType, clients.ContentTypeJSON)
+	envelope := msgTypes.NewMessageEnvelope([]byte(strings.TrimSpace(intervalAction.Parameters)), ctx)
+	return correlationId, msgClient.Publish(envelope, intervalAction.Topic)
+}
@@ -0,0 +1,65 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	msgTypes "github.com/edgexfoundry/go-mod-messaging/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// publisher records the messages published instead of sending them to a message bus.
+type publisher struct {
+	topics    []string
+	envelopes []msgTypes.MessageEnvelope
+}
+
+func (p *publisher) Connect() error {
+	return nil
+}
+
+func (p *publisher) Publish(message msgTypes.MessageEnvelope, topic string) error {
+	p.topics = append(p.topics, topic)
+	p.envelopes = append(p.envelopes, message)
+	return nil
+}
+
+func (p *publisher) Subscribe(_ []msgTypes.TopicChannel, _ chan error) error {
+	return nil
+}
+
+func (p *publisher) Disconnect() error {
+	return nil
+}
+
+func TestPublishIntervalAction(t *testing.T) {
+	intervalAction := contract.IntervalAction{
+		Name:       "trigger-export",
+		Target:     "app-service",
+		Protocol:   "MESSAGEBUS",
+		Topic:      "edgex/trigger",
+		Parameters: ` {"export": true} `,
+	}
+
+	p := &publisher{}
+	correlationId, err := publishIntervalAction(p, intervalAction)
+	require.NoError(t, err)
+	require.Len(t, p.envelopes, 1)
+	assert.Equal(t, []string{"edgex/trigger"}, p.topics)
+	assert.Equal(t, `{"export": true}`, string(p.envelopes[0].Payload))
+	assert.Equal(t, clients.ContentTypeJSON, p.envelopes[0].ContentType)
+	assert.Equal(t, correlationId, p.envelopes[0].CorrelationID)
+	assert.NotEmpty(t, correlationId)
+
+	_, err = publishIntervalAction(nil, intervalAction)
+	assert.Error(t, err, "the message bus isn't enabled")
+}
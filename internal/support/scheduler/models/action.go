@@ -0,0 +1,22 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"strings"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// IntervalActionMessageBus is the protocol of the interval actions publishing their parameters to their topic on the
+// message bus instead of sending a REST request.
+const IntervalActionMessageBus = "MESSAGEBUS"
+
+// IsMessageBusAction tells whether the interval action publishes to the message bus, its protocol being MESSAGEBUS
+// whatever the case.
+func IsMessageBusAction(intervalAction contract.IntervalAction) bool {
+	return strings.EqualFold(intervalAction.Protocol, IntervalActionMessageBus)
+}
@@ -0,0 +1,81 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Schemes of the authorization headers built from the username and password of a secret.
+const (
+	AuthSchemeBasic  = "Basic"
+	AuthSchemeBearer = "Bearer"
+)
+
+// AuthHeader injects the secret stored under SecretPath in the secret store as the Header of the requests of a REST
+// interval action, 'Authorization' when empty, so that the tokens of the protected endpoints are never kept along with
+// the action. The password of the secret is sent prefixed by the Scheme, e.g. 'Bearer', or as is when there is none,
+// while the Basic scheme sends the username and password of the secret.
+type AuthHeader struct {
+	SecretPath string `json:"secretPath"`
+	Header     string `json:"header,omitempty"`
+	Scheme     string `json:"scheme,omitempty"`
+}
+
+// Name returns the canonical name of the header.
+func (h AuthHeader) Name() string {
+	if h.Header == "" {
+		return "Authorization"
+	}
+	return http.CanonicalHeaderKey(h.Header)
+}
+
+// Value returns the value of the header for the username and password of the secret.
+func (h AuthHeader) Value(username string, password string) string {
+	switch {
+	case strings.EqualFold(h.Scheme, AuthSchemeBasic):
+		return AuthSchemeBasic + " " + base64.StdEncoding.EncodeToString([]byte(username+":"+password))
+	case h.Scheme != "":
+		return h.Scheme + " " + password
+	default:
+		return password
+	}
+}
+
+// ValidateAuthHeaders checks that every header references a secret, that its name and scheme are HTTP tokens and that
+// no header is injected twice.
+func ValidateAuthHeaders(headers []AuthHeader) error {
+	names := make(map[string]bool, len(headers))
+	for _, h := range headers {
+		if strings.TrimSpace(h.SecretPath) == "" {
+			return fmt.Errorf("no secretPath for the header %s", h.Name())
+		}
+		if h.Header != "" && !isToken(h.Header) {
+			return fmt.Errorf("invalid header '%s'", h.Header)
+		}
+		if h.Scheme != "" && !isToken(h.Scheme) {
+			return fmt.Errorf("invalid scheme '%s'", h.Scheme)
+		}
+		if names[h.Name()] {
+			return fmt.Errorf("the header %s is injected twice", h.Name())
+		}
+		names[h.Name()] = true
+	}
+	return nil
+}
+
+// isToken tells whether s is an HTTP token, as header names and authorization schemes are.
+func isToken(s string) bool {
+	for _, c := range s {
+		if c <= ' ' || c >= 0x7f || strings.ContainsRune("()<>@,;:\\\"/[]?={}", c) {
+			return false
+		}
+	}
+	return s != ""
+}
@@ -0,0 +1,75 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Outcomes of the steps of a chain of interval actions
+const (
+	ChainStepSucceeded = "SUCCEEDED"
+	ChainStepFailed    = "FAILED"
+	ChainStepSkipped   = "SKIPPED"
+)
+
+// ChainOutcome records a run of the interval actions of an interval chained by their runAfter dependencies, which are
+// executed one after the other within one run of the interval. A step is skipped when an action it runs after failed
+// or was skipped, so that the chain succeeds only when all its steps do. Steps are the outcomes by action name, Started
+// is in epoch milliseconds and Duration in milliseconds.
+type ChainOutcome struct {
+	Started   int64             `json:"started"`
+	Duration  int64             `json:"duration"`
+	Succeeded bool              `json:"succeeded"`
+	Steps     map[string]string `json:"steps"`
+}
+
+// OrderChain returns the names of the interval actions of the dependencies, the actions each one runs after by name,
+// in an order in which every action comes after the ones it runs after. The actions only depended on are included. The
+// order is the same for the same dependencies. It returns an error naming an action of a cycle.
+func OrderChain(runAfter map[string][]string) ([]string, error) {
+	const (
+		visiting = 1
+		visited  = 2
+	)
+
+	names := make([]string, 0, len(runAfter))
+	for name := range runAfter {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	order := make([]string, 0, len(names))
+	state := make(map[string]int, len(names))
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case visiting:
+			return fmt.Errorf("%s runs after itself through its dependencies", name)
+		case visited:
+			return nil
+		}
+		state[name] = visiting
+		upstreams := append([]string(nil), runAfter[name]...)
+		sort.Strings(upstreams)
+		for _, upstream := range upstreams {
+			if err := visit(upstream); err != nil {
+				return err
+			}
+		}
+		state[name] = visited
+		order = append(order, name)
+		return nil
+	}
+
+	for _, name := range names {
+		if err := visit(name); err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+}
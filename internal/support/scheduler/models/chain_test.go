@@ -0,0 +1,35 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderChain(t *testing.T) {
+	order, err := OrderChain(map[string][]string{
+		"notify": {"purge"},
+		"purge":  {"export"},
+		"report": {"export"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"export", "purge", "notify", "report"}, order)
+
+	order, err = OrderChain(map[string][]string{})
+	require.NoError(t, err)
+	assert.Empty(t, order)
+
+	for _, runAfter := range []map[string][]string{
+		{"export": {"export"}},
+		{"export": {"notify"}, "purge": {"export"}, "notify": {"purge"}},
+	} {
+		_, err = OrderChain(runAfter)
+		assert.Error(t, err, runAfter)
+	}
+}
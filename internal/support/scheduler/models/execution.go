@@ -0,0 +1,51 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// MaxResponseSnippetLength is the number of bytes of the response of an interval action kept in its execution.
+const MaxResponseSnippetLength = 512
+
+// IntervalActionExecution records a single execution of an interval action by the scheduler. Started is in epoch
+// milliseconds and Duration in milliseconds. StatusCode is 0 and Error is set when no response was received. The
+// executions of the MESSAGEBUS actions have the topic published to instead of a url and no status code. Attempts is the
+// number of times the action was attempted, the execution recording the last attempt.
+type IntervalActionExecution struct {
+	ID             string `json:"id"`
+	IntervalAction string `json:"intervalAction"`
+	Interval       string `json:"interval"`
+	Method         string `json:"method"`
+	Url            string `json:"url"`
+	Topic          string `json:"topic,omitempty"`
+	Started        int64  `json:"started"`
+	Duration       int64  `json:"duration"`
+	StatusCode     int    `json:"statusCode,omitempty"`
+	Response       string `json:"response,omitempty"`
+	Error          string `json:"error,omitempty"`
+	Attempts       int    `json:"attempts,omitempty"`
+}
+
+// Succeeded tells whether the interval action was executed and answered with a 2xx status code, or published for a
+// MESSAGEBUS action.
+func (e IntervalActionExecution) Succeeded() bool {
+	if e.Method == IntervalActionMessageBus {
+		return e.Error == ""
+	}
+	return e.Error == "" && e.StatusCode >= 200 && e.StatusCode < 300
+}
+
+// ResponseSnippet returns the beginning of the response, up to MaxResponseSnippetLength bytes without splitting a
+// UTF-8 character.
+func ResponseSnippet(response []byte) string {
+	if len(response) <= MaxResponseSnippetLength {
+		return string(response)
+	}
+	end := MaxResponseSnippetLength
+	// back up to the first byte of the character cut, continuation bytes being 10xxxxxx
+	for end > 0 && response[end]&0xC0 == 0x80 {
+		end--
+	}
+	return string(response[:end])
+}
@@ -0,0 +1,77 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// ExecutionPolicy defines how an interval action is executed: Timeout bounds every attempt, the service timeout
+// applying when empty, a failed attempt is retried up to Retries times, the first retry waiting RetryBackoff and every
+// following one twice as long as the previous one, and the execution is delayed by a random duration up to Jitter so
+// that the actions of the same interval don't all hit their targets at once. The durations are Go durations, e.g.
+// '500ms'.
+type ExecutionPolicy struct {
+	Timeout      string `json:"timeout,omitempty"`
+	Retries      int    `json:"retries,omitempty"`
+	RetryBackoff string `json:"retryBackoff,omitempty"`
+	Jitter       string `json:"jitter,omitempty"`
+}
+
+// IsDefault tells whether the policy is the default one, executing the action once, right away, with the service
+// timeout.
+func (p ExecutionPolicy) IsDefault() bool {
+	return p == ExecutionPolicy{}
+}
+
+// Validate checks that the durations are positive, or empty, and that the number of retries isn't negative.
+func (p ExecutionPolicy) Validate() error {
+	if p.Retries < 0 {
+		return fmt.Errorf("negative retries %d", p.Retries)
+	}
+	durations := []struct{ name, value string }{
+		{"timeout", p.Timeout},
+		{"retryBackoff", p.RetryBackoff},
+		{"jitter", p.Jitter},
+	}
+	for _, duration := range durations {
+		if duration.value == "" {
+			continue
+		}
+		if d, err := time.ParseDuration(duration.value); err != nil || d <= 0 {
+			return fmt.Errorf("invalid %s '%s'", duration.name, duration.value)
+		}
+	}
+	return nil
+}
+
+// TimeoutDuration returns the timeout of an attempt, defaultTimeout when the policy has none.
+func (p ExecutionPolicy) TimeoutDuration(defaultTimeout time.Duration) time.Duration {
+	return parsePolicyDuration(p.Timeout, defaultTimeout)
+}
+
+// JitterDelay returns the delay of the execution for random, between 0 and 1.
+func (p ExecutionPolicy) JitterDelay(random float64) time.Duration {
+	return time.Duration(random * float64(parsePolicyDuration(p.Jitter, 0)))
+}
+
+// RetryDelay returns the delay before the retry, counted from 1, doubling the backoff with every retry.
+func (p ExecutionPolicy) RetryDelay(retry int) time.Duration {
+	delay := parsePolicyDuration(p.RetryBackoff, 0)
+	for i := 1; i < retry && delay < time.Hour; i++ {
+		delay *= 2
+	}
+	return delay
+}
+
+func parsePolicyDuration(value string, defaultDuration time.Duration) time.Duration {
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return defaultDuration
+	}
+	return d
+}
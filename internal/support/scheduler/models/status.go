@@ -0,0 +1,31 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// IntervalStatus reports how accurately the scheduler executes an interval. Times are epoch milliseconds and drifts
+// are the milliseconds between the scheduled and the actual start of an execution. Catch-up runs are the runs missed
+// while the scheduler was down which were executed on startup, according to the misfire policy of the interval, apart
+// from the scheduled executions. The maximum and the remaining iterations are set for an interval limited to a number of
+// runs, counting the catch-up runs, which is disabled once it has no run left. The last chain is the outcome of the last
+// run of the actions of the interval chained by their runAfter dependencies.
+type IntervalStatus struct {
+	Name                string        `json:"name"`
+	Frequency           string        `json:"frequency,omitempty"`
+	Cron                string        `json:"cron,omitempty"`
+	Timezone            string        `json:"timezone,omitempty"`
+	NextRun             int64         `json:"nextRun"`
+	LastRun             int64         `json:"lastRun,omitempty"`
+	Executions          int64         `json:"executions"`
+	SkippedRuns         int64         `json:"skippedRuns"`
+	CatchUpRuns         int64         `json:"catchUpRuns,omitempty"`
+	PendingRuns         int64         `json:"pendingCatchUpRuns,omitempty"`
+	MaxIterations       int64         `json:"maxIterations,omitempty"`
+	RemainingIterations *int64        `json:"remainingIterations,omitempty"`
+	LastDrift           int64         `json:"lastDrift"`
+	MaxDrift            int64         `json:"maxDrift"`
+	AverageDrift        int64         `json:"averageDrift"`
+	LastChain           *ChainOutcome `json:"lastChain,omitempty"`
+}
@@ -32,6 +32,12 @@ type intervalAdd struct {
 func (op intervalAdd) Execute() (id string, err error) {
 	name := op.interval.Name
 
+	if op.interval.Cron != "" {
+		if op.interval.Cron, err = NormalizeCron(op.interval.Cron); err != nil {
+			return "", err
+		}
+	}
+
 	// Check if the name is unique
 	ret, err := op.database.IntervalByName(name)
 	if err == nil && ret.Name == name {
@@ -58,6 +58,15 @@ func TestAddExecutor(t *testing.T) {
 			expectedError:    true,
 			expectedErrorVal: intervalErrors.NewErrIntervalNameInUse(SuccessfulDatabaseResult[0].Name),
 		},
+		{
+			name:             "Error Invalid Cron",
+			mockDb:           createAddMockIntervalSuccess(),
+			scClient:         createAddMockIntervalSCSuccess(),
+			interval:         IntervalHasInvalidCron,
+			expectedResult:   "",
+			expectedError:    true,
+			expectedErrorVal: ErrTestInvalidCron,
+		},
 		{
 			name:             "Error AddInterval",
 			mockDb:           createAddMockIntervalError(),
@@ -0,0 +1,78 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package interval
+
+import (
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/errors"
+
+	"github.com/robfig/cron"
+)
+
+var (
+	// standardParser parses the 5 fields expressions, from the minute to the day of the week.
+	standardParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+	// secondsParser parses the 6 fields expressions, starting with the second.
+	secondsParser = cron.NewParser(
+		cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+	// legacyParser parses the 5 fields expressions stored by the previous releases, which start with the second and
+	// leave out the day of the week.
+	legacyParser = cron.NewParser(
+		cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.DowOptional | cron.Descriptor)
+)
+
+// ParseCron parses the cron expression of an interval, either a standard one of 5 fields, e.g. '30 2 * * MON-FRI', one
+// of 6 fields starting with the second, e.g. '0 */5 * * * *', or a descriptor such as '@daily' or '@every 90s'. The
+// error returned on an invalid expression is an ErrInvalidCronFormat telling what is wrong with it.
+func ParseCron(spec string) (cron.Schedule, error) {
+	parser := standardParser
+	if len(strings.Fields(spec)) == 6 {
+		parser = secondsParser
+	}
+	schedule, err := parser.Parse(spec)
+	if err != nil {
+		return nil, errors.NewErrInvalidCronFormat(spec, err)
+	}
+	return schedule, nil
+}
+
+// NormalizeCron validates the cron expression given to an interval, as ParseCron does, and returns the expression to
+// store. The standard expressions of 5 fields are stored with their second, e.g. '30 2 * * MON-FRI' as
+// '0 30 2 * * MON-FRI', so the only expressions of 5 fields stored are those of the previous releases.
+func NormalizeCron(spec string) (string, error) {
+	if _, err := ParseCron(spec); err != nil {
+		return "", err
+	}
+	if fields := strings.Fields(spec); len(fields) == 5 {
+		return "0 " + strings.Join(fields, " "), nil
+	}
+	return spec, nil
+}
+
+// ParseStoredCron parses the cron expression stored with an interval. The expressions of 5 fields were stored by the
+// previous releases, which read them starting with the second, e.g. '0 30 2 * *' runs every day at 2:30, and are
+// still read the same way.
+func ParseStoredCron(spec string) (cron.Schedule, error) {
+	if len(strings.Fields(spec)) != 5 {
+		return ParseCron(spec)
+	}
+	schedule, err := legacyParser.Parse(spec)
+	if err != nil {
+		return nil, errors.NewErrInvalidCronFormat(spec, err)
+	}
+	return schedule, nil
+}
+
+// UpgradeStoredCron returns the cron expression stored with an interval in the form NormalizeCron stores, the
+// expressions of 5 fields of the previous releases being given their day of the week, so the expression means the same
+// wherever it is given, e.g. to the schedule import of another site.
+func UpgradeStoredCron(spec string) string {
+	if fields := strings.Fields(spec); len(fields) == 5 {
+		return strings.Join(fields, " ") + " *"
+	}
+	return spec
+}
@@ -0,0 +1,109 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package interval
+
+import (
+	"testing"
+	"time"
+
+	intervalErrors "github.com/edgexfoundry/edgex-go/internal/support/scheduler/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCron(t *testing.T) {
+	from := time.Date(2020, time.June, 1, 0, 0, 0, 0, time.UTC) // a Monday
+	tests := []struct {
+		name     string
+		spec     string
+		expected time.Time
+	}{
+		{"5 fields", "30 2 * * *", time.Date(2020, time.June, 1, 2, 30, 0, 0, time.UTC)},
+		{"5 fields with day of week", "0 9 * * SAT", time.Date(2020, time.June, 6, 9, 0, 0, 0, time.UTC)},
+		{"6 fields", "15 30 2 * * *", time.Date(2020, time.June, 1, 2, 30, 15, 0, time.UTC)},
+		{"6 fields with question mark", "*/10 * * ? * *", time.Date(2020, time.June, 1, 0, 0, 10, 0, time.UTC)},
+		{"descriptor", "@monthly", time.Date(2020, time.July, 1, 0, 0, 0, 0, time.UTC)},
+		{"every", "@every 90s", from.Add(90 * time.Second)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := ParseCron(tt.spec)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, schedule.Next(from))
+		})
+	}
+}
+
+func TestParseCronInvalid(t *testing.T) {
+	for _, spec := range []string{"", "invalid", "* * * *", "61 * * * *", "0 0 0 * * * *", "* * 32 * *", "@fortnightly"} {
+		t.Run(spec, func(t *testing.T) {
+			_, err := ParseCron(spec)
+			require.Error(t, err)
+			assert.IsType(t, intervalErrors.ErrInvalidCronFormat{}, err)
+			assert.Contains(t, err.Error(), spec)
+		})
+	}
+}
+
+func TestNormalizeCron(t *testing.T) {
+	tests := []struct {
+		spec     string
+		expected string
+	}{
+		{"30 2 * * MON-FRI", "0 30 2 * * MON-FRI"},
+		{"15 30 2 * * *", "15 30 2 * * *"},
+		{"@daily", "@daily"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			normalized, err := NormalizeCron(tt.spec)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, normalized)
+
+			// the expression stored is read as the expression given
+			expected, _ := ParseCron(tt.spec)
+			stored, err := ParseStoredCron(normalized)
+			require.NoError(t, err)
+			from := time.Date(2020, time.June, 1, 0, 0, 0, 0, time.UTC)
+			assert.Equal(t, expected.Next(from), stored.Next(from))
+		})
+	}
+
+	_, err := NormalizeCron("61 * * * *")
+	assert.IsType(t, intervalErrors.ErrInvalidCronFormat{}, err)
+}
+
+func TestParseStoredCron(t *testing.T) {
+	from := time.Date(2020, time.June, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		spec     string
+		expected time.Time
+	}{
+		// stored by the previous releases, which read the expressions of 5 fields starting with the second
+		{"legacy 5 fields", "0 30 2 * *", time.Date(2020, time.June, 1, 2, 30, 0, 0, time.UTC)},
+		{"legacy 5 fields with seconds", "*/10 * * * *", time.Date(2020, time.June, 1, 0, 0, 10, 0, time.UTC)},
+		{"6 fields", "0 30 2 * * SAT", time.Date(2020, time.June, 6, 2, 30, 0, 0, time.UTC)},
+		{"descriptor", "@hourly", time.Date(2020, time.June, 1, 1, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := ParseStoredCron(tt.spec)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, schedule.Next(from))
+		})
+	}
+
+	_, err := ParseStoredCron("0 61 * * *")
+	assert.IsType(t, intervalErrors.ErrInvalidCronFormat{}, err)
+}
+
+func TestUpgradeStoredCron(t *testing.T) {
+	assert.Equal(t, "0 30 2 * * *", UpgradeStoredCron("0 30 2 * *"))
+	assert.Equal(t, "0 30 2 * * MON-FRI", UpgradeStoredCron("0 30 2 * * MON-FRI"))
+	assert.Equal(t, "@daily", UpgradeStoredCron("@daily"))
+}
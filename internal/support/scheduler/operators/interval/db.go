@@ -13,7 +13,11 @@
  *******************************************************************************/
 package interval
 
-import contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+import (
+	"time"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+)
 
 // IntervalLoader provides functionality for obtaining Interval.
 type IntervalLoader interface {
@@ -44,6 +48,32 @@ type IntervalUpdater interface {
 	IntervalActionLoader
 }
 
+// IntervalTimezoneLoader provides the timezones of the intervals scheduled in a named timezone, by interval id.
+type IntervalTimezoneLoader interface {
+	IntervalTimezones() (map[string]string, error)
+}
+
+// IntervalTimezoneWriter stores the timezone of an interval.
+type IntervalTimezoneWriter interface {
+	SetIntervalTimezone(id string, timezone string) error
+}
+
+// IntervalMisfirePolicyLoader provides the misfire policies of the intervals which don't skip their missed runs, by
+// interval id.
+type IntervalMisfirePolicyLoader interface {
+	IntervalMisfirePolicies() (map[string]string, error)
+}
+
+// IntervalMisfirePolicyWriter stores the misfire policy of an interval.
+type IntervalMisfirePolicyWriter interface {
+	SetIntervalMisfirePolicy(id string, policy string) error
+}
+
+// IntervalIterationsWriter stores the maximum number of runs of an interval.
+type IntervalIterationsWriter interface {
+	SetIntervalMaxIterations(id string, maxIterations int64) error
+}
+
 // SchedulerQueueLoader provides functionality for obtaining Interval from SchedulerQueue
 type SchedulerQueueLoader interface {
 	QueryIntervalByID(intervalId string) (contract.Interval, error)
@@ -71,3 +101,13 @@ type SchedulerQueueUpdater interface {
 type IntervalActionLoader interface {
 	IntervalActionsByIntervalName(name string) ([]contract.IntervalAction, error)
 }
+
+// SchedulerQueueTimezoneWriter sets the timezone of an interval in SchedulerQueue
+type SchedulerQueueTimezoneWriter interface {
+	SetIntervalTimezone(intervalId string, location *time.Location)
+}
+
+// SchedulerQueueIterationsWriter sets the maximum and the remaining number of runs of an interval in SchedulerQueue
+type SchedulerQueueIterationsWriter interface {
+	SetIntervalIterations(intervalId string, maxIterations int64, remaining int64)
+}
@@ -0,0 +1,58 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package interval
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/errors"
+)
+
+// ValidateMaxIterations checks the maximum number of runs of an interval, 0 for no limit. The error returned on a
+// negative number is an ErrInvalidMaxIterations.
+func ValidateMaxIterations(maxIterations int64) error {
+	if maxIterations < 0 {
+		return errors.NewErrInvalidMaxIterations(maxIterations)
+	}
+	return nil
+}
+
+type MaxIterationsExecutor interface {
+	Execute() error
+}
+
+type intervalMaxIterations struct {
+	database      IntervalIterationsWriter
+	scClient      SchedulerQueueIterationsWriter
+	id            string
+	maxIterations int64
+}
+
+// Execute stores the maximum number of runs of the interval and restarts the count of its runs.
+func (op intervalMaxIterations) Execute() error {
+	if err := ValidateMaxIterations(op.maxIterations); err != nil {
+		return err
+	}
+	if err := op.database.SetIntervalMaxIterations(op.id, op.maxIterations); err != nil {
+		return err
+	}
+	op.scClient.SetIntervalIterations(op.id, op.maxIterations, -1)
+	return nil
+}
+
+// NewMaxIterationsExecutor returns an executor setting the maximum number of runs of the interval with the id, after
+// which the interval completes. The runs are counted from then on, and a maximum of 0 removes the limit.
+func NewMaxIterationsExecutor(
+	database IntervalIterationsWriter,
+	scClient SchedulerQueueIterationsWriter,
+	id string,
+	maxIterations int64) MaxIterationsExecutor {
+
+	return intervalMaxIterations{
+		database:      database,
+		scClient:      scClient,
+		id:            id,
+		maxIterations: maxIterations,
+	}
+}
@@ -0,0 +1,52 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package interval
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/errors"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/operators/interval/mocks"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateMaxIterations(t *testing.T) {
+	assert.NoError(t, ValidateMaxIterations(0))
+	assert.NoError(t, ValidateMaxIterations(3))
+	assert.IsType(t, errors.ErrInvalidMaxIterations{}, ValidateMaxIterations(-1))
+}
+
+func TestMaxIterationsExecutor(t *testing.T) {
+	tests := []struct {
+		name          string
+		maxIterations int64
+		dbErr         error
+		expectedError bool
+	}{
+		{"Limited", 3, nil, false},
+		{"Unlimited", 0, nil, false},
+		{"Negative", -1, nil, true},
+		{"Database error", 3, Error, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			database := &mocks.IntervalIterationsWriter{}
+			database.On("SetIntervalMaxIterations", ValidInterval.ID, tt.maxIterations).Return(tt.dbErr)
+			scClient := &mocks.SchedulerQueueIterationsWriter{}
+			scClient.On("SetIntervalIterations", ValidInterval.ID, tt.maxIterations, int64(-1)).Return()
+
+			err := NewMaxIterationsExecutor(database, scClient, ValidInterval.ID, tt.maxIterations).Execute()
+			assert.Equal(t, tt.expectedError, err != nil)
+			if tt.expectedError {
+				scClient.AssertNotCalled(t, "SetIntervalIterations", ValidInterval.ID, tt.maxIterations, int64(-1))
+				return
+			}
+			database.AssertExpectations(t)
+			scClient.AssertExpectations(t)
+		})
+	}
+}
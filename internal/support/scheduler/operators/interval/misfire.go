@@ -0,0 +1,76 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package interval
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/errors"
+)
+
+// Misfire policies, deciding what becomes of the runs of an interval missed while the scheduler was down
+const (
+	// MisfireSkip skips the missed runs, the default.
+	MisfireSkip = "skip"
+	// MisfireRunOnceNow runs the interval once on startup when any run was missed.
+	MisfireRunOnceNow = "runOnceNow"
+	// MisfireRunAllMissed runs the interval on startup as many times as runs were missed.
+	MisfireRunAllMissed = "runAllMissed"
+)
+
+// ValidateMisfirePolicy checks the misfire policy of an interval, skip when empty. The error returned on an unknown
+// policy is an ErrInvalidMisfirePolicy.
+func ValidateMisfirePolicy(policy string) error {
+	switch policy {
+	case "", MisfireSkip, MisfireRunOnceNow, MisfireRunAllMissed:
+		return nil
+	}
+	return errors.NewErrInvalidMisfirePolicy(policy)
+}
+
+// MisfirePolicy returns the misfire policy of the interval with the id, empty when it skips its missed runs, as it
+// does when the database doesn't support misfire policies.
+func MisfirePolicy(database IntervalMisfirePolicyLoader, id string) (string, error) {
+	policies, err := database.IntervalMisfirePolicies()
+	if err == db.ErrUnsupportedDatabase {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return policies[id], nil
+}
+
+type MisfirePolicyExecutor interface {
+	Execute() error
+}
+
+type intervalMisfirePolicy struct {
+	database IntervalMisfirePolicyWriter
+	id       string
+	policy   string
+}
+
+// Execute stores the misfire policy of the interval, which applies the next time the scheduler starts.
+func (op intervalMisfirePolicy) Execute() error {
+	if err := ValidateMisfirePolicy(op.policy); err != nil {
+		return err
+	}
+	policy := op.policy
+	if policy == MisfireSkip {
+		policy = ""
+	}
+	return op.database.SetIntervalMisfirePolicy(op.id, policy)
+}
+
+// NewMisfirePolicyExecutor returns an executor setting the misfire policy of the interval with the id. An empty policy
+// resets it to skip.
+func NewMisfirePolicyExecutor(database IntervalMisfirePolicyWriter, id string, policy string) MisfirePolicyExecutor {
+	return intervalMisfirePolicy{
+		database: database,
+		id:       id,
+		policy:   policy,
+	}
+}
@@ -0,0 +1,78 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package interval
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/errors"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/operators/interval/mocks"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateMisfirePolicy(t *testing.T) {
+	for _, policy := range []string{"", MisfireSkip, MisfireRunOnceNow, MisfireRunAllMissed} {
+		assert.NoError(t, ValidateMisfirePolicy(policy), policy)
+	}
+	assert.IsType(t, errors.ErrInvalidMisfirePolicy{}, ValidateMisfirePolicy("runTwice"))
+}
+
+func TestMisfirePolicy(t *testing.T) {
+	tests := []struct {
+		name           string
+		policies       map[string]string
+		err            error
+		expectedPolicy string
+		expectedError  bool
+	}{
+		{"Catching up", map[string]string{ValidInterval.ID: MisfireRunAllMissed}, nil, MisfireRunAllMissed, false},
+		{"Skipping", map[string]string{}, nil, "", false},
+		{"Unsupported database", nil, db.ErrUnsupportedDatabase, "", false},
+		{"Database error", nil, Error, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loader := &mocks.IntervalMisfirePolicyLoader{}
+			loader.On("IntervalMisfirePolicies").Return(tt.policies, tt.err)
+
+			policy, err := MisfirePolicy(loader, ValidInterval.ID)
+			assert.Equal(t, tt.expectedError, err != nil)
+			assert.Equal(t, tt.expectedPolicy, policy)
+		})
+	}
+}
+
+func TestMisfirePolicyExecutor(t *testing.T) {
+	tests := []struct {
+		name           string
+		policy         string
+		dbErr          error
+		expectedStored string
+		expectedError  bool
+	}{
+		{"Run once now", MisfireRunOnceNow, nil, MisfireRunOnceNow, false},
+		{"Skip", MisfireSkip, nil, "", false},
+		{"Default", "", nil, "", false},
+		{"Invalid policy", "runTwice", nil, "", true},
+		{"Database error", MisfireRunAllMissed, Error, MisfireRunAllMissed, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			database := &mocks.IntervalMisfirePolicyWriter{}
+			database.On("SetIntervalMisfirePolicy", ValidInterval.ID, tt.expectedStored).Return(tt.dbErr)
+
+			err := NewMisfirePolicyExecutor(database, ValidInterval.ID, tt.policy).Execute()
+			assert.Equal(t, tt.expectedError, err != nil)
+			if tt.expectedError && tt.dbErr == nil {
+				database.AssertNotCalled(t, "SetIntervalMisfirePolicy")
+				return
+			}
+			database.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package interval
+
+import (
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/errors"
+)
+
+// LoadTimezone returns the location of the IANA zone name of an interval, e.g. 'Europe/Paris', nil for UTC when the
+// name is empty. The error returned on an unknown zone is an ErrInvalidTimezone.
+func LoadTimezone(timezone string) (*time.Location, error) {
+	if timezone == "" {
+		return nil, nil
+	}
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, errors.NewErrInvalidTimezone(timezone, err)
+	}
+	return location, nil
+}
+
+// Timezone returns the timezone of the interval with the id, empty when it is scheduled in UTC, as it is when the
+// database doesn't support timezones.
+func Timezone(database IntervalTimezoneLoader, id string) (string, error) {
+	timezones, err := database.IntervalTimezones()
+	if err == db.ErrUnsupportedDatabase {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return timezones[id], nil
+}
+
+type TimezoneExecutor interface {
+	Execute() error
+}
+
+type intervalTimezone struct {
+	database IntervalTimezoneWriter
+	scClient SchedulerQueueTimezoneWriter
+	id       string
+	timezone string
+}
+
+// Execute stores the timezone of the interval and reschedules the interval in it.
+func (op intervalTimezone) Execute() error {
+	location, err := LoadTimezone(op.timezone)
+	if err != nil {
+		return err
+	}
+	if err = op.database.SetIntervalTimezone(op.id, op.timezone); err != nil {
+		return err
+	}
+	op.scClient.SetIntervalTimezone(op.id, location)
+	return nil
+}
+
+// NewTimezoneExecutor returns an executor setting the timezone of the interval with the id, its start and end times
+// and its cron expression being evaluated in the zone rather than in UTC. An empty timezone resets it to UTC.
+func NewTimezoneExecutor(
+	database IntervalTimezoneWriter,
+	scClient SchedulerQueueTimezoneWriter,
+	id string,
+	timezone string) TimezoneExecutor {
+
+	return intervalTimezone{
+		database: database,
+		scClient: scClient,
+		id:       id,
+		timezone: timezone,
+	}
+}
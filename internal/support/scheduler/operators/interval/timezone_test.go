@@ -0,0 +1,96 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package interval
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/errors"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/operators/interval/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const testTimezone = "Europe/Paris"
+
+func TestLoadTimezone(t *testing.T) {
+	location, err := LoadTimezone(testTimezone)
+	assert.NoError(t, err)
+	assert.Equal(t, testTimezone, location.String())
+
+	location, err = LoadTimezone("")
+	assert.NoError(t, err)
+	assert.Nil(t, location)
+
+	_, err = LoadTimezone("Europe/Atlantis")
+	assert.IsType(t, errors.ErrInvalidTimezone{}, err)
+}
+
+func TestTimezone(t *testing.T) {
+	tests := []struct {
+		name             string
+		timezones        map[string]string
+		err              error
+		expectedTimezone string
+		expectedError    bool
+	}{
+		{"In a timezone", map[string]string{ValidInterval.ID: testTimezone}, nil, testTimezone, false},
+		{"In UTC", map[string]string{}, nil, "", false},
+		{"Unsupported database", nil, db.ErrUnsupportedDatabase, "", false},
+		{"Database error", nil, Error, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loader := &mocks.IntervalTimezoneLoader{}
+			loader.On("IntervalTimezones").Return(tt.timezones, tt.err)
+
+			timezone, err := Timezone(loader, ValidInterval.ID)
+			assert.Equal(t, tt.expectedError, err != nil)
+			assert.Equal(t, tt.expectedTimezone, timezone)
+		})
+	}
+}
+
+func TestTimezoneExecutor(t *testing.T) {
+	tests := []struct {
+		name             string
+		timezone         string
+		dbErr            error
+		expectedLocation string
+		expectedError    bool
+	}{
+		{"Named timezone", testTimezone, nil, testTimezone, false},
+		{"UTC", "", nil, "", false},
+		{"Invalid timezone", "Europe/Atlantis", nil, "", true},
+		{"Database error", testTimezone, Error, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			database := &mocks.IntervalTimezoneWriter{}
+			database.On("SetIntervalTimezone", ValidInterval.ID, tt.timezone).Return(tt.dbErr)
+			scClient := &mocks.SchedulerQueueTimezoneWriter{}
+			scClient.On("SetIntervalTimezone", ValidInterval.ID, mock.Anything).Return()
+
+			err := NewTimezoneExecutor(database, scClient, ValidInterval.ID, tt.timezone).Execute()
+			if tt.expectedError {
+				assert.Error(t, err)
+				scClient.AssertNotCalled(t, "SetIntervalTimezone", ValidInterval.ID, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			database.AssertExpectations(t)
+			location := scClient.Calls[0].Arguments.Get(1).(*time.Location)
+			if tt.expectedLocation == "" {
+				assert.Nil(t, location)
+			} else {
+				assert.Equal(t, tt.expectedLocation, location.String())
+			}
+		})
+	}
+}
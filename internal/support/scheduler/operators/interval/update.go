@@ -16,7 +16,6 @@ package interval
 import (
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/errors"
-	"github.com/robfig/cron"
 
 	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
 )
@@ -43,8 +42,8 @@ func (op intervalUpdate) Execute() error {
 	}
 	// Update the fields
 	if op.interval.Cron != "" {
-		if _, err := cron.Parse(op.interval.Cron); err != nil {
-			return errors.NewErrInvalidCronFormat(op.interval.Cron)
+		if op.interval.Cron, err = NormalizeCron(op.interval.Cron); err != nil {
+			return err
 		}
 		to.Cron = op.interval.Cron
 	}
@@ -14,6 +14,7 @@
 package interval
 
 import (
+	"errors"
 	"testing"
 
 	intervalErrors "github.com/edgexfoundry/edgex-go/internal/support/scheduler/errors"
@@ -23,6 +24,10 @@ import (
 
 var TestInvalidCron = "invalid"
 var TestValidCron = "* * * ? * *"
+var TestStandardCron = "30 2 * * MON-FRI"
+var ErrTestInvalidCron = intervalErrors.NewErrInvalidCronFormat(
+	TestInvalidCron,
+	errors.New("Expected exactly 5 fields, found 1: invalid"))
 
 var IntervalHasInvalidCron = contract.Interval{
 
@@ -123,7 +128,7 @@ func TestUpdateExecutor(t *testing.T) {
 			scClient:         createMockIntervalUpdaterSCSuccess(SuccessfulDatabaseResult[0]),
 			interval:         IntervalHasInvalidCron,
 			expectedError:    true,
-			expectedErrorVal: intervalErrors.NewErrInvalidCronFormat(TestInvalidCron),
+			expectedErrorVal: ErrTestInvalidCron,
 		},
 		{
 			name:             "Cron is valid",
@@ -133,6 +138,14 @@ func TestUpdateExecutor(t *testing.T) {
 			expectedError:    false,
 			expectedErrorVal: nil,
 		},
+		{
+			name:             "Cron of 5 fields stored with its second",
+			dbMock:           createMockIntervalUpdaterCronNormalized(),
+			scClient:         createMockIntervalUpdaterSCSuccess(intervalWithCron("0 " + TestStandardCron)),
+			interval:         intervalWithCron(TestStandardCron),
+			expectedError:    false,
+			expectedErrorVal: nil,
+		},
 
 		{
 			name:             "Unexpected error in UpdateIntervalInQueue",
@@ -239,6 +252,19 @@ func createMockIntervalUpdaterCronValid() IntervalUpdater {
 	return &dbMock
 }
 
+func intervalWithCron(cron string) contract.Interval {
+	interval := IntervalHasValidCron
+	interval.Cron = cron
+	return interval
+}
+
+func createMockIntervalUpdaterCronNormalized() IntervalUpdater {
+	dbMock := mocks.IntervalUpdater{}
+	dbMock.On("IntervalById", Id).Return(IntervalHasValidCron, nil)
+	dbMock.On("UpdateInterval", intervalWithCron("0 "+TestStandardCron)).Return(nil)
+	return &dbMock
+}
+
 func createMockIntervalUpdaterNotFoundErr() IntervalUpdater {
 	dbMock := mocks.IntervalUpdater{}
 	dbMock.On("IntervalById", Id).Return(contract.Interval{}, ErrorNotFound)
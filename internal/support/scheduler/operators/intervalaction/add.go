@@ -15,6 +15,7 @@ package intervalaction
 
 import (
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/errors"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
 	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
 )
 
@@ -60,6 +61,9 @@ func addNewIntervalAction(iaa intervalActionAdd) (string, error) {
 	if target == "" {
 		return "", errors.NewErrIntervalActionTargetNameRequired(iaa.intervalAction.ID)
 	}
+	if schedulerModels.IsMessageBusAction(iaa.intervalAction) && iaa.intervalAction.Topic == "" {
+		return "", errors.NewErrIntervalActionTopicRequired(name)
+	}
 
 	// Validate the Interval
 	interval := iaa.intervalAction.Interval
@@ -24,6 +24,14 @@ import (
 
 //var InvalidFreqInterval = SuccessfulIntervalActionResult[4]
 
+var MessageBusIntervalActionNoTopic = contract.IntervalAction{
+	Name:       "publish-trigger",
+	Interval:   Intervals[0].Name,
+	Target:     "app-service",
+	Protocol:   "MESSAGEBUS",
+	Parameters: "{}",
+}
+
 func TestAddExecutor(t *testing.T) {
 
 	tests := []struct {
@@ -71,6 +79,15 @@ func TestAddExecutor(t *testing.T) {
 			expectedError:    true,
 			expectedErrorVal: intervalErrors.NewErrIntervalActionTargetNameRequired(InvalidIntervalAction.ID),
 		},
+		{
+			name:             "Error No Topic",
+			mockDb:           createAddMockIntervalActionTopicErr(),
+			scClient:         createAddMockIntervalSCSuccess(),
+			intervalAction:   MessageBusIntervalActionNoTopic,
+			expectedResult:   "",
+			expectedError:    true,
+			expectedErrorVal: intervalErrors.NewErrIntervalActionTopicRequired(MessageBusIntervalActionNoTopic.Name),
+		},
 		{
 			name:             "Error No Interval",
 			mockDb:           createAddMockIntervalActionNoIntervalErr(),
@@ -177,6 +194,12 @@ func createAddMockIntervalActionTargetErr() IntervalActionWriter {
 	return &dbMock
 }
 
+func createAddMockIntervalActionTopicErr() IntervalActionWriter {
+	dbMock := mocks.IntervalActionWriter{}
+	dbMock.On("IntervalActionByName", MessageBusIntervalActionNoTopic.Name).Return(OtherValidIntervalAction, nil)
+	return &dbMock
+}
+
 func createAddMockIntervalActionNoIntervalErr() IntervalActionWriter {
 	dbMock := mocks.IntervalActionWriter{}
 	dbMock.On("IntervalActionByName", IntervalActionNoInterval.Name).Return(OtherValidIntervalAction, nil)
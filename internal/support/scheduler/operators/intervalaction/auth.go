@@ -0,0 +1,62 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package intervalaction
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/errors"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+// ValidateAuthHeaders checks the secret-backed headers of the interval action by name. The error returned on invalid
+// headers is an ErrInvalidAuthHeaders.
+func ValidateAuthHeaders(name string, headers []schedulerModels.AuthHeader) error {
+	if err := schedulerModels.ValidateAuthHeaders(headers); err != nil {
+		return errors.NewErrInvalidAuthHeaders(name, err.Error())
+	}
+	return nil
+}
+
+type AuthHeadersExecutor interface {
+	Execute() error
+}
+
+type intervalActionAuthHeaders struct {
+	database IntervalActionAuthHeadersWriter
+	scClient SchedulerQueueAuthHeadersWriter
+	id       string
+	name     string
+	headers  []schedulerModels.AuthHeader
+}
+
+// Execute stores the secret-backed headers of the interval action and injects them from its next execution on.
+func (op intervalActionAuthHeaders) Execute() error {
+	if err := ValidateAuthHeaders(op.name, op.headers); err != nil {
+		return err
+	}
+	if err := op.database.SetIntervalActionAuthHeaders(op.id, op.headers); err != nil {
+		return err
+	}
+	op.scClient.SetIntervalActionAuthHeaders(op.id, op.headers)
+	return nil
+}
+
+// NewAuthHeadersExecutor returns an executor setting the headers injected from the secret store into the requests of
+// the interval action with the id and name. No header removes them.
+func NewAuthHeadersExecutor(
+	database IntervalActionAuthHeadersWriter,
+	scClient SchedulerQueueAuthHeadersWriter,
+	id string,
+	name string,
+	headers []schedulerModels.AuthHeader) AuthHeadersExecutor {
+
+	return intervalActionAuthHeaders{
+		database: database,
+		scClient: scClient,
+		id:       id,
+		name:     name,
+		headers:  headers,
+	}
+}
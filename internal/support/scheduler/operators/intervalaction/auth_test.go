@@ -0,0 +1,64 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package intervalaction
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/errors"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/operators/intervalaction/mocks"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateAuthHeaders(t *testing.T) {
+	assert.NoError(t, ValidateAuthHeaders("action", nil))
+	assert.NoError(t, ValidateAuthHeaders("action", []schedulerModels.AuthHeader{
+		{SecretPath: "scheduler/export", Scheme: schedulerModels.AuthSchemeBearer},
+		{SecretPath: "scheduler/export-key", Header: "X-Api-Key"},
+	}))
+
+	for _, headers := range [][]schedulerModels.AuthHeader{
+		{{Header: "X-Api-Key"}},
+		{{SecretPath: "scheduler/export", Header: "X Api Key"}},
+		{{SecretPath: "scheduler/export", Scheme: "Bearer:"}},
+		{{SecretPath: "scheduler/export"}, {SecretPath: "scheduler/other", Header: "authorization"}},
+	} {
+		assert.IsType(t, errors.ErrInvalidAuthHeaders{}, ValidateAuthHeaders("action", headers), headers)
+	}
+}
+
+func TestAuthHeadersExecutor(t *testing.T) {
+	tests := []struct {
+		name          string
+		headers       []schedulerModels.AuthHeader
+		dbErr         error
+		expectedError bool
+	}{
+		{"Headers", []schedulerModels.AuthHeader{{SecretPath: "scheduler/export", Scheme: "Bearer"}}, nil, false},
+		{"No header", nil, nil, false},
+		{"Invalid headers", []schedulerModels.AuthHeader{{Scheme: "Bearer"}}, nil, true},
+		{"Database error", []schedulerModels.AuthHeader{{SecretPath: "scheduler/export"}}, Error, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			database := &mocks.IntervalActionAuthHeadersWriter{}
+			database.On("SetIntervalActionAuthHeaders", ValidIntervalAction.ID, tt.headers).Return(tt.dbErr)
+			scClient := &mocks.SchedulerQueueAuthHeadersWriter{}
+			scClient.On("SetIntervalActionAuthHeaders", ValidIntervalAction.ID, tt.headers).Return()
+
+			err := NewAuthHeadersExecutor(database, scClient, ValidIntervalAction.ID, ValidIntervalAction.Name, tt.headers).Execute()
+			assert.Equal(t, tt.expectedError, err != nil)
+			if tt.expectedError {
+				scClient.AssertNotCalled(t, "SetIntervalActionAuthHeaders", ValidIntervalAction.ID, tt.headers)
+				return
+			}
+			database.AssertExpectations(t)
+			scClient.AssertExpectations(t)
+		})
+	}
+}
@@ -14,6 +14,7 @@
 package intervalaction
 
 import (
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/operators/interval"
 	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
 )
@@ -33,6 +34,21 @@ type IntervalActionWriter interface {
 	IntervalActionLoader
 }
 
+// IntervalActionPolicyWriter stores the execution policy of an interval action.
+type IntervalActionPolicyWriter interface {
+	SetIntervalActionPolicy(id string, policy schedulerModels.ExecutionPolicy) error
+}
+
+// IntervalActionDependencyWriter stores the runAfter dependencies of an interval action.
+type IntervalActionDependencyWriter interface {
+	SetIntervalActionDependencies(id string, runAfter []string) error
+}
+
+// IntervalActionAuthHeadersWriter stores the secret-backed headers of an interval action.
+type IntervalActionAuthHeadersWriter interface {
+	SetIntervalActionAuthHeaders(id string, headers []schedulerModels.AuthHeader) error
+}
+
 type SchedulerQueueLoader interface {
 	QueryIntervalActionByID(intervalActionId string) (contract.IntervalAction, error)
 	QueryIntervalActionByName(intervalActionName string) (contract.IntervalAction, error)
@@ -43,3 +59,25 @@ type SchedulerQueueWriter interface {
 	AddIntervalActionToQueue(interval contract.IntervalAction) error
 	SchedulerQueueLoader
 }
+
+// SchedulerQueueDependencyLoader provides the runAfter dependencies of the interval actions in SchedulerQueue
+type SchedulerQueueDependencyLoader interface {
+	QueryIntervalActionByName(intervalActionName string) (contract.IntervalAction, error)
+	QueryIntervalActionDependencies(intervalName string) map[string][]string
+}
+
+// SchedulerQueueDependencyWriter sets the runAfter dependencies of an interval action in SchedulerQueue
+type SchedulerQueueDependencyWriter interface {
+	SetIntervalActionDependencies(intervalActionId string, runAfter []string)
+	SchedulerQueueDependencyLoader
+}
+
+// SchedulerQueuePolicyWriter sets the execution policy of an interval action in SchedulerQueue
+type SchedulerQueuePolicyWriter interface {
+	SetIntervalActionPolicy(intervalActionId string, policy schedulerModels.ExecutionPolicy)
+}
+
+// SchedulerQueueAuthHeadersWriter sets the secret-backed headers of an interval action in SchedulerQueue
+type SchedulerQueueAuthHeadersWriter interface {
+	SetIntervalActionAuthHeaders(intervalActionId string, headers []schedulerModels.AuthHeader)
+}
@@ -0,0 +1,83 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package intervalaction
+
+import (
+	"fmt"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/errors"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+// ValidateDependencies checks that the interval actions the interval action by name runs after are other actions of
+// its interval, and that the dependencies of the interval's actions don't form a cycle once the action's are set. The
+// error returned on invalid dependencies is an ErrInvalidIntervalActionDependencies.
+func ValidateDependencies(scClient SchedulerQueueDependencyLoader, name string, interval string, runAfter []string) error {
+	if len(runAfter) == 0 {
+		return nil
+	}
+	for _, upstream := range runAfter {
+		action, err := scClient.QueryIntervalActionByName(upstream)
+		if upstream == name || err != nil || action.Interval != interval {
+			return errors.NewErrInvalidIntervalActionDependencies(
+				name,
+				fmt.Sprintf("%s is not another intervalAction of the interval %s", upstream, interval))
+		}
+	}
+
+	dependencies := scClient.QueryIntervalActionDependencies(interval)
+	dependencies[name] = runAfter
+	if _, err := schedulerModels.OrderChain(dependencies); err != nil {
+		return errors.NewErrInvalidIntervalActionDependencies(name, err.Error())
+	}
+	return nil
+}
+
+type DependenciesExecutor interface {
+	Execute() error
+}
+
+type intervalActionDependencies struct {
+	database IntervalActionDependencyWriter
+	scClient SchedulerQueueDependencyWriter
+	id       string
+	name     string
+	interval string
+	runAfter []string
+}
+
+// Execute stores the runAfter dependencies of the interval action and applies them from the next run of its interval
+// on.
+func (op intervalActionDependencies) Execute() error {
+	if err := ValidateDependencies(op.scClient, op.name, op.interval, op.runAfter); err != nil {
+		return err
+	}
+	if err := op.database.SetIntervalActionDependencies(op.id, op.runAfter); err != nil {
+		return err
+	}
+	op.scClient.SetIntervalActionDependencies(op.id, op.runAfter)
+	return nil
+}
+
+// NewDependenciesExecutor returns an executor setting the interval actions, by name, the interval action with the id
+// and name runs after within a run of its interval. No dependency removes the action from the chain of its interval.
+func NewDependenciesExecutor(
+	database IntervalActionDependencyWriter,
+	scClient SchedulerQueueDependencyWriter,
+	id string,
+	name string,
+	interval string,
+	runAfter []string) DependenciesExecutor {
+
+	return intervalActionDependencies{
+		database: database,
+		scClient: scClient,
+		id:       id,
+		name:     name,
+		interval: interval,
+		runAfter: runAfter,
+	}
+}
@@ -0,0 +1,74 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package intervalaction
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/errors"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/operators/intervalaction/mocks"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDependenciesExecutor(t *testing.T) {
+	upstream := OtherValidIntervalAction
+	elsewhere := contract.IntervalAction{Name: "elsewhere", Interval: "daily"}
+
+	tests := []struct {
+		name          string
+		runAfter      []string
+		dependencies  map[string][]string
+		dbErr         error
+		expectedError error
+	}{
+		{"Dependencies", []string{upstream.Name}, map[string][]string{}, nil, nil},
+		{"No dependency", nil, map[string][]string{}, nil, nil},
+		{"Itself", []string{ValidIntervalAction.Name}, map[string][]string{}, nil, errors.ErrInvalidIntervalActionDependencies{}},
+		{"Unknown action", []string{"unknown"}, map[string][]string{}, nil, errors.ErrInvalidIntervalActionDependencies{}},
+		{"Action of another interval", []string{elsewhere.Name}, map[string][]string{}, nil, errors.ErrInvalidIntervalActionDependencies{}},
+		{
+			"Cycle",
+			[]string{upstream.Name},
+			map[string][]string{upstream.Name: {ValidIntervalAction.Name}},
+			nil,
+			errors.ErrInvalidIntervalActionDependencies{},
+		},
+		{"Database error", []string{upstream.Name}, map[string][]string{}, Error, Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			database := &mocks.IntervalActionDependencyWriter{}
+			database.On("SetIntervalActionDependencies", ValidIntervalAction.ID, tt.runAfter).Return(tt.dbErr)
+			scClient := &mocks.SchedulerQueueDependencyWriter{}
+			scClient.On("QueryIntervalActionByName", upstream.Name).Return(upstream, nil)
+			scClient.On("QueryIntervalActionByName", ValidIntervalAction.Name).Return(ValidIntervalAction, nil)
+			scClient.On("QueryIntervalActionByName", elsewhere.Name).Return(elsewhere, nil)
+			scClient.On("QueryIntervalActionByName", mock.Anything).Return(contract.IntervalAction{}, ErrorNotFound)
+			scClient.On("QueryIntervalActionDependencies", ValidIntervalAction.Interval).Return(tt.dependencies)
+			scClient.On("SetIntervalActionDependencies", ValidIntervalAction.ID, tt.runAfter).Return()
+
+			err := NewDependenciesExecutor(
+				database,
+				scClient,
+				ValidIntervalAction.ID,
+				ValidIntervalAction.Name,
+				ValidIntervalAction.Interval,
+				tt.runAfter).Execute()
+			if tt.expectedError != nil {
+				assert.IsType(t, tt.expectedError, err)
+				scClient.AssertNotCalled(t, "SetIntervalActionDependencies", ValidIntervalAction.ID, tt.runAfter)
+				return
+			}
+			assert.NoError(t, err)
+			database.AssertExpectations(t)
+			scClient.AssertCalled(t, "SetIntervalActionDependencies", ValidIntervalAction.ID, tt.runAfter)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package intervalaction
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/errors"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+// ValidateExecutionPolicy checks the execution policy of the interval action by name. The error returned on an invalid
+// policy is an ErrInvalidExecutionPolicy.
+func ValidateExecutionPolicy(name string, policy schedulerModels.ExecutionPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return errors.NewErrInvalidExecutionPolicy(name, err.Error())
+	}
+	return nil
+}
+
+type ExecutionPolicyExecutor interface {
+	Execute() error
+}
+
+type intervalActionPolicy struct {
+	database IntervalActionPolicyWriter
+	scClient SchedulerQueuePolicyWriter
+	id       string
+	name     string
+	policy   schedulerModels.ExecutionPolicy
+}
+
+// Execute stores the execution policy of the interval action and applies it from its next execution on.
+func (op intervalActionPolicy) Execute() error {
+	if err := ValidateExecutionPolicy(op.name, op.policy); err != nil {
+		return err
+	}
+	if err := op.database.SetIntervalActionPolicy(op.id, op.policy); err != nil {
+		return err
+	}
+	op.scClient.SetIntervalActionPolicy(op.id, op.policy)
+	return nil
+}
+
+// NewExecutionPolicyExecutor returns an executor setting the timeout, the retries and the jitter the interval action
+// with the id and name is executed with. The default policy executes the action once, right away, with the service
+// timeout.
+func NewExecutionPolicyExecutor(
+	database IntervalActionPolicyWriter,
+	scClient SchedulerQueuePolicyWriter,
+	id string,
+	name string,
+	policy schedulerModels.ExecutionPolicy) ExecutionPolicyExecutor {
+
+	return intervalActionPolicy{
+		database: database,
+		scClient: scClient,
+		id:       id,
+		name:     name,
+		policy:   policy,
+	}
+}
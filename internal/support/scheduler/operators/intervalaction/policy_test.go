@@ -0,0 +1,66 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package intervalaction
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/errors"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/operators/intervalaction/mocks"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateExecutionPolicy(t *testing.T) {
+	assert.NoError(t, ValidateExecutionPolicy("action", schedulerModels.ExecutionPolicy{}))
+	assert.NoError(t, ValidateExecutionPolicy("action", schedulerModels.ExecutionPolicy{
+		Timeout:      "2s",
+		Retries:      3,
+		RetryBackoff: "500ms",
+		Jitter:       "10s",
+	}))
+
+	for _, policy := range []schedulerModels.ExecutionPolicy{
+		{Retries: -1},
+		{Timeout: "soon"},
+		{RetryBackoff: "-1s"},
+		{Jitter: "0s"},
+	} {
+		assert.IsType(t, errors.ErrInvalidExecutionPolicy{}, ValidateExecutionPolicy("action", policy), policy)
+	}
+}
+
+func TestExecutionPolicyExecutor(t *testing.T) {
+	tests := []struct {
+		name          string
+		policy        schedulerModels.ExecutionPolicy
+		dbErr         error
+		expectedError bool
+	}{
+		{"Policy", schedulerModels.ExecutionPolicy{Timeout: "2s", Retries: 3}, nil, false},
+		{"Default policy", schedulerModels.ExecutionPolicy{}, nil, false},
+		{"Invalid policy", schedulerModels.ExecutionPolicy{Retries: -1}, nil, true},
+		{"Database error", schedulerModels.ExecutionPolicy{Retries: 3}, Error, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			database := &mocks.IntervalActionPolicyWriter{}
+			database.On("SetIntervalActionPolicy", ValidIntervalAction.ID, tt.policy).Return(tt.dbErr)
+			scClient := &mocks.SchedulerQueuePolicyWriter{}
+			scClient.On("SetIntervalActionPolicy", ValidIntervalAction.ID, tt.policy).Return()
+
+			err := NewExecutionPolicyExecutor(database, scClient, ValidIntervalAction.ID, ValidIntervalAction.Name, tt.policy).Execute()
+			assert.Equal(t, tt.expectedError, err != nil)
+			if tt.expectedError {
+				scClient.AssertNotCalled(t, "SetIntervalActionPolicy", ValidIntervalAction.ID, tt.policy)
+				return
+			}
+			database.AssertExpectations(t)
+			scClient.AssertExpectations(t)
+		})
+	}
+}
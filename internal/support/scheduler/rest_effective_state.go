@@ -0,0 +1,146 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/interfaces"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+// effectiveState is the declarative state of the scheduler, in the canonical form emitted by the effective state
+// endpoint: no identifier, timestamp nor run count specific to the site, and every list sorted, so that GitOps
+// pipelines can diff it against the state kept under version control to detect drift.
+type effectiveState struct {
+	Intervals       []effectiveInterval       `json:"intervals"`
+	IntervalActions []effectiveIntervalAction `json:"intervalActions"`
+}
+
+// effectiveInterval is an interval along with its settings.
+type effectiveInterval struct {
+	Interval      models.Interval `json:"interval"`
+	Timezone      string          `json:"timezone,omitempty"`
+	MisfirePolicy string          `json:"misfirePolicy,omitempty"`
+	MaxIterations int64           `json:"maxIterations,omitempty"`
+}
+
+// effectiveIntervalAction is an interval action along with its execution policy, the actions it runs after and the
+// headers injected into its requests.
+type effectiveIntervalAction struct {
+	IntervalAction  models.IntervalAction            `json:"intervalAction"`
+	ExecutionPolicy *schedulerModels.ExecutionPolicy `json:"executionPolicy,omitempty"`
+	RunAfter        []string                         `json:"runAfter,omitempty"`
+	AuthHeaders     []schedulerModels.AuthHeader     `json:"authHeaders,omitempty"`
+}
+
+func restGetEffectiveState(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	state, err := readEffectiveState(dbClient)
+	if err != nil {
+		lc.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	pkg.EncodeCanonical(state, w, r, lc)
+}
+
+// readEffectiveState reads the intervals and interval actions along with their settings in their canonical form. The
+// settings the database doesn't support can't be configured and are left empty.
+func readEffectiveState(dbClient interfaces.DBClient) (effectiveState, error) {
+	intervals, err := dbClient.Intervals()
+	if err != nil {
+		return effectiveState{}, err
+	}
+	intervalActions, err := dbClient.IntervalActions()
+	if err != nil {
+		return effectiveState{}, err
+	}
+
+	timezones, err := dbClient.IntervalTimezones()
+	if err != nil && err != db.ErrUnsupportedDatabase {
+		return effectiveState{}, err
+	}
+	misfirePolicies, err := dbClient.IntervalMisfirePolicies()
+	if err != nil && err != db.ErrUnsupportedDatabase {
+		return effectiveState{}, err
+	}
+	maxIterations, err := dbClient.IntervalMaxIterations()
+	if err != nil && err != db.ErrUnsupportedDatabase {
+		return effectiveState{}, err
+	}
+	policies, err := dbClient.IntervalActionPolicies()
+	if err != nil && err != db.ErrUnsupportedDatabase {
+		return effectiveState{}, err
+	}
+	dependencies, err := dbClient.IntervalActionDependencies()
+	if err != nil && err != db.ErrUnsupportedDatabase {
+		return effectiveState{}, err
+	}
+	headers, err := dbClient.IntervalActionAuthHeaders()
+	if err != nil && err != db.ErrUnsupportedDatabase {
+		return effectiveState{}, err
+	}
+
+	state := effectiveState{
+		Intervals:       make([]effectiveInterval, len(intervals)),
+		IntervalActions: make([]effectiveIntervalAction, len(intervalActions)),
+	}
+	for i, interval := range intervals {
+		effective := effectiveInterval{
+			Timezone:      timezones[interval.ID],
+			MisfirePolicy: misfirePolicies[interval.ID],
+			MaxIterations: maxIterations[interval.ID],
+		}
+		interval.ID = ""
+		interval.Timestamps = models.Timestamps{}
+		effective.Interval = interval
+		state.Intervals[i] = effective
+	}
+	sort.Slice(state.Intervals, func(i, j int) bool {
+		return state.Intervals[i].Interval.Name < state.Intervals[j].Interval.Name
+	})
+
+	for i, action := range intervalActions {
+		effective := effectiveIntervalAction{}
+		if policy, exists := policies[action.ID]; exists && !policy.IsDefault() {
+			effective.ExecutionPolicy = &policy
+		}
+		if runAfter := dependencies[action.ID]; len(runAfter) > 0 {
+			effective.RunAfter = append([]string(nil), runAfter...)
+			sort.Strings(effective.RunAfter)
+		}
+		if actionHeaders := headers[action.ID]; len(actionHeaders) > 0 {
+			effective.AuthHeaders = append([]schedulerModels.AuthHeader(nil), actionHeaders...)
+			sort.Slice(effective.AuthHeaders, func(i, j int) bool {
+				return effective.AuthHeaders[i].Name() < effective.AuthHeaders[j].Name()
+			})
+		}
+		action.ID = ""
+		action.Created = 0
+		action.Modified = 0
+		effective.IntervalAction = action
+		state.IntervalActions[i] = effective
+	}
+	sort.Slice(state.IntervalActions, func(i, j int) bool {
+		return state.IntervalActions[i].IntervalAction.Name < state.IntervalActions[j].IntervalAction.Name
+	})
+	return state, nil
+}
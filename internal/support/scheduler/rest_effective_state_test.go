@@ -0,0 +1,97 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler
+
+import (
+	"encoding/json"
+	goErrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/interfaces/mocks"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockEffectiveStateLoader(intervalsErr error) *mocks.DBClient {
+	dbMock := &mocks.DBClient{}
+	dbMock.On("Intervals").Return([]contract.Interval{
+		{ID: "nightly-id", Name: "nightly", Frequency: "P1D", Timestamps: contract.Timestamps{Created: 1600000000000}},
+		{ID: "hourly-id", Name: "hourly", Frequency: "PT1H"},
+	}, intervalsErr)
+	dbMock.On("IntervalActions").Return([]contract.IntervalAction{
+		{ID: "scrub-id", Name: "scrub", Interval: "hourly", Target: "core-data"},
+		{ID: "export-id", Name: "export", Interval: "nightly", Target: "core-data"},
+	}, nil)
+	dbMock.On("IntervalTimezones").Return(map[string]string{"nightly-id": "Europe/Paris"}, nil)
+	dbMock.On("IntervalMisfirePolicies").Return(nil, db.ErrUnsupportedDatabase)
+	dbMock.On("IntervalMaxIterations").Return(map[string]int64{"hourly-id": 24}, nil)
+	dbMock.On("IntervalActionPolicies").Return(map[string]schedulerModels.ExecutionPolicy{
+		"export-id": {Retries: 3, RetryBackoff: "1m"},
+	}, nil)
+	dbMock.On("IntervalActionDependencies").Return(map[string][]string{"export-id": {"scrub", "compact"}}, nil)
+	dbMock.On("IntervalActionAuthHeaders").Return(map[string][]schedulerModels.AuthHeader{
+		"export-id": {
+			{SecretPath: "scheduler/export-key", Header: "X-Api-Key"},
+			{SecretPath: "scheduler/export", Scheme: schedulerModels.AuthSchemeBearer},
+		},
+	}, nil)
+	return dbMock
+}
+
+func TestGetEffectiveState(t *testing.T) {
+	uri := clients.ApiConfigRoute + "/" + EFFECTIVE
+	rr := httptest.NewRecorder()
+	restGetEffectiveState(rr, httptest.NewRequest(http.MethodGet, uri, nil), logger.NewMockClient(), createMockEffectiveStateLoader(nil))
+	require.Equal(t, http.StatusOK, rr.Code)
+	etag := rr.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+
+	var state effectiveState
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &state))
+	require.Len(t, state.Intervals, 2)
+	assert.Equal(t, "hourly", state.Intervals[0].Interval.Name, "the intervals should be sorted by name")
+	assert.Equal(t, int64(24), state.Intervals[0].MaxIterations)
+	assert.Empty(t, state.Intervals[0].Timezone)
+	nightly := state.Intervals[1]
+	assert.Empty(t, nightly.Interval.ID, "site specific ids should not be emitted")
+	assert.Zero(t, nightly.Interval.Timestamps.Created)
+	assert.Equal(t, "Europe/Paris", nightly.Timezone)
+	assert.Empty(t, nightly.MisfirePolicy, "the settings unsupported by the database should be left empty")
+
+	require.Len(t, state.IntervalActions, 2)
+	export := state.IntervalActions[0]
+	assert.Equal(t, "export", export.IntervalAction.Name, "the interval actions should be sorted by name")
+	assert.Empty(t, export.IntervalAction.ID)
+	require.NotNil(t, export.ExecutionPolicy)
+	assert.Equal(t, 3, export.ExecutionPolicy.Retries)
+	assert.Equal(t, []string{"compact", "scrub"}, export.RunAfter)
+	require.Len(t, export.AuthHeaders, 2)
+	assert.Equal(t, "Authorization", export.AuthHeaders[0].Name())
+	scrub := state.IntervalActions[1]
+	assert.Nil(t, scrub.ExecutionPolicy)
+	assert.Empty(t, scrub.RunAfter)
+	assert.Empty(t, scrub.AuthHeaders)
+
+	req := httptest.NewRequest(http.MethodGet, uri, nil)
+	req.Header.Set("If-None-Match", etag)
+	rr = httptest.NewRecorder()
+	restGetEffectiveState(rr, req, logger.NewMockClient(), createMockEffectiveStateLoader(nil))
+	assert.Equal(t, http.StatusNotModified, rr.Code)
+
+	rr = httptest.NewRecorder()
+	restGetEffectiveState(rr, httptest.NewRequest(http.MethodGet, uri, nil), logger.NewMockClient(),
+		createMockEffectiveStateLoader(goErrors.New("database unavailable")))
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+}
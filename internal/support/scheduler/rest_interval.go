@@ -16,6 +16,7 @@ package scheduler
 
 import (
 	"encoding/json"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -27,12 +28,114 @@ import (
 	"github.com/gorilla/mux"
 
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/errors"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/interfaces"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/operators/interval"
 )
 
+// intervalSettings are the timezone, the misfire policy and the maximum number of runs of an interval, given along with
+// the interval when it is added or updated. An absent setting is left unchanged, an empty timezone schedules the
+// interval in UTC, an empty misfire policy skips the runs missed while the scheduler was down and a maximum of 0 runs
+// removes the limit. Setting the maximum restarts the count of the runs.
+type intervalSettings struct {
+	Timezone      *string `json:"timezone"`
+	MisfirePolicy *string `json:"misfirePolicy"`
+	MaxIterations *int64  `json:"maxIterations"`
+}
+
+// validate checks the settings given along with an interval.
+func (settings intervalSettings) validate() error {
+	if settings.Timezone != nil {
+		if _, err := interval.LoadTimezone(*settings.Timezone); err != nil {
+			return err
+		}
+	}
+	if settings.MisfirePolicy != nil {
+		if err := interval.ValidateMisfirePolicy(*settings.MisfirePolicy); err != nil {
+			return err
+		}
+	}
+	if settings.MaxIterations != nil {
+		if err := interval.ValidateMaxIterations(*settings.MaxIterations); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeInterval decodes the interval in the body of the request along with its settings.
+func decodeInterval(r *http.Request) (models.Interval, intervalSettings, error) {
+	var from models.Interval
+	var settings intervalSettings
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err == nil {
+		err = json.Unmarshal(body, &from)
+	}
+	if err == nil {
+		err = json.Unmarshal(body, &settings)
+	}
+	return from, settings, err
+}
+
+// withSettings returns the intervals along with their timezones, misfire policies, and maximum and remaining number of
+// runs, the intervals scheduled in UTC which skip their missed runs and aren't limited being left as is.
+func withSettings(intervals []models.Interval, dbClient interfaces.DBClient) ([]interface{}, error) {
+	timezones, err := dbClient.IntervalTimezones()
+	if err != nil && err != db.ErrUnsupportedDatabase {
+		return nil, err
+	}
+	policies, err := dbClient.IntervalMisfirePolicies()
+	if err != nil && err != db.ErrUnsupportedDatabase {
+		return nil, err
+	}
+	maxIterations, err := dbClient.IntervalMaxIterations()
+	if err != nil && err != db.ErrUnsupportedDatabase {
+		return nil, err
+	}
+	remainingIterations, err := dbClient.IntervalRemainingIterations()
+	if err != nil && err != db.ErrUnsupportedDatabase {
+		return nil, err
+	}
+
+	results := make([]interface{}, len(intervals))
+	for i, result := range intervals {
+		results[i] = result
+		timezone, hasTimezone := timezones[result.ID]
+		policy, hasPolicy := policies[result.ID]
+		max, hasMax := maxIterations[result.ID]
+		remaining, hasRemaining := remainingIterations[result.ID]
+		if !hasTimezone && !hasPolicy && !hasMax && !hasRemaining {
+			continue
+		}
+		// the interval is marshaled on its own, then merged with its settings
+		data, err := json.Marshal(result)
+		if err != nil {
+			return nil, err
+		}
+		var fields map[string]interface{}
+		if err = json.Unmarshal(data, &fields); err != nil {
+			return nil, err
+		}
+		if hasTimezone {
+			fields["timezone"] = timezone
+		}
+		if hasPolicy {
+			fields["misfirePolicy"] = policy
+		}
+		if hasMax {
+			fields["maxIterations"] = max
+		}
+		if hasRemaining {
+			fields["remainingIterations"] = remaining
+		}
+		results[i] = fields
+	}
+	return results, nil
+}
+
 func restGetIntervals(
 	w http.ResponseWriter,
 	r *http.Request,
@@ -51,7 +154,13 @@ func restGetIntervals(
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	pkg.Encode(intervals, w, lc)
+	results, err := withSettings(intervals, dbClient)
+	if err != nil {
+		lc.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	pkg.Encode(results, w, lc)
 }
 
 func restUpdateInterval(
@@ -65,9 +174,7 @@ func restUpdateInterval(
 		defer r.Body.Close()
 	}
 
-	var from models.Interval
-	dec := json.NewDecoder(r.Body)
-	err := dec.Decode(&from)
+	from, settings, err := decodeInterval(r)
 
 	// Problem decoding
 	if err != nil {
@@ -75,6 +182,11 @@ func restUpdateInterval(
 		lc.Error("Error decoding the interval: " + err.Error())
 		return
 	}
+	if err = settings.validate(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error(err.Error())
+		return
+	}
 
 	lc.Info("Updating Interval: " + from.ID)
 	op := interval.NewUpdateExecutor(dbClient, scClient, from)
@@ -96,6 +208,40 @@ func restUpdateInterval(
 		return
 	}
 
+	if settings.Timezone != nil || settings.MisfirePolicy != nil || settings.MaxIterations != nil {
+		id := from.ID
+		if id == "" {
+			updated, err := interval.NewNameExecutor(dbClient, from.Name).Execute()
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusServiceUnavailable)
+				lc.Error(err.Error())
+				return
+			}
+			id = updated.ID
+		}
+		if settings.Timezone != nil {
+			if err = interval.NewTimezoneExecutor(dbClient, scClient, id, *settings.Timezone).Execute(); err != nil {
+				http.Error(w, err.Error(), http.StatusServiceUnavailable)
+				lc.Error(err.Error())
+				return
+			}
+		}
+		if settings.MisfirePolicy != nil {
+			if err = interval.NewMisfirePolicyExecutor(dbClient, id, *settings.MisfirePolicy).Execute(); err != nil {
+				http.Error(w, err.Error(), http.StatusServiceUnavailable)
+				lc.Error(err.Error())
+				return
+			}
+		}
+		if settings.MaxIterations != nil {
+			if err = interval.NewMaxIterationsExecutor(dbClient, scClient, id, *settings.MaxIterations).Execute(); err != nil {
+				http.Error(w, err.Error(), http.StatusServiceUnavailable)
+				lc.Error(err.Error())
+				return
+			}
+		}
+	}
+
 	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
 	w.Write([]byte("true"))
@@ -111,15 +257,18 @@ func restAddInterval(
 	if r.Body != nil {
 		defer r.Body.Close()
 	}
-	var intervalObj models.Interval
-	dec := json.NewDecoder(r.Body)
-	err := dec.Decode(&intervalObj)
+	intervalObj, settings, err := decodeInterval(r)
 
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		lc.Error("Error decoding interval" + err.Error())
 		return
 	}
+	if err = settings.validate(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error(err.Error())
+		return
+	}
 	lc.Info("Posting new Interval: " + intervalObj.String())
 
 	op := interval.NewAddExecutor(dbClient, scClient, intervalObj)
@@ -128,6 +277,8 @@ func restAddInterval(
 		switch t := err.(type) {
 		case errors.ErrIntervalNameInUse:
 			http.Error(w, t.Error(), http.StatusBadRequest)
+		case errors.ErrInvalidCronFormat:
+			http.Error(w, t.Error(), http.StatusBadRequest)
 		default:
 			http.Error(w, t.Error(), http.StatusInternalServerError)
 		}
@@ -135,6 +286,28 @@ func restAddInterval(
 		return
 	}
 
+	if settings.Timezone != nil && *settings.Timezone != "" {
+		if err = interval.NewTimezoneExecutor(dbClient, scClient, newId, *settings.Timezone).Execute(); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			lc.Error(err.Error())
+			return
+		}
+	}
+	if settings.MisfirePolicy != nil && *settings.MisfirePolicy != "" {
+		if err = interval.NewMisfirePolicyExecutor(dbClient, newId, *settings.MisfirePolicy).Execute(); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			lc.Error(err.Error())
+			return
+		}
+	}
+	if settings.MaxIterations != nil && *settings.MaxIterations != 0 {
+		if err = interval.NewMaxIterationsExecutor(dbClient, scClient, newId, *settings.MaxIterations).Execute(); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			lc.Error(err.Error())
+			return
+		}
+	}
+
 	w.WriteHeader(http.StatusOK)
 	w.Write([]byte(newId))
 }
@@ -171,7 +344,13 @@ func restGetIntervalByID(
 		}
 		return
 	}
-	pkg.Encode(result, w, lc)
+	results, err := withSettings([]models.Interval{result}, dbClient)
+	if err != nil {
+		lc.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	pkg.Encode(results[0], w, lc)
 }
 
 func restDeleteIntervalByID(
@@ -240,8 +419,14 @@ func restGetIntervalByName(
 		lc.Error(err.Error())
 		return
 	}
+	results, err := withSettings([]models.Interval{result}, dbClient)
+	if err != nil {
+		lc.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
 
-	pkg.Encode(result, w, lc)
+	pkg.Encode(results[0], w, lc)
 
 }
 
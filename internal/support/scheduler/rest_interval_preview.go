@@ -0,0 +1,111 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/constant"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/utils"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/errors"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/operators/interval"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	v2 "github.com/edgexfoundry/go-mod-core-contracts/v2"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+
+	"github.com/gorilla/mux"
+)
+
+const defaultNextRunCount = 10
+
+// intervalNextRunsResponse is the response of the interval next runs API.
+type intervalNextRunsResponse struct {
+	commonDTO.BaseResponse `json:",inline"`
+	Name                   string   `json:"name"`
+	Timezone               string   `json:"timezone"`
+	NextRuns               []string `json:"nextRuns"`
+}
+
+// restGetIntervalNextRuns previews the next execution times of the interval with {name}, so that a schedule can be
+// verified before relying on it. The times are rendered in the tz location, by default the timezone of the interval.
+// api/v2/interval/name/{name}/next?count={count}&tz={tz}
+func restGetIntervalNextRuns(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	configuration *config.ConfigurationStruct) {
+
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+	name := mux.Vars(r)[v2.Name]
+
+	writeError := func(message string, statusCode int) {
+		lc.Error(message, clients.CorrelationHeader, correlationId)
+		utils.WriteHttpHeader(w, ctx, statusCode)
+		pkg.Encode(commonDTO.NewBaseResponse("", message, statusCode), w, lc)
+	}
+
+	count, edgexErr := utils.ParseQueryStringToInt(r, constant.Count, defaultNextRunCount, 1, configuration.Service.MaxResultCount)
+	if edgexErr != nil {
+		writeError(edgexErr.Message(), edgexErr.Code())
+		return
+	}
+
+	timezone := r.URL.Query().Get(constant.Timezone)
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		writeError(fmt.Sprintf("invalid timezone %s: %s", timezone, err.Error()), http.StatusBadRequest)
+		return
+	}
+
+	result, err := interval.NewNameExecutor(dbClient, name).Execute()
+	if err != nil {
+		switch err.(type) {
+		case errors.ErrIntervalNotFound:
+			writeError(err.Error(), http.StatusNotFound)
+		default:
+			writeError(err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	var intervalContext IntervalContext
+	zone, err := interval.Timezone(dbClient, result.ID)
+	if err == nil {
+		intervalContext.Location, err = interval.LoadTimezone(zone)
+	}
+	if err != nil {
+		writeError(err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if timezone == "" {
+		location = intervalContext.location()
+	}
+	intervalContext.Reset(result, lc)
+
+	runs := intervalContext.NextRuns(count)
+	nextRuns := make([]string, len(runs))
+	for i, run := range runs {
+		nextRuns[i] = run.In(location).Format(time.RFC3339)
+	}
+
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(intervalNextRunsResponse{
+		BaseResponse: commonDTO.NewBaseResponse("", "", http.StatusOK),
+		Name:         result.Name,
+		Timezone:     location.String(),
+		NextRuns:     nextRuns,
+	}, w, lc)
+}
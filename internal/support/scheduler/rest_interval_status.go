@@ -0,0 +1,54 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/interfaces"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/gorilla/mux"
+)
+
+func restGetIntervalStatuses(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	scClient interfaces.SchedulerQueueClient) {
+
+	defer r.Body.Close()
+
+	pkg.Encode(scClient.QueryIntervalStatuses(), w, lc)
+}
+
+func restGetIntervalStatusByName(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	scClient interfaces.SchedulerQueueClient) {
+
+	defer r.Body.Close()
+
+	name, err := url.QueryUnescape(mux.Vars(r)[NAME])
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error("Error un-escaping the value name: " + err.Error())
+		return
+	}
+
+	status, err := scClient.QueryIntervalStatusByName(name)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		lc.Error(err.Error())
+		return
+	}
+
+	pkg.Encode(status, w, lc)
+}
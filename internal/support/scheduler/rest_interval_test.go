@@ -34,6 +34,7 @@ import (
 	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
 
 	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/mock"
 )
 
 // TestURI this is not really used since we are using the HTTP testing framework and not creating routes, but rather
@@ -45,6 +46,10 @@ var TestOtherName = "weekly"
 var TestIncorrectId = "123e4567-e89b-12d3-a456-4266554400%0"
 var TestIncorrectName = "hourly%b"
 var TestLimit = 5
+var TestTimezone = "Europe/Paris"
+var TestInvalidTimezone = "Europe/Atlantis"
+var TestMisfirePolicy = interval.MisfireRunAllMissed
+var TestMaxIterations = int64(3)
 
 var intervalForAdd = contract.Interval{
 	ID:        TestId,
@@ -135,6 +140,48 @@ func TestAddInterval(t *testing.T) {
 			scClient:       createMockIntervalLoaderSCAddSuccess(),
 			expectedStatus: http.StatusOK,
 		},
+		{
+			name:           "OK with timezone",
+			request:        createRequestIntervalWithTimezone(http.MethodPost, intervalForAdd, TestTimezone),
+			dbMock:         createMockIntervalLoaderAddSuccess(),
+			scClient:       createMockIntervalLoaderSCAddSuccess(),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "ErrInvalidTimezone",
+			request:        createRequestIntervalWithTimezone(http.MethodPost, intervalForAdd, TestInvalidTimezone),
+			dbMock:         createMockIntervalLoaderAddSuccess(),
+			scClient:       createMockIntervalLoaderSCAddSuccess(),
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "OK with misfire policy",
+			request:        createRequestIntervalWithSetting(http.MethodPost, intervalForAdd, "misfirePolicy", TestMisfirePolicy),
+			dbMock:         createMockIntervalLoaderAddSuccess(),
+			scClient:       createMockIntervalLoaderSCAddSuccess(),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "ErrInvalidMisfirePolicy",
+			request:        createRequestIntervalWithSetting(http.MethodPost, intervalForAdd, "misfirePolicy", "runTwice"),
+			dbMock:         createMockIntervalLoaderAddSuccess(),
+			scClient:       createMockIntervalLoaderSCAddSuccess(),
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "OK with max iterations",
+			request:        createRequestIntervalWithSetting(http.MethodPost, intervalForAdd, "maxIterations", TestMaxIterations),
+			dbMock:         createMockIntervalLoaderAddSuccess(),
+			scClient:       createMockIntervalLoaderSCAddSuccess(),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "ErrInvalidMaxIterations",
+			request:        createRequestIntervalWithSetting(http.MethodPost, intervalForAdd, "maxIterations", -1),
+			dbMock:         createMockIntervalLoaderAddSuccess(),
+			scClient:       createMockIntervalLoaderSCAddSuccess(),
+			expectedStatus: http.StatusBadRequest,
+		},
 		{
 			name:           "ErrIntervalNameInUse",
 			request:        createRequestIntervalAdd(intervalForAdd),
@@ -187,6 +234,48 @@ func TestUpdateInterval(t *testing.T) {
 			scClient:       createMockIntervalLoaderSCUpdateSuccess(),
 			expectedStatus: http.StatusOK,
 		},
+		{
+			name:           "OK with timezone",
+			request:        createRequestIntervalWithTimezone(http.MethodPut, intervalForAdd, TestTimezone),
+			dbMock:         createMockIntervalLoaderUpdateSuccess(),
+			scClient:       createMockIntervalLoaderSCUpdateSuccess(),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "ErrInvalidTimezone",
+			request:        createRequestIntervalWithTimezone(http.MethodPut, intervalForAdd, TestInvalidTimezone),
+			dbMock:         createMockIntervalLoaderUpdateSuccess(),
+			scClient:       createMockIntervalLoaderSCUpdateSuccess(),
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "OK with misfire policy",
+			request:        createRequestIntervalWithSetting(http.MethodPut, intervalForAdd, "misfirePolicy", TestMisfirePolicy),
+			dbMock:         createMockIntervalLoaderUpdateSuccess(),
+			scClient:       createMockIntervalLoaderSCUpdateSuccess(),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "ErrInvalidMisfirePolicy",
+			request:        createRequestIntervalWithSetting(http.MethodPut, intervalForAdd, "misfirePolicy", "runTwice"),
+			dbMock:         createMockIntervalLoaderUpdateSuccess(),
+			scClient:       createMockIntervalLoaderSCUpdateSuccess(),
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "OK with max iterations",
+			request:        createRequestIntervalWithSetting(http.MethodPut, intervalForAdd, "maxIterations", TestMaxIterations),
+			dbMock:         createMockIntervalLoaderUpdateSuccess(),
+			scClient:       createMockIntervalLoaderSCUpdateSuccess(),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "ErrInvalidMaxIterations",
+			request:        createRequestIntervalWithSetting(http.MethodPut, intervalForAdd, "maxIterations", -1),
+			dbMock:         createMockIntervalLoaderUpdateSuccess(),
+			scClient:       createMockIntervalLoaderSCUpdateSuccess(),
+			expectedStatus: http.StatusBadRequest,
+		},
 		{
 			name:           "ErrInvalidTimeFormat",
 			request:        createRequestIntervalUpdate(intervalForAddInvalidTime),
@@ -301,6 +390,10 @@ func createMockIntervalLoaderAllSuccess() interfaces.DBClient {
 	myMock := mocks.DBClient{}
 	myMock.On("Intervals").Return(createIntervals(1), nil)
 	myMock.On("IntervalsWithLimit", TestLimit).Return(createIntervals(1), nil)
+	myMock.On("IntervalTimezones").Return(map[string]string{TestId: TestTimezone}, nil)
+	myMock.On("IntervalMisfirePolicies").Return(map[string]string{TestId: TestMisfirePolicy}, nil)
+	myMock.On("IntervalMaxIterations").Return(map[string]int64{TestId: TestMaxIterations}, nil)
+	myMock.On("IntervalRemainingIterations").Return(map[string]int64{TestId: 1}, nil)
 
 	return &myMock
 }
@@ -321,6 +414,9 @@ func createMockIntervalLoaderAddSuccess() interfaces.DBClient {
 
 	myMock.On("IntervalByName", intervalForAdd.Name).Return(interval, nil)
 	myMock.On("AddInterval", intervalForAdd).Return(intervalForAdd.ID, nil)
+	myMock.On("SetIntervalTimezone", intervalForAdd.ID, TestTimezone).Return(nil)
+	myMock.On("SetIntervalMisfirePolicy", intervalForAdd.ID, TestMisfirePolicy).Return(nil)
+	myMock.On("SetIntervalMaxIterations", intervalForAdd.ID, TestMaxIterations).Return(nil)
 	return &myMock
 }
 
@@ -335,6 +431,9 @@ func createMockIntervalLoaderUpdateSuccess() interfaces.DBClient {
 	myMock.On("IntervalByName", intervalForAdd.Name).Return(contract.Interval{}, db.ErrNotFound)
 	myMock.On("IntervalActionsByIntervalName", TestName).Return([]contract.IntervalAction{}, nil)
 	myMock.On("UpdateInterval", intervalForAdd).Return(nil)
+	myMock.On("SetIntervalTimezone", intervalForAdd.ID, TestTimezone).Return(nil)
+	myMock.On("SetIntervalMisfirePolicy", intervalForAdd.ID, TestMisfirePolicy).Return(nil)
+	myMock.On("SetIntervalMaxIterations", intervalForAdd.ID, TestMaxIterations).Return(nil)
 	return &myMock
 }
 
@@ -416,12 +515,16 @@ func validateInterval(interval *contract.Interval) {
 func createMockIntervalLoaderSCAddSuccess() interfaces.SchedulerQueueClient {
 	myMock := mocks.SchedulerQueueClient{}
 	myMock.On("AddIntervalToQueue", intervalForAdd).Return(nil)
+	myMock.On("SetIntervalTimezone", intervalForAdd.ID, mock.Anything).Return()
+	myMock.On("SetIntervalIterations", intervalForAdd.ID, TestMaxIterations, int64(-1)).Return()
 	return &myMock
 }
 
 func createMockIntervalLoaderSCUpdateSuccess() interfaces.SchedulerQueueClient {
 	myMock := mocks.SchedulerQueueClient{}
 	myMock.On("UpdateIntervalInQueue", intervalForAdd).Return(nil)
+	myMock.On("SetIntervalTimezone", intervalForAdd.ID, mock.Anything).Return()
+	myMock.On("SetIntervalIterations", intervalForAdd.ID, TestMaxIterations, int64(-1)).Return()
 	return &myMock
 }
 
@@ -447,6 +550,10 @@ func createMockIntervalLoader(methodName string, desiredError error, arg string)
 		myMock.On(methodName, arg).Return(createIntervals(1)[0], nil)
 		myMock.On("IntervalById", TestId).Return(createIntervals(1)[0], nil)
 	}
+	myMock.On("IntervalTimezones").Return(map[string]string{TestId: TestTimezone}, nil)
+	myMock.On("IntervalMisfirePolicies").Return(map[string]string{TestId: TestMisfirePolicy}, nil)
+	myMock.On("IntervalMaxIterations").Return(map[string]int64{TestId: TestMaxIterations}, nil)
+	myMock.On("IntervalRemainingIterations").Return(map[string]int64{TestId: 1}, nil)
 	return &myMock
 }
 
@@ -505,6 +612,20 @@ func createRequestIntervalUpdate(interval contract.Interval) *http.Request {
 	return mux.SetURLVars(req, map[string]string{})
 }
 
+func createRequestIntervalWithTimezone(method string, interval contract.Interval, timezone string) *http.Request {
+	return createRequestIntervalWithSetting(method, interval, "timezone", timezone)
+}
+
+func createRequestIntervalWithSetting(method string, interval contract.Interval, setting string, value interface{}) *http.Request {
+	b, _ := json.Marshal(interval)
+	var fields map[string]interface{}
+	_ = json.Unmarshal(b, &fields)
+	fields[setting] = value
+	b, _ = json.Marshal(fields)
+	req := httptest.NewRequest(method, TestURI, bytes.NewBuffer(b))
+	return mux.SetURLVars(req, map[string]string{})
+}
+
 func createRequest(pathParamName string, pathParamValue string) *http.Request {
 	req := httptest.NewRequest(http.MethodGet, TestURI, nil)
 	return mux.SetURLVars(req, map[string]string{pathParamName: pathParamValue})
@@ -16,6 +16,7 @@ package scheduler
 
 import (
 	"encoding/json"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -30,9 +31,48 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/errors"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/interfaces"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/operators/intervalaction"
 )
 
+// intervalActionSettings holds the settings which may be given along with an interval action, an absent setting being
+// left unchanged.
+type intervalActionSettings struct {
+	ExecutionPolicy *schedulerModels.ExecutionPolicy `json:"executionPolicy"`
+	// RunAfter names the interval actions of the same interval the action runs after, an empty list removing them
+	RunAfter *[]string `json:"runAfter"`
+	// AuthHeaders are injected from the secret store into the requests of the action, an empty list removing them
+	AuthHeaders *[]schedulerModels.AuthHeader `json:"authHeaders"`
+}
+
+// validate checks the settings given along with the interval action by name.
+func (settings intervalActionSettings) validate(name string) error {
+	if settings.ExecutionPolicy != nil {
+		if err := intervalaction.ValidateExecutionPolicy(name, *settings.ExecutionPolicy); err != nil {
+			return err
+		}
+	}
+	if settings.AuthHeaders != nil {
+		return intervalaction.ValidateAuthHeaders(name, *settings.AuthHeaders)
+	}
+	return nil
+}
+
+// decodeIntervalAction decodes the interval action in the body of the request along with its settings.
+func decodeIntervalAction(r *http.Request) (contract.IntervalAction, intervalActionSettings, error) {
+	var from contract.IntervalAction
+	var settings intervalActionSettings
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err == nil {
+		err = json.Unmarshal(body, &from)
+	}
+	if err == nil {
+		err = json.Unmarshal(body, &settings)
+	}
+	return from, settings, err
+}
+
 func restGetIntervalAction(
 	w http.ResponseWriter,
 	r *http.Request,
@@ -69,14 +109,25 @@ func restAddIntervalAction(
 	if r.Body != nil {
 		defer r.Body.Close()
 	}
-	var intervalAction contract.IntervalAction
-	dec := json.NewDecoder(r.Body)
-	err := dec.Decode(&intervalAction)
+	intervalAction, settings, err := decodeIntervalAction(r)
 
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	if err = settings.validate(intervalAction.Name); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error(err.Error())
+		return
+	}
+	if settings.RunAfter != nil {
+		err = intervalaction.ValidateDependencies(scClient, intervalAction.Name, intervalAction.Interval, *settings.RunAfter)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			lc.Error(err.Error())
+			return
+		}
+	}
 	lc.Info("posting new intervalAction: " + intervalAction.String())
 
 	op := intervalaction.NewAddExecutor(dbClient, scClient, intervalAction)
@@ -85,6 +136,8 @@ func restAddIntervalAction(
 		switch t := err.(type) {
 		case errors.ErrIntervalActionNameInUse:
 			http.Error(w, t.Error(), http.StatusBadRequest)
+		case errors.ErrIntervalActionTopicRequired:
+			http.Error(w, t.Error(), http.StatusBadRequest)
 		case errors.ErrIntervalNotFound:
 			http.Error(w, t.Error(), http.StatusBadRequest)
 		default:
@@ -94,6 +147,44 @@ func restAddIntervalAction(
 		return
 	}
 
+	if settings.ExecutionPolicy != nil && !settings.ExecutionPolicy.IsDefault() {
+		op := intervalaction.NewExecutionPolicyExecutor(
+			dbClient,
+			scClient,
+			newId,
+			intervalAction.Name,
+			*settings.ExecutionPolicy)
+		if err = op.Execute(); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			lc.Error(err.Error())
+			return
+		}
+	}
+
+	if settings.RunAfter != nil && len(*settings.RunAfter) > 0 {
+		op := intervalaction.NewDependenciesExecutor(
+			dbClient,
+			scClient,
+			newId,
+			intervalAction.Name,
+			intervalAction.Interval,
+			*settings.RunAfter)
+		if err = op.Execute(); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			lc.Error(err.Error())
+			return
+		}
+	}
+
+	if settings.AuthHeaders != nil && len(*settings.AuthHeaders) > 0 {
+		op := intervalaction.NewAuthHeadersExecutor(dbClient, scClient, newId, intervalAction.Name, *settings.AuthHeaders)
+		if err = op.Execute(); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			lc.Error(err.Error())
+			return
+		}
+	}
+
 	w.WriteHeader(http.StatusOK)
 	w.Write([]byte(newId))
 }
@@ -146,6 +237,8 @@ func intervalActionHandler(
 			switch t := err.(type) {
 			case errors.ErrIntervalActionNameInUse:
 				http.Error(w, t.Error(), http.StatusBadRequest)
+			case errors.ErrIntervalActionTopicRequired:
+				http.Error(w, t.Error(), http.StatusBadRequest)
 			case errors.ErrInvalidTimeFormat:
 				http.Error(w, t.Error(), http.StatusBadRequest)
 			case errors.ErrInvalidFrequencyFormat:
@@ -161,9 +254,7 @@ func intervalActionHandler(
 		w.Write([]byte(newId))
 		break
 	case http.MethodPut:
-		var from models.IntervalAction
-		dec := json.NewDecoder(r.Body)
-		err := dec.Decode(&from)
+		from, settings, err := decodeIntervalAction(r)
 
 		// Problem decoding
 		if err != nil {
@@ -171,6 +262,11 @@ func intervalActionHandler(
 			lc.Error("Error decoding the intervalAction: " + err.Error())
 			return
 		}
+		if err = settings.validate(from.Name); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			lc.Error(err.Error())
+			return
+		}
 
 		lc.Info("Updating IntervalAction: " + from.ID)
 		err = updateIntervalAction(from, dbClient, scClient)
@@ -188,6 +284,8 @@ func intervalActionHandler(
 				http.Error(w, t.Error(), http.StatusBadRequest)
 			case errors.ErrIntervalNameInUse:
 				http.Error(w, t.Error(), http.StatusBadRequest)
+			case errors.ErrIntervalActionTopicRequired:
+				http.Error(w, t.Error(), http.StatusBadRequest)
 			default:
 				http.Error(w, err.Error(), http.StatusServiceUnavailable)
 			}
@@ -195,6 +293,61 @@ func intervalActionHandler(
 			return
 		}
 
+		if settings.ExecutionPolicy != nil || settings.RunAfter != nil || settings.AuthHeaders != nil {
+			updated, err := dbClient.IntervalActionByName(from.Name)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusServiceUnavailable)
+				lc.Error(err.Error())
+				return
+			}
+			if settings.ExecutionPolicy != nil {
+				op := intervalaction.NewExecutionPolicyExecutor(
+					dbClient,
+					scClient,
+					updated.ID,
+					updated.Name,
+					*settings.ExecutionPolicy)
+				if err = op.Execute(); err != nil {
+					http.Error(w, err.Error(), http.StatusServiceUnavailable)
+					lc.Error(err.Error())
+					return
+				}
+			}
+			if settings.RunAfter != nil {
+				// the dependencies are checked against the interval the action belongs to once updated
+				op := intervalaction.NewDependenciesExecutor(
+					dbClient,
+					scClient,
+					updated.ID,
+					updated.Name,
+					updated.Interval,
+					*settings.RunAfter)
+				if err = op.Execute(); err != nil {
+					switch err.(type) {
+					case errors.ErrInvalidIntervalActionDependencies:
+						http.Error(w, err.Error(), http.StatusBadRequest)
+					default:
+						http.Error(w, err.Error(), http.StatusServiceUnavailable)
+					}
+					lc.Error(err.Error())
+					return
+				}
+			}
+			if settings.AuthHeaders != nil {
+				op := intervalaction.NewAuthHeadersExecutor(
+					dbClient,
+					scClient,
+					updated.ID,
+					updated.Name,
+					*settings.AuthHeaders)
+				if err = op.Execute(); err != nil {
+					http.Error(w, err.Error(), http.StatusServiceUnavailable)
+					lc.Error(err.Error())
+					return
+				}
+			}
+		}
+
 		w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte("true"))
@@ -0,0 +1,85 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler
+
+import (
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/utils"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/errors"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/interfaces"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	v2 "github.com/edgexfoundry/go-mod-core-contracts/v2"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+
+	"github.com/gorilla/mux"
+)
+
+const defaultExecutionHistoryLimit = 20
+
+// intervalActionHistoryResponse is the response of the interval action history API.
+type intervalActionHistoryResponse struct {
+	commonDTO.BaseResponse `json:",inline"`
+	Name                   string                                    `json:"name"`
+	Executions             []schedulerModels.IntervalActionExecution `json:"executions"`
+}
+
+// restGetIntervalActionHistory returns the latest executions of the interval action with {name}, the latest first, so
+// that it can be verified that the action actually ran and how its target responded.
+// api/v2/intervalaction/name/{name}/history?limit={limit}
+func restGetIntervalActionHistory(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	configuration *config.ConfigurationStruct) {
+
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+	name := mux.Vars(r)[v2.Name]
+
+	writeError := func(message string, statusCode int) {
+		lc.Error(message, clients.CorrelationHeader, correlationId)
+		utils.WriteHttpHeader(w, ctx, statusCode)
+		pkg.Encode(commonDTO.NewBaseResponse("", message, statusCode), w, lc)
+	}
+
+	limit, edgexErr := utils.ParseQueryStringToInt(r, v2.Limit, defaultExecutionHistoryLimit, 1, configuration.Service.MaxResultCount)
+	if edgexErr != nil {
+		writeError(edgexErr.Message(), edgexErr.Code())
+		return
+	}
+
+	intervalAction, err := getIntervalActionByName(name, dbClient)
+	if err != nil {
+		switch err.(type) {
+		case errors.ErrIntervalActionNotFound:
+			writeError(err.Error(), http.StatusNotFound)
+		default:
+			writeError(err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	executions, err := dbClient.IntervalActionExecutionsByName(intervalAction.Name, limit)
+	if err != nil {
+		writeError(err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	pkg.Encode(intervalActionHistoryResponse{
+		BaseResponse: commonDTO.NewBaseResponse("", "", http.StatusOK),
+		Name:         intervalAction.Name,
+		Executions:   executions,
+	}, w, lc)
+}
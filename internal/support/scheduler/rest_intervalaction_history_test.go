@@ -0,0 +1,120 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler
+
+import (
+	"encoding/json"
+	goErrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/constant"
+	schedConfig "github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/interfaces/mocks"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetIntervalActionHistory(t *testing.T) {
+	action := contract.IntervalAction{ID: TestId, Name: "scrub-pushed-events", Interval: "midnight"}
+	executions := []schedulerModels.IntervalActionExecution{
+		{ID: "2", IntervalAction: action.Name, Started: 2000, StatusCode: http.StatusAccepted},
+		{ID: "1", IntervalAction: action.Name, Started: 1000, Error: "connection refused"},
+	}
+
+	tests := []struct {
+		name           string
+		action         string
+		query          string
+		dbMock         func() *mocks.DBClient
+		expectedStatus int
+	}{
+		{
+			"OK",
+			action.Name,
+			"?limit=5",
+			func() *mocks.DBClient {
+				dbMock := &mocks.DBClient{}
+				dbMock.On("IntervalActionByName", action.Name).Return(action, nil)
+				dbMock.On("IntervalActionExecutionsByName", action.Name, 5).Return(executions, nil)
+				return dbMock
+			},
+			http.StatusOK,
+		},
+		{
+			"OK default limit",
+			action.Name,
+			"",
+			func() *mocks.DBClient {
+				dbMock := &mocks.DBClient{}
+				dbMock.On("IntervalActionByName", action.Name).Return(action, nil)
+				dbMock.On("IntervalActionExecutionsByName", action.Name, defaultExecutionHistoryLimit).Return(executions, nil)
+				return dbMock
+			},
+			http.StatusOK,
+		},
+		{
+			"Invalid limit",
+			action.Name,
+			"?limit=x",
+			func() *mocks.DBClient { return &mocks.DBClient{} },
+			http.StatusBadRequest,
+		},
+		{
+			"Interval action not found",
+			"unknown",
+			"",
+			func() *mocks.DBClient {
+				dbMock := &mocks.DBClient{}
+				dbMock.On("IntervalActionByName", "unknown").Return(contract.IntervalAction{}, db.ErrNotFound)
+				return dbMock
+			},
+			http.StatusNotFound,
+		},
+		{
+			"Database error",
+			action.Name,
+			"",
+			func() *mocks.DBClient {
+				dbMock := &mocks.DBClient{}
+				dbMock.On("IntervalActionByName", action.Name).Return(action, nil)
+				dbMock.On("IntervalActionExecutionsByName", action.Name, defaultExecutionHistoryLimit).Return(nil, goErrors.New("test error"))
+				return dbMock
+			},
+			http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dbMock := tt.dbMock()
+			req := httptest.NewRequest(http.MethodGet, "/api/v2/intervalaction/name/"+tt.action+"/"+constant.History+tt.query, nil)
+			req = mux.SetURLVars(req, map[string]string{"name": tt.action})
+			rr := httptest.NewRecorder()
+			configuration := &schedConfig.ConfigurationStruct{Service: bootstrapConfig.ServiceInfo{MaxResultCount: 100}}
+
+			restGetIntervalActionHistory(rr, req, logger.NewMockClient(), dbMock, configuration)
+
+			require.Equal(t, tt.expectedStatus, rr.Code, rr.Body.String())
+			dbMock.AssertExpectations(t)
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+			var response intervalActionHistoryResponse
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+			assert.Equal(t, action.Name, response.Name)
+			assert.Equal(t, executions, response.Executions)
+		})
+	}
+}
@@ -26,6 +26,7 @@ import (
 	schedConfig "github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/interfaces"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/interfaces/mocks"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
 
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
 
@@ -58,6 +59,10 @@ var InvalidIntervalActionForAdd = contract.IntervalAction{
 
 var TestIntervalActionURI = "/" + INTERVALACTION
 
+var testExecutionPolicy = schedulerModels.ExecutionPolicy{Timeout: "2s", Retries: 3, RetryBackoff: "1s", Jitter: "500ms"}
+
+var testAuthHeaders = []schedulerModels.AuthHeader{{SecretPath: "scheduler/export", Scheme: schedulerModels.AuthSchemeBearer}}
+
 func TestGetIntervalAction(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -125,6 +130,52 @@ func TestAddIntervalAction(t *testing.T) {
 			scClient:       createMockIntervalActionLoaderSCAddSuccess(),
 			expectedStatus: http.StatusOK,
 		},
+		{
+			name:           "OK with execution policy",
+			request:        createRequestIntervalActionAddWithPolicy(intervalActionForAdd, testExecutionPolicy),
+			dbMock:         createMockIntervalActionLoaderAddSuccess(),
+			scClient:       createMockIntervalActionLoaderSCAddSuccess(),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Error invalid execution policy",
+			request: createRequestIntervalActionAddWithPolicy(
+				intervalActionForAdd,
+				schedulerModels.ExecutionPolicy{Retries: 1, RetryBackoff: "forever"}),
+			dbMock:         createMockIntervalActionLoaderAddSuccess(),
+			scClient:       createMockIntervalActionLoaderSCAddSuccess(),
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "OK with runAfter",
+			request:        createRequestIntervalActionAddRunAfter(intervalActionForAdd, []string{otherIntervalActionForAdd.Name}),
+			dbMock:         createMockIntervalActionLoaderAddSuccess(),
+			scClient:       createMockIntervalActionLoaderSCAddSuccess(),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Error invalid runAfter",
+			request:        createRequestIntervalActionAddRunAfter(intervalActionForAdd, []string{intervalActionForAdd.Name}),
+			dbMock:         createMockIntervalActionLoaderAddSuccess(),
+			scClient:       createMockIntervalActionLoaderSCAddSuccess(),
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "OK with auth headers",
+			request:        createRequestIntervalActionAddAuthHeaders(intervalActionForAdd, testAuthHeaders),
+			dbMock:         createMockIntervalActionLoaderAddSuccess(),
+			scClient:       createMockIntervalActionLoaderSCAddSuccess(),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Error invalid auth headers",
+			request: createRequestIntervalActionAddAuthHeaders(
+				intervalActionForAdd,
+				[]schedulerModels.AuthHeader{{Header: "X-Api-Key"}}),
+			dbMock:         createMockIntervalActionLoaderAddSuccess(),
+			scClient:       createMockIntervalActionLoaderSCAddSuccess(),
+			expectedStatus: http.StatusBadRequest,
+		},
 		{
 			name:           "Error Decoding",
 			request:        createRequestIntervalActionAdd(InvalidIntervalActionForAdd),
@@ -196,6 +247,9 @@ func createMockIntervalActionLoaderAddSuccess() interfaces.DBClient {
 	myMock.On("IntervalActionByName", intervalActionForAdd.Name).Return(intervalAction, nil)
 	myMock.On("IntervalByName", intervalActionForAdd.Interval).Return(createIntervals(1)[0], nil)
 	myMock.On("AddIntervalAction", intervalActionForAdd).Return(intervalActionForAdd.ID, nil)
+	myMock.On("SetIntervalActionPolicy", intervalActionForAdd.ID, testExecutionPolicy).Return(nil)
+	myMock.On("SetIntervalActionDependencies", intervalActionForAdd.ID, []string{otherIntervalActionForAdd.Name}).Return(nil)
+	myMock.On("SetIntervalActionAuthHeaders", intervalActionForAdd.ID, testAuthHeaders).Return(nil)
 	return &myMock
 }
 
@@ -235,6 +289,13 @@ func createMockIntervalActionLoaderSCAddSuccess() interfaces.SchedulerQueueClien
 	validateIntervalAction(&otherIntervalActionForAdd)
 	myMock.On("QueryIntervalActionByName", intervalActionForAdd.Name).Return(otherIntervalActionForAdd, nil)
 	myMock.On("AddIntervalActionToQueue", intervalActionForAdd).Return(nil)
+	myMock.On("SetIntervalActionPolicy", intervalActionForAdd.ID, testExecutionPolicy).Return()
+	myMock.On("QueryIntervalActionByName", otherIntervalActionForAdd.Name).Return(otherIntervalActionForAdd, nil)
+	myMock.On("QueryIntervalActionDependencies", intervalActionForAdd.Interval).Return(func(string) map[string][]string {
+		return map[string][]string{}
+	})
+	myMock.On("SetIntervalActionDependencies", intervalActionForAdd.ID, []string{otherIntervalActionForAdd.Name}).Return()
+	myMock.On("SetIntervalActionAuthHeaders", intervalActionForAdd.ID, testAuthHeaders).Return()
 
 	return &myMock
 }
@@ -249,3 +310,39 @@ func createRequestIntervalActionAdd(intervalAction contract.IntervalAction) *htt
 	req := httptest.NewRequest(http.MethodPost, TestIntervalActionURI, bytes.NewBuffer(b))
 	return mux.SetURLVars(req, map[string]string{})
 }
+
+func createRequestIntervalActionAddWithPolicy(
+	intervalAction contract.IntervalAction,
+	policy schedulerModels.ExecutionPolicy) *http.Request {
+
+	var fields map[string]interface{}
+	b, _ := json.Marshal(intervalAction)
+	_ = json.Unmarshal(b, &fields)
+	fields["executionPolicy"] = policy
+	b, _ = json.Marshal(fields)
+	req := httptest.NewRequest(http.MethodPost, TestIntervalActionURI, bytes.NewBuffer(b))
+	return mux.SetURLVars(req, map[string]string{})
+}
+
+func createRequestIntervalActionAddRunAfter(intervalAction contract.IntervalAction, runAfter []string) *http.Request {
+	var fields map[string]interface{}
+	b, _ := json.Marshal(intervalAction)
+	_ = json.Unmarshal(b, &fields)
+	fields["runAfter"] = runAfter
+	b, _ = json.Marshal(fields)
+	req := httptest.NewRequest(http.MethodPost, TestIntervalActionURI, bytes.NewBuffer(b))
+	return mux.SetURLVars(req, map[string]string{})
+}
+
+func createRequestIntervalActionAddAuthHeaders(
+	intervalAction contract.IntervalAction,
+	headers []schedulerModels.AuthHeader) *http.Request {
+
+	var fields map[string]interface{}
+	b, _ := json.Marshal(intervalAction)
+	_ = json.Unmarshal(b, &fields)
+	fields["authHeaders"] = headers
+	b, _ = json.Marshal(fields)
+	req := httptest.NewRequest(http.MethodPost, TestIntervalActionURI, bytes.NewBuffer(b))
+	return mux.SetURLVars(req, map[string]string{})
+}
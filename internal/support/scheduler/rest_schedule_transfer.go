@@ -0,0 +1,193 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/transfer"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/operators/interval"
+)
+
+// scheduleConfiguration is the document exchanged by the schedule export and import endpoints, used to replicate the
+// intervals and interval actions of one site to others.
+type scheduleConfiguration struct {
+	Intervals       []models.Interval       `json:"intervals"`
+	IntervalActions []models.IntervalAction `json:"intervalActions"`
+}
+
+// scheduleImport is the document given to the schedule import endpoint, whose entries are decoded one by one so an
+// invalid entry is reported without rejecting the others.
+type scheduleImport struct {
+	Intervals       []json.RawMessage `json:"intervals"`
+	IntervalActions []json.RawMessage `json:"intervalActions"`
+}
+
+// entryName returns the name of an entry of an imported document, even when the entry is invalid.
+func entryName(entry json.RawMessage) string {
+	var named struct {
+		Name string `json:"name"`
+	}
+	_ = json.Unmarshal(entry, &named)
+	return named.Name
+}
+
+func restExportSchedule(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	intervals, err := dbClient.Intervals()
+	if err != nil {
+		lc.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	intervalActions, err := dbClient.IntervalActions()
+	if err != nil {
+		lc.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// identifiers and timestamps are specific to this site and are assigned again on import
+	for i := range intervals {
+		intervals[i].ID = ""
+		intervals[i].Timestamps = models.Timestamps{}
+		intervals[i].Cron = interval.UpgradeStoredCron(intervals[i].Cron)
+	}
+	for i := range intervalActions {
+		intervalActions[i].ID = ""
+		intervalActions[i].Created = 0
+		intervalActions[i].Modified = 0
+	}
+
+	pkg.Encode(scheduleConfiguration{Intervals: intervals, IntervalActions: intervalActions}, w, lc)
+}
+
+func restImportSchedule(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	scClient interfaces.SchedulerQueueClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	var document scheduleImport
+	err := json.NewDecoder(r.Body).Decode(&document)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error("Error decoding schedule configuration: " + err.Error())
+		return
+	}
+
+	lc.Info(fmt.Sprintf("Importing %d intervals and %d interval actions",
+		len(document.Intervals), len(document.IntervalActions)))
+	result := transfer.NewImportResult()
+	// intervals go first so the interval actions of the document can refer to them
+	for _, entry := range document.Intervals {
+		var i models.Interval
+		if err = json.Unmarshal(entry, &i); err != nil {
+			result.Fail(INTERVAL+"/"+entryName(entry), err)
+			continue
+		}
+		importInterval(i, result, dbClient, scClient)
+	}
+	for _, entry := range document.IntervalActions {
+		var a models.IntervalAction
+		if err = json.Unmarshal(entry, &a); err != nil {
+			result.Fail(INTERVALACTION+"/"+entryName(entry), err)
+			continue
+		}
+		importIntervalAction(a, result, dbClient, scClient)
+	}
+
+	result.Write(w, lc)
+}
+
+// importInterval adds the interval, or replaces the existing interval with the same name.
+func importInterval(
+	i models.Interval,
+	result *transfer.ImportResult,
+	dbClient interfaces.DBClient,
+	scClient interfaces.SchedulerQueueClient) {
+
+	key := INTERVAL + "/" + i.Name
+	if i.Name == "" {
+		result.Fail(key, fmt.Errorf("interval name is required"))
+		return
+	}
+
+	existing, err := dbClient.IntervalByName(i.Name)
+	switch {
+	case err == db.ErrNotFound:
+		i.ID = ""
+		if _, err = addNewInterval(i, dbClient, scClient); err != nil {
+			result.Fail(key, err)
+			return
+		}
+		result.Create(key)
+	case err != nil:
+		result.Fail(key, err)
+	default:
+		i.ID = existing.ID
+		if err = interval.NewUpdateExecutor(dbClient, scClient, i).Execute(); err != nil {
+			result.Fail(key, err)
+			return
+		}
+		result.Update(key)
+	}
+}
+
+// importIntervalAction adds the interval action, or replaces the existing interval action with the same name.
+func importIntervalAction(
+	a models.IntervalAction,
+	result *transfer.ImportResult,
+	dbClient interfaces.DBClient,
+	scClient interfaces.SchedulerQueueClient) {
+
+	key := INTERVALACTION + "/" + a.Name
+	if a.Name == "" {
+		result.Fail(key, fmt.Errorf("interval action name is required"))
+		return
+	}
+
+	existing, err := dbClient.IntervalActionByName(a.Name)
+	switch {
+	case err == db.ErrNotFound:
+		a.ID = ""
+		if _, err = addNewIntervalAction(a, dbClient, scClient); err != nil {
+			result.Fail(key, err)
+			return
+		}
+		result.Create(key)
+	case err != nil:
+		result.Fail(key, err)
+	default:
+		a.ID = existing.ID
+		if err = updateIntervalAction(a, dbClient, scClient); err != nil {
+			result.Fail(key, err)
+			return
+		}
+		result.Update(key)
+	}
+}
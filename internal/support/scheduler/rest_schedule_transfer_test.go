@@ -0,0 +1,72 @@
+//
+// Copyright (C) 2020 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/transfer"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/interfaces/mocks"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportSchedule(t *testing.T) {
+	dbMock := &mocks.DBClient{}
+	dbMock.On("Intervals").Return([]contract.Interval{{ID: TestId, Name: TestName, Cron: "0 30 2 * *"}}, nil)
+	dbMock.On("IntervalActions").Return([]contract.IntervalAction{{ID: TestId, Name: "scrub", Interval: TestName, Target: "core-data"}}, nil)
+
+	rr := httptest.NewRecorder()
+	restExportSchedule(rr, httptest.NewRequest(http.MethodGet, TestURI, nil), logger.NewMockClient(), dbMock)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	var document scheduleConfiguration
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&document))
+	require.Len(t, document.Intervals, 1)
+	require.Len(t, document.IntervalActions, 1)
+	assert.Equal(t, TestName, document.Intervals[0].Name)
+	assert.Empty(t, document.Intervals[0].ID, "site specific ids should not be exported")
+	assert.Equal(t, "0 30 2 * * *", document.Intervals[0].Cron, "legacy cron expressions should be exported upgraded")
+	assert.Empty(t, document.IntervalActions[0].ID, "site specific ids should not be exported")
+}
+
+func TestImportSchedule(t *testing.T) {
+	newInterval := contract.Interval{Name: TestOtherName, Frequency: "PT1H"}
+	actionWithoutTarget := contract.IntervalAction{Name: "no-target", Interval: TestOtherName}
+	document := scheduleConfiguration{
+		Intervals:       []contract.Interval{newInterval},
+		IntervalActions: []contract.IntervalAction{actionWithoutTarget},
+	}
+
+	dbMock := &mocks.DBClient{}
+	dbMock.On("IntervalByName", TestOtherName).Return(contract.Interval{}, db.ErrNotFound)
+	dbMock.On("AddInterval",
+		mock.MatchedBy(func(i contract.Interval) bool { return i.Name == TestOtherName })).Return(TestId, nil)
+	dbMock.On("IntervalActionByName", actionWithoutTarget.Name).Return(contract.IntervalAction{}, db.ErrNotFound)
+	scMock := &mocks.SchedulerQueueClient{}
+	scMock.On("AddIntervalToQueue", mock.Anything).Return(nil)
+
+	body, _ := json.Marshal(document)
+	rr := httptest.NewRecorder()
+	restImportSchedule(rr, httptest.NewRequest(http.MethodPost, TestURI, bytes.NewBuffer(body)), logger.NewMockClient(), dbMock, scMock)
+	require.Equal(t, http.StatusMultiStatus, rr.Code)
+
+	var result transfer.ImportResult
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&result))
+	assert.Equal(t, []string{INTERVAL + "/" + TestOtherName}, result.Created)
+	assert.Empty(t, result.Updated)
+	assert.Contains(t, result.Failed, INTERVALACTION+"/"+actionWithoutTarget.Name)
+}
@@ -20,7 +20,9 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/secretref"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/telemetry"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/constant"
 	schedulerContainer "github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
@@ -44,7 +46,7 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 	r.HandleFunc(clients.
 		ApiConfigRoute,
 		func(w http.ResponseWriter, _ *http.Request) {
-			pkg.Encode(schedulerContainer.ConfigurationFrom(dic.Get), w, bootstrapContainer.LoggingClientFrom(dic.Get))
+			secretref.EncodeConfiguration(schedulerContainer.ConfigurationFrom(dic.Get), w, dic)
 		}).Methods(http.MethodGet)
 
 	// Metrics
@@ -57,6 +59,41 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 	// Version
 	r.HandleFunc(clients.ApiVersionRoute, pkg.VersionHandler).Methods(http.MethodGet)
 
+	// Effective state, in canonical form for the detection of drift
+	r.HandleFunc(
+		clients.ApiConfigRoute+"/"+EFFECTIVE,
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetEffectiveState(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
+
+	// Interval next runs preview
+	r.HandleFunc(
+		constant.ApiIntervalNextRunsByNameRoute,
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetIntervalNextRuns(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get),
+				schedulerContainer.ConfigurationFrom(dic.Get))
+		}).Methods(http.MethodGet)
+
+	// Interval action execution history
+	r.HandleFunc(
+		constant.ApiIntervalActionHistoryByNameRoute,
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetIntervalActionHistory(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get),
+				schedulerContainer.ConfigurationFrom(dic.Get))
+		}).Methods(http.MethodGet)
+
 	// Interval
 	r.HandleFunc(clients.
 		ApiIntervalRoute,
@@ -88,6 +125,45 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				container.DBClientFrom(dic.Get),
 				schedulerContainer.QueueFrom(dic.Get))
 		}).Methods(http.MethodPost)
+	// Export/Import of intervals together with their interval actions
+	r.HandleFunc(
+		clients.ApiIntervalRoute+"/"+EXPORT,
+		func(w http.ResponseWriter, r *http.Request) {
+			restExportSchedule(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
+	r.HandleFunc(
+		clients.ApiIntervalRoute+"/"+IMPORT,
+		func(w http.ResponseWriter, r *http.Request) {
+			restImportSchedule(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get),
+				schedulerContainer.QueueFrom(dic.Get))
+		}).Methods(http.MethodPost)
+	// Execution status of the intervals in the scheduler queue
+	r.HandleFunc(
+		clients.ApiIntervalRoute+"/"+STATUS,
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetIntervalStatuses(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				schedulerContainer.QueueFrom(dic.Get))
+		}).Methods(http.MethodGet)
+	r.HandleFunc(
+		clients.ApiIntervalRoute+"/"+STATUS+"/"+NAME+"/{"+NAME+"}",
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetIntervalStatusByName(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				schedulerContainer.QueueFrom(dic.Get))
+		}).Methods(http.MethodGet)
 	interval := r.PathPrefix(clients.ApiIntervalRoute).Subrouter()
 	interval.HandleFunc(
 		"/{"+ID+"}",
@@ -13,6 +13,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -22,7 +23,12 @@ import (
 	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
 	queueV1 "gopkg.in/eapache/queue.v1"
 
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/messaging"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/interfaces"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+	intervalOperator "github.com/edgexfoundry/edgex-go/internal/support/scheduler/operators/interval"
 )
 
 // the interval specific shared variables
@@ -35,12 +41,36 @@ var (
 	intervalActionIdToIntervalMap           = make(map[string]string)
 	intervalActionNameToIntervalMap         = make(map[string]string)
 	intervalActionNameToIntervalActionIdMap = make(map[string]string)
+	intervalIdToLocationMap                 = make(map[string]*time.Location)
+	intervalIdToMaxIterationsMap            = make(map[string]int64)
+	intervalActionIdToPolicyMap             = make(map[string]schedulerModels.ExecutionPolicy)
+	intervalActionIdToRunAfterMap           = make(map[string][]string)
+	intervalActionIdToAuthHeadersMap        = make(map[string][]schedulerModels.AuthHeader)
 )
 
-func StartTicker(ticker *time.Ticker, lc logger.LoggingClient, configuration *config.ConfigurationStruct) {
+// StartTicker triggers the intervals on every tick as long as the instance is the leader. An instance taking over from
+// another one reloads the intervals the former leader executed and modified meanwhile first.
+func StartTicker(
+	ticker *time.Ticker,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	scClient interfaces.SchedulerQueueClient,
+	msgClient messaging.MessageClient,
+	recorder *ExecutionRecorder,
+	secrets *HeaderSecrets,
+	elector *LeaderElector,
+	configuration *config.ConfigurationStruct) {
 	go func() {
 		for range ticker.C {
-			triggerInterval(lc, configuration)
+			if !elector.IsLeader() {
+				continue
+			}
+			if elector.TookOver() {
+				if err := LoadScheduler(lc, dbClient, scClient, configuration); err != nil {
+					lc.Error(fmt.Sprintf("Failed to reload schedules and events on taking over %s", err.Error()))
+				}
+			}
+			triggerInterval(lc, dbClient, msgClient, recorder, secrets, configuration)
 		}
 	}()
 }
@@ -66,7 +96,12 @@ func clearMaps() {
 	intervalActionIdToIntervalMap = make(map[string]string)           // map : interval action id -> interval id
 	intervalActionNameToIntervalMap = make(map[string]string)         // map : interval action name -> interval id
 	intervalActionNameToIntervalActionIdMap = make(map[string]string) // map : interval action name -> interval actionId
+	intervalIdToLocationMap = make(map[string]*time.Location)         // map : interval id -> interval timezone
+	intervalIdToMaxIterationsMap = make(map[string]int64)             // map : interval id -> interval max iterations
 
+	intervalActionIdToPolicyMap = make(map[string]schedulerModels.ExecutionPolicy)   // map : interval action id -> execution policy
+	intervalActionIdToRunAfterMap = make(map[string][]string)                        // map : interval action id -> names of the actions it runs after
+	intervalActionIdToAuthHeadersMap = make(map[string][]schedulerModels.AuthHeader) // map : interval action id -> secret-backed headers
 }
 
 func addIntervalOperation(interval contract.Interval, context *IntervalContext) {
@@ -138,6 +173,34 @@ func (qc *QueueClient) QueryIntervalByName(intervalName string) (contract.Interv
 	return intervalContext.Interval, nil
 }
 
+func (qc *QueueClient) QueryIntervalStatuses() []schedulerModels.IntervalStatus {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	statuses := make([]schedulerModels.IntervalStatus, 0, len(intervalNameToContextMap))
+	for _, intervalContext := range intervalNameToContextMap {
+		statuses = append(statuses, intervalContext.Status())
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Name < statuses[j].Name
+	})
+
+	return statuses
+}
+
+func (qc *QueueClient) QueryIntervalStatusByName(intervalName string) (schedulerModels.IntervalStatus, error) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	intervalContext, exists := intervalNameToContextMap[intervalName]
+	if !exists {
+		return schedulerModels.IntervalStatus{},
+			fmt.Errorf("scheduler could not find interval with interval with name : %s", intervalName)
+	}
+
+	return intervalContext.Status(), nil
+}
+
 func (qc *QueueClient) AddIntervalToQueue(interval contract.Interval) error {
 	mutex.Lock()
 	defer mutex.Unlock()
@@ -153,6 +216,8 @@ func (qc *QueueClient) AddIntervalToQueue(interval contract.Interval) error {
 	context := IntervalContext{
 		IntervalActionsMap: make(map[string]contract.IntervalAction),
 		MarkedDeleted:      false,
+		Location:           intervalIdToLocationMap[intervalId],
+		IterationLimit:     intervalIdToMaxIterationsMap[intervalId],
 	}
 
 	qc.loggingClient.Debug(fmt.Sprintf("resetting the interval with id : %s", intervalId))
@@ -192,6 +257,76 @@ func (qc *QueueClient) UpdateIntervalInQueue(interval contract.Interval) error {
 	return nil
 }
 
+// SetIntervalTimezone sets the timezone the interval is scheduled in, nil for UTC, rescheduling the interval when it
+// is already in the queue. The timezone of an interval not in the queue yet applies once it is added.
+func (qc *QueueClient) SetIntervalTimezone(intervalId string, location *time.Location) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if location == nil {
+		delete(intervalIdToLocationMap, intervalId)
+	} else {
+		intervalIdToLocationMap[intervalId] = location
+	}
+
+	context, exists := intervalIdToContextMap[intervalId]
+	if !exists {
+		return
+	}
+	context.Location = location
+	context.Reset(context.Interval, qc.loggingClient)
+
+	qc.loggingClient.Info(fmt.Sprintf("rescheduled the interval with id: %s in timezone %s", intervalId, context.location()))
+}
+
+// SetIntervalIterations sets the maximum number of runs of the interval, 0 for no limit, after which it completes, and
+// the number of runs it has left, the maximum when negative. The maximum of an interval not in the queue yet applies
+// once it is added.
+func (qc *QueueClient) SetIntervalIterations(intervalId string, maxIterations int64, remaining int64) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if maxIterations == 0 {
+		delete(intervalIdToMaxIterationsMap, intervalId)
+	} else {
+		intervalIdToMaxIterationsMap[intervalId] = maxIterations
+	}
+
+	context, exists := intervalIdToContextMap[intervalId]
+	if !exists {
+		return
+	}
+	context.SetIterations(maxIterations, remaining)
+
+	qc.loggingClient.Info(fmt.Sprintf(
+		"the interval with id: %s has %d runs left out of %d",
+		intervalId,
+		context.RemainingIterations(),
+		context.MaxIterations))
+}
+
+// SetIntervalMissedRuns queues, according to the misfire policy, the runs of the interval missed since its last run
+// while the scheduler was down, at most max. They are executed as soon as possible apart from the schedule. It returns
+// the number of runs queued.
+func (qc *QueueClient) SetIntervalMissedRuns(intervalId string, lastRun time.Time, policy string, max int64) int64 {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	context, exists := intervalIdToContextMap[intervalId]
+	if !exists || policy == "" || policy == intervalOperator.MisfireSkip {
+		return 0
+	}
+	if policy == intervalOperator.MisfireRunOnceNow && max > 1 {
+		max = 1
+	}
+	// the catch-up runs count against the maximum number of runs of the interval
+	if remaining := context.RemainingIterations(); remaining >= 0 && max > remaining {
+		max = remaining
+	}
+	context.MissedRuns = context.MissedRunsSince(lastRun, time.Now(), max)
+	return context.MissedRuns
+}
+
 func (qc *QueueClient) RemoveIntervalInQueue(intervalId string) error {
 	mutex.Lock()
 	defer mutex.Unlock()
@@ -209,6 +344,8 @@ func (qc *QueueClient) RemoveIntervalInQueue(intervalId string) error {
 	}
 
 	deleteIntervalOperation(intervalContext.Interval, intervalContext)
+	delete(intervalIdToLocationMap, intervalId)
+	delete(intervalIdToMaxIterationsMap, intervalId)
 
 	qc.loggingClient.Info(fmt.Sprintf("removed the interval with id: %s from the scheduler queue", intervalId))
 
@@ -378,6 +515,8 @@ func (qc *QueueClient) UpdateIntervalActionQueue(intervalAction contract.Interva
 			context := IntervalContext{
 				IntervalActionsMap: make(map[string]contract.IntervalAction),
 				MarkedDeleted:      false,
+				Location:           intervalIdToLocationMap[newIntervalId],
+				IterationLimit:     intervalIdToMaxIterationsMap[newIntervalId],
 			}
 			context.Reset(interval, qc.loggingClient)
 
@@ -418,14 +557,111 @@ func (qc *QueueClient) RemoveIntervalActionQueue(intervalActionId string) error
 	}
 
 	delete(intervalContext.IntervalActionsMap, intervalActionId)
+	delete(intervalActionIdToPolicyMap, intervalActionId)
+	delete(intervalActionIdToRunAfterMap, intervalActionId)
+	delete(intervalActionIdToAuthHeadersMap, intervalActionId)
 
 	qc.loggingClient.Info(fmt.Sprintf("removed the intervalAction with id: %s", intervalActionId))
 
 	return nil
 }
 
-func triggerInterval(lc logger.LoggingClient, configuration *config.ConfigurationStruct) {
-	nowEpoch := time.Now().Unix()
+// SetIntervalActionPolicy sets the policy the interval action is executed with from its next execution on, whether it
+// is already in the queue or not.
+func (qc *QueueClient) SetIntervalActionPolicy(intervalActionId string, policy schedulerModels.ExecutionPolicy) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if policy.IsDefault() {
+		delete(intervalActionIdToPolicyMap, intervalActionId)
+		return
+	}
+	intervalActionIdToPolicyMap[intervalActionId] = policy
+
+	qc.loggingClient.Info(fmt.Sprintf(
+		"the intervalAction with id: %s is executed with timeout '%s', %d retries backing off from '%s' and jitter '%s'",
+		intervalActionId,
+		policy.Timeout,
+		policy.Retries,
+		policy.RetryBackoff,
+		policy.Jitter))
+}
+
+// QueryIntervalActionDependencies returns the names of the interval actions each interval action of the interval runs
+// after, by action name.
+func (qc *QueueClient) QueryIntervalActionDependencies(intervalName string) map[string][]string {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	dependencies := make(map[string][]string)
+	intervalContext, exists := intervalNameToContextMap[intervalName]
+	if !exists {
+		return dependencies
+	}
+	for id, intervalAction := range intervalContext.IntervalActionsMap {
+		if runAfter, exists := intervalActionIdToRunAfterMap[id]; exists {
+			dependencies[intervalAction.Name] = append([]string(nil), runAfter...)
+		}
+	}
+	return dependencies
+}
+
+// SetIntervalActionDependencies sets the names of the interval actions the interval action runs after from the next run
+// of its interval on, whether it is already in the queue or not.
+func (qc *QueueClient) SetIntervalActionDependencies(intervalActionId string, runAfter []string) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if len(runAfter) == 0 {
+		delete(intervalActionIdToRunAfterMap, intervalActionId)
+		return
+	}
+	intervalActionIdToRunAfterMap[intervalActionId] = append([]string(nil), runAfter...)
+
+	qc.loggingClient.Info(fmt.Sprintf(
+		"the intervalAction with id: %s runs after %s",
+		intervalActionId,
+		strings.Join(runAfter, ", ")))
+}
+
+// SetIntervalActionAuthHeaders sets the headers injected from the secret store into the requests of the interval action
+// from its next execution on, whether it is already in the queue or not.
+func (qc *QueueClient) SetIntervalActionAuthHeaders(intervalActionId string, headers []schedulerModels.AuthHeader) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if len(headers) == 0 {
+		delete(intervalActionIdToAuthHeadersMap, intervalActionId)
+		return
+	}
+	intervalActionIdToAuthHeadersMap[intervalActionId] = append([]schedulerModels.AuthHeader(nil), headers...)
+
+	names := make([]string, len(headers))
+	for i, h := range headers {
+		names[i] = h.Name()
+	}
+	qc.loggingClient.Info(fmt.Sprintf(
+		"the requests of the intervalAction with id: %s carry the headers %s from the secret store",
+		intervalActionId,
+		strings.Join(names, ", ")))
+}
+
+// authHeadersOf returns the headers injected from the secret store into the requests of the interval action by id.
+func authHeadersOf(intervalActionId string) []schedulerModels.AuthHeader {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	return intervalActionIdToAuthHeadersMap[intervalActionId]
+}
+
+func triggerInterval(
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	msgClient messaging.MessageClient,
+	recorder *ExecutionRecorder,
+	secrets *HeaderSecrets,
+	configuration *config.ConfigurationStruct) {
+	now := time.Now()
 
 	defer func() {
 		if err := recover(); err != nil {
@@ -446,8 +682,15 @@ func triggerInterval(lc logger.LoggingClient, configuration *config.Configuratio
 			if intervalContext.MarkedDeleted {
 				lc.Debug("the interval with id : " + intervalId + " be marked as deleted, removing it.")
 				continue // really delete from the queue
+			} else if intervalContext.RemainingIterations() == 0 && intervalContext.MissedRuns == 0 {
+				// the interval ran its maximum number of times, before the scheduler restarted possibly
+				lc.Debug("the interval with id : " + intervalId + " has no run left, removing it.")
+				continue
 			} else {
-				if intervalContext.NextTime.Unix() <= nowEpoch {
+				// the next time is zero when the cron expression of the interval matches no time anymore, the runs
+				// missed while the scheduler was down are caught up as soon as possible
+				if intervalContext.MissedRuns > 0 ||
+					(!intervalContext.NextTime.IsZero() && !intervalContext.NextTime.After(now)) {
 					lc.Debug(
 						"executing interval, detail : {" + intervalContext.GetInfo() + "} ," +
 							" at : " + intervalContext.NextTime.String())
@@ -455,7 +698,7 @@ func triggerInterval(lc logger.LoggingClient, configuration *config.Configuratio
 					wg.Add(1)
 
 					// execute it in a individual go routine
-					go execute(intervalContext, &wg, lc, configuration)
+					go execute(intervalContext, &wg, lc, dbClient, msgClient, recorder, secrets, configuration)
 				} else {
 					intervalQueue.Add(intervalContext)
 				}
@@ -470,6 +713,10 @@ func execute(
 	context *IntervalContext,
 	wg *sync.WaitGroup,
 	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	msgClient messaging.MessageClient,
+	recorder *ExecutionRecorder,
+	secrets *HeaderSecrets,
 	configuration *config.ConfigurationStruct) {
 
 	intervalActionMap := context.IntervalActionsMap
@@ -482,44 +729,74 @@ func execute(
 		}
 	}()
 
+	// a catch-up run is executed apart from the schedule, which it leaves as is
+	mutex.Lock()
+	catchUp := context.MissedRuns > 0
+	if catchUp {
+		context.CatchUp()
+	} else {
+		context.RecordExecution(time.Now())
+	}
+	remaining := context.RemainingIterations()
+	mutex.Unlock()
+
 	lc.Debug(fmt.Sprintf("%d interval action need to be executed.", len(intervalActionMap)))
 
-	// execute interval action one by one
+	// the interval actions are executed apart, so that a slow target or the retries of a failing one don't hold up the
+	// schedule, the chained ones one after the other
+	mutex.Lock()
+	chain := newIntervalActionChain(intervalActionMap, intervalActionIdToRunAfterMap, intervalActionIdToPolicyMap)
+	mutex.Unlock()
+
 	for eventId := range intervalActionMap {
+		intervalAction, _ := intervalActionMap[eventId]
+		if chain.includes(intervalAction.Name) {
+			continue
+		}
 		lc.Debug(
 			"the event with id : " + eventId +
 				" belongs to interval : " + context.Interval.ID + " will be executing!")
-		intervalAction, _ := intervalActionMap[eventId]
-
-		executingUrl := getUrlStr(intervalAction)
-		lc.Debug("the event with id : " + eventId + " will request url : " + executingUrl)
 
-		httpMethod := intervalAction.HTTPMethod
-		if !validMethod(httpMethod) {
-			lc.Error(fmt.Sprintf("net/http: invalid method %q", httpMethod))
-			return
-		}
+		mutex.Lock()
+		policy := intervalActionIdToPolicyMap[eventId]
+		mutex.Unlock()
 
-		req, err := getHttpRequest(httpMethod, executingUrl, intervalAction, lc)
+		go executeIntervalAction(context.Interval.Name, intervalAction, policy, lc, msgClient, recorder, secrets, configuration)
+	}
+	if len(chain.order) > 0 {
+		go executeChain(context, chain, lc, msgClient, recorder, secrets, configuration)
+	}
 
-		if err != nil {
-			lc.Error("create new request occurs error : " + err.Error())
+	if catchUp {
+		lc.Info(fmt.Sprintf("caught up a run of the interval %s missed while the scheduler was down", context.Interval.Name))
+	} else {
+		mutex.Lock()
+		skipped := context.SkippedRuns
+		handled := time.Now()
+		context.UpdateNextTime()
+		context.UpdateIterations()
+		skipped = context.SkippedRuns - skipped
+		remaining = context.RemainingIterations()
+		mutex.Unlock()
+
+		if skipped > 0 {
+			lc.Warn(fmt.Sprintf("the interval %s fell behind its schedule, %d runs were skipped", context.Interval.Name, skipped))
 		}
-
-		client := &http.Client{
-			Timeout: time.Duration(configuration.Service.Timeout) * time.Millisecond,
+		recordLastRun(dbClient, context.Interval.ID, handled, lc)
+	}
+	if remaining >= 0 {
+		recordRemainingIterations(dbClient, context.Interval.ID, remaining, lc)
+		if remaining == 0 {
+			lc.Info(fmt.Sprintf("the interval %s ran its maximum number of times and is disabled", context.Interval.Name))
 		}
-		responseBytes, statusCode, err := sendRequestAndGetResponse(client, req)
-		responseStr := string(responseBytes)
-
-		lc.Debug(fmt.Sprintf("execution returns status code : %d", statusCode))
-		lc.Debug("execution returns response content : " + responseStr)
 	}
 
-	context.UpdateNextTime()
-	context.UpdateIterations()
+	// the runs missed before the end of an interval are caught up even though the interval is complete
+	mutex.Lock()
+	complete := context.IsComplete() && context.MissedRuns == 0
+	mutex.Unlock()
 
-	if context.IsComplete() {
+	if complete {
 		lc.Debug("completed interval, detail : " + context.GetInfo())
 	} else {
 		lc.Debug("requeue interval, detail : " + context.GetInfo())
@@ -529,6 +806,25 @@ func execute(
 	return
 }
 
+// recordLastRun persists the time up to which the runs of the interval were executed or skipped, from which the runs
+// missed while the scheduler was down are counted on startup. A failure is only logged so that it doesn't affect the
+// schedule.
+func recordLastRun(dbClient interfaces.DBClient, intervalId string, lastRun time.Time, lc logger.LoggingClient) {
+	err := dbClient.SetIntervalLastRun(intervalId, toMillis(lastRun))
+	if err != nil && err != db.ErrUnsupportedDatabase {
+		lc.Error(fmt.Sprintf("failed to record the last run of the interval with id %s: %s", intervalId, err.Error()))
+	}
+}
+
+// recordRemainingIterations persists the number of runs left to the interval, so that it doesn't run more times than
+// its maximum when the scheduler restarts. A failure is only logged so that it doesn't affect the schedule.
+func recordRemainingIterations(dbClient interfaces.DBClient, intervalId string, remaining int64, lc logger.LoggingClient) {
+	err := dbClient.SetIntervalRemainingIterations(intervalId, remaining)
+	if err != nil && err != db.ErrUnsupportedDatabase {
+		lc.Error(fmt.Sprintf("failed to record the runs left to the interval with id %s: %s", intervalId, err.Error()))
+	}
+}
+
 // TODO xmlviking We may need to modify this for authorization type in the future
 func getHttpRequest(
 	httpMethod string,
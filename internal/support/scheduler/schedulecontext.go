@@ -10,8 +10,13 @@ package scheduler
 import (
 	"time"
 
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+	intervalOperator "github.com/edgexfoundry/edgex-go/internal/support/scheduler/operators/interval"
+
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
 	"github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/robfig/cron"
 )
 
 type IntervalContext struct {
@@ -21,9 +26,32 @@ type IntervalContext struct {
 	EndTime            time.Time
 	NextTime           time.Time
 	Frequency          time.Duration
-	CurrentIterations  int64
-	MaxIterations      int64
-	MarkedDeleted      bool
+	// Schedule of the cron expression of the interval, which takes precedence over its frequency, nil when it has none.
+	Schedule cron.Schedule
+	// Location of the timezone of the interval, in which its start, its end and its cron expression are evaluated and
+	// its frequencies of whole days are counted in calendar days, nil for UTC.
+	Location *time.Location
+	// IterationLimit is the maximum number of runs of the interval, set apart from it like its timezone, after which it
+	// completes, 0 for no limit. An interval run once has a maximum of 1 run whatever the limit.
+	IterationLimit    int64
+	CurrentIterations int64
+	MaxIterations     int64
+	MarkedDeleted     bool
+	// MissedRuns is the number of runs missed while the scheduler was down still to be caught up, executed as soon as
+	// possible apart from the schedule.
+	MissedRuns int64
+
+	// execution statistics, the drift being the delay between the scheduled and the actual start of an execution
+	Executions  int64
+	SkippedRuns int64
+	CatchUpRuns int64
+	LastRun     time.Time
+	LastDrift   time.Duration
+	MaxDrift    time.Duration
+	TotalDrift  time.Duration
+	// LastChain is the outcome of the last run of the actions of the interval chained by their dependencies, nil when
+	// none has completed.
+	LastChain *schedulerModels.ChainOutcome
 }
 
 func (sc *IntervalContext) Reset(interval models.Interval, lc logger.LoggingClient) {
@@ -34,19 +62,16 @@ func (sc *IntervalContext) Reset(interval models.Interval, lc logger.LoggingClie
 
 	sc.Interval = interval
 
-	// run times, current and max iteration
-	if sc.Interval.RunOnce {
-		sc.MaxIterations = 1
-	} else {
-		sc.MaxIterations = 0
-	}
-	sc.CurrentIterations = 0
+	// run times, max iteration, the iterations already run being kept so that the interval isn't run more times than
+	// its maximum when it is updated
+	sc.MaxIterations = sc.maxIterations()
 
 	// start and end time
+	now := time.Now()
 	if sc.Interval.Start == "" {
-		sc.StartTime = time.Now()
+		sc.StartTime = now
 	} else {
-		t, err := time.Parse(TIMELAYOUT, sc.Interval.Start)
+		t, err := time.ParseInLocation(TIMELAYOUT, sc.Interval.Start, sc.location())
 		if err != nil {
 			lc.Error("parse time error, the original time string is : " + sc.Interval.Start)
 		}
@@ -58,7 +83,7 @@ func (sc *IntervalContext) Reset(interval models.Interval, lc logger.LoggingClie
 		// use max time
 		sc.EndTime = time.Unix(1<<63-62135596801, 999999999)
 	} else {
-		t, err := time.Parse(TIMELAYOUT, sc.Interval.End)
+		t, err := time.ParseInLocation(TIMELAYOUT, sc.Interval.End, sc.location())
 		if err != nil {
 			lc.Error("parse time error, the original time string is : " + sc.Interval.End)
 		}
@@ -66,9 +91,17 @@ func (sc *IntervalContext) Reset(interval models.Interval, lc logger.LoggingClie
 		sc.EndTime = t
 	}
 
-	// frequency and next time
-	nowBenchmark := time.Now().Unix()
-	if !sc.Interval.RunOnce {
+	// cron schedule or frequency, and next time
+	sc.Schedule = nil
+	sc.Frequency = 0
+	if !sc.Interval.RunOnce && sc.Interval.Cron != "" {
+		schedule, err := intervalOperator.ParseStoredCron(sc.Interval.Cron)
+		if err != nil {
+			lc.Error("interval parse cron error " + err.Error())
+		}
+		sc.Schedule = schedule
+	}
+	if !sc.Interval.RunOnce && sc.Schedule == nil {
 		frequency, err := parseFrequency(sc.Interval.Frequency)
 		if err != nil {
 			lc.Error("interval parse frequency error  %v", err.Error())
@@ -76,28 +109,179 @@ func (sc *IntervalContext) Reset(interval models.Interval, lc logger.LoggingClie
 		sc.Frequency = frequency
 	}
 
-	sc.NextTime = sc.StartTime
-	if sc.StartTime.Unix() <= nowBenchmark && !sc.Interval.RunOnce {
-		for sc.NextTime.Unix() <= nowBenchmark {
-			sc.NextTime = sc.NextTime.Add(sc.Frequency)
+	next := sc.StartTime
+	if sc.Schedule != nil {
+		// the first run is the first time matching the expression from the start time, or from now once started
+		from := next.Add(-time.Nanosecond)
+		if from.Before(now) {
+			from = now
 		}
+		next = sc.Schedule.Next(sc.in(from))
+	} else if !next.After(now) && !sc.Interval.RunOnce && sc.Frequency > 0 {
+		next = sc.advance(next, sc.runsUntilAfter(next, now))
+	}
+	// The next run is expressed relative to now so that it carries a monotonic clock reading; the following runs are
+	// derived from it and are therefore immune to changes of the wall clock.
+	if !next.IsZero() {
+		next = now.Add(next.Sub(now))
 	}
+	sc.NextTime = next
+	sc.MissedRuns = 0
+	sc.resetStatistics()
 }
 
 func (sc *IntervalContext) IsComplete() bool {
 	return sc.isComplete(time.Now())
 }
 
+// UpdateIterations counts a run of the interval against its maximum number of runs.
 func (sc *IntervalContext) UpdateIterations() {
-	if !sc.IsComplete() {
+	if sc.MaxIterations == 0 || sc.CurrentIterations < sc.MaxIterations {
 		sc.CurrentIterations += 1
 	}
 }
 
+// SetIterations sets the maximum number of runs of the interval, 0 for no limit, and the number of runs it has left,
+// the maximum when negative.
+func (sc *IntervalContext) SetIterations(maxIterations int64, remaining int64) {
+	sc.IterationLimit = maxIterations
+	sc.MaxIterations = sc.maxIterations()
+	sc.CurrentIterations = 0
+	if remaining >= 0 && remaining < sc.MaxIterations {
+		sc.CurrentIterations = sc.MaxIterations - remaining
+	}
+}
+
+// RemainingIterations returns the number of runs the interval has left, -1 when its runs aren't limited.
+func (sc *IntervalContext) RemainingIterations() int64 {
+	if sc.MaxIterations == 0 {
+		return -1
+	}
+	if sc.CurrentIterations >= sc.MaxIterations {
+		return 0
+	}
+	return sc.MaxIterations - sc.CurrentIterations
+}
+
+// UpdateNextTime advances the next run by the frequency, or to the next time matching the cron expression, against the
+// absolute schedule rather than the end of the previous execution. Runs which were missed because an execution
+// outlasted the frequency are skipped.
 func (sc *IntervalContext) UpdateNextTime() {
-	if !sc.IsComplete() {
-		sc.NextTime = sc.NextTime.Add(sc.Frequency)
+	sc.updateNextTime(time.Now())
+}
+
+// MissedRunsSince returns the number of runs of the interval scheduled after the last run and up to now, at most max,
+// which were missed while the scheduler was down. An interval run once has no missed run, being executed on startup
+// when its start is past.
+func (sc *IntervalContext) MissedRunsSince(lastRun time.Time, now time.Time, max int64) int64 {
+	if sc.Interval.RunOnce || (sc.Frequency <= 0 && sc.Schedule == nil) || max <= 0 {
+		return 0
+	}
+
+	var next time.Time
+	if lastRun.Before(sc.StartTime) {
+		next = sc.StartTime
+		if sc.Schedule != nil {
+			next = sc.Schedule.Next(sc.in(sc.StartTime.Add(-time.Nanosecond)))
+		}
+	} else if sc.Schedule != nil {
+		next = sc.Schedule.Next(sc.in(lastRun))
+	} else {
+		next = sc.advance(sc.StartTime, sc.runsUntilAfter(sc.StartTime, lastRun))
+	}
+
+	var missed int64
+	for ; missed < max && !next.IsZero() && !next.After(now) && !next.After(sc.EndTime); next = sc.after(next) {
+		missed++
+	}
+	return missed
+}
+
+// CatchUp records the execution of a run missed while the scheduler was down, which counts against the maximum number
+// of runs of the interval.
+func (sc *IntervalContext) CatchUp() {
+	if sc.MissedRuns > 0 {
+		sc.MissedRuns--
+	}
+	sc.CatchUpRuns++
+	if sc.MaxIterations != 0 {
+		sc.UpdateIterations()
+	}
+}
+
+// RecordExecution records the drift of an execution starting at now.
+func (sc *IntervalContext) RecordExecution(now time.Time) {
+	drift := now.Sub(sc.NextTime)
+	if drift < 0 {
+		drift = 0
+	}
+
+	sc.Executions++
+	sc.LastRun = now
+	sc.LastDrift = drift
+	sc.TotalDrift += drift
+	if drift > sc.MaxDrift {
+		sc.MaxDrift = drift
+	}
+}
+
+// Status returns the execution statistics of the interval.
+func (sc *IntervalContext) Status() schedulerModels.IntervalStatus {
+	status := schedulerModels.IntervalStatus{
+		Name:        sc.Interval.Name,
+		Frequency:   sc.Interval.Frequency,
+		Cron:        sc.Interval.Cron,
+		NextRun:     toMillis(sc.NextTime),
+		Executions:  sc.Executions,
+		SkippedRuns: sc.SkippedRuns,
+		CatchUpRuns: sc.CatchUpRuns,
+		PendingRuns: sc.MissedRuns,
+		LastDrift:   sc.LastDrift.Milliseconds(),
+		MaxDrift:    sc.MaxDrift.Milliseconds(),
+		LastChain:   sc.LastChain,
+	}
+	if sc.Location != nil {
+		status.Timezone = sc.Location.String()
+	}
+	if remaining := sc.RemainingIterations(); remaining >= 0 {
+		status.MaxIterations = sc.MaxIterations
+		status.RemainingIterations = &remaining
+	}
+	if sc.Executions > 0 {
+		status.LastRun = toMillis(sc.LastRun)
+		status.AverageDrift = (sc.TotalDrift / time.Duration(sc.Executions)).Milliseconds()
 	}
+	return status
+}
+
+// NextRuns returns, at most, the next count times at which the interval will be executed.
+func (sc *IntervalContext) NextRuns(count int) []time.Time {
+	runs := []time.Time{}
+	if sc.Interval.RunOnce {
+		if count > 0 && !sc.isComplete(time.Now()) {
+			runs = append(runs, sc.NextTime)
+		}
+		return runs
+	}
+
+	if sc.Frequency <= 0 && sc.Schedule == nil {
+		return runs
+	}
+	if sc.MaxIterations != 0 && int64(count) > sc.MaxIterations-sc.CurrentIterations {
+		count = int(sc.MaxIterations - sc.CurrentIterations)
+	}
+	for next := sc.NextTime; len(runs) < count && !next.IsZero() && !next.After(sc.EndTime); next = sc.after(next) {
+		runs = append(runs, next)
+	}
+	return runs
+}
+
+// maxIterations returns the maximum number of runs of the interval, 0 for no limit.
+func (sc *IntervalContext) maxIterations() int64 {
+	if sc.Interval.RunOnce {
+		return 1
+	}
+	return sc.IterationLimit
 }
 
 func (sc *IntervalContext) GetInfo() string {
@@ -107,6 +291,96 @@ func (sc *IntervalContext) GetInfo() string {
 func (sc *IntervalContext) isComplete(time time.Time) bool {
 	complete := (sc.StartTime.Unix() < time.Unix() && sc.Interval.RunOnce) ||
 		(sc.NextTime.Unix() > sc.EndTime.Unix()) ||
+		// the cron expression matches no time anymore, e.g. a date in the past
+		(sc.Schedule != nil && sc.NextTime.IsZero()) ||
 		((sc.MaxIterations != 0) && (sc.CurrentIterations >= sc.MaxIterations))
 	return complete
 }
+
+func (sc *IntervalContext) updateNextTime(now time.Time) {
+	if sc.IsComplete() {
+		return
+	}
+
+	if sc.Schedule != nil {
+		sc.NextTime = sc.after(sc.NextTime)
+		for !sc.NextTime.IsZero() && !sc.NextTime.After(now) {
+			sc.NextTime = sc.after(sc.NextTime)
+			sc.SkippedRuns++
+		}
+		return
+	}
+
+	sc.NextTime = sc.advance(sc.NextTime, 1)
+	if sc.Frequency > 0 && !sc.NextTime.After(now) {
+		missed := sc.runsUntilAfter(sc.NextTime, now)
+		sc.NextTime = sc.advance(sc.NextTime, missed)
+		sc.SkippedRuns += missed
+	}
+}
+
+// after returns the run following the one at t, which is the zero time when the cron expression matches no time after
+// it. The time returned keeps the monotonic clock reading of t.
+func (sc *IntervalContext) after(t time.Time) time.Time {
+	if sc.Schedule == nil {
+		return sc.advance(t, 1)
+	}
+	next := sc.Schedule.Next(sc.in(t))
+	if next.IsZero() {
+		return next
+	}
+	return t.Add(next.Sub(t))
+}
+
+// advance returns t advanced by n times the frequency, keeping the monotonic clock reading of t. In a timezone,
+// frequencies of whole days advance by calendar days so that the runs keep their time of day across daylight saving
+// time changes, a day being 23 or 25 hours long then.
+func (sc *IntervalContext) advance(t time.Time, n int64) time.Time {
+	if sc.Location == nil || sc.Frequency <= 0 || sc.Frequency%(24*time.Hour) != 0 {
+		return t.Add(time.Duration(n) * sc.Frequency)
+	}
+	days := int64(sc.Frequency/(24*time.Hour)) * n
+	return t.Add(t.In(sc.Location).AddDate(0, 0, int(days)).Sub(t))
+}
+
+// runsUntilAfter returns the number of times the frequency must be added to from, which is not after now, to get past
+// now.
+func (sc *IntervalContext) runsUntilAfter(from time.Time, now time.Time) int64 {
+	n := int64(now.Sub(from)/sc.Frequency) + 1
+	// calendar days may be shorter or longer than the frequency
+	for n > 1 && sc.advance(from, n-1).After(now) {
+		n--
+	}
+	for !sc.advance(from, n).After(now) {
+		n++
+	}
+	return n
+}
+
+// location returns the location of the timezone of the interval.
+func (sc *IntervalContext) location() *time.Location {
+	if sc.Location == nil {
+		return time.UTC
+	}
+	return sc.Location
+}
+
+// in returns t in the timezone of the interval, in which the cron expression is evaluated.
+func (sc *IntervalContext) in(t time.Time) time.Time {
+	return t.In(sc.location())
+}
+
+func (sc *IntervalContext) resetStatistics() {
+	sc.Executions = 0
+	sc.SkippedRuns = 0
+	sc.CatchUpRuns = 0
+	sc.LastRun = time.Time{}
+	sc.LastDrift = 0
+	sc.MaxDrift = 0
+	sc.TotalDrift = 0
+	sc.LastChain = nil
+}
+
+func toMillis(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
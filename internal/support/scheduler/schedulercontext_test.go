@@ -189,6 +189,432 @@ func TestIsComplete(t *testing.T) {
 	}
 }
 
+func TestUpdateNextTimeSkipsMissedRuns(t *testing.T) {
+	testInterval := models.Interval{
+		Name:      TestIntervalName,
+		Frequency: "10s",
+	}
+
+	lc := logger.NewMockClient()
+	testIntervalContext := IntervalContext{}
+	testIntervalContext.Reset(testInterval, lc)
+	scheduled := testIntervalContext.NextTime
+
+	// an execution finishing within the frequency keeps the absolute schedule
+	testIntervalContext.updateNextTime(scheduled.Add(3 * time.Second))
+	if !testIntervalContext.NextTime.Equal(scheduled.Add(10 * time.Second)) {
+		t.Fatalf(TestUnexpectedMsgFormatStr, testIntervalContext.NextTime, scheduled.Add(10*time.Second))
+	}
+
+	// an execution outlasting two frequencies skips the runs it missed
+	testIntervalContext.updateNextTime(scheduled.Add(35 * time.Second))
+	if !testIntervalContext.NextTime.Equal(scheduled.Add(40 * time.Second)) {
+		t.Fatalf(TestUnexpectedMsgFormatStr, testIntervalContext.NextTime, scheduled.Add(40*time.Second))
+	}
+	if testIntervalContext.SkippedRuns != 2 {
+		t.Fatalf(TestUnexpectedMsgFormatStrForInt64Val, testIntervalContext.SkippedRuns, 2)
+	}
+}
+
+func TestRecordExecution(t *testing.T) {
+	testInterval := models.Interval{
+		Name:      TestIntervalName,
+		Frequency: "10s",
+	}
+
+	lc := logger.NewMockClient()
+	testIntervalContext := IntervalContext{}
+	testIntervalContext.Reset(testInterval, lc)
+	scheduled := testIntervalContext.NextTime
+
+	testIntervalContext.RecordExecution(scheduled.Add(200 * time.Millisecond))
+	testIntervalContext.RecordExecution(scheduled.Add(400 * time.Millisecond))
+	testIntervalContext.RecordExecution(scheduled.Add(-time.Second))
+
+	status := testIntervalContext.Status()
+	if status.Executions != 3 {
+		t.Fatalf(TestUnexpectedMsgFormatStrForInt64Val, status.Executions, 3)
+	}
+	if status.LastDrift != 0 {
+		t.Fatalf(TestUnexpectedMsgFormatStrForInt64Val, status.LastDrift, 0)
+	}
+	if status.MaxDrift != 400 {
+		t.Fatalf(TestUnexpectedMsgFormatStrForInt64Val, status.MaxDrift, 400)
+	}
+	if status.AverageDrift != 200 {
+		t.Fatalf(TestUnexpectedMsgFormatStrForInt64Val, status.AverageDrift, 200)
+	}
+
+	testIntervalContext.Reset(testInterval, lc)
+	if testIntervalContext.Status().Executions != 0 {
+		t.Fatal(TestUnexpectedMsg)
+	}
+}
+
+func TestNextRuns(t *testing.T) {
+	testInterval := models.Interval{
+		Name:      TestIntervalName,
+		Frequency: "1h",
+	}
+
+	lc := logger.NewMockClient()
+	testIntervalContext := IntervalContext{}
+	testIntervalContext.Reset(testInterval, lc)
+	scheduled := testIntervalContext.NextTime
+
+	runs := testIntervalContext.NextRuns(3)
+	if len(runs) != 3 {
+		t.Fatalf(TestUnexpectedMsgFormatStrForIntVal, len(runs), 3)
+	}
+	for i, run := range runs {
+		expected := scheduled.Add(time.Duration(i) * time.Hour)
+		if !run.Equal(expected) {
+			t.Fatalf(TestUnexpectedMsgFormatStr, run, expected)
+		}
+	}
+
+	// the preview stops at the end of the interval
+	testInterval.End = scheduled.Add(90 * time.Minute).UTC().Format(TIMELAYOUT)
+	testIntervalContext.Reset(testInterval, lc)
+	if runs := testIntervalContext.NextRuns(10); len(runs) != 2 {
+		t.Fatalf(TestUnexpectedMsgFormatStrForIntVal, len(runs), 2)
+	}
+}
+
+func TestCronNextRuns(t *testing.T) {
+	testInterval := models.Interval{
+		Name:      TestIntervalName,
+		Start:     "20300101T000000",
+		Frequency: "1h",
+		Cron:      "0 30 2 * * *",
+	}
+
+	lc := logger.NewMockClient()
+	testIntervalContext := IntervalContext{}
+	testIntervalContext.Reset(testInterval, lc)
+
+	// the cron expression takes precedence over the frequency
+	runs := testIntervalContext.NextRuns(3)
+	if len(runs) != 3 {
+		t.Fatalf(TestUnexpectedMsgFormatStrForIntVal, len(runs), 3)
+	}
+	for i, run := range runs {
+		expected := time.Date(2030, time.January, 1+i, 2, 30, 0, 0, time.UTC)
+		if !run.Equal(expected) {
+			t.Fatalf(TestUnexpectedMsgFormatStr, run, expected)
+		}
+	}
+}
+
+func TestCronLegacyExpression(t *testing.T) {
+	// stored by a previous release, which read the expressions of 5 fields starting with the second
+	testInterval := models.Interval{
+		Name:  TestIntervalName,
+		Start: "20300101T000000",
+		Cron:  "15 30 2 * *",
+	}
+
+	lc := logger.NewMockClient()
+	testIntervalContext := IntervalContext{}
+	testIntervalContext.Reset(testInterval, lc)
+
+	runs := testIntervalContext.NextRuns(2)
+	if len(runs) != 2 {
+		t.Fatalf(TestUnexpectedMsgFormatStrForIntVal, len(runs), 2)
+	}
+	for i, run := range runs {
+		expected := time.Date(2030, time.January, 1+i, 2, 30, 15, 0, time.UTC)
+		if !run.Equal(expected) {
+			t.Fatalf(TestUnexpectedMsgFormatStr, run, expected)
+		}
+	}
+}
+
+func TestCronUpdateNextTimeSkipsMissedRuns(t *testing.T) {
+	testInterval := models.Interval{
+		Name:  TestIntervalName,
+		Start: "20300101T000000",
+		Cron:  "*/15 * * * * *",
+	}
+
+	lc := logger.NewMockClient()
+	testIntervalContext := IntervalContext{}
+	testIntervalContext.Reset(testInterval, lc)
+	scheduled := testIntervalContext.NextTime
+	expected := time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)
+	if !scheduled.Equal(expected) {
+		t.Fatalf(TestUnexpectedMsgFormatStr, scheduled, expected)
+	}
+
+	// an execution finishing before the next matching time keeps the schedule
+	testIntervalContext.updateNextTime(scheduled.Add(3 * time.Second))
+	if !testIntervalContext.NextTime.Equal(scheduled.Add(15 * time.Second)) {
+		t.Fatalf(TestUnexpectedMsgFormatStr, testIntervalContext.NextTime, scheduled.Add(15*time.Second))
+	}
+
+	// an execution outlasting two matching times skips the runs it missed
+	testIntervalContext.updateNextTime(scheduled.Add(40 * time.Second))
+	if !testIntervalContext.NextTime.Equal(scheduled.Add(45 * time.Second)) {
+		t.Fatalf(TestUnexpectedMsgFormatStr, testIntervalContext.NextTime, scheduled.Add(45*time.Second))
+	}
+	if testIntervalContext.SkippedRuns != 1 {
+		t.Fatalf(TestUnexpectedMsgFormatStrForInt64Val, testIntervalContext.SkippedRuns, 1)
+	}
+}
+
+func TestMissedRunsSince(t *testing.T) {
+	testInterval := models.Interval{
+		Name:      TestIntervalName,
+		Start:     "20200101T000000",
+		Frequency: "1h",
+	}
+
+	lc := logger.NewMockClient()
+	testIntervalContext := IntervalContext{}
+	testIntervalContext.Reset(testInterval, lc)
+
+	lastRun := time.Date(2020, 1, 1, 5, 30, 0, 0, time.UTC)
+	now := time.Date(2020, 1, 1, 9, 0, 0, 0, time.UTC)
+	// the runs at 6, 7, 8 and 9 o'clock were missed
+	if missed := testIntervalContext.MissedRunsSince(lastRun, now, 100); missed != 4 {
+		t.Fatalf(TestUnexpectedMsgFormatStrForInt64Val, missed, 4)
+	}
+	if missed := testIntervalContext.MissedRunsSince(lastRun, now, 2); missed != 2 {
+		t.Fatalf(TestUnexpectedMsgFormatStrForInt64Val, missed, 2)
+	}
+	// a last run before the start counts the runs from the start
+	if missed := testIntervalContext.MissedRunsSince(time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC), now, 100); missed != 10 {
+		t.Fatalf(TestUnexpectedMsgFormatStrForInt64Val, missed, 10)
+	}
+
+	// no run is missed after the end
+	testInterval.End = "20200101T063000"
+	testIntervalContext.Reset(testInterval, lc)
+	if missed := testIntervalContext.MissedRunsSince(lastRun, now, 100); missed != 1 {
+		t.Fatalf(TestUnexpectedMsgFormatStrForInt64Val, missed, 1)
+	}
+
+	// an interval run once has no missed run
+	testIntervalContext.Reset(models.Interval{Name: TestIntervalName, Start: testInterval.Start, RunOnce: true}, lc)
+	if missed := testIntervalContext.MissedRunsSince(lastRun, now, 100); missed != 0 {
+		t.Fatalf(TestUnexpectedMsgFormatStrForInt64Val, missed, 0)
+	}
+}
+
+func TestCronMissedRunsSince(t *testing.T) {
+	testInterval := models.Interval{
+		Name:  TestIntervalName,
+		Start: "20200101T000000",
+		Cron:  "0 0 */2 * * *",
+	}
+
+	lc := logger.NewMockClient()
+	testIntervalContext := IntervalContext{}
+	testIntervalContext.Reset(testInterval, lc)
+
+	// the runs at 6 and 8 o'clock were missed, the one at 4 o'clock being the last run
+	lastRun := time.Date(2020, 1, 1, 4, 0, 0, 0, time.UTC)
+	now := time.Date(2020, 1, 1, 9, 0, 0, 0, time.UTC)
+	if missed := testIntervalContext.MissedRunsSince(lastRun, now, 100); missed != 2 {
+		t.Fatalf(TestUnexpectedMsgFormatStrForInt64Val, missed, 2)
+	}
+}
+
+func TestCatchUp(t *testing.T) {
+	lc := logger.NewMockClient()
+	testIntervalContext := IntervalContext{}
+	testIntervalContext.Reset(models.Interval{Name: TestIntervalName, Frequency: "1h"}, lc)
+	scheduled := testIntervalContext.NextTime
+
+	testIntervalContext.MissedRuns = 2
+	testIntervalContext.CatchUp()
+	status := testIntervalContext.Status()
+	if status.CatchUpRuns != 1 || status.PendingRuns != 1 {
+		t.Fatalf("unexpected catch-up runs %d and pending runs %d", status.CatchUpRuns, status.PendingRuns)
+	}
+	if !testIntervalContext.NextTime.Equal(scheduled) || status.Executions != 0 {
+		t.Fatal("a catch-up run changed the schedule")
+	}
+}
+
+func TestIterations(t *testing.T) {
+	lc := logger.NewMockClient()
+	testIntervalContext := IntervalContext{}
+	testIntervalContext.Reset(models.Interval{Name: TestIntervalName, Frequency: "1h"}, lc)
+	if remaining := testIntervalContext.RemainingIterations(); remaining != -1 {
+		t.Fatalf(TestUnexpectedMsgFormatStrForInt64Val, remaining, -1)
+	}
+
+	testIntervalContext.SetIterations(3, 1)
+	if remaining := testIntervalContext.RemainingIterations(); remaining != 1 {
+		t.Fatalf(TestUnexpectedMsgFormatStrForInt64Val, remaining, 1)
+	}
+	testIntervalContext.UpdateIterations()
+	if remaining := testIntervalContext.RemainingIterations(); remaining != 0 || !testIntervalContext.IsComplete() {
+		t.Fatalf(TestUnexpectedMsgFormatStrForInt64Val, remaining, 0)
+	}
+
+	// an update of the interval keeps the runs it has left
+	testIntervalContext.SetIterations(3, -1)
+	testIntervalContext.MissedRuns = 1
+	testIntervalContext.CatchUp()
+	testIntervalContext.Reset(models.Interval{Name: TestIntervalName, Frequency: "2h"}, lc)
+	if remaining := testIntervalContext.RemainingIterations(); remaining != 2 {
+		t.Fatalf(TestUnexpectedMsgFormatStrForInt64Val, remaining, 2)
+	}
+
+	testIntervalContext.Reset(models.Interval{Name: TestIntervalName, Frequency: "2h", RunOnce: true}, lc)
+	if testIntervalContext.MaxIterations != 1 {
+		t.Fatalf(TestUnexpectedMsgFormatStrForInt64Val, testIntervalContext.MaxIterations, 1)
+	}
+}
+
+func TestCronNeverMatching(t *testing.T) {
+	testInterval := models.Interval{
+		Name: TestIntervalName,
+		Cron: "0 0 0 30 2 *",
+	}
+
+	lc := logger.NewMockClient()
+	testIntervalContext := IntervalContext{}
+	testIntervalContext.Reset(testInterval, lc)
+
+	if !testIntervalContext.IsComplete() {
+		t.Fatal(TestUnexpectedMsg)
+	}
+	if runs := testIntervalContext.NextRuns(3); len(runs) != 0 {
+		t.Fatalf(TestUnexpectedMsgFormatStrForIntVal, len(runs), 0)
+	}
+}
+
+func TestTimezoneStartAndEnd(t *testing.T) {
+	location, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	testInterval := models.Interval{
+		Name:      TestIntervalName,
+		Start:     "20300101T000000",
+		End:       "20300102T000000",
+		Frequency: "1h",
+	}
+
+	lc := logger.NewMockClient()
+	testIntervalContext := IntervalContext{Location: location}
+	testIntervalContext.Reset(testInterval, lc)
+
+	expected := time.Date(2029, time.December, 31, 15, 0, 0, 0, time.UTC)
+	if !testIntervalContext.StartTime.Equal(expected) {
+		t.Fatalf(TestUnexpectedMsgFormatStr, testIntervalContext.StartTime, expected)
+	}
+	expected = time.Date(2030, time.January, 1, 15, 0, 0, 0, time.UTC)
+	if !testIntervalContext.EndTime.Equal(expected) {
+		t.Fatalf(TestUnexpectedMsgFormatStr, testIntervalContext.EndTime, expected)
+	}
+	if status := testIntervalContext.Status(); status.Timezone != "Asia/Tokyo" {
+		t.Fatalf(TestUnexpectedMsgFormatStr, status.Timezone, "Asia/Tokyo")
+	}
+}
+
+func TestTimezoneDailyFrequencyAcrossDST(t *testing.T) {
+	location, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// daylight saving time starts on March 10, 2030 in New York
+	testInterval := models.Interval{
+		Name:      TestIntervalName,
+		Start:     "20300308T090000",
+		Frequency: "24h",
+	}
+
+	lc := logger.NewMockClient()
+	testIntervalContext := IntervalContext{Location: location}
+	testIntervalContext.Reset(testInterval, lc)
+
+	runs := testIntervalContext.NextRuns(4)
+	if len(runs) != 4 {
+		t.Fatalf(TestUnexpectedMsgFormatStrForIntVal, len(runs), 4)
+	}
+	for i, run := range runs {
+		expected := time.Date(2030, time.March, 8+i, 9, 0, 0, 0, location)
+		if !run.Equal(expected) {
+			t.Fatalf(TestUnexpectedMsgFormatStr, run, expected)
+		}
+	}
+	if day := runs[2].Sub(runs[1]); day != 23*time.Hour {
+		t.Fatalf(TestUnexpectedMsgFormatStr, day, 23*time.Hour)
+	}
+
+	// the runs of March 9 and 10 missed are skipped by calendar days
+	testIntervalContext.updateNextTime(time.Date(2030, time.March, 10, 10, 0, 0, 0, location))
+	expected := time.Date(2030, time.March, 11, 9, 0, 0, 0, location)
+	if !testIntervalContext.NextTime.Equal(expected) {
+		t.Fatalf(TestUnexpectedMsgFormatStr, testIntervalContext.NextTime, expected)
+	}
+	if testIntervalContext.SkippedRuns != 2 {
+		t.Fatalf(TestUnexpectedMsgFormatStrForInt64Val, testIntervalContext.SkippedRuns, 2)
+	}
+}
+
+func TestTimezoneHourlyFrequencyAcrossDST(t *testing.T) {
+	location, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	testInterval := models.Interval{
+		Name:      TestIntervalName,
+		Start:     "20300310T010000",
+		Frequency: "1h",
+	}
+
+	lc := logger.NewMockClient()
+	testIntervalContext := IntervalContext{Location: location}
+	testIntervalContext.Reset(testInterval, lc)
+
+	// frequencies of less than a day are elapsed time, skipping the hour which doesn't exist
+	runs := testIntervalContext.NextRuns(2)
+	if len(runs) != 2 {
+		t.Fatalf(TestUnexpectedMsgFormatStrForIntVal, len(runs), 2)
+	}
+	expected := time.Date(2030, time.March, 10, 3, 0, 0, 0, location)
+	if !runs[1].Equal(expected) {
+		t.Fatalf(TestUnexpectedMsgFormatStr, runs[1], expected)
+	}
+}
+
+func TestTimezoneCronAcrossDST(t *testing.T) {
+	location, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// daylight saving time starts on March 31, 2030 in Paris
+	testInterval := models.Interval{
+		Name:  TestIntervalName,
+		Start: "20300329T000000",
+		Cron:  "0 0 9 * * *",
+	}
+
+	lc := logger.NewMockClient()
+	testIntervalContext := IntervalContext{Location: location}
+	testIntervalContext.Reset(testInterval, lc)
+
+	runs := testIntervalContext.NextRuns(4)
+	if len(runs) != 4 {
+		t.Fatalf(TestUnexpectedMsgFormatStrForIntVal, len(runs), 4)
+	}
+	for i, run := range runs {
+		expected := time.Date(2030, time.March, 29+i, 9, 0, 0, 0, location)
+		if !run.Equal(expected) {
+			t.Fatalf(TestUnexpectedMsgFormatStr, run, expected)
+		}
+	}
+	expected := time.Date(2030, time.March, 31, 7, 0, 0, 0, time.UTC)
+	if !runs[2].Equal(expected) {
+		t.Fatalf(TestUnexpectedMsgFormatStr, runs[2], expected)
+	}
+}
+
 func TestParseNanoSecondFrequency(t *testing.T) {
 
 	durationStr := "50ns"
@@ -15,6 +15,8 @@
 package config
 
 import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
+
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
 )
 
@@ -29,6 +31,7 @@ type ConfigurationStruct struct {
 	Registry         bootstrapConfig.RegistryInfo
 	FormatSpecifier  string
 	SecretStore      bootstrapConfig.SecretStoreInfo
+	Audit            audit.AuditInfo
 }
 
 type WritableInfo struct {
@@ -20,6 +20,7 @@ import (
 
 	"github.com/edgexfoundry/edgex-go"
 	"github.com/edgexfoundry/edgex-go/internal"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/audit"
 	agentConfig "github.com/edgexfoundry/edgex-go/internal/system/agent/config"
 	"github.com/edgexfoundry/edgex-go/internal/system/agent/container"
 
@@ -70,6 +71,7 @@ func Main(ctx context.Context, cancel context.CancelFunc, router *mux.Router, re
 		dic,
 		[]interfaces.BootstrapHandler{
 			NewBootstrap(router).BootstrapHandler,
+			audit.NewBootstrap(router, clients.SystemManagementAgentServiceKey, &configuration.Audit).BootstrapHandler,
 			httpServer.BootstrapHandler,
 			message.NewBootstrap(clients.SystemManagementAgentServiceKey, edgex.Version).BootstrapHandler,
 			testing.NewBootstrap(httpServer, readyStream).BootstrapHandler,